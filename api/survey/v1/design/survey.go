@@ -592,6 +592,411 @@ var _ = Service("survey", func() {
 		})
 	})
 
+	Method("create_survey_response", func() {
+		Description("Submit a survey response (proxies to ITX POST /v2/surveys/responses). Answers are validated against the survey's template questions first; the caller must be the respondent the response belongs to")
+
+		Security(JWTAuth, func() {
+			Scope("manage:projects")
+			Scope("manage:surveys")
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+
+			Attribute("survey_uid", String, "Survey identifier", func() {
+				Example("b03cdbaf-53b1-4d47-bc04-dd7e459dd309")
+			})
+
+			Attribute("survey_response_uid", String, "Survey response identifier", func() {
+				Example("cba14f40-1636-11ec-9621-0242ac130002")
+			})
+
+			Attribute("answers", ArrayOf(SurveyAnswer), "Answers to the survey questions")
+
+			Required("survey_uid", "survey_response_uid", "answers")
+		})
+
+		HTTP(func() {
+			POST("/surveys/{survey_uid}/responses")
+			Response(StatusCreated)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("update_survey_response", func() {
+		Description("Replace the answers of a survey response (proxies to ITX PUT /v2/surveys/responses/{response_id}). Answers are validated against the survey's template questions first")
+
+		Security(JWTAuth, func() {
+			Scope("manage:projects")
+			Scope("manage:surveys")
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+
+			Attribute("survey_uid", String, "Survey identifier", func() {
+				Example("b03cdbaf-53b1-4d47-bc04-dd7e459dd309")
+			})
+
+			Attribute("response_id", String, "Response identifier", func() {
+				Example("cba14f40-1636-11ec-9621-0242ac130002")
+			})
+
+			Attribute("answers", ArrayOf(SurveyAnswer), "Answers to the survey questions")
+
+			Required("survey_uid", "response_id", "answers")
+		})
+
+		HTTP(func() {
+			PUT("/surveys/{survey_uid}/responses/{response_id}")
+			Response(StatusNoContent)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("list_survey_invites", func() {
+		Description("List the LFID invites sent to recipients of a survey, with counts by status")
+
+		Security(JWTAuth, func() {
+			Scope("manage:projects")
+			Scope("manage:surveys")
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+
+			Attribute("survey_uid", String, "Survey identifier", func() {
+				Example("b03cdbaf-53b1-4d47-bc04-dd7e459dd309")
+			})
+
+			Attribute("status", String, "Only list invites in this status", func() {
+				Enum("pending", "sent", "failed", "accepted", "expired")
+				Example("failed")
+			})
+
+			Required("survey_uid")
+		})
+
+		Result(SurveyInviteList)
+
+		HTTP(func() {
+			GET("/surveys/{survey_uid}/invites")
+			Param("status")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("get_survey_invite_settings", func() {
+		Description("Get the LFID invite settings stored for a survey")
+
+		Security(JWTAuth, func() {
+			Scope("manage:projects")
+			Scope("manage:surveys")
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+
+			Attribute("survey_uid", String, "Survey identifier", func() {
+				Example("b03cdbaf-53b1-4d47-bc04-dd7e459dd309")
+			})
+
+			Required("survey_uid")
+		})
+
+		Result(InviteSettings)
+
+		HTTP(func() {
+			GET("/surveys/{survey_uid}/invite_settings")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("update_survey_invite_settings", func() {
+		Description("Replace the LFID invite settings of a survey; unset fields inherit from the project")
+
+		Security(JWTAuth, func() {
+			Scope("manage:projects")
+			Scope("manage:surveys")
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+
+			Attribute("survey_uid", String, "Survey identifier", func() {
+				Example("b03cdbaf-53b1-4d47-bc04-dd7e459dd309")
+			})
+
+			InviteSettingsAttributes()
+
+			Required("survey_uid")
+		})
+
+		Result(InviteSettings)
+
+		HTTP(func() {
+			PUT("/surveys/{survey_uid}/invite_settings")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("delete_survey_invite_settings", func() {
+		Description("Remove the LFID invite settings of a survey so that its invites inherit again")
+
+		Security(JWTAuth, func() {
+			Scope("manage:projects")
+			Scope("manage:surveys")
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+
+			Attribute("survey_uid", String, "Survey identifier", func() {
+				Example("b03cdbaf-53b1-4d47-bc04-dd7e459dd309")
+			})
+
+			Required("survey_uid")
+		})
+
+		HTTP(func() {
+			DELETE("/surveys/{survey_uid}/invite_settings")
+			Response(StatusNoContent)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("get_project_invite_settings", func() {
+		Description("Get the LFID invite settings stored for a project")
+
+		Security(JWTAuth, func() {
+			Scope("manage:projects")
+			Scope("manage:surveys")
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+
+			Attribute("project_uid", String, "Project identifier", func() {
+				Example("7cad5a8d-19d0-41a4-81a6-043453daf9ee")
+			})
+
+			Required("project_uid")
+		})
+
+		Result(InviteSettings)
+
+		HTTP(func() {
+			GET("/surveys/projects/{project_uid}/invite_settings")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("update_project_invite_settings", func() {
+		Description("Replace the LFID invite settings of a project; unset fields inherit the service defaults")
+
+		Security(JWTAuth, func() {
+			Scope("manage:projects")
+			Scope("manage:surveys")
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+
+			Attribute("project_uid", String, "Project identifier", func() {
+				Example("7cad5a8d-19d0-41a4-81a6-043453daf9ee")
+			})
+
+			InviteSettingsAttributes()
+
+			Required("project_uid")
+		})
+
+		Result(InviteSettings)
+
+		HTTP(func() {
+			PUT("/surveys/projects/{project_uid}/invite_settings")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("delete_project_invite_settings", func() {
+		Description("Remove the LFID invite settings of a project so that its invites inherit again")
+
+		Security(JWTAuth, func() {
+			Scope("manage:projects")
+			Scope("manage:surveys")
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+
+			Attribute("project_uid", String, "Project identifier", func() {
+				Example("7cad5a8d-19d0-41a4-81a6-043453daf9ee")
+			})
+
+			Required("project_uid")
+		})
+
+		HTTP(func() {
+			DELETE("/surveys/projects/{project_uid}/invite_settings")
+			Response(StatusNoContent)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("get_survey_settings", func() {
+		Description("Get the settings this service keeps for a survey")
+
+		Security(JWTAuth, func() {
+			Scope("manage:projects")
+			Scope("manage:surveys")
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+
+			Attribute("survey_uid", String, "Survey identifier", func() {
+				Example("b03cdbaf-53b1-4d47-bc04-dd7e459dd309")
+			})
+
+			Required("survey_uid")
+		})
+
+		Result(SurveySettings)
+
+		HTTP(func() {
+			GET("/surveys/{survey_uid}/settings")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("update_survey_settings", func() {
+		Description("Replace the settings of a survey. Turning share_results on grants every respondent with an LFX username the results_viewer relation on the survey; turning it off revokes it. Turning anonymous on republishes the survey's responses de-identified; it cannot be turned off.")
+
+		Security(JWTAuth, func() {
+			Scope("manage:projects")
+			Scope("manage:surveys")
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+
+			Attribute("survey_uid", String, "Survey identifier", func() {
+				Example("b03cdbaf-53b1-4d47-bc04-dd7e459dd309")
+			})
+
+			Attribute("share_results", Boolean, "Let respondents view the survey's aggregate results", func() {
+				Default(false)
+				Example(true)
+			})
+			Attribute("anonymous", Boolean, "Keep respondent identity and individual answers from organizers; cannot be turned off once on", func() {
+				Default(false)
+				Example(false)
+			})
+
+			Required("survey_uid")
+		})
+
+		Result(SurveySettings)
+
+		HTTP(func() {
+			PUT("/surveys/{survey_uid}/settings")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("Conflict", StatusConflict)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("get_survey_results", func() {
+		Description("Get the aggregate results of a survey (proxies to ITX GET /v2/surveys/{survey_id}/results without free-text comments). Open to respondents of surveys that share results.")
+
+		Security(JWTAuth, func() {
+			Scope("manage:projects")
+			Scope("manage:surveys")
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+
+			Attribute("survey_uid", String, "Survey identifier", func() {
+				Example("b03cdbaf-53b1-4d47-bc04-dd7e459dd309")
+			})
+
+			Required("survey_uid")
+		})
+
+		Result(SurveyResults)
+
+		HTTP(func() {
+			GET("/surveys/{survey_uid}/results")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
 	Method("validate_email", func() {
 		Description("Validate email template body and subject (proxies to ITX POST /v2/surveys/validate_email)")
 
@@ -603,19 +1008,426 @@ var _ = Service("survey", func() {
 		Payload(func() {
 			BearerTokenAttribute()
 
-			Attribute("body", String, "Email body template")
-			Attribute("subject", String, "Email subject template")
+			Attribute("body", String, "Email body template")
+			Attribute("subject", String, "Email subject template")
+		})
+
+		Result(ValidateEmailResult)
+
+		HTTP(func() {
+			POST("/surveys/validate_email")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("InternalServerError", StatusInternalServerError)
+		})
+	})
+
+	Method("list_survey_templates", func() {
+		Description("Search the catalog of SurveyMonkey surveys that surveys can be scheduled from")
+
+		Security(JWTAuth, func() {
+			Scope("manage:projects")
+			Scope("manage:surveys")
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+
+			Attribute("title", String, "Only return templates whose title or nickname contains this text (case-insensitive)", func() {
+				Example("NPS")
+			})
+			Attribute("category", String, "Only return templates in this category", func() {
+				Example("community")
+			})
+			Attribute("language", String, "Only return templates in this language", func() {
+				Example("en")
+			})
+			Attribute("offset", Int, "Number of matching templates to skip", func() {
+				Minimum(0)
+				Default(0)
+				Example(0)
+			})
+			Attribute("limit", Int, "Maximum number of templates to return", func() {
+				Minimum(1)
+				Maximum(200)
+				Default(50)
+				Example(50)
+			})
+		})
+
+		Result(SurveyTemplateList)
+
+		HTTP(func() {
+			GET("/survey_templates")
+			Param("title")
+			Param("category")
+			Param("language")
+			Param("offset")
+			Param("limit")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("get_survey_template", func() {
+		Description("Get a SurveyMonkey survey from the template catalog")
+
+		Security(JWTAuth, func() {
+			Scope("manage:projects")
+			Scope("manage:surveys")
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+
+			Attribute("template_id", String, "SurveyMonkey survey ID, as passed to schedule_survey as survey_monkey_id", func() {
+				Example("508563520")
+			})
+
+			Required("template_id")
 		})
 
-		Result(ValidateEmailResult)
+		Result(SurveyTemplate)
 
 		HTTP(func() {
-			POST("/surveys/validate_email")
+			GET("/survey_templates/{template_id}")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("list_dead_letters", func() {
+		Description("List v1-objects KV events that exhausted their retries or failed conversion")
+
+		Security(JWTAuth, func() {
+			Scope("manage:surveys")
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+
+			Attribute("key_prefix", String, "Only return events whose KV key starts with this prefix", func() {
+				Example("itx-survey-responses.")
+			})
+			Attribute("after", UInt64, "Return entries after this sequence (next_after from the previous page)", func() {
+				Example(42)
+			})
+			Attribute("limit", Int, "Maximum number of entries to return", func() {
+				Minimum(1)
+				Maximum(500)
+				Default(50)
+				Example(50)
+			})
+		})
+
+		Result(DeadLetterList)
+
+		HTTP(func() {
+			GET("/surveys/admin/dead_letters")
+			Param("key_prefix")
+			Param("after")
+			Param("limit")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("get_dead_letter", func() {
+		Description("Inspect a dead-lettered KV event, including its original payload and headers")
+
+		Security(JWTAuth, func() {
+			Scope("manage:surveys")
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+
+			Attribute("sequence", UInt64, "Dead-letter stream sequence", func() {
+				Example(42)
+			})
+
+			Required("sequence")
+		})
+
+		Result(DeadLetterEntry)
+
+		HTTP(func() {
+			GET("/surveys/admin/dead_letters/{sequence}")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("replay_dead_letter", func() {
+		Description("Re-run a dead-lettered KV event through the event handlers; the entry is removed when it succeeds")
+
+		Security(JWTAuth, func() {
+			Scope("manage:surveys")
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+
+			Attribute("sequence", UInt64, "Dead-letter stream sequence", func() {
+				Example(42)
+			})
+
+			Required("sequence")
+		})
+
+		Result(DeadLetterReplayResult)
+
+		HTTP(func() {
+			POST("/surveys/admin/dead_letters/{sequence}/replay")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("start_reindex", func() {
+		Description("Replay v1-objects survey entries through the event handlers in the background, to rebuild downstream documents")
+
+		Security(JWTAuth, func() {
+			Scope("manage:surveys")
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+
+			Attribute("key_prefixes", ArrayOf(String), "Only replay keys starting with one of these prefixes; each must begin with itx-surveys, itx-survey-responses, itx-survey-exclusions or surveymonkey-surveys. Defaults to all of them.", func() {
+				Example([]string{"itx-survey-responses."})
+			})
+			Attribute("modified_since", String, "Only replay entries last written at or after this time (RFC3339)", func() {
+				Format(FormatDateTime)
+				Example("2026-01-01T00:00:00Z")
+			})
+			Attribute("modified_until", String, "Only replay entries last written before this time (RFC3339)", func() {
+				Format(FormatDateTime)
+				Example("2026-02-01T00:00:00Z")
+			})
+			Attribute("dry_run", Boolean, "Count matching entries without running the handlers", func() {
+				Default(false)
+			})
+			Attribute("force", Boolean, "Republish entries even when their converted content is unchanged since the last publish", func() {
+				Default(false)
+			})
+			Attribute("rate_per_second", Int, "Maximum entries run through the handlers per second", func() {
+				Minimum(1)
+				Maximum(1000)
+				Default(50)
+				Example(50)
+			})
+		})
+
+		Result(ReindexStatus)
+
+		HTTP(func() {
+			POST("/surveys/admin/reindex")
+			Response(StatusAccepted)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("Conflict", StatusConflict)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("get_reindex", func() {
+		Description("Report the progress of the current or most recent reindex on this instance")
+
+		Security(JWTAuth, func() {
+			Scope("manage:surveys")
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+		})
+
+		Result(ReindexStatus)
+
+		HTTP(func() {
+			GET("/surveys/admin/reindex")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("cancel_reindex", func() {
+		Description("Stop the running reindex; entries already replayed are not rolled back")
+
+		Security(JWTAuth, func() {
+			Scope("manage:surveys")
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+		})
+
+		Result(ReindexStatus)
+
+		HTTP(func() {
+			POST("/surveys/admin/reindex/cancel")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("Conflict", StatusConflict)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("rerun_invite_enrichment", func() {
+		Description("Re-run the ITX survey response enrichment for an accepted LFID invite, e.g. after its invite_accepted event was dead-lettered")
+
+		Security(JWTAuth, func() {
+			Scope("manage:surveys")
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+
+			Attribute("email", String, "Email the invite was accepted for", func() {
+				Format(FormatEmail)
+				Example("jane@example.com")
+			})
+			Attribute("username", String, "LFX username of the acceptor; looked up by email when omitted", func() {
+				Example("jdoe")
+			})
+
+			Required("email")
+		})
+
+		Result(InviteEnrichmentResult)
+
+		HTTP(func() {
+			POST("/surveys/admin/invite_enrichment")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("start_invite_backfill", func() {
+		Description("Send LFID invites in the background to participants of open surveys who have an email but no username and were never invited, e.g. because they were synced before invites were enabled")
+
+		Security(JWTAuth, func() {
+			Scope("manage:surveys")
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+
+			Attribute("survey_uids", ArrayOf(String), "Only backfill the responses of these surveys. Defaults to every open survey.", func() {
+				Example([]string{"b03cdbaf-53b1-4d47-bc04-dd7e459dd309"})
+			})
+			Attribute("dry_run", Boolean, "Report the responses that would be invited without sending anything", func() {
+				Default(false)
+			})
+			Attribute("rate_per_second", Int, "Maximum candidates checked and invited per second", func() {
+				Minimum(1)
+				Maximum(50)
+				Default(5)
+				Example(5)
+			})
+		})
+
+		Result(InviteBackfillStatus)
+
+		HTTP(func() {
+			POST("/surveys/admin/invite_backfill")
+			Response(StatusAccepted)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("Conflict", StatusConflict)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("get_invite_backfill", func() {
+		Description("Report the progress of the current or most recent invite backfill on this instance")
+
+		Security(JWTAuth, func() {
+			Scope("manage:surveys")
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+		})
+
+		Result(InviteBackfillStatus)
+
+		HTTP(func() {
+			GET("/surveys/admin/invite_backfill")
+			Response(StatusOK)
+			Response("BadRequest", StatusBadRequest)
+			Response("Unauthorized", StatusUnauthorized)
+			Response("Forbidden", StatusForbidden)
+			Response("NotFound", StatusNotFound)
+			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
+		})
+	})
+
+	Method("cancel_invite_backfill", func() {
+		Description("Stop the running invite backfill; invites already sent are not withdrawn")
+
+		Security(JWTAuth, func() {
+			Scope("manage:surveys")
+		})
+
+		Payload(func() {
+			BearerTokenAttribute()
+		})
+
+		Result(InviteBackfillStatus)
+
+		HTTP(func() {
+			POST("/surveys/admin/invite_backfill/cancel")
 			Response(StatusOK)
 			Response("BadRequest", StatusBadRequest)
 			Response("Unauthorized", StatusUnauthorized)
 			Response("Forbidden", StatusForbidden)
+			Response("Conflict", StatusConflict)
 			Response("InternalServerError", StatusInternalServerError)
+			Response("ServiceUnavailable", StatusServiceUnavailable)
 		})
 	})
 })
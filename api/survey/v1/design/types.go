@@ -18,6 +18,31 @@ func BearerTokenAttribute() {
 	})
 }
 
+// InviteSettingsAttributes are the writable LFID invite settings of a project or survey.
+func InviteSettingsAttributes() {
+	Attribute("enabled", Boolean, "Send LFID invites; false turns them off and true turns them back on for a survey of a disabled project. Omit to inherit.", func() {
+		Example(true)
+	})
+	Attribute("role", String, "Invite service role granted when the invite is accepted. Omit to inherit.", func() {
+		Enum("Participant")
+		Example("Participant")
+	})
+	Attribute("expiration_days", Int, "Days an invite stays valid. Omit to inherit.", func() {
+		Minimum(1)
+		Maximum(90)
+		Example(30)
+	})
+	Attribute("return_url_template", String, "Where the recipient lands after accepting; {base_url}, {survey_uid}, {project_uid} and {locale} are substituted. Omit to inherit.", func() {
+		Example("{base_url}/{locale}/surveys/{survey_uid}")
+	})
+	Attribute("display_name_fallback", String, "Survey name used in the invite when the survey has none. Omit to inherit.", func() {
+		Example("Member Survey")
+	})
+	Attribute("locale", String, "Recipient language as a BCP 47 tag, substituted for {locale} in the return URL. Omit to inherit.", func() {
+		Example("ja-JP")
+	})
+}
+
 //
 // Type Definitions
 //
@@ -220,9 +245,22 @@ var BadRequestError = Type("BadRequestError", func() {
 	Description("Bad request error response")
 	Attribute("code", String, "HTTP status code")
 	Attribute("message", String, "Error message")
+	Attribute("question_errors", ArrayOf(QuestionError), "Per-question problems when survey response answers are rejected")
 	Required("code", "message")
 })
 
+// QuestionError describes why the answer to one question was rejected
+var QuestionError = Type("QuestionError", func() {
+	Description("Validation problem with the answer to one survey question")
+	Attribute("question_id", String, "SurveyMonkey question ID", func() {
+		Example("123456789")
+	})
+	Attribute("message", String, "Why the answer was rejected", func() {
+		Example("choice 999 is not an option for this question")
+	})
+	Required("question_id", "message")
+})
+
 // NotFoundError represents a 404 Not Found error
 var NotFoundError = Type("NotFoundError", func() {
 	Description("Not found error response")
@@ -652,6 +690,32 @@ var SurveyAnswerChoice = Type("SurveyAnswerChoice", func() {
 	})
 })
 
+// SurveyAnswer is the answer to one question when submitting or updating a survey response.
+// Set the one field that matches the question type.
+var SurveyAnswer = Type("SurveyAnswer", func() {
+	Description("Answer to one survey question")
+
+	Attribute("question_id", String, "SurveyMonkey question ID", func() {
+		Example("123456789")
+	})
+
+	Attribute("answer_text", String, "Text answer for open-ended, demographic and date questions", func() {
+		Example("More meetup content")
+	})
+
+	Attribute("choice_ids", ArrayOf(String), "Selected choice IDs for choice and matrix questions", func() {
+		Example([]string{"987654321"})
+	})
+
+	Attribute("rating_value", Int, "Rating for rating questions", func() {
+		Example(9)
+	})
+
+	Attribute("yes_no_value", Boolean, "Answer for single choice questions with two choices")
+
+	Required("question_id")
+})
+
 // ValidateEmailResult represents the validated email template response
 var ValidateEmailResult = Type("ValidateEmailResult", func() {
 	Description("Validated email template body and subject")
@@ -666,3 +730,494 @@ var ValidateEmailResult = Type("ValidateEmailResult", func() {
 
 	Required("body", "subject")
 })
+
+// DeadLetterEntry represents a v1-objects KV event that the event processor could not process
+var DeadLetterEntry = Type("DeadLetterEntry", func() {
+	Description("KV event parked in the dead-letter stream")
+
+	Attribute("sequence", UInt64, "Dead-letter stream sequence, used as the entry identifier", func() {
+		Example(42)
+	})
+	Attribute("key", String, "Original v1-objects KV key", func() {
+		Example("itx-survey-responses.cba14f40-1636-11ec-9621-0242ac130002")
+	})
+	Attribute("operation", String, "Original KV operation", func() {
+		Enum("PUT", "DEL", "PURGE")
+		Example("PUT")
+	})
+	Attribute("revision", UInt64, "KV revision of the original event", func() {
+		Example(1234)
+	})
+	Attribute("reason", String, "Why the event was dead-lettered", func() {
+		Enum("max_deliveries", "conversion_error")
+		Example("max_deliveries")
+	})
+	Attribute("error", String, "Last processing error", func() {
+		Example("retries exhausted")
+	})
+	Attribute("attempts", UInt64, "Number of delivery attempts made", func() {
+		Example(3)
+	})
+	Attribute("dead_lettered_at", String, "When the event was dead-lettered (RFC3339)", func() {
+		Format(FormatDateTime)
+		Example("2026-01-15T10:30:00Z")
+	})
+	Attribute("payload", Bytes, "Original KV value (JSON or msgpack); only returned when inspecting a single entry")
+	Attribute("headers", MapOf(String, ArrayOf(String)), "Original message headers; only returned when inspecting a single entry")
+
+	Required("sequence", "key", "operation", "reason", "error", "attempts", "dead_lettered_at")
+})
+
+// SurveyInvite represents the LFID invite state of one survey recipient
+var SurveyInvite = Type("SurveyInvite", func() {
+	Description("LFID invite sent to a survey recipient who had no LFID")
+
+	Attribute("survey_response_uid", String, "Survey response the invite was sent for", func() {
+		Example("cba14f40-1636-11ec-9621-0242ac130002")
+	})
+	Attribute("email", String, "Recipient email", func() {
+		Example("jane@example.com")
+	})
+	Attribute("name", String, "Recipient name", func() {
+		Example("Jane Doe")
+	})
+	Attribute("status", String, "Invite status; sent invites past their expiry are reported as expired", func() {
+		Enum("pending", "sent", "failed", "accepted", "expired")
+		Example("sent")
+	})
+	Attribute("invite_uid", String, "Invite service invite UID", func() {
+		Example("0f5a8d5e-3c1b-4d1e-9b2a-6f7e8d9c0b1a")
+	})
+	Attribute("error", String, "Why the last send failed", func() {
+		Example("invite service unavailable")
+	})
+	Attribute("created_at", String, "When the invite was first attempted (RFC3339)", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("sent_at", String, "When the invite service accepted the invite (RFC3339)", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("failed_at", String, "When the last send failed (RFC3339)", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("expires_at", String, "When the invite expires (RFC3339)", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("accepted_at", String, "When the invite was accepted (RFC3339)", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("accepted_by", String, "LFX username of the acceptor", func() {
+		Example("jdoe")
+	})
+	Attribute("reinvites", Int, "Times the invite was re-sent after expiring unaccepted", func() {
+		Example(1)
+	})
+	Attribute("attempts", ArrayOf(InviteAttempt), "Every send of the invite, oldest first")
+	Attribute("attached_to", String, "Survey response whose invite the recipient shares instead of receiving a separate one", func() {
+		Example("e2a1c3f0-1636-11ec-9621-0242ac130002")
+	})
+	Attribute("updated_at", String, "When the invite state last changed (RFC3339)", func() {
+		Format(FormatDateTime)
+	})
+
+	Required("survey_response_uid", "email", "status", "reinvites", "created_at", "updated_at")
+})
+
+// InviteAttempt is one send of an LFID invite
+var InviteAttempt = Type("InviteAttempt", func() {
+	Description("One send of an LFID invite to the invite service")
+
+	Attribute("at", String, "When the send was attempted (RFC3339)", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("invite_uid", String, "Invite UID issued by a successful send", func() {
+		Example("0f5a8d5e-3c1b-4d1e-9b2a-6f7e8d9c0b1a")
+	})
+	Attribute("expires_at", String, "When the issued invite expires (RFC3339)", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("error", String, "Why the send failed", func() {
+		Example("invite service unavailable")
+	})
+
+	Required("at")
+})
+
+// SurveyInviteCounts counts the invites of a survey by status
+var SurveyInviteCounts = Type("SurveyInviteCounts", func() {
+	Description("Number of invites in each status")
+
+	Attribute("total", Int, "All invites")
+	Attribute("pending", Int, "Invites interrupted before the invite service replied")
+	Attribute("sent", Int, "Invites awaiting acceptance")
+	Attribute("failed", Int, "Invites the invite service rejected or did not answer")
+	Attribute("accepted", Int, "Accepted invites")
+	Attribute("expired", Int, "Sent invites past their expiry")
+
+	Required("total", "pending", "sent", "failed", "accepted", "expired")
+})
+
+// SurveyInviteList represents the invite state of a survey
+var SurveyInviteList = Type("SurveyInviteList", func() {
+	Description("LFID invites of a survey with counts by status")
+
+	Attribute("counts", SurveyInviteCounts, "Counts over all invites of the survey, regardless of the status filter")
+	Attribute("invites", ArrayOf(SurveyInvite), "Invites ordered by email", func() {
+		Example([]interface{}{})
+	})
+
+	Required("counts", "invites")
+})
+
+// InviteSettings represents the LFID invite settings stored for a project or survey
+var InviteSettings = Type("InviteSettings", func() {
+	Description("LFID invite settings of a project or survey. Unset fields inherit: survey settings override project settings, which override the service defaults.")
+
+	Attribute("scope", String, "What the settings apply to", func() {
+		Enum("project", "survey")
+		Example("survey")
+	})
+	Attribute("uid", String, "Project or survey identifier", func() {
+		Example("b03cdbaf-53b1-4d47-bc04-dd7e459dd309")
+	})
+	InviteSettingsAttributes()
+	Attribute("updated_at", String, "When the settings were last changed (RFC3339)", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("updated_by", String, "Who last changed the settings", func() {
+		Example("jdoe")
+	})
+
+	Required("scope", "uid", "updated_at")
+})
+
+// SurveySettings represents the settings this service keeps for a survey
+var SurveySettings = Type("SurveySettings", func() {
+	Description("Settings of a survey kept by the survey service rather than ITX")
+
+	Attribute("survey_uid", String, "Survey identifier", func() {
+		Example("b03cdbaf-53b1-4d47-bc04-dd7e459dd309")
+	})
+	Attribute("share_results", Boolean, "Whether respondents can view the survey's aggregate results", func() {
+		Example(true)
+	})
+	Attribute("anonymous", Boolean, "Whether responses are kept de-identified from organizers", func() {
+		Example(false)
+	})
+	Attribute("updated_at", String, "When the settings were last changed (RFC3339); unset until they are first stored", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("updated_by", String, "Who last changed the settings", func() {
+		Example("jdoe")
+	})
+	Attribute("results_access", ResultsAccessSync, "results_viewer changes made by this update; only set on update")
+
+	Required("survey_uid", "share_results", "anonymous")
+})
+
+// ResultsAccessSync represents the results_viewer changes made to apply share_results
+var ResultsAccessSync = Type("ResultsAccessSync", func() {
+	Description("results_viewer grants and revocations made to apply share_results")
+
+	Attribute("granted", Int, "Respondents granted results access", func() {
+		Example(42)
+	})
+	Attribute("revoked", Int, "Respondents whose results access was revoked", func() {
+		Example(0)
+	})
+	Attribute("failed", Int, "Changes that failed; update the settings again to retry them", func() {
+		Example(0)
+	})
+
+	Required("granted", "revoked", "failed")
+})
+
+// SurveyResults represents the aggregate results of a survey
+var SurveyResults = Type("SurveyResults", func() {
+	Description("Aggregate results of a survey. Free-text comments are not included.")
+
+	Attribute("survey_uid", String, "Survey identifier", func() {
+		Example("b03cdbaf-53b1-4d47-bc04-dd7e459dd309")
+	})
+	Attribute("num_recipients", Int, "Number of recipients", func() {
+		Example(120)
+	})
+	Attribute("num_responses", Int, "Number of responses", func() {
+		Example(48)
+	})
+	Attribute("survey_end_time", String, "When the survey closes (RFC3339)", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("questions", ArrayOf(SurveyQuestionResult), "Answer counts per question")
+	Attribute("min_cell_size", Int, "Set for anonymous surveys: answers given by fewer respondents are suppressed", func() {
+		Example(5)
+	})
+	Attribute("suppressed", Boolean, "Set for anonymous surveys with fewer than min_cell_size responses: every answer is suppressed", func() {
+		Example(false)
+	})
+
+	Required("survey_uid", "num_recipients", "num_responses", "questions")
+})
+
+// SurveyQuestionResult represents the answer counts of one survey question
+var SurveyQuestionResult = Type("SurveyQuestionResult", func() {
+	Description("Answer counts of a survey question")
+
+	Attribute("question_id", String, "Question identifier", func() {
+		Example("123456789")
+	})
+	Attribute("question_text", String, "Question text", func() {
+		Example("How likely are you to recommend this project?")
+	})
+	Attribute("question_type", String, "Question type", func() {
+		Example("nps")
+	})
+	Attribute("answers", ArrayOf(SurveyAnswerResult), "Counts per answer")
+
+	Required("question_id", "answers")
+})
+
+// SurveyAnswerResult represents how often an answer was given to a question
+var SurveyAnswerResult = Type("SurveyAnswerResult", func() {
+	Description("How often an answer was given")
+
+	Attribute("answer", String, "Answer", func() {
+		Example("10")
+	})
+	Attribute("count", Int, "Number of responses with the answer", func() {
+		Example(12)
+	})
+	Attribute("percentage", Float64, "Share of the question's responses with the answer", func() {
+		Example(25.0)
+	})
+	Attribute("suppressed", Boolean, "The answer was given by too few respondents of an anonymous survey; count and percentage are zero", func() {
+		Example(false)
+	})
+
+	Required("answer", "count", "percentage")
+})
+
+// SurveyTemplate represents a SurveyMonkey survey in the template catalog
+var SurveyTemplate = Type("SurveyTemplate", func() {
+	Description("SurveyMonkey survey that surveys can be scheduled from")
+
+	Attribute("id", String, "SurveyMonkey survey ID; pass as survey_monkey_id to schedule_survey", func() {
+		Example("508563520")
+	})
+	Attribute("title", String, "Survey title", func() {
+		Example("Net Promoter Score")
+	})
+	Attribute("nickname", String, "Internal nickname", func() {
+		Example("NPS 2025")
+	})
+	Attribute("category", String, "Survey category", func() {
+		Example("community")
+	})
+	Attribute("language", String, "Survey language", func() {
+		Example("en")
+	})
+	Attribute("question_count", Int, "Number of questions", func() {
+		Example(12)
+	})
+	Attribute("page_count", Int, "Number of pages", func() {
+		Example(3)
+	})
+	Attribute("preview_url", String, "URL to preview the survey", func() {
+		Example("https://www.surveymonkey.com/r/Preview/?sm=abc")
+	})
+	Attribute("collect_url", String, "URL of the survey collectors", func() {
+		Example("https://www.surveymonkey.com/collect/list?sm=abc")
+	})
+	Attribute("date_created", String, "When the survey was created in SurveyMonkey", func() {
+		Example("2025-01-15T10:00:00")
+	})
+	Attribute("date_modified", String, "When the survey was last modified in SurveyMonkey", func() {
+		Example("2025-02-01T08:30:00")
+	})
+
+	Required("id", "title")
+})
+
+// SurveyTemplateList represents a page of the survey template catalog
+var SurveyTemplateList = Type("SurveyTemplateList", func() {
+	Description("Page of survey templates ordered by title")
+
+	Attribute("templates", ArrayOf(SurveyTemplate), "Matching templates", func() {
+		Example([]interface{}{})
+	})
+	Attribute("total", Int, "Number of matching templates across all pages", func() {
+		Example(120)
+	})
+	Attribute("next_offset", Int, "Pass as offset to fetch the next page; absent on the last page", func() {
+		Example(50)
+	})
+
+	Required("templates", "total")
+})
+
+// DeadLetterList represents a page of dead-lettered KV events
+var DeadLetterList = Type("DeadLetterList", func() {
+	Description("Page of dead-lettered KV events in stream order")
+
+	Attribute("entries", ArrayOf(DeadLetterEntry), "Dead-lettered events", func() {
+		Example([]interface{}{})
+	})
+	Attribute("next_after", UInt64, "Pass as after to fetch the next page; absent on the last page", func() {
+		Example(42)
+	})
+
+	Required("entries")
+})
+
+// DeadLetterReplayResult represents the outcome of replaying a dead-lettered KV event
+var DeadLetterReplayResult = Type("DeadLetterReplayResult", func() {
+	Description("Outcome of replaying a dead-lettered KV event")
+
+	Attribute("sequence", UInt64, "Dead-letter stream sequence", func() {
+		Example(42)
+	})
+	Attribute("key", String, "Original v1-objects KV key", func() {
+		Example("itx-survey-responses.cba14f40-1636-11ec-9621-0242ac130002")
+	})
+	Attribute("outcome", String, "replayed: the key's current value, or the delete while the key is gone, was run through the handlers; superseded or key_deleted: the key has since been written again or deleted, so the event was dropped without a replay", func() {
+		Enum("replayed", "superseded", "key_deleted")
+		Example("replayed")
+	})
+	Attribute("succeeded", Boolean, "True when the event was resolved and removed from the dead-letter stream")
+	Attribute("error", String, "Why the replay failed; the entry is kept for another attempt", func() {
+		Example("handler requested a retry; the failure is still transient")
+	})
+
+	Required("sequence", "key", "outcome", "succeeded")
+})
+
+// InviteEnrichmentResult reports a manual re-run of the invite acceptance enrichment
+var InviteEnrichmentResult = Type("InviteEnrichmentResult", func() {
+	Description("Outcome of re-running the survey response enrichment for an accepted LFID invite")
+
+	Attribute("email", String, "Acceptor email", func() {
+		Example("jane@example.com")
+	})
+	Attribute("username", String, "LFX username written to the survey responses", func() {
+		Example("jdoe")
+	})
+	Attribute("dead_letters_cleared", UInt64, "Dead-lettered invite_accepted events of the email removed after the run", func() {
+		Example(1)
+	})
+
+	Required("email", "username", "dead_letters_cleared")
+})
+
+// ReindexStatus represents the progress of a reindex run
+var ReindexStatus = Type("ReindexStatus", func() {
+	Description("Progress of a reindex run")
+
+	Attribute("state", String, "Run state", func() {
+		Enum("running", "completed", "cancelled", "failed")
+		Example("running")
+	})
+	Attribute("dry_run", Boolean, "True when entries are only counted")
+	Attribute("force", Boolean, "True when unchanged entries are republished")
+	Attribute("key_prefixes", ArrayOf(String), "Key prefixes the run is limited to; absent when it covers every survey object type", func() {
+		Example([]string{"itx-survey-responses."})
+	})
+	Attribute("modified_since", String, "Lower bound on the entry write time (RFC3339)", func() {
+		Format(FormatDateTime)
+		Example("2026-01-01T00:00:00Z")
+	})
+	Attribute("modified_until", String, "Upper bound on the entry write time (RFC3339)", func() {
+		Format(FormatDateTime)
+		Example("2026-02-01T00:00:00Z")
+	})
+	Attribute("rate_per_second", Int, "Handler rate limit", func() {
+		Example(50)
+	})
+	Attribute("started_at", String, "When the run started (RFC3339)", func() {
+		Format(FormatDateTime)
+		Example("2026-01-15T10:30:00Z")
+	})
+	Attribute("finished_at", String, "When the run ended (RFC3339); absent while running", func() {
+		Format(FormatDateTime)
+		Example("2026-01-15T11:30:00Z")
+	})
+	Attribute("total", Int, "Keys under the selected prefixes", func() {
+		Example(12000)
+	})
+	Attribute("scanned", Int, "Keys read so far", func() {
+		Example(6000)
+	})
+	Attribute("matched", Int, "Scanned keys inside the time range", func() {
+		Example(5800)
+	})
+	Attribute("processed", Int, "Entries the handlers processed", func() {
+		Example(5790)
+	})
+	Attribute("failed", Int, "Entries that could not be read or processed", func() {
+		Example(10)
+	})
+	Attribute("failed_keys", ArrayOf(String), "First keys that failed (capped at 100)", func() {
+		Example([]string{"itx-survey-responses.cba14f40-1636-11ec-9621-0242ac130002"})
+	})
+	Attribute("error", String, "Why the run failed", func() {
+		Example("failed to list itx-surveys keys: context deadline exceeded")
+	})
+
+	Required("state", "dry_run", "force", "rate_per_second", "started_at", "total", "scanned", "matched", "processed", "failed")
+})
+
+// InviteBackfillStatus reports the progress of an LFID invite backfill
+var InviteBackfillStatus = Type("InviteBackfillStatus", func() {
+	Description("Progress of an LFID invite backfill run")
+
+	Attribute("state", String, "Run state", func() {
+		Enum("running", "completed", "cancelled", "failed")
+		Example("completed")
+	})
+	Attribute("dry_run", Boolean, "True when candidates are only reported")
+	Attribute("survey_uids", ArrayOf(String), "Surveys the run is limited to; absent when it covers every open survey", func() {
+		Example([]string{"b03cdbaf-53b1-4d47-bc04-dd7e459dd309"})
+	})
+	Attribute("rate_per_second", Int, "Candidate rate limit", func() {
+		Example(5)
+	})
+	Attribute("started_at", String, "When the run started (RFC3339)", func() {
+		Format(FormatDateTime)
+		Example("2026-01-15T10:30:00Z")
+	})
+	Attribute("finished_at", String, "When the run ended (RFC3339); absent while running", func() {
+		Format(FormatDateTime)
+		Example("2026-01-15T11:30:00Z")
+	})
+	Attribute("total", Int, "Survey responses listed", func() {
+		Example(12000)
+	})
+	Attribute("scanned", Int, "Survey responses read so far", func() {
+		Example(12000)
+	})
+	Attribute("candidates", Int, "Responses that need an invite", func() {
+		Example(340)
+	})
+	Attribute("recipients", Int, "Distinct emails among the candidates; at most this many invites are sent", func() {
+		Example(310)
+	})
+	Attribute("invited", Int, "Candidates sent a new invite", func() {
+		Example(305)
+	})
+	Attribute("attached", Int, "Candidates attached to an outstanding invite of their email", func() {
+		Example(33)
+	})
+	Attribute("failed", Int, "Candidates whose invite could not be sent", func() {
+		Example(2)
+	})
+	Attribute("skipped", MapOf(String, Int), "Responses passed over, by reason: no_email, has_username, already_invited, survey_not_open, no_survey_name, invites_disabled, not_synced, has_lfid or unreadable", func() {
+		Example(map[string]int{"has_username": 11000, "already_invited": 600, "survey_not_open": 60})
+	})
+	Attribute("candidate_response_uids", ArrayOf(String), "First candidates found (capped at 100)", func() {
+		Example([]string{"cba14f40-1636-11ec-9621-0242ac130002"})
+	})
+	Attribute("error", String, "Why the run failed", func() {
+		Example("failed to list survey responses: context deadline exceeded")
+	})
+
+	Required("state", "dry_run", "rate_per_second", "started_at", "total", "scanned", "candidates", "recipients", "invited", "attached", "failed", "skipped")
+})
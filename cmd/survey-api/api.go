@@ -98,11 +98,131 @@ func (api *SurveyAPI) ListSurveyResponses(ctx context.Context, p *survey.ListSur
 	return api.surveyService.ListSurveyResponses(ctx, p)
 }
 
+// CreateSurveyResponse implements survey.Service.CreateSurveyResponse
+func (api *SurveyAPI) CreateSurveyResponse(ctx context.Context, p *survey.CreateSurveyResponsePayload) error {
+	return api.surveyService.CreateSurveyResponse(ctx, p)
+}
+
+// UpdateSurveyResponse implements survey.Service.UpdateSurveyResponse
+func (api *SurveyAPI) UpdateSurveyResponse(ctx context.Context, p *survey.UpdateSurveyResponsePayload) error {
+	return api.surveyService.UpdateSurveyResponse(ctx, p)
+}
+
+// ListSurveyInvites implements survey.Service.ListSurveyInvites
+func (api *SurveyAPI) ListSurveyInvites(ctx context.Context, p *survey.ListSurveyInvitesPayload) (*survey.SurveyInviteList, error) {
+	return api.surveyService.ListSurveyInvites(ctx, p)
+}
+
+// GetSurveyInviteSettings implements survey.Service.GetSurveyInviteSettings
+func (api *SurveyAPI) GetSurveyInviteSettings(ctx context.Context, p *survey.GetSurveyInviteSettingsPayload) (*survey.InviteSettings, error) {
+	return api.surveyService.GetSurveyInviteSettings(ctx, p)
+}
+
+// UpdateSurveyInviteSettings implements survey.Service.UpdateSurveyInviteSettings
+func (api *SurveyAPI) UpdateSurveyInviteSettings(ctx context.Context, p *survey.UpdateSurveyInviteSettingsPayload) (*survey.InviteSettings, error) {
+	return api.surveyService.UpdateSurveyInviteSettings(ctx, p)
+}
+
+// DeleteSurveyInviteSettings implements survey.Service.DeleteSurveyInviteSettings
+func (api *SurveyAPI) DeleteSurveyInviteSettings(ctx context.Context, p *survey.DeleteSurveyInviteSettingsPayload) error {
+	return api.surveyService.DeleteSurveyInviteSettings(ctx, p)
+}
+
+// GetProjectInviteSettings implements survey.Service.GetProjectInviteSettings
+func (api *SurveyAPI) GetProjectInviteSettings(ctx context.Context, p *survey.GetProjectInviteSettingsPayload) (*survey.InviteSettings, error) {
+	return api.surveyService.GetProjectInviteSettings(ctx, p)
+}
+
+// UpdateProjectInviteSettings implements survey.Service.UpdateProjectInviteSettings
+func (api *SurveyAPI) UpdateProjectInviteSettings(ctx context.Context, p *survey.UpdateProjectInviteSettingsPayload) (*survey.InviteSettings, error) {
+	return api.surveyService.UpdateProjectInviteSettings(ctx, p)
+}
+
+// DeleteProjectInviteSettings implements survey.Service.DeleteProjectInviteSettings
+func (api *SurveyAPI) DeleteProjectInviteSettings(ctx context.Context, p *survey.DeleteProjectInviteSettingsPayload) error {
+	return api.surveyService.DeleteProjectInviteSettings(ctx, p)
+}
+
+// GetSurveySettings implements survey.Service.GetSurveySettings
+func (api *SurveyAPI) GetSurveySettings(ctx context.Context, p *survey.GetSurveySettingsPayload) (*survey.SurveySettings, error) {
+	return api.surveyService.GetSurveySettings(ctx, p)
+}
+
+// UpdateSurveySettings implements survey.Service.UpdateSurveySettings
+func (api *SurveyAPI) UpdateSurveySettings(ctx context.Context, p *survey.UpdateSurveySettingsPayload) (*survey.SurveySettings, error) {
+	return api.surveyService.UpdateSurveySettings(ctx, p)
+}
+
+// GetSurveyResults implements survey.Service.GetSurveyResults
+func (api *SurveyAPI) GetSurveyResults(ctx context.Context, p *survey.GetSurveyResultsPayload) (*survey.SurveyResults, error) {
+	return api.surveyService.GetSurveyResults(ctx, p)
+}
+
 // ValidateEmail implements survey.Service.ValidateEmail
 func (api *SurveyAPI) ValidateEmail(ctx context.Context, p *survey.ValidateEmailPayload) (*survey.ValidateEmailResult, error) {
 	return api.surveyService.ValidateEmail(ctx, p)
 }
 
+// ListSurveyTemplates implements survey.Service.ListSurveyTemplates
+func (api *SurveyAPI) ListSurveyTemplates(ctx context.Context, p *survey.ListSurveyTemplatesPayload) (*survey.SurveyTemplateList, error) {
+	return api.surveyService.ListSurveyTemplates(ctx, p)
+}
+
+// GetSurveyTemplate implements survey.Service.GetSurveyTemplate
+func (api *SurveyAPI) GetSurveyTemplate(ctx context.Context, p *survey.GetSurveyTemplatePayload) (*survey.SurveyTemplate, error) {
+	return api.surveyService.GetSurveyTemplate(ctx, p)
+}
+
+// ListDeadLetters implements survey.Service.ListDeadLetters
+func (api *SurveyAPI) ListDeadLetters(ctx context.Context, p *survey.ListDeadLettersPayload) (*survey.DeadLetterList, error) {
+	return api.surveyService.ListDeadLetters(ctx, p)
+}
+
+// GetDeadLetter implements survey.Service.GetDeadLetter
+func (api *SurveyAPI) GetDeadLetter(ctx context.Context, p *survey.GetDeadLetterPayload) (*survey.DeadLetterEntry, error) {
+	return api.surveyService.GetDeadLetter(ctx, p)
+}
+
+// ReplayDeadLetter implements survey.Service.ReplayDeadLetter
+func (api *SurveyAPI) ReplayDeadLetter(ctx context.Context, p *survey.ReplayDeadLetterPayload) (*survey.DeadLetterReplayResult, error) {
+	return api.surveyService.ReplayDeadLetter(ctx, p)
+}
+
+// StartReindex implements survey.Service.StartReindex
+func (api *SurveyAPI) StartReindex(ctx context.Context, p *survey.StartReindexPayload) (*survey.ReindexStatus, error) {
+	return api.surveyService.StartReindex(ctx, p)
+}
+
+// GetReindex implements survey.Service.GetReindex
+func (api *SurveyAPI) GetReindex(ctx context.Context, p *survey.GetReindexPayload) (*survey.ReindexStatus, error) {
+	return api.surveyService.GetReindex(ctx, p)
+}
+
+// CancelReindex implements survey.Service.CancelReindex
+func (api *SurveyAPI) CancelReindex(ctx context.Context, p *survey.CancelReindexPayload) (*survey.ReindexStatus, error) {
+	return api.surveyService.CancelReindex(ctx, p)
+}
+
+// RerunInviteEnrichment implements survey.Service.RerunInviteEnrichment
+func (api *SurveyAPI) RerunInviteEnrichment(ctx context.Context, p *survey.RerunInviteEnrichmentPayload) (*survey.InviteEnrichmentResult, error) {
+	return api.surveyService.RerunInviteEnrichment(ctx, p)
+}
+
+// StartInviteBackfill implements survey.Service.StartInviteBackfill
+func (api *SurveyAPI) StartInviteBackfill(ctx context.Context, p *survey.StartInviteBackfillPayload) (*survey.InviteBackfillStatus, error) {
+	return api.surveyService.StartInviteBackfill(ctx, p)
+}
+
+// GetInviteBackfill implements survey.Service.GetInviteBackfill
+func (api *SurveyAPI) GetInviteBackfill(ctx context.Context, p *survey.GetInviteBackfillPayload) (*survey.InviteBackfillStatus, error) {
+	return api.surveyService.GetInviteBackfill(ctx, p)
+}
+
+// CancelInviteBackfill implements survey.Service.CancelInviteBackfill
+func (api *SurveyAPI) CancelInviteBackfill(ctx context.Context, p *survey.CancelInviteBackfillPayload) (*survey.InviteBackfillStatus, error) {
+	return api.surveyService.CancelInviteBackfill(ctx, p)
+}
+
 // JWTAuth implements survey.Auther.JWTAuth
 // This is called by goa to validate JWT tokens before calling service methods
 func (api *SurveyAPI) JWTAuth(ctx context.Context, token string, scheme *security.JWTScheme) (context.Context, error) {
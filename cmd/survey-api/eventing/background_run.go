@@ -0,0 +1,136 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+)
+
+// backgroundRun is the lifecycle shared by the admin-triggered runs (reindex, invite
+// backfill): one run at a time, detached from the request that started it, with a
+// status that is kept after the run ends. S is the run's status type.
+type backgroundRun[S any] struct {
+	// name is used in error messages, e.g. "reindex"
+	name string
+	// clone copies a status so callers never share its slices and maps
+	clone func(s *S) *S
+
+	mu      sync.Mutex
+	status  *S
+	running bool
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+func newBackgroundRun[S any](name string, clone func(s *S) *S) *backgroundRun[S] {
+	return &backgroundRun[S]{name: name, clone: clone}
+}
+
+// start records status and runs fn in the background. The run outlives the request that
+// started it; it is stopped by cancelRun or stop. fn records its outcome on the status.
+func (r *backgroundRun[S]) start(ctx context.Context, status *S, fn func(ctx context.Context)) (*S, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.running {
+		return nil, domain.NewConflictError(fmt.Sprintf("%s %s is already running", article(r.name), r.name))
+	}
+
+	runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	r.status = status
+	r.running = true
+	r.cancel = cancel
+	r.done = make(chan struct{})
+
+	go func(done chan struct{}) {
+		defer close(done)
+		defer cancel()
+		fn(runCtx)
+
+		r.mu.Lock()
+		r.running = false
+		r.mu.Unlock()
+	}(r.done)
+
+	return r.clone(r.status), nil
+}
+
+// current returns the status of the current or most recent run
+func (r *backgroundRun[S]) current() (*S, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.status == nil {
+		return nil, domain.NewNotFoundError(fmt.Sprintf("no %s has been run", r.name))
+	}
+	return r.clone(r.status), nil
+}
+
+// cancelRun stops the running run and waits for it to wind down
+func (r *backgroundRun[S]) cancelRun(ctx context.Context) (*S, error) {
+	r.mu.Lock()
+	if !r.running {
+		r.mu.Unlock()
+		return nil, domain.NewConflictError(fmt.Sprintf("no %s is running", r.name))
+	}
+	r.cancel()
+	done := r.done
+	r.mu.Unlock()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return nil, domain.NewUnavailableError(fmt.Sprintf("timed out waiting for the %s to stop", r.name), ctx.Err())
+	}
+	return r.current()
+}
+
+// stop cancels any running run and waits for it, for use during shutdown
+func (r *backgroundRun[S]) stop() {
+	r.mu.Lock()
+	if r.cancel == nil {
+		r.mu.Unlock()
+		return
+	}
+	r.cancel()
+	done := r.done
+	r.mu.Unlock()
+	<-done
+}
+
+// snapshot returns a copy of the status of the active run; it is only called from the run
+func (r *backgroundRun[S]) snapshot() *S {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.clone(r.status)
+}
+
+// update applies fn to the status under the lock
+func (r *backgroundRun[S]) update(fn func(s *S)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	fn(r.status)
+}
+
+// finalState returns the state a run ends in, given the error it returned
+func finalState[T ~string](err error, completed, cancelled, failed T) T {
+	switch {
+	case err == nil:
+		return completed
+	case errors.Is(err, context.Canceled):
+		return cancelled
+	default:
+		return failed
+	}
+}
+
+func article(noun string) string {
+	if noun != "" && (noun[0] == 'a' || noun[0] == 'e' || noun[0] == 'i' || noun[0] == 'o' || noun[0] == 'u') {
+		return "an"
+	}
+	return "a"
+}
@@ -0,0 +1,56 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRunStatus struct {
+	State string
+}
+
+func TestBackgroundRun(t *testing.T) {
+	ctx := context.Background()
+	runs := newBackgroundRun("invite backfill", func(s *testRunStatus) *testRunStatus {
+		c := *s
+		return &c
+	})
+
+	_, err := runs.current()
+	assert.EqualError(t, err, "no invite backfill has been run")
+	_, err = runs.cancelRun(ctx)
+	assert.EqualError(t, err, "no invite backfill is running")
+
+	started := make(chan struct{})
+	status, err := runs.start(ctx, &testRunStatus{State: "running"}, func(ctx context.Context) {
+		close(started)
+		<-ctx.Done()
+		runs.update(func(s *testRunStatus) { s.State = finalState(ctx.Err(), "completed", "cancelled", "failed") })
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "running", status.State)
+	<-started
+
+	_, err = runs.start(ctx, &testRunStatus{}, func(context.Context) {})
+	assert.Equal(t, domain.ErrorTypeConflict, domain.GetErrorType(err))
+	assert.EqualError(t, err, "an invite backfill is already running")
+
+	status, err = runs.cancelRun(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "cancelled", status.State)
+
+	// A finished run can be followed by a new one.
+	_, err = runs.start(ctx, &testRunStatus{State: "running"}, func(context.Context) {})
+	require.NoError(t, err)
+	runs.stop()
+	status, err = runs.current()
+	require.NoError(t, err)
+	assert.Equal(t, "running", status.State, "the status is whatever the run recorded")
+}
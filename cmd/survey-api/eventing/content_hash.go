@@ -0,0 +1,113 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+// Object types reported on the publish metrics
+const (
+	objectTypeSurvey          = "survey"
+	objectTypeSurveyResponse  = "survey_response"
+	objectTypeSurveyTemplate  = "survey_template"
+	objectTypeSurveyExclusion = "survey_exclusion"
+)
+
+var meter = otel.Meter("github.com/linuxfoundation/lfx-v2-survey-service/cmd/survey-api/eventing")
+
+// publishCounters counts KV records that were republished versus skipped as unchanged,
+// and the republished records that were restored after a delete
+type publishCounters struct {
+	published metric.Int64Counter
+	skipped   metric.Int64Counter
+	restored  metric.Int64Counter
+}
+
+// kvPublishCounters creates the counters on first use; the handlers are plain functions
+// with no processor to hang them on. A failure falls back to no-op counters.
+var kvPublishCounters = sync.OnceValue(func() publishCounters {
+	published, err := meter.Int64Counter("eventing.kv.published",
+		metric.WithDescription("Converted v1 records published to the indexer and FGA-sync"),
+		metric.WithUnit("{record}"),
+	)
+	if err != nil {
+		published = noop.Int64Counter{}
+	}
+	skipped, err := meter.Int64Counter("eventing.kv.skipped_unchanged",
+		metric.WithDescription("v1 records not republished because their converted content was unchanged"),
+		metric.WithUnit("{record}"),
+	)
+	if err != nil {
+		skipped = noop.Int64Counter{}
+	}
+	restored, err := meter.Int64Counter("eventing.kv.restored",
+		metric.WithDescription("Deleted v1 records recreated after the delete was reversed"),
+		metric.WithUnit("{record}"),
+	)
+	if err != nil {
+		restored = noop.Int64Counter{}
+	}
+	return publishCounters{published: published, skipped: skipped, restored: restored}
+})
+
+func recordPublished(ctx context.Context, objectType string) {
+	kvPublishCounters().published.Add(ctx, 1, metric.WithAttributes(attribute.String("object_type", objectType)))
+}
+
+func recordRestored(ctx context.Context, objectType string) {
+	kvPublishCounters().restored.Add(ctx, 1, metric.WithAttributes(attribute.String("object_type", objectType)))
+}
+
+func recordSkippedUnchanged(ctx context.Context, objectType string) {
+	kvPublishCounters().skipped.Add(ctx, 1, metric.WithAttributes(attribute.String("object_type", objectType)))
+}
+
+// contentHash returns the SHA-256 hex digest of the JSON encoding of a converted record.
+// encoding/json emits struct fields in declaration order and sorts map keys, so equal
+// content always hashes the same. It is empty if v cannot be marshalled.
+func contentHash(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// mappingValue is what a live mapping key holds: the content hash, or the plain
+// synced marker when no hash is available
+func mappingValue(hash string) []byte {
+	if hash == "" {
+		return []byte(syncedMarker)
+	}
+	return []byte(hash)
+}
+
+// isUnchangedContent reports whether the stored mapping value already records this
+// content, in which case republishing can be skipped
+func isUnchangedContent(ctx context.Context, stored []byte, hash string) bool {
+	return hash != "" && !isForceRepublish(ctx) && string(stored) == hash
+}
+
+type forceRepublishKey struct{}
+
+// withForceRepublish makes the handlers publish even when the content hash matches
+func withForceRepublish(ctx context.Context) context.Context {
+	return context.WithValue(ctx, forceRepublishKey{}, true)
+}
+
+func isForceRepublish(ctx context.Context) bool {
+	force, _ := ctx.Value(forceRepublishKey{}).(bool)
+	return force
+}
@@ -0,0 +1,84 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/infrastructure/idmapper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentHash(t *testing.T) {
+	a := &domain.SurveyTemplateData{ID: "t-1", Title: "Survey", QuestionCount: 3}
+	b := &domain.SurveyTemplateData{ID: "t-1", Title: "Survey", QuestionCount: 3}
+	c := &domain.SurveyTemplateData{ID: "t-1", Title: "Survey", QuestionCount: 4}
+
+	assert.Len(t, contentHash(a), 64)
+	assert.Equal(t, contentHash(a), contentHash(b))
+	assert.NotEqual(t, contentHash(a), contentHash(c))
+
+	assert.Equal(t, []byte(syncedMarker), mappingValue(""))
+	assert.False(t, isUnchangedContent(context.Background(), []byte(syncedMarker), contentHash(a)), "legacy mappings are republished")
+	assert.False(t, isUnchangedContent(context.Background(), []byte(""), ""), "a missing hash never matches")
+	assert.False(t, isUnchangedContent(withForceRepublish(context.Background()), []byte(contentHash(a)), contentHash(a)))
+}
+
+func TestHandleSurveyTemplateUpdate_SkipsUnchangedContent(t *testing.T) {
+	_, mappings := setupEventBuckets(t)
+	ctx := context.Background()
+	publisher := &recordingPublisher{}
+	v1Data := map[string]any{"id": "t-1", "title": "Template", "question_count": 3}
+
+	require.False(t, handleSurveyTemplateUpdate(ctx, "surveymonkey-surveys.t-1", v1Data, publisher, mappings, slog.Default()))
+	require.False(t, handleSurveyTemplateUpdate(ctx, "surveymonkey-surveys.t-1", v1Data, publisher, mappings, slog.Default()))
+	assert.Len(t, publisher.events, 1, "identical content is published once")
+
+	entry, err := mappings.Get(ctx, "survey_template.t-1")
+	require.NoError(t, err)
+	assert.Len(t, string(entry.Value()), 64, "the mapping holds the content hash")
+
+	require.False(t, handleSurveyTemplateUpdate(withForceRepublish(ctx), "surveymonkey-surveys.t-1", v1Data, publisher, mappings, slog.Default()))
+	assert.Len(t, publisher.events, 2, "force republishes unchanged content")
+
+	v1Data["title"] = "Renamed"
+	require.False(t, handleSurveyTemplateUpdate(ctx, "surveymonkey-surveys.t-1", v1Data, publisher, mappings, slog.Default()))
+	require.Len(t, publisher.events, 3)
+	assert.Equal(t, "updated", publisher.events[2].action)
+}
+
+func TestHandleSurveyResponseUpdate_SkipsUnchangedContent(t *testing.T) {
+	objects, mappings := setupEventBuckets(t)
+	ctx := context.Background()
+	publisher := &recordingPublisher{}
+
+	putJSON(t, objects, "itx-surveys.s-1", map[string]any{"id": "s-1", "survey_title": "Q1"})
+	_, err := mappings.PutString(ctx, "survey.s-1", syncedMarker)
+	require.NoError(t, err)
+	v1Data := map[string]any{
+		"id":        "r-1",
+		"survey_id": "s-1",
+		"username":  "user",
+		"project":   map[string]any{"id": "p-1", "name": "Project"},
+	}
+
+	handle := func() {
+		t.Helper()
+		require.False(t, handleSurveyResponseUpdate(ctx, "itx-survey-responses.r-1", v1Data, publisher, idmapper.NewNoOpMapper(), mappings, objects, nil, slog.Default()))
+	}
+	handle()
+	handle()
+	assert.Len(t, publisher.responses(), 1)
+
+	// A change to the denormalized parent survey changes the response's content.
+	putJSON(t, objects, "itx-surveys.s-1", map[string]any{"id": "s-1", "survey_title": "Q2"})
+	handle()
+	responses := publisher.responses()
+	require.Len(t, responses, 2)
+	assert.Equal(t, "Q2", responses[1].SurveyTitle)
+}
@@ -0,0 +1,281 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	// DefaultDeadLetterStreamName is the JetStream stream holding dead-lettered KV events
+	DefaultDeadLetterStreamName = "SURVEY_SERVICE_KV_DEAD_LETTER"
+
+	// deadLetterSubjectPrefix is followed by the object type, e.g. "...kv-dead-letter.itx-survey-responses"
+	deadLetterSubjectPrefix = "lfx.survey-service.kv-dead-letter"
+
+	defaultDeadLetterListLimit = 50
+	maxDeadLetterListLimit     = 500
+)
+
+// deadLetterRecord is the JSON body of a dead-letter stream message
+type deadLetterRecord struct {
+	Key            string                  `json:"key"`
+	Operation      string                  `json:"operation"`
+	Revision       uint64                  `json:"revision,omitempty"`
+	Payload        []byte                  `json:"payload,omitempty"`
+	Headers        map[string][]string     `json:"headers,omitempty"`
+	Reason         domain.DeadLetterReason `json:"reason"`
+	Error          string                  `json:"error"`
+	Attempts       uint64                  `json:"attempts"`
+	DeadLetteredAt time.Time               `json:"dead_lettered_at"`
+}
+
+// failureRecorder carries the error behind a handler's decision to ACK an entry it could
+// not convert, so the caller can dead-letter the entry instead of dropping it
+type failureRecorder struct {
+	err error
+}
+
+type failureRecorderKey struct{}
+
+func withFailureRecorder(ctx context.Context) (context.Context, *failureRecorder) {
+	rec := &failureRecorder{}
+	return context.WithValue(ctx, failureRecorderKey{}, rec), rec
+}
+
+// withoutFailureRecorder detaches the caller's recorder, for handlers run on behalf of
+// another entry whose failures must not be attributed to the message being handled
+func withoutFailureRecorder(ctx context.Context) context.Context {
+	return context.WithValue(ctx, failureRecorderKey{}, (*failureRecorder)(nil))
+}
+
+// recordPermanentFailure notes that the entry being handled can never be processed as-is.
+// Handlers still return false (ACK); it is a no-op when no recorder is attached.
+func recordPermanentFailure(ctx context.Context, err error) {
+	if rec, ok := ctx.Value(failureRecorderKey{}).(*failureRecorder); ok && rec != nil && rec.err == nil {
+		rec.err = err
+	}
+}
+
+// DeadLetterQueue stores KV events that exhausted their retries or failed conversion,
+// and replays them through the KV handlers on request
+type DeadLetterQueue struct {
+	js          jetstream.JetStream
+	stream      jetstream.Stream
+	v1ObjectsKV jetstream.KeyValue
+	maxDeliver  int
+	handle      func(ctx context.Context, entry jetstream.KeyValueEntry) bool
+	logger      *slog.Logger
+}
+
+// newDeadLetterQueue creates or updates the dead-letter stream. handle is the KV handler
+// used for replays of the current v1ObjectsKV entries; maxDeliver matches the consumer so
+// the final attempt can be detected.
+func newDeadLetterQueue(
+	ctx context.Context,
+	js jetstream.JetStream,
+	v1ObjectsKV jetstream.KeyValue,
+	streamName string,
+	maxAge time.Duration,
+	maxDeliver int,
+	handle func(ctx context.Context, entry jetstream.KeyValueEntry) bool,
+	logger *slog.Logger,
+) (*DeadLetterQueue, error) {
+	if streamName == "" {
+		streamName = DefaultDeadLetterStreamName
+	}
+	stream, err := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:        streamName,
+		Description: "KV events the survey service could not process",
+		Subjects:    []string{deadLetterSubjectPrefix + ".>"},
+		Storage:     jetstream.FileStorage,
+		Retention:   jetstream.LimitsPolicy,
+		MaxAge:      maxAge,
+		Discard:     jetstream.DiscardOld,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create or update %s stream: %w", streamName, err)
+	}
+
+	return &DeadLetterQueue{
+		js:          js,
+		stream:      stream,
+		v1ObjectsKV: v1ObjectsKV,
+		maxDeliver:  maxDeliver,
+		handle:      handle,
+		logger:      logger,
+	}, nil
+}
+
+// isFinalDelivery reports whether a NAK on this attempt would exhaust MaxDeliver
+func (q *DeadLetterQueue) isFinalDelivery(numDelivered uint64) bool {
+	return q.maxDeliver > 0 && numDelivered >= uint64(q.maxDeliver)
+}
+
+// publish writes a dead-letter record to the stream
+func (q *DeadLetterQueue) publish(ctx context.Context, rec deadLetterRecord) error {
+	rec.DeadLetteredAt = time.Now().UTC()
+	body, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("failed to marshal dead-letter record: %w", err)
+	}
+	if _, err := q.js.Publish(ctx, deadLetterSubject(rec.Key), body); err != nil {
+		return fmt.Errorf("failed to publish dead-letter record: %w", err)
+	}
+	return nil
+}
+
+// deadLetterSubject files records under the object type so they can be filtered by subject
+func deadLetterSubject(key string) string {
+	prefix, _, _ := strings.Cut(key, ".")
+	if prefix == "" {
+		prefix = "unknown"
+	}
+	return deadLetterSubjectPrefix + "." + prefix
+}
+
+// List returns dead-lettered events in stream order
+func (q *DeadLetterQueue) List(ctx context.Context, filter domain.DeadLetterFilter) ([]domain.DeadLetter, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = defaultDeadLetterListLimit
+	}
+	limit = min(limit, maxDeadLetterListLimit)
+
+	subject := deadLetterSubjectPrefix + ".>"
+	if objectType, _, found := strings.Cut(filter.KeyPrefix, "."); found {
+		// The object type is part of the subject, so the server can skip other types.
+		subject = deadLetterSubjectPrefix + "." + objectType
+	}
+
+	entries := make([]domain.DeadLetter, 0, limit)
+	for seq := filter.AfterSequence + 1; len(entries) < limit; seq++ {
+		msg, err := q.stream.GetMsg(ctx, seq, jetstream.WithGetMsgSubject(subject))
+		if errors.Is(err, jetstream.ErrMsgNotFound) {
+			break
+		}
+		if err != nil {
+			return nil, domain.NewUnavailableError("failed to read dead-letter stream", err)
+		}
+		seq = msg.Sequence
+
+		entry, err := decodeDeadLetter(msg)
+		if err != nil {
+			q.logger.With(errKey, err, "sequence", msg.Sequence).WarnContext(ctx, "skipping unreadable dead-letter record")
+			continue
+		}
+		if strings.HasPrefix(entry.Key, filter.KeyPrefix) {
+			entries = append(entries, *entry)
+		}
+	}
+	return entries, nil
+}
+
+// Get returns a single dead-lettered event
+func (q *DeadLetterQueue) Get(ctx context.Context, sequence uint64) (*domain.DeadLetter, error) {
+	msg, err := q.stream.GetMsg(ctx, sequence)
+	if errors.Is(err, jetstream.ErrMsgNotFound) {
+		return nil, domain.NewNotFoundError(fmt.Sprintf("dead letter %d not found", sequence))
+	}
+	if err != nil {
+		return nil, domain.NewUnavailableError("failed to read dead-letter stream", err)
+	}
+	entry, err := decodeDeadLetter(msg)
+	if err != nil {
+		return nil, domain.NewInternalError(fmt.Sprintf("dead letter %d is unreadable", sequence), err)
+	}
+	return entry, nil
+}
+
+// Replay re-runs a dead-lettered event through the KV handlers using the key's current state
+// in v1-objects, since the dead-lettered payload may be stale. The event is dropped without
+// a replay when the key has since been deleted or written again: the consumer processes
+// those newer events. The entry is removed from the stream unless the handlers fail on it,
+// in which case it stays for a later attempt.
+func (q *DeadLetterQueue) Replay(ctx context.Context, sequence uint64) (*domain.DeadLetterReplayResult, error) {
+	dl, err := q.Get(ctx, sequence)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, outcome, err := q.currentEntry(ctx, dl)
+	if err != nil {
+		return nil, err
+	}
+	result := &domain.DeadLetterReplayResult{Sequence: sequence, Key: dl.Key, Outcome: outcome}
+
+	if entry != nil {
+		handlerCtx, rec := withFailureRecorder(ctx)
+		retry := q.handle(handlerCtx, entry)
+		switch {
+		case retry:
+			result.Error = "handler requested a retry; the failure is still transient"
+		case rec.err != nil:
+			result.Error = rec.err.Error()
+		}
+	}
+
+	if result.Error == "" {
+		if err := q.stream.DeleteMsg(ctx, sequence); err != nil {
+			// The event was resolved; a leftover entry only risks a redundant replay.
+			q.logger.With(errKey, err, "sequence", sequence).WarnContext(ctx, "failed to remove replayed dead letter")
+		}
+		result.Succeeded = true
+	}
+
+	q.logger.With("sequence", sequence, "key", dl.Key, "outcome", outcome, "succeeded", result.Succeeded).InfoContext(ctx, "replayed dead-lettered KV event")
+	return result, nil
+}
+
+// currentEntry returns the entry to replay for a dead letter: the current value of its key,
+// or the dead-lettered delete while the key is still gone. It returns nil with the reason
+// when the event has been superseded and must not be replayed.
+func (q *DeadLetterQueue) currentEntry(ctx context.Context, dl *domain.DeadLetter) (jetstream.KeyValueEntry, domain.DeadLetterReplayOutcome, error) {
+	operation := kvOperationFromHeader(dl.Operation)
+
+	current, err := q.v1ObjectsKV.Get(ctx, dl.Key)
+	switch {
+	case errors.Is(err, jetstream.ErrKeyNotFound):
+		if operation == jetstream.KeyValuePut {
+			return nil, domain.DeadLetterReplayKeyDeleted, nil
+		}
+		return &kvEntry{key: dl.Key, operation: operation, revision: dl.Revision}, domain.DeadLetterReplayed, nil
+	case err != nil:
+		return nil, "", domain.NewUnavailableError(fmt.Sprintf("failed to read %s from %s", dl.Key, V1ObjectsBucket), err)
+	}
+
+	// Records dead-lettered without a revision cannot be ordered, so the current value is replayed.
+	if dl.Revision != 0 && current.Revision() > dl.Revision {
+		return nil, domain.DeadLetterReplaySuperseded, nil
+	}
+	return current, domain.DeadLetterReplayed, nil
+}
+
+func decodeDeadLetter(msg *jetstream.RawStreamMsg) (*domain.DeadLetter, error) {
+	var rec deadLetterRecord
+	if err := json.Unmarshal(msg.Data, &rec); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal dead-letter record: %w", err)
+	}
+	return &domain.DeadLetter{
+		Sequence:       msg.Sequence,
+		Key:            rec.Key,
+		Operation:      rec.Operation,
+		Revision:       rec.Revision,
+		Payload:        rec.Payload,
+		Headers:        rec.Headers,
+		Reason:         rec.Reason,
+		Error:          rec.Error,
+		Attempts:       rec.Attempts,
+		DeadLetteredAt: rec.DeadLetteredAt,
+	}, nil
+}
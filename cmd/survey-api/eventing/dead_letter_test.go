@@ -0,0 +1,289 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	infraeventing "github.com/linuxfoundation/lfx-v2-survey-service/internal/infrastructure/eventing"
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupJetStream starts an in-process JetStream server
+func setupJetStream(t *testing.T) jetstream.JetStream {
+	t.Helper()
+
+	ns, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      -1,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+	})
+	require.NoError(t, err)
+	go ns.Start()
+	if !ns.ReadyForConnections(4 * time.Second) {
+		t.Fatal("NATS server not ready")
+	}
+
+	nc, err := nats.Connect(ns.ClientURL())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		nc.Close()
+		ns.Shutdown()
+	})
+
+	js, err := jetstream.New(nc)
+	require.NoError(t, err)
+	return js
+}
+
+func newTestDeadLetterQueue(t *testing.T, js jetstream.JetStream, handle func(context.Context, jetstream.KeyValueEntry) bool) *DeadLetterQueue {
+	t.Helper()
+	kv, err := js.CreateOrUpdateKeyValue(context.Background(), jetstream.KeyValueConfig{Bucket: V1ObjectsBucket})
+	require.NoError(t, err)
+	q, err := newDeadLetterQueue(context.Background(), js, kv, "", 0, 3, handle, slog.Default())
+	require.NoError(t, err)
+	return q
+}
+
+func TestDeadLetterQueue_ListAndGet(t *testing.T) {
+	js := setupJetStream(t)
+	q := newTestDeadLetterQueue(t, js, nil)
+	ctx := context.Background()
+
+	for _, key := range []string{"itx-surveys.s-1", "itx-survey-responses.r-1", "itx-survey-responses.r-2"} {
+		require.NoError(t, q.publish(ctx, deadLetterRecord{
+			Key:       key,
+			Operation: "PUT",
+			Payload:   []byte(`{"id":"x"}`),
+			Headers:   map[string][]string{"KV-Operation": {"PUT"}},
+			Reason:    domain.DeadLetterReasonMaxDeliveries,
+			Error:     "retries exhausted",
+			Attempts:  3,
+		}))
+	}
+
+	all, err := q.List(ctx, domain.DeadLetterFilter{})
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	assert.Equal(t, "itx-surveys.s-1", all[0].Key)
+
+	responses, err := q.List(ctx, domain.DeadLetterFilter{KeyPrefix: "itx-survey-responses."})
+	require.NoError(t, err)
+	require.Len(t, responses, 2)
+
+	page, err := q.List(ctx, domain.DeadLetterFilter{AfterSequence: responses[0].Sequence, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, "itx-survey-responses.r-2", page[0].Key)
+
+	got, err := q.Get(ctx, responses[1].Sequence)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(`{"id":"x"}`), got.Payload)
+	assert.Equal(t, []string{"PUT"}, got.Headers["KV-Operation"])
+	assert.Equal(t, uint64(3), got.Attempts)
+	assert.False(t, got.DeadLetteredAt.IsZero())
+
+	_, err = q.Get(ctx, 999)
+	assert.Equal(t, domain.ErrorTypeNotFound, domain.GetErrorType(err))
+}
+
+func TestDeadLetterQueue_Replay(t *testing.T) {
+	tests := []struct {
+		name          string
+		handle        func(context.Context, jetstream.KeyValueEntry) bool
+		wantSucceeded bool
+		wantError     string
+	}{
+		{
+			name:          "processed entry is removed",
+			handle:        func(context.Context, jetstream.KeyValueEntry) bool { return false },
+			wantSucceeded: true,
+		},
+		{
+			name:      "transient failure is kept",
+			handle:    func(context.Context, jetstream.KeyValueEntry) bool { return true },
+			wantError: "handler requested a retry; the failure is still transient",
+		},
+		{
+			name: "conversion failure is kept",
+			handle: func(ctx context.Context, _ jetstream.KeyValueEntry) bool {
+				recordPermanentFailure(ctx, errors.New("bad payload"))
+				return false
+			},
+			wantError: "bad payload",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			js := setupJetStream(t)
+			ctx := context.Background()
+
+			var replayed jetstream.KeyValueEntry
+			q := newTestDeadLetterQueue(t, js, func(ctx context.Context, entry jetstream.KeyValueEntry) bool {
+				replayed = entry
+				return tt.handle(ctx, entry)
+			})
+			require.NoError(t, q.publish(ctx, deadLetterRecord{
+				Key:       "itx-survey-responses.r-1",
+				Operation: "DEL",
+				Revision:  42,
+				Reason:    domain.DeadLetterReasonMaxDeliveries,
+			}))
+			entries, err := q.List(ctx, domain.DeadLetterFilter{})
+			require.NoError(t, err)
+			require.Len(t, entries, 1)
+			seq := entries[0].Sequence
+
+			result, err := q.Replay(ctx, seq)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSucceeded, result.Succeeded)
+			assert.Equal(t, tt.wantError, result.Error)
+			assert.Equal(t, domain.DeadLetterReplayed, result.Outcome, "the delete is replayed while the key is gone")
+			assert.Equal(t, "itx-survey-responses.r-1", replayed.Key())
+			assert.Equal(t, jetstream.KeyValueDelete, replayed.Operation())
+			assert.Equal(t, uint64(42), replayed.Revision(), "replays keep the source revision for deduplication")
+
+			_, err = q.Get(ctx, seq)
+			if tt.wantSucceeded {
+				assert.Equal(t, domain.ErrorTypeNotFound, domain.GetErrorType(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeadLetterQueue_ReplayReadsCurrentValue(t *testing.T) {
+	js := setupJetStream(t)
+	ctx := context.Background()
+
+	var replayed []jetstream.KeyValueEntry
+	q := newTestDeadLetterQueue(t, js, func(_ context.Context, entry jetstream.KeyValueEntry) bool {
+		replayed = append(replayed, entry)
+		return false
+	})
+	kv := q.v1ObjectsKV
+
+	deadLetter := func(key string, revision uint64) uint64 {
+		t.Helper()
+		require.NoError(t, q.publish(ctx, deadLetterRecord{
+			Key:       key,
+			Operation: "PUT",
+			Revision:  revision,
+			Payload:   []byte(`{"id":"stale"}`),
+			Reason:    domain.DeadLetterReasonMaxDeliveries,
+		}))
+		info, err := q.stream.Info(ctx)
+		require.NoError(t, err)
+		return info.State.LastSeq
+	}
+
+	// Unchanged since it was dead-lettered: the stored value is replayed
+	current, err := kv.Put(ctx, "itx-surveys.s-1", []byte(`{"id":"current"}`))
+	require.NoError(t, err)
+	result, err := q.Replay(ctx, deadLetter("itx-surveys.s-1", current))
+	require.NoError(t, err)
+	assert.Equal(t, domain.DeadLetterReplayed, result.Outcome)
+	assert.True(t, result.Succeeded)
+	require.Len(t, replayed, 1)
+	assert.Equal(t, []byte(`{"id":"current"}`), replayed[0].Value())
+	assert.Equal(t, current, replayed[0].Revision())
+
+	// Written again since: the consumer handles the newer revision
+	seq := deadLetter("itx-surveys.s-1", current)
+	_, err = kv.Put(ctx, "itx-surveys.s-1", []byte(`{"id":"newer"}`))
+	require.NoError(t, err)
+	result, err = q.Replay(ctx, seq)
+	require.NoError(t, err)
+	assert.Equal(t, domain.DeadLetterReplaySuperseded, result.Outcome)
+	assert.True(t, result.Succeeded)
+
+	// Deleted since: the update is dropped
+	seq = deadLetter("itx-surveys.s-2", 1)
+	result, err = q.Replay(ctx, seq)
+	require.NoError(t, err)
+	assert.Equal(t, domain.DeadLetterReplayKeyDeleted, result.Outcome)
+	assert.True(t, result.Succeeded)
+
+	assert.Len(t, replayed, 1, "superseded events are not replayed")
+	entries, err := q.List(ctx, domain.DeadLetterFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestKVMessageHandler_DeadLettersConversionErrors(t *testing.T) {
+	js := setupJetStream(t)
+	ctx := context.Background()
+	q := newTestDeadLetterQueue(t, js, nil)
+
+	kv, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{Bucket: V1ObjectsBucket})
+	require.NoError(t, err)
+	_, err = kv.Put(ctx, "itx-surveys.s-1", []byte{0xc1}) // neither JSON nor msgpack
+	require.NoError(t, err)
+
+	consumer, err := js.CreateOrUpdateConsumer(ctx, "KV_"+V1ObjectsBucket, jetstream.ConsumerConfig{
+		AckPolicy: jetstream.AckExplicitPolicy,
+	})
+	require.NoError(t, err)
+	msg, err := consumer.Next(jetstream.FetchMaxWait(2 * time.Second))
+	require.NoError(t, err)
+
+	handlers, err := newSurveyKVHandlerRegistry(kvHandlerDeps{logger: slog.Default()}, nil)
+	require.NoError(t, err)
+	kvMessageHandler(ctx, msg, handlers, q, slog.Default())
+
+	entries, err := q.List(ctx, domain.DeadLetterFilter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "itx-surveys.s-1", entries[0].Key)
+	assert.Equal(t, domain.DeadLetterReasonConversionError, entries[0].Reason)
+	assert.Equal(t, uint64(1), entries[0].Attempts)
+	assert.Equal(t, []byte{0xc1}, entries[0].Payload)
+	assert.NotZero(t, entries[0].Revision)
+}
+
+func TestKVMessageHandler_PublishesWithMsgID(t *testing.T) {
+	js := setupJetStream(t)
+	ctx := context.Background()
+
+	index, err := js.CreateStream(ctx, jetstream.StreamConfig{Name: "INDEX", Subjects: []string{"lfx.index.>"}})
+	require.NoError(t, err)
+	objects, err := js.CreateKeyValue(ctx, jetstream.KeyValueConfig{Bucket: V1ObjectsBucket})
+	require.NoError(t, err)
+	mappings, err := js.CreateKeyValue(ctx, jetstream.KeyValueConfig{Bucket: "v1-mappings"})
+	require.NoError(t, err)
+	revision, err := objects.Put(ctx, "surveymonkey-surveys.sm-1", []byte(`{"id":"sm-1","title":"Board NPS"}`))
+	require.NoError(t, err)
+
+	consumer, err := js.CreateOrUpdateConsumer(ctx, "KV_"+V1ObjectsBucket, jetstream.ConsumerConfig{
+		AckPolicy: jetstream.AckExplicitPolicy,
+	})
+	require.NoError(t, err)
+	msg, err := consumer.Next(jetstream.FetchMaxWait(2 * time.Second))
+	require.NoError(t, err)
+
+	handlers, err := newSurveyKVHandlerRegistry(kvHandlerDeps{
+		publisher:  infraeventing.NewJetStreamPublisher(js.Conn(), js, 0, slog.Default()),
+		mappingsKV: mappings,
+		logger:     slog.Default(),
+	}, nil)
+	require.NoError(t, err)
+	kvMessageHandler(ctx, msg, handlers, nil, slog.Default())
+
+	published, err := index.GetLastMsgForSubject(ctx, infraeventing.IndexSurveyTemplateSubject)
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("%s:sm-1:%d", infraeventing.IndexSurveyTemplateSubject, revision), published.Header.Get(jetstream.MsgIDHeader))
+}
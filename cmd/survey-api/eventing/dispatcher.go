@@ -0,0 +1,279 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"hash/fnv"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	// DefaultWorkers is the number of KV messages processed concurrently when unset
+	DefaultWorkers = 8
+
+	// dispatchQueueSize is the per-worker backlog before Dispatch NAKs new messages
+	dispatchQueueSize = 16
+
+	// dispatchBackoff is how long a message is deferred when its worker's queue is full
+	dispatchBackoff = 5 * time.Second
+)
+
+// kvDispatcher fans KV messages out to a fixed set of workers. Each message is routed to
+// a shard by its ordering key, and each shard is drained by a single worker, so messages
+// for the same object are handled in the order they were delivered.
+type kvDispatcher struct {
+	shards     []chan queuedMsg
+	handle     func(msg jetstream.Msg)
+	logger     *slog.Logger
+	wg         sync.WaitGroup
+	ackWait    time.Duration
+	maxDeliver int
+
+	mu     sync.Mutex
+	closed bool
+	// sending counts Dispatch calls between the closed check and the channel send
+	sending sync.WaitGroup
+	// inflight pins a key to the shard holding its queued or running messages, so a
+	// delete (which has no payload to derive the parent survey from) follows its update.
+	inflight map[string]*inflightKey
+	// deferred tracks the keys with a message NAKed for a full queue, so that message is
+	// dropped if a newer revision of its key was dispatched before it came back.
+	deferred map[string]*deferredKey
+}
+
+type inflightKey struct {
+	shard int
+	count int
+}
+
+type deferredKey struct {
+	// seqs are the stream sequences of the deferred messages
+	seqs map[uint64]struct{}
+	// newest is the newest stream sequence dispatched for the key since
+	newest uint64
+}
+
+// queuedMsg is a message waiting for its worker; stop ends the in-progress acks sent
+// for it while it waits
+type queuedMsg struct {
+	msg  jetstream.Msg
+	stop func()
+}
+
+// newKVDispatcher starts workers goroutines that run handle for each dispatched message.
+// Each worker queues up to queueSize messages and keeps them from being redelivered while
+// they wait; ackWait and maxDeliver match the consumer.
+func newKVDispatcher(workers, queueSize int, ackWait time.Duration, maxDeliver int, handle func(msg jetstream.Msg), logger *slog.Logger) *kvDispatcher {
+	if workers <= 0 {
+		workers = DefaultWorkers
+	}
+	d := &kvDispatcher{
+		shards:     make([]chan queuedMsg, workers),
+		handle:     handle,
+		logger:     logger,
+		ackWait:    ackWait,
+		maxDeliver: maxDeliver,
+		inflight:   make(map[string]*inflightKey),
+		deferred:   make(map[string]*deferredKey),
+	}
+	for i := range d.shards {
+		d.shards[i] = make(chan queuedMsg, queueSize)
+		d.wg.Go(func() { d.work(d.shards[i]) })
+	}
+	return d
+}
+
+// Dispatch queues a message on its shard. A queued message is kept in progress until its
+// worker picks it up. When the shard's queue is full the message is NAKed with a delay,
+// unless its key already has messages queued, which it must not overtake, or it is on
+// its final delivery, which a NAK would drop; Dispatch then waits for room. A deferred
+// message that comes back after a newer revision of its key is ACKed without handling.
+// Messages dispatched after Close are NAKed so they are redelivered.
+func (d *kvDispatcher) Dispatch(msg jetstream.Msg) {
+	key := kvKeyFromSubject(msg.Subject())
+	seq := streamSequence(msg)
+
+	d.mu.Lock()
+	if d.closed {
+		d.mu.Unlock()
+		if err := msg.Nak(); err != nil {
+			d.logger.With(errKey, err, "key", key).Warn("failed to NAK KV message received during shutdown")
+		}
+		return
+	}
+	if d.superseded(key, seq) {
+		d.mu.Unlock()
+		if err := msg.Ack(); err != nil {
+			d.logger.With(errKey, err, "key", key).Warn("failed to ACK superseded KV message")
+		} else {
+			d.logger.With("key", key, "sequence", seq).Debug("dropped deferred KV message superseded by a newer revision")
+		}
+		return
+	}
+	pin, pinned := d.inflight[key]
+	if !pinned {
+		pin = &inflightKey{shard: d.shardFor(dispatchOrderingKey(key, msg))}
+		d.inflight[key] = pin
+	}
+	pin.count++
+	shard := d.shards[pin.shard]
+	d.sending.Add(1)
+	d.mu.Unlock()
+
+	defer d.sending.Done()
+
+	if err := msg.InProgress(); err != nil {
+		d.logger.With(errKey, err, "key", key).Warn("failed to extend KV message ack deadline")
+	}
+	queued := queuedMsg{msg: msg, stop: heartbeat(msg, inProgressInterval(d.ackWait), d.logger)}
+	select {
+	case shard <- queued:
+		return
+	default:
+	}
+
+	if !pinned && !d.isFinalDelivery(msg) {
+		queued.stop()
+		d.release(key)
+		d.markDeferred(key, seq)
+		if err := msg.NakWithDelay(dispatchBackoff); err != nil {
+			d.logger.With(errKey, err, "key", key).Warn("failed to NAK KV message while its worker is busy")
+		} else {
+			d.logger.With("key", key).Debug("worker queue full, deferred KV message")
+		}
+		return
+	}
+	// The workers keep draining until Close, so a full queue only delays this send.
+	shard <- queued
+}
+
+// superseded records a dispatched message against the deferred messages of its key and
+// reports whether it is a deferred message whose key has a newer revision; callers hold d.mu
+func (d *kvDispatcher) superseded(key string, seq uint64) bool {
+	def, ok := d.deferred[key]
+	if !ok || seq == 0 {
+		return false
+	}
+	if _, wasDeferred := def.seqs[seq]; !wasDeferred {
+		def.newest = max(def.newest, seq)
+		return false
+	}
+	delete(def.seqs, seq)
+	if len(def.seqs) == 0 {
+		delete(d.deferred, key)
+	}
+	return def.newest > seq
+}
+
+// markDeferred records a message NAKed for a full queue
+func (d *kvDispatcher) markDeferred(key string, seq uint64) {
+	if seq == 0 {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	def, ok := d.deferred[key]
+	if !ok {
+		def = &deferredKey{seqs: make(map[uint64]struct{})}
+		d.deferred[key] = def
+	}
+	def.seqs[seq] = struct{}{}
+}
+
+// streamSequence returns the stream sequence of msg, or 0 without metadata
+func streamSequence(msg jetstream.Msg) uint64 {
+	metadata, err := msg.Metadata()
+	if err != nil {
+		return 0
+	}
+	return metadata.Sequence.Stream
+}
+
+// isFinalDelivery reports whether NAKing msg would exhaust MaxDeliver
+func (d *kvDispatcher) isFinalDelivery(msg jetstream.Msg) bool {
+	if d.maxDeliver <= 0 {
+		return false
+	}
+	metadata, err := msg.Metadata()
+	if err != nil {
+		// Without metadata a NAK might drop the message, so wait for the worker instead.
+		return true
+	}
+	return metadata.NumDelivered >= uint64(d.maxDeliver)
+}
+
+// Close stops accepting messages and waits for the workers to finish the queued ones.
+// Drain the consumer first; anything it dispatches afterwards is NAKed.
+func (d *kvDispatcher) Close() {
+	d.mu.Lock()
+	if d.closed {
+		d.mu.Unlock()
+		return
+	}
+	d.closed = true
+	d.mu.Unlock()
+
+	d.sending.Wait()
+	for _, shard := range d.shards {
+		close(shard)
+	}
+	d.wg.Wait()
+}
+
+func (d *kvDispatcher) work(queue <-chan queuedMsg) {
+	for queued := range queue {
+		queued.stop()
+		d.handle(queued.msg)
+		d.release(kvKeyFromSubject(queued.msg.Subject()))
+	}
+}
+
+// release unpins a key once its last in-flight message has been handled
+func (d *kvDispatcher) release(key string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if pin, ok := d.inflight[key]; ok {
+		pin.count--
+		if pin.count <= 0 {
+			delete(d.inflight, key)
+		}
+	}
+}
+
+func (d *kvDispatcher) shardFor(orderingKey string) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(orderingKey))
+	return int(h.Sum32() % uint32(len(d.shards)))
+}
+
+// dispatchOrderingKey returns the key whose messages must be handled in order. Responses
+// are grouped under their parent survey's key, so a survey and the responses that
+// follow it in a bulk sync are handled on the same worker, survey first.
+func dispatchOrderingKey(key string, msg jetstream.Msg) string {
+	if !strings.HasPrefix(key, "itx-survey-responses.") || kvOperationFromHeader(msg.Headers().Get("KV-Operation")) != jetstream.KeyValuePut {
+		return key
+	}
+	v1Data, err := decodeKVValue(msg.Data())
+	if err != nil {
+		return key
+	}
+	if surveyID, ok := v1Data["survey_id"].(string); ok && surveyID != "" {
+		return "itx-surveys." + surveyID
+	}
+	return key
+}
+
+// kvKeyFromSubject extracts the KV key from a $KV.{bucket}.{key} subject
+func kvKeyFromSubject(subject string) string {
+	prefix := "$KV." + V1ObjectsBucket + "."
+	if len(subject) > len(prefix) {
+		return subject[len(prefix):]
+	}
+	return ""
+}
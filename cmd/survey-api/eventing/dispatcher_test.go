@@ -0,0 +1,311 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeMsg is a jetstream.Msg carrying only what the dispatcher reads
+type fakeMsg struct {
+	jetstream.Msg
+	key    string
+	data   []byte
+	op     string
+	nakked atomic.Bool
+	acked  atomic.Bool
+	// inProgress counts the in-progress acks sent for the message
+	inProgress atomic.Int32
+	// delivered is the delivery attempt reported in the metadata
+	delivered uint64
+	// seq is the stream sequence reported in the metadata
+	seq      uint64
+	nakDelay time.Duration
+}
+
+func newFakeMsg(key, op string, data string) *fakeMsg {
+	return &fakeMsg{key: key, op: op, data: []byte(data)}
+}
+
+func (m *fakeMsg) Subject() string { return "$KV." + V1ObjectsBucket + "." + m.key }
+func (m *fakeMsg) Data() []byte    { return m.data }
+func (m *fakeMsg) Headers() nats.Header {
+	h := nats.Header{}
+	if m.op != "" {
+		h.Set("KV-Operation", m.op)
+	}
+	return h
+}
+func (m *fakeMsg) Nak() error {
+	m.nakked.Store(true)
+	return nil
+}
+func (m *fakeMsg) NakWithDelay(delay time.Duration) error {
+	m.nakDelay = delay
+	m.nakked.Store(true)
+	return nil
+}
+func (m *fakeMsg) Ack() error {
+	m.acked.Store(true)
+	return nil
+}
+func (m *fakeMsg) InProgress() error {
+	m.inProgress.Add(1)
+	return nil
+}
+func (m *fakeMsg) Metadata() (*jetstream.MsgMetadata, error) {
+	return &jetstream.MsgMetadata{NumDelivered: max(m.delivered, 1), Sequence: jetstream.SequencePair{Stream: m.seq}}, nil
+}
+
+func TestDispatchOrderingKey(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *fakeMsg
+		want string
+	}{
+		{"survey", newFakeMsg("itx-surveys.s-1", "", `{"id":"s-1"}`), "itx-surveys.s-1"},
+		{"response put groups under its survey", newFakeMsg("itx-survey-responses.r-1", "", `{"id":"r-1","survey_id":"s-1"}`), "itx-surveys.s-1"},
+		{"response delete", newFakeMsg("itx-survey-responses.r-1", "DEL", ""), "itx-survey-responses.r-1"},
+		{"response without survey", newFakeMsg("itx-survey-responses.r-1", "", `{"id":"r-1"}`), "itx-survey-responses.r-1"},
+		{"undecodable response", newFakeMsg("itx-survey-responses.r-1", "", "\xc1"), "itx-survey-responses.r-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, dispatchOrderingKey(tt.msg.key, tt.msg))
+		})
+	}
+}
+
+func TestKVDispatcher_PreservesPerSurveyOrder(t *testing.T) {
+	var mu sync.Mutex
+	handled := map[string][]string{}
+	d := newKVDispatcher(4, 64, time.Second, 3, func(msg jetstream.Msg) {
+		m := msg.(*fakeMsg)
+		time.Sleep(time.Duration(len(m.key)%3) * time.Millisecond)
+		mu.Lock()
+		defer mu.Unlock()
+		group := dispatchOrderingKey(m.key, m)
+		handled[group] = append(handled[group], m.key)
+	}, slog.Default())
+
+	want := map[string][]string{}
+	for s := range 8 {
+		survey := fmt.Sprintf("itx-surveys.s-%d", s)
+		d.Dispatch(newFakeMsg(survey, "", "{}"))
+		want[survey] = append(want[survey], survey)
+		for r := range 5 {
+			key := fmt.Sprintf("itx-survey-responses.s-%d-r-%d", s, r)
+			d.Dispatch(newFakeMsg(key, "", fmt.Sprintf(`{"survey_id":"s-%d"}`, s)))
+			want[survey] = append(want[survey], key)
+		}
+	}
+	d.Close()
+
+	assert.Equal(t, want, handled)
+	assert.Empty(t, d.inflight, "keys are unpinned once handled")
+}
+
+func TestKVDispatcher_DeleteFollowsInFlightUpdate(t *testing.T) {
+	release := make(chan struct{})
+	var mu sync.Mutex
+	var handled []string
+	d := newKVDispatcher(8, dispatchQueueSize, time.Second, 3, func(msg jetstream.Msg) {
+		m := msg.(*fakeMsg)
+		if m.op == "" {
+			<-release
+		}
+		mu.Lock()
+		handled = append(handled, m.key+":"+m.op)
+		mu.Unlock()
+	}, slog.Default())
+
+	d.Dispatch(newFakeMsg("itx-survey-responses.r-1", "", `{"survey_id":"s-1"}`))
+	d.Dispatch(newFakeMsg("itx-survey-responses.r-1", "DEL", ""))
+
+	// The delete waits behind the blocked update rather than running on its own shard.
+	time.Sleep(20 * time.Millisecond)
+	mu.Lock()
+	assert.Empty(t, handled)
+	mu.Unlock()
+
+	close(release)
+	d.Close()
+	assert.Equal(t, []string{"itx-survey-responses.r-1:", "itx-survey-responses.r-1:DEL"}, handled)
+}
+
+func TestKVDispatcher_CloseDrainsAndNaksLateMessages(t *testing.T) {
+	var count atomic.Int32
+	d := newKVDispatcher(2, 32, time.Second, 3, func(jetstream.Msg) {
+		time.Sleep(time.Millisecond)
+		count.Add(1)
+	}, slog.Default())
+
+	for i := range 20 {
+		d.Dispatch(newFakeMsg(fmt.Sprintf("itx-surveys.s-%d", i), "", "{}"))
+	}
+	d.Close()
+	require.Equal(t, int32(20), count.Load(), "queued messages are handled before Close returns")
+
+	late := newFakeMsg("itx-surveys.late", "", "{}")
+	d.Dispatch(late)
+	assert.True(t, late.nakked.Load())
+	assert.Equal(t, int32(20), count.Load())
+	d.Close() // idempotent
+}
+
+func TestKVDispatcher_KeepsQueuedMessagesInProgress(t *testing.T) {
+	release := make(chan struct{})
+	d := newKVDispatcher(1, dispatchQueueSize, 30*time.Millisecond, 3, func(msg jetstream.Msg) {
+		if msg.(*fakeMsg).key == "itx-surveys.s-blocking" {
+			<-release
+		}
+	}, slog.Default())
+
+	d.Dispatch(newFakeMsg("itx-surveys.s-blocking", "", "{}"))
+	queued := newFakeMsg("itx-surveys.s-queued", "", "{}")
+	d.Dispatch(queued)
+	assert.GreaterOrEqual(t, queued.inProgress.Load(), int32(1), "in progress on enqueue")
+	assert.Eventually(t, func() bool { return queued.inProgress.Load() >= 3 }, time.Second, 5*time.Millisecond, "in progress while queued")
+
+	close(release)
+	d.Close()
+	sent := queued.inProgress.Load()
+	time.Sleep(30 * time.Millisecond)
+	assert.Equal(t, sent, queued.inProgress.Load(), "no in-progress acks once handled")
+}
+
+func TestKVDispatcher_DefersMessagesWhenQueueIsFull(t *testing.T) {
+	release := make(chan struct{})
+	var handled atomic.Int32
+	d := newKVDispatcher(1, 1, time.Second, 3, func(msg jetstream.Msg) {
+		if msg.(*fakeMsg).key == "itx-surveys.s-blocking" {
+			<-release
+		}
+		handled.Add(1)
+	}, slog.Default())
+
+	d.Dispatch(newFakeMsg("itx-surveys.s-blocking", "", "{}"))
+	require.Eventually(t, func() bool { return len(d.shards[0]) == 0 }, time.Second, time.Millisecond, "the worker takes the first message")
+	d.Dispatch(newFakeMsg("itx-surveys.s-queued", "", "{}"))
+
+	deferred := newFakeMsg("itx-surveys.s-deferred", "", "{}")
+	d.Dispatch(deferred)
+	assert.True(t, deferred.nakked.Load(), "a message for a full queue is NAKed")
+	assert.Equal(t, dispatchBackoff, deferred.nakDelay)
+	d.mu.Lock()
+	assert.NotContains(t, d.inflight, "itx-surveys.s-deferred", "a deferred message does not pin its key")
+	d.mu.Unlock()
+
+	final := newFakeMsg("itx-surveys.s-final", "", "{}")
+	final.delivered = 3
+	dispatched := make(chan struct{})
+	go func() {
+		d.Dispatch(final)
+		close(dispatched)
+	}()
+	select {
+	case <-dispatched:
+		t.Fatal("a message on its final delivery must wait for room instead of being NAKed")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	<-dispatched
+	d.Close()
+	assert.False(t, final.nakked.Load())
+	assert.Equal(t, int32(3), handled.Load())
+}
+
+func TestKVDispatcher_KeepsRevisionOrderWhenQueueIsFull(t *testing.T) {
+	release := make(chan struct{})
+	var mu sync.Mutex
+	var order []uint64
+	d := newKVDispatcher(1, 1, time.Second, 3, func(msg jetstream.Msg) {
+		m := msg.(*fakeMsg)
+		if m.key == "itx-surveys.s-blocking" {
+			<-release
+			return
+		}
+		mu.Lock()
+		order = append(order, m.seq)
+		mu.Unlock()
+	}, slog.Default())
+
+	d.Dispatch(newFakeMsg("itx-surveys.s-blocking", "", "{}"))
+	require.Eventually(t, func() bool { return len(d.shards[0]) == 0 }, time.Second, time.Millisecond, "the worker takes the first message")
+
+	first := newFakeMsg("itx-surveys.s-1", "", "{}")
+	first.seq = 10
+	d.Dispatch(first)
+	second := newFakeMsg("itx-surveys.s-1", "", "{}")
+	second.seq = 11
+	dispatched := make(chan struct{})
+	go func() {
+		d.Dispatch(second)
+		close(dispatched)
+	}()
+	select {
+	case <-dispatched:
+		t.Fatal("a revision of a key with queued messages must wait for room instead of being NAKed")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	<-dispatched
+	d.Close()
+	assert.False(t, second.nakked.Load())
+	assert.Equal(t, []uint64{10, 11}, order)
+}
+
+func TestKVDispatcher_DropsDeferredMessageSupersededByNewerRevision(t *testing.T) {
+	release := make(chan struct{})
+	var mu sync.Mutex
+	var order []uint64
+	d := newKVDispatcher(1, 1, time.Second, 3, func(msg jetstream.Msg) {
+		m := msg.(*fakeMsg)
+		if m.key == "itx-surveys.s-blocking" {
+			<-release
+			return
+		}
+		mu.Lock()
+		order = append(order, m.seq)
+		mu.Unlock()
+	}, slog.Default())
+
+	d.Dispatch(newFakeMsg("itx-surveys.s-blocking", "", "{}"))
+	require.Eventually(t, func() bool { return len(d.shards[0]) == 0 }, time.Second, time.Millisecond, "the worker takes the first message")
+	d.Dispatch(newFakeMsg("itx-surveys.s-queued", "", "{}"))
+
+	older := newFakeMsg("itx-surveys.s-1", "", "{}")
+	older.seq = 10
+	d.Dispatch(older)
+	require.True(t, older.nakked.Load(), "the older revision is deferred")
+
+	close(release)
+	require.Eventually(t, func() bool { return len(d.shards[0]) == 0 }, time.Second, time.Millisecond)
+	newer := newFakeMsg("itx-surveys.s-1", "", "{}")
+	newer.seq = 11
+	d.Dispatch(newer)
+
+	// The deferred revision is redelivered after the newer one went through.
+	redelivered := newFakeMsg("itx-surveys.s-1", "", "{}")
+	redelivered.seq = 10
+	redelivered.delivered = 2
+	d.Dispatch(redelivered)
+	d.Close()
+
+	assert.True(t, redelivered.acked.Load(), "the superseded revision is ACKed")
+	assert.Equal(t, []uint64{0, 11}, order, "only the newer revision of s-1 is handled")
+	assert.Empty(t, d.deferred)
+}
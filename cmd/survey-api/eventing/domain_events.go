@@ -0,0 +1,290 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	indexerConstants "github.com/linuxfoundation/lfx-v2-indexer-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// Domain events are derived by comparing a record with a snapshot of the state fields
+// last seen for it, kept in v1-mappings. The v1-objects bucket does not keep enough
+// history to diff against, and the snapshot makes replays and redeliveries idempotent.
+
+// surveyState is the part of a survey that domain events are derived from
+type surveyState struct {
+	// Status is the survey's lifecycle state: scheduled, open or closed
+	Status string `json:"status"`
+}
+
+// Lifecycle states of a survey, as reported by its domain events
+const (
+	surveyLifecycleScheduled = "scheduled"
+	surveyLifecycleOpen      = "open"
+	surveyLifecycleClosed    = "closed"
+)
+
+// surveyResponseState is the part of a survey response that domain events are derived from
+type surveyResponseState struct {
+	Delivered bool `json:"delivered"`
+	Opened    bool `json:"opened"`
+	Submitted bool `json:"submitted"`
+	Bounced   bool `json:"bounced"`
+}
+
+func surveyStateKey(uid string) string {
+	return fmt.Sprintf("survey_state.%s", uid)
+}
+
+func surveyResponseStateKey(uid string) string {
+	return fmt.Sprintf("survey_response_state.%s", uid)
+}
+
+func surveyStateOf(s *domain.SurveyData, now time.Time) surveyState {
+	status := surveyLifecycle(s.SurveyStatus)
+	if status == surveyLifecycleOpen && s.SurveyCutoffDate != "" {
+		if cutoff, err := time.Parse(time.RFC3339, s.SurveyCutoffDate); err == nil && !cutoff.After(now) {
+			status = surveyLifecycleClosed
+		}
+	}
+	return surveyState{Status: status}
+}
+
+// surveyLifecycle maps a v1 survey_status (scheduled, sending, sent, cancelled) to the
+// lifecycle state. A survey opens for responses once it starts sending. Lifecycle states
+// map to themselves, as do the raw statuses older snapshots were stored with.
+func surveyLifecycle(status string) string {
+	switch strings.ToLower(status) {
+	case "scheduled":
+		return surveyLifecycleScheduled
+	case "sending", "sent", surveyLifecycleOpen:
+		return surveyLifecycleOpen
+	case "cancelled", surveyLifecycleClosed:
+		return surveyLifecycleClosed
+	}
+	return ""
+}
+
+func surveyResponseStateOf(r *domain.SurveyResponseData) surveyResponseState {
+	return surveyResponseState{
+		Delivered: r.SESDeliverySuccessful,
+		Opened:    r.EmailOpenedFirstTime != "",
+		Submitted: r.ResponseDatetime != "",
+		Bounced:   r.SESBounceType != "",
+	}
+}
+
+// surveyTransitions returns the event types for a survey moving from prev to cur
+func surveyTransitions(prev, cur surveyState) []string {
+	if surveyLifecycle(prev.Status) == cur.Status {
+		return nil
+	}
+	switch cur.Status {
+	case surveyLifecycleScheduled:
+		return []string{domain.DomainEventSurveyScheduled}
+	case surveyLifecycleOpen:
+		return []string{domain.DomainEventSurveyOpened}
+	case surveyLifecycleClosed:
+		return []string{domain.DomainEventSurveyClosed}
+	}
+	return nil
+}
+
+// surveyResponseTransitions returns the event types for a response moving from prev to
+// cur, in the order the steps happen. Each step is reported once.
+func surveyResponseTransitions(prev, cur surveyResponseState) []string {
+	var types []string
+	if cur.Delivered && !prev.Delivered {
+		types = append(types, domain.DomainEventSurveyResponseDelivered)
+	}
+	if cur.Bounced && !prev.Bounced {
+		types = append(types, domain.DomainEventSurveyResponseBounced)
+	}
+	if cur.Opened && !prev.Opened {
+		types = append(types, domain.DomainEventSurveyResponseOpened)
+	}
+	if cur.Submitted && !prev.Submitted {
+		types = append(types, domain.DomainEventSurveyResponseSubmitted)
+	}
+	return types
+}
+
+// publishSurveyDomainEvents publishes the transitions of a survey that was just indexed.
+// Returns true if the message should be retried (NAK).
+func publishSurveyDomainEvents(
+	ctx context.Context,
+	survey *domain.SurveyData,
+	hash string,
+	action indexerConstants.MessageAction,
+	publisher domain.EventPublisher,
+	mappingsKV jetstream.KeyValue,
+	logger *slog.Logger,
+) bool {
+	cur := surveyStateOf(survey, time.Now())
+	return publishStateTransitions(ctx, surveyStateKey(survey.UID), cur, action, surveyTransitions,
+		func(eventType string, prev surveyState) *domain.DomainEvent {
+			return newDomainEvent(eventType, objectTypeSurvey, survey.UID, hash, surveyEventData(survey, prev, cur))
+		}, publisher, mappingsKV, logger)
+}
+
+// publishSurveyResponseDomainEvents publishes the transitions of a survey response that
+// was just indexed. Returns true if the message should be retried (NAK).
+func publishSurveyResponseDomainEvents(
+	ctx context.Context,
+	response *domain.SurveyResponseData,
+	hash string,
+	action indexerConstants.MessageAction,
+	publisher domain.EventPublisher,
+	mappingsKV jetstream.KeyValue,
+	logger *slog.Logger,
+) bool {
+	return publishStateTransitions(ctx, surveyResponseStateKey(response.UID), surveyResponseStateOf(response), action, surveyResponseTransitions,
+		func(eventType string, _ surveyResponseState) *domain.DomainEvent {
+			return newDomainEvent(eventType, objectTypeSurveyResponse, response.UID, hash, surveyResponseEventData(response))
+		}, publisher, mappingsKV, logger)
+}
+
+// publishStateTransitions diffs cur against the stored snapshot, publishes an event per
+// transition and then stores cur. A record that was synced before snapshots existed has
+// no snapshot but an updated action; it is seeded without events, so turning this on
+// does not replay the history of every existing record. Returns true to NAK.
+func publishStateTransitions[S comparable](
+	ctx context.Context,
+	key string,
+	cur S,
+	action indexerConstants.MessageAction,
+	transitions func(prev, cur S) []string,
+	newEvent func(eventType string, prev S) *domain.DomainEvent,
+	publisher domain.EventPublisher,
+	mappingsKV jetstream.KeyValue,
+	logger *slog.Logger,
+) bool {
+	funcLogger := logger.With("state_key", key)
+
+	prev, found, err := loadStateSnapshot[S](ctx, mappingsKV, key)
+	if err != nil {
+		funcLogger.With(errKey, err).WarnContext(ctx, "failed to read state snapshot for domain events")
+		return isTransientError(err)
+	}
+	if found && prev == cur {
+		return false
+	}
+
+	if found || action == indexerConstants.ActionCreated {
+		for _, eventType := range transitions(prev, cur) {
+			event := newEvent(eventType, prev)
+			if err := publisher.PublishDomainEvent(ctx, event); err != nil {
+				funcLogger.With(errKey, err, "event_type", eventType).ErrorContext(ctx, "failed to publish domain event")
+				if isTransientError(err) {
+					// Nothing is stored, so the retry derives the same events, with the same IDs.
+					return true
+				}
+				continue
+			}
+			funcLogger.With("event_type", eventType, "event_id", event.ID).DebugContext(ctx, "published domain event")
+		}
+	}
+
+	data, err := json.Marshal(cur)
+	if err != nil {
+		funcLogger.With(errKey, err).WarnContext(ctx, "failed to marshal state snapshot")
+		return false
+	}
+	if _, err := mappingsKV.Put(ctx, key, data); err != nil {
+		funcLogger.With(errKey, err).WarnContext(ctx, "failed to store state snapshot")
+	}
+	return false
+}
+
+// loadStateSnapshot reads a stored snapshot. An unreadable snapshot counts as missing.
+func loadStateSnapshot[S any](ctx context.Context, mappingsKV jetstream.KeyValue, key string) (S, bool, error) {
+	var state S
+	entry, err := mappingsKV.Get(ctx, key)
+	if errors.Is(err, jetstream.ErrKeyNotFound) || errors.Is(err, jetstream.ErrKeyDeleted) {
+		return state, false, nil
+	}
+	if err != nil {
+		return state, false, err
+	}
+	if err := json.Unmarshal(entry.Value(), &state); err != nil {
+		return *new(S), false, nil
+	}
+	return state, true, nil
+}
+
+// domainEventNamespace scopes the name-based UUIDs used as event IDs
+var domainEventNamespace = uuid.NewSHA1(uuid.NameSpaceURL, []byte("https://lfx.linuxfoundation.org/survey/domain-events"))
+
+// newDomainEvent builds an event whose ID is derived from the object, the event type and
+// the content hash of the record that produced it, so a redelivered record republishes
+// the same ID and consumers can drop the duplicate.
+func newDomainEvent(eventType, objectType, uid, hash string, data any) *domain.DomainEvent {
+	return &domain.DomainEvent{
+		ID:         uuid.NewSHA1(domainEventNamespace, []byte(objectType+"/"+uid+"/"+eventType+"/"+hash)).String(),
+		Type:       eventType,
+		Version:    domain.DomainEventVersion,
+		OccurredAt: time.Now().UTC().Format(time.RFC3339),
+		ObjectType: objectType,
+		ObjectUID:  uid,
+		Data:       data,
+	}
+}
+
+func surveyEventData(s *domain.SurveyData, prev, cur surveyState) *domain.SurveyEventData {
+	data := &domain.SurveyEventData{
+		SurveyUID:        s.UID,
+		SurveyTitle:      s.SurveyTitle,
+		PreviousStatus:   surveyLifecycle(prev.Status),
+		Status:           cur.Status,
+		SurveyStatus:     s.SurveyStatus,
+		SurveySendDate:   s.SurveySendDate,
+		SurveyCutoffDate: s.SurveyCutoffDate,
+		IsNPSSurvey:      s.IsNPSSurvey,
+		CommitteeUIDs:    []string{},
+		ProjectUIDs:      []string{},
+	}
+	for _, c := range s.Committees {
+		if c.CommitteeUID != "" && !slices.Contains(data.CommitteeUIDs, c.CommitteeUID) {
+			data.CommitteeUIDs = append(data.CommitteeUIDs, c.CommitteeUID)
+		}
+		if c.ProjectUID != "" && !slices.Contains(data.ProjectUIDs, c.ProjectUID) {
+			data.ProjectUIDs = append(data.ProjectUIDs, c.ProjectUID)
+		}
+	}
+	return data
+}
+
+// surveyResponseEventData builds the payload of a response's domain events. Responses of an
+// anonymous survey leave out the respondent's identity.
+func surveyResponseEventData(r *domain.SurveyResponseData) *domain.SurveyResponseEventData {
+	data := &domain.SurveyResponseEventData{
+		SurveyResponseUID:    r.UID,
+		SurveyUID:            r.SurveyUID,
+		Email:                r.Email,
+		Username:             r.Username,
+		CommitteeUID:         r.CommitteeUID,
+		ProjectUID:           r.Project.ProjectUID,
+		ResponseDatetime:     r.ResponseDatetime,
+		EmailOpenedFirstTime: r.EmailOpenedFirstTime,
+		SESBounceType:        r.SESBounceType,
+		SESBounceSubtype:     r.SESBounceSubtype,
+		Anonymous:            r.Anonymous,
+	}
+	if r.Anonymous {
+		data.Email = ""
+		data.Username = ""
+	}
+	return data
+}
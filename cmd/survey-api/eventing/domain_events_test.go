@@ -0,0 +1,184 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	indexerConstants "github.com/linuxfoundation/lfx-v2-indexer-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// domainEvents returns the recorded domain events
+func (p *recordingPublisher) domainEvents() []*domain.DomainEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var out []*domain.DomainEvent
+	for _, e := range p.events {
+		if e.domain != nil {
+			out = append(out, e.domain)
+		}
+	}
+	return out
+}
+
+func eventTypes(events []*domain.DomainEvent) []string {
+	var types []string
+	for _, e := range events {
+		types = append(types, e.Type)
+	}
+	return types
+}
+
+func TestSurveyStateOf(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		status, cutoff string
+		want           string
+	}{
+		{status: "scheduled", want: "scheduled"},
+		{status: "sending", want: "open"},
+		{status: "sent", want: "open"},
+		{status: "sent", cutoff: "2025-06-30T00:00:00Z", want: "open"},
+		{status: "sent", cutoff: "2025-05-01T00:00:00Z", want: "closed"},
+		{status: "cancelled", want: "closed"},
+		{status: "unknown", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status+"/"+tt.cutoff, func(t *testing.T) {
+			got := surveyStateOf(&domain.SurveyData{SurveyStatus: tt.status, SurveyCutoffDate: tt.cutoff}, now)
+			assert.Equal(t, tt.want, got.Status)
+		})
+	}
+}
+
+func TestSurveyTransitions(t *testing.T) {
+	tests := []struct {
+		prev, cur string
+		want      []string
+	}{
+		{prev: "", cur: "scheduled", want: []string{domain.DomainEventSurveyScheduled}},
+		{prev: "scheduled", cur: "open", want: []string{domain.DomainEventSurveyOpened}},
+		{prev: "open", cur: "closed", want: []string{domain.DomainEventSurveyClosed}},
+		{prev: "open", cur: "open"},
+		{prev: "closed", cur: ""},
+		// Snapshots written before lifecycle states were derived hold the raw v1 status
+		{prev: "sending", cur: "open"},
+		{prev: "sent", cur: "closed", want: []string{domain.DomainEventSurveyClosed}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.prev+"->"+tt.cur, func(t *testing.T) {
+			assert.Equal(t, tt.want, surveyTransitions(surveyState{Status: tt.prev}, surveyState{Status: tt.cur}))
+		})
+	}
+}
+
+func TestSurveyResponseTransitions(t *testing.T) {
+	got := surveyResponseTransitions(surveyResponseState{}, surveyResponseState{Delivered: true, Opened: true, Submitted: true})
+	assert.Equal(t, []string{
+		domain.DomainEventSurveyResponseDelivered,
+		domain.DomainEventSurveyResponseOpened,
+		domain.DomainEventSurveyResponseSubmitted,
+	}, got)
+
+	got = surveyResponseTransitions(surveyResponseState{Delivered: true}, surveyResponseState{Delivered: true, Bounced: true})
+	assert.Equal(t, []string{domain.DomainEventSurveyResponseBounced}, got)
+
+	assert.Empty(t, surveyResponseTransitions(surveyResponseState{Opened: true}, surveyResponseState{Opened: true}))
+}
+
+func TestPublishSurveyDomainEvents(t *testing.T) {
+	_, mappings := setupEventBuckets(t)
+	ctx := context.Background()
+	publisher := &recordingPublisher{}
+	survey := &domain.SurveyData{
+		UID:          "s-1",
+		SurveyStatus: "scheduled",
+		Committees: []domain.SurveyCommitteeData{
+			{CommitteeUID: "c-1", ProjectUID: "p-1"},
+			{CommitteeUID: "c-2", ProjectUID: "p-1"},
+		},
+	}
+
+	// A new survey reports its initial state
+	require.False(t, publishSurveyDomainEvents(ctx, survey, "h1", indexerConstants.ActionCreated, publisher, mappings, slog.Default()))
+	events := publisher.domainEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, domain.DomainEventSurveyScheduled, events[0].Type)
+	assert.Equal(t, domain.DomainEventVersion, events[0].Version)
+	data := events[0].Data.(*domain.SurveyEventData)
+	assert.Equal(t, []string{"c-1", "c-2"}, data.CommitteeUIDs)
+	assert.Equal(t, []string{"p-1"}, data.ProjectUIDs)
+
+	// A redelivery of the same state publishes nothing
+	require.False(t, publishSurveyDomainEvents(ctx, survey, "h1", indexerConstants.ActionUpdated, publisher, mappings, slog.Default()))
+	assert.Len(t, publisher.domainEvents(), 1)
+
+	survey.SurveyStatus = "sending"
+	require.False(t, publishSurveyDomainEvents(ctx, survey, "h2", indexerConstants.ActionUpdated, publisher, mappings, slog.Default()))
+	events = publisher.domainEvents()
+	require.Len(t, events, 2)
+	assert.Equal(t, domain.DomainEventSurveyOpened, events[1].Type)
+	data = events[1].Data.(*domain.SurveyEventData)
+	assert.Equal(t, "scheduled", data.PreviousStatus)
+	assert.Equal(t, "open", data.Status)
+	assert.Equal(t, "sending", data.SurveyStatus)
+	assert.NotEqual(t, events[0].ID, events[1].ID)
+
+	// Finishing the send keeps the survey open
+	survey.SurveyStatus = "sent"
+	require.False(t, publishSurveyDomainEvents(ctx, survey, "h3", indexerConstants.ActionUpdated, publisher, mappings, slog.Default()))
+	assert.Len(t, publisher.domainEvents(), 2)
+
+	survey.SurveyStatus = "cancelled"
+	require.False(t, publishSurveyDomainEvents(ctx, survey, "h4", indexerConstants.ActionUpdated, publisher, mappings, slog.Default()))
+	events = publisher.domainEvents()
+	require.Len(t, events, 3)
+	assert.Equal(t, domain.DomainEventSurveyClosed, events[2].Type)
+	assert.Equal(t, "open", events[2].Data.(*domain.SurveyEventData).PreviousStatus)
+}
+
+func TestPublishSurveyResponseDomainEvents_SeedsExistingRecords(t *testing.T) {
+	_, mappings := setupEventBuckets(t)
+	ctx := context.Background()
+	publisher := &recordingPublisher{}
+	response := &domain.SurveyResponseData{UID: "r-1", SurveyUID: "s-1", SESDeliverySuccessful: true}
+
+	// A response synced before snapshots existed is seeded without events
+	require.False(t, publishSurveyResponseDomainEvents(ctx, response, "h1", indexerConstants.ActionUpdated, publisher, mappings, slog.Default()))
+	assert.Empty(t, publisher.domainEvents())
+
+	response.ResponseDatetime = "2025-01-02T03:04:05Z"
+	require.False(t, publishSurveyResponseDomainEvents(ctx, response, "h2", indexerConstants.ActionUpdated, publisher, mappings, slog.Default()))
+	assert.Equal(t, []string{domain.DomainEventSurveyResponseSubmitted}, eventTypes(publisher.domainEvents()))
+}
+
+func TestSurveyResponseEventData_Anonymous(t *testing.T) {
+	response := &domain.SurveyResponseData{UID: "r-1", SurveyUID: "s-1", Email: "jane@example.com", Username: "jdoe"}
+
+	data := surveyResponseEventData(response)
+	assert.Equal(t, "jane@example.com", data.Email)
+	assert.Equal(t, "jdoe", data.Username)
+	assert.False(t, data.Anonymous)
+
+	response.Anonymous = true
+	data = surveyResponseEventData(response)
+	assert.Empty(t, data.Email)
+	assert.Empty(t, data.Username)
+	assert.True(t, data.Anonymous)
+	assert.Equal(t, "r-1", data.SurveyResponseUID)
+}
+
+func TestNewDomainEvent_IDIsStablePerRecord(t *testing.T) {
+	a := newDomainEvent(domain.DomainEventSurveyOpened, objectTypeSurvey, "s-1", "h1", nil)
+	b := newDomainEvent(domain.DomainEventSurveyOpened, objectTypeSurvey, "s-1", "h1", nil)
+	c := newDomainEvent(domain.DomainEventSurveyOpened, objectTypeSurvey, "s-1", "h2", nil)
+	assert.Equal(t, a.ID, b.ID)
+	assert.NotEqual(t, a.ID, c.ID)
+}
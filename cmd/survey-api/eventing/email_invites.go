@@ -0,0 +1,311 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	// emailInvitePrefix keys the outstanding LFID invite of each recipient in the mappings
+	// bucket: email_invite.{hashed email} = JSON emailInvite. Responses of the same email
+	// attach to the outstanding invite instead of sending another one.
+	emailInvitePrefix = "email_invite"
+
+	// emailInviteClaimTimeout is how long a claim without an invite UID holds back other
+	// sends for the email; after it the send is assumed to have been interrupted
+	emailInviteClaimTimeout = 5 * time.Minute
+
+	// emailInviteUpdateAttempts bounds the revision-conflict retries on the email marker
+	emailInviteUpdateAttempts = 3
+)
+
+// inviteRef identifies the survey response an invite record belongs to
+type inviteRef struct {
+	SurveyUID         string `json:"survey_uid"`
+	SurveyResponseUID string `json:"survey_response_uid"`
+}
+
+func surveyInviteRef(invite *domain.SurveyInvite) inviteRef {
+	return inviteRef{SurveyUID: invite.SurveyUID, SurveyResponseUID: invite.SurveyResponseUID}
+}
+
+// emailInvite is the invite outstanding for an email. Owner is the response the invite
+// was sent for; Linked are the responses attached to it.
+type emailInvite struct {
+	Owner inviteRef `json:"owner"`
+	// InviteUID is empty while the send for Owner is in flight
+	InviteUID string      `json:"invite_uid,omitempty"`
+	ExpiresAt *time.Time  `json:"expires_at,omitempty"`
+	ClaimedAt time.Time   `json:"claimed_at"`
+	Linked    []inviteRef `json:"linked,omitempty"`
+}
+
+// outstanding reports whether new responses of the email should attach to this invite
+func (e *emailInvite) outstanding(now time.Time) bool {
+	switch {
+	case e.InviteUID == "":
+		return now.Before(e.ClaimedAt.Add(emailInviteClaimTimeout))
+	case e.ExpiresAt != nil:
+		return now.Before(*e.ExpiresAt)
+	default:
+		return now.Before(e.ClaimedAt.Add(inviteExpirationDays * 24 * time.Hour))
+	}
+}
+
+// refs returns the owner and linked responses of the invite
+func (e *emailInvite) refs() []inviteRef {
+	return append([]inviteRef{e.Owner}, e.Linked...)
+}
+
+func linkInviteRef(refs []inviteRef, ref inviteRef) []inviteRef {
+	if slices.Contains(refs, ref) {
+		return refs
+	}
+	return append(refs, ref)
+}
+
+// hashEmail returns the hex SHA-256 of the normalized email, for use in KV keys and
+// subjects where the address itself must not appear
+func hashEmail(email string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(email))))
+	return hex.EncodeToString(sum[:])
+}
+
+func emailInviteKey(email string) string {
+	return fmt.Sprintf("%s.%s", emailInvitePrefix, hashEmail(email))
+}
+
+func decodeEmailInvite(value []byte) (*emailInvite, error) {
+	var invite emailInvite
+	if err := json.Unmarshal(value, &invite); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal email invite: %w", err)
+	}
+	return &invite, nil
+}
+
+// isRevisionConflict reports whether a KV create or update lost a race with another writer
+func isRevisionConflict(err error) bool {
+	var apiErr *jetstream.APIError
+	return errors.Is(err, jetstream.ErrKeyExists) ||
+		(errors.As(err, &apiErr) && apiErr.ErrorCode == jetstream.JSErrCodeStreamWrongLastSequence)
+}
+
+// claimEmailInvite reserves the recipient's email for an invite sent for ref and returns
+// nil, or, when another response of the email has an outstanding invite, links ref to it
+// and returns that invite so the caller attaches instead of sending. An expired invite is
+// replaced and its responses move to the new one. When the marker cannot be read or
+// written the caller sends anyway: a duplicate email is better than a lost invite.
+func (h *SurveyResponseInviteHandler) claimEmailInvite(ctx context.Context, logger *slog.Logger, email string, ref inviteRef, now time.Time) *emailInvite {
+	key := emailInviteKey(email)
+	for range emailInviteUpdateAttempts {
+		claim := &emailInvite{Owner: ref, ClaimedAt: now}
+
+		entry, err := h.v1MappingsKV.Get(ctx, key)
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			data, err := json.Marshal(claim)
+			if err != nil {
+				logger.With(errKey, err).WarnContext(ctx, "failed to marshal email invite claim")
+				return nil
+			}
+			if _, err := h.v1MappingsKV.Create(ctx, key, data); isRevisionConflict(err) {
+				continue
+			} else if err != nil {
+				logger.With(errKey, err).WarnContext(ctx, "failed to store email invite claim; sending without de-duplication")
+			}
+			return nil
+		}
+		if err != nil {
+			logger.With(errKey, err).WarnContext(ctx, "failed to read email invite marker; sending without de-duplication")
+			return nil
+		}
+
+		next, outstanding := claim, false
+		current, err := decodeEmailInvite(entry.Value())
+		switch {
+		case err != nil:
+			logger.With(errKey, err).WarnContext(ctx, "replacing unreadable email invite marker")
+		case current.Owner == ref:
+			// A re-send of the owner's expired invite also covers the linked responses
+			next.Linked = current.Linked
+		case current.outstanding(now):
+			current.Linked = linkInviteRef(current.Linked, ref)
+			next, outstanding = current, true
+		default:
+			next.Linked = linkInviteRef(current.Linked, current.Owner)
+		}
+
+		data, err := json.Marshal(next)
+		if err != nil {
+			logger.With(errKey, err).WarnContext(ctx, "failed to marshal email invite marker")
+			return nil
+		}
+		if _, err := h.v1MappingsKV.Update(ctx, key, data, entry.Revision()); isRevisionConflict(err) {
+			continue
+		} else if err != nil {
+			logger.With(errKey, err).WarnContext(ctx, "failed to update email invite marker; sending without de-duplication")
+			return nil
+		}
+		if outstanding {
+			return next
+		}
+		return nil
+	}
+	logger.WarnContext(ctx, "email invite marker kept changing; sending without de-duplication")
+	return nil
+}
+
+// attachInvite links an invite record to the outstanding invite of its email instead of
+// sending another one
+func (h *SurveyResponseInviteHandler) attachInvite(ctx context.Context, logger *slog.Logger, invite *domain.SurveyInvite, outstanding *emailInvite) {
+	invite.AttachedTo = outstanding.Owner.SurveyResponseUID
+	invite.ClaimedAt = nil
+	invite.InviteUID = outstanding.InviteUID
+	invite.ExpiresAt = outstanding.ExpiresAt
+	invite.Error = ""
+	invite.Status = domain.InviteStatusPending
+	sentMarker := "pending"
+	if outstanding.InviteUID != "" {
+		sentAt := time.Now().UTC()
+		invite.Status = domain.InviteStatusSent
+		invite.SentAt = &sentAt
+		sentMarker = outstanding.InviteUID
+	}
+
+	if _, err := h.v1MappingsKV.Put(ctx, surveyResponseLFIDInviteSentKey(invite.SurveyResponseUID), []byte(sentMarker)); err != nil {
+		logger.With(errKey, err).WarnContext(ctx, "failed to store survey response LFID invite sent marker")
+	}
+	h.recordInvite(ctx, logger, invite)
+	logger.InfoContext(ctx, "attached survey response to the outstanding LFID invite of its email",
+		"invite_uid", outstanding.InviteUID,
+		"attached_to", outstanding.Owner.SurveyResponseUID,
+	)
+}
+
+// completeEmailInvite records a sent invite on the email marker it owns and hands the
+// invite to the responses linked to it
+func (h *SurveyResponseInviteHandler) completeEmailInvite(ctx context.Context, logger *slog.Logger, invite *domain.SurveyInvite) {
+	owner := surveyInviteRef(invite)
+	marker := h.updateEmailInvite(ctx, logger, invite.Email, func(e *emailInvite) bool {
+		if e.Owner != owner {
+			return false
+		}
+		e.InviteUID = invite.InviteUID
+		e.ExpiresAt = invite.ExpiresAt
+		return true
+	})
+	if marker == nil {
+		return
+	}
+
+	for _, ref := range marker.Linked {
+		h.updateLinkedInvite(ctx, logger, ref, func(linked *domain.SurveyInvite) {
+			sentAt := time.Now().UTC()
+			linked.AttachedTo = owner.SurveyResponseUID
+			linked.Status = domain.InviteStatusSent
+			linked.InviteUID = invite.InviteUID
+			linked.SentAt = &sentAt
+			linked.ExpiresAt = invite.ExpiresAt
+			linked.Error = ""
+			if _, err := h.v1MappingsKV.Put(ctx, surveyResponseLFIDInviteSentKey(ref.SurveyResponseUID), []byte(invite.InviteUID)); err != nil {
+				logger.With(errKey, err, "survey_response_uid", ref.SurveyResponseUID).WarnContext(ctx, "failed to update linked LFID invite sent marker")
+			}
+		})
+	}
+}
+
+// releaseEmailInvite removes the email marker owned by a failed invite, so the next
+// response of the email sends again, and fails the responses linked to it
+func (h *SurveyResponseInviteHandler) releaseEmailInvite(ctx context.Context, logger *slog.Logger, invite *domain.SurveyInvite) {
+	key := emailInviteKey(invite.Email)
+	entry, err := h.v1MappingsKV.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, jetstream.ErrKeyNotFound) {
+			logger.With(errKey, err).WarnContext(ctx, "failed to read email invite marker")
+		}
+		return
+	}
+	marker, err := decodeEmailInvite(entry.Value())
+	if err != nil || marker.Owner != surveyInviteRef(invite) {
+		return
+	}
+	if err := h.v1MappingsKV.Delete(ctx, key, jetstream.LastRevision(entry.Revision())); err != nil {
+		logger.With(errKey, err).WarnContext(ctx, "failed to release email invite marker")
+		return
+	}
+
+	for _, ref := range marker.Linked {
+		h.updateLinkedInvite(ctx, logger, ref, func(linked *domain.SurveyInvite) {
+			failedAt := time.Now().UTC()
+			linked.Status = domain.InviteStatusFailed
+			linked.FailedAt = &failedAt
+			linked.Error = invite.Error
+		})
+	}
+}
+
+// updateEmailInvite applies fn to the email marker and stores it, retrying on revision
+// conflicts. It returns the stored marker, or nil when fn declines or the marker is missing.
+func (h *SurveyResponseInviteHandler) updateEmailInvite(ctx context.Context, logger *slog.Logger, email string, fn func(*emailInvite) bool) *emailInvite {
+	key := emailInviteKey(email)
+	for range emailInviteUpdateAttempts {
+		entry, err := h.v1MappingsKV.Get(ctx, key)
+		if err != nil {
+			if !errors.Is(err, jetstream.ErrKeyNotFound) {
+				logger.With(errKey, err).WarnContext(ctx, "failed to read email invite marker")
+			}
+			return nil
+		}
+		marker, err := decodeEmailInvite(entry.Value())
+		if err != nil || !fn(marker) {
+			return nil
+		}
+		data, err := json.Marshal(marker)
+		if err != nil {
+			return nil
+		}
+		if _, err := h.v1MappingsKV.Update(ctx, key, data, entry.Revision()); isRevisionConflict(err) {
+			continue
+		} else if err != nil {
+			logger.With(errKey, err).WarnContext(ctx, "failed to update email invite marker")
+			return nil
+		}
+		return marker
+	}
+	logger.WarnContext(ctx, "email invite marker kept changing; linked responses not updated")
+	return nil
+}
+
+// updateLinkedInvite applies fn to the invite record of a linked response. Records that
+// were accepted in the meantime are left alone.
+func (h *SurveyResponseInviteHandler) updateLinkedInvite(ctx context.Context, logger *slog.Logger, ref inviteRef, fn func(*domain.SurveyInvite)) {
+	logger = logger.With("linked_survey_uid", ref.SurveyUID, "linked_survey_response_uid", ref.SurveyResponseUID)
+	entry, err := h.v1MappingsKV.Get(ctx, surveyInviteKey(ref.SurveyUID, ref.SurveyResponseUID))
+	if err != nil {
+		logger.With(errKey, err).WarnContext(ctx, "failed to read linked survey invite")
+		return
+	}
+	linked, err := decodeSurveyInvite(entry.Value())
+	if err != nil {
+		logger.With(errKey, err).WarnContext(ctx, "skipping unreadable linked survey invite")
+		return
+	}
+	if linked.Status == domain.InviteStatusAccepted {
+		return
+	}
+	fn(linked)
+	h.recordInvite(ctx, logger, linked)
+}
@@ -0,0 +1,141 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailInviteDeduplication(t *testing.T) {
+	objects, mappings := setupEventBuckets(t)
+	ctx := context.Background()
+	logger := slog.Default()
+	putJSON(t, objects, "itx-surveys.s-1", map[string]any{"name": "Member Survey"})
+	putJSON(t, objects, "itx-surveys.s-2", map[string]any{"name": "Board Survey"})
+
+	expiresAt := time.Now().Add(30 * 24 * time.Hour)
+	sender := &stubSurveyInviteSender{result: &domain.InviteResult{InviteUID: "inv-1", ExpiresAt: expiresAt}}
+	handler := &SurveyResponseInviteHandler{
+		inviteSender:     sender,
+		userReader:       stubSurveyInviteUserReader{err: domain.ErrUserNotFound},
+		v1ObjectsKV:      objects,
+		v1MappingsKV:     mappings,
+		selfServeBaseURL: "https://lfx.example.org",
+	}
+
+	readInvite := func(surveyUID, responseUID string) *domain.SurveyInvite {
+		t.Helper()
+		entry, err := mappings.Get(ctx, surveyInviteKey(surveyUID, responseUID))
+		require.NoError(t, err)
+		invite, err := decodeSurveyInvite(entry.Value())
+		require.NoError(t, err)
+		return invite
+	}
+	readMarker := func(email string) *emailInvite {
+		t.Helper()
+		entry, err := mappings.Get(ctx, emailInviteKey(email))
+		require.NoError(t, err)
+		marker, err := decodeEmailInvite(entry.Value())
+		require.NoError(t, err)
+		return marker
+	}
+
+	t.Run("later responses of the email attach to the outstanding invite", func(t *testing.T) {
+		handler.maybeSendInvite(ctx, logger, "r-1", "jane@example.com", "Jane", "s-1", "p-1")
+		require.True(t, sender.called)
+
+		sender.called = false
+		handler.maybeSendInvite(ctx, logger, "r-2", " Jane@Example.com ", "Jane", "s-2", "p-1")
+		assert.False(t, sender.called, "no second invite for the same email")
+
+		attached := readInvite("s-2", "r-2")
+		assert.Equal(t, domain.InviteStatusSent, attached.Status)
+		assert.Equal(t, "inv-1", attached.InviteUID)
+		assert.Equal(t, "r-1", attached.AttachedTo)
+		marker, err := mappings.Get(ctx, surveyResponseLFIDInviteSentKey("r-2"))
+		require.NoError(t, err)
+		assert.Equal(t, "inv-1", string(marker.Value()))
+
+		emailMarker := readMarker("jane@example.com")
+		assert.Equal(t, inviteRef{SurveyUID: "s-1", SurveyResponseUID: "r-1"}, emailMarker.Owner)
+		assert.Equal(t, []inviteRef{{SurveyUID: "s-2", SurveyResponseUID: "r-2"}}, emailMarker.Linked)
+	})
+
+	t.Run("acceptance enriches every linked response", func(t *testing.T) {
+		tracker := newSurveyInviteTracker(mappings, logger)
+		updated, err := tracker.MarkInviteAccepted(ctx, "s-1", "inv-1", "jane@example.com", "jdoe", time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, 2, updated)
+		assert.Equal(t, domain.InviteStatusAccepted, readInvite("s-2", "r-2").Status)
+		assert.Equal(t, "jdoe", readInvite("s-2", "r-2").AcceptedBy)
+
+		_, err = mappings.Get(ctx, emailInviteKey("jane@example.com"))
+		assert.ErrorIs(t, err, jetstream.ErrKeyNotFound)
+	})
+
+	t.Run("responses attached to an in-flight send get its outcome", func(t *testing.T) {
+		owner := &domain.SurveyInvite{SurveyResponseUID: "r-3", SurveyUID: "s-1", Email: "bob@example.com", Status: domain.InviteStatusPending}
+		require.Nil(t, handler.claimEmailInvite(ctx, logger, owner.Email, surveyInviteRef(owner), time.Now()))
+
+		handler.maybeSendInvite(ctx, logger, "r-4", "bob@example.com", "Bob", "s-2", "p-1")
+		assert.Equal(t, domain.InviteStatusPending, readInvite("s-2", "r-4").Status)
+
+		sender.result = &domain.InviteResult{InviteUID: "inv-2", ExpiresAt: expiresAt}
+		require.True(t, handler.sendInvite(ctx, logger, owner, handler.newInviteRequest(owner, "Member Survey", defaultInviteOptions(), inviteExpirationDays)))
+
+		linked := readInvite("s-2", "r-4")
+		assert.Equal(t, domain.InviteStatusSent, linked.Status)
+		assert.Equal(t, "inv-2", linked.InviteUID)
+		assert.Equal(t, "r-3", linked.AttachedTo)
+		assert.Equal(t, "inv-2", readMarker("bob@example.com").InviteUID)
+
+		owner2 := &domain.SurveyInvite{SurveyResponseUID: "r-5", SurveyUID: "s-1", Email: "carol@example.com", Status: domain.InviteStatusPending}
+		require.Nil(t, handler.claimEmailInvite(ctx, logger, owner2.Email, surveyInviteRef(owner2), time.Now()))
+		handler.maybeSendInvite(ctx, logger, "r-6", "carol@example.com", "Carol", "s-2", "p-1")
+
+		sender.err = errors.New("invite service unavailable")
+		defer func() { sender.err = nil }()
+		require.False(t, handler.sendInvite(ctx, logger, owner2, handler.newInviteRequest(owner2, "Member Survey", defaultInviteOptions(), inviteExpirationDays)))
+
+		linked = readInvite("s-2", "r-6")
+		assert.Equal(t, domain.InviteStatusFailed, linked.Status)
+		assert.Equal(t, "invite service unavailable", linked.Error)
+		_, err := mappings.Get(ctx, emailInviteKey("carol@example.com"))
+		assert.ErrorIs(t, err, jetstream.ErrKeyNotFound, "the next response of the email sends again")
+	})
+
+	t.Run("an expired invite is replaced by a new send", func(t *testing.T) {
+		expired := time.Now().Add(-time.Hour)
+		data, err := json.Marshal(emailInvite{
+			Owner:     inviteRef{SurveyUID: "s-1", SurveyResponseUID: "r-7"},
+			InviteUID: "inv-old",
+			ExpiresAt: &expired,
+			ClaimedAt: expired.Add(-30 * 24 * time.Hour),
+		})
+		require.NoError(t, err)
+		_, err = mappings.Put(ctx, emailInviteKey("dave@example.com"), data)
+		require.NoError(t, err)
+
+		sender.called = false
+		sender.result = &domain.InviteResult{InviteUID: "inv-3", ExpiresAt: expiresAt}
+		handler.maybeSendInvite(ctx, logger, "r-8", "dave@example.com", "Dave", "s-2", "p-1")
+		require.True(t, sender.called)
+
+		marker := readMarker("dave@example.com")
+		assert.Equal(t, "r-8", marker.Owner.SurveyResponseUID)
+		assert.Equal(t, "inv-3", marker.InviteUID)
+		assert.Equal(t, []inviteRef{{SurveyUID: "s-1", SurveyResponseUID: "r-7"}}, marker.Linked)
+		assert.Empty(t, readInvite("s-2", "r-8").AttachedTo)
+	})
+}
@@ -4,6 +4,7 @@
 package eventing
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"log/slog"
@@ -18,6 +19,9 @@ import (
 
 const (
 	V1MappingsBucket = "v1-mappings"
+
+	// consumerDrainTimeout bounds how long Stop waits for buffered messages to be dispatched
+	consumerDrainTimeout = 30 * time.Second
 )
 
 // EventProcessor handles NATS KV bucket event processing
@@ -26,11 +30,21 @@ type EventProcessor struct {
 	jsInstance    jetstream.JetStream
 	consumer      jetstream.Consumer
 	consumeCtx    jetstream.ConsumeContext
+	dispatcher    *kvDispatcher
 	publisher     domain.EventPublisher
 	idMapper      domain.IDMapper
 	mappingsKV    jetstream.KeyValue
 	v1ObjectsKV   jetstream.KeyValue
 	inviteHandler *SurveyResponseInviteHandler
+	resender      *InviteResender
+	handlers      *KVHandlerRegistry
+	deadLetters   *DeadLetterQueue
+	reindexer     *Reindexer
+	backfiller    *InviteBackfiller
+	templates     *SurveyTemplateCatalog
+	invites       *SurveyInviteTracker
+	settings      *InviteSettingsStore
+	surveys       *SurveySettingsStore
 	logger        *slog.Logger
 	config        eventing.Config
 }
@@ -68,7 +82,17 @@ func NewEventProcessor(
 	}
 
 	// Initialize publisher
-	publisher := eventing.NewNATSPublisher(conn, logger)
+	var publisher *eventing.NATSPublisher
+	switch cfg.PublishMode {
+	case "", eventing.PublishModeCore:
+		publisher = eventing.NewNATSPublisher(conn, logger)
+	case eventing.PublishModeJetStream:
+		publisher = eventing.NewJetStreamPublisher(conn, jsContext, cfg.PublishAckTimeout, logger)
+	default:
+		conn.Close()
+		return nil, fmt.Errorf("invalid publish mode %q: must be %s or %s", cfg.PublishMode, eventing.PublishModeCore, eventing.PublishModeJetStream)
+	}
+	logger.Info("event publisher configured", "publish_mode", cmp.Or(cfg.PublishMode, eventing.PublishModeCore))
 
 	// Access the V1 mappings KV bucket
 	mappingsKV, err := jsContext.KeyValue(context.Background(), V1MappingsBucket)
@@ -97,8 +121,12 @@ func NewEventProcessor(
 		}
 		logger.Info("survey response LFID invite handler configured", "base_url", inviteCfg.SelfServeBaseURL)
 	}
+	var resender *InviteResender
+	if inviteHandler != nil && inviteCfg.MaxReinvites > 0 && inviteCfg.ResendInterval > 0 {
+		resender = newInviteResender(inviteHandler, inviteCfg.ResendInterval, inviteCfg.MaxReinvites, logger)
+	}
 
-	return &EventProcessor{
+	ep := &EventProcessor{
 		natsConn:      conn,
 		jsInstance:    jsContext,
 		publisher:     publisher,
@@ -106,9 +134,81 @@ func NewEventProcessor(
 		mappingsKV:    mappingsKV,
 		v1ObjectsKV:   v1ObjectsKV,
 		inviteHandler: inviteHandler,
+		resender:      resender,
 		logger:        logger,
 		config:        cfg,
-	}, nil
+	}
+
+	deps := kvHandlerDeps{
+		publisher:   publisher,
+		idMapper:    idMapper,
+		mappingsKV:  mappingsKV,
+		v1ObjectsKV: v1ObjectsKV,
+		logger:      logger,
+	}
+	ep.handlers, err = newSurveyKVHandlerRegistry(deps, inviteHandler)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to register KV handlers: %w", err)
+	}
+	// Reindexing rebuilds downstream documents only, so it runs without the invite handler.
+	reindexHandlers, err := newSurveyKVHandlerRegistry(deps, nil)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to register KV handlers: %w", err)
+	}
+
+	// Create the dead-letter stream; replays go through the same handlers as live events.
+	ep.deadLetters, err = newDeadLetterQueue(context.Background(), jsContext, v1ObjectsKV, cfg.DeadLetterStreamName, cfg.DeadLetterMaxAge, cfg.MaxDeliver,
+		ep.handlers.Handle, logger)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	ep.reindexer = newReindexer(v1ObjectsKV, reindexHandlers.Prefixes(), reindexHandlers.Handle, logger)
+	ep.templates = newSurveyTemplateCatalog(v1ObjectsKV, logger)
+	ep.invites = newSurveyInviteTracker(mappingsKV, logger)
+	ep.backfiller = newInviteBackfiller(inviteHandler, v1ObjectsKV, idMapper, logger)
+	ep.settings = newInviteSettingsStore(mappingsKV, logger)
+	ep.surveys = newSurveySettingsStore(mappingsKV, v1ObjectsKV, publisher, idMapper, logger)
+
+	return ep, nil
+}
+
+// DeadLetters returns the queue of KV events that could not be processed
+func (ep *EventProcessor) DeadLetters() *DeadLetterQueue {
+	return ep.deadLetters
+}
+
+// Reindexer returns the runner that replays v1-objects entries through the handlers
+func (ep *EventProcessor) Reindexer() *Reindexer {
+	return ep.reindexer
+}
+
+// InviteBackfiller returns the runner that invites participants synced without an invite
+func (ep *EventProcessor) InviteBackfiller() *InviteBackfiller {
+	return ep.backfiller
+}
+
+// SurveyTemplates returns the read model of the SurveyMonkey survey templates
+func (ep *EventProcessor) SurveyTemplates() *SurveyTemplateCatalog {
+	return ep.templates
+}
+
+// SurveyInvites returns the LFID invite state recorded for survey responses
+func (ep *EventProcessor) SurveyInvites() *SurveyInviteTracker {
+	return ep.invites
+}
+
+// InviteSettings returns the store of project and survey invite settings
+func (ep *EventProcessor) InviteSettings() *InviteSettingsStore {
+	return ep.settings
+}
+
+// SurveySettings returns the store of survey settings
+func (ep *EventProcessor) SurveySettings() *SurveySettingsStore {
+	return ep.surveys
 }
 
 // InjectInviteDependencies sets the invite sender and user reader on the invite handler
@@ -120,17 +220,32 @@ func (ep *EventProcessor) InjectInviteDependencies(sender domain.InviteSender, r
 	}
 }
 
+// filterSubjects returns the configured filter subjects, or those of the registered handlers
+func (ep *EventProcessor) filterSubjects() []string {
+	if len(ep.config.FilterSubjects) > 0 {
+		return ep.config.FilterSubjects
+	}
+	return ep.handlers.FilterSubjects()
+}
+
 // Start starts the event processor
 func (ep *EventProcessor) Start(ctx context.Context) error {
 	ep.logger.Info("Starting event processor", "consumer_name", ep.config.ConsumerName)
 
+	if err := ep.templates.Start(ctx); err != nil {
+		return err
+	}
+	if ep.resender != nil {
+		ep.resender.Start(ctx)
+	}
+
 	// Create or update consumer
 	consumer, err := ep.jsInstance.CreateOrUpdateConsumer(ctx, ep.config.StreamName, jetstream.ConsumerConfig{
 		Name:           ep.config.ConsumerName,
 		Durable:        ep.config.ConsumerName,
 		DeliverPolicy:  jetstream.DeliverLastPerSubjectPolicy,
 		AckPolicy:      jetstream.AckExplicitPolicy,
-		FilterSubjects: ep.config.FilterSubjects,
+		FilterSubjects: ep.filterSubjects(),
 		MaxDeliver:     ep.config.MaxDeliver,
 		AckWait:        ep.config.AckWait,
 		MaxAckPending:  ep.config.MaxAckPending,
@@ -141,18 +256,24 @@ func (ep *EventProcessor) Start(ctx context.Context) error {
 	}
 	ep.consumer = consumer
 
+	// Handlers outlive ctx so messages already dispatched when it is cancelled can finish
+	// and be ACKed while Stop drains the workers.
+	handlerCtx := context.WithoutCancel(ctx)
+	ep.dispatcher = newKVDispatcher(ep.config.Workers, dispatchQueueSize, ep.config.AckWait, ep.config.MaxDeliver, func(msg jetstream.Msg) {
+		kvMessageHandler(withInProgress(handlerCtx, msg, ep.config.AckWait), msg, ep.handlers, ep.deadLetters, ep.logger)
+	}, ep.logger)
+
 	// Start consuming messages
-	consumeCtx, err := consumer.Consume(func(msg jetstream.Msg) {
-		kvMessageHandler(ctx, msg, ep.publisher, ep.idMapper, ep.mappingsKV, ep.v1ObjectsKV, ep.inviteHandler, ep.logger)
-	}, jetstream.ConsumeErrHandler(func(_ jetstream.ConsumeContext, err error) {
+	consumeCtx, err := consumer.Consume(ep.dispatcher.Dispatch, jetstream.ConsumeErrHandler(func(_ jetstream.ConsumeContext, err error) {
 		ep.logger.With("error", err).Error("KV consumer error encountered")
 	}))
 	if err != nil {
+		ep.dispatcher.Close()
 		return fmt.Errorf("failed to start consuming messages: %w", err)
 	}
 	ep.consumeCtx = consumeCtx
 
-	ep.logger.Info("Event processor started successfully")
+	ep.logger.Info("Event processor started successfully", "workers", len(ep.dispatcher.shards))
 
 	// Block until context is cancelled
 	<-ctx.Done()
@@ -165,10 +286,36 @@ func (ep *EventProcessor) Start(ctx context.Context) error {
 func (ep *EventProcessor) Stop() error {
 	ep.logger.Info("Stopping event processor...")
 
-	// Stop the consumer
+	// Drain the consumer so buffered messages are still dispatched, then let the workers
+	// finish them before the connection goes away
 	if ep.consumeCtx != nil {
-		ep.consumeCtx.Stop()
-		ep.logger.Info("Consumer stopped")
+		ep.consumeCtx.Drain()
+		select {
+		case <-ep.consumeCtx.Closed():
+			ep.logger.Info("Consumer drained")
+		case <-time.After(consumerDrainTimeout):
+			ep.logger.Warn("Timed out draining consumer; undispatched messages will be redelivered")
+			ep.consumeCtx.Stop()
+		}
+	}
+	if ep.dispatcher != nil {
+		ep.dispatcher.Close()
+		ep.logger.Info("KV workers stopped")
+	}
+
+	// Stop any reindex or invite backfill, the invite resender and the template watcher
+	// before the connection they read from goes away
+	if ep.reindexer != nil {
+		ep.reindexer.Stop()
+	}
+	if ep.backfiller != nil {
+		ep.backfiller.Stop()
+	}
+	if ep.resender != nil {
+		ep.resender.Stop()
+	}
+	if ep.templates != nil {
+		ep.templates.Stop()
 	}
 
 	// Drain and close the NATS connection
@@ -0,0 +1,72 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// defaultInProgressInterval is how often an in-progress ack is sent when the ack wait is unknown
+const defaultInProgressInterval = 10 * time.Second
+
+// inProgressInterval returns how often to extend a message's ack deadline: three times per
+// ack wait, so one lost in-progress ack does not let the message be redelivered
+func inProgressInterval(ackWait time.Duration) time.Duration {
+	if ackWait <= 0 {
+		return defaultInProgressInterval
+	}
+	return ackWait / 3
+}
+
+// heartbeat sends an in-progress ack for msg every interval until stop is called
+func heartbeat(msg jetstream.Msg, interval time.Duration, logger *slog.Logger) (stop func()) {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := msg.InProgress(); err != nil {
+					logger.With(errKey, err, "key", kvKeyFromSubject(msg.Subject())).Warn("failed to extend KV message ack deadline")
+				}
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+type inProgressKey struct{}
+
+type inProgressMsg struct {
+	msg      jetstream.Msg
+	interval time.Duration
+}
+
+// withInProgress records the message being handled, so handlers that run longer than the
+// ack wait can keep it from being redelivered with keepInProgress
+func withInProgress(ctx context.Context, msg jetstream.Msg, ackWait time.Duration) context.Context {
+	return context.WithValue(ctx, inProgressKey{}, &inProgressMsg{msg: msg, interval: inProgressInterval(ackWait)})
+}
+
+// keepInProgress extends the ack deadline of the message being handled until stop is called.
+// It is a no-op outside the consumer, e.g. for reindex runs and dead-letter replays.
+func keepInProgress(ctx context.Context, logger *slog.Logger) (stop func()) {
+	p, ok := ctx.Value(inProgressKey{}).(*inProgressMsg)
+	if !ok {
+		return func() {}
+	}
+	return heartbeat(p.msg, p.interval, logger)
+}
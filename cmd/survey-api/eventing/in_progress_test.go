@@ -0,0 +1,31 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeepInProgress(t *testing.T) {
+	t.Run("extends the ack deadline until stopped", func(t *testing.T) {
+		msg := newFakeMsg("itx-surveys.s-1", "", `{}`)
+		stop := keepInProgress(withInProgress(context.Background(), msg, 30*time.Millisecond), slog.Default())
+
+		assert.Eventually(t, func() bool { return msg.inProgress.Load() >= 2 }, time.Second, 5*time.Millisecond)
+		stop()
+		sent := msg.inProgress.Load()
+		time.Sleep(50 * time.Millisecond)
+		assert.Equal(t, sent, msg.inProgress.Load(), "no in-progress acks after stop")
+	})
+
+	t.Run("is a no-op outside the consumer", func(t *testing.T) {
+		stop := keepInProgress(context.Background(), slog.Default())
+		stop()
+	})
+}
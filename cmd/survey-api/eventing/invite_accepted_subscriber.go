@@ -4,76 +4,211 @@
 package eventing
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	natsgo "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
 
 	inviteapi "github.com/linuxfoundation/lfx-v2-invite-service/pkg/api"
 
 	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	surveyconstants "github.com/linuxfoundation/lfx-v2-survey-service/pkg/constants"
 )
 
 const (
-	inviteAcceptedQueueGroup  = "survey-service-invite-accepted"
-	inviteAcceptedCallTimeout = 30 * time.Second
+	// DefaultInviteAcceptedStreamName is the stream created to capture invite_accepted
+	// events when no existing stream captures the subject
+	DefaultInviteAcceptedStreamName = "SURVEY_SERVICE_INVITE_ACCEPTED"
+	// DefaultInviteAcceptedConsumerName is the durable consumer shared by all instances
+	DefaultInviteAcceptedConsumerName = "survey-service-invite-accepted"
+	// DefaultInviteAcceptedDeadLetterStreamName holds acceptances whose enrichment gave up
+	DefaultInviteAcceptedDeadLetterStreamName = "SURVEY_SERVICE_INVITE_ACCEPTED_DEAD_LETTER"
+	// DefaultInviteAcceptedMaxDeliver covers about two hours of ITX unavailability
+	DefaultInviteAcceptedMaxDeliver = 8
+
+	// inviteAcceptedDeadLetterSubjectPrefix is followed by the hashed acceptor email, so
+	// the dead letters of one email can be purged after a successful re-run
+	inviteAcceptedDeadLetterSubjectPrefix = "lfx.survey-service.invite-accepted-dead-letter"
+
+	inviteAcceptedStreamMaxAge     = 7 * 24 * time.Hour
+	inviteAcceptedDeadLetterMaxAge = 14 * 24 * time.Hour
+	inviteAcceptedCallTimeout      = 30 * time.Second
+	inviteAcceptedDrainTimeout     = 30 * time.Second
 )
 
-// InviteAcceptedSubscriber subscribes to lfx.invite-service.invite_accepted events
-// and calls the ITX Survey Service to enrich all survey-response records tied to the
-// acceptor's email with their new username and profile data.
+// inviteAcceptedBackoff is the delay before each redelivery of an acceptance whose
+// enrichment failed with ITX unavailable; the last delay repeats
+var inviteAcceptedBackoff = []time.Duration{
+	10 * time.Second,
+	30 * time.Second,
+	time.Minute,
+	5 * time.Minute,
+	15 * time.Minute,
+	30 * time.Minute,
+	time.Hour,
+}
+
+// inviteAcceptanceRecorder records accepted invites in the survey invite state
+type inviteAcceptanceRecorder interface {
+	MarkInviteAccepted(ctx context.Context, surveyUID, inviteUID, email, username string, acceptedAt time.Time) (int, error)
+}
+
+// inviteAcceptedDeadLetter is the JSON body of an invite_accepted dead-letter message
+type inviteAcceptedDeadLetter struct {
+	Event          json.RawMessage         `json:"event"`
+	Email          string                  `json:"email,omitempty"`
+	Username       string                  `json:"username,omitempty"`
+	InviteUID      string                  `json:"invite_uid,omitempty"`
+	Reason         domain.DeadLetterReason `json:"reason"`
+	Error          string                  `json:"error"`
+	Attempts       uint64                  `json:"attempts"`
+	DeadLetteredAt time.Time               `json:"dead_lettered_at"`
+}
+
+// InviteAcceptedSubscriber consumes lfx.invite-service.invite_accepted events from a
+// durable JetStream consumer and calls the ITX Survey Service to enrich all
+// survey-response records tied to the acceptor's email with their new username and
+// profile data. Enrichment that fails with ITX unavailable is redelivered with backoff;
+// other failures, and acceptances still failing on the last delivery, are dead-lettered.
 type InviteAcceptedSubscriber struct {
 	nc               *natsgo.Conn
 	acceptanceClient domain.InviteAcceptanceClient
+	userReader       domain.UserReader
+	invites          inviteAcceptanceRecorder
+	config           InviteFeatureConfig
+	backoff          []time.Duration
 	logger           *slog.Logger
-	sub              *natsgo.Subscription
+
+	js          jetstream.JetStream
+	deadLetters jetstream.Stream
+	consumeCtx  jetstream.ConsumeContext
 
 	ctx    context.Context
 	cancel context.CancelFunc
 }
 
-// NewInviteAcceptedSubscriber creates a new subscriber but does not start it.
+// NewInviteAcceptedSubscriber creates a new subscriber but does not start it. userReader
+// resolves usernames for EnrichInvitee and may be nil.
 func NewInviteAcceptedSubscriber(
 	nc *natsgo.Conn,
 	acceptanceClient domain.InviteAcceptanceClient,
+	userReader domain.UserReader,
+	cfg InviteFeatureConfig,
 	logger *slog.Logger,
 ) *InviteAcceptedSubscriber {
+	cfg.AcceptedStreamName = cmp.Or(cfg.AcceptedStreamName, DefaultInviteAcceptedStreamName)
+	cfg.AcceptedConsumerName = cmp.Or(cfg.AcceptedConsumerName, DefaultInviteAcceptedConsumerName)
+	cfg.AcceptedDeadLetterStreamName = cmp.Or(cfg.AcceptedDeadLetterStreamName, DefaultInviteAcceptedDeadLetterStreamName)
+	if cfg.AcceptedMaxDeliver <= 0 {
+		cfg.AcceptedMaxDeliver = DefaultInviteAcceptedMaxDeliver
+	}
 	return &InviteAcceptedSubscriber{
 		nc:               nc,
 		acceptanceClient: acceptanceClient,
+		userReader:       userReader,
+		config:           cfg,
+		backoff:          inviteAcceptedBackoff,
 		logger:           logger,
 	}
 }
 
-// Start registers the NATS QueueSubscribe and begins processing acceptance events.
+// SetInviteTracker makes the subscriber record acceptances in the survey invite state.
+// It must be called before Start; without it acceptances only enrich the responses.
+func (s *InviteAcceptedSubscriber) SetInviteTracker(t *SurveyInviteTracker) {
+	s.invites = t
+}
+
+// Start creates the streams and the durable consumer and begins processing acceptance
+// events. An existing stream that captures the invite_accepted subject is used as is;
+// otherwise the subscriber creates its own.
 func (s *InviteAcceptedSubscriber) Start(ctx context.Context) error {
-	s.ctx, s.cancel = context.WithCancel(ctx)
+	js, err := jetstream.New(s.nc)
+	if err != nil {
+		return fmt.Errorf("failed to create JetStream context: %w", err)
+	}
+	s.js = js
 
-	sub, err := s.nc.QueueSubscribe(
-		inviteapi.InviteServiceAcceptedSubject,
-		inviteAcceptedQueueGroup,
-		s.handle,
-	)
+	streamName, err := js.StreamNameBySubject(ctx, inviteapi.InviteServiceAcceptedSubject)
+	if errors.Is(err, jetstream.ErrStreamNotFound) {
+		streamName = s.config.AcceptedStreamName
+		_, err = js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+			Name:        streamName,
+			Description: "invite_accepted events consumed by the survey service",
+			Subjects:    []string{inviteapi.InviteServiceAcceptedSubject},
+			Storage:     jetstream.FileStorage,
+			Retention:   jetstream.LimitsPolicy,
+			MaxAge:      inviteAcceptedStreamMaxAge,
+			Discard:     jetstream.DiscardOld,
+		})
+	}
 	if err != nil {
-		if s.cancel != nil {
-			s.cancel()
-		}
-		return err
+		return fmt.Errorf("failed to set up invite_accepted stream: %w", err)
 	}
-	s.sub = sub
-	s.logger.Info("invite_accepted subscriber started", "subject", inviteapi.InviteServiceAcceptedSubject)
+
+	s.deadLetters, err = js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:        s.config.AcceptedDeadLetterStreamName,
+		Description: "invite_accepted events the survey service could not process",
+		Subjects:    []string{inviteAcceptedDeadLetterSubjectPrefix + ".>"},
+		Storage:     jetstream.FileStorage,
+		Retention:   jetstream.LimitsPolicy,
+		MaxAge:      inviteAcceptedDeadLetterMaxAge,
+		Discard:     jetstream.DiscardOld,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create or update %s stream: %w", s.config.AcceptedDeadLetterStreamName, err)
+	}
+
+	consumer, err := js.CreateOrUpdateConsumer(ctx, streamName, jetstream.ConsumerConfig{
+		Name:          s.config.AcceptedConsumerName,
+		Durable:       s.config.AcceptedConsumerName,
+		DeliverPolicy: jetstream.DeliverNewPolicy,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		FilterSubject: inviteapi.InviteServiceAcceptedSubject,
+		MaxDeliver:    s.config.AcceptedMaxDeliver,
+		AckWait:       2 * inviteAcceptedCallTimeout,
+		Description:   "Durable invite_accepted consumer for survey-response enrichment",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create or update invite_accepted consumer: %w", err)
+	}
+
+	// Handlers outlive ctx so acceptances in flight when it is cancelled still finish.
+	s.ctx, s.cancel = context.WithCancel(context.WithoutCancel(ctx))
+	consumeCtx, err := consumer.Consume(s.handle, jetstream.ConsumeErrHandler(func(_ jetstream.ConsumeContext, err error) {
+		s.logger.With(errKey, err).Error("invite_accepted consumer error encountered")
+	}))
+	if err != nil {
+		s.cancel()
+		return fmt.Errorf("failed to start consuming invite_accepted events: %w", err)
+	}
+	s.consumeCtx = consumeCtx
+	s.logger.Info("invite_accepted subscriber started",
+		"subject", inviteapi.InviteServiceAcceptedSubject,
+		"stream", streamName,
+		"consumer", s.config.AcceptedConsumerName,
+		"max_deliver", s.config.AcceptedMaxDeliver,
+	)
 	return nil
 }
 
-// Stop drains the subscription (allowing in-flight handlers to complete), then cancels
-// the context. Drain must precede cancel so that handlers blocked in AcceptInvite are
-// not aborted mid-request by context cancellation.
+// Stop drains the consumer (allowing in-flight handlers to complete), then cancels the
+// context. Drain must precede cancel so that handlers blocked in AcceptInvite are not
+// aborted mid-request by context cancellation.
 func (s *InviteAcceptedSubscriber) Stop() {
-	if s.sub != nil {
-		if err := s.sub.Drain(); err != nil {
-			s.logger.With(errKey, err).Warn("error draining invite_accepted subscription")
+	if s.consumeCtx != nil {
+		s.consumeCtx.Drain()
+		select {
+		case <-s.consumeCtx.Closed():
+		case <-time.After(inviteAcceptedDrainTimeout):
+			s.logger.Warn("timed out draining invite_accepted consumer; unacknowledged events will be redelivered")
+			s.consumeCtx.Stop()
 		}
 	}
 	if s.cancel != nil {
@@ -81,30 +216,159 @@ func (s *InviteAcceptedSubscriber) Stop() {
 	}
 }
 
-func (s *InviteAcceptedSubscriber) handle(msg *natsgo.Msg) {
+func (s *InviteAcceptedSubscriber) handle(msg jetstream.Msg) {
 	ctx, cancel := context.WithTimeout(s.ctx, inviteAcceptedCallTimeout)
 	defer cancel()
 
+	metadata, err := msg.Metadata()
+	if err != nil {
+		s.logger.With(errKey, err).Warn("failed to get invite_accepted message metadata, assuming first delivery")
+		metadata = &jetstream.MsgMetadata{NumDelivered: 1}
+	}
+
 	var evt inviteapi.InviteServiceAcceptedEvent
-	if err := json.Unmarshal(msg.Data, &evt); err != nil {
-		s.logger.With(errKey, err).Warn("failed to parse InviteServiceAcceptedEvent; discarding")
+	if err := json.Unmarshal(msg.Data(), &evt); err != nil {
+		s.logger.With(errKey, err).Warn("failed to parse InviteServiceAcceptedEvent; dead-lettering")
+		s.settle(ctx, msg, evt, domain.DeadLetterReasonConversionError, err, metadata.NumDelivered)
+		return
+	}
+	logger := s.logger.With("email", evt.Recipient.Email, "username", evt.AcceptedBy, "attempt", metadata.NumDelivered)
+
+	err = processInviteAcceptedEvent(ctx, evt, s.acceptanceClient, s.invites, s.logger)
+	switch {
+	case err == nil:
+		if err := msg.Ack(); err != nil {
+			logger.With(errKey, err).Error("failed to acknowledge invite_accepted message")
+		}
+	case domain.GetErrorType(err) == domain.ErrorTypeUnavailable && metadata.NumDelivered < uint64(s.config.AcceptedMaxDeliver):
+		delay := s.retryDelay(metadata.NumDelivered)
+		logger.With(errKey, err).Warn("invite_accepted enrichment failed; retrying", "delay", delay)
+		if err := msg.NakWithDelay(delay); err != nil {
+			logger.With(errKey, err).Error("failed to NAK invite_accepted message for retry")
+		}
+	case domain.GetErrorType(err) == domain.ErrorTypeUnavailable:
+		logger.With(errKey, err).Error("invite_accepted enrichment failed on the final attempt; dead-lettering")
+		s.settle(ctx, msg, evt, domain.DeadLetterReasonMaxDeliveries, err, metadata.NumDelivered)
+	default:
+		logger.With(errKey, err).Error("invite_accepted enrichment rejected; dead-lettering")
+		s.settle(ctx, msg, evt, domain.DeadLetterReasonRejected, err, metadata.NumDelivered)
+	}
+}
+
+// settle dead-letters an acceptance and ACKs it. If the dead letter cannot be written
+// the message is NAKed instead, so it is not lost while deliveries remain.
+func (s *InviteAcceptedSubscriber) settle(
+	ctx context.Context,
+	msg jetstream.Msg,
+	evt inviteapi.InviteServiceAcceptedEvent,
+	reason domain.DeadLetterReason,
+	cause error,
+	attempts uint64,
+) {
+	rec := inviteAcceptedDeadLetter{
+		Event:          json.RawMessage(msg.Data()),
+		Email:          evt.Recipient.Email,
+		Username:       evt.AcceptedBy,
+		InviteUID:      evt.UID,
+		Reason:         reason,
+		Error:          cause.Error(),
+		Attempts:       attempts,
+		DeadLetteredAt: time.Now().UTC(),
+	}
+	if !json.Valid(rec.Event) {
+		rec.Event, _ = json.Marshal(string(msg.Data()))
+	}
+	body, err := json.Marshal(rec)
+	if err == nil {
+		_, err = s.js.Publish(ctx, inviteAcceptedDeadLetterSubject(evt.Recipient.Email), body)
+	}
+	if err != nil {
+		s.logger.With(errKey, err, "email", evt.Recipient.Email).Error("failed to dead-letter invite_accepted message")
+		if err := msg.NakWithDelay(s.retryDelay(attempts)); err != nil {
+			s.logger.With(errKey, err).Error("failed to NAK invite_accepted message")
+		}
 		return
 	}
 
-	if err := processInviteAcceptedEvent(ctx, evt, s.acceptanceClient, s.logger); err != nil {
-		s.logger.With(errKey, err).Warn("invite_accepted enrichment failed; best-effort, not retrying",
-			"email", evt.Recipient.Email,
-			"username", evt.AcceptedBy,
-		)
+	s.logger.Warn("dead-lettered invite_accepted message",
+		"email", evt.Recipient.Email,
+		"reason", reason,
+		"attempts", attempts,
+	)
+	if err := msg.Ack(); err != nil {
+		s.logger.With(errKey, err).Error("failed to acknowledge invite_accepted message")
+	}
+}
+
+// retryDelay returns the backoff before redelivery after the given delivery attempt
+func (s *InviteAcceptedSubscriber) retryDelay(numDelivered uint64) time.Duration {
+	i := min(int(max(numDelivered, 1))-1, len(s.backoff)-1)
+	return s.backoff[i]
+}
+
+// inviteAcceptedDeadLetterSubject files dead letters under the hashed acceptor email
+func inviteAcceptedDeadLetterSubject(email string) string {
+	if strings.TrimSpace(email) == "" {
+		return inviteAcceptedDeadLetterSubjectPrefix + ".unknown"
+	}
+	return inviteAcceptedDeadLetterSubjectPrefix + "." + hashEmail(email)
+}
+
+// EnrichInvitee implements domain.InviteEnricher.EnrichInvitee. When username is empty it
+// is looked up by email. After a successful run the dead-lettered acceptances of the
+// email are removed.
+func (s *InviteAcceptedSubscriber) EnrichInvitee(ctx context.Context, email, username string) (*domain.InviteEnrichmentResult, error) {
+	email = strings.TrimSpace(email)
+	username = strings.TrimSpace(username)
+	if username == "" {
+		if s.userReader == nil {
+			return nil, domain.NewValidationError("username is required: LFID lookup is not available")
+		}
+		found, err := s.userReader.UsernameByEmail(ctx, email)
+		if errors.Is(err, domain.ErrUserNotFound) || (err == nil && found == "") {
+			return nil, domain.NewNotFoundError(fmt.Sprintf("no LFID found for %s", email))
+		}
+		if err != nil {
+			return nil, domain.NewUnavailableError("failed to look up LFID", err)
+		}
+		username = found
+	}
+
+	if err := s.acceptanceClient.AcceptInvite(ctx, email, username); err != nil {
+		return nil, err
+	}
+	s.logger.InfoContext(ctx, "invite_accepted enrichment re-run complete", "email", email, "username", username)
+
+	result := &domain.InviteEnrichmentResult{Email: email, Username: username}
+	if s.deadLetters == nil {
+		return result, nil
 	}
+	subject := inviteAcceptedDeadLetterSubject(email)
+	info, err := s.deadLetters.Info(ctx, jetstream.WithSubjectFilter(subject))
+	if err != nil {
+		s.logger.With(errKey, err).WarnContext(ctx, "failed to count dead-lettered invite_accepted events", "email", email)
+		return result, nil
+	}
+	if count := info.State.Subjects[subject]; count > 0 {
+		if err := s.deadLetters.Purge(ctx, jetstream.WithPurgeSubject(subject)); err != nil {
+			s.logger.With(errKey, err).WarnContext(ctx, "failed to remove dead-lettered invite_accepted events", "email", email)
+			return result, nil
+		}
+		result.DeadLettersCleared = count
+	}
+	return result, nil
 }
 
-// processInviteAcceptedEvent validates an invite acceptance event and calls ITX to enrich
-// all survey-response records for the acceptor's email.
+var _ domain.InviteEnricher = (*InviteAcceptedSubscriber)(nil)
+
+// processInviteAcceptedEvent validates an invite acceptance event, records it in the
+// survey invite state and calls ITX to enrich all survey-response records for the
+// acceptor's email. invites may be nil.
 func processInviteAcceptedEvent(
 	ctx context.Context,
 	evt inviteapi.InviteServiceAcceptedEvent,
 	client domain.InviteAcceptanceClient,
+	invites inviteAcceptanceRecorder,
 	logger *slog.Logger,
 ) error {
 	email := evt.Recipient.Email
@@ -121,6 +385,24 @@ func processInviteAcceptedEvent(
 		"resource_type", evt.Resource.Type,
 	)
 
+	// The acceptance is recorded even if the enrichment below fails: the recipient has an
+	// LFID either way. Redeliveries find the invites already accepted.
+	if invites != nil && evt.Resource.Type == surveyconstants.ResourceTypeSurvey && evt.Resource.UID != "" {
+		var acceptedAt time.Time
+		if evt.AcceptedAt != nil {
+			acceptedAt = *evt.AcceptedAt
+		}
+		updated, err := invites.MarkInviteAccepted(ctx, evt.Resource.UID, evt.UID, email, username, acceptedAt)
+		if err != nil {
+			logger.With(errKey, err).Warn("failed to record accepted survey invite",
+				"survey_uid", evt.Resource.UID,
+				"invite_uid", evt.UID,
+			)
+		} else {
+			logger.Debug("recorded accepted survey invite", "survey_uid", evt.Resource.UID, "invites", updated)
+		}
+	}
+
 	if err := client.AcceptInvite(ctx, email, username); err != nil {
 		return err
 	}
@@ -0,0 +1,128 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	inviteapi "github.com/linuxfoundation/lfx-v2-invite-service/pkg/api"
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scriptedAcceptanceClient returns the scripted errors in order, then nil
+type scriptedAcceptanceClient struct {
+	mu    sync.Mutex
+	errs  []error
+	calls []string
+}
+
+func (c *scriptedAcceptanceClient) AcceptInvite(_ context.Context, email, username string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = append(c.calls, email+"/"+username)
+	if len(c.errs) == 0 {
+		return nil
+	}
+	err := c.errs[0]
+	c.errs = c.errs[1:]
+	return err
+}
+
+func (c *scriptedAcceptanceClient) callCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.calls)
+}
+
+func startInviteAcceptedSubscriber(t *testing.T, js jetstream.JetStream, client domain.InviteAcceptanceClient, maxDeliver int) *InviteAcceptedSubscriber {
+	t.Helper()
+	sub := NewInviteAcceptedSubscriber(js.Conn(), client, stubSurveyInviteUserReader{username: "jdoe"}, InviteFeatureConfig{
+		AcceptedMaxDeliver: maxDeliver,
+	}, slog.Default())
+	sub.backoff = []time.Duration{10 * time.Millisecond}
+	require.NoError(t, sub.Start(context.Background()))
+	t.Cleanup(sub.Stop)
+	return sub
+}
+
+func publishInviteAccepted(t *testing.T, js jetstream.JetStream, email string) {
+	t.Helper()
+	var evt inviteapi.InviteServiceAcceptedEvent
+	evt.UID = "inv-1"
+	evt.AcceptedBy = "jdoe"
+	evt.Recipient.Email = email
+	data, err := json.Marshal(evt)
+	require.NoError(t, err)
+	require.NoError(t, js.Conn().Publish(inviteapi.InviteServiceAcceptedSubject, data))
+}
+
+func inviteDeadLetterCount(t *testing.T, sub *InviteAcceptedSubscriber, email string) uint64 {
+	t.Helper()
+	subject := inviteAcceptedDeadLetterSubject(email)
+	info, err := sub.deadLetters.Info(context.Background(), jetstream.WithSubjectFilter(subject))
+	require.NoError(t, err)
+	return info.State.Subjects[subject]
+}
+
+func TestInviteAcceptedSubscriber(t *testing.T) {
+	unavailable := domain.NewUnavailableError("ITX invite_accepted request failed")
+
+	t.Run("retries while ITX is unavailable", func(t *testing.T) {
+		js := setupJetStream(t)
+		client := &scriptedAcceptanceClient{errs: []error{unavailable, unavailable}}
+		sub := startInviteAcceptedSubscriber(t, js, client, 5)
+
+		publishInviteAccepted(t, js, "jane@example.com")
+		require.Eventually(t, func() bool { return client.callCount() == 3 }, 5*time.Second, 10*time.Millisecond)
+		time.Sleep(50 * time.Millisecond)
+		assert.Equal(t, 3, client.callCount(), "no delivery after the successful one")
+		assert.Zero(t, inviteDeadLetterCount(t, sub, "jane@example.com"))
+	})
+
+	t.Run("dead-letters after the last attempt and on permanent errors", func(t *testing.T) {
+		js := setupJetStream(t)
+		client := &scriptedAcceptanceClient{errs: []error{
+			unavailable, unavailable, // jane: both deliveries
+			domain.NewValidationError("unknown email"), // bob: first delivery
+		}}
+		sub := startInviteAcceptedSubscriber(t, js, client, 2)
+
+		publishInviteAccepted(t, js, "jane@example.com")
+		require.Eventually(t, func() bool { return inviteDeadLetterCount(t, sub, "jane@example.com") == 1 }, 5*time.Second, 10*time.Millisecond)
+		publishInviteAccepted(t, js, "bob@example.com")
+		require.Eventually(t, func() bool { return inviteDeadLetterCount(t, sub, "bob@example.com") == 1 }, 5*time.Second, 10*time.Millisecond)
+		assert.Equal(t, 3, client.callCount())
+
+		msg, err := sub.deadLetters.GetLastMsgForSubject(context.Background(), inviteAcceptedDeadLetterSubject("jane@example.com"))
+		require.NoError(t, err)
+		var rec inviteAcceptedDeadLetter
+		require.NoError(t, json.Unmarshal(msg.Data, &rec))
+		assert.Equal(t, domain.DeadLetterReasonMaxDeliveries, rec.Reason)
+		assert.Equal(t, uint64(2), rec.Attempts)
+		assert.Equal(t, "inv-1", rec.InviteUID)
+
+		// A manual re-run looks up the username and clears the email's dead letters only
+		result, err := sub.EnrichInvitee(context.Background(), " jane@example.com ", "")
+		require.NoError(t, err)
+		assert.Equal(t, "jdoe", result.Username)
+		assert.Equal(t, uint64(1), result.DeadLettersCleared)
+		assert.Zero(t, inviteDeadLetterCount(t, sub, "jane@example.com"))
+		assert.Equal(t, uint64(1), inviteDeadLetterCount(t, sub, "bob@example.com"))
+	})
+}
+
+func TestInviteAcceptedSubscriber_EnrichInviteeWithoutLFID(t *testing.T) {
+	sub := NewInviteAcceptedSubscriber(nil, &scriptedAcceptanceClient{}, stubSurveyInviteUserReader{err: domain.ErrUserNotFound}, InviteFeatureConfig{}, slog.Default())
+
+	_, err := sub.EnrichInvitee(context.Background(), "jane@example.com", "")
+	assert.Equal(t, domain.ErrorTypeNotFound, domain.GetErrorType(err))
+}
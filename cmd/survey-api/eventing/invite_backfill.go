@@ -0,0 +1,320 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	defaultInviteBackfillRatePerSecond = 5
+	maxInviteBackfillRatePerSecond     = 50
+
+	// maxInviteBackfillCandidateUIDs caps the candidates kept on the status
+	maxInviteBackfillCandidateUIDs = 100
+)
+
+// InviteBackfiller sends LFID invites to survey participants that were synced before the
+// invite feature was enabled. Live events only invite on ActionCreated, so nothing else
+// reaches these responses.
+type InviteBackfiller struct {
+	handler     *SurveyResponseInviteHandler
+	v1ObjectsKV jetstream.KeyValue
+	idMapper    domain.IDMapper
+	logger      *slog.Logger
+	now         func() time.Time
+
+	runs *backgroundRun[domain.InviteBackfillStatus]
+}
+
+// newInviteBackfiller creates a backfiller that invites through handler, which is nil
+// when the invite feature is disabled. idMapper resolves the project of each response
+// for its invite settings.
+func newInviteBackfiller(handler *SurveyResponseInviteHandler, v1ObjectsKV jetstream.KeyValue, idMapper domain.IDMapper, logger *slog.Logger) *InviteBackfiller {
+	return &InviteBackfiller{
+		handler:     handler,
+		v1ObjectsKV: v1ObjectsKV,
+		idMapper:    idMapper,
+		logger:      logger,
+		now:         time.Now,
+		runs:        newBackgroundRun("invite backfill", cloneInviteBackfillStatus),
+	}
+}
+
+// Start validates the options and starts a run in the background. Like a reindex, the run
+// outlives the request that started it.
+func (b *InviteBackfiller) Start(ctx context.Context, opts domain.InviteBackfillOptions) (*domain.InviteBackfillStatus, error) {
+	if !b.handler.inviteEnabled() {
+		return nil, domain.NewUnavailableError("LFID invites are disabled")
+	}
+	normalizeInviteBackfillOptions(&opts)
+	return b.runs.start(ctx, &domain.InviteBackfillStatus{
+		Options:   opts,
+		State:     domain.InviteBackfillStateRunning,
+		StartedAt: time.Now().UTC(),
+		Skipped:   make(map[string]int),
+	}, func(ctx context.Context) { b.run(ctx, opts) })
+}
+
+// Status returns the current or most recent run
+func (b *InviteBackfiller) Status(_ context.Context) (*domain.InviteBackfillStatus, error) {
+	return b.runs.current()
+}
+
+// Cancel stops the running backfill and waits for it to wind down
+func (b *InviteBackfiller) Cancel(ctx context.Context) (*domain.InviteBackfillStatus, error) {
+	return b.runs.cancelRun(ctx)
+}
+
+// Stop cancels any running backfill and waits for it, for use during shutdown
+func (b *InviteBackfiller) Stop() {
+	b.runs.stop()
+}
+
+func cloneInviteBackfillStatus(status *domain.InviteBackfillStatus) *domain.InviteBackfillStatus {
+	s := *status
+	s.Options.SurveyUIDs = slices.Clone(s.Options.SurveyUIDs)
+	s.Skipped = maps.Clone(s.Skipped)
+	s.CandidateResponseUIDs = slices.Clone(s.CandidateResponseUIDs)
+	return &s
+}
+
+func (b *InviteBackfiller) skip(reason string) {
+	b.runs.update(func(s *domain.InviteBackfillStatus) { s.Skipped[reason]++ })
+}
+
+func (b *InviteBackfiller) run(ctx context.Context, opts domain.InviteBackfillOptions) {
+	logger := b.logger.With("handler", "invite_backfill", "dry_run", opts.DryRun, "survey_uids", opts.SurveyUIDs)
+	logger.InfoContext(ctx, "invite backfill started")
+
+	err := b.backfill(ctx, opts, logger)
+
+	b.runs.update(func(s *domain.InviteBackfillStatus) {
+		s.FinishedAt = time.Now().UTC()
+		s.State = finalState(err, domain.InviteBackfillStateCompleted, domain.InviteBackfillStateCancelled, domain.InviteBackfillStateFailed)
+		if s.State == domain.InviteBackfillStateFailed {
+			s.Error = err.Error()
+		}
+	})
+
+	final := b.runs.snapshot()
+	logger = logger.With("state", final.State, "total", final.Total, "candidates", final.Candidates,
+		"recipients", final.Recipients, "invited", final.Invited, "attached", final.Attached, "failed", final.Failed)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		logger.With(errKey, err).ErrorContext(ctx, "invite backfill failed")
+		return
+	}
+	logger.InfoContext(ctx, "invite backfill finished")
+}
+
+// backfill lists the survey responses, then checks and invites each candidate at the
+// configured rate
+func (b *InviteBackfiller) backfill(ctx context.Context, opts domain.InviteBackfillOptions, logger *slog.Logger) error {
+	keys, err := listKVKeys(ctx, b.v1ObjectsKV, "itx-survey-responses.>")
+	if err != nil {
+		return fmt.Errorf("failed to list survey responses: %w", err)
+	}
+	b.runs.update(func(s *domain.InviteBackfillStatus) { s.Total = len(keys) })
+
+	limiter := time.NewTicker(time.Second / time.Duration(opts.RatePerSecond))
+	defer limiter.Stop()
+
+	surveys := make(map[string]*inviteSurvey)
+	recipients := make(map[string]struct{})
+	for _, key := range keys {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		candidate := b.candidate(ctx, logger, key, opts, surveys)
+		b.runs.update(func(s *domain.InviteBackfillStatus) { s.Scanned++ })
+		if candidate == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-limiter.C:
+		}
+
+		// The LFID lookup is rate limited with the sends since it calls the auth service
+		username, err := b.handler.userReader.UsernameByEmail(ctx, candidate.email)
+		if err == nil && username != "" {
+			b.skip(domain.InviteBackfillSkipHasLFID)
+			continue
+		}
+
+		hashed := hashEmail(candidate.email)
+		_, seen := recipients[hashed]
+		recipients[hashed] = struct{}{}
+		b.runs.update(func(s *domain.InviteBackfillStatus) {
+			s.Candidates++
+			if !seen {
+				s.Recipients++
+			}
+			if len(s.CandidateResponseUIDs) < maxInviteBackfillCandidateUIDs {
+				s.CandidateResponseUIDs = append(s.CandidateResponseUIDs, candidate.responseUID)
+			}
+		})
+		if opts.DryRun {
+			continue
+		}
+
+		responseLogger := logger.With("survey_response_id", candidate.responseUID, "survey_id", candidate.surveyUID)
+		b.handler.maybeSendInvite(ctx, responseLogger, candidate.responseUID, candidate.email, candidate.name, candidate.surveyUID, candidate.projectUID)
+		b.recordOutcome(ctx, responseLogger, candidate)
+	}
+	return nil
+}
+
+// inviteBackfillCandidate is a survey response the backfill invites
+type inviteBackfillCandidate struct {
+	responseUID string
+	surveyUID   string
+	projectUID  string
+	email       string
+	name        string
+}
+
+// candidate reads the response under key and returns it when it needs an invite, or
+// counts why it does not. surveys caches the open surveys read during the run.
+func (b *InviteBackfiller) candidate(ctx context.Context, logger *slog.Logger, key string, opts domain.InviteBackfillOptions, surveys map[string]*inviteSurvey) *inviteBackfillCandidate {
+	entry, err := b.v1ObjectsKV.Get(ctx, key)
+	if errors.Is(err, jetstream.ErrKeyNotFound) || errors.Is(err, jetstream.ErrKeyDeleted) {
+		return nil
+	}
+	if err != nil {
+		logger.With(errKey, err, "key", key).WarnContext(ctx, "failed to read survey response for invite backfill")
+		b.skip(domain.InviteBackfillSkipUnreadable)
+		return nil
+	}
+	data, err := decodeKVValue(entry.Value())
+	if err != nil {
+		b.skip(domain.InviteBackfillSkipUnreadable)
+		return nil
+	}
+
+	stringField := func(field string) string {
+		value, _ := data[field].(string)
+		return strings.TrimSpace(value)
+	}
+	c := &inviteBackfillCandidate{
+		responseUID: stringField("id"),
+		surveyUID:   stringField("survey_id"),
+		email:       stringField("email"),
+		name:        strings.TrimSpace(stringField("first_name") + " " + stringField("last_name")),
+	}
+	if len(opts.SurveyUIDs) > 0 && !slices.Contains(opts.SurveyUIDs, c.surveyUID) {
+		return nil
+	}
+	switch {
+	case c.responseUID == "" || c.surveyUID == "":
+		b.skip(domain.InviteBackfillSkipUnreadable)
+		return nil
+	case c.email == "":
+		b.skip(domain.InviteBackfillSkipNoEmail)
+		return nil
+	case stringField("username") != "":
+		b.skip(domain.InviteBackfillSkipHasUsername)
+		return nil
+	}
+
+	if _, err := b.handler.v1MappingsKV.Get(ctx, surveyResponseLFIDInviteSentKey(c.responseUID)); err == nil {
+		b.skip(domain.InviteBackfillSkipAlreadyInvited)
+		return nil
+	} else if !errors.Is(err, jetstream.ErrKeyNotFound) {
+		logger.With(errKey, err, "key", key).WarnContext(ctx, "failed to check LFID invite sent marker for invite backfill")
+		b.skip(domain.InviteBackfillSkipUnreadable)
+		return nil
+	}
+	if !b.handler.mappingLive(ctx, logger, surveyResponseMappingKey(c.responseUID)) {
+		b.skip(domain.InviteBackfillSkipNotSynced)
+		return nil
+	}
+
+	survey, ok := surveys[c.surveyUID]
+	if !ok {
+		survey = b.handler.openInviteSurvey(ctx, logger, c.surveyUID)
+		surveys[c.surveyUID] = survey
+	}
+	if survey == nil || (!survey.cutoff.IsZero() && !survey.cutoff.After(b.now())) {
+		b.skip(domain.InviteBackfillSkipSurveyNotOpen)
+		return nil
+	}
+
+	if project, ok := data["project"].(map[string]any); ok {
+		c.projectUID = b.projectUID(ctx, logger, project)
+	}
+	settings := b.handler.inviteOptions(ctx, logger, c.surveyUID, c.projectUID)
+	switch {
+	case !settings.enabled:
+		b.skip(domain.InviteBackfillSkipDisabled)
+		return nil
+	case settings.surveyName(survey) == "":
+		b.skip(domain.InviteBackfillSkipNoSurveyName)
+		return nil
+	}
+	return c
+}
+
+// projectUID maps the v1 project of a response to its v2 UID, or "" when it cannot be
+// mapped; the response is then invited with its survey's settings only
+func (b *InviteBackfiller) projectUID(ctx context.Context, logger *slog.Logger, project map[string]any) string {
+	v1ID, _ := project["id"].(string)
+	if b.idMapper == nil || strings.TrimSpace(v1ID) == "" {
+		return ""
+	}
+	projectUID, err := b.idMapper.MapProjectV1ToV2(ctx, v1ID)
+	if err != nil {
+		logger.With(errKey, err, "project_id", v1ID).WarnContext(ctx, "failed to map project for invite backfill")
+		return ""
+	}
+	return projectUID
+}
+
+// recordOutcome counts the invite state maybeSendInvite left for the candidate
+func (b *InviteBackfiller) recordOutcome(ctx context.Context, logger *slog.Logger, c *inviteBackfillCandidate) {
+	var invite *domain.SurveyInvite
+	entry, err := b.handler.v1MappingsKV.Get(ctx, surveyInviteKey(c.surveyUID, c.responseUID))
+	if err == nil {
+		invite, err = decodeSurveyInvite(entry.Value())
+	}
+	b.runs.update(func(s *domain.InviteBackfillStatus) {
+		switch {
+		case err != nil:
+			// The handler passed the response over since it was checked; its logs say why
+			s.Failed++
+		case invite.AttachedTo != "":
+			s.Attached++
+		case invite.Status == domain.InviteStatusSent:
+			s.Invited++
+		default:
+			s.Failed++
+		}
+	})
+	if err != nil {
+		logger.With(errKey, err).WarnContext(ctx, "no invite recorded for backfilled survey response")
+	}
+}
+
+// normalizeInviteBackfillOptions fills in defaults
+func normalizeInviteBackfillOptions(opts *domain.InviteBackfillOptions) {
+	if len(opts.SurveyUIDs) == 0 {
+		opts.SurveyUIDs = nil
+	}
+	if opts.RatePerSecond <= 0 {
+		opts.RatePerSecond = defaultInviteBackfillRatePerSecond
+	}
+	opts.RatePerSecond = min(opts.RatePerSecond, maxInviteBackfillRatePerSecond)
+}
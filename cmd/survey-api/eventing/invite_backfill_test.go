@@ -0,0 +1,135 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// lfidsByEmail reports the usernames of the emails it holds and ErrUserNotFound otherwise
+type lfidsByEmail map[string]string
+
+func (l lfidsByEmail) UsernameByEmail(_ context.Context, email string) (string, error) {
+	if username, ok := l[email]; ok {
+		return username, nil
+	}
+	return "", domain.ErrUserNotFound
+}
+
+// runInviteBackfill starts a run and waits for it to finish
+func runInviteBackfill(t *testing.T, b *InviteBackfiller, opts domain.InviteBackfillOptions) *domain.InviteBackfillStatus {
+	t.Helper()
+	ctx := context.Background()
+	_, err := b.Start(ctx, opts)
+	require.NoError(t, err)
+
+	var status *domain.InviteBackfillStatus
+	require.Eventually(t, func() bool {
+		status, err = b.Status(ctx)
+		return err == nil && status.State != domain.InviteBackfillStateRunning
+	}, 5*time.Second, 10*time.Millisecond)
+	return status
+}
+
+func TestInviteBackfiller(t *testing.T) {
+	objects, mappings := setupEventBuckets(t)
+	ctx := context.Background()
+
+	putJSON(t, objects, "itx-surveys.s-open", map[string]any{"name": "Member Survey", "survey_status": "sent"})
+	putJSON(t, objects, "itx-surveys.s-closed", map[string]any{"name": "Old Survey", "survey_status": "cancelled"})
+	responses := []map[string]any{
+		{"id": "r-1", "survey_id": "s-open", "email": "a@example.com", "first_name": "Ann"},
+		{"id": "r-2", "survey_id": "s-open", "email": "a@example.com"},
+		{"id": "r-3", "survey_id": "s-open", "email": "b@example.com", "username": "bob"},
+		{"id": "r-4", "survey_id": "s-open"},
+		{"id": "r-5", "survey_id": "s-open", "email": "c@example.com"},
+		{"id": "r-6", "survey_id": "s-closed", "email": "d@example.com"},
+		{"id": "r-7", "survey_id": "s-open", "email": "e@example.com"},
+		{"id": "r-8", "survey_id": "s-open", "email": "f@example.com"},
+	}
+	for _, response := range responses {
+		uid := response["id"].(string)
+		putJSON(t, objects, "itx-survey-responses."+uid, response)
+		if uid == "r-7" {
+			continue // never synced to v2
+		}
+		_, err := mappings.Put(ctx, surveyResponseMappingKey(uid), []byte(syncedMarker))
+		require.NoError(t, err)
+	}
+	for _, key := range []string{"survey.s-open", "survey.s-closed"} {
+		_, err := mappings.Put(ctx, key, []byte(syncedMarker))
+		require.NoError(t, err)
+	}
+	_, err := mappings.Put(ctx, surveyResponseLFIDInviteSentKey("r-5"), []byte("inv-old"))
+	require.NoError(t, err)
+
+	sender := &stubSurveyInviteSender{result: &domain.InviteResult{InviteUID: "inv-1", ExpiresAt: time.Now().Add(30 * 24 * time.Hour)}}
+	handler := &SurveyResponseInviteHandler{
+		inviteSender:     sender,
+		userReader:       lfidsByEmail{"f@example.com": "frank"},
+		v1ObjectsKV:      objects,
+		v1MappingsKV:     mappings,
+		selfServeBaseURL: "https://lfx.example.org",
+	}
+	b := newInviteBackfiller(handler, objects, nil, slog.Default())
+	t.Cleanup(b.Stop)
+
+	wantSkipped := map[string]int{
+		domain.InviteBackfillSkipHasUsername:    1,
+		domain.InviteBackfillSkipNoEmail:        1,
+		domain.InviteBackfillSkipAlreadyInvited: 1,
+		domain.InviteBackfillSkipSurveyNotOpen:  1,
+		domain.InviteBackfillSkipNotSynced:      1,
+		domain.InviteBackfillSkipHasLFID:        1,
+	}
+
+	t.Run("dry run reports candidates without sending", func(t *testing.T) {
+		status := runInviteBackfill(t, b, domain.InviteBackfillOptions{DryRun: true, RatePerSecond: 50})
+
+		assert.Equal(t, domain.InviteBackfillStateCompleted, status.State)
+		assert.Equal(t, 8, status.Total)
+		assert.Equal(t, 8, status.Scanned)
+		assert.Equal(t, 2, status.Candidates)
+		assert.Equal(t, 1, status.Recipients)
+		assert.Equal(t, []string{"r-1", "r-2"}, status.CandidateResponseUIDs)
+		assert.Equal(t, wantSkipped, status.Skipped)
+		assert.Zero(t, status.Invited+status.Attached+status.Failed)
+		assert.False(t, sender.called)
+	})
+
+	t.Run("sends one invite per recipient", func(t *testing.T) {
+		status := runInviteBackfill(t, b, domain.InviteBackfillOptions{SurveyUIDs: []string{"s-open", "s-closed"}, RatePerSecond: 50})
+
+		assert.Equal(t, 2, status.Candidates)
+		assert.Equal(t, 1, status.Invited)
+		assert.Equal(t, 1, status.Attached)
+		assert.Zero(t, status.Failed)
+		assert.Equal(t, "a@example.com", sender.last.Recipient.Email)
+		assert.Equal(t, "Ann", sender.last.Recipient.Name)
+
+		for _, uid := range []string{"r-1", "r-2"} {
+			marker, err := mappings.Get(ctx, surveyResponseLFIDInviteSentKey(uid))
+			require.NoError(t, err)
+			assert.Equal(t, "inv-1", string(marker.Value()))
+		}
+	})
+
+	t.Run("a second run finds nothing left", func(t *testing.T) {
+		status := runInviteBackfill(t, b, domain.InviteBackfillOptions{SurveyUIDs: []string{"s-open"}, RatePerSecond: 50})
+		assert.Zero(t, status.Candidates)
+		assert.Equal(t, 3, status.Skipped[domain.InviteBackfillSkipAlreadyInvited])
+	})
+
+	t.Run("refuses to start with invites disabled", func(t *testing.T) {
+		_, err := newInviteBackfiller(nil, objects, nil, slog.Default()).Start(ctx, domain.InviteBackfillOptions{})
+		assert.Equal(t, domain.ErrorTypeUnavailable, domain.GetErrorType(err))
+	})
+}
@@ -3,6 +3,8 @@
 
 package eventing
 
+import "time"
+
 // InviteFeatureConfig holds LFID invite feature settings shared by the event
 // processor (outbound invites) and the invite_accepted subscriber.
 type InviteFeatureConfig struct {
@@ -13,4 +15,21 @@ type InviteFeatureConfig struct {
 	// return_url. When empty, outbound invite sending is disabled via inviteEnabled()
 	// but the invite_accepted subscriber may still run.
 	SelfServeBaseURL string
+	// ResendInterval reflects INVITE_RESEND_INTERVAL — how often expired invites are
+	// checked for a re-send.
+	ResendInterval time.Duration
+	// MaxReinvites reflects INVITE_MAX_REINVITES — how many times an invite that expired
+	// unaccepted is re-sent. Zero disables re-sending.
+	MaxReinvites int
+	// AcceptedStreamName reflects INVITE_ACCEPTED_STREAM_NAME — the stream created for
+	// invite_accepted events when no existing stream captures the subject.
+	AcceptedStreamName string
+	// AcceptedConsumerName reflects INVITE_ACCEPTED_CONSUMER_NAME — the durable consumer
+	// shared by all instances.
+	AcceptedConsumerName string
+	// AcceptedMaxDeliver reflects INVITE_ACCEPTED_MAX_DELIVER — delivery attempts before
+	// an acceptance whose enrichment keeps failing is dead-lettered.
+	AcceptedMaxDeliver int
+	// AcceptedDeadLetterStreamName reflects INVITE_ACCEPTED_DEAD_LETTER_STREAM_NAME.
+	AcceptedDeadLetterStreamName string
 }
@@ -0,0 +1,264 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// inviteClaimTimeout is how long a re-send claim holds an invite. An invite still pending
+// this long after it was claimed was interrupted before the send outcome was recorded.
+const inviteClaimTimeout = 15 * time.Minute
+
+// InviteResender periodically re-sends LFID invites that expired without being accepted
+// while their survey is still open. Each invite is re-sent at most maxReinvites times.
+type InviteResender struct {
+	handler      *SurveyResponseInviteHandler
+	interval     time.Duration
+	maxReinvites int
+	logger       *slog.Logger
+	now          func() time.Time
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newInviteResender(handler *SurveyResponseInviteHandler, interval time.Duration, maxReinvites int, logger *slog.Logger) *InviteResender {
+	return &InviteResender{
+		handler:      handler,
+		interval:     interval,
+		maxReinvites: maxReinvites,
+		logger:       logger.With("component", "invite_resender"),
+		now:          time.Now,
+	}
+}
+
+// inviteResendSummary counts the outcome of one resend run
+type inviteResendSummary struct {
+	checked  int
+	resent   int
+	attached int
+	failed   int
+	skipped  int
+}
+
+// Start runs a resend pass every interval in the background until Stop is called
+func (r *InviteResender) Start(ctx context.Context) {
+	runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	r.cancel = cancel
+	r.done = make(chan struct{})
+	go func() {
+		defer close(r.done)
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-runCtx.Done():
+				return
+			case <-ticker.C:
+				r.runOnce(runCtx)
+			}
+		}
+	}()
+	r.logger.Info("invite resender started", "interval", r.interval, "max_reinvites", r.maxReinvites)
+}
+
+// Stop cancels any running pass and waits for it to finish
+func (r *InviteResender) Stop() {
+	if r.cancel == nil {
+		return
+	}
+	r.cancel()
+	<-r.done
+}
+
+// runOnce re-sends every due invite. Errors are logged per invite so one bad record
+// does not hold back the rest.
+func (r *InviteResender) runOnce(ctx context.Context) inviteResendSummary {
+	var summary inviteResendSummary
+	if !r.handler.inviteEnabled() {
+		return summary
+	}
+
+	keys, err := listKVKeys(ctx, r.handler.v1MappingsKV, surveyInviteKey("*", "*"))
+	if err != nil {
+		r.logger.With(errKey, err).WarnContext(ctx, "failed to list survey invites for resend")
+		return summary
+	}
+
+	surveys := make(map[string]*inviteSurvey)
+	for _, key := range keys {
+		if ctx.Err() != nil {
+			break
+		}
+		summary.checked++
+		switch r.resend(ctx, key, surveys) {
+		case inviteResendSent:
+			summary.resent++
+		case inviteResendAttached:
+			summary.attached++
+		case inviteResendFailed:
+			summary.failed++
+		case inviteResendSkipped:
+			summary.skipped++
+		}
+	}
+
+	if summary.resent > 0 || summary.attached > 0 || summary.failed > 0 {
+		r.logger.InfoContext(ctx, "invite resend run completed",
+			"checked", summary.checked,
+			"resent", summary.resent,
+			"attached", summary.attached,
+			"failed", summary.failed,
+			"skipped", summary.skipped,
+		)
+	}
+	return summary
+}
+
+type inviteResendOutcome int
+
+const (
+	inviteResendNotDue inviteResendOutcome = iota
+	inviteResendSkipped
+	inviteResendSent
+	inviteResendFailed
+	inviteResendAttached
+)
+
+// resend re-sends the invite stored under key when it is due. surveys caches the v1
+// survey records read during the run.
+func (r *InviteResender) resend(ctx context.Context, key string, surveys map[string]*inviteSurvey) inviteResendOutcome {
+	entry, err := r.handler.v1MappingsKV.Get(ctx, key)
+	if errors.Is(err, jetstream.ErrKeyNotFound) {
+		return inviteResendNotDue
+	}
+	if err != nil {
+		r.logger.With(errKey, err, "key", key).WarnContext(ctx, "failed to read survey invite")
+		return inviteResendSkipped
+	}
+	invite, err := decodeSurveyInvite(entry.Value())
+	if err != nil {
+		r.logger.With(errKey, err, "key", key).WarnContext(ctx, "skipping unreadable survey invite")
+		return inviteResendSkipped
+	}
+
+	now := r.now()
+	if !r.due(invite, now) {
+		return inviteResendNotDue
+	}
+
+	// A stale claim already counted its re-invite
+	reclaim := invite.Status == domain.InviteStatusPending
+	if !reclaim {
+		invite.Reinvites++
+	}
+	logger := r.logger.With(
+		"survey_uid", invite.SurveyUID,
+		"survey_response_uid", invite.SurveyResponseUID,
+		"reinvite", invite.Reinvites,
+	)
+
+	survey, ok := surveys[invite.SurveyUID]
+	if !ok {
+		survey = r.handler.openInviteSurvey(ctx, logger, invite.SurveyUID)
+		surveys[invite.SurveyUID] = survey
+	}
+	if survey == nil || (!survey.cutoff.IsZero() && !survey.cutoff.After(now)) {
+		return inviteResendNotDue
+	}
+	if !r.responseLive(ctx, logger, invite.SurveyResponseUID) {
+		return inviteResendNotDue
+	}
+	opts := r.handler.inviteOptions(ctx, logger, invite.SurveyUID, invite.ProjectUID)
+	surveyName := opts.surveyName(survey)
+	if !opts.enabled || surveyName == "" {
+		return inviteResendNotDue
+	}
+
+	username, err := r.handler.userReader.UsernameByEmail(ctx, invite.Email)
+	if err == nil && username != "" {
+		// The recipient signed up without the invite; the response is enriched elsewhere.
+		logger.DebugContext(ctx, "survey invite recipient already has LFID, skipping re-send")
+		return inviteResendNotDue
+	}
+	if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+		logger.With(errKey, err).WarnContext(ctx, "failed to check LFID for invite recipient; retrying next run")
+		return inviteResendSkipped
+	}
+
+	// Claim the invite at the revision it was read at so that only one replica re-sends it
+	claimedAt := now.UTC()
+	invite.Status = domain.InviteStatusPending
+	invite.ClaimedAt = &claimedAt
+	invite.UpdatedAt = claimedAt
+	data, err := json.Marshal(invite)
+	if err != nil {
+		logger.With(errKey, err).WarnContext(ctx, "failed to marshal survey invite")
+		return inviteResendSkipped
+	}
+	if _, err := r.handler.v1MappingsKV.Update(ctx, key, data, entry.Revision()); err != nil {
+		logger.With(errKey, err).DebugContext(ctx, "survey invite changed since read, skipping re-send")
+		return inviteResendSkipped
+	}
+
+	// The recipient may have been invited for another survey since; share that invite
+	if outstanding := r.handler.claimEmailInvite(ctx, logger, invite.Email, surveyInviteRef(invite), now); outstanding != nil {
+		invite.Reinvites--
+		r.handler.attachInvite(ctx, logger, invite, outstanding)
+		return inviteResendAttached
+	}
+
+	req := r.handler.newInviteRequest(invite, surveyName, opts, reinviteExpirationDays(now, survey.cutoff, opts.expirationDays))
+	if !r.handler.sendInvite(ctx, logger, invite, req) {
+		return inviteResendFailed
+	}
+	return inviteResendSent
+}
+
+// due reports whether an invite is waiting on a re-send: a sent invite that expired
+// unaccepted, or a failed re-send, with re-invites left, or a re-send claim that timed out
+func (r *InviteResender) due(invite *domain.SurveyInvite, now time.Time) bool {
+	if invite.Status == domain.InviteStatusPending {
+		return invite.ClaimedAt != nil && !invite.ClaimedAt.Add(inviteClaimTimeout).After(now)
+	}
+	if invite.Reinvites >= r.maxReinvites {
+		return false
+	}
+	switch invite.Status {
+	case domain.InviteStatusSent:
+		return invite.ExpiresAt != nil && invite.ExpiresAt.Before(now)
+	case domain.InviteStatusFailed:
+		return invite.Reinvites > 0
+	default:
+		return false
+	}
+}
+
+// responseLive reports whether the response was indexed, not deleted, and still carries
+// the sent marker that guards its invite
+func (r *InviteResender) responseLive(ctx context.Context, logger *slog.Logger, responseUID string) bool {
+	if !r.handler.mappingLive(ctx, logger, surveyResponseMappingKey(responseUID)) {
+		return false
+	}
+	_, err := r.handler.v1MappingsKV.Get(ctx, surveyResponseLFIDInviteSentKey(responseUID))
+	return err == nil
+}
+
+// reinviteExpirationDays keeps a re-sent invite from outliving the survey cutoff
+func reinviteExpirationDays(now, cutoff time.Time, expirationDays int) int {
+	if cutoff.IsZero() {
+		return expirationDays
+	}
+	days := int(math.Ceil(cutoff.Sub(now).Hours() / 24))
+	return max(1, min(expirationDays, days))
+}
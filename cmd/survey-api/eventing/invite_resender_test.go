@@ -0,0 +1,181 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInviteResender(t *testing.T) {
+	objects, mappings := setupEventBuckets(t)
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	expired, future := now.Add(-time.Hour), now.Add(24*time.Hour)
+
+	putJSON(t, objects, "itx-surveys.s-open", map[string]any{
+		"name":               "Member Survey",
+		"survey_status":      "sending",
+		"survey_cutoff_date": now.Add(10 * 24 * time.Hour).Format(time.RFC3339),
+	})
+	putJSON(t, objects, "itx-surveys.s-closed", map[string]any{
+		"name":               "Old Survey",
+		"survey_status":      "sent",
+		"survey_cutoff_date": now.Add(-24 * time.Hour).Format(time.RFC3339),
+	})
+	for _, key := range []string{"survey.s-open", "survey.s-closed"} {
+		_, err := mappings.Put(ctx, key, []byte(syncedMarker))
+		require.NoError(t, err)
+	}
+
+	invites := []*domain.SurveyInvite{
+		{SurveyResponseUID: "r-expired", SurveyUID: "s-open", Email: "a@example.com", Status: domain.InviteStatusSent, InviteUID: "inv-1", ExpiresAt: &expired},
+		{SurveyResponseUID: "r-current", SurveyUID: "s-open", Email: "b@example.com", Status: domain.InviteStatusSent, InviteUID: "inv-2", ExpiresAt: &future},
+		{SurveyResponseUID: "r-maxed", SurveyUID: "s-open", Email: "c@example.com", Status: domain.InviteStatusSent, InviteUID: "inv-3", ExpiresAt: &expired, Reinvites: 2},
+		{SurveyResponseUID: "r-first-failure", SurveyUID: "s-open", Email: "d@example.com", Status: domain.InviteStatusFailed},
+		{SurveyResponseUID: "r-closed", SurveyUID: "s-closed", Email: "e@example.com", Status: domain.InviteStatusSent, InviteUID: "inv-5", ExpiresAt: &expired},
+	}
+	for _, invite := range invites {
+		require.NoError(t, putSurveyInvite(ctx, mappings, invite))
+		_, err := mappings.Put(ctx, surveyResponseMappingKey(invite.SurveyResponseUID), []byte(syncedMarker))
+		require.NoError(t, err)
+		_, err = mappings.Put(ctx, surveyResponseLFIDInviteSentKey(invite.SurveyResponseUID), []byte(invite.InviteUID))
+		require.NoError(t, err)
+	}
+
+	sender := &stubSurveyInviteSender{result: &domain.InviteResult{InviteUID: "inv-new", ExpiresAt: now.Add(10 * 24 * time.Hour)}}
+	handler := &SurveyResponseInviteHandler{
+		inviteSender:     sender,
+		userReader:       stubSurveyInviteUserReader{err: domain.ErrUserNotFound},
+		v1ObjectsKV:      objects,
+		v1MappingsKV:     mappings,
+		selfServeBaseURL: "https://lfx.example.org",
+	}
+	resender := newInviteResender(handler, time.Hour, 2, slog.Default())
+	resender.now = func() time.Time { return now }
+
+	readInvite := func(responseUID string) *domain.SurveyInvite {
+		entry, err := mappings.Get(ctx, surveyInviteKey("s-open", responseUID))
+		require.NoError(t, err)
+		invite, err := decodeSurveyInvite(entry.Value())
+		require.NoError(t, err)
+		return invite
+	}
+
+	t.Run("re-sends only expired invites of open surveys", func(t *testing.T) {
+		summary := resender.runOnce(ctx)
+		assert.Equal(t, 5, summary.checked)
+		assert.Equal(t, 1, summary.resent)
+		assert.Equal(t, "a@example.com", sender.last.Recipient.Email)
+		assert.Equal(t, "Member Survey", sender.last.Resource.Name)
+		assert.Equal(t, 10, sender.last.ExpirationDays, "capped at the survey cutoff")
+
+		invite := readInvite("r-expired")
+		assert.Equal(t, domain.InviteStatusSent, invite.Status)
+		assert.Equal(t, "inv-new", invite.InviteUID)
+		assert.Equal(t, 1, invite.Reinvites)
+		require.Len(t, invite.Attempts, 1)
+		assert.Equal(t, "inv-new", invite.Attempts[0].InviteUID)
+
+		marker, err := mappings.Get(ctx, surveyResponseLFIDInviteSentKey("r-expired"))
+		require.NoError(t, err)
+		assert.Equal(t, "inv-new", string(marker.Value()))
+	})
+
+	t.Run("records failed re-sends and retries them within the limit", func(t *testing.T) {
+		resender.now = func() time.Time { return now.Add(11 * 24 * time.Hour) }
+		_, err := objects.Put(ctx, "itx-surveys.s-open", []byte(`{"name":"Member Survey","survey_status":"sent"}`))
+		require.NoError(t, err)
+
+		sender.err = errors.New("invite service unavailable")
+		summary := resender.runOnce(ctx)
+		assert.Equal(t, 2, summary.failed, "r-expired and r-current expired by now")
+
+		invite := readInvite("r-expired")
+		assert.Equal(t, domain.InviteStatusFailed, invite.Status)
+		assert.Equal(t, 2, invite.Reinvites)
+		require.Len(t, invite.Attempts, 2)
+		assert.Equal(t, "invite service unavailable", invite.Attempts[1].Error)
+		assert.Equal(t, inviteExpirationDays, sender.last.ExpirationDays, "no cutoff to cap the expiry")
+
+		// r-expired has used both re-invites; r-current has one left
+		sender.err = nil
+		summary = resender.runOnce(ctx)
+		assert.Equal(t, 1, summary.resent)
+		assert.Equal(t, "b@example.com", sender.last.Recipient.Email)
+		assert.Equal(t, 2, readInvite("r-current").Reinvites)
+
+		summary = resender.runOnce(ctx)
+		assert.Zero(t, summary.resent+summary.failed)
+	})
+}
+
+func TestReinviteExpirationDays(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, inviteExpirationDays, reinviteExpirationDays(now, time.Time{}, inviteExpirationDays))
+	assert.Equal(t, inviteExpirationDays, reinviteExpirationDays(now, now.Add(90*24*time.Hour), inviteExpirationDays))
+	assert.Equal(t, 3, reinviteExpirationDays(now, now.Add(60*time.Hour), inviteExpirationDays))
+	assert.Equal(t, 1, reinviteExpirationDays(now, now.Add(time.Hour), inviteExpirationDays))
+}
+
+func TestInviteResender_ReclaimsInterruptedClaim(t *testing.T) {
+	objects, mappings := setupEventBuckets(t)
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	claimedAt := now.Add(-5 * time.Minute)
+
+	putJSON(t, objects, "itx-surveys.s-open", map[string]any{"name": "Member Survey", "survey_status": "sent"})
+	_, err := mappings.Put(ctx, "survey.s-open", []byte(syncedMarker))
+	require.NoError(t, err)
+
+	invites := []*domain.SurveyInvite{
+		// A re-send that used the last re-invite and was interrupted before its outcome was recorded
+		{SurveyResponseUID: "r-claimed", SurveyUID: "s-open", Email: "a@example.com", Status: domain.InviteStatusPending, InviteUID: "inv-1", Reinvites: 2, ClaimedAt: &claimedAt},
+		// A first send in flight is not the resender's to retry
+		{SurveyResponseUID: "r-first-send", SurveyUID: "s-open", Email: "b@example.com", Status: domain.InviteStatusPending},
+	}
+	for _, invite := range invites {
+		require.NoError(t, putSurveyInvite(ctx, mappings, invite))
+		_, err := mappings.Put(ctx, surveyResponseMappingKey(invite.SurveyResponseUID), []byte(syncedMarker))
+		require.NoError(t, err)
+		_, err = mappings.Put(ctx, surveyResponseLFIDInviteSentKey(invite.SurveyResponseUID), []byte("pending"))
+		require.NoError(t, err)
+	}
+
+	sender := &stubSurveyInviteSender{result: &domain.InviteResult{InviteUID: "inv-new", ExpiresAt: now.Add(30 * 24 * time.Hour)}}
+	handler := &SurveyResponseInviteHandler{
+		inviteSender:     sender,
+		userReader:       stubSurveyInviteUserReader{err: domain.ErrUserNotFound},
+		v1ObjectsKV:      objects,
+		v1MappingsKV:     mappings,
+		selfServeBaseURL: "https://lfx.example.org",
+	}
+	resender := newInviteResender(handler, time.Hour, 2, slog.Default())
+
+	// The claim is still held
+	resender.now = func() time.Time { return now }
+	summary := resender.runOnce(ctx)
+	assert.Zero(t, summary.resent+summary.failed)
+
+	resender.now = func() time.Time { return now.Add(inviteClaimTimeout) }
+	summary = resender.runOnce(ctx)
+	assert.Equal(t, 1, summary.resent)
+	assert.Equal(t, "a@example.com", sender.last.Recipient.Email)
+
+	entry, err := mappings.Get(ctx, surveyInviteKey("s-open", "r-claimed"))
+	require.NoError(t, err)
+	invite, err := decodeSurveyInvite(entry.Value())
+	require.NoError(t, err)
+	assert.Equal(t, domain.InviteStatusSent, invite.Status)
+	assert.Equal(t, "inv-new", invite.InviteUID)
+	assert.Equal(t, 2, invite.Reinvites, "the interrupted claim already counted the re-invite")
+	assert.Nil(t, invite.ClaimedAt)
+}
@@ -0,0 +1,247 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	surveyconstants "github.com/linuxfoundation/lfx-v2-survey-service/pkg/constants"
+	"github.com/nats-io/nats.go/jetstream"
+	"golang.org/x/text/language"
+)
+
+const (
+	// inviteSettingsPrefix keys the invite settings of projects and surveys in the mappings
+	// bucket: invite_settings.{scope}.{uid} = JSON domain.InviteSettings
+	inviteSettingsPrefix = "invite_settings"
+
+	// maxInviteExpirationDays is the longest expiry the invite service accepts
+	maxInviteExpirationDays = 90
+
+	defaultInviteReturnURLTemplate = "{base_url}/surveys/{survey_uid}"
+)
+
+// inviteReturnURLPlaceholders are the placeholders a return URL template may use
+var inviteReturnURLPlaceholders = []string{"{base_url}", "{survey_uid}", "{project_uid}", "{locale}"}
+
+var inviteReturnURLPlaceholderPattern = regexp.MustCompile(`\{[^{}]*\}`)
+
+func inviteSettingsKey(scope domain.InviteSettingsScope, uid string) string {
+	return fmt.Sprintf("%s.%s.%s", inviteSettingsPrefix, scope, uid)
+}
+
+// loadInviteSettings reads the settings stored for a scope, or nil when there are none
+func loadInviteSettings(ctx context.Context, mappingsKV jetstream.KeyValue, scope domain.InviteSettingsScope, uid string) (*domain.InviteSettings, error) {
+	entry, err := mappingsKV.Get(ctx, inviteSettingsKey(scope, uid))
+	if errors.Is(err, jetstream.ErrKeyNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var settings domain.InviteSettings
+	if err := json.Unmarshal(entry.Value(), &settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal invite settings: %w", err)
+	}
+	return &settings, nil
+}
+
+// InviteSettingsStore implements domain.InviteSettingsStore on the mappings bucket
+type InviteSettingsStore struct {
+	mappingsKV jetstream.KeyValue
+	logger     *slog.Logger
+}
+
+func newInviteSettingsStore(mappingsKV jetstream.KeyValue, logger *slog.Logger) *InviteSettingsStore {
+	return &InviteSettingsStore{
+		mappingsKV: mappingsKV,
+		logger:     logger.With("component", "invite_settings"),
+	}
+}
+
+// GetInviteSettings implements domain.InviteSettingsStore.GetInviteSettings
+func (s *InviteSettingsStore) GetInviteSettings(ctx context.Context, scope domain.InviteSettingsScope, uid string) (*domain.InviteSettings, error) {
+	settings, err := loadInviteSettings(ctx, s.mappingsKV, scope, uid)
+	if err != nil {
+		return nil, domain.NewUnavailableError("failed to read invite settings", err)
+	}
+	if settings == nil {
+		return nil, domain.NewNotFoundError(fmt.Sprintf("no invite settings are stored for %s %s", scope, uid))
+	}
+	return settings, nil
+}
+
+// PutInviteSettings implements domain.InviteSettingsStore.PutInviteSettings
+func (s *InviteSettingsStore) PutInviteSettings(ctx context.Context, settings *domain.InviteSettings) (*domain.InviteSettings, error) {
+	if err := normalizeInviteSettings(settings); err != nil {
+		return nil, err
+	}
+	settings.UpdatedAt = time.Now().UTC()
+	data, err := json.Marshal(settings)
+	if err != nil {
+		return nil, domain.NewInternalError("failed to marshal invite settings", err)
+	}
+	if _, err := s.mappingsKV.Put(ctx, inviteSettingsKey(settings.Scope, settings.UID), data); err != nil {
+		return nil, domain.NewUnavailableError("failed to store invite settings", err)
+	}
+	return settings, nil
+}
+
+// DeleteInviteSettings implements domain.InviteSettingsStore.DeleteInviteSettings
+func (s *InviteSettingsStore) DeleteInviteSettings(ctx context.Context, scope domain.InviteSettingsScope, uid string) error {
+	if _, err := s.GetInviteSettings(ctx, scope, uid); err != nil {
+		return err
+	}
+	if err := s.mappingsKV.Delete(ctx, inviteSettingsKey(scope, uid)); err != nil {
+		return domain.NewUnavailableError("failed to delete invite settings", err)
+	}
+	return nil
+}
+
+// normalizeInviteSettings validates the settings, trims them and canonicalizes the locale
+func normalizeInviteSettings(settings *domain.InviteSettings) error {
+	settings.UID = strings.TrimSpace(settings.UID)
+	settings.Role = strings.TrimSpace(settings.Role)
+	settings.ReturnURLTemplate = strings.TrimSpace(settings.ReturnURLTemplate)
+	settings.DisplayNameFallback = strings.TrimSpace(settings.DisplayNameFallback)
+	settings.Locale = strings.TrimSpace(settings.Locale)
+
+	if settings.Scope != domain.InviteSettingsScopeProject && settings.Scope != domain.InviteSettingsScopeSurvey {
+		return domain.NewValidationError(fmt.Sprintf("unknown invite settings scope %q", settings.Scope))
+	}
+	if settings.UID == "" {
+		return domain.NewValidationError(fmt.Sprintf("a %s uid is required", settings.Scope))
+	}
+	if settings.Role != "" && !slices.Contains(surveyconstants.InviteRoles, settings.Role) {
+		return domain.NewValidationError(fmt.Sprintf("role %q is not one of %s", settings.Role, strings.Join(surveyconstants.InviteRoles, ", ")))
+	}
+	if settings.ExpirationDays < 0 || settings.ExpirationDays > maxInviteExpirationDays {
+		return domain.NewValidationError(fmt.Sprintf("expiration_days must be between 1 and %d", maxInviteExpirationDays))
+	}
+	if settings.Locale != "" {
+		tag, err := language.Parse(settings.Locale)
+		if err != nil {
+			return domain.NewValidationError(fmt.Sprintf("locale %q is not a BCP 47 language tag", settings.Locale), err)
+		}
+		settings.Locale = tag.String()
+	}
+	if settings.ReturnURLTemplate != "" {
+		if err := validateInviteReturnURLTemplate(settings.ReturnURLTemplate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateInviteReturnURLTemplate checks that the template only uses known placeholders
+// and renders to an absolute http(s) URL
+func validateInviteReturnURLTemplate(template string) error {
+	for _, placeholder := range inviteReturnURLPlaceholderPattern.FindAllString(template, -1) {
+		if !slices.Contains(inviteReturnURLPlaceholders, placeholder) {
+			return domain.NewValidationError(fmt.Sprintf("return_url_template uses unknown placeholder %s; use %s",
+				placeholder, strings.Join(inviteReturnURLPlaceholders, ", ")))
+		}
+	}
+	rendered := renderInviteReturnURL(template, "https://lfx.example.org", "survey", "project", "en")
+	u, err := url.Parse(rendered)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return domain.NewValidationError("return_url_template must render to an absolute http or https URL")
+	}
+	return nil
+}
+
+func renderInviteReturnURL(template, baseURL, surveyUID, projectUID, locale string) string {
+	return strings.NewReplacer(
+		"{base_url}", strings.TrimRight(baseURL, "/"),
+		"{survey_uid}", url.PathEscape(surveyUID),
+		"{project_uid}", url.PathEscape(projectUID),
+		"{locale}", url.PathEscape(locale),
+	).Replace(template)
+}
+
+// inviteOptions are the invite settings in effect for one send
+type inviteOptions struct {
+	enabled             bool
+	role                string
+	expirationDays      int
+	returnURLTemplate   string
+	displayNameFallback string
+	locale              string
+}
+
+func defaultInviteOptions() inviteOptions {
+	return inviteOptions{
+		enabled:           true,
+		role:              surveyconstants.InviteRoleParticipant,
+		expirationDays:    inviteExpirationDays,
+		returnURLTemplate: defaultInviteReturnURLTemplate,
+	}
+}
+
+// apply overlays the fields set in settings
+func (o *inviteOptions) apply(settings *domain.InviteSettings) {
+	if settings.Enabled != nil {
+		o.enabled = *settings.Enabled
+	}
+	if settings.Role != "" {
+		o.role = settings.Role
+	}
+	if settings.ExpirationDays > 0 {
+		o.expirationDays = settings.ExpirationDays
+	}
+	if settings.ReturnURLTemplate != "" {
+		o.returnURLTemplate = settings.ReturnURLTemplate
+	}
+	if settings.DisplayNameFallback != "" {
+		o.displayNameFallback = settings.DisplayNameFallback
+	}
+	if settings.Locale != "" {
+		o.locale = settings.Locale
+	}
+}
+
+// surveyName returns the name the invite gives the survey, or "" when there is none
+func (o inviteOptions) surveyName(survey *inviteSurvey) string {
+	if survey != nil && survey.name != "" {
+		return survey.name
+	}
+	return o.displayNameFallback
+}
+
+// inviteOptions resolves the settings for an invite of a survey response: the defaults,
+// then the project's settings, then the survey's. A layer that cannot be read is skipped.
+func (h *SurveyResponseInviteHandler) inviteOptions(ctx context.Context, logger *slog.Logger, surveyUID, projectUID string) inviteOptions {
+	opts := defaultInviteOptions()
+	layers := []struct {
+		scope domain.InviteSettingsScope
+		uid   string
+	}{
+		{domain.InviteSettingsScopeProject, projectUID},
+		{domain.InviteSettingsScopeSurvey, surveyUID},
+	}
+	for _, layer := range layers {
+		if layer.uid == "" {
+			continue
+		}
+		settings, err := loadInviteSettings(ctx, h.v1MappingsKV, layer.scope, layer.uid)
+		if err != nil {
+			logger.With(errKey, err, "scope", layer.scope).WarnContext(ctx, "failed to read invite settings; using the inherited ones")
+			continue
+		}
+		if settings != nil {
+			opts.apply(settings)
+		}
+	}
+	return opts
+}
@@ -0,0 +1,197 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	surveyconstants "github.com/linuxfoundation/lfx-v2-survey-service/pkg/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeInviteSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings domain.InviteSettings
+		wantErr  string
+	}{
+		{
+			name: "accepts every field",
+			settings: domain.InviteSettings{
+				Scope:               domain.InviteSettingsScopeSurvey,
+				UID:                 "s-1",
+				Role:                "Participant",
+				ExpirationDays:      90,
+				ReturnURLTemplate:   "{base_url}/{locale}/projects/{project_uid}/surveys/{survey_uid}",
+				DisplayNameFallback: "Member Survey",
+				Locale:              "ja-jp",
+			},
+		},
+		{
+			name:     "rejects an unknown scope",
+			settings: domain.InviteSettings{Scope: "committee", UID: "c-1"},
+			wantErr:  `unknown invite settings scope "committee"`,
+		},
+		{
+			name:     "requires a uid",
+			settings: domain.InviteSettings{Scope: domain.InviteSettingsScopeProject, UID: " "},
+			wantErr:  "a project uid is required",
+		},
+		{
+			name:     "rejects a role outside the allowed set",
+			settings: domain.InviteSettings{Scope: domain.InviteSettingsScopeProject, UID: "p-1", Role: "Admin"},
+			wantErr:  `role "Admin" is not one of Participant`,
+		},
+		{
+			name:     "rejects an expiry beyond the invite service maximum",
+			settings: domain.InviteSettings{Scope: domain.InviteSettingsScopeProject, UID: "p-1", ExpirationDays: 91},
+			wantErr:  "expiration_days must be between 1 and 90",
+		},
+		{
+			name:     "rejects a malformed locale",
+			settings: domain.InviteSettings{Scope: domain.InviteSettingsScopeProject, UID: "p-1", Locale: "not a locale"},
+			wantErr:  `locale "not a locale" is not a BCP 47 language tag`,
+		},
+		{
+			name:     "rejects an unknown placeholder",
+			settings: domain.InviteSettings{Scope: domain.InviteSettingsScopeProject, UID: "p-1", ReturnURLTemplate: "{base_url}/{survey_name}"},
+			wantErr:  "return_url_template uses unknown placeholder {survey_name}",
+		},
+		{
+			name:     "rejects a relative return URL",
+			settings: domain.InviteSettings{Scope: domain.InviteSettingsScopeProject, UID: "p-1", ReturnURLTemplate: "/surveys/{survey_uid}"},
+			wantErr:  "return_url_template must render to an absolute http or https URL",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := normalizeInviteSettings(&tt.settings)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, domain.ErrorTypeValidation, domain.GetErrorType(err))
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+
+	t.Run("canonicalizes the locale", func(t *testing.T) {
+		settings := domain.InviteSettings{Scope: domain.InviteSettingsScopeSurvey, UID: "s-1", Locale: " ja-jp "}
+		require.NoError(t, normalizeInviteSettings(&settings))
+		assert.Equal(t, "ja-JP", settings.Locale)
+	})
+}
+
+func TestInviteSettingsStore(t *testing.T) {
+	_, mappings := setupEventBuckets(t)
+	ctx := context.Background()
+	store := newInviteSettingsStore(mappings, slog.Default())
+
+	_, err := store.GetInviteSettings(ctx, domain.InviteSettingsScopeSurvey, "s-1")
+	assert.Equal(t, domain.ErrorTypeNotFound, domain.GetErrorType(err))
+
+	stored, err := store.PutInviteSettings(ctx, &domain.InviteSettings{
+		Scope:          domain.InviteSettingsScopeSurvey,
+		UID:            "s-1",
+		ExpirationDays: 14,
+		UpdatedBy:      "jdoe",
+	})
+	require.NoError(t, err)
+	assert.False(t, stored.UpdatedAt.IsZero())
+
+	got, err := store.GetInviteSettings(ctx, domain.InviteSettingsScopeSurvey, "s-1")
+	require.NoError(t, err)
+	assert.Equal(t, 14, got.ExpirationDays)
+	assert.Equal(t, "jdoe", got.UpdatedBy)
+
+	_, err = store.PutInviteSettings(ctx, &domain.InviteSettings{Scope: domain.InviteSettingsScopeSurvey, UID: "s-1", ExpirationDays: 120})
+	assert.Equal(t, domain.ErrorTypeValidation, domain.GetErrorType(err))
+
+	require.NoError(t, store.DeleteInviteSettings(ctx, domain.InviteSettingsScopeSurvey, "s-1"))
+	err = store.DeleteInviteSettings(ctx, domain.InviteSettingsScopeSurvey, "s-1")
+	assert.Equal(t, domain.ErrorTypeNotFound, domain.GetErrorType(err))
+}
+
+func TestInviteSettingsResolution(t *testing.T) {
+	objects, mappings := setupEventBuckets(t)
+	ctx := context.Background()
+	logger := slog.Default()
+	store := newInviteSettingsStore(mappings, logger)
+	putJSON(t, objects, "itx-surveys.s-named", map[string]any{"name": "Member Survey"})
+	putJSON(t, objects, "itx-surveys.s-unnamed", map[string]any{})
+	putJSON(t, objects, "itx-surveys.s-blank", map[string]any{})
+
+	disabled, enabled := false, true
+	put := func(settings *domain.InviteSettings) {
+		t.Helper()
+		_, err := store.PutInviteSettings(ctx, settings)
+		require.NoError(t, err)
+	}
+	put(&domain.InviteSettings{
+		Scope:             domain.InviteSettingsScopeProject,
+		UID:               "p-1",
+		Role:              surveyconstants.InviteRoleParticipant,
+		ExpirationDays:    14,
+		ReturnURLTemplate: "{base_url}/{locale}/projects/{project_uid}/surveys/{survey_uid}",
+		Locale:            "de",
+	})
+	put(&domain.InviteSettings{Scope: domain.InviteSettingsScopeSurvey, UID: "s-unnamed", DisplayNameFallback: "Annual Survey", Locale: "ja-JP"})
+	put(&domain.InviteSettings{Scope: domain.InviteSettingsScopeProject, UID: "p-off", Enabled: &disabled})
+	put(&domain.InviteSettings{Scope: domain.InviteSettingsScopeSurvey, UID: "s-on", Enabled: &enabled})
+
+	handler := &SurveyResponseInviteHandler{
+		inviteSender:     &stubSurveyInviteSender{},
+		userReader:       stubSurveyInviteUserReader{err: domain.ErrUserNotFound},
+		v1ObjectsKV:      objects,
+		v1MappingsKV:     mappings,
+		selfServeBaseURL: "https://lfx.example.org/",
+	}
+
+	t.Run("defaults apply without settings", func(t *testing.T) {
+		assert.Equal(t, defaultInviteOptions(), handler.inviteOptions(ctx, logger, "s-named", "p-none"))
+	})
+
+	t.Run("survey settings override the project's", func(t *testing.T) {
+		opts := handler.inviteOptions(ctx, logger, "s-unnamed", "p-1")
+		assert.True(t, opts.enabled)
+		assert.Equal(t, surveyconstants.InviteRoleParticipant, opts.role)
+		assert.Equal(t, 14, opts.expirationDays)
+		assert.Equal(t, "Annual Survey", opts.displayNameFallback)
+		assert.Equal(t, "ja-JP", opts.locale)
+	})
+
+	t.Run("a survey can re-enable invites of a disabled project", func(t *testing.T) {
+		assert.False(t, handler.inviteOptions(ctx, logger, "s-named", "p-off").enabled)
+		assert.True(t, handler.inviteOptions(ctx, logger, "s-on", "p-off").enabled)
+	})
+
+	t.Run("sends with the resolved settings and the name fallback", func(t *testing.T) {
+		sender := &stubSurveyInviteSender{result: &domain.InviteResult{InviteUID: "inv-1", ExpiresAt: time.Now().Add(14 * 24 * time.Hour)}}
+		handler.inviteSender = sender
+		handler.maybeSendInvite(ctx, logger, "r-1", "jane@example.com", "Jane", "s-unnamed", "p-1")
+
+		require.True(t, sender.called)
+		assert.Equal(t, "Annual Survey", sender.last.Resource.Name)
+		assert.Equal(t, surveyconstants.InviteRoleParticipant, sender.last.Role)
+		assert.Equal(t, 14, sender.last.ExpirationDays)
+		assert.Equal(t, "https://lfx.example.org/ja-JP/projects/p-1/surveys/s-unnamed", sender.last.ReturnURL)
+	})
+
+	t.Run("skips when invites are disabled or the survey has no name", func(t *testing.T) {
+		sender := &stubSurveyInviteSender{result: &domain.InviteResult{InviteUID: "inv-2"}}
+		handler.inviteSender = sender
+		handler.maybeSendInvite(ctx, logger, "r-2", "bob@example.com", "Bob", "s-named", "p-off")
+		handler.maybeSendInvite(ctx, logger, "r-3", "carol@example.com", "Carol", "s-blank", "p-none")
+
+		assert.False(t, sender.called)
+		_, err := mappings.Get(ctx, surveyResponseLFIDInviteSentKey("r-2"))
+		assert.Error(t, err, "a skipped invite leaves no marker so it can be sent once enabled")
+	})
+}
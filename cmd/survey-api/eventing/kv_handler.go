@@ -4,11 +4,11 @@
 package eventing
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
 	"log/slog"
-	"strings"
 	"time"
 
 	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
@@ -22,6 +22,10 @@ const (
 	// tombstoneMarker is written to a mapping key after successful deletion,
 	// preventing duplicate delete events if the same KV delete is redelivered.
 	tombstoneMarker = "!del"
+
+	// syncedMarker is the live mapping value used before content hashes were stored;
+	// it never matches a hash, so such records are republished once and then hashed.
+	syncedMarker = "1"
 )
 
 // isTombstonedMapping reports whether a mapping value is a tombstone marker.
@@ -34,6 +38,8 @@ type kvEntry struct {
 	key       string
 	value     []byte
 	operation jetstream.KeyValueOp
+	// revision is the v1-objects stream sequence of the entry; 0 when unknown
+	revision uint64
 }
 
 func (e *kvEntry) Key() string {
@@ -61,18 +67,28 @@ func (e *kvEntry) Delta() uint64 {
 }
 
 func (e *kvEntry) Revision() uint64 {
-	return 0
+	return e.revision
 }
 
-// kvMessageHandler processes KV update messages from the consumer
+// kvOperationFromHeader maps a KV-Operation header value to the KV operation it denotes
+func kvOperationFromHeader(opHeader string) jetstream.KeyValueOp {
+	switch opHeader {
+	case "DEL":
+		return jetstream.KeyValueDelete
+	case "PURGE":
+		return jetstream.KeyValuePurge
+	default:
+		return jetstream.KeyValuePut
+	}
+}
+
+// kvMessageHandler processes KV update messages from the consumer.
+// deadLetters may be nil, in which case exhausted and unconvertible events are dropped.
 func kvMessageHandler(
 	ctx context.Context,
 	msg jetstream.Msg,
-	publisher domain.EventPublisher,
-	idMapper domain.IDMapper,
-	mappingsKV jetstream.KeyValue,
-	v1ObjectsKV jetstream.KeyValue,
-	inviteHandler *SurveyResponseInviteHandler,
+	handlers *KVHandlerRegistry,
+	deadLetters *DeadLetterQueue,
 	logger *slog.Logger,
 ) {
 	// Parse the message as a KV entry
@@ -80,41 +96,59 @@ func kvMessageHandler(
 	subject := msg.Subject()
 
 	// Extract key from the subject ($KV.{bucket}.{key})
-	key := ""
-	if len(subject) > len(fmt.Sprintf("$KV.%s.", V1ObjectsBucket)) {
-		key = subject[len(fmt.Sprintf("$KV.%s.", V1ObjectsBucket)):]
-	}
+	key := kvKeyFromSubject(subject)
 
-	// Determine operation from headers
-	operation := jetstream.KeyValuePut // Default to PUT
-	if opHeader := headers.Get("KV-Operation"); opHeader != "" {
-		switch opHeader {
-		case "DEL":
-			operation = jetstream.KeyValueDelete
-		case "PURGE":
-			operation = jetstream.KeyValuePurge
-		}
+	// Determine operation from headers (defaults to PUT)
+	opHeader := headers.Get("KV-Operation")
+	operation := kvOperationFromHeader(opHeader)
+
+	// Get message metadata for the entry revision and the retry attempt number
+	metadata, err := msg.Metadata()
+	if err != nil {
+		logger.With("error", err, "key", key).Warn("failed to get message metadata, using default delay")
+		metadata = &jetstream.MsgMetadata{NumDelivered: 1}
 	}
 
-	// Create a mock KV entry for the handler
+	// Create a mock KV entry for the handler. The KV revision is the stream sequence,
+	// which publishers use to derive deduplication IDs.
 	entry := &kvEntry{
 		key:       key,
 		value:     msg.Data(),
 		operation: operation,
+		revision:  metadata.Sequence.Stream,
 	}
 
 	// Process the KV entry and check if retry is needed
-	shouldRetry := kvHandler(ctx, entry, publisher, idMapper, mappingsKV, v1ObjectsKV, inviteHandler, logger)
+	handlerCtx, failure := withFailureRecorder(ctx)
+	shouldRetry := handlers.Handle(handlerCtx, entry)
+
+	// Park events that will never succeed as delivered: conversion failures, and retries
+	// on the final delivery (a NAK there would be dropped by the server).
+	if deadLetters != nil && (failure.err != nil || (shouldRetry && deadLetters.isFinalDelivery(metadata.NumDelivered))) {
+		rec := deadLetterRecord{
+			Key:       key,
+			Operation: cmp.Or(opHeader, "PUT"),
+			Revision:  metadata.Sequence.Stream,
+			Payload:   msg.Data(),
+			Headers:   headers,
+			Reason:    domain.DeadLetterReasonMaxDeliveries,
+			Error:     "retries exhausted",
+			Attempts:  metadata.NumDelivered,
+		}
+		if failure.err != nil {
+			rec.Reason = domain.DeadLetterReasonConversionError
+			rec.Error = failure.err.Error()
+		}
+		if err := deadLetters.publish(ctx, rec); err != nil {
+			logger.With("error", err, "key", key).Error("failed to dead-letter KV message")
+		} else {
+			logger.With("key", key, "reason", rec.Reason, "attempts", rec.Attempts).Warn("dead-lettered KV message")
+			shouldRetry = false
+		}
+	}
 
 	// Handle message acknowledgment based on retry decision
 	if shouldRetry {
-		// Get message metadata to determine retry attempt number
-		metadata, err := msg.Metadata()
-		if err != nil {
-			logger.With("error", err, "key", key).Warn("failed to get message metadata, using default delay")
-			metadata = &jetstream.MsgMetadata{NumDelivered: 1}
-		}
-
 		// Calculate exponential backoff delay based on delivery attempt
 		// Attempts: 1st retry = 2s, 2nd retry = 10s, 3rd+ retry = 20s
 		var delay time.Duration
@@ -143,29 +177,6 @@ func kvMessageHandler(
 	}
 }
 
-// kvHandler routes KV entries by operation type
-// Returns true if the message should be retried (NAK), false if it should be acknowledged (ACK)
-func kvHandler(
-	ctx context.Context,
-	entry jetstream.KeyValueEntry,
-	publisher domain.EventPublisher,
-	idMapper domain.IDMapper,
-	mappingsKV jetstream.KeyValue,
-	v1ObjectsKV jetstream.KeyValue,
-	inviteHandler *SurveyResponseInviteHandler,
-	logger *slog.Logger,
-) bool {
-	switch entry.Operation() {
-	case jetstream.KeyValuePut:
-		return handleKVPut(ctx, entry, publisher, idMapper, mappingsKV, v1ObjectsKV, inviteHandler, logger)
-	case jetstream.KeyValueDelete, jetstream.KeyValuePurge:
-		return handleKVDelete(ctx, entry, publisher, mappingsKV, logger)
-	default:
-		logger.With("key", entry.Key(), "operation", entry.Operation()).Debug("ignoring unknown KV operation")
-		return false // ACK unknown operations
-	}
-}
-
 // decodeKVValue decodes a KV entry value from JSON or msgpack into a map.
 // Tries JSON first; on failure resets the map and retries with msgpack.
 // Returns an error only if both formats fail.
@@ -179,112 +190,3 @@ func decodeKVValue(value []byte) (map[string]any, error) {
 	}
 	return data, nil
 }
-
-// handleKVPut processes PUT operations by routing to specific handlers based on key prefix
-func handleKVPut(
-	ctx context.Context,
-	entry jetstream.KeyValueEntry,
-	publisher domain.EventPublisher,
-	idMapper domain.IDMapper,
-	mappingsKV jetstream.KeyValue,
-	v1ObjectsKV jetstream.KeyValue,
-	inviteHandler *SurveyResponseInviteHandler,
-	logger *slog.Logger,
-) bool {
-	key := entry.Key()
-
-	// Parse the data (try JSON first, then msgpack)
-	v1Data, err := decodeKVValue(entry.Value())
-	if err != nil {
-		logger.With(errKey, err, "key", key).ErrorContext(ctx, "failed to unmarshal KV entry data as JSON or msgpack")
-		return false
-	}
-
-	// Check if this is a soft delete (record has _sdc_deleted_at field).
-	if deletedAt, exists := v1Data["_sdc_deleted_at"]; exists && deletedAt != nil && deletedAt != "" {
-		logger.With("key", key, "_sdc_deleted_at", deletedAt).InfoContext(ctx, "processing soft delete from KV bucket")
-		return handleKVSoftDelete(ctx, entry, publisher, mappingsKV, logger)
-	}
-
-	// Extract the prefix (everything before the first period) for faster lookup.
-	prefix := key
-	if dotIndex := strings.Index(key, "."); dotIndex != -1 {
-		prefix = key[:dotIndex]
-	}
-
-	// Route to specific handlers based on prefix
-	switch prefix {
-	case "itx-surveys":
-		return handleSurveyUpdate(ctx, key, v1Data, publisher, idMapper, mappingsKV, logger)
-	case "itx-survey-responses":
-		return handleSurveyResponseUpdate(ctx, key, v1Data, publisher, idMapper, mappingsKV, v1ObjectsKV, inviteHandler, logger)
-	case "surveymonkey-surveys":
-		return handleSurveyTemplateUpdate(ctx, key, v1Data, publisher, mappingsKV, logger)
-	default:
-		// Not a survey-related key, ACK and skip
-		logger.With("key", key, "prefix", prefix).Debug("skipping update - unsupported type")
-		return false
-	}
-}
-
-// handleKVDelete processes DELETE and PURGE operations
-func handleKVDelete(
-	ctx context.Context,
-	entry jetstream.KeyValueEntry,
-	publisher domain.EventPublisher,
-	mappingsKV jetstream.KeyValue,
-	logger *slog.Logger,
-) bool {
-	key := entry.Key()
-	logger.With("key", key, "operation", entry.Operation()).InfoContext(ctx, "processing hard delete from KV bucket")
-	return handleResourceDelete(ctx, entry, publisher, mappingsKV, logger)
-}
-
-// handleKVSoftDelete processes a soft delete (record with _sdc_deleted_at field).
-// Returns true if the operation should be retried, false otherwise.
-func handleKVSoftDelete(ctx context.Context,
-	entry jetstream.KeyValueEntry,
-	publisher domain.EventPublisher,
-	mappingsKV jetstream.KeyValue,
-	logger *slog.Logger,
-) bool {
-	return handleResourceDelete(ctx, entry, publisher, mappingsKV, logger)
-}
-
-// handleResourceDelete handles deletion of resources by key prefix.
-// Returns true if the operation should be retried, false otherwise.
-func handleResourceDelete(ctx context.Context,
-	entry jetstream.KeyValueEntry,
-	publisher domain.EventPublisher,
-	mappingsKV jetstream.KeyValue,
-	logger *slog.Logger) bool {
-	// Extract the prefix (everything before the first period) for faster lookup.
-	key := entry.Key()
-	prefix := key
-	if dotIndex := strings.Index(key, "."); dotIndex != -1 {
-		prefix = key[:dotIndex]
-	}
-
-	// Extract UID from key (everything after the first period).
-	uid := ""
-	if dotIndex := strings.Index(key, "."); dotIndex != -1 && dotIndex < len(key)-1 {
-		uid = key[dotIndex+1:]
-	}
-
-	if uid == "" {
-		logger.With("key", key).WarnContext(ctx, "cannot extract UID from key for deletion")
-		return false
-	}
-
-	// Route to appropriate delete handler based on prefix
-	switch prefix {
-	case "itx-surveys":
-		return handleSurveyDelete(ctx, uid, publisher, mappingsKV, logger)
-	case "itx-survey-responses":
-		return handleSurveyResponseDelete(ctx, uid, publisher, mappingsKV, logger)
-	case "surveymonkey-surveys":
-		return handleSurveyTemplateDelete(ctx, uid, publisher, mappingsKV, logger)
-	default:
-		return false // ACK unsupported types
-	}
-}
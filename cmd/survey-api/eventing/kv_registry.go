@@ -0,0 +1,218 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/infrastructure/eventing"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// KVObjectHandler syncs one v1 object type from the v1-objects bucket to v2.
+// HandleUpdate and HandleDelete return true if the message should be retried (NAK).
+type KVObjectHandler interface {
+	// Prefix is the v1-objects key prefix before the first ".", e.g. "itx-surveys"
+	Prefix() string
+	// ObjectType is the v2 object type, used for metrics
+	ObjectType() string
+	// MappingKey returns the v1-mappings key that tracks the object with this UID
+	MappingKey(uid string) string
+	// HandleUpdate processes a live PUT of the record stored under key
+	HandleUpdate(ctx context.Context, key string, v1Data map[string]any) bool
+	// HandleDelete processes a hard delete, purge or soft delete of the object
+	HandleDelete(ctx context.Context, uid string) bool
+}
+
+// KVHandlerRegistry routes v1-objects entries to the handler registered for their key prefix
+type KVHandlerRegistry struct {
+	handlers map[string]KVObjectHandler
+	prefixes []string
+	logger   *slog.Logger
+	// mappingsKV, when set, is checked for tombstones so that repeated deletes are skipped
+	mappingsKV jetstream.KeyValue
+
+	events   metric.Int64Counter
+	duration metric.Float64Histogram
+}
+
+// NewKVHandlerRegistry creates a registry holding handlers. Registration order is the
+// order reindexing visits object types, so register parents before their children.
+func NewKVHandlerRegistry(logger *slog.Logger, handlers ...KVObjectHandler) (*KVHandlerRegistry, error) {
+	events, err := meter.Int64Counter("eventing.kv.events",
+		metric.WithDescription("v1-objects KV events handled, by object type, operation and outcome"),
+		metric.WithUnit("{event}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	duration, err := meter.Float64Histogram("eventing.kv.handle.duration",
+		metric.WithDescription("Time spent handling a v1-objects KV event"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &KVHandlerRegistry{
+		handlers: make(map[string]KVObjectHandler, len(handlers)),
+		logger:   logger,
+		events:   events,
+		duration: duration,
+	}
+	for _, h := range handlers {
+		if err := r.Register(h); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Register adds a handler; each prefix can only be handled once
+func (r *KVHandlerRegistry) Register(h KVObjectHandler) error {
+	prefix := h.Prefix()
+	if prefix == "" || strings.Contains(prefix, ".") {
+		return fmt.Errorf("invalid KV handler prefix %q", prefix)
+	}
+	if _, exists := r.handlers[prefix]; exists {
+		return fmt.Errorf("a KV handler is already registered for prefix %q", prefix)
+	}
+	r.handlers[prefix] = h
+	r.prefixes = append(r.prefixes, prefix)
+	return nil
+}
+
+// Prefixes returns the registered key prefixes in registration order
+func (r *KVHandlerRegistry) Prefixes() []string {
+	return append([]string(nil), r.prefixes...)
+}
+
+// FilterSubjects returns the consumer filter subjects covering every registered prefix
+func (r *KVHandlerRegistry) FilterSubjects() []string {
+	subjects := make([]string, 0, len(r.prefixes))
+	for _, prefix := range r.prefixes {
+		subjects = append(subjects, fmt.Sprintf("$KV.%s.%s.>", V1ObjectsBucket, prefix))
+	}
+	return subjects
+}
+
+// lookup returns the handler for a key and the UID after the prefix
+func (r *KVHandlerRegistry) lookup(key string) (KVObjectHandler, string, bool) {
+	prefix, uid, _ := strings.Cut(key, ".")
+	h, ok := r.handlers[prefix]
+	return h, uid, ok
+}
+
+// Handle routes a KV entry by operation and key prefix.
+// Returns true if the message should be retried (NAK), false if it should be acknowledged (ACK).
+func (r *KVHandlerRegistry) Handle(ctx context.Context, entry jetstream.KeyValueEntry) bool {
+	key := entry.Key()
+	h, uid, ok := r.lookup(key)
+	if !ok {
+		// Not a survey-related key, ACK and skip
+		r.logger.With("key", key).Debug("skipping KV entry - unsupported type")
+		return false
+	}
+
+	start := time.Now()
+	ctx = eventing.WithSourceRevision(ctx, entry.Revision())
+	operation, retry := r.handle(ctx, entry, h, uid)
+	outcome := "ack"
+	if retry {
+		outcome = "retry"
+	}
+	attrs := metric.WithAttributes(
+		attribute.String("object_type", h.ObjectType()),
+		attribute.String("operation", operation),
+	)
+	r.events.Add(ctx, 1, attrs, metric.WithAttributes(attribute.String("outcome", outcome)))
+	r.duration.Record(ctx, time.Since(start).Seconds(), attrs)
+	return retry
+}
+
+// handle runs the handler for an entry and reports which operation it performed
+func (r *KVHandlerRegistry) handle(ctx context.Context, entry jetstream.KeyValueEntry, h KVObjectHandler, uid string) (string, bool) {
+	key := entry.Key()
+
+	switch entry.Operation() {
+	case jetstream.KeyValuePut:
+	case jetstream.KeyValueDelete, jetstream.KeyValuePurge:
+		r.logger.With("key", key, "operation", entry.Operation()).InfoContext(ctx, "processing hard delete from KV bucket")
+		return "delete", r.handleDelete(ctx, key, h, uid)
+	default:
+		r.logger.With("key", key, "operation", entry.Operation()).Debug("ignoring unknown KV operation")
+		return "ignored", false // ACK unknown operations
+	}
+
+	// Parse the data (try JSON first, then msgpack)
+	v1Data, err := decodeKVValue(entry.Value())
+	if err != nil {
+		r.logger.With(errKey, err, "key", key).ErrorContext(ctx, "failed to unmarshal KV entry data as JSON or msgpack")
+		recordPermanentFailure(ctx, err)
+		return "update", false
+	}
+
+	// Check if this is a soft delete (record has _sdc_deleted_at field).
+	if deletedAt, exists := v1Data["_sdc_deleted_at"]; exists && deletedAt != nil && deletedAt != "" {
+		r.logger.With("key", key, "_sdc_deleted_at", deletedAt).InfoContext(ctx, "processing soft delete from KV bucket")
+		return "delete", r.handleDelete(ctx, key, h, uid)
+	}
+
+	return "update", h.HandleUpdate(ctx, key, v1Data)
+}
+
+func (r *KVHandlerRegistry) handleDelete(ctx context.Context, key string, h KVObjectHandler, uid string) bool {
+	if uid == "" {
+		r.logger.With("key", key).WarnContext(ctx, "cannot extract UID from key for deletion")
+		return false
+	}
+	// Skip if already tombstoned — prevents duplicate delete events on redelivery
+	if r.tombstoned(ctx, h.MappingKey(uid)) {
+		r.logger.With("key", key).DebugContext(ctx, "delete already processed, skipping")
+		return false
+	}
+	return h.HandleDelete(ctx, uid)
+}
+
+// tombstoned reports whether the mapping under mappingKey records a processed delete
+func (r *KVHandlerRegistry) tombstoned(ctx context.Context, mappingKey string) bool {
+	if r.mappingsKV == nil {
+		return false
+	}
+	entry, err := r.mappingsKV.Get(ctx, mappingKey)
+	return err == nil && isTombstonedMapping(entry.Value())
+}
+
+// kvHandlerDeps are the clients shared by the object handlers
+type kvHandlerDeps struct {
+	publisher   domain.EventPublisher
+	idMapper    domain.IDMapper
+	mappingsKV  jetstream.KeyValue
+	v1ObjectsKV jetstream.KeyValue
+	logger      *slog.Logger
+}
+
+// newSurveyKVHandlerRegistry registers the survey object handlers: templates and surveys
+// before the responses and exclusions that depend on them. inviteHandler may be nil to
+// disable invites.
+func newSurveyKVHandlerRegistry(deps kvHandlerDeps, inviteHandler *SurveyResponseInviteHandler) (*KVHandlerRegistry, error) {
+	r, err := NewKVHandlerRegistry(deps.logger,
+		&surveyTemplateKVHandler{deps},
+		&surveyKVHandler{deps},
+		&surveyResponseKVHandler{kvHandlerDeps: deps, inviteHandler: inviteHandler},
+		&surveyExclusionKVHandler{deps},
+	)
+	if err != nil {
+		return nil, err
+	}
+	r.mappingsKV = deps.mappingsKV
+	return r, nil
+}
@@ -0,0 +1,155 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeObjectHandler records the calls routed to it
+type fakeObjectHandler struct {
+	prefix  string
+	retry   bool
+	updates []string
+	deletes []string
+}
+
+func (h *fakeObjectHandler) Prefix() string               { return h.prefix }
+func (h *fakeObjectHandler) ObjectType() string           { return "fake" }
+func (h *fakeObjectHandler) MappingKey(uid string) string { return "fake." + uid }
+
+func (h *fakeObjectHandler) HandleUpdate(_ context.Context, key string, _ map[string]any) bool {
+	h.updates = append(h.updates, key)
+	return h.retry
+}
+
+func (h *fakeObjectHandler) HandleDelete(_ context.Context, uid string) bool {
+	h.deletes = append(h.deletes, uid)
+	return h.retry
+}
+
+// opEntry is a KV entry with a configurable operation
+type opEntry struct {
+	mockKeyValueEntry
+	op jetstream.KeyValueOp
+}
+
+func (e opEntry) Operation() jetstream.KeyValueOp { return e.op }
+
+func TestKVHandlerRegistry_Register(t *testing.T) {
+	r, err := NewKVHandlerRegistry(slog.Default(),
+		&fakeObjectHandler{prefix: "parents"},
+		&fakeObjectHandler{prefix: "children"},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"parents", "children"}, r.Prefixes())
+	assert.Equal(t, []string{
+		"$KV.v1-objects.parents.>",
+		"$KV.v1-objects.children.>",
+	}, r.FilterSubjects())
+
+	assert.Error(t, r.Register(&fakeObjectHandler{prefix: "parents"}), "duplicate prefix")
+	assert.Error(t, r.Register(&fakeObjectHandler{prefix: ""}), "empty prefix")
+	assert.Error(t, r.Register(&fakeObjectHandler{prefix: "a.b"}), "prefix with a dot")
+	assert.Len(t, r.Prefixes(), 2)
+}
+
+func TestKVHandlerRegistry_SurveyHandlers(t *testing.T) {
+	r, err := newSurveyKVHandlerRegistry(kvHandlerDeps{logger: slog.Default()}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, testReindexObjectTypes, r.Prefixes())
+}
+
+func TestKVHandlerRegistry_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		entry       jetstream.KeyValueEntry
+		wantUpdates []string
+		wantDeletes []string
+	}{
+		{
+			name:        "put",
+			entry:       opEntry{mockKeyValueEntry{key: "things.t-1", value: []byte(`{"name":"one"}`)}, jetstream.KeyValuePut},
+			wantUpdates: []string{"things.t-1"},
+		},
+		{
+			name:        "soft delete",
+			entry:       opEntry{mockKeyValueEntry{key: "things.t-1", value: []byte(`{"_sdc_deleted_at":"2025-01-01T00:00:00Z"}`)}, jetstream.KeyValuePut},
+			wantDeletes: []string{"t-1"},
+		},
+		{
+			name:        "hard delete",
+			entry:       opEntry{mockKeyValueEntry{key: "things.t-1"}, jetstream.KeyValueDelete},
+			wantDeletes: []string{"t-1"},
+		},
+		{
+			name:        "purge",
+			entry:       opEntry{mockKeyValueEntry{key: "things.t-1"}, jetstream.KeyValuePurge},
+			wantDeletes: []string{"t-1"},
+		},
+		{
+			name:  "delete without a UID",
+			entry: opEntry{mockKeyValueEntry{key: "things"}, jetstream.KeyValueDelete},
+		},
+		{
+			name:  "unregistered prefix",
+			entry: opEntry{mockKeyValueEntry{key: "others.o-1", value: []byte(`{}`)}, jetstream.KeyValuePut},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &fakeObjectHandler{prefix: "things"}
+			r, err := NewKVHandlerRegistry(slog.Default(), h)
+			require.NoError(t, err)
+
+			assert.False(t, r.Handle(ctx, tt.entry))
+			assert.Equal(t, tt.wantUpdates, h.updates)
+			assert.Equal(t, tt.wantDeletes, h.deletes)
+		})
+	}
+}
+
+func TestKVHandlerRegistry_HandleRetryAndDecodeFailure(t *testing.T) {
+	h := &fakeObjectHandler{prefix: "things", retry: true}
+	r, err := NewKVHandlerRegistry(slog.Default(), h)
+	require.NoError(t, err)
+
+	// The handler's retry decision is passed through
+	assert.True(t, r.Handle(context.Background(), opEntry{mockKeyValueEntry{key: "things.t-1", value: []byte(`{}`)}, jetstream.KeyValuePut}))
+
+	// An undecodable value is ACKed and recorded as a permanent failure without reaching the handler
+	ctx, rec := withFailureRecorder(context.Background())
+	assert.False(t, r.Handle(ctx, opEntry{mockKeyValueEntry{key: "things.t-2", value: []byte{0xc1}}, jetstream.KeyValuePut}))
+	assert.Equal(t, []string{"things.t-1"}, h.updates)
+	assert.Error(t, rec.err)
+}
+
+func TestKVHandlerRegistry_SkipsTombstonedDeletes(t *testing.T) {
+	_, mappings := setupEventBuckets(t)
+	ctx := context.Background()
+	h := &fakeObjectHandler{prefix: "things"}
+	r, err := NewKVHandlerRegistry(slog.Default(), h)
+	require.NoError(t, err)
+	r.mappingsKV = mappings
+
+	_, err = mappings.Put(ctx, h.MappingKey("t-1"), []byte(tombstoneMarker))
+	require.NoError(t, err)
+	_, err = mappings.Put(ctx, h.MappingKey("t-2"), []byte(syncedMarker))
+	require.NoError(t, err)
+
+	assert.False(t, r.Handle(ctx, opEntry{mockKeyValueEntry{key: "things.t-1"}, jetstream.KeyValueDelete}))
+	assert.False(t, r.Handle(ctx, opEntry{mockKeyValueEntry{key: "things.t-2"}, jetstream.KeyValueDelete}))
+	assert.False(t, r.Handle(ctx, opEntry{mockKeyValueEntry{key: "things.t-3"}, jetstream.KeyValueDelete}))
+	assert.Equal(t, []string{"t-2", "t-3"}, h.deletes, "the tombstoned delete is skipped")
+}
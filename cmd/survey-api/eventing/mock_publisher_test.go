@@ -0,0 +1,99 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"sync"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+)
+
+// publishedEvent is one call recorded by recordingPublisher
+type publishedEvent struct {
+	action    string
+	survey    *domain.SurveyData
+	response  *domain.SurveyResponseData
+	template  *domain.SurveyTemplateData
+	exclusion *domain.SurveyExclusionData
+	domain    *domain.DomainEvent
+	// resultsViewer is set for results_viewer grants and revocations
+	resultsViewer *resultsViewerChange
+}
+
+// resultsViewerChange is one PublishSurveyResultsViewer call
+type resultsViewerChange struct {
+	surveyUID string
+	username  string
+	granted   bool
+}
+
+// recordingPublisher is a domain.EventPublisher that records every event it is given.
+type recordingPublisher struct {
+	mu     sync.Mutex
+	events []publishedEvent
+	err    error
+}
+
+func (p *recordingPublisher) record(e publishedEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err != nil {
+		return p.err
+	}
+	p.events = append(p.events, e)
+	return nil
+}
+
+func (p *recordingPublisher) PublishSurveyEvent(_ context.Context, action string, survey *domain.SurveyData) error {
+	return p.record(publishedEvent{action: action, survey: survey})
+}
+
+func (p *recordingPublisher) PublishSurveyResponseEvent(_ context.Context, action string, response *domain.SurveyResponseData) error {
+	return p.record(publishedEvent{action: action, response: response})
+}
+
+func (p *recordingPublisher) PublishSurveyTemplateEvent(_ context.Context, action string, template *domain.SurveyTemplateData) error {
+	return p.record(publishedEvent{action: action, template: template})
+}
+
+func (p *recordingPublisher) PublishSurveyExclusionEvent(_ context.Context, action string, exclusion *domain.SurveyExclusionData) error {
+	return p.record(publishedEvent{action: action, exclusion: exclusion})
+}
+
+func (p *recordingPublisher) PublishDomainEvent(_ context.Context, event *domain.DomainEvent) error {
+	return p.record(publishedEvent{action: event.Type, domain: event})
+}
+
+func (p *recordingPublisher) PublishSurveyResultsViewer(_ context.Context, surveyUID, username string, granted bool) error {
+	return p.record(publishedEvent{resultsViewer: &resultsViewerChange{surveyUID: surveyUID, username: username, granted: granted}})
+}
+
+func (p *recordingPublisher) Close() error { return nil }
+
+// responses returns the recorded survey response events
+func (p *recordingPublisher) responses() []*domain.SurveyResponseData {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var out []*domain.SurveyResponseData
+	for _, e := range p.events {
+		if e.response != nil {
+			out = append(out, e.response)
+		}
+	}
+	return out
+}
+
+// resultsViewerChanges returns the recorded results_viewer grants and revocations
+func (p *recordingPublisher) resultsViewerChanges() []resultsViewerChange {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var out []resultsViewerChange
+	for _, e := range p.events {
+		if e.resultsViewer != nil {
+			out = append(out, *e.resultsViewer)
+		}
+	}
+	return out
+}
@@ -0,0 +1,251 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	defaultReindexRatePerSecond = 50
+	maxReindexRatePerSecond     = 1000
+
+	// maxReindexFailedKeys caps the failed keys kept on the status
+	maxReindexFailedKeys = 100
+
+	// reindexProgressInterval is how many scanned keys pass between progress log lines
+	reindexProgressInterval = 1000
+)
+
+// Reindexer replays v1-objects entries through the KV handlers, for rebuilding
+// downstream indexes that the DeliverLastPerSubject consumer will not redeliver
+type Reindexer struct {
+	v1ObjectsKV jetstream.KeyValue
+	// objectTypes are the v1-objects key prefixes covered, in processing order
+	objectTypes []string
+	handle      func(ctx context.Context, entry jetstream.KeyValueEntry) bool
+	logger      *slog.Logger
+
+	runs *backgroundRun[domain.ReindexStatus]
+}
+
+// newReindexer creates a reindexer over objectTypes, visited in the given order so parents
+// are replayed before their children; handle is the KV handler each matching entry is run through
+func newReindexer(v1ObjectsKV jetstream.KeyValue, objectTypes []string, handle func(ctx context.Context, entry jetstream.KeyValueEntry) bool, logger *slog.Logger) *Reindexer {
+	return &Reindexer{
+		v1ObjectsKV: v1ObjectsKV,
+		objectTypes: objectTypes,
+		handle:      handle,
+		logger:      logger,
+		runs:        newBackgroundRun("reindex", cloneReindexStatus),
+	}
+}
+
+// Start validates the options and starts a run in the background. The run outlives the
+// request that started it; it is stopped by Cancel or when the processor shuts down.
+func (r *Reindexer) Start(ctx context.Context, opts domain.ReindexOptions) (*domain.ReindexStatus, error) {
+	if err := normalizeReindexOptions(&opts, r.objectTypes); err != nil {
+		return nil, err
+	}
+	return r.runs.start(ctx, &domain.ReindexStatus{
+		Options:   opts,
+		State:     domain.ReindexStateRunning,
+		StartedAt: time.Now().UTC(),
+	}, func(ctx context.Context) { r.run(ctx, opts) })
+}
+
+// Status returns the current or most recent run
+func (r *Reindexer) Status(_ context.Context) (*domain.ReindexStatus, error) {
+	return r.runs.current()
+}
+
+// Cancel stops the running reindex and waits for it to wind down
+func (r *Reindexer) Cancel(ctx context.Context) (*domain.ReindexStatus, error) {
+	return r.runs.cancelRun(ctx)
+}
+
+// Stop cancels any running reindex and waits for it, for use during shutdown
+func (r *Reindexer) Stop() {
+	r.runs.stop()
+}
+
+func cloneReindexStatus(status *domain.ReindexStatus) *domain.ReindexStatus {
+	s := *status
+	s.Options.KeyPrefixes = slices.Clone(s.Options.KeyPrefixes)
+	s.FailedKeys = slices.Clone(s.FailedKeys)
+	return &s
+}
+
+func (r *Reindexer) run(ctx context.Context, opts domain.ReindexOptions) {
+	logger := r.logger.With("handler", "reindex", "dry_run", opts.DryRun, "force", opts.Force, "key_prefixes", opts.KeyPrefixes)
+	logger.InfoContext(ctx, "reindex started")
+
+	err := r.replay(ctx, opts, logger)
+
+	r.runs.update(func(s *domain.ReindexStatus) {
+		s.FinishedAt = time.Now().UTC()
+		s.State = finalState(err, domain.ReindexStateCompleted, domain.ReindexStateCancelled, domain.ReindexStateFailed)
+		if s.State == domain.ReindexStateFailed {
+			s.Error = err.Error()
+		}
+	})
+
+	final := r.runs.snapshot()
+	logger = logger.With("state", final.State, "total", final.Total, "matched", final.Matched,
+		"processed", final.Processed, "failed", final.Failed)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		logger.With(errKey, err).ErrorContext(ctx, "reindex failed")
+		return
+	}
+	logger.InfoContext(ctx, "reindex finished")
+}
+
+// replay lists the matching keys, then runs each through the handlers at the configured rate
+func (r *Reindexer) replay(ctx context.Context, opts domain.ReindexOptions, logger *slog.Logger) error {
+	keys, err := r.listKeys(ctx, opts.KeyPrefixes)
+	if err != nil {
+		return err
+	}
+	r.runs.update(func(s *domain.ReindexStatus) { s.Total = len(keys) })
+	logger.With("total", len(keys)).InfoContext(ctx, "reindex keys listed")
+
+	limiter := time.NewTicker(time.Second / time.Duration(opts.RatePerSecond))
+	defer limiter.Stop()
+
+	for i, key := range keys {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if i > 0 && i%reindexProgressInterval == 0 {
+			s := r.runs.snapshot()
+			logger.With("scanned", s.Scanned, "total", s.Total, "processed", s.Processed, "failed", s.Failed).
+				InfoContext(ctx, "reindex progress")
+		}
+
+		entry, err := r.v1ObjectsKV.Get(ctx, key)
+		if errors.Is(err, jetstream.ErrKeyNotFound) || errors.Is(err, jetstream.ErrKeyDeleted) {
+			// Deleted since it was listed; the delete event covers it.
+			r.runs.update(func(s *domain.ReindexStatus) { s.Scanned++ })
+			continue
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			logger.With(errKey, err, "key", key).WarnContext(ctx, "failed to read entry for reindex")
+			r.runs.update(func(s *domain.ReindexStatus) { s.Scanned++; recordReindexFailure(s, key) })
+			continue
+		}
+
+		matched := inReindexTimeRange(entry.Created(), opts)
+		r.runs.update(func(s *domain.ReindexStatus) {
+			s.Scanned++
+			if matched {
+				s.Matched++
+			}
+		})
+		if !matched || opts.DryRun {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-limiter.C:
+		}
+
+		handlerCtx, rec := withFailureRecorder(ctx)
+		if opts.Force {
+			handlerCtx = withForceRepublish(handlerCtx)
+		}
+		retry := r.handle(handlerCtx, entry)
+		if retry || rec.err != nil {
+			logger.With("key", key, "retry", retry).WarnContext(ctx, "reindex entry failed")
+			r.runs.update(func(s *domain.ReindexStatus) { recordReindexFailure(s, key) })
+			continue
+		}
+		r.runs.update(func(s *domain.ReindexStatus) { s.Processed++ })
+	}
+	return nil
+}
+
+// listKeys returns the keys to replay, grouped in objectTypes order
+func (r *Reindexer) listKeys(ctx context.Context, prefixes []string) ([]string, error) {
+	var keys []string
+	for _, objectType := range r.objectTypes {
+		typePrefixes := reindexPrefixesForType(prefixes, objectType)
+		if prefixes != nil && len(typePrefixes) == 0 {
+			continue
+		}
+
+		typeKeys, err := listKVKeys(ctx, r.v1ObjectsKV, objectType+".>")
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s keys: %w", objectType, err)
+		}
+		for _, key := range typeKeys {
+			if len(typePrefixes) == 0 || slices.ContainsFunc(typePrefixes, func(p string) bool { return strings.HasPrefix(key, p) }) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys, nil
+}
+
+// reindexPrefixesForType returns the requested prefixes that belong to objectType
+func reindexPrefixesForType(prefixes []string, objectType string) []string {
+	var out []string
+	for _, p := range prefixes {
+		if t, _, _ := strings.Cut(p, "."); t == objectType {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+func inReindexTimeRange(modified time.Time, opts domain.ReindexOptions) bool {
+	if !opts.ModifiedSince.IsZero() && modified.Before(opts.ModifiedSince) {
+		return false
+	}
+	if !opts.ModifiedUntil.IsZero() && !modified.Before(opts.ModifiedUntil) {
+		return false
+	}
+	return true
+}
+
+func recordReindexFailure(s *domain.ReindexStatus, key string) {
+	s.Failed++
+	if len(s.FailedKeys) < maxReindexFailedKeys {
+		s.FailedKeys = append(s.FailedKeys, key)
+	}
+}
+
+// normalizeReindexOptions validates the options and fills in defaults
+func normalizeReindexOptions(opts *domain.ReindexOptions, objectTypes []string) error {
+	for _, p := range opts.KeyPrefixes {
+		objectType, _, _ := strings.Cut(p, ".")
+		if !slices.Contains(objectTypes, objectType) {
+			return domain.NewValidationError(fmt.Sprintf("key prefix %q must start with one of %s", p, strings.Join(objectTypes, ", ")))
+		}
+	}
+	if len(opts.KeyPrefixes) == 0 {
+		opts.KeyPrefixes = nil
+	}
+	if !opts.ModifiedSince.IsZero() && !opts.ModifiedUntil.IsZero() && !opts.ModifiedSince.Before(opts.ModifiedUntil) {
+		return domain.NewValidationError("modified_since must be before modified_until")
+	}
+	if opts.RatePerSecond <= 0 {
+		opts.RatePerSecond = defaultReindexRatePerSecond
+	}
+	opts.RatePerSecond = min(opts.RatePerSecond, maxReindexRatePerSecond)
+	return nil
+}
@@ -0,0 +1,154 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testReindexObjectTypes matches the registration order of the survey handlers
+var testReindexObjectTypes = []string{"surveymonkey-surveys", "itx-surveys", "itx-survey-responses", "itx-survey-exclusions"}
+
+// runReindex starts a run and waits for it to finish
+func runReindex(t *testing.T, r *Reindexer, opts domain.ReindexOptions) *domain.ReindexStatus {
+	t.Helper()
+	ctx := context.Background()
+	_, err := r.Start(ctx, opts)
+	require.NoError(t, err)
+
+	var status *domain.ReindexStatus
+	require.Eventually(t, func() bool {
+		status, err = r.Status(ctx)
+		return err == nil && status.State != domain.ReindexStateRunning
+	}, 5*time.Second, 10*time.Millisecond)
+	return status
+}
+
+func TestReindexer(t *testing.T) {
+	objects, _ := setupEventBuckets(t)
+	ctx := context.Background()
+	for _, key := range []string{
+		"itx-survey-responses.r-1",
+		"itx-surveys.s-1",
+		"surveymonkey-surveys.t-1",
+		"itx-survey-responses.r-bad",
+		"itx-surveys.s-2",
+		"unrelated.x-1",
+	} {
+		_, err := objects.Put(ctx, key, []byte(`{}`))
+		require.NoError(t, err)
+	}
+	_, err := objects.Put(ctx, "itx-surveys.s-deleted", []byte(`{}`))
+	require.NoError(t, err)
+	require.NoError(t, objects.Delete(ctx, "itx-surveys.s-deleted"))
+
+	var mu sync.Mutex
+	var handled []string
+	r := newReindexer(objects, testReindexObjectTypes, func(ctx context.Context, entry jetstream.KeyValueEntry) bool {
+		mu.Lock()
+		handled = append(handled, entry.Key())
+		mu.Unlock()
+		if entry.Key() == "itx-survey-responses.r-bad" {
+			recordPermanentFailure(ctx, errors.New("bad payload"))
+		}
+		return false
+	}, slog.Default())
+	t.Cleanup(r.Stop)
+
+	t.Run("all object types, parents first", func(t *testing.T) {
+		handled = nil
+		status := runReindex(t, r, domain.ReindexOptions{RatePerSecond: 1000})
+
+		assert.Equal(t, domain.ReindexStateCompleted, status.State)
+		assert.Equal(t, 5, status.Total)
+		assert.Equal(t, 5, status.Matched)
+		assert.Equal(t, 4, status.Processed)
+		assert.Equal(t, 1, status.Failed)
+		assert.Equal(t, []string{"itx-survey-responses.r-bad"}, status.FailedKeys)
+		assert.False(t, status.FinishedAt.IsZero())
+
+		require.Len(t, handled, 5)
+		assert.Equal(t, "surveymonkey-surveys.t-1", handled[0])
+		assert.ElementsMatch(t, []string{"itx-surveys.s-1", "itx-surveys.s-2"}, handled[1:3])
+	})
+
+	t.Run("key prefix", func(t *testing.T) {
+		handled = nil
+		status := runReindex(t, r, domain.ReindexOptions{KeyPrefixes: []string{"itx-surveys.s-2", "itx-survey-responses."}, RatePerSecond: 1000})
+
+		assert.Equal(t, 3, status.Total)
+		assert.ElementsMatch(t, []string{"itx-surveys.s-2", "itx-survey-responses.r-1", "itx-survey-responses.r-bad"}, handled)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		handled = nil
+		status := runReindex(t, r, domain.ReindexOptions{DryRun: true, RatePerSecond: 1000})
+
+		assert.Equal(t, 5, status.Matched)
+		assert.Zero(t, status.Processed)
+		assert.Empty(t, handled)
+	})
+
+	t.Run("time range", func(t *testing.T) {
+		handled = nil
+		status := runReindex(t, r, domain.ReindexOptions{ModifiedSince: time.Now().Add(time.Hour), RatePerSecond: 1000})
+
+		assert.Equal(t, 5, status.Scanned)
+		assert.Zero(t, status.Matched)
+		assert.Empty(t, handled)
+	})
+}
+
+func TestReindexer_StartValidationAndCancel(t *testing.T) {
+	objects, _ := setupEventBuckets(t)
+	ctx := context.Background()
+	for _, key := range []string{"itx-surveys.s-1", "itx-surveys.s-2", "itx-surveys.s-3"} {
+		_, err := objects.Put(ctx, key, []byte(`{}`))
+		require.NoError(t, err)
+	}
+
+	release := make(chan struct{})
+	r := newReindexer(objects, testReindexObjectTypes, func(ctx context.Context, _ jetstream.KeyValueEntry) bool {
+		select {
+		case <-release:
+		case <-ctx.Done():
+		}
+		return false
+	}, slog.Default())
+	t.Cleanup(func() { close(release); r.Stop() })
+
+	_, err := r.Status(ctx)
+	assert.Equal(t, domain.ErrorTypeNotFound, domain.GetErrorType(err))
+
+	_, err = r.Start(ctx, domain.ReindexOptions{KeyPrefixes: []string{"itx-projects."}})
+	assert.Equal(t, domain.ErrorTypeValidation, domain.GetErrorType(err))
+
+	now := time.Now()
+	_, err = r.Start(ctx, domain.ReindexOptions{ModifiedSince: now, ModifiedUntil: now.Add(-time.Hour)})
+	assert.Equal(t, domain.ErrorTypeValidation, domain.GetErrorType(err))
+
+	status, err := r.Start(ctx, domain.ReindexOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, defaultReindexRatePerSecond, status.Options.RatePerSecond)
+
+	_, err = r.Start(ctx, domain.ReindexOptions{})
+	assert.Equal(t, domain.ErrorTypeConflict, domain.GetErrorType(err))
+
+	status, err = r.Cancel(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ReindexStateCancelled, status.State)
+
+	_, err = r.Cancel(ctx)
+	assert.Equal(t, domain.ErrorTypeConflict, domain.GetErrorType(err))
+}
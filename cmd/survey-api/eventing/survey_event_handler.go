@@ -291,6 +291,27 @@ func (c *SurveyCommitteeDBRaw) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// surveyKVHandler syncs itx-surveys records
+type surveyKVHandler struct {
+	kvHandlerDeps
+}
+
+func (h *surveyKVHandler) Prefix() string               { return "itx-surveys" }
+func (h *surveyKVHandler) ObjectType() string           { return objectTypeSurvey }
+func (h *surveyKVHandler) MappingKey(uid string) string { return surveyMappingKey(uid) }
+
+func (h *surveyKVHandler) HandleUpdate(ctx context.Context, key string, v1Data map[string]any) bool {
+	return handleSurveyUpdate(ctx, key, v1Data, h.publisher, h.idMapper, h.mappingsKV, h.v1ObjectsKV, h.logger)
+}
+
+func (h *surveyKVHandler) HandleDelete(ctx context.Context, uid string) bool {
+	return handleSurveyDelete(ctx, uid, h.publisher, h.mappingsKV, h.logger)
+}
+
+func surveyMappingKey(uid string) string {
+	return fmt.Sprintf("survey.%s", uid)
+}
+
 // handleSurveyUpdate processes a survey update from itx-surveys records
 // Returns true if the message should be retried (NAK), false if it should be acknowledged (ACK)
 func handleSurveyUpdate(
@@ -300,6 +321,7 @@ func handleSurveyUpdate(
 	publisher domain.EventPublisher,
 	idMapper domain.IDMapper,
 	mappingsKV jetstream.KeyValue,
+	v1ObjectsKV jetstream.KeyValue,
 	logger *slog.Logger,
 ) bool {
 	funcLogger := logger.With("key", key, "handler", "survey")
@@ -310,6 +332,7 @@ func handleSurveyUpdate(
 	surveyData, err := convertMapToSurveyData(ctx, v1Data, idMapper, funcLogger)
 	if err != nil {
 		funcLogger.With(errKey, err).ErrorContext(ctx, "failed to convert v1Data to survey")
+		recordPermanentFailure(ctx, err)
 		return false // Permanent error, ACK and skip
 	}
 
@@ -334,11 +357,27 @@ func handleSurveyUpdate(
 		return false // Permanent issue, ACK and skip
 	}
 
-	// Determine action (created vs updated) by checking if mapping exists
-	mappingKey := fmt.Sprintf("survey.%s", surveyData.UID)
+	// Determine action (created vs updated) by checking if mapping exists.
+	// A tombstoned mapping means the survey was deleted and has been restored in v1.
+	mappingKey := surveyMappingKey(surveyData.UID)
+	hash := contentHash(surveyData)
 	indexerAction := indexerConstants.ActionCreated
-	if _, err := mappingsKV.Get(ctx, mappingKey); err == nil {
+	restored := false
+	if entry, err := mappingsKV.Get(ctx, mappingKey); err == nil && isTombstonedMapping(entry.Value()) {
+		restored = true
+		funcLogger.InfoContext(ctx, "survey restored after delete, recreating index document and access")
+		if err := markSurveyResponsesForRestore(ctx, mappingsKV, surveyData.UID); err != nil {
+			funcLogger.With(errKey, err).WarnContext(ctx, "failed to mark survey responses for restore, will retry")
+			return true // NAK for retry
+		}
+	} else if err == nil {
 		indexerAction = indexerConstants.ActionUpdated
+		if isUnchangedContent(ctx, entry.Value(), hash) {
+			funcLogger.DebugContext(ctx, "survey content unchanged, skipping publish")
+			recordSkippedUnchanged(ctx, objectTypeSurvey)
+			// A previous delivery may have published the survey but not finished the fan-out.
+			return refreshSurveyResponses(ctx, surveyData, publisher, idMapper, mappingsKV, v1ObjectsKV, logger)
+		}
 	}
 
 	// Publish to indexer and FGA-sync
@@ -351,14 +390,27 @@ func handleSurveyUpdate(
 		return false // Permanent error, ACK and skip
 	}
 
-	// Store mapping to track that we've seen this survey
-	if _, err := mappingsKV.Put(ctx, mappingKey, []byte("1")); err != nil {
+	recordPublished(ctx, objectTypeSurvey)
+	if restored {
+		recordRestored(ctx, objectTypeSurvey)
+	}
+
+	// Derived events go out before the mapping is stored, so a retry still sees the change.
+	if publishSurveyDomainEvents(ctx, surveyData, hash, indexerAction, publisher, mappingsKV, funcLogger) {
+		return true // NAK for retry
+	}
+
+	// Store the content hash to track that we've seen this survey and what was published
+	if _, err := mappingsKV.Put(ctx, mappingKey, mappingValue(hash)); err != nil {
 		funcLogger.With(errKey, err).WarnContext(ctx, "failed to store survey mapping")
 		// Don't retry on mapping storage failures
 	}
 
 	funcLogger.InfoContext(ctx, "successfully sent survey indexer and access messages")
-	return false // Success, ACK the message
+
+	// Responses carry copies of the survey's display fields; refresh them if those changed
+	// or the survey was just restored.
+	return refreshSurveyResponses(ctx, surveyData, publisher, idMapper, mappingsKV, v1ObjectsKV, logger)
 }
 
 // convertMapToSurveyData converts v1 survey data to v2 format with proper types and UIDs
@@ -481,12 +533,7 @@ func handleSurveyDelete(
 
 	funcLogger.DebugContext(ctx, "processing survey delete")
 
-	// Skip if already tombstoned — prevents duplicate delete events on redelivery
-	mappingKey := fmt.Sprintf("survey.%s", uid)
-	if entry, err := mappingsKV.Get(ctx, mappingKey); err == nil && isTombstonedMapping(entry.Value()) {
-		funcLogger.DebugContext(ctx, "survey delete already processed, skipping")
-		return false
-	}
+	mappingKey := surveyMappingKey(uid)
 
 	// Create minimal survey data for delete event
 	surveyData := &domain.SurveyData{
@@ -523,8 +570,10 @@ func isTransientError(err error) bool {
 	// Check known NATS sentinel errors that indicate infrastructure unavailability.
 	// ErrNoResponders fires when JetStream has no active responders (e.g., during a
 	// rolling restart or brief outage) and should always retry rather than silently
-	// index incomplete data.
+	// index incomplete data. ErrNoStreamResponse is a JetStream publish that no stream
+	// acknowledged, which in JetStream publish mode means the message may not be stored.
 	if errors.Is(err, nats.ErrNoResponders) ||
+		errors.Is(err, jetstream.ErrNoStreamResponse) ||
 		errors.Is(err, nats.ErrTimeout) ||
 		errors.Is(err, nats.ErrConnectionClosed) ||
 		errors.Is(err, nats.ErrConnectionDraining) {
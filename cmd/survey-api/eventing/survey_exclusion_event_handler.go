@@ -0,0 +1,285 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	indexerConstants "github.com/linuxfoundation/lfx-v2-indexer-service/pkg/constants"
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// SurveyExclusionDBRaw represents raw survey exclusion data from v1 DynamoDB/NATS KV bucket
+type SurveyExclusionDBRaw struct {
+	ID              string `json:"id"`
+	Email           string `json:"email"`
+	UserID          string `json:"user_id"`
+	SurveyID        string `json:"survey_id"`
+	CommitteeID     string `json:"committee_id"` // v1 SFID
+	GlobalExclusion bool   `json:"global_exclusion"`
+	CreatedAt       string `json:"created_at"`
+	LastModifiedAt  string `json:"last_modified_at"`
+}
+
+// UnmarshalJSON implements custom unmarshaling to handle both string and bool inputs for global_exclusion.
+func (e *SurveyExclusionDBRaw) UnmarshalJSON(data []byte) error {
+	tmp := struct {
+		ID              string      `json:"id"`
+		Email           string      `json:"email"`
+		UserID          string      `json:"user_id"`
+		SurveyID        string      `json:"survey_id"`
+		CommitteeID     string      `json:"committee_id"`
+		GlobalExclusion interface{} `json:"global_exclusion"`
+		CreatedAt       string      `json:"created_at"`
+		LastModifiedAt  string      `json:"last_modified_at"`
+	}{}
+
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	e.ID = tmp.ID
+	e.Email = tmp.Email
+	e.UserID = tmp.UserID
+	e.SurveyID = tmp.SurveyID
+	e.CommitteeID = tmp.CommitteeID
+	e.CreatedAt = tmp.CreatedAt
+	e.LastModifiedAt = tmp.LastModifiedAt
+
+	switch val := tmp.GlobalExclusion.(type) {
+	case nil:
+	case bool:
+		e.GlobalExclusion = val
+	case string:
+		if val == "" {
+			break
+		}
+		global, err := strconv.ParseBool(val)
+		if err != nil {
+			return fmt.Errorf("failed to convert global_exclusion: %w", err)
+		}
+		e.GlobalExclusion = global
+	default:
+		return fmt.Errorf("failed to convert global_exclusion: invalid type %T", val)
+	}
+
+	return nil
+}
+
+// surveyExclusionKVHandler syncs itx-survey-exclusions records
+type surveyExclusionKVHandler struct {
+	kvHandlerDeps
+}
+
+func (h *surveyExclusionKVHandler) Prefix() string     { return "itx-survey-exclusions" }
+func (h *surveyExclusionKVHandler) ObjectType() string { return objectTypeSurveyExclusion }
+func (h *surveyExclusionKVHandler) MappingKey(uid string) string {
+	return surveyExclusionMappingKey(uid)
+}
+
+func (h *surveyExclusionKVHandler) HandleUpdate(ctx context.Context, key string, v1Data map[string]any) bool {
+	return handleSurveyExclusionUpdate(ctx, key, v1Data, h.publisher, h.idMapper, h.mappingsKV, h.logger)
+}
+
+func (h *surveyExclusionKVHandler) HandleDelete(ctx context.Context, uid string) bool {
+	return handleSurveyExclusionDelete(ctx, uid, h.publisher, h.mappingsKV, h.logger)
+}
+
+func surveyExclusionMappingKey(uid string) string {
+	return fmt.Sprintf("survey_exclusion.%s", uid)
+}
+
+// handleSurveyExclusionUpdate processes a survey exclusion update from itx-survey-exclusions records
+// Returns true if the message should be retried (NAK), false if it should be acknowledged (ACK)
+func handleSurveyExclusionUpdate(
+	ctx context.Context,
+	key string,
+	v1Data map[string]interface{},
+	publisher domain.EventPublisher,
+	idMapper domain.IDMapper,
+	mappingsKV jetstream.KeyValue,
+	logger *slog.Logger,
+) bool {
+	funcLogger := logger.With("key", key, "handler", "survey_exclusion")
+
+	funcLogger.DebugContext(ctx, "processing survey exclusion update")
+
+	exclusionData, err := convertMapToSurveyExclusionData(ctx, v1Data, idMapper, funcLogger)
+	if domain.GetErrorType(err) == domain.ErrorTypeUnavailable {
+		funcLogger.With(errKey, err).WarnContext(ctx, "committee mapping unavailable, will retry survey exclusion sync")
+		return true // NAK for retry
+	}
+	if err != nil {
+		funcLogger.With(errKey, err).ErrorContext(ctx, "failed to convert v1Data to survey exclusion")
+		recordPermanentFailure(ctx, err)
+		return false // Permanent error, ACK and skip
+	}
+
+	if exclusionData.UID == "" {
+		funcLogger.ErrorContext(ctx, "missing or invalid uid in survey exclusion data")
+		return false // Permanent error, ACK and skip
+	}
+	funcLogger = funcLogger.With("survey_exclusion_id", exclusionData.UID, "scope", exclusionData.Scope)
+
+	switch exclusionData.Scope {
+	case domain.SurveyExclusionScopeSurvey:
+		// Survey exclusions inherit access from the survey, so it must be synced first.
+		funcLogger = funcLogger.With("survey_id", exclusionData.SurveyID)
+		if entry, err := mappingsKV.Get(ctx, surveyMappingKey(exclusionData.SurveyID)); err != nil || isTombstonedMapping(entry.Value()) {
+			funcLogger.With(errKey, err).InfoContext(ctx, "parent survey not found in mappings, will retry survey exclusion sync")
+			return true // NAK for retry - survey may not be processed yet
+		}
+	case domain.SurveyExclusionScopeCommittee:
+		if exclusionData.CommitteeUID == "" {
+			funcLogger.With("committee_id", exclusionData.CommitteeID).InfoContext(ctx, "skipping survey exclusion sync - committee not found in mappings")
+			return false // Permanent issue, ACK and skip
+		}
+	}
+
+	mappingKey := surveyExclusionMappingKey(exclusionData.UID)
+	hash := contentHash(exclusionData)
+	indexerAction := indexerConstants.ActionCreated
+	restored := false
+	if entry, err := mappingsKV.Get(ctx, mappingKey); err == nil && isTombstonedMapping(entry.Value()) {
+		// The survey exclusion was deleted and has been restored in v1; recreate it.
+		restored = true
+		funcLogger.InfoContext(ctx, "survey exclusion restored after delete, recreating index document")
+	} else if err == nil {
+		indexerAction = indexerConstants.ActionUpdated
+		if isUnchangedContent(ctx, entry.Value(), hash) {
+			funcLogger.DebugContext(ctx, "survey exclusion content unchanged, skipping publish")
+			recordSkippedUnchanged(ctx, objectTypeSurveyExclusion)
+			return false // Nothing to do, ACK the message
+		}
+	}
+
+	if err := publisher.PublishSurveyExclusionEvent(ctx, string(indexerAction), exclusionData); err != nil {
+		funcLogger.With(errKey, err).ErrorContext(ctx, "failed to publish survey exclusion event")
+		if isTransientError(err) {
+			return true // NAK for retry
+		}
+		return false // Permanent error, ACK and skip
+	}
+
+	recordPublished(ctx, objectTypeSurveyExclusion)
+	if restored {
+		recordRestored(ctx, objectTypeSurveyExclusion)
+	}
+
+	if _, err := mappingsKV.Put(ctx, mappingKey, mappingValue(hash)); err != nil {
+		funcLogger.With(errKey, err).WarnContext(ctx, "failed to store survey exclusion mapping")
+	}
+
+	funcLogger.InfoContext(ctx, "successfully sent survey exclusion indexer and access messages")
+	return false // Success, ACK the message
+}
+
+// convertMapToSurveyExclusionData converts v1 survey exclusion data to v2 format with its scope and v2 UIDs
+func convertMapToSurveyExclusionData(
+	ctx context.Context,
+	v1Data map[string]interface{},
+	idMapper domain.IDMapper,
+	logger *slog.Logger,
+) (*domain.SurveyExclusionData, error) {
+	jsonBytes, err := json.Marshal(v1Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal v1Data to JSON: %w", err)
+	}
+
+	var raw SurveyExclusionDBRaw
+	if err := json.Unmarshal(jsonBytes, &raw); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON into SurveyExclusionDBRaw: %w", err)
+	}
+
+	scope, err := surveyExclusionScope(&raw)
+	if err != nil {
+		return nil, err
+	}
+
+	exclusionData := &domain.SurveyExclusionData{
+		UID:             raw.ID,
+		ID:              raw.ID,
+		Email:           raw.Email,
+		UserID:          raw.UserID,
+		Scope:           scope,
+		SurveyID:        raw.SurveyID,
+		SurveyUID:       raw.SurveyID, // survey_id becomes survey_uid in v2
+		CommitteeID:     raw.CommitteeID,
+		GlobalExclusion: raw.GlobalExclusion,
+		CreatedAt:       raw.CreatedAt,
+		LastModifiedAt:  raw.LastModifiedAt,
+	}
+
+	// Map v1 committee ID (SFID) to v2 committee UID
+	if raw.CommitteeID != "" {
+		committeeUID, err := idMapper.MapCommitteeV1ToV2(ctx, raw.CommitteeID)
+		switch errType := domain.GetErrorType(err); {
+		case err == nil:
+			exclusionData.CommitteeUID = committeeUID
+		case errType == domain.ErrorTypeValidation || errType == domain.ErrorTypeNotFound:
+			// The committee has no mapping; leave committee_uid unset
+			logger.With(errKey, err, "field", "committee_id", "value", raw.CommitteeID).
+				WarnContext(ctx, "failed to get v2 committee UID from v1 committee ID")
+		default:
+			// The mapping may exist; the lookup itself failed
+			return nil, fmt.Errorf("failed to map committee %s: %w", raw.CommitteeID, err)
+		}
+	}
+
+	return exclusionData, nil
+}
+
+// surveyExclusionScope returns the narrowest scope the exclusion applies to: a survey,
+// then a committee, then everything when it is flagged global
+func surveyExclusionScope(raw *SurveyExclusionDBRaw) (string, error) {
+	switch {
+	case raw.SurveyID != "":
+		return domain.SurveyExclusionScopeSurvey, nil
+	case raw.CommitteeID != "":
+		return domain.SurveyExclusionScopeCommittee, nil
+	case raw.GlobalExclusion:
+		return domain.SurveyExclusionScopeGlobal, nil
+	default:
+		return "", errors.New("survey exclusion has no survey, committee or global scope")
+	}
+}
+
+// handleSurveyExclusionDelete processes a survey exclusion delete from itx-survey-exclusions records
+// Returns true if the message should be retried (NAK), false if it should be acknowledged (ACK)
+func handleSurveyExclusionDelete(
+	ctx context.Context,
+	uid string,
+	publisher domain.EventPublisher,
+	mappingsKV jetstream.KeyValue,
+	logger *slog.Logger,
+) bool {
+	funcLogger := logger.With("survey_exclusion_id", uid, "handler", "survey_exclusion_delete")
+
+	funcLogger.DebugContext(ctx, "processing survey exclusion delete")
+
+	mappingKey := surveyExclusionMappingKey(uid)
+
+	exclusionData := &domain.SurveyExclusionData{UID: uid}
+
+	if err := publisher.PublishSurveyExclusionEvent(ctx, string(indexerConstants.ActionDeleted), exclusionData); err != nil {
+		funcLogger.With(errKey, err).ErrorContext(ctx, "failed to publish survey exclusion delete event")
+		if isTransientError(err) {
+			return true // NAK for retry
+		}
+		return false // Permanent error, ACK and skip
+	}
+
+	if _, err := mappingsKV.Put(ctx, mappingKey, []byte(tombstoneMarker)); err != nil {
+		funcLogger.With(errKey, err).WarnContext(ctx, "failed to tombstone survey exclusion mapping")
+	}
+
+	funcLogger.InfoContext(ctx, "successfully sent survey exclusion delete indexer and access messages")
+	return false // Success, ACK the message
+}
@@ -0,0 +1,142 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/infrastructure/idmapper"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertMapToSurveyExclusionData(t *testing.T) {
+	tests := []struct {
+		name      string
+		v1Data    map[string]any
+		wantScope string
+		wantErr   bool
+	}{
+		{
+			name:      "survey exclusion",
+			v1Data:    map[string]any{"id": "e-1", "email": "a@example.com", "survey_id": "s-1", "committee_id": "c-1"},
+			wantScope: domain.SurveyExclusionScopeSurvey,
+		},
+		{
+			name:      "committee exclusion",
+			v1Data:    map[string]any{"id": "e-1", "email": "a@example.com", "committee_id": "c-1", "global_exclusion": "true"},
+			wantScope: domain.SurveyExclusionScopeCommittee,
+		},
+		{
+			name:      "global exclusion as a string",
+			v1Data:    map[string]any{"id": "e-1", "user_id": "u-1", "global_exclusion": "true"},
+			wantScope: domain.SurveyExclusionScopeGlobal,
+		},
+		{
+			name:      "global exclusion as a bool",
+			v1Data:    map[string]any{"id": "e-1", "user_id": "u-1", "global_exclusion": true},
+			wantScope: domain.SurveyExclusionScopeGlobal,
+		},
+		{
+			name:    "no scope",
+			v1Data:  map[string]any{"id": "e-1", "email": "a@example.com", "global_exclusion": "false"},
+			wantErr: true,
+		},
+		{
+			name:    "unparsable global flag",
+			v1Data:  map[string]any{"id": "e-1", "global_exclusion": "sometimes"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := convertMapToSurveyExclusionData(context.Background(), tt.v1Data, idmapper.NewNoOpMapper(), slog.Default())
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "e-1", data.UID)
+			assert.Equal(t, tt.wantScope, data.Scope)
+			assert.Equal(t, data.CommitteeID, data.CommitteeUID)
+		})
+	}
+}
+
+func TestHandleSurveyExclusion(t *testing.T) {
+	_, mappings := setupEventBuckets(t)
+	ctx := context.Background()
+	publisher := &recordingPublisher{}
+	mapper := idmapper.NewNoOpMapper()
+	exclusion := map[string]any{"id": "e-1", "email": "a@example.com", "survey_id": "s-1"}
+
+	// A survey exclusion waits for its survey to be synced
+	assert.True(t, handleSurveyExclusionUpdate(ctx, "itx-survey-exclusions.e-1", exclusion, publisher, mapper, mappings, slog.Default()))
+	assert.Empty(t, publisher.events)
+
+	_, err := mappings.Put(ctx, surveyMappingKey("s-1"), []byte(syncedMarker))
+	require.NoError(t, err)
+	assert.False(t, handleSurveyExclusionUpdate(ctx, "itx-survey-exclusions.e-1", exclusion, publisher, mapper, mappings, slog.Default()))
+	require.Len(t, publisher.events, 1)
+	assert.Equal(t, "created", publisher.events[0].action)
+	assert.Equal(t, "s-1", publisher.events[0].exclusion.SurveyUID)
+
+	// Unchanged content is not republished
+	assert.False(t, handleSurveyExclusionUpdate(ctx, "itx-survey-exclusions.e-1", exclusion, publisher, mapper, mappings, slog.Default()))
+	assert.Len(t, publisher.events, 1)
+
+	assert.False(t, handleSurveyExclusionDelete(ctx, "e-1", publisher, mappings, slog.Default()))
+	require.Len(t, publisher.events, 2)
+	assert.Equal(t, "deleted", publisher.events[1].action)
+	entry, err := mappings.Get(ctx, surveyExclusionMappingKey("e-1"))
+	require.NoError(t, err)
+	assert.True(t, isTombstonedMapping(entry.Value()))
+
+	// A repeated delete is skipped
+	r, err := newSurveyKVHandlerRegistry(kvHandlerDeps{publisher: publisher, idMapper: mapper, mappingsKV: mappings, logger: slog.Default()}, nil)
+	require.NoError(t, err)
+	assert.False(t, r.Handle(ctx, opEntry{mockKeyValueEntry{key: "itx-survey-exclusions.e-1"}, jetstream.KeyValueDelete}))
+	assert.Len(t, publisher.events, 2)
+}
+
+// committeeErrMapper fails every committee v1-to-v2 lookup with err, or maps the
+// committee to itself when err is nil
+type committeeErrMapper struct {
+	domain.IDMapper
+	err error
+}
+
+func (m committeeErrMapper) MapCommitteeV1ToV2(_ context.Context, id string) (string, error) {
+	if m.err != nil {
+		return "", m.err
+	}
+	return id, nil
+}
+
+func TestHandleSurveyExclusion_CommitteeMappingErrors(t *testing.T) {
+	_, mappings := setupEventBuckets(t)
+	ctx := context.Background()
+	publisher := &recordingPublisher{}
+	exclusion := map[string]any{"id": "e-1", "email": "a@example.com", "committee_id": "c-1"}
+
+	// A lookup that could not be made is retried
+	mapper := committeeErrMapper{IDMapper: idmapper.NewNoOpMapper(), err: domain.NewUnavailableError("v1-sync-helper lookup timed out")}
+	assert.True(t, handleSurveyExclusionUpdate(ctx, "itx-survey-exclusions.e-1", exclusion, publisher, mapper, mappings, slog.Default()))
+	assert.Empty(t, publisher.events)
+
+	// A committee with no mapping is skipped
+	mapper.err = domain.NewValidationError("invalid ID: mapping not found for committee.sfid.c-1")
+	assert.False(t, handleSurveyExclusionUpdate(ctx, "itx-survey-exclusions.e-1", exclusion, publisher, mapper, mappings, slog.Default()))
+	assert.Empty(t, publisher.events)
+
+	mapper.err = nil
+	assert.False(t, handleSurveyExclusionUpdate(ctx, "itx-survey-exclusions.e-1", exclusion, publisher, mapper, mappings, slog.Default()))
+	require.Len(t, publisher.events, 1)
+	assert.Equal(t, "c-1", publisher.events[0].exclusion.CommitteeUID)
+}
@@ -0,0 +1,186 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// surveyInvitePrefix keys the LFID invite state of each survey response in the mappings
+// bucket: survey_invite.{survey_uid}.{response_uid} = JSON domain.SurveyInvite
+const surveyInvitePrefix = "survey_invite"
+
+func surveyInviteKey(surveyUID, responseUID string) string {
+	return fmt.Sprintf("%s.%s.%s", surveyInvitePrefix, surveyUID, responseUID)
+}
+
+// putSurveyInvite stores the invite state of a survey response
+func putSurveyInvite(ctx context.Context, mappingsKV jetstream.KeyValue, invite *domain.SurveyInvite) error {
+	invite.UpdatedAt = time.Now().UTC()
+	data, err := json.Marshal(invite)
+	if err != nil {
+		return fmt.Errorf("failed to marshal survey invite: %w", err)
+	}
+	_, err = mappingsKV.Put(ctx, surveyInviteKey(invite.SurveyUID, invite.SurveyResponseUID), data)
+	return err
+}
+
+func decodeSurveyInvite(value []byte) (*domain.SurveyInvite, error) {
+	var invite domain.SurveyInvite
+	if err := json.Unmarshal(value, &invite); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal survey invite: %w", err)
+	}
+	return &invite, nil
+}
+
+// SurveyInviteTracker reads and updates the invite state that SurveyResponseInviteHandler
+// records when it sends LFID invites
+type SurveyInviteTracker struct {
+	mappingsKV jetstream.KeyValue
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+func newSurveyInviteTracker(mappingsKV jetstream.KeyValue, logger *slog.Logger) *SurveyInviteTracker {
+	return &SurveyInviteTracker{
+		mappingsKV: mappingsKV,
+		logger:     logger.With("component", "survey_invite_tracker"),
+		now:        time.Now,
+	}
+}
+
+// ListSurveyInvites implements domain.SurveyInviteReader.ListSurveyInvites
+func (t *SurveyInviteTracker) ListSurveyInvites(ctx context.Context, surveyUID string) ([]domain.SurveyInvite, error) {
+	invites, err := t.loadSurveyInvites(ctx, surveyUID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := t.now()
+	result := make([]domain.SurveyInvite, 0, len(invites))
+	for _, stored := range invites {
+		invite := *stored
+		if invite.Status == domain.InviteStatusSent && invite.ExpiresAt != nil && invite.ExpiresAt.Before(now) {
+			invite.Status = domain.InviteStatusExpired
+		}
+		result = append(result, invite)
+	}
+	slices.SortFunc(result, func(a, b domain.SurveyInvite) int {
+		return cmp.Or(
+			cmp.Compare(strings.ToLower(a.Email), strings.ToLower(b.Email)),
+			cmp.Compare(a.SurveyResponseUID, b.SurveyResponseUID),
+		)
+	})
+	return result, nil
+}
+
+// MarkInviteAccepted records the acceptance of an invite for a survey. The invite is
+// matched by its UID, and responses that were invited under the acceptor's email are
+// marked as well since the new LFID covers them all: those of the same survey and those
+// of other surveys attached to the email's invite. It returns the number of invites
+// updated.
+func (t *SurveyInviteTracker) MarkInviteAccepted(ctx context.Context, surveyUID, inviteUID, email, username string, acceptedAt time.Time) (int, error) {
+	if acceptedAt.IsZero() {
+		acceptedAt = t.now()
+	}
+	acceptedAt = acceptedAt.UTC()
+
+	surveyUIDs := []string{surveyUID}
+	marker, markerRevision := t.emailInvite(ctx, email)
+	if marker != nil {
+		for _, ref := range marker.refs() {
+			if !slices.Contains(surveyUIDs, ref.SurveyUID) {
+				surveyUIDs = append(surveyUIDs, ref.SurveyUID)
+			}
+		}
+	}
+
+	updated := 0
+	for _, uid := range surveyUIDs {
+		invites, err := t.loadSurveyInvites(ctx, uid)
+		if err != nil {
+			return updated, err
+		}
+		for _, invite := range invites {
+			if invite.Status == domain.InviteStatusAccepted {
+				continue
+			}
+			if invite.InviteUID != inviteUID && !strings.EqualFold(invite.Email, email) {
+				continue
+			}
+			invite.Status = domain.InviteStatusAccepted
+			invite.AcceptedAt = &acceptedAt
+			invite.AcceptedBy = username
+			if err := putSurveyInvite(ctx, t.mappingsKV, invite); err != nil {
+				return updated, fmt.Errorf("failed to store accepted invite for survey response %s: %w", invite.SurveyResponseUID, err)
+			}
+			updated++
+		}
+	}
+
+	// The recipient has an LFID now, so later responses need no invite to attach to
+	if marker != nil {
+		if err := t.mappingsKV.Delete(ctx, emailInviteKey(email), jetstream.LastRevision(markerRevision)); err != nil {
+			t.logger.With(errKey, err).WarnContext(ctx, "failed to remove email invite marker after acceptance")
+		}
+	}
+	return updated, nil
+}
+
+// emailInvite reads the outstanding invite marker of an email and its revision, or nil
+func (t *SurveyInviteTracker) emailInvite(ctx context.Context, email string) (*emailInvite, uint64) {
+	if strings.TrimSpace(email) == "" {
+		return nil, 0
+	}
+	entry, err := t.mappingsKV.Get(ctx, emailInviteKey(email))
+	if err != nil {
+		if !errors.Is(err, jetstream.ErrKeyNotFound) {
+			t.logger.With(errKey, err).WarnContext(ctx, "failed to read email invite marker; only the accepted survey is updated")
+		}
+		return nil, 0
+	}
+	marker, err := decodeEmailInvite(entry.Value())
+	if err != nil {
+		t.logger.With(errKey, err).WarnContext(ctx, "skipping unreadable email invite marker")
+		return nil, 0
+	}
+	return marker, entry.Revision()
+}
+
+// loadSurveyInvites reads every invite record of a survey, skipping unreadable ones
+func (t *SurveyInviteTracker) loadSurveyInvites(ctx context.Context, surveyUID string) ([]*domain.SurveyInvite, error) {
+	keys, err := listKVKeys(ctx, t.mappingsKV, surveyInviteKey(surveyUID, "*"))
+	if err != nil {
+		return nil, domain.NewUnavailableError("failed to list survey invites", err)
+	}
+
+	invites := make([]*domain.SurveyInvite, 0, len(keys))
+	for _, key := range keys {
+		entry, err := t.mappingsKV.Get(ctx, key)
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, domain.NewUnavailableError("failed to read survey invite", err)
+		}
+		invite, err := decodeSurveyInvite(entry.Value())
+		if err != nil {
+			t.logger.With(errKey, err, "key", key).WarnContext(ctx, "skipping unreadable survey invite")
+			continue
+		}
+		invites = append(invites, invite)
+	}
+	return invites, nil
+}
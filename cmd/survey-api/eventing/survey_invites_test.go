@@ -0,0 +1,70 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSurveyInviteTracker(t *testing.T) {
+	_, mappings := setupEventBuckets(t)
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	past, future := now.Add(-time.Hour), now.Add(24*time.Hour)
+
+	for _, invite := range []*domain.SurveyInvite{
+		{SurveyResponseUID: "r-1", SurveyUID: "s-1", Email: "b@example.com", Status: domain.InviteStatusSent, InviteUID: "inv-1", ExpiresAt: &future},
+		{SurveyResponseUID: "r-2", SurveyUID: "s-1", Email: "a@example.com", Status: domain.InviteStatusSent, InviteUID: "inv-2", ExpiresAt: &past},
+		{SurveyResponseUID: "r-3", SurveyUID: "s-1", Email: "c@example.com", Status: domain.InviteStatusFailed, Error: "timeout"},
+		{SurveyResponseUID: "r-4", SurveyUID: "s-1", Email: "B@example.com", Status: domain.InviteStatusPending},
+		{SurveyResponseUID: "r-5", SurveyUID: "s-2", Email: "b@example.com", Status: domain.InviteStatusSent, InviteUID: "inv-5"},
+	} {
+		require.NoError(t, putSurveyInvite(ctx, mappings, invite))
+	}
+
+	tracker := newSurveyInviteTracker(mappings, slog.Default())
+	tracker.now = func() time.Time { return now }
+
+	invites, err := tracker.ListSurveyInvites(ctx, "s-1")
+	require.NoError(t, err)
+	require.Len(t, invites, 4)
+	assert.Equal(t, "a@example.com", invites[0].Email, "ordered by email")
+	assert.Equal(t, domain.InviteStatusExpired, invites[0].Status, "sent invites past their expiry are expired")
+	assert.Equal(t, domain.InviteStatusSent, invites[1].Status)
+
+	// Accepting inv-1 also covers the other response invited under the same email, but
+	// not the invite of another survey
+	updated, err := tracker.MarkInviteAccepted(ctx, "s-1", "inv-1", "b@example.com", "bob", now)
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated)
+
+	invites, err = tracker.ListSurveyInvites(ctx, "s-1")
+	require.NoError(t, err)
+	accepted := 0
+	for _, invite := range invites {
+		if invite.Status == domain.InviteStatusAccepted {
+			accepted++
+			assert.Equal(t, "bob", invite.AcceptedBy)
+			require.NotNil(t, invite.AcceptedAt)
+			assert.True(t, now.Equal(*invite.AcceptedAt))
+		}
+	}
+	assert.Equal(t, 2, accepted)
+
+	other, err := tracker.ListSurveyInvites(ctx, "s-2")
+	require.NoError(t, err)
+	require.Len(t, other, 1)
+	assert.Equal(t, domain.InviteStatusSent, other[0].Status)
+
+	empty, err := tracker.ListSurveyInvites(ctx, "s-unknown")
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+}
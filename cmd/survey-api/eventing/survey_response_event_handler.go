@@ -176,6 +176,28 @@ type SurveyResponseProjectDBRaw struct {
 	Name string `json:"name"`
 }
 
+// surveyResponseKVHandler syncs itx-survey-responses records
+type surveyResponseKVHandler struct {
+	kvHandlerDeps
+	inviteHandler *SurveyResponseInviteHandler
+}
+
+func (h *surveyResponseKVHandler) Prefix() string               { return "itx-survey-responses" }
+func (h *surveyResponseKVHandler) ObjectType() string           { return objectTypeSurveyResponse }
+func (h *surveyResponseKVHandler) MappingKey(uid string) string { return surveyResponseMappingKey(uid) }
+
+func (h *surveyResponseKVHandler) HandleUpdate(ctx context.Context, key string, v1Data map[string]any) bool {
+	return handleSurveyResponseUpdate(ctx, key, v1Data, h.publisher, h.idMapper, h.mappingsKV, h.v1ObjectsKV, h.inviteHandler, h.logger)
+}
+
+func (h *surveyResponseKVHandler) HandleDelete(ctx context.Context, uid string) bool {
+	return handleSurveyResponseDelete(ctx, uid, h.publisher, h.mappingsKV, h.logger)
+}
+
+func surveyResponseMappingKey(uid string) string {
+	return fmt.Sprintf("survey_response.%s", uid)
+}
+
 // handleSurveyResponseUpdate processes a survey response update from itx-survey-responses records
 // Returns true if the message should be retried (NAK), false if it should be acknowledged (ACK)
 func handleSurveyResponseUpdate(
@@ -197,6 +219,7 @@ func handleSurveyResponseUpdate(
 	responseData, err := convertMapToSurveyResponseData(ctx, v1Data, idMapper, funcLogger)
 	if err != nil {
 		funcLogger.With(errKey, err).ErrorContext(ctx, "failed to convert v1Data to survey response")
+		recordPermanentFailure(ctx, err)
 		return false // Permanent error, ACK and skip
 	}
 
@@ -213,12 +236,19 @@ func handleSurveyResponseUpdate(
 		return false // Permanent error, ACK and skip
 	}
 	funcLogger = funcLogger.With("survey_id", responseData.SurveyID)
-	// Treat a tombstoned survey mapping as not-found so responses are not synced after parent deletion.
-	surveyMappingKey := fmt.Sprintf("survey.%s", responseData.SurveyID)
-	if entry, err := mappingsKV.Get(ctx, surveyMappingKey); err != nil || isTombstonedMapping(entry.Value()) {
+	parentMappingKey := surveyMappingKey(responseData.SurveyID)
+	parentEntry, err := mappingsKV.Get(ctx, parentMappingKey)
+	if err != nil {
 		funcLogger.With(errKey, err).InfoContext(ctx, "parent survey not found in mappings, will retry survey response sync")
 		return true // NAK for retry - survey may not be processed yet
 	}
+	if isTombstonedMapping(parentEntry.Value()) {
+		// Responses are not synced under a deleted survey. Index the response under it so
+		// that restoring the survey syncs the response, instead of retrying until then.
+		indexSurveyResponse(ctx, mappingsKV, responseData.SurveyID, responseData.UID, funcLogger)
+		funcLogger.InfoContext(ctx, "parent survey is deleted, deferring survey response sync until it is restored")
+		return false // ACK, refreshSurveyResponses syncs it via the restore marker when the survey is restored
+	}
 
 	// Check if parent project exists in mappings
 	if responseData.Project.ProjectUID == "" {
@@ -246,11 +276,32 @@ func handleSurveyResponseUpdate(
 		applyParentSurveyDenormalization(responseData, parentSurvey)
 	}
 
+	// Responses of an anonymous survey must never be indexed with identity, so the
+	// settings have to be readable before anything is published.
+	surveySettings, err := loadSurveySettings(ctx, mappingsKV, responseData.SurveyID)
+	if err != nil {
+		funcLogger.With(errKey, err).WarnContext(ctx, "failed to read survey settings, will retry survey response sync")
+		return true // NAK for retry
+	}
+	responseData.Anonymous = surveySettings != nil && surveySettings.Anonymous
+
 	// Determine action (created vs updated) by checking if mapping exists
-	mappingKey := fmt.Sprintf("survey_response.%s", responseData.UID)
+	// The hash covers the denormalized survey fields, so a parent survey change republishes.
+	// A tombstoned mapping means the response was deleted and has been restored in v1.
+	mappingKey := surveyResponseMappingKey(responseData.UID)
+	hash := contentHash(responseData)
 	indexerAction := indexerConstants.ActionCreated
-	if _, err := mappingsKV.Get(ctx, mappingKey); err == nil {
+	restored := false
+	if entry, err := mappingsKV.Get(ctx, mappingKey); err == nil && isTombstonedMapping(entry.Value()) {
+		restored = true
+		funcLogger.InfoContext(ctx, "survey response restored after delete, recreating index document and access")
+	} else if err == nil {
 		indexerAction = indexerConstants.ActionUpdated
+		if isUnchangedContent(ctx, entry.Value(), hash) {
+			funcLogger.DebugContext(ctx, "survey response content unchanged, skipping publish")
+			recordSkippedUnchanged(ctx, objectTypeSurveyResponse)
+			return false // Nothing to do, ACK the message
+		}
 	}
 
 	// Publish to indexer and FGA-sync
@@ -263,16 +314,28 @@ func handleSurveyResponseUpdate(
 		return false // Permanent error, ACK and skip
 	}
 
-	// Store mapping to track that we've seen this survey response
-	if _, err := mappingsKV.Put(ctx, mappingKey, []byte("1")); err != nil {
+	recordPublished(ctx, objectTypeSurveyResponse)
+	if restored {
+		recordRestored(ctx, objectTypeSurveyResponse)
+	}
+
+	if publishSurveyResponseDomainEvents(ctx, responseData, hash, indexerAction, publisher, mappingsKV, funcLogger) {
+		return true // NAK for retry
+	}
+
+	// Store the content hash to track that we've seen this survey response and what was published
+	if _, err := mappingsKV.Put(ctx, mappingKey, mappingValue(hash)); err != nil {
 		funcLogger.With(errKey, err).WarnContext(ctx, "failed to store survey response mapping")
 		// Don't retry on mapping storage failures
 	}
+	indexSurveyResponse(ctx, mappingsKV, responseData.SurveyID, responseData.UID, funcLogger)
+	syncResponseResultsAccess(ctx, publisher, mappingsKV, surveySettings, responseData.SurveyID, responseData.UID, strings.TrimSpace(responseData.Username), funcLogger)
 
 	// Best-effort: send an LFID invite to new participants who have no username yet.
-	if shouldSendSurveyResponseInvite(indexerAction, responseData.Username, responseData.Email) {
+	// A restored response was invited when it was first created.
+	if !restored && shouldSendSurveyResponseInvite(indexerAction, responseData.Username, responseData.Email) {
 		displayName := strings.TrimSpace(responseData.FirstName + " " + responseData.LastName)
-		inviteHandler.maybeSendInvite(ctx, funcLogger, responseData.UID, responseData.Email, displayName, responseData.SurveyID)
+		inviteHandler.maybeSendInvite(ctx, funcLogger, responseData.UID, responseData.Email, displayName, responseData.SurveyID, responseData.Project.ProjectUID)
 	}
 
 	funcLogger.InfoContext(ctx, "successfully sent survey response indexer and access messages")
@@ -448,12 +511,7 @@ func handleSurveyResponseDelete(
 
 	funcLogger.DebugContext(ctx, "processing survey response delete")
 
-	// Skip if already tombstoned — prevents duplicate delete events on redelivery
-	mappingKey := fmt.Sprintf("survey_response.%s", uid)
-	if entry, err := mappingsKV.Get(ctx, mappingKey); err == nil && isTombstonedMapping(entry.Value()) {
-		funcLogger.DebugContext(ctx, "survey response delete already processed, skipping")
-		return false
-	}
+	mappingKey := surveyResponseMappingKey(uid)
 
 	// Create minimal survey response data for delete event
 	responseData := &domain.SurveyResponseData{
@@ -476,6 +534,8 @@ func handleSurveyResponseDelete(
 		funcLogger.With(errKey, err).WarnContext(ctx, "failed to tombstone survey response mapping")
 		// Don't retry on mapping failures
 	}
+	unindexSurveyResponse(ctx, mappingsKV, uid, funcLogger)
+	revokeDeletedResponseResultsAccess(ctx, publisher, mappingsKV, uid, funcLogger)
 
 	funcLogger.InfoContext(ctx, "successfully sent survey response delete indexer and access messages")
 	return false // Success, ACK the message
@@ -0,0 +1,259 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync/atomic"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-survey-service/pkg/concurrent"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	// surveyResponseIndexPrefix keys the survey→response index in the mappings bucket:
+	// survey_responses.{survey_uid}.{response_uid} = "1"
+	surveyResponseIndexPrefix = "survey_responses"
+
+	// surveyDenormPrefix keys the fingerprint of the survey fields last copied onto its
+	// response documents: survey_denorm.{survey_uid} = sha256 hex
+	surveyDenormPrefix = "survey_denorm"
+
+	// restorePendingMarker replaces the denormalization fingerprint of a restored survey
+	// until all of its responses have been republished
+	restorePendingMarker = "!restore"
+
+	// surveyResponseFanoutWorkers bounds concurrent response republishes per survey
+	surveyResponseFanoutWorkers = 10
+)
+
+func surveyResponseIndexKey(surveyUID, responseUID string) string {
+	return fmt.Sprintf("%s.%s.%s", surveyResponseIndexPrefix, surveyUID, responseUID)
+}
+
+func surveyDenormKey(surveyUID string) string {
+	return fmt.Sprintf("%s.%s", surveyDenormPrefix, surveyUID)
+}
+
+// surveyDenormFields projects the survey fields that applyParentSurveyDenormalization
+// copies onto responses; it is the input to the denormalization fingerprint. The response
+// total and modification time change with every submission, so they are left out: they
+// reach a response when it is next synced rather than fanning out to all of them.
+func surveyDenormFields(s *domain.SurveyData) *domain.SurveyData {
+	fields := &domain.SurveyData{
+		SurveyTitle:       s.SurveyTitle,
+		SurveyStatus:      s.SurveyStatus,
+		SurveySendDate:    s.SurveySendDate,
+		SurveyCutoffDate:  s.SurveyCutoffDate,
+		IsNPSSurvey:       s.IsNPSSurvey,
+		IsProjectSurvey:   s.IsProjectSurvey,
+		CommitteeCategory: s.CommitteeCategory,
+		CreatorName:       s.CreatorName,
+		CreatedAt:         s.CreatedAt,
+		TotalRecipients:   s.TotalRecipients,
+	}
+	for _, c := range s.Committees {
+		fields.Committees = append(fields.Committees, domain.SurveyCommitteeData{
+			CommitteeID:   c.CommitteeID,
+			CommitteeName: c.CommitteeName,
+		})
+	}
+	return fields
+}
+
+// surveyDenormFingerprint hashes the denormalized survey fields
+func surveyDenormFingerprint(s *domain.SurveyData) (string, error) {
+	data, err := json.Marshal(surveyDenormFields(s))
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal survey denormalization fields: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// indexSurveyResponse records a response under its parent survey. Failures are logged
+// only: a missing index entry means the response is not refreshed on the next survey change.
+func indexSurveyResponse(ctx context.Context, mappingsKV jetstream.KeyValue, surveyUID, responseUID string, logger *slog.Logger) {
+	if _, err := mappingsKV.Put(ctx, surveyResponseIndexKey(surveyUID, responseUID), []byte("1")); err != nil {
+		logger.With(errKey, err).WarnContext(ctx, "failed to store survey response index entry")
+	}
+}
+
+// unindexSurveyResponse removes a response from the index. The delete event only carries
+// the response UID, so the entry is found with a wildcard on the survey segment.
+func unindexSurveyResponse(ctx context.Context, mappingsKV jetstream.KeyValue, responseUID string, logger *slog.Logger) {
+	keys, err := listKVKeys(ctx, mappingsKV, surveyResponseIndexKey("*", responseUID))
+	if err != nil {
+		logger.With(errKey, err).WarnContext(ctx, "failed to look up survey response index entry")
+		return
+	}
+	for _, key := range keys {
+		if err := mappingsKV.Delete(ctx, key); err != nil {
+			logger.With(errKey, err, "index_key", key).WarnContext(ctx, "failed to remove survey response index entry")
+		}
+	}
+}
+
+// listKVKeys returns the keys matching a subject-style filter, or none if nothing matches
+func listKVKeys(ctx context.Context, kv jetstream.KeyValue, filter string) ([]string, error) {
+	lister, err := kv.ListKeysFiltered(ctx, filter)
+	if errors.Is(err, jetstream.ErrNoKeysFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for key := range lister.Keys() {
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// refreshSurveyResponses re-publishes the responses of a survey when the survey fields
+// denormalized onto them have changed since the last refresh. The survey message is kept
+// in progress meanwhile, since a large survey takes longer than the ack wait.
+// Returns true if the survey message should be retried (NAK), false otherwise.
+func refreshSurveyResponses(
+	ctx context.Context,
+	surveyData *domain.SurveyData,
+	publisher domain.EventPublisher,
+	idMapper domain.IDMapper,
+	mappingsKV jetstream.KeyValue,
+	v1ObjectsKV jetstream.KeyValue,
+	logger *slog.Logger,
+) bool {
+	funcLogger := logger.With("survey_uid", surveyData.UID, "handler", "survey_response_refresh")
+
+	fingerprint, err := surveyDenormFingerprint(surveyData)
+	if err != nil {
+		funcLogger.With(errKey, err).ErrorContext(ctx, "failed to fingerprint survey denormalization fields")
+		return false
+	}
+	denormKey := surveyDenormKey(surveyData.UID)
+	if entry, err := mappingsKV.Get(ctx, denormKey); err == nil {
+		switch string(entry.Value()) {
+		case fingerprint:
+			funcLogger.DebugContext(ctx, "denormalized survey fields unchanged, skipping response refresh")
+			return false
+		case restorePendingMarker:
+			// Unchanged responses are republished too: the indexer may have dropped them with the survey.
+			ctx = withForceRepublish(ctx)
+		}
+	}
+
+	keys, err := listKVKeys(ctx, mappingsKV, surveyResponseIndexKey(surveyData.UID, "*"))
+	if err != nil {
+		funcLogger.With(errKey, err).ErrorContext(ctx, "failed to list survey responses for refresh")
+		return isTransientError(err)
+	}
+
+	stop := keepInProgress(ctx, funcLogger)
+	defer stop()
+
+	var retry, refreshed atomic.Int32
+	indexPrefix := surveyResponseIndexKey(surveyData.UID, "")
+	functions := make([]func() error, 0, len(keys))
+	for _, indexKey := range keys {
+		responseUID := strings.TrimPrefix(indexKey, indexPrefix)
+		functions = append(functions, func() error {
+			switch refreshSurveyResponse(ctx, indexKey, responseUID, publisher, idMapper, mappingsKV, v1ObjectsKV, funcLogger) {
+			case refreshRetry:
+				retry.Add(1)
+			case refreshPublished:
+				refreshed.Add(1)
+			}
+			return nil
+		})
+	}
+	if err := concurrent.NewWorkerPool(surveyResponseFanoutWorkers).Run(ctx, functions...); err != nil {
+		funcLogger.With(errKey, err).WarnContext(ctx, "survey response refresh interrupted")
+		return true
+	}
+
+	funcLogger = funcLogger.With("responses", len(keys), "refreshed", refreshed.Load(), "retry", retry.Load())
+	if retry.Load() > 0 {
+		// Leave the fingerprint as-is so the redelivered survey message refreshes again.
+		funcLogger.WarnContext(ctx, "some survey responses could not be refreshed, will retry")
+		return true
+	}
+
+	if _, err := mappingsKV.Put(ctx, denormKey, []byte(fingerprint)); err != nil {
+		funcLogger.With(errKey, err).WarnContext(ctx, "failed to store survey denormalization fingerprint")
+	}
+	funcLogger.InfoContext(ctx, "refreshed denormalized survey fields on survey responses")
+	return false
+}
+
+// markSurveyResponsesForRestore makes the next response refresh of a survey that is
+// being restored after a delete republish every indexed response: responses the indexer
+// may have dropped with the survey, and responses that arrived while it was deleted and
+// were deferred. The marker stays until the refresh succeeds, so it survives redeliveries
+// of the survey message after its mapping is live again.
+func markSurveyResponsesForRestore(ctx context.Context, mappingsKV jetstream.KeyValue, surveyUID string) error {
+	_, err := mappingsKV.Put(ctx, surveyDenormKey(surveyUID), []byte(restorePendingMarker))
+	return err
+}
+
+type refreshOutcome int
+
+const (
+	refreshSkipped refreshOutcome = iota
+	refreshPublished
+	refreshRetry
+)
+
+// refreshSurveyResponse re-runs a single indexed response through handleSurveyResponseUpdate
+func refreshSurveyResponse(
+	ctx context.Context,
+	indexKey, responseUID string,
+	publisher domain.EventPublisher,
+	idMapper domain.IDMapper,
+	mappingsKV jetstream.KeyValue,
+	v1ObjectsKV jetstream.KeyValue,
+	logger *slog.Logger,
+) refreshOutcome {
+	responseLogger := logger.With("survey_response_id", responseUID)
+	responseKey := "itx-survey-responses." + responseUID
+
+	entry, err := v1ObjectsKV.Get(ctx, responseKey)
+	if errors.Is(err, jetstream.ErrKeyNotFound) || errors.Is(err, jetstream.ErrKeyDeleted) {
+		// The response is gone; drop the stale index entry.
+		if err := mappingsKV.Delete(ctx, indexKey); err != nil {
+			responseLogger.With(errKey, err).WarnContext(ctx, "failed to remove stale survey response index entry")
+		}
+		return refreshSkipped
+	}
+	if err != nil {
+		responseLogger.With(errKey, err).WarnContext(ctx, "failed to fetch survey response for refresh")
+		if isTransientError(err) {
+			return refreshRetry
+		}
+		return refreshSkipped
+	}
+
+	v1Data, err := decodeKVValue(entry.Value())
+	if err != nil {
+		responseLogger.With(errKey, err).WarnContext(ctx, "failed to decode survey response for refresh")
+		return refreshSkipped
+	}
+	if deletedAt, exists := v1Data["_sdc_deleted_at"]; exists && deletedAt != nil && deletedAt != "" {
+		return refreshSkipped
+	}
+
+	// A response that cannot be converted is the response's problem, not the survey's:
+	// keep it out of the survey message's dead-letter decision.
+	if handleSurveyResponseUpdate(withoutFailureRecorder(ctx), responseKey, v1Data, publisher, idMapper, mappingsKV, v1ObjectsKV, nil, logger) {
+		return refreshRetry
+	}
+	return refreshPublished
+}
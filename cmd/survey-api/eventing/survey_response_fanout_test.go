@@ -0,0 +1,212 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/infrastructure/idmapper"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupEventBuckets creates empty v1-objects and v1-mappings buckets on an in-process server
+func setupEventBuckets(t *testing.T) (objects, mappings jetstream.KeyValue) {
+	t.Helper()
+	js := setupJetStream(t)
+	ctx := context.Background()
+
+	objects, err := js.CreateKeyValue(ctx, jetstream.KeyValueConfig{Bucket: V1ObjectsBucket})
+	require.NoError(t, err)
+	mappings, err = js.CreateKeyValue(ctx, jetstream.KeyValueConfig{Bucket: V1MappingsBucket})
+	require.NoError(t, err)
+	return objects, mappings
+}
+
+func putJSON(t *testing.T, kv jetstream.KeyValue, key string, v any) {
+	t.Helper()
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	_, err = kv.Put(context.Background(), key, data)
+	require.NoError(t, err)
+}
+
+func TestSurveyDenormFingerprint(t *testing.T) {
+	base := &domain.SurveyData{
+		UID:         "s-1",
+		SurveyTitle: "Q1",
+		Committees:  []domain.SurveyCommitteeData{{CommitteeID: "c-1", CommitteeName: "TSC"}},
+	}
+	fp, err := surveyDenormFingerprint(base)
+	require.NoError(t, err)
+
+	// Fields that are not copied onto responses do not change the fingerprint.
+	other := *base
+	other.EmailBody = "changed"
+	other.NPSValue = 9
+	// Nor do the fields that change with every submission.
+	other.TotalResponses = 12
+	other.LastModifiedAt = "2025-03-04T10:15:00Z"
+	fpOther, err := surveyDenormFingerprint(&other)
+	require.NoError(t, err)
+	assert.Equal(t, fp, fpOther)
+
+	renamed := *base
+	renamed.Committees = []domain.SurveyCommitteeData{{CommitteeID: "c-1", CommitteeName: "Board"}}
+	fpRenamed, err := surveyDenormFingerprint(&renamed)
+	require.NoError(t, err)
+	assert.NotEqual(t, fp, fpRenamed)
+}
+
+func TestRefreshSurveyResponses(t *testing.T) {
+	objects, mappings := setupEventBuckets(t)
+	ctx := context.Background()
+
+	putJSON(t, objects, "itx-surveys.s-1", map[string]any{"id": "s-1", "survey_title": "Renamed", "survey_status": "closed"})
+	for _, id := range []string{"r-1", "r-2"} {
+		putJSON(t, objects, "itx-survey-responses."+id, map[string]any{
+			"id":        id,
+			"survey_id": "s-1",
+			"email":     id + "@example.com",
+			"username":  id,
+			"project":   map[string]any{"id": "p-1", "name": "Project"},
+		})
+	}
+	_, err := mappings.PutString(ctx, "survey.s-1", "1")
+	require.NoError(t, err)
+	for _, id := range []string{"r-1", "r-2", "r-gone"} {
+		_, err := mappings.PutString(ctx, surveyResponseIndexKey("s-1", id), "1")
+		require.NoError(t, err)
+	}
+	// Another survey's responses are not touched.
+	_, err = mappings.PutString(ctx, surveyResponseIndexKey("s-2", "r-9"), "1")
+	require.NoError(t, err)
+
+	publisher := &recordingPublisher{}
+	survey := &domain.SurveyData{UID: "s-1", SurveyTitle: "Renamed", SurveyStatus: "closed"}
+	retry := refreshSurveyResponses(ctx, survey, publisher, idmapper.NewNoOpMapper(), mappings, objects, slog.Default())
+	require.False(t, retry)
+
+	responses := publisher.responses()
+	require.Len(t, responses, 2)
+	for _, r := range responses {
+		assert.Equal(t, "Renamed", r.SurveyTitle)
+		assert.Equal(t, "closed", r.SurveyStatus)
+	}
+
+	_, err = mappings.Get(ctx, surveyResponseIndexKey("s-1", "r-gone"))
+	assert.ErrorIs(t, err, jetstream.ErrKeyNotFound, "stale index entries are removed")
+	_, err = mappings.Get(ctx, surveyDenormKey("s-1"))
+	assert.NoError(t, err, "fingerprint is stored after a complete refresh")
+
+	// Same denormalized fields: nothing is republished.
+	retry = refreshSurveyResponses(ctx, survey, publisher, idmapper.NewNoOpMapper(), mappings, objects, slog.Default())
+	require.False(t, retry)
+	assert.Len(t, publisher.responses(), 2)
+}
+
+func TestSurveyResponseIndexMaintenance(t *testing.T) {
+	_, mappings := setupEventBuckets(t)
+	ctx := context.Background()
+
+	indexSurveyResponse(ctx, mappings, "s-1", "r-1", slog.Default())
+	_, err := mappings.Get(ctx, surveyResponseIndexKey("s-1", "r-1"))
+	require.NoError(t, err)
+
+	unindexSurveyResponse(ctx, mappings, "r-1", slog.Default())
+	_, err = mappings.Get(ctx, surveyResponseIndexKey("s-1", "r-1"))
+	assert.ErrorIs(t, err, jetstream.ErrKeyNotFound)
+
+	// Nothing indexed is a no-op.
+	unindexSurveyResponse(ctx, mappings, "r-unknown", slog.Default())
+}
+
+func TestSurveyRestoreAfterSoftDelete(t *testing.T) {
+	objects, mappings := setupEventBuckets(t)
+	ctx := context.Background()
+	logger := slog.Default()
+	mapper := idmapper.NewNoOpMapper()
+
+	survey := map[string]any{
+		"id":            "s-1",
+		"survey_title":  "Q1",
+		"survey_status": "open",
+		"committees":    []map[string]any{{"committee_id": "c-1", "project_id": "p-1"}},
+	}
+	response := func(id string) map[string]any {
+		return map[string]any{
+			"id":        id,
+			"survey_id": "s-1",
+			"email":     id + "@example.com",
+			"username":  id,
+			"project":   map[string]any{"id": "p-1", "name": "Project"},
+		}
+	}
+	putJSON(t, objects, "itx-surveys.s-1", survey)
+	putJSON(t, objects, "itx-survey-responses.r-1", response("r-1"))
+	putJSON(t, objects, "itx-survey-responses.r-2", response("r-2"))
+
+	publisher := &recordingPublisher{}
+	require.False(t, handleSurveyUpdate(ctx, "itx-surveys.s-1", survey, publisher, mapper, mappings, objects, logger))
+	require.False(t, handleSurveyResponseUpdate(ctx, "itx-survey-responses.r-1", response("r-1"), publisher, mapper, mappings, objects, nil, logger))
+
+	// The survey is soft deleted; a response arriving meanwhile is deferred, not retried
+	require.False(t, handleSurveyDelete(ctx, "s-1", publisher, mappings, logger))
+	require.False(t, handleSurveyResponseUpdate(ctx, "itx-survey-responses.r-2", response("r-2"), publisher, mapper, mappings, objects, nil, logger))
+	_, err := mappings.Get(ctx, surveyResponseMappingKey("r-2"))
+	require.ErrorIs(t, err, jetstream.ErrKeyNotFound)
+
+	// Undeleting the survey recreates it and re-syncs both responses
+	publisher = &recordingPublisher{}
+	require.False(t, handleSurveyUpdate(ctx, "itx-surveys.s-1", survey, publisher, mapper, mappings, objects, logger))
+
+	var surveyActions []string
+	responseActions := map[string]string{}
+	for _, e := range publisher.events {
+		switch {
+		case e.survey != nil:
+			surveyActions = append(surveyActions, e.action)
+		case e.response != nil:
+			responseActions[e.response.UID] = e.action
+		}
+	}
+	assert.Equal(t, []string{"created"}, surveyActions)
+	assert.Equal(t, map[string]string{"r-1": "updated", "r-2": "created"}, responseActions)
+
+	entry, err := mappings.Get(ctx, surveyDenormKey("s-1"))
+	require.NoError(t, err)
+	assert.NotEqual(t, restorePendingMarker, string(entry.Value()), "restore marker is cleared once responses are synced")
+}
+
+func TestSurveyResponseRestoreAfterSoftDelete(t *testing.T) {
+	objects, mappings := setupEventBuckets(t)
+	ctx := context.Background()
+	logger := slog.Default()
+
+	v1Data := map[string]any{
+		"id":        "r-1",
+		"survey_id": "s-1",
+		"email":     "r-1@example.com",
+		"project":   map[string]any{"id": "p-1", "name": "Project"},
+	}
+	_, err := mappings.PutString(ctx, surveyMappingKey("s-1"), syncedMarker)
+	require.NoError(t, err)
+	_, err = mappings.PutString(ctx, surveyResponseMappingKey("r-1"), tombstoneMarker)
+	require.NoError(t, err)
+
+	publisher := &recordingPublisher{}
+	require.False(t, handleSurveyResponseUpdate(ctx, "itx-survey-responses.r-1", v1Data, publisher, idmapper.NewNoOpMapper(), mappings, objects, nil, logger))
+
+	responses := publisher.responses()
+	require.Len(t, responses, 1)
+	assert.Equal(t, "created", publisher.events[0].action)
+	entry, err := mappings.Get(ctx, surveyResponseMappingKey("r-1"))
+	require.NoError(t, err)
+	assert.False(t, isTombstonedMapping(entry.Value()))
+}
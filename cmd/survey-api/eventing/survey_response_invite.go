@@ -8,8 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
-	"net/url"
 	"strings"
+	"time"
 
 	indexerConstants "github.com/linuxfoundation/lfx-v2-indexer-service/pkg/constants"
 	inviteapi "github.com/linuxfoundation/lfx-v2-invite-service/pkg/api"
@@ -35,6 +35,10 @@ func (h *SurveyResponseInviteHandler) inviteEnabled() bool {
 		strings.TrimSpace(h.selfServeBaseURL) != ""
 }
 
+// inviteExpirationDays is how long an invite stays valid. Re-sends are shortened to the
+// survey cutoff.
+const inviteExpirationDays = 30
+
 const surveyResponseLFIDInviteSentKeyFmt = "v1_survey_response_lfid_invite_sent.%s"
 
 func surveyResponseLFIDInviteSentKey(surveyResponseUID string) string {
@@ -42,11 +46,12 @@ func surveyResponseLFIDInviteSentKey(surveyResponseUID string) string {
 }
 
 // maybeSendInvite performs a best-effort LFID invite for a new survey-response participant
-// who has no username. All errors are logged and swallowed.
+// who has no username, with the invite settings of the survey and its project. All errors
+// are logged and swallowed.
 func (h *SurveyResponseInviteHandler) maybeSendInvite(
 	ctx context.Context,
 	logger *slog.Logger,
-	surveyResponseUID, email, displayName, surveyID string,
+	surveyResponseUID, email, displayName, surveyID, projectUID string,
 ) {
 	if !h.inviteEnabled() {
 		return
@@ -79,36 +84,32 @@ func (h *SurveyResponseInviteHandler) maybeSendInvite(
 		logger.With(errKey, err).WarnContext(ctx, "failed to check LFID for survey response; proceeding with invite as best-effort")
 	}
 
-	var surveyName string
-	surveyKey := fmt.Sprintf("itx-surveys.%s", surveyID)
-	if entry, kvErr := h.v1ObjectsKV.Get(ctx, surveyKey); kvErr == nil {
-		if data, decErr := decodeKVValue(entry.Value()); decErr == nil {
-			if name, ok := data["name"].(string); ok {
-				surveyName = strings.TrimSpace(name)
-			}
-		}
+	opts := h.inviteOptions(ctx, logger, surveyID, projectUID)
+	if !opts.enabled {
+		logger.DebugContext(ctx, "LFID invites are disabled for the survey or its project, skipping invite")
+		return
 	}
+
+	survey, err := h.loadInviteSurvey(ctx, surveyID)
+	if err != nil {
+		logger.With(errKey, err).DebugContext(ctx, "could not read survey for invite")
+	}
+	surveyName := opts.surveyName(survey)
 	if surveyName == "" {
-		logger.WarnContext(ctx, "could not resolve survey name; skipping invite to avoid confusing email")
+		logger.WarnContext(ctx, "could not resolve survey name and no display name fallback is set; skipping invite to avoid confusing email")
 		return
 	}
 
-	returnURL := fmt.Sprintf("%s/surveys/%s", strings.TrimRight(h.selfServeBaseURL, "/"), url.PathEscape(surveyID))
-	name := strings.TrimSpace(displayName)
-	req := inviteapi.SendInviteRequest{
-		Recipient: &inviteapi.Recipient{
-			Email: email,
-			Name:  name,
-		},
-		Resource: &inviteapi.Resource{
-			UID:  surveyID,
-			Name: surveyName,
-			Type: surveyconstants.ResourceTypeSurvey,
-		},
-		Role:           surveyconstants.InviteRoleParticipant,
-		ReturnURL:      returnURL,
-		ExpirationDays: 30,
+	invite := &domain.SurveyInvite{
+		SurveyResponseUID: surveyResponseUID,
+		SurveyUID:         surveyID,
+		ProjectUID:        projectUID,
+		Email:             email,
+		Name:              strings.TrimSpace(displayName),
+		Status:            domain.InviteStatusPending,
+		CreatedAt:         time.Now().UTC(),
 	}
+	req := h.newInviteRequest(invite, surveyName, opts, opts.expirationDays)
 
 	// Write a "pending" marker before calling SendInvite to close the duplicate-invite
 	// window: a concurrent redelivery that passes the Get check above would also see
@@ -118,18 +119,146 @@ func (h *SurveyResponseInviteHandler) maybeSendInvite(
 		return
 	}
 
+	// A recipient who already has an outstanding invite from another survey gets no
+	// second email; accepting that invite enriches this response too.
+	if outstanding := h.claimEmailInvite(ctx, logger, email, surveyInviteRef(invite), invite.CreatedAt); outstanding != nil {
+		h.attachInvite(ctx, logger, invite, outstanding)
+		return
+	}
+	h.recordInvite(ctx, logger, invite)
+
+	h.sendInvite(ctx, logger, invite, req)
+}
+
+// inviteSurvey holds the fields of the v1 survey record that invites depend on
+type inviteSurvey struct {
+	name   string
+	status string
+	// cutoff is zero when the survey has no parseable cutoff date
+	cutoff time.Time
+}
+
+// loadInviteSurvey reads the v1 survey record an invite is sent for
+func (h *SurveyResponseInviteHandler) loadInviteSurvey(ctx context.Context, surveyID string) (*inviteSurvey, error) {
+	entry, err := h.v1ObjectsKV.Get(ctx, fmt.Sprintf("itx-surveys.%s", surveyID))
+	if err != nil {
+		return nil, err
+	}
+	data, err := decodeKVValue(entry.Value())
+	if err != nil {
+		return nil, err
+	}
+
+	survey := &inviteSurvey{}
+	if name, ok := data["name"].(string); ok {
+		survey.name = strings.TrimSpace(name)
+	}
+	if status, ok := data["survey_status"].(string); ok {
+		survey.status = strings.TrimSpace(status)
+	}
+	if cutoff, ok := data["survey_cutoff_date"].(string); ok {
+		if t, err := time.Parse(time.RFC3339, strings.TrimSpace(cutoff)); err == nil {
+			survey.cutoff = t
+		}
+	}
+	return survey, nil
+}
+
+// openInviteSurvey returns the survey when it still exists in v2 and is open, i.e. its
+// v1 status is sending or sent, or nil. The cutoff and the name, which the invite
+// settings may supply, are left to the caller.
+func (h *SurveyResponseInviteHandler) openInviteSurvey(ctx context.Context, logger *slog.Logger, surveyUID string) *inviteSurvey {
+	if !h.mappingLive(ctx, logger, surveyMappingKey(surveyUID)) {
+		return nil
+	}
+	survey, err := h.loadInviteSurvey(ctx, surveyUID)
+	if err != nil {
+		logger.With(errKey, err).DebugContext(ctx, "could not read survey for invite")
+		return nil
+	}
+	if surveyLifecycle(survey.status) != surveyLifecycleOpen {
+		return nil
+	}
+	return survey
+}
+
+// mappingLive reports whether key was synced to v2 and not deleted since
+func (h *SurveyResponseInviteHandler) mappingLive(ctx context.Context, logger *slog.Logger, key string) bool {
+	entry, err := h.v1MappingsKV.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, jetstream.ErrKeyNotFound) {
+			logger.With(errKey, err, "key", key).WarnContext(ctx, "failed to read mapping for invite")
+		}
+		return false
+	}
+	return !isTombstonedMapping(entry.Value())
+}
+
+// newInviteRequest builds the invite-service request for invite under the resolved settings
+func (h *SurveyResponseInviteHandler) newInviteRequest(invite *domain.SurveyInvite, surveyName string, opts inviteOptions, expirationDays int) inviteapi.SendInviteRequest {
+	return inviteapi.SendInviteRequest{
+		Recipient: &inviteapi.Recipient{
+			Email: invite.Email,
+			Name:  invite.Name,
+		},
+		Resource: &inviteapi.Resource{
+			UID:  invite.SurveyUID,
+			Name: surveyName,
+			Type: surveyconstants.ResourceTypeSurvey,
+		},
+		Role:           opts.role,
+		ReturnURL:      renderInviteReturnURL(opts.returnURLTemplate, h.selfServeBaseURL, invite.SurveyUID, invite.ProjectUID, opts.locale),
+		ExpirationDays: expirationDays,
+	}
+}
+
+// sendInvite hands the invite to the invite service and records the attempt and its
+// outcome. On success the sent marker is updated to the new invite UID and the responses
+// attached to the email's invite share it; on failure they fail with it.
+func (h *SurveyResponseInviteHandler) sendInvite(ctx context.Context, logger *slog.Logger, invite *domain.SurveyInvite, req inviteapi.SendInviteRequest) bool {
+	invite.AttachedTo = ""
+	invite.ClaimedAt = nil
 	result, sendErr := h.inviteSender.SendInvite(ctx, req)
 	if sendErr != nil {
 		logger.With(errKey, sendErr).WarnContext(ctx, "failed to send LFID invite for survey response; continuing")
-		return
+		failedAt := time.Now().UTC()
+		invite.Status = domain.InviteStatusFailed
+		invite.FailedAt = &failedAt
+		invite.Error = sendErr.Error()
+		invite.Attempts = append(invite.Attempts, domain.InviteAttempt{At: failedAt, Error: sendErr.Error()})
+		h.recordInvite(ctx, logger, invite)
+		h.releaseEmailInvite(ctx, logger, invite)
+		return false
 	}
-	if _, err := h.v1MappingsKV.Put(ctx, inviteSentKey, []byte(result.InviteUID)); err != nil {
+	if _, err := h.v1MappingsKV.Put(ctx, surveyResponseLFIDInviteSentKey(invite.SurveyResponseUID), []byte(result.InviteUID)); err != nil {
 		logger.With(errKey, err).WarnContext(ctx, "failed to update survey response LFID invite sent marker")
 	}
+	sentAt := time.Now().UTC()
+	invite.Status = domain.InviteStatusSent
+	invite.InviteUID = result.InviteUID
+	invite.Error = ""
+	invite.SentAt = &sentAt
+	invite.ExpiresAt = nil
+	if !result.ExpiresAt.IsZero() {
+		expiresAt := result.ExpiresAt.UTC()
+		invite.ExpiresAt = &expiresAt
+	}
+	invite.Attempts = append(invite.Attempts, domain.InviteAttempt{At: sentAt, InviteUID: result.InviteUID, ExpiresAt: invite.ExpiresAt})
+	h.recordInvite(ctx, logger, invite)
+	h.completeEmailInvite(ctx, logger, invite)
 	logger.InfoContext(ctx, "sent LFID invite for survey response",
 		"invite_uid", result.InviteUID,
 		"expires_at", result.ExpiresAt,
 	)
+	return true
+}
+
+// recordInvite stores the invite state for the invite management API. Failures are
+// logged only: the sent marker, not this record, prevents duplicate invites.
+func (h *SurveyResponseInviteHandler) recordInvite(ctx context.Context, logger *slog.Logger, invite *domain.SurveyInvite) {
+	if err := putSurveyInvite(ctx, h.v1MappingsKV, invite); err != nil {
+		logger.With(errKey, err, "invite_status", invite.Status).WarnContext(ctx, "failed to store survey invite state")
+	}
 }
 
 // shouldSendSurveyResponseInvite reports whether a new no-LFID survey response should trigger an invite.
@@ -88,10 +88,17 @@ func TestMaybeSendSurveyInvite(t *testing.T) {
 		surveyResponseUID = "response-123"
 		surveyID          = "survey-456"
 		email             = "guest@example.com"
+		projectUID        = "project-789"
 	)
 
 	inviteSentKey := surveyResponseLFIDInviteSentKey(surveyResponseUID)
+	inviteStateKey := surveyInviteKey(surveyID, surveyResponseUID)
+	emailKey := emailInviteKey(email)
 	surveyKey := "itx-surveys." + surveyID
+	noInviteSettings := func(kv *mockKeyValue) {
+		kv.On("Get", mock.Anything, inviteSettingsKey(domain.InviteSettingsScopeProject, projectUID)).Return(nil, jetstream.ErrKeyNotFound)
+		kv.On("Get", mock.Anything, inviteSettingsKey(domain.InviteSettingsScopeSurvey, surveyID)).Return(nil, jetstream.ErrKeyNotFound)
+	}
 	surveyPayload, err := json.Marshal(map[string]any{"name": "Member Survey 2025"})
 	require.NoError(t, err)
 
@@ -121,6 +128,7 @@ func TestMaybeSendSurveyInvite(t *testing.T) {
 			userReader: stubSurveyInviteUserReader{err: domain.ErrUserNotFound},
 			setupMaps: func(kv *mockKeyValue) {
 				kv.On("Get", mock.Anything, inviteSentKey).Return(nil, jetstream.ErrKeyNotFound)
+				noInviteSettings(kv)
 			},
 			setupObjects: func(kv *mockKeyValue) {
 				kv.On("Get", mock.Anything, surveyKey).Return(nil, jetstream.ErrKeyNotFound)
@@ -133,6 +141,10 @@ func TestMaybeSendSurveyInvite(t *testing.T) {
 				kv.On("Get", mock.Anything, inviteSentKey).Return(nil, jetstream.ErrKeyNotFound)
 				kv.On("Put", mock.Anything, inviteSentKey, []byte("pending")).Return(uint64(1), nil)
 				kv.On("Put", mock.Anything, inviteSentKey, []byte("invite-new")).Return(uint64(2), nil)
+				kv.On("Put", mock.Anything, inviteStateKey, mock.Anything).Return(uint64(3), nil).Twice()
+				kv.On("Get", mock.Anything, emailKey).Return(nil, jetstream.ErrKeyNotFound).Twice()
+				kv.On("Create", mock.Anything, emailKey, mock.Anything).Return(uint64(4), nil)
+				noInviteSettings(kv)
 			},
 			setupObjects: func(kv *mockKeyValue) {
 				kv.On("Get", mock.Anything, surveyKey).
@@ -147,6 +159,10 @@ func TestMaybeSendSurveyInvite(t *testing.T) {
 				kv.On("Get", mock.Anything, inviteSentKey).Return(nil, jetstream.ErrKeyNotFound)
 				kv.On("Put", mock.Anything, inviteSentKey, []byte("pending")).Return(uint64(1), nil)
 				kv.On("Put", mock.Anything, inviteSentKey, []byte("invite-new")).Return(uint64(2), nil)
+				kv.On("Put", mock.Anything, inviteStateKey, mock.Anything).Return(uint64(3), nil).Twice()
+				kv.On("Get", mock.Anything, emailKey).Return(nil, jetstream.ErrKeyNotFound).Twice()
+				kv.On("Create", mock.Anything, emailKey, mock.Anything).Return(uint64(4), nil)
+				noInviteSettings(kv)
 			},
 			setupObjects: func(kv *mockKeyValue) {
 				kv.On("Get", mock.Anything, surveyKey).
@@ -183,7 +199,7 @@ func TestMaybeSendSurveyInvite(t *testing.T) {
 				selfServeBaseURL: "https://app.dev.lfx.dev",
 			}
 
-			h.maybeSendInvite(context.Background(), slog.Default(), surveyResponseUID, email, "Guest", surveyID)
+			h.maybeSendInvite(context.Background(), slog.Default(), surveyResponseUID, email, "Guest", surveyID, projectUID)
 
 			assert.Equal(t, tt.wantCalled, sender.called)
 			if tt.wantCalled {
@@ -0,0 +1,383 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-survey-service/pkg/concurrent"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	// surveySettingsPrefix keys the settings of a survey in the mappings bucket:
+	// survey_settings.{survey_uid} = JSON domain.SurveySettings
+	surveySettingsPrefix = "survey_settings"
+
+	// resultsViewerPrefix records the results_viewer grant made for a survey response:
+	// results_viewer.{survey_uid}.{response_uid} = username. It is what revocations read,
+	// since a deleted response no longer carries its username.
+	resultsViewerPrefix = "results_viewer"
+
+	// resultsAccessWorkers bounds concurrent grants and revocations per survey
+	resultsAccessWorkers = 10
+)
+
+func surveySettingsKey(surveyUID string) string {
+	return fmt.Sprintf("%s.%s", surveySettingsPrefix, surveyUID)
+}
+
+func resultsViewerKey(surveyUID, responseUID string) string {
+	return fmt.Sprintf("%s.%s.%s", resultsViewerPrefix, surveyUID, responseUID)
+}
+
+// loadSurveySettings reads the settings stored for a survey, or nil when there are none
+func loadSurveySettings(ctx context.Context, mappingsKV jetstream.KeyValue, surveyUID string) (*domain.SurveySettings, error) {
+	entry, err := mappingsKV.Get(ctx, surveySettingsKey(surveyUID))
+	if errors.Is(err, jetstream.ErrKeyNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var settings domain.SurveySettings
+	if err := json.Unmarshal(entry.Value(), &settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal survey settings: %w", err)
+	}
+	return &settings, nil
+}
+
+// SurveySettingsStore implements domain.SurveySettingsStore on the mappings bucket
+type SurveySettingsStore struct {
+	mappingsKV  jetstream.KeyValue
+	v1ObjectsKV jetstream.KeyValue
+	publisher   domain.EventPublisher
+	idMapper    domain.IDMapper
+	logger      *slog.Logger
+}
+
+func newSurveySettingsStore(mappingsKV, v1ObjectsKV jetstream.KeyValue, publisher domain.EventPublisher, idMapper domain.IDMapper, logger *slog.Logger) *SurveySettingsStore {
+	return &SurveySettingsStore{
+		mappingsKV:  mappingsKV,
+		v1ObjectsKV: v1ObjectsKV,
+		publisher:   publisher,
+		idMapper:    idMapper,
+		logger:      logger.With("component", "survey_settings"),
+	}
+}
+
+// GetSurveySettings implements domain.SurveySettingsStore.GetSurveySettings
+func (s *SurveySettingsStore) GetSurveySettings(ctx context.Context, surveyUID string) (*domain.SurveySettings, error) {
+	settings, err := loadSurveySettings(ctx, s.mappingsKV, surveyUID)
+	if err != nil {
+		return nil, domain.NewUnavailableError("failed to read survey settings", err)
+	}
+	if settings == nil {
+		return &domain.SurveySettings{SurveyUID: surveyUID}, nil
+	}
+	return settings, nil
+}
+
+// PutSurveySettings implements domain.SurveySettingsStore.PutSurveySettings. The results
+// access of every respondent, and the index documents of an anonymous survey's responses,
+// are brought in line even when the settings are unchanged, so storing the same settings
+// again retries the changes that failed.
+func (s *SurveySettingsStore) PutSurveySettings(ctx context.Context, settings *domain.SurveySettings) (*domain.SurveySettings, *domain.ResultsAccessSync, error) {
+	settings.SurveyUID = strings.TrimSpace(settings.SurveyUID)
+	if settings.SurveyUID == "" {
+		return nil, nil, domain.NewValidationError("a survey uid is required")
+	}
+
+	entry, err := s.mappingsKV.Get(ctx, surveyMappingKey(settings.SurveyUID))
+	if errors.Is(err, jetstream.ErrKeyNotFound) || (err == nil && isTombstonedMapping(entry.Value())) {
+		return nil, nil, domain.NewNotFoundError(fmt.Sprintf("survey %s has not been synced", settings.SurveyUID))
+	}
+	if err != nil {
+		return nil, nil, domain.NewUnavailableError("failed to look up survey", err)
+	}
+
+	// Turning anonymity off would re-identify responses that were collected as anonymous.
+	previous, err := loadSurveySettings(ctx, s.mappingsKV, settings.SurveyUID)
+	if err != nil {
+		return nil, nil, domain.NewUnavailableError("failed to read survey settings", err)
+	}
+	if previous != nil && previous.Anonymous && !settings.Anonymous {
+		return nil, nil, domain.NewConflictError(fmt.Sprintf("survey %s is anonymous and cannot be made identified again", settings.SurveyUID))
+	}
+
+	settings.UpdatedAt = time.Now().UTC()
+	data, err := json.Marshal(settings)
+	if err != nil {
+		return nil, nil, domain.NewInternalError("failed to marshal survey settings", err)
+	}
+	if _, err := s.mappingsKV.Put(ctx, surveySettingsKey(settings.SurveyUID), data); err != nil {
+		return nil, nil, domain.NewUnavailableError("failed to store survey settings", err)
+	}
+
+	logger := s.logger.With("survey_uid", settings.SurveyUID, "share_results", settings.ShareResults, "anonymous", settings.Anonymous)
+	if settings.Anonymous {
+		if err := s.republishResponses(ctx, settings.SurveyUID, logger); err != nil {
+			return nil, nil, domain.NewUnavailableError("survey settings were stored but some responses could not be de-identified; store them again to retry", err)
+		}
+	}
+
+	var sync *domain.ResultsAccessSync
+	if settings.ShareResults {
+		sync, err = s.grantResultsAccess(ctx, settings.SurveyUID, logger)
+	} else {
+		sync, err = s.revokeResultsAccess(ctx, settings.SurveyUID, logger)
+	}
+	if err != nil {
+		return nil, nil, domain.NewUnavailableError("survey settings were stored but results access could not be updated; store them again to retry", err)
+	}
+	logger.With("granted", sync.Granted, "revoked", sync.Revoked, "failed", sync.Failed).InfoContext(ctx, "updated survey results access")
+	return settings, sync, nil
+}
+
+// republishResponses runs every indexed response of the survey through the response
+// handler, which reads the stored settings. Responses whose index document already
+// matches are skipped by their content hash.
+func (s *SurveySettingsStore) republishResponses(ctx context.Context, surveyUID string, logger *slog.Logger) error {
+	keys, err := listKVKeys(ctx, s.mappingsKV, surveyResponseIndexKey(surveyUID, "*"))
+	if err != nil {
+		return fmt.Errorf("failed to list survey responses: %w", err)
+	}
+
+	var retry atomic.Int32
+	indexPrefix := surveyResponseIndexKey(surveyUID, "")
+	functions := make([]func() error, 0, len(keys))
+	for _, indexKey := range keys {
+		responseUID := strings.TrimPrefix(indexKey, indexPrefix)
+		functions = append(functions, func() error {
+			if refreshSurveyResponse(ctx, indexKey, responseUID, s.publisher, s.idMapper, s.mappingsKV, s.v1ObjectsKV, logger) == refreshRetry {
+				retry.Add(1)
+			}
+			return nil
+		})
+	}
+	if err := concurrent.NewWorkerPool(surveyResponseFanoutWorkers).Run(ctx, functions...); err != nil {
+		return err
+	}
+	if n := retry.Load(); n > 0 {
+		return fmt.Errorf("%d of %d responses could not be republished", n, len(keys))
+	}
+	return nil
+}
+
+// grantResultsAccess grants results_viewer to the user of every indexed response of the survey
+func (s *SurveySettingsStore) grantResultsAccess(ctx context.Context, surveyUID string, logger *slog.Logger) (*domain.ResultsAccessSync, error) {
+	keys, err := listKVKeys(ctx, s.mappingsKV, surveyResponseIndexKey(surveyUID, "*"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list survey responses: %w", err)
+	}
+
+	var granted, failed atomic.Int32
+	indexPrefix := surveyResponseIndexKey(surveyUID, "")
+	functions := make([]func() error, 0, len(keys))
+	for _, indexKey := range keys {
+		responseUID := strings.TrimPrefix(indexKey, indexPrefix)
+		functions = append(functions, func() error {
+			responseLogger := logger.With("survey_response_uid", responseUID)
+			username, err := s.responseUsername(ctx, responseUID)
+			if err != nil {
+				responseLogger.With(errKey, err).WarnContext(ctx, "failed to read survey response for results access")
+				failed.Add(1)
+				return nil
+			}
+			if username == "" {
+				return nil
+			}
+			if err := grantResultsViewer(ctx, s.publisher, s.mappingsKV, surveyUID, responseUID, username, responseLogger); err != nil {
+				responseLogger.With(errKey, err).WarnContext(ctx, "failed to grant results access")
+				failed.Add(1)
+				return nil
+			}
+			granted.Add(1)
+			return nil
+		})
+	}
+	if err := concurrent.NewWorkerPool(resultsAccessWorkers).Run(ctx, functions...); err != nil {
+		return nil, err
+	}
+	return &domain.ResultsAccessSync{Granted: int(granted.Load()), Failed: int(failed.Load())}, nil
+}
+
+// revokeResultsAccess revokes every results_viewer grant recorded for the survey
+func (s *SurveySettingsStore) revokeResultsAccess(ctx context.Context, surveyUID string, logger *slog.Logger) (*domain.ResultsAccessSync, error) {
+	keys, err := listKVKeys(ctx, s.mappingsKV, resultsViewerKey(surveyUID, "*"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list results access grants: %w", err)
+	}
+
+	// A user with several responses is revoked once, after which all their records go.
+	keysByUser := map[string][]string{}
+	for _, key := range keys {
+		entry, err := s.mappingsKV.Get(ctx, key)
+		if errors.Is(err, jetstream.ErrKeyNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read results access grant: %w", err)
+		}
+		username := string(entry.Value())
+		keysByUser[username] = append(keysByUser[username], key)
+	}
+
+	var revoked, failed atomic.Int32
+	functions := make([]func() error, 0, len(keysByUser))
+	for username, userKeys := range keysByUser {
+		functions = append(functions, func() error {
+			userLogger := logger.With("username", username)
+			if err := s.publisher.PublishSurveyResultsViewer(ctx, surveyUID, username, false); err != nil {
+				userLogger.With(errKey, err).WarnContext(ctx, "failed to revoke results access")
+				failed.Add(1)
+				return nil
+			}
+			for _, key := range userKeys {
+				if err := s.mappingsKV.Delete(ctx, key); err != nil {
+					userLogger.With(errKey, err, "grant_key", key).WarnContext(ctx, "failed to remove results access grant record")
+				}
+			}
+			revoked.Add(1)
+			return nil
+		})
+	}
+	if err := concurrent.NewWorkerPool(resultsAccessWorkers).Run(ctx, functions...); err != nil {
+		return nil, err
+	}
+	return &domain.ResultsAccessSync{Revoked: int(revoked.Load()), Failed: int(failed.Load())}, nil
+}
+
+// responseUsername returns the LFX username of a live survey response, or "" when it has
+// none or was deleted
+func (s *SurveySettingsStore) responseUsername(ctx context.Context, responseUID string) (string, error) {
+	entry, err := s.v1ObjectsKV.Get(ctx, "itx-survey-responses."+responseUID)
+	if errors.Is(err, jetstream.ErrKeyNotFound) || errors.Is(err, jetstream.ErrKeyDeleted) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	data, err := decodeKVValue(entry.Value())
+	if err != nil {
+		return "", err
+	}
+	if deletedAt, exists := data["_sdc_deleted_at"]; exists && deletedAt != nil && deletedAt != "" {
+		return "", nil
+	}
+	username, _ := data["username"].(string)
+	return strings.TrimSpace(username), nil
+}
+
+// grantResultsViewer grants a response's user results_viewer on the survey and records
+// the grant. A different user recorded for the response is revoked first.
+func grantResultsViewer(ctx context.Context, publisher domain.EventPublisher, mappingsKV jetstream.KeyValue, surveyUID, responseUID, username string, logger *slog.Logger) error {
+	if recorded, err := recordedResultsViewer(ctx, mappingsKV, surveyUID, responseUID); err != nil {
+		return err
+	} else if recorded != "" && recorded != username {
+		if err := revokeResultsViewer(ctx, publisher, mappingsKV, surveyUID, responseUID, recorded, logger); err != nil {
+			return err
+		}
+	}
+	if err := publisher.PublishSurveyResultsViewer(ctx, surveyUID, username, true); err != nil {
+		return err
+	}
+	if _, err := mappingsKV.Put(ctx, resultsViewerKey(surveyUID, responseUID), []byte(username)); err != nil {
+		logger.With(errKey, err).WarnContext(ctx, "failed to record results access grant; it will not be revoked with the response")
+	}
+	return nil
+}
+
+// revokeResultsViewer removes the grant recorded for a response. The user keeps
+// results_viewer while another of their responses to the survey still grants it.
+func revokeResultsViewer(ctx context.Context, publisher domain.EventPublisher, mappingsKV jetstream.KeyValue, surveyUID, responseUID, username string, logger *slog.Logger) error {
+	key := resultsViewerKey(surveyUID, responseUID)
+	keys, err := listKVKeys(ctx, mappingsKV, resultsViewerKey(surveyUID, "*"))
+	if err != nil {
+		return fmt.Errorf("failed to list results access grants: %w", err)
+	}
+	shared := false
+	for _, other := range keys {
+		if other == key {
+			continue
+		}
+		if entry, err := mappingsKV.Get(ctx, other); err == nil && string(entry.Value()) == username {
+			shared = true
+			break
+		}
+	}
+	if !shared {
+		if err := publisher.PublishSurveyResultsViewer(ctx, surveyUID, username, false); err != nil {
+			return err
+		}
+	}
+	if err := mappingsKV.Delete(ctx, key); err != nil {
+		logger.With(errKey, err).WarnContext(ctx, "failed to remove results access grant record")
+	}
+	return nil
+}
+
+func recordedResultsViewer(ctx context.Context, mappingsKV jetstream.KeyValue, surveyUID, responseUID string) (string, error) {
+	entry, err := mappingsKV.Get(ctx, resultsViewerKey(surveyUID, responseUID))
+	if errors.Is(err, jetstream.ErrKeyNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read results access grant: %w", err)
+	}
+	return string(entry.Value()), nil
+}
+
+// syncResponseResultsAccess keeps the results_viewer grant of a synced response in line
+// with its survey's share_results setting, nil when none are stored, and its current
+// username. Failures are logged only; storing the survey settings again retries them.
+func syncResponseResultsAccess(ctx context.Context, publisher domain.EventPublisher, mappingsKV jetstream.KeyValue, settings *domain.SurveySettings, surveyUID, responseUID, username string, logger *slog.Logger) {
+	if settings != nil && settings.ShareResults && username != "" {
+		if err := grantResultsViewer(ctx, publisher, mappingsKV, surveyUID, responseUID, username, logger); err != nil {
+			logger.With(errKey, err).WarnContext(ctx, "failed to grant results access")
+		}
+		return
+	}
+
+	recorded, err := recordedResultsViewer(ctx, mappingsKV, surveyUID, responseUID)
+	if err != nil {
+		logger.With(errKey, err).WarnContext(ctx, "failed to check results access grant")
+		return
+	}
+	if recorded == "" {
+		return
+	}
+	if err := revokeResultsViewer(ctx, publisher, mappingsKV, surveyUID, responseUID, recorded, logger); err != nil {
+		logger.With(errKey, err).WarnContext(ctx, "failed to revoke results access")
+	}
+}
+
+// revokeDeletedResponseResultsAccess revokes the grants recorded for a deleted response.
+// The delete event only carries the response UID, so the survey segment is a wildcard.
+func revokeDeletedResponseResultsAccess(ctx context.Context, publisher domain.EventPublisher, mappingsKV jetstream.KeyValue, responseUID string, logger *slog.Logger) {
+	keys, err := listKVKeys(ctx, mappingsKV, resultsViewerKey("*", responseUID))
+	if err != nil {
+		logger.With(errKey, err).WarnContext(ctx, "failed to look up results access grants of deleted response")
+		return
+	}
+	for _, key := range keys {
+		surveyUID := strings.TrimSuffix(strings.TrimPrefix(key, resultsViewerPrefix+"."), "."+responseUID)
+		entry, err := mappingsKV.Get(ctx, key)
+		if err != nil {
+			logger.With(errKey, err, "grant_key", key).WarnContext(ctx, "failed to read results access grant of deleted response")
+			continue
+		}
+		if err := revokeResultsViewer(ctx, publisher, mappingsKV, surveyUID, responseUID, string(entry.Value()), logger); err != nil {
+			logger.With(errKey, err, "survey_uid", surveyUID).WarnContext(ctx, "failed to revoke results access of deleted response")
+		}
+	}
+}
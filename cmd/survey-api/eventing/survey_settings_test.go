@@ -0,0 +1,156 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/infrastructure/idmapper"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSurveySettingsStore(t *testing.T) {
+	objects, mappings := setupEventBuckets(t)
+	ctx := context.Background()
+	logger := slog.Default()
+
+	_, err := mappings.PutString(ctx, surveyMappingKey("s-1"), "1")
+	require.NoError(t, err)
+	responses := map[string]map[string]any{
+		"r-1": {"id": "r-1", "survey_id": "s-1", "username": "jdoe"},
+		// A second response of the same user shares the grant.
+		"r-2": {"id": "r-2", "survey_id": "s-1", "username": "jdoe"},
+		"r-3": {"id": "r-3", "survey_id": "s-1", "username": "asmith"},
+		// Participants without an LFX username cannot be granted.
+		"r-4": {"id": "r-4", "survey_id": "s-1", "email": "new@example.com"},
+	}
+	for uid, response := range responses {
+		putJSON(t, objects, "itx-survey-responses."+uid, response)
+		_, err := mappings.PutString(ctx, surveyResponseIndexKey("s-1", uid), "1")
+		require.NoError(t, err)
+	}
+
+	publisher := &recordingPublisher{}
+	store := newSurveySettingsStore(mappings, objects, publisher, idmapper.NewNoOpMapper(), logger)
+
+	got, err := store.GetSurveySettings(ctx, "s-1")
+	require.NoError(t, err)
+	assert.False(t, got.ShareResults, "results are not shared by default")
+
+	_, _, err = store.PutSurveySettings(ctx, &domain.SurveySettings{SurveyUID: "s-unknown", ShareResults: true})
+	assert.Equal(t, domain.ErrorTypeNotFound, domain.GetErrorType(err))
+
+	stored, sync, err := store.PutSurveySettings(ctx, &domain.SurveySettings{SurveyUID: "s-1", ShareResults: true, UpdatedBy: "pm"})
+	require.NoError(t, err)
+	assert.False(t, stored.UpdatedAt.IsZero())
+	assert.Equal(t, &domain.ResultsAccessSync{Granted: 3}, sync)
+	assert.ElementsMatch(t, []resultsViewerChange{
+		{surveyUID: "s-1", username: "jdoe", granted: true},
+		{surveyUID: "s-1", username: "jdoe", granted: true},
+		{surveyUID: "s-1", username: "asmith", granted: true},
+	}, publisher.resultsViewerChanges())
+
+	t.Run("a synced response follows the setting", func(t *testing.T) {
+		shared := &domain.SurveySettings{SurveyUID: "s-1", ShareResults: true}
+		publisher.events = nil
+		syncResponseResultsAccess(ctx, publisher, mappings, shared, "s-1", "r-5", "bnew", logger)
+		assert.Equal(t, []resultsViewerChange{{surveyUID: "s-1", username: "bnew", granted: true}}, publisher.resultsViewerChanges())
+
+		// A response whose username changed moves the grant to the new user.
+		publisher.events = nil
+		syncResponseResultsAccess(ctx, publisher, mappings, shared, "s-1", "r-5", "bnew2", logger)
+		assert.Equal(t, []resultsViewerChange{
+			{surveyUID: "s-1", username: "bnew", granted: false},
+			{surveyUID: "s-1", username: "bnew2", granted: true},
+		}, publisher.resultsViewerChanges())
+	})
+
+	t.Run("deleting a response keeps a grant shared with another response", func(t *testing.T) {
+		publisher.events = nil
+		revokeDeletedResponseResultsAccess(ctx, publisher, mappings, "r-2", logger)
+		assert.Empty(t, publisher.resultsViewerChanges())
+		_, err := mappings.Get(ctx, resultsViewerKey("s-1", "r-2"))
+		assert.ErrorIs(t, err, jetstream.ErrKeyNotFound)
+
+		revokeDeletedResponseResultsAccess(ctx, publisher, mappings, "r-5", logger)
+		assert.Equal(t, []resultsViewerChange{{surveyUID: "s-1", username: "bnew2", granted: false}}, publisher.resultsViewerChanges())
+	})
+
+	t.Run("turning sharing off revokes every grant", func(t *testing.T) {
+		publisher.events = nil
+		_, sync, err := store.PutSurveySettings(ctx, &domain.SurveySettings{SurveyUID: "s-1"})
+		require.NoError(t, err)
+		assert.Equal(t, &domain.ResultsAccessSync{Revoked: 2}, sync)
+		assert.ElementsMatch(t, []resultsViewerChange{
+			{surveyUID: "s-1", username: "jdoe", granted: false},
+			{surveyUID: "s-1", username: "asmith", granted: false},
+		}, publisher.resultsViewerChanges())
+
+		keys, err := listKVKeys(ctx, mappings, resultsViewerKey("s-1", "*"))
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+
+		publisher.events = nil
+		syncResponseResultsAccess(ctx, publisher, mappings, &domain.SurveySettings{SurveyUID: "s-1"}, "s-1", "r-6", "cnew", logger)
+		assert.Empty(t, publisher.resultsViewerChanges(), "new responses are not granted while sharing is off")
+	})
+}
+
+func TestSurveySettingsStore_Anonymous(t *testing.T) {
+	objects, mappings := setupEventBuckets(t)
+	ctx := context.Background()
+	logger := slog.Default()
+
+	putJSON(t, objects, "itx-surveys.s-1", map[string]any{"id": "s-1", "survey_title": "Climate Survey"})
+	_, err := mappings.PutString(ctx, surveyMappingKey("s-1"), "1")
+	require.NoError(t, err)
+	for _, id := range []string{"r-1", "r-2"} {
+		putJSON(t, objects, "itx-survey-responses."+id, map[string]any{
+			"id":         id,
+			"survey_id":  "s-1",
+			"email":      id + "@example.com",
+			"first_name": "Jane",
+			"username":   id,
+			"project":    map[string]any{"id": "p-1", "name": "Project"},
+		})
+	}
+
+	// The responses were synced before the survey was made anonymous.
+	publisher := &recordingPublisher{}
+	for _, id := range []string{"r-1", "r-2"} {
+		refreshSurveyResponse(ctx, surveyResponseIndexKey("s-1", id), id, publisher, idmapper.NewNoOpMapper(), mappings, objects, logger)
+	}
+	for _, r := range publisher.responses() {
+		assert.False(t, r.Anonymous)
+	}
+
+	publisher.events = nil
+	store := newSurveySettingsStore(mappings, objects, publisher, idmapper.NewNoOpMapper(), logger)
+	_, _, err = store.PutSurveySettings(ctx, &domain.SurveySettings{SurveyUID: "s-1", Anonymous: true})
+	require.NoError(t, err)
+
+	responses := publisher.responses()
+	require.Len(t, responses, 2, "every indexed response is republished")
+	for _, r := range responses {
+		assert.True(t, r.Anonymous)
+		assert.NotEmpty(t, r.Username, "the access message keeps the respondent's owner relation")
+	}
+
+	// Storing the same settings again republishes nothing.
+	publisher.events = nil
+	_, _, err = store.PutSurveySettings(ctx, &domain.SurveySettings{SurveyUID: "s-1", Anonymous: true, ShareResults: true})
+	require.NoError(t, err)
+	assert.Empty(t, publisher.responses())
+
+	_, _, err = store.PutSurveySettings(ctx, &domain.SurveySettings{SurveyUID: "s-1"})
+	assert.Equal(t, domain.ErrorTypeConflict, domain.GetErrorType(err))
+	got, err := store.GetSurveySettings(ctx, "s-1")
+	require.NoError(t, err)
+	assert.True(t, got.Anonymous)
+}
@@ -0,0 +1,204 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-survey-service/pkg/kvwatch"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	defaultSurveyTemplatePageSize = 50
+	maxSurveyTemplatePageSize     = 200
+)
+
+// SurveyTemplateCatalog is an in-memory read model of the surveymonkey-surveys records
+// in v1-objects. It watches the bucket directly rather than hooking into the KV consumer:
+// the durable consumer does not redeliver acknowledged entries, so a restarted service
+// would otherwise start with an empty catalog.
+type SurveyTemplateCatalog struct {
+	v1ObjectsKV jetstream.KeyValue
+	logger      *slog.Logger
+
+	mu        sync.RWMutex
+	templates map[string]*domain.SurveyTemplateData // keyed by the v1 key UID
+	ready     bool
+
+	watch *kvwatch.Watch
+}
+
+// newSurveyTemplateCatalog creates an empty catalog; Start loads and follows the feed
+func newSurveyTemplateCatalog(v1ObjectsKV jetstream.KeyValue, logger *slog.Logger) *SurveyTemplateCatalog {
+	return &SurveyTemplateCatalog{
+		v1ObjectsKV: v1ObjectsKV,
+		logger:      logger.With("component", "survey_template_catalog"),
+		templates:   make(map[string]*domain.SurveyTemplateData),
+	}
+}
+
+// Start watches the template records in the background. The catalog reports itself
+// unavailable until the watcher has delivered every existing record, and again while
+// a closed watcher is re-created and catches up.
+func (c *SurveyTemplateCatalog) Start(ctx context.Context) error {
+	watch, err := kvwatch.Start(ctx, func(ctx context.Context) (jetstream.KeyWatcher, error) {
+		return c.v1ObjectsKV.Watch(ctx, surveyTemplateKeyPrefix+".*")
+	}, kvwatch.Mirror{
+		Reset:  c.reset,
+		Apply:  c.apply,
+		Synced: c.markReady,
+	}, c.logger)
+	if err != nil {
+		return fmt.Errorf("failed to watch survey templates: %w", err)
+	}
+	c.watch = watch
+	return nil
+}
+
+// Stop stops following the feed and waits for the watcher to finish
+func (c *SurveyTemplateCatalog) Stop() {
+	if c.watch == nil {
+		return
+	}
+	c.watch.Stop()
+}
+
+// reset drops the catalog after its watcher closed; the re-created watcher reloads it
+func (c *SurveyTemplateCatalog) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.templates = make(map[string]*domain.SurveyTemplateData)
+	c.ready = false
+	c.logger.Warn("survey template watcher closed, catalog unavailable until it reloads")
+}
+
+func (c *SurveyTemplateCatalog) markReady() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.ready {
+		c.ready = true
+		c.logger.Info("survey template catalog loaded", "templates", len(c.templates))
+	}
+}
+
+// apply updates the catalog from one v1-objects entry
+func (c *SurveyTemplateCatalog) apply(entry jetstream.KeyValueEntry) {
+	_, uid, _ := strings.Cut(entry.Key(), ".")
+	if uid == "" {
+		return
+	}
+
+	template, err := surveyTemplateFromEntry(entry)
+	if err != nil {
+		c.logger.With(errKey, err, "key", entry.Key()).Warn("failed to convert survey template for catalog")
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if template == nil {
+		delete(c.templates, uid)
+		return
+	}
+	if template.ID == "" {
+		template.ID = uid
+	}
+	c.templates[uid] = template
+}
+
+// surveyTemplateFromEntry converts a template entry, returning nil for a hard or soft delete
+func surveyTemplateFromEntry(entry jetstream.KeyValueEntry) (*domain.SurveyTemplateData, error) {
+	if entry.Operation() != jetstream.KeyValuePut {
+		return nil, nil
+	}
+	v1Data, err := decodeKVValue(entry.Value())
+	if err != nil {
+		return nil, err
+	}
+	if deletedAt, exists := v1Data["_sdc_deleted_at"]; exists && deletedAt != nil && deletedAt != "" {
+		return nil, nil
+	}
+	return convertMapToSurveyTemplateData(v1Data)
+}
+
+// List implements domain.SurveyTemplateCatalog.List
+func (c *SurveyTemplateCatalog) List(_ context.Context, filter domain.SurveyTemplateFilter) (*domain.SurveyTemplatePage, error) {
+	if filter.Offset < 0 {
+		return nil, domain.NewValidationError("offset must not be negative")
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultSurveyTemplatePageSize
+	}
+	filter.Limit = min(filter.Limit, maxSurveyTemplatePageSize)
+
+	c.mu.RLock()
+	if !c.ready {
+		c.mu.RUnlock()
+		return nil, errSurveyTemplateCatalogLoading
+	}
+	var matches []*domain.SurveyTemplateData
+	for _, t := range c.templates {
+		if matchesSurveyTemplateFilter(t, filter) {
+			matches = append(matches, t)
+		}
+	}
+	c.mu.RUnlock()
+
+	slices.SortFunc(matches, func(a, b *domain.SurveyTemplateData) int {
+		return cmp.Or(
+			cmp.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title)),
+			cmp.Compare(a.ID, b.ID),
+		)
+	})
+
+	page := &domain.SurveyTemplatePage{Templates: []domain.SurveyTemplateData{}, Total: len(matches)}
+	if filter.Offset >= len(matches) {
+		return page, nil
+	}
+	for _, t := range matches[filter.Offset:min(filter.Offset+filter.Limit, len(matches))] {
+		page.Templates = append(page.Templates, *t)
+	}
+	return page, nil
+}
+
+// Get implements domain.SurveyTemplateCatalog.Get
+func (c *SurveyTemplateCatalog) Get(_ context.Context, id string) (*domain.SurveyTemplateData, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if !c.ready {
+		return nil, errSurveyTemplateCatalogLoading
+	}
+	t, ok := c.templates[id]
+	if !ok {
+		return nil, domain.NewNotFoundError(fmt.Sprintf("survey template %s not found", id))
+	}
+	template := *t
+	return &template, nil
+}
+
+var errSurveyTemplateCatalogLoading = domain.NewUnavailableError("survey template catalog is still loading")
+
+func matchesSurveyTemplateFilter(t *domain.SurveyTemplateData, filter domain.SurveyTemplateFilter) bool {
+	if filter.Title != "" {
+		title := strings.ToLower(filter.Title)
+		if !strings.Contains(strings.ToLower(t.Title), title) && !strings.Contains(strings.ToLower(t.Nickname), title) {
+			return false
+		}
+	}
+	if filter.Category != "" && !strings.EqualFold(t.Category, filter.Category) {
+		return false
+	}
+	if filter.Language != "" && !strings.EqualFold(t.Language, filter.Language) {
+		return false
+	}
+	return true
+}
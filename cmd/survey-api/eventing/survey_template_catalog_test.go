@@ -0,0 +1,108 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSurveyTemplateCatalog(t *testing.T) {
+	objects, _ := setupEventBuckets(t)
+	ctx := context.Background()
+
+	putJSON(t, objects, "surveymonkey-surveys.1", map[string]any{"id": "1", "title": "Community NPS", "language": "en", "category": "community", "question_count": "12"})
+	putJSON(t, objects, "surveymonkey-surveys.2", map[string]any{"id": "2", "title": "Board survey", "nickname": "NPS board", "language": "en"})
+	putJSON(t, objects, "surveymonkey-surveys.3", map[string]any{"id": "3", "title": "Encuesta NPS", "language": "es"})
+	putJSON(t, objects, "itx-surveys.s-1", map[string]any{"id": "s-1", "survey_title": "Not a template"})
+
+	catalog := newSurveyTemplateCatalog(objects, slog.Default())
+	_, err := catalog.Get(ctx, "1")
+	assert.Equal(t, domain.ErrorTypeUnavailable, domain.GetErrorType(err), "unavailable before the feed is loaded")
+
+	require.NoError(t, catalog.Start(ctx))
+	t.Cleanup(catalog.Stop)
+	require.Eventually(t, func() bool {
+		_, err := catalog.Get(ctx, "1")
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	template, err := catalog.Get(ctx, "1")
+	require.NoError(t, err)
+	assert.Equal(t, 12, template.QuestionCount)
+
+	page, err := catalog.List(ctx, domain.SurveyTemplateFilter{Title: "nps", Language: "EN"})
+	require.NoError(t, err)
+	require.Equal(t, 2, page.Total)
+	assert.Equal(t, "Board survey", page.Templates[0].Title, "nickname matches and results are ordered by title")
+	assert.Equal(t, "Community NPS", page.Templates[1].Title)
+
+	page, err = catalog.List(ctx, domain.SurveyTemplateFilter{Offset: 2, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, 3, page.Total)
+	require.Len(t, page.Templates, 1)
+	assert.Equal(t, "3", page.Templates[0].ID)
+
+	// Soft and hard deletes remove templates; updates replace them
+	putJSON(t, objects, "surveymonkey-surveys.2", map[string]any{"id": "2", "title": "Board survey", "_sdc_deleted_at": "2026-01-01T00:00:00Z"})
+	require.NoError(t, objects.Delete(ctx, "surveymonkey-surveys.3"))
+	putJSON(t, objects, "surveymonkey-surveys.1", map[string]any{"id": "1", "title": "Community NPS v2"})
+	require.Eventually(t, func() bool {
+		page, err := catalog.List(ctx, domain.SurveyTemplateFilter{})
+		return err == nil && page.Total == 1 && page.Templates[0].Title == "Community NPS v2"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, err = catalog.Get(ctx, "2")
+	assert.Equal(t, domain.ErrorTypeNotFound, domain.GetErrorType(err))
+}
+
+func TestSurveyTemplateCatalog_EmptyFeedIsReady(t *testing.T) {
+	objects, _ := setupEventBuckets(t)
+	ctx := context.Background()
+
+	catalog := newSurveyTemplateCatalog(objects, slog.Default())
+	require.NoError(t, catalog.Start(ctx))
+	t.Cleanup(catalog.Stop)
+
+	require.Eventually(t, func() bool {
+		page, err := catalog.List(ctx, domain.SurveyTemplateFilter{})
+		return err == nil && page.Total == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestSurveyTemplateCatalog_UnavailableUntilReloaded(t *testing.T) {
+	objects, _ := setupEventBuckets(t)
+	ctx := context.Background()
+	putJSON(t, objects, "surveymonkey-surveys.1", map[string]any{"id": "1", "title": "Community NPS"})
+
+	catalog := newSurveyTemplateCatalog(objects, slog.Default())
+	require.NoError(t, catalog.Start(ctx))
+	t.Cleanup(catalog.Stop)
+	require.Eventually(t, func() bool {
+		_, err := catalog.Get(ctx, "1")
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The watcher closed: nothing is served until the new one has caught up.
+	catalog.reset()
+	_, err := catalog.Get(ctx, "1")
+	assert.Equal(t, domain.ErrorTypeUnavailable, domain.GetErrorType(err))
+	_, err = catalog.List(ctx, domain.SurveyTemplateFilter{})
+	assert.Equal(t, domain.ErrorTypeUnavailable, domain.GetErrorType(err))
+
+	entry, err := objects.Get(ctx, "surveymonkey-surveys.1")
+	require.NoError(t, err)
+	catalog.apply(entry)
+	catalog.markReady()
+
+	template, err := catalog.Get(ctx, "1")
+	require.NoError(t, err)
+	assert.Equal(t, "Community NPS", template.Title)
+}
@@ -4,10 +4,13 @@
 package eventing
 
 import (
+	"bytes"
+	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strconv"
 
 	indexerConstants "github.com/linuxfoundation/lfx-v2-indexer-service/pkg/constants"
@@ -15,72 +18,91 @@ import (
 	"github.com/nats-io/nats.go/jetstream"
 )
 
+// surveyTemplateKeyPrefix is the v1-objects key prefix of survey template records
+const surveyTemplateKeyPrefix = "surveymonkey-surveys"
+
 // SurveyTemplateDBRaw represents raw survey template data from the surveymonkey-surveys DynamoDB table
 type SurveyTemplateDBRaw struct {
-	ID              string            `json:"id"`
-	Title           string            `json:"title"`
-	Href            string            `json:"href"`
-	Nickname        string            `json:"nickname"`
-	QuestionCount   int               `json:"question_count"`
-	AnalyzeUrl      string            `json:"analyze_url"`
-	EditUrl         string            `json:"edit_url"`
-	CollectUrl      string            `json:"collect_url"`
-	Preview         string            `json:"preview"`
-	DateCreated     string            `json:"date_created"`
-	DateModified    string            `json:"date_modified"`
-	Language        string            `json:"language"`
-	FolderID        string            `json:"folder_id"`
-	PageCount       int               `json:"page_count"`
-	Category        string            `json:"category"`
-	IsOwner         bool              `json:"is_owner"`
-	CustomVariables map[string]string `json:"custom_variables"`
+	ID              string                    `json:"id"`
+	Title           string                    `json:"title"`
+	Href            string                    `json:"href"`
+	Nickname        string                    `json:"nickname"`
+	QuestionCount   int                       `json:"question_count"`
+	AnalyzeUrl      string                    `json:"analyze_url"`
+	EditUrl         string                    `json:"edit_url"`
+	CollectUrl      string                    `json:"collect_url"`
+	Preview         string                    `json:"preview"`
+	DateCreated     string                    `json:"date_created"`
+	DateModified    string                    `json:"date_modified"`
+	Language        string                    `json:"language"`
+	FolderID        string                    `json:"folder_id"`
+	PageCount       int                       `json:"page_count"`
+	Category        string                    `json:"category"`
+	IsOwner         bool                      `json:"is_owner"`
+	CustomVariables map[string]string         `json:"custom_variables"`
+	Pages           []SurveyTemplatePageDBRaw `json:"pages"`
+}
+
+// SurveyTemplatePageDBRaw is one page of the SurveyMonkey survey details stored on a template record
+type SurveyTemplatePageDBRaw struct {
+	ID        string                        `json:"id"`
+	Title     string                        `json:"title"`
+	Position  interface{}                   `json:"position"`
+	Questions []SurveyTemplateQuestionDBRaw `json:"questions"`
+}
+
+// SurveyTemplateQuestionDBRaw is one question in the SurveyMonkey survey details format.
+// Required is an object describing the requirement, or null when the question is optional.
+type SurveyTemplateQuestionDBRaw struct {
+	ID       string          `json:"id"`
+	Position interface{}     `json:"position"`
+	Family   string          `json:"family"`
+	Subtype  string          `json:"subtype"`
+	Required json.RawMessage `json:"required"`
+	Headings []struct {
+		Heading string `json:"heading"`
+	} `json:"headings"`
+	Answers struct {
+		Choices []SurveyTemplateChoiceDBRaw `json:"choices"`
+		Other   *SurveyTemplateChoiceDBRaw  `json:"other"`
+	} `json:"answers"`
+}
+
+// SurveyTemplateChoiceDBRaw is one answer choice in the SurveyMonkey survey details format
+type SurveyTemplateChoiceDBRaw struct {
+	ID       string      `json:"id"`
+	Text     string      `json:"text"`
+	Position interface{} `json:"position"`
+	Weight   interface{} `json:"weight"`
 }
 
 // UnmarshalJSON implements custom unmarshaling to handle both string and int inputs for numeric fields.
 func (s *SurveyTemplateDBRaw) UnmarshalJSON(data []byte) error {
 	tmp := struct {
-		ID              string            `json:"id"`
-		Title           string            `json:"title"`
-		Href            string            `json:"href"`
-		Nickname        string            `json:"nickname"`
-		QuestionCount   interface{}       `json:"question_count"`
-		AnalyzeUrl      string            `json:"analyze_url"`
-		EditUrl         string            `json:"edit_url"`
-		CollectUrl      string            `json:"collect_url"`
-		Preview         string            `json:"preview"`
-		DateCreated     string            `json:"date_created"`
-		DateModified    string            `json:"date_modified"`
-		Language        string            `json:"language"`
-		FolderID        string            `json:"folder_id"`
-		PageCount       interface{}       `json:"page_count"`
-		Category        string            `json:"category"`
-		IsOwner         bool              `json:"is_owner"`
-		CustomVariables map[string]string `json:"custom_variables"`
+		ID              string                    `json:"id"`
+		Title           string                    `json:"title"`
+		Href            string                    `json:"href"`
+		Nickname        string                    `json:"nickname"`
+		QuestionCount   interface{}               `json:"question_count"`
+		AnalyzeUrl      string                    `json:"analyze_url"`
+		EditUrl         string                    `json:"edit_url"`
+		CollectUrl      string                    `json:"collect_url"`
+		Preview         string                    `json:"preview"`
+		DateCreated     string                    `json:"date_created"`
+		DateModified    string                    `json:"date_modified"`
+		Language        string                    `json:"language"`
+		FolderID        string                    `json:"folder_id"`
+		PageCount       interface{}               `json:"page_count"`
+		Category        string                    `json:"category"`
+		IsOwner         bool                      `json:"is_owner"`
+		CustomVariables map[string]string         `json:"custom_variables"`
+		Pages           []SurveyTemplatePageDBRaw `json:"pages"`
 	}{}
 
 	if err := json.Unmarshal(data, &tmp); err != nil {
 		return err
 	}
 
-	convertToInt := func(v interface{}) (int, error) {
-		if v == nil {
-			return 0, nil
-		}
-		switch val := v.(type) {
-		case string:
-			if val == "" {
-				return 0, nil
-			}
-			return strconv.Atoi(val)
-		case float64:
-			return int(val), nil
-		case int:
-			return val, nil
-		default:
-			return 0, fmt.Errorf("invalid type for numeric field: %T", v)
-		}
-	}
-
 	s.ID = tmp.ID
 	s.Title = tmp.Title
 	s.Href = tmp.Href
@@ -96,18 +118,60 @@ func (s *SurveyTemplateDBRaw) UnmarshalJSON(data []byte) error {
 	s.Category = tmp.Category
 	s.IsOwner = tmp.IsOwner
 	s.CustomVariables = tmp.CustomVariables
+	s.Pages = tmp.Pages
 
 	var err error
-	if s.QuestionCount, err = convertToInt(tmp.QuestionCount); err != nil {
+	if s.QuestionCount, err = templateIntField(tmp.QuestionCount); err != nil {
 		return fmt.Errorf("failed to convert question_count: %w", err)
 	}
-	if s.PageCount, err = convertToInt(tmp.PageCount); err != nil {
+	if s.PageCount, err = templateIntField(tmp.PageCount); err != nil {
 		return fmt.Errorf("failed to convert page_count: %w", err)
 	}
 
 	return nil
 }
 
+// templateIntField converts a numeric field that may be stored as a string or a number
+func templateIntField(v interface{}) (int, error) {
+	if v == nil {
+		return 0, nil
+	}
+	switch val := v.(type) {
+	case string:
+		if val == "" {
+			return 0, nil
+		}
+		return strconv.Atoi(val)
+	case float64:
+		return int(val), nil
+	case int:
+		return val, nil
+	default:
+		return 0, fmt.Errorf("invalid type for numeric field: %T", v)
+	}
+}
+
+// surveyTemplateKVHandler syncs surveymonkey-surveys records
+type surveyTemplateKVHandler struct {
+	kvHandlerDeps
+}
+
+func (h *surveyTemplateKVHandler) Prefix() string               { return surveyTemplateKeyPrefix }
+func (h *surveyTemplateKVHandler) ObjectType() string           { return objectTypeSurveyTemplate }
+func (h *surveyTemplateKVHandler) MappingKey(uid string) string { return surveyTemplateMappingKey(uid) }
+
+func (h *surveyTemplateKVHandler) HandleUpdate(ctx context.Context, key string, v1Data map[string]any) bool {
+	return handleSurveyTemplateUpdate(ctx, key, v1Data, h.publisher, h.mappingsKV, h.logger)
+}
+
+func (h *surveyTemplateKVHandler) HandleDelete(ctx context.Context, uid string) bool {
+	return handleSurveyTemplateDelete(ctx, uid, h.publisher, h.mappingsKV, h.logger)
+}
+
+func surveyTemplateMappingKey(id string) string {
+	return fmt.Sprintf("survey_template.%s", id)
+}
+
 // handleSurveyTemplateUpdate processes a survey template update from surveymonkey-surveys records
 // Returns true if the message should be retried (NAK), false if it should be acknowledged (ACK)
 func handleSurveyTemplateUpdate(
@@ -125,6 +189,7 @@ func handleSurveyTemplateUpdate(
 	templateData, err := convertMapToSurveyTemplateData(v1Data)
 	if err != nil {
 		funcLogger.With(errKey, err).ErrorContext(ctx, "failed to convert v1Data to survey template")
+		recordPermanentFailure(ctx, err)
 		return false // Permanent error, ACK and skip
 	}
 
@@ -135,10 +200,21 @@ func handleSurveyTemplateUpdate(
 	funcLogger = funcLogger.With("template_id", templateData.ID)
 
 	// Determine action (created vs updated) by checking if mapping exists
-	mappingKey := fmt.Sprintf("survey_template.%s", templateData.ID)
+	mappingKey := surveyTemplateMappingKey(templateData.ID)
+	hash := contentHash(templateData)
 	indexerAction := indexerConstants.ActionCreated
-	if _, err := mappingsKV.Get(ctx, mappingKey); err == nil {
+	restored := false
+	if entry, err := mappingsKV.Get(ctx, mappingKey); err == nil && isTombstonedMapping(entry.Value()) {
+		// The survey template was deleted and has been restored in v1; recreate it.
+		restored = true
+		funcLogger.InfoContext(ctx, "survey template restored after delete, recreating index document")
+	} else if err == nil {
 		indexerAction = indexerConstants.ActionUpdated
+		if isUnchangedContent(ctx, entry.Value(), hash) {
+			funcLogger.DebugContext(ctx, "survey template content unchanged, skipping publish")
+			recordSkippedUnchanged(ctx, objectTypeSurveyTemplate)
+			return false // Nothing to do, ACK the message
+		}
 	}
 
 	if err := publisher.PublishSurveyTemplateEvent(ctx, string(indexerAction), templateData); err != nil {
@@ -149,7 +225,12 @@ func handleSurveyTemplateUpdate(
 		return false // Permanent error, ACK and skip
 	}
 
-	if _, err := mappingsKV.Put(ctx, mappingKey, []byte("1")); err != nil {
+	recordPublished(ctx, objectTypeSurveyTemplate)
+	if restored {
+		recordRestored(ctx, objectTypeSurveyTemplate)
+	}
+
+	if _, err := mappingsKV.Put(ctx, mappingKey, mappingValue(hash)); err != nil {
 		funcLogger.With(errKey, err).WarnContext(ctx, "failed to store survey template mapping")
 	}
 
@@ -169,6 +250,11 @@ func convertMapToSurveyTemplateData(v1Data map[string]interface{}) (*domain.Surv
 		return nil, fmt.Errorf("failed to unmarshal JSON into SurveyTemplateDBRaw: %w", err)
 	}
 
+	pages, err := convertSurveyTemplatePages(raw.Pages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert survey template pages: %w", err)
+	}
+
 	return &domain.SurveyTemplateData{
 		ID:              raw.ID,
 		Title:           raw.Title,
@@ -187,9 +273,104 @@ func convertMapToSurveyTemplateData(v1Data map[string]interface{}) (*domain.Surv
 		Category:        raw.Category,
 		IsOwner:         raw.IsOwner,
 		CustomVariables: raw.CustomVariables,
+		Pages:           pages,
 	}, nil
 }
 
+// convertSurveyTemplatePages converts the SurveyMonkey page and question structure. Pages,
+// questions and choices are ordered by position, and rating questions get the range of
+// values they accept: the choice weights when the choices are weighted (e.g. NPS 0-10),
+// otherwise 1 to the number of choices.
+func convertSurveyTemplatePages(rawPages []SurveyTemplatePageDBRaw) ([]domain.SurveyQuestionPage, error) {
+	if len(rawPages) == 0 {
+		return nil, nil
+	}
+
+	pages := make([]domain.SurveyQuestionPage, 0, len(rawPages))
+	for _, rawPage := range rawPages {
+		position, err := templateIntField(rawPage.Position)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert position of page %s: %w", rawPage.ID, err)
+		}
+		page := domain.SurveyQuestionPage{ID: rawPage.ID, Title: rawPage.Title, Position: position}
+
+		for _, rawQuestion := range rawPage.Questions {
+			question, err := convertSurveyTemplateQuestion(rawQuestion)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert question %s: %w", rawQuestion.ID, err)
+			}
+			page.Questions = append(page.Questions, question)
+		}
+		slices.SortStableFunc(page.Questions, func(a, b domain.SurveyQuestion) int { return cmp.Compare(a.Position, b.Position) })
+		pages = append(pages, page)
+	}
+	slices.SortStableFunc(pages, func(a, b domain.SurveyQuestionPage) int { return cmp.Compare(a.Position, b.Position) })
+	return pages, nil
+}
+
+func convertSurveyTemplateQuestion(raw SurveyTemplateQuestionDBRaw) (domain.SurveyQuestion, error) {
+	position, err := templateIntField(raw.Position)
+	if err != nil {
+		return domain.SurveyQuestion{}, fmt.Errorf("failed to convert position: %w", err)
+	}
+	question := domain.SurveyQuestion{
+		ID:       raw.ID,
+		Family:   raw.Family,
+		Subtype:  raw.Subtype,
+		Position: position,
+		Required: isRequiredQuestion(raw.Required),
+	}
+	if len(raw.Headings) > 0 {
+		question.Heading = raw.Headings[0].Heading
+	}
+
+	rawChoices := raw.Answers.Choices
+	if raw.Answers.Other != nil {
+		rawChoices = append(slices.Clip(rawChoices), *raw.Answers.Other)
+	}
+	weighted := false
+	for _, rawChoice := range rawChoices {
+		choicePosition, err := templateIntField(rawChoice.Position)
+		if err != nil {
+			return domain.SurveyQuestion{}, fmt.Errorf("failed to convert position of choice %s: %w", rawChoice.ID, err)
+		}
+		question.Choices = append(question.Choices, domain.SurveyQuestionChoice{ID: rawChoice.ID, Text: rawChoice.Text, Position: choicePosition})
+
+		if question.Family != domain.QuestionFamilyMatrix || question.Subtype != domain.QuestionSubtypeRating || rawChoice.Weight == nil {
+			continue
+		}
+		weight, err := templateIntField(rawChoice.Weight)
+		if err != nil {
+			return domain.SurveyQuestion{}, fmt.Errorf("failed to convert weight of choice %s: %w", rawChoice.ID, err)
+		}
+		if !weighted || weight < question.RatingMin {
+			question.RatingMin = weight
+		}
+		if !weighted || weight > question.RatingMax {
+			question.RatingMax = weight
+		}
+		weighted = true
+	}
+	slices.SortStableFunc(question.Choices, func(a, b domain.SurveyQuestionChoice) int { return cmp.Compare(a.Position, b.Position) })
+
+	if question.Family == domain.QuestionFamilyMatrix && question.Subtype == domain.QuestionSubtypeRating && !weighted && len(question.Choices) > 0 {
+		question.RatingMin = 1
+		question.RatingMax = len(question.Choices)
+	}
+	return question, nil
+}
+
+// isRequiredQuestion reports whether a SurveyMonkey "required" value marks the question as
+// required. SurveyMonkey sends an object for required questions and null otherwise.
+func isRequiredQuestion(required json.RawMessage) bool {
+	switch string(bytes.TrimSpace(required)) {
+	case "", "null", "false", `""`:
+		return false
+	default:
+		return true
+	}
+}
+
 // handleSurveyTemplateDelete processes a survey template delete from surveymonkey-surveys records
 // Returns true if the message should be retried (NAK), false if it should be acknowledged (ACK)
 func handleSurveyTemplateDelete(
@@ -203,11 +384,7 @@ func handleSurveyTemplateDelete(
 
 	funcLogger.DebugContext(ctx, "processing survey template delete")
 
-	mappingKey := fmt.Sprintf("survey_template.%s", uid)
-	if entry, err := mappingsKV.Get(ctx, mappingKey); err == nil && isTombstonedMapping(entry.Value()) {
-		funcLogger.DebugContext(ctx, "survey template delete already processed, skipping")
-		return false
-	}
+	mappingKey := surveyTemplateMappingKey(uid)
 
 	templateData := &domain.SurveyTemplateData{ID: uid}
 
@@ -0,0 +1,73 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package eventing
+
+import (
+	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertMapToSurveyTemplateData_Pages(t *testing.T) {
+	v1Data := map[string]any{
+		"id":    "sm-1",
+		"title": "Board NPS",
+		"pages": []any{
+			map[string]any{"id": "p2", "position": "2", "questions": []any{
+				map[string]any{"id": "comments", "position": 1, "family": "open_ended", "subtype": "essay", "required": nil,
+					"headings": []any{map[string]any{"heading": "Anything else?"}}},
+			}},
+			map[string]any{"id": "p1", "position": "1", "questions": []any{
+				map[string]any{"id": "role", "position": "2", "family": "single_choice", "subtype": "vertical",
+					"required": map[string]any{"text": "This question requires an answer.", "type": "all"},
+					"answers": map[string]any{
+						"choices": []any{
+							map[string]any{"id": "c2", "text": "Member", "position": "2"},
+							map[string]any{"id": "c1", "text": "Chair", "position": "1"},
+						},
+						"other": map[string]any{"id": "c-other", "text": "Other", "position": "3"},
+					}},
+				map[string]any{"id": "nps", "position": "1", "family": "matrix", "subtype": "rating",
+					"answers": map[string]any{"choices": []any{
+						map[string]any{"id": "n0", "position": "1", "weight": "0"},
+						map[string]any{"id": "n10", "position": "11", "weight": 10},
+					}}},
+				map[string]any{"id": "stars", "position": "3", "family": "matrix", "subtype": "rating",
+					"answers": map[string]any{"choices": []any{
+						map[string]any{"id": "s1"}, map[string]any{"id": "s2"}, map[string]any{"id": "s3"},
+					}}},
+			}},
+		},
+	}
+
+	template, err := convertMapToSurveyTemplateData(v1Data)
+	require.NoError(t, err)
+	require.Len(t, template.Pages, 2)
+	assert.Equal(t, "p1", template.Pages[0].ID, "pages are ordered by position")
+
+	questions := template.Pages[0].Questions
+	require.Len(t, questions, 3)
+	assert.Equal(t, []string{"nps", "role", "stars"}, []string{questions[0].ID, questions[1].ID, questions[2].ID})
+
+	nps := questions[0]
+	assert.False(t, nps.Required)
+	assert.Equal(t, 0, nps.RatingMin, "weighted rating range")
+	assert.Equal(t, 10, nps.RatingMax)
+
+	role := questions[1]
+	assert.True(t, role.Required)
+	assert.Equal(t, domain.QuestionFamilySingleChoice, role.Family)
+	require.Len(t, role.Choices, 3)
+	assert.Equal(t, "c1", role.Choices[0].ID, "choices are ordered by position")
+	assert.Equal(t, "c-other", role.Choices[2].ID, "the other option is a valid choice")
+
+	assert.Equal(t, 1, questions[2].RatingMin, "unweighted rating range")
+	assert.Equal(t, 3, questions[2].RatingMax)
+
+	comments := template.Pages[1].Questions[0]
+	assert.False(t, comments.Required)
+	assert.Equal(t, "Anything else?", comments.Heading)
+}
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
@@ -115,13 +116,26 @@ func run() int {
 
 	// Initialize ID mapper for v1/v2 ID conversions
 	var idMapper domain.IDMapper
-	if cfg.IDMappingDisabled {
+	if cfg.IDMappingFile != "" {
+		fileMapper, err := idmapper.NewFileMapper(idmapper.FileConfig{
+			Path:           cfg.IDMappingFile,
+			ReloadInterval: cfg.IDMappingFileReloadInterval,
+		}, logger)
+		if err != nil {
+			logger.Error("Failed to load ID mapping file", "error", err, "path", cfg.IDMappingFile)
+			return 1
+		}
+		defer fileMapper.Close()
+		logger.Warn("ID mapping uses a STATIC FILE - NATS ID mapping is not used", "path", cfg.IDMappingFile)
+		idMapper = fileMapper
+	} else if cfg.IDMappingDisabled {
 		logger.Warn("ID mapping is DISABLED - using no-op mapper (IDs will pass through unchanged)")
 		idMapper = idmapper.NewNoOpMapper()
 	} else {
 		natsMapper, err := idmapper.NewNATSMapper(idmapper.Config{
 			URL:     cfg.NATSURL,
 			Timeout: cfg.NATSTimeout,
+			Bucket:  apieventing.V1MappingsBucket,
 		})
 		if err != nil {
 			logger.Error("Failed to initialize ID mapper", "error", err)
@@ -129,6 +143,45 @@ func run() int {
 		}
 		defer natsMapper.Close()
 		idMapper = natsMapper
+
+		switch cfg.IDMappingSource {
+		case "kv":
+			// Serve lookups from a mirror of the v1-mappings bucket; request/reply is
+			// only used for keys the mirror does not have.
+			kvMapper, err := idmapper.NewKVMapper(context.Background(), idmapper.KVConfig{
+				URL:    cfg.NATSURL,
+				Bucket: apieventing.V1MappingsBucket,
+			}, natsMapper, logger)
+			if err != nil {
+				logger.Error("Failed to initialize KV ID mapper", "error", err)
+				return 1
+			}
+			defer kvMapper.Close()
+			logger.Info("ID mapping source is KV - resolving from v1-mappings bucket with request/reply fallback")
+			idMapper = kvMapper
+		case "nats":
+			// Default: every lookup is a request/reply to the v1-sync-helper
+		default:
+			logger.Warn("unknown ID_MAPPING_SOURCE, using nats request/reply", "source", cfg.IDMappingSource)
+		}
+
+		if cfg.IDMappingCacheEnabled {
+			cachingMapper, err := idmapper.NewCachingMapper(idMapper, idmapper.CacheConfig{
+				Size:        cfg.IDMappingCacheSize,
+				TTL:         cfg.IDMappingCacheTTL,
+				NegativeTTL: cfg.IDMappingCacheNegativeTTL,
+			})
+			if err != nil {
+				logger.Error("Failed to initialize ID mapping cache", "error", err)
+				return 1
+			}
+			logger.Info("ID mapping cache is ENABLED",
+				"size", cfg.IDMappingCacheSize,
+				"ttl", cfg.IDMappingCacheTTL,
+				"negative_ttl", cfg.IDMappingCacheNegativeTTL,
+			)
+			idMapper = cachingMapper
+		}
 	}
 
 	// Create shutdown channel for coordinating graceful shutdown
@@ -137,7 +190,7 @@ func run() int {
 	// Resolve invite feature configuration
 	inviteCfg := parseInviteConfig(cfg, logger)
 
-	// Start invite_accepted subscriber and create invite NATS deps before the event
+	// Create the invite_accepted subscriber and invite NATS deps before the event
 	// processor so that InjectInviteDependencies completes before any KV events are
 	// processed (avoids a startup window where invites would be silently skipped).
 	var inviteAcceptedSubscriber *apieventing.InviteAcceptedSubscriber
@@ -145,7 +198,7 @@ func run() int {
 	var inviteSender *infraNATS.NATSInviteSender
 	var userReader *infraNATS.NATSUserReader
 	if inviteCfg.Enabled {
-		logger.Info("Invite feature is ENABLED - starting invite_accepted subscriber")
+		logger.Info("Invite feature is ENABLED - creating invite_accepted subscriber")
 		nc, err := natsgo.Connect(cfg.NATSURL,
 			natsgo.Name("survey-service-invite-accepted"),
 			natsgo.DrainTimeout(30*time.Second),
@@ -158,11 +211,7 @@ func run() int {
 		inviteSender = infraNATS.NewInviteSender(inviteNATSConn, logger)
 		userReader = infraNATS.NewUserReader(inviteNATSConn, logger)
 
-		inviteAcceptedSubscriber = apieventing.NewInviteAcceptedSubscriber(inviteNATSConn, proxyClient, logger)
-		if err := inviteAcceptedSubscriber.Start(context.Background()); err != nil {
-			logger.Error("Failed to start invite_accepted subscriber", "error", err)
-			return 1
-		}
+		inviteAcceptedSubscriber = apieventing.NewInviteAcceptedSubscriber(inviteNATSConn, proxyClient, userReader, inviteCfg, logger)
 	}
 
 	// Initialize event processor (if enabled)
@@ -172,17 +221,17 @@ func run() int {
 	if cfg.EventProcessingEnabled {
 		logger.Info("Event processing is ENABLED - initializing event processor")
 		ep, err := apieventing.NewEventProcessor(eventing.Config{
-			NATSURL:      cfg.NATSURL,
-			ConsumerName: cfg.EventConsumerName,
-			StreamName:   cfg.EventStreamName,
-			FilterSubjects: []string{
-				"$KV.v1-objects.itx-surveys.>",
-				"$KV.v1-objects.itx-survey-responses.>",
-				"$KV.v1-objects.surveymonkey-surveys.>",
-			},
-			MaxDeliver:    3,
-			AckWait:       30 * time.Second,
-			MaxAckPending: 1000,
+			NATSURL:              cfg.NATSURL,
+			ConsumerName:         cfg.EventConsumerName,
+			StreamName:           cfg.EventStreamName,
+			MaxDeliver:           3,
+			AckWait:              30 * time.Second,
+			MaxAckPending:        1000,
+			Workers:              cfg.EventWorkers,
+			DeadLetterStreamName: cfg.EventDeadLetterStreamName,
+			DeadLetterMaxAge:     cfg.EventDeadLetterMaxAge,
+			PublishMode:          cfg.EventPublishMode,
+			PublishAckTimeout:    cfg.EventPublishAckTimeout,
 		}, idMapper, inviteCfg, logger)
 		if err != nil {
 			logger.Error("Failed to initialize event processor", "error", err)
@@ -210,8 +259,32 @@ func run() int {
 		logger.Info("Event processing is DISABLED - skipping event processor initialization")
 	}
 
+	// The subscriber starts once the event processor exists so that acceptances are
+	// recorded in the survey invite state from the first event.
+	if inviteAcceptedSubscriber != nil {
+		if eventProcessor != nil {
+			inviteAcceptedSubscriber.SetInviteTracker(eventProcessor.SurveyInvites())
+		}
+		if err := inviteAcceptedSubscriber.Start(context.Background()); err != nil {
+			logger.Error("Failed to start invite_accepted subscriber", "error", err)
+			return 1
+		}
+	}
+
 	// Initialize service layer
 	surveyService := service.NewSurveyService(jwtAuth, proxyClient, idMapper, logger)
+	if eventProcessor != nil {
+		surveyService.SetDeadLetterQueue(eventProcessor.DeadLetters())
+		surveyService.SetReindexer(eventProcessor.Reindexer())
+		surveyService.SetInviteBackfiller(eventProcessor.InviteBackfiller())
+		surveyService.SetSurveyTemplateCatalog(eventProcessor.SurveyTemplates())
+		surveyService.SetSurveyInviteReader(eventProcessor.SurveyInvites())
+		surveyService.SetInviteSettingsStore(eventProcessor.InviteSettings())
+		surveyService.SetSurveySettingsStore(eventProcessor.SurveySettings())
+	}
+	if inviteAcceptedSubscriber != nil {
+		surveyService.SetInviteEnricher(inviteAcceptedSubscriber)
+	}
 
 	// Initialize API layer
 	surveyAPI := NewSurveyAPI(surveyService)
@@ -364,50 +437,92 @@ func run() int {
 
 // config holds the application configuration
 type config struct {
-	Port                   string
-	JWKSURL                string
-	Audience               string
-	MockLocalPrincipal     string
-	ITXBaseURL             string
-	ITXAuth0Domain         string
-	ITXClientID            string
-	ITXPrivateKey          string
-	ITXAudience            string
-	ITXTimeout             time.Duration
-	NATSURL                string
-	NATSTimeout            time.Duration
-	IDMappingDisabled      bool
+	Port               string
+	JWKSURL            string
+	Audience           string
+	MockLocalPrincipal string
+	ITXBaseURL         string
+	ITXAuth0Domain     string
+	ITXClientID        string
+	ITXPrivateKey      string
+	ITXAudience        string
+	ITXTimeout         time.Duration
+	NATSURL            string
+	NATSTimeout        time.Duration
+	IDMappingDisabled  bool
+	IDMappingSource    string
+	// Static ID mapping file (local dev); takes precedence over the other mapping settings
+	IDMappingFile               string
+	IDMappingFileReloadInterval time.Duration
+	// ID mapping cache
+	IDMappingCacheEnabled     bool
+	IDMappingCacheSize        int
+	IDMappingCacheTTL         time.Duration
+	IDMappingCacheNegativeTTL time.Duration
+	// Event processing
 	EventProcessingEnabled bool
 	EventConsumerName      string
 	EventStreamName        string
+	EventWorkers           int
+	// Dead-lettered KV events
+	EventDeadLetterStreamName string
+	EventDeadLetterMaxAge     time.Duration
+	// Indexer, FGA and domain event publishing
+	EventPublishMode       string
+	EventPublishAckTimeout time.Duration
 	// Invite feature
-	InvitesEnabled   bool
-	SelfServeBaseURL string
-	LFXEnvironment   string
+	InvitesEnabled       bool
+	SelfServeBaseURL     string
+	LFXEnvironment       string
+	InviteResendInterval time.Duration
+	InviteMaxReinvites   int
+	// Durable invite_accepted consumer
+	InviteAcceptedStreamName           string
+	InviteAcceptedConsumerName         string
+	InviteAcceptedMaxDeliver           int
+	InviteAcceptedDeadLetterStreamName string
 }
 
 // loadConfig loads configuration from environment variables
 func loadConfig() config {
 	return config{
-		Port:                   getEnv("PORT", "8080"),
-		JWKSURL:                getEnv("JWKS_URL", "http://heimdall:4457/.well-known/jwks"),
-		Audience:               getEnv("AUDIENCE", "lfx-v2-survey-service"),
-		MockLocalPrincipal:     getEnv("JWT_AUTH_DISABLED_MOCK_LOCAL_PRINCIPAL", ""),
-		ITXBaseURL:             getEnv("ITX_BASE_URL", "https://api.dev.itx.linuxfoundation.org/"),
-		ITXAuth0Domain:         getEnv("ITX_AUTH0_DOMAIN", "linuxfoundation-dev.auth0.com"),
-		ITXClientID:            getEnv("ITX_CLIENT_ID", ""),
-		ITXPrivateKey:          getEnv("ITX_CLIENT_PRIVATE_KEY", ""),
-		ITXAudience:            getEnv("ITX_AUDIENCE", "https://api.dev.itx.linuxfoundation.org/"),
-		ITXTimeout:             30 * time.Second,
-		NATSURL:                getEnv("NATS_URL", "nats://nats:4222"),
-		NATSTimeout:            5 * time.Second,
-		IDMappingDisabled:      getEnv("ID_MAPPING_DISABLED", "") == "true",
-		EventProcessingEnabled: getEnv("EVENT_PROCESSING_ENABLED", "true") == "true",
-		EventConsumerName:      getEnv("EVENT_CONSUMER_NAME", "survey-service-kv-consumer"),
-		EventStreamName:        getEnv("EVENT_STREAM_NAME", "KV_v1-objects"),
-		InvitesEnabled:         getEnv("INVITES_ENABLED", "false") == "true",
-		SelfServeBaseURL:       getEnv("LFX_SELF_SERVE_BASE_URL", ""),
-		LFXEnvironment:         getEnv("LFX_ENVIRONMENT", "dev"),
+		Port:                               getEnv("PORT", "8080"),
+		JWKSURL:                            getEnv("JWKS_URL", "http://heimdall:4457/.well-known/jwks"),
+		Audience:                           getEnv("AUDIENCE", "lfx-v2-survey-service"),
+		MockLocalPrincipal:                 getEnv("JWT_AUTH_DISABLED_MOCK_LOCAL_PRINCIPAL", ""),
+		ITXBaseURL:                         getEnv("ITX_BASE_URL", "https://api.dev.itx.linuxfoundation.org/"),
+		ITXAuth0Domain:                     getEnv("ITX_AUTH0_DOMAIN", "linuxfoundation-dev.auth0.com"),
+		ITXClientID:                        getEnv("ITX_CLIENT_ID", ""),
+		ITXPrivateKey:                      getEnv("ITX_CLIENT_PRIVATE_KEY", ""),
+		ITXAudience:                        getEnv("ITX_AUDIENCE", "https://api.dev.itx.linuxfoundation.org/"),
+		ITXTimeout:                         30 * time.Second,
+		NATSURL:                            getEnv("NATS_URL", "nats://nats:4222"),
+		NATSTimeout:                        5 * time.Second,
+		IDMappingDisabled:                  getEnv("ID_MAPPING_DISABLED", "") == "true",
+		IDMappingSource:                    getEnv("ID_MAPPING_SOURCE", "nats"),
+		IDMappingFile:                      getEnv("ID_MAPPING_FILE", ""),
+		IDMappingFileReloadInterval:        getEnvDuration("ID_MAPPING_FILE_RELOAD_INTERVAL", 5*time.Second),
+		IDMappingCacheEnabled:              getEnv("ID_MAPPING_CACHE_ENABLED", "true") == "true",
+		IDMappingCacheSize:                 getEnvInt("ID_MAPPING_CACHE_SIZE", 10000),
+		IDMappingCacheTTL:                  getEnvDuration("ID_MAPPING_CACHE_TTL", 10*time.Minute),
+		IDMappingCacheNegativeTTL:          getEnvDuration("ID_MAPPING_CACHE_NEGATIVE_TTL", time.Minute),
+		EventProcessingEnabled:             getEnv("EVENT_PROCESSING_ENABLED", "true") == "true",
+		EventConsumerName:                  getEnv("EVENT_CONSUMER_NAME", "survey-service-kv-consumer"),
+		EventStreamName:                    getEnv("EVENT_STREAM_NAME", "KV_v1-objects"),
+		EventWorkers:                       getEnvInt("EVENT_WORKERS", apieventing.DefaultWorkers),
+		EventDeadLetterStreamName:          getEnv("EVENT_DEAD_LETTER_STREAM_NAME", apieventing.DefaultDeadLetterStreamName),
+		EventDeadLetterMaxAge:              getEnvDuration("EVENT_DEAD_LETTER_MAX_AGE", 14*24*time.Hour),
+		EventPublishMode:                   getEnv("EVENT_PUBLISH_MODE", eventing.PublishModeCore),
+		EventPublishAckTimeout:             getEnvDuration("EVENT_PUBLISH_ACK_TIMEOUT", eventing.DefaultPublishAckTimeout),
+		InvitesEnabled:                     getEnv("INVITES_ENABLED", "false") == "true",
+		SelfServeBaseURL:                   getEnv("LFX_SELF_SERVE_BASE_URL", ""),
+		LFXEnvironment:                     getEnv("LFX_ENVIRONMENT", "dev"),
+		InviteResendInterval:               getEnvDuration("INVITE_RESEND_INTERVAL", time.Hour),
+		InviteMaxReinvites:                 getEnvInt("INVITE_MAX_REINVITES", 2),
+		InviteAcceptedStreamName:           getEnv("INVITE_ACCEPTED_STREAM_NAME", apieventing.DefaultInviteAcceptedStreamName),
+		InviteAcceptedConsumerName:         getEnv("INVITE_ACCEPTED_CONSUMER_NAME", apieventing.DefaultInviteAcceptedConsumerName),
+		InviteAcceptedMaxDeliver:           getEnvInt("INVITE_ACCEPTED_MAX_DELIVER", apieventing.DefaultInviteAcceptedMaxDeliver),
+		InviteAcceptedDeadLetterStreamName: getEnv("INVITE_ACCEPTED_DEAD_LETTER_STREAM_NAME", apieventing.DefaultInviteAcceptedDeadLetterStreamName),
 	}
 }
 
@@ -443,6 +558,13 @@ func parseInviteConfig(cfg config, logger *slog.Logger) apieventing.InviteFeatur
 	return apieventing.InviteFeatureConfig{
 		Enabled:          true,
 		SelfServeBaseURL: baseURL,
+		ResendInterval:   cfg.InviteResendInterval,
+		MaxReinvites:     cfg.InviteMaxReinvites,
+
+		AcceptedStreamName:           cfg.InviteAcceptedStreamName,
+		AcceptedConsumerName:         cfg.InviteAcceptedConsumerName,
+		AcceptedMaxDeliver:           cfg.InviteAcceptedMaxDeliver,
+		AcceptedDeadLetterStreamName: cfg.InviteAcceptedDeadLetterStreamName,
 	}
 }
 
@@ -464,3 +586,20 @@ func getEnv(key, defaultVal string) string {
 	}
 	return defaultVal
 }
+
+// getEnvInt returns the integer value of an environment variable, or defaultVal if unset or invalid
+func getEnvInt(key string, defaultVal int) int {
+	if n, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return n
+	}
+	return defaultVal
+}
+
+// getEnvDuration returns the duration value (e.g. "30s", "10m") of an environment variable,
+// or defaultVal if unset or invalid
+func getEnvDuration(key string, defaultVal time.Duration) time.Duration {
+	if d, err := time.ParseDuration(os.Getenv(key)); err == nil {
+		return d
+	}
+	return defaultVal
+}
@@ -24,13 +24,13 @@ import (
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() []string {
 	return []string{
-		"survey (schedule-survey|get-survey|update-survey|delete-survey|bulk-resend-survey|preview-send-survey|send-missing-recipients|delete-survey-response|resend-survey-response|delete-recipient-group|create-exclusion|delete-exclusion|get-exclusion|delete-exclusion-by-id|list-survey-responses|validate-email)",
+		"survey (schedule-survey|get-survey|update-survey|delete-survey|bulk-resend-survey|preview-send-survey|send-missing-recipients|delete-survey-response|resend-survey-response|delete-recipient-group|create-exclusion|delete-exclusion|get-exclusion|delete-exclusion-by-id|list-survey-responses|create-survey-response|update-survey-response|list-survey-invites|get-survey-invite-settings|update-survey-invite-settings|delete-survey-invite-settings|get-project-invite-settings|update-project-invite-settings|delete-project-invite-settings|get-survey-settings|update-survey-settings|get-survey-results|validate-email|list-survey-templates|get-survey-template|list-dead-letters|get-dead-letter|replay-dead-letter|start-reindex|get-reindex|cancel-reindex|rerun-invite-enrichment|start-invite-backfill|get-invite-backfill|cancel-invite-backfill)",
 	}
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + " " + "survey schedule-survey --body '{\n      \"committee_uid\": \"qa1e8536-a985-4cf5-b981-a170927a1d11\",\n      \"committee_voting_enabled\": true,\n      \"creator_id\": \"Quam esse saepe eum non omnis amet.\",\n      \"creator_name\": \"Consequatur quod numquam et provident dolorem voluptatem.\",\n      \"creator_username\": \"Numquam eum suscipit labore odio rerum.\",\n      \"email_body\": \"Repellendus sunt omnis voluptate minima possimus.\",\n      \"email_body_text\": \"Occaecati sunt odit quia quia fugiat est.\",\n      \"email_subject\": \"Quis vero voluptatem et temporibus.\",\n      \"is_project_survey\": true,\n      \"send_immediately\": true,\n      \"stage_filter\": \"Dolorem quia et in.\",\n      \"survey_cutoff_date\": \"Omnis sint reprehenderit.\",\n      \"survey_monkey_id\": \"Omnis est at omnis.\",\n      \"survey_reminder_rate_days\": 3291766316701683331,\n      \"survey_send_date\": \"Et nulla quia.\",\n      \"survey_title\": \"Dolorum cumque.\"\n   }' --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"" + "\n" +
+	return os.Args[0] + " " + "survey schedule-survey --body '{\n      \"committee_uid\": \"qa1e8536-a985-4cf5-b981-a170927a1d11\",\n      \"committee_voting_enabled\": false,\n      \"creator_id\": \"Dolorem est ut sunt dolore.\",\n      \"creator_name\": \"Consectetur atque.\",\n      \"creator_username\": \"Omnis tempore odio.\",\n      \"email_body\": \"Ea deleniti consectetur in nam.\",\n      \"email_body_text\": \"Voluptatem laboriosam quo delectus aspernatur.\",\n      \"email_subject\": \"Consequuntur laborum harum.\",\n      \"is_project_survey\": true,\n      \"send_immediately\": false,\n      \"stage_filter\": \"Sit quis maxime sunt in laudantium.\",\n      \"survey_cutoff_date\": \"Et esse labore unde.\",\n      \"survey_monkey_id\": \"Soluta nam adipisci.\",\n      \"survey_reminder_rate_days\": 2027057317300210423,\n      \"survey_send_date\": \"Voluptatem officia dolores quasi.\",\n      \"survey_title\": \"Cumque nemo eligendi quasi ut consequatur.\"\n   }' --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"" + "\n" +
 		""
 }
 
@@ -121,9 +121,114 @@ func ParseEndpoint(
 		surveyListSurveyResponsesProjectUidsFlag = surveyListSurveyResponsesFlags.String("project-uids", "", "")
 		surveyListSurveyResponsesTokenFlag       = surveyListSurveyResponsesFlags.String("token", "", "")
 
+		surveyCreateSurveyResponseFlags         = flag.NewFlagSet("create-survey-response", flag.ExitOnError)
+		surveyCreateSurveyResponseBodyFlag      = surveyCreateSurveyResponseFlags.String("body", "REQUIRED", "")
+		surveyCreateSurveyResponseSurveyUIDFlag = surveyCreateSurveyResponseFlags.String("survey-uid", "REQUIRED", "Survey identifier")
+		surveyCreateSurveyResponseTokenFlag     = surveyCreateSurveyResponseFlags.String("token", "", "")
+
+		surveyUpdateSurveyResponseFlags          = flag.NewFlagSet("update-survey-response", flag.ExitOnError)
+		surveyUpdateSurveyResponseBodyFlag       = surveyUpdateSurveyResponseFlags.String("body", "REQUIRED", "")
+		surveyUpdateSurveyResponseSurveyUIDFlag  = surveyUpdateSurveyResponseFlags.String("survey-uid", "REQUIRED", "Survey identifier")
+		surveyUpdateSurveyResponseResponseIDFlag = surveyUpdateSurveyResponseFlags.String("response-id", "REQUIRED", "Response identifier")
+		surveyUpdateSurveyResponseTokenFlag      = surveyUpdateSurveyResponseFlags.String("token", "", "")
+
+		surveyListSurveyInvitesFlags         = flag.NewFlagSet("list-survey-invites", flag.ExitOnError)
+		surveyListSurveyInvitesSurveyUIDFlag = surveyListSurveyInvitesFlags.String("survey-uid", "REQUIRED", "Survey identifier")
+		surveyListSurveyInvitesStatusFlag    = surveyListSurveyInvitesFlags.String("status", "", "")
+		surveyListSurveyInvitesTokenFlag     = surveyListSurveyInvitesFlags.String("token", "", "")
+
+		surveyGetSurveyInviteSettingsFlags         = flag.NewFlagSet("get-survey-invite-settings", flag.ExitOnError)
+		surveyGetSurveyInviteSettingsSurveyUIDFlag = surveyGetSurveyInviteSettingsFlags.String("survey-uid", "REQUIRED", "Survey identifier")
+		surveyGetSurveyInviteSettingsTokenFlag     = surveyGetSurveyInviteSettingsFlags.String("token", "", "")
+
+		surveyUpdateSurveyInviteSettingsFlags         = flag.NewFlagSet("update-survey-invite-settings", flag.ExitOnError)
+		surveyUpdateSurveyInviteSettingsBodyFlag      = surveyUpdateSurveyInviteSettingsFlags.String("body", "REQUIRED", "")
+		surveyUpdateSurveyInviteSettingsSurveyUIDFlag = surveyUpdateSurveyInviteSettingsFlags.String("survey-uid", "REQUIRED", "Survey identifier")
+		surveyUpdateSurveyInviteSettingsTokenFlag     = surveyUpdateSurveyInviteSettingsFlags.String("token", "", "")
+
+		surveyDeleteSurveyInviteSettingsFlags         = flag.NewFlagSet("delete-survey-invite-settings", flag.ExitOnError)
+		surveyDeleteSurveyInviteSettingsSurveyUIDFlag = surveyDeleteSurveyInviteSettingsFlags.String("survey-uid", "REQUIRED", "Survey identifier")
+		surveyDeleteSurveyInviteSettingsTokenFlag     = surveyDeleteSurveyInviteSettingsFlags.String("token", "", "")
+
+		surveyGetProjectInviteSettingsFlags          = flag.NewFlagSet("get-project-invite-settings", flag.ExitOnError)
+		surveyGetProjectInviteSettingsProjectUIDFlag = surveyGetProjectInviteSettingsFlags.String("project-uid", "REQUIRED", "Project identifier")
+		surveyGetProjectInviteSettingsTokenFlag      = surveyGetProjectInviteSettingsFlags.String("token", "", "")
+
+		surveyUpdateProjectInviteSettingsFlags          = flag.NewFlagSet("update-project-invite-settings", flag.ExitOnError)
+		surveyUpdateProjectInviteSettingsBodyFlag       = surveyUpdateProjectInviteSettingsFlags.String("body", "REQUIRED", "")
+		surveyUpdateProjectInviteSettingsProjectUIDFlag = surveyUpdateProjectInviteSettingsFlags.String("project-uid", "REQUIRED", "Project identifier")
+		surveyUpdateProjectInviteSettingsTokenFlag      = surveyUpdateProjectInviteSettingsFlags.String("token", "", "")
+
+		surveyDeleteProjectInviteSettingsFlags          = flag.NewFlagSet("delete-project-invite-settings", flag.ExitOnError)
+		surveyDeleteProjectInviteSettingsProjectUIDFlag = surveyDeleteProjectInviteSettingsFlags.String("project-uid", "REQUIRED", "Project identifier")
+		surveyDeleteProjectInviteSettingsTokenFlag      = surveyDeleteProjectInviteSettingsFlags.String("token", "", "")
+
+		surveyGetSurveySettingsFlags         = flag.NewFlagSet("get-survey-settings", flag.ExitOnError)
+		surveyGetSurveySettingsSurveyUIDFlag = surveyGetSurveySettingsFlags.String("survey-uid", "REQUIRED", "Survey identifier")
+		surveyGetSurveySettingsTokenFlag     = surveyGetSurveySettingsFlags.String("token", "", "")
+
+		surveyUpdateSurveySettingsFlags         = flag.NewFlagSet("update-survey-settings", flag.ExitOnError)
+		surveyUpdateSurveySettingsBodyFlag      = surveyUpdateSurveySettingsFlags.String("body", "REQUIRED", "")
+		surveyUpdateSurveySettingsSurveyUIDFlag = surveyUpdateSurveySettingsFlags.String("survey-uid", "REQUIRED", "Survey identifier")
+		surveyUpdateSurveySettingsTokenFlag     = surveyUpdateSurveySettingsFlags.String("token", "", "")
+
+		surveyGetSurveyResultsFlags         = flag.NewFlagSet("get-survey-results", flag.ExitOnError)
+		surveyGetSurveyResultsSurveyUIDFlag = surveyGetSurveyResultsFlags.String("survey-uid", "REQUIRED", "Survey identifier")
+		surveyGetSurveyResultsTokenFlag     = surveyGetSurveyResultsFlags.String("token", "", "")
+
 		surveyValidateEmailFlags     = flag.NewFlagSet("validate-email", flag.ExitOnError)
 		surveyValidateEmailBodyFlag  = surveyValidateEmailFlags.String("body", "REQUIRED", "")
 		surveyValidateEmailTokenFlag = surveyValidateEmailFlags.String("token", "", "")
+
+		surveyListSurveyTemplatesFlags        = flag.NewFlagSet("list-survey-templates", flag.ExitOnError)
+		surveyListSurveyTemplatesTitleFlag    = surveyListSurveyTemplatesFlags.String("title", "", "")
+		surveyListSurveyTemplatesCategoryFlag = surveyListSurveyTemplatesFlags.String("category", "", "")
+		surveyListSurveyTemplatesLanguageFlag = surveyListSurveyTemplatesFlags.String("language", "", "")
+		surveyListSurveyTemplatesOffsetFlag   = surveyListSurveyTemplatesFlags.String("offset", "", "")
+		surveyListSurveyTemplatesLimitFlag    = surveyListSurveyTemplatesFlags.String("limit", "50", "")
+		surveyListSurveyTemplatesTokenFlag    = surveyListSurveyTemplatesFlags.String("token", "", "")
+
+		surveyGetSurveyTemplateFlags          = flag.NewFlagSet("get-survey-template", flag.ExitOnError)
+		surveyGetSurveyTemplateTemplateIDFlag = surveyGetSurveyTemplateFlags.String("template-id", "REQUIRED", "SurveyMonkey survey ID, as passed to schedule_survey as survey_monkey_id")
+		surveyGetSurveyTemplateTokenFlag      = surveyGetSurveyTemplateFlags.String("token", "", "")
+
+		surveyListDeadLettersFlags         = flag.NewFlagSet("list-dead-letters", flag.ExitOnError)
+		surveyListDeadLettersKeyPrefixFlag = surveyListDeadLettersFlags.String("key-prefix", "", "")
+		surveyListDeadLettersAfterFlag     = surveyListDeadLettersFlags.String("after", "", "")
+		surveyListDeadLettersLimitFlag     = surveyListDeadLettersFlags.String("limit", "50", "")
+		surveyListDeadLettersTokenFlag     = surveyListDeadLettersFlags.String("token", "", "")
+
+		surveyGetDeadLetterFlags        = flag.NewFlagSet("get-dead-letter", flag.ExitOnError)
+		surveyGetDeadLetterSequenceFlag = surveyGetDeadLetterFlags.String("sequence", "REQUIRED", "Dead-letter stream sequence")
+		surveyGetDeadLetterTokenFlag    = surveyGetDeadLetterFlags.String("token", "", "")
+
+		surveyReplayDeadLetterFlags        = flag.NewFlagSet("replay-dead-letter", flag.ExitOnError)
+		surveyReplayDeadLetterSequenceFlag = surveyReplayDeadLetterFlags.String("sequence", "REQUIRED", "Dead-letter stream sequence")
+		surveyReplayDeadLetterTokenFlag    = surveyReplayDeadLetterFlags.String("token", "", "")
+
+		surveyStartReindexFlags     = flag.NewFlagSet("start-reindex", flag.ExitOnError)
+		surveyStartReindexBodyFlag  = surveyStartReindexFlags.String("body", "REQUIRED", "")
+		surveyStartReindexTokenFlag = surveyStartReindexFlags.String("token", "", "")
+
+		surveyGetReindexFlags     = flag.NewFlagSet("get-reindex", flag.ExitOnError)
+		surveyGetReindexTokenFlag = surveyGetReindexFlags.String("token", "", "")
+
+		surveyCancelReindexFlags     = flag.NewFlagSet("cancel-reindex", flag.ExitOnError)
+		surveyCancelReindexTokenFlag = surveyCancelReindexFlags.String("token", "", "")
+
+		surveyRerunInviteEnrichmentFlags     = flag.NewFlagSet("rerun-invite-enrichment", flag.ExitOnError)
+		surveyRerunInviteEnrichmentBodyFlag  = surveyRerunInviteEnrichmentFlags.String("body", "REQUIRED", "")
+		surveyRerunInviteEnrichmentTokenFlag = surveyRerunInviteEnrichmentFlags.String("token", "", "")
+
+		surveyStartInviteBackfillFlags     = flag.NewFlagSet("start-invite-backfill", flag.ExitOnError)
+		surveyStartInviteBackfillBodyFlag  = surveyStartInviteBackfillFlags.String("body", "REQUIRED", "")
+		surveyStartInviteBackfillTokenFlag = surveyStartInviteBackfillFlags.String("token", "", "")
+
+		surveyGetInviteBackfillFlags     = flag.NewFlagSet("get-invite-backfill", flag.ExitOnError)
+		surveyGetInviteBackfillTokenFlag = surveyGetInviteBackfillFlags.String("token", "", "")
+
+		surveyCancelInviteBackfillFlags     = flag.NewFlagSet("cancel-invite-backfill", flag.ExitOnError)
+		surveyCancelInviteBackfillTokenFlag = surveyCancelInviteBackfillFlags.String("token", "", "")
 	)
 	surveyFlags.Usage = surveyUsage
 	surveyScheduleSurveyFlags.Usage = surveyScheduleSurveyUsage
@@ -141,7 +246,31 @@ func ParseEndpoint(
 	surveyGetExclusionFlags.Usage = surveyGetExclusionUsage
 	surveyDeleteExclusionByIDFlags.Usage = surveyDeleteExclusionByIDUsage
 	surveyListSurveyResponsesFlags.Usage = surveyListSurveyResponsesUsage
+	surveyCreateSurveyResponseFlags.Usage = surveyCreateSurveyResponseUsage
+	surveyUpdateSurveyResponseFlags.Usage = surveyUpdateSurveyResponseUsage
+	surveyListSurveyInvitesFlags.Usage = surveyListSurveyInvitesUsage
+	surveyGetSurveyInviteSettingsFlags.Usage = surveyGetSurveyInviteSettingsUsage
+	surveyUpdateSurveyInviteSettingsFlags.Usage = surveyUpdateSurveyInviteSettingsUsage
+	surveyDeleteSurveyInviteSettingsFlags.Usage = surveyDeleteSurveyInviteSettingsUsage
+	surveyGetProjectInviteSettingsFlags.Usage = surveyGetProjectInviteSettingsUsage
+	surveyUpdateProjectInviteSettingsFlags.Usage = surveyUpdateProjectInviteSettingsUsage
+	surveyDeleteProjectInviteSettingsFlags.Usage = surveyDeleteProjectInviteSettingsUsage
+	surveyGetSurveySettingsFlags.Usage = surveyGetSurveySettingsUsage
+	surveyUpdateSurveySettingsFlags.Usage = surveyUpdateSurveySettingsUsage
+	surveyGetSurveyResultsFlags.Usage = surveyGetSurveyResultsUsage
 	surveyValidateEmailFlags.Usage = surveyValidateEmailUsage
+	surveyListSurveyTemplatesFlags.Usage = surveyListSurveyTemplatesUsage
+	surveyGetSurveyTemplateFlags.Usage = surveyGetSurveyTemplateUsage
+	surveyListDeadLettersFlags.Usage = surveyListDeadLettersUsage
+	surveyGetDeadLetterFlags.Usage = surveyGetDeadLetterUsage
+	surveyReplayDeadLetterFlags.Usage = surveyReplayDeadLetterUsage
+	surveyStartReindexFlags.Usage = surveyStartReindexUsage
+	surveyGetReindexFlags.Usage = surveyGetReindexUsage
+	surveyCancelReindexFlags.Usage = surveyCancelReindexUsage
+	surveyRerunInviteEnrichmentFlags.Usage = surveyRerunInviteEnrichmentUsage
+	surveyStartInviteBackfillFlags.Usage = surveyStartInviteBackfillUsage
+	surveyGetInviteBackfillFlags.Usage = surveyGetInviteBackfillUsage
+	surveyCancelInviteBackfillFlags.Usage = surveyCancelInviteBackfillUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -222,9 +351,81 @@ func ParseEndpoint(
 			case "list-survey-responses":
 				epf = surveyListSurveyResponsesFlags
 
+			case "create-survey-response":
+				epf = surveyCreateSurveyResponseFlags
+
+			case "update-survey-response":
+				epf = surveyUpdateSurveyResponseFlags
+
+			case "list-survey-invites":
+				epf = surveyListSurveyInvitesFlags
+
+			case "get-survey-invite-settings":
+				epf = surveyGetSurveyInviteSettingsFlags
+
+			case "update-survey-invite-settings":
+				epf = surveyUpdateSurveyInviteSettingsFlags
+
+			case "delete-survey-invite-settings":
+				epf = surveyDeleteSurveyInviteSettingsFlags
+
+			case "get-project-invite-settings":
+				epf = surveyGetProjectInviteSettingsFlags
+
+			case "update-project-invite-settings":
+				epf = surveyUpdateProjectInviteSettingsFlags
+
+			case "delete-project-invite-settings":
+				epf = surveyDeleteProjectInviteSettingsFlags
+
+			case "get-survey-settings":
+				epf = surveyGetSurveySettingsFlags
+
+			case "update-survey-settings":
+				epf = surveyUpdateSurveySettingsFlags
+
+			case "get-survey-results":
+				epf = surveyGetSurveyResultsFlags
+
 			case "validate-email":
 				epf = surveyValidateEmailFlags
 
+			case "list-survey-templates":
+				epf = surveyListSurveyTemplatesFlags
+
+			case "get-survey-template":
+				epf = surveyGetSurveyTemplateFlags
+
+			case "list-dead-letters":
+				epf = surveyListDeadLettersFlags
+
+			case "get-dead-letter":
+				epf = surveyGetDeadLetterFlags
+
+			case "replay-dead-letter":
+				epf = surveyReplayDeadLetterFlags
+
+			case "start-reindex":
+				epf = surveyStartReindexFlags
+
+			case "get-reindex":
+				epf = surveyGetReindexFlags
+
+			case "cancel-reindex":
+				epf = surveyCancelReindexFlags
+
+			case "rerun-invite-enrichment":
+				epf = surveyRerunInviteEnrichmentFlags
+
+			case "start-invite-backfill":
+				epf = surveyStartInviteBackfillFlags
+
+			case "get-invite-backfill":
+				epf = surveyGetInviteBackfillFlags
+
+			case "cancel-invite-backfill":
+				epf = surveyCancelInviteBackfillFlags
+
 			}
 
 		}
@@ -295,9 +496,81 @@ func ParseEndpoint(
 			case "list-survey-responses":
 				endpoint = c.ListSurveyResponses()
 				data, err = surveyc.BuildListSurveyResponsesPayload(*surveyListSurveyResponsesSurveyUIDFlag, *surveyListSurveyResponsesPageTokenFlag, *surveyListSurveyResponsesPerPageFlag, *surveyListSurveyResponsesProjectUIDFlag, *surveyListSurveyResponsesProjectUidsFlag, *surveyListSurveyResponsesTokenFlag)
+			case "create-survey-response":
+				endpoint = c.CreateSurveyResponse()
+				data, err = surveyc.BuildCreateSurveyResponsePayload(*surveyCreateSurveyResponseBodyFlag, *surveyCreateSurveyResponseSurveyUIDFlag, *surveyCreateSurveyResponseTokenFlag)
+			case "update-survey-response":
+				endpoint = c.UpdateSurveyResponse()
+				data, err = surveyc.BuildUpdateSurveyResponsePayload(*surveyUpdateSurveyResponseBodyFlag, *surveyUpdateSurveyResponseSurveyUIDFlag, *surveyUpdateSurveyResponseResponseIDFlag, *surveyUpdateSurveyResponseTokenFlag)
+			case "list-survey-invites":
+				endpoint = c.ListSurveyInvites()
+				data, err = surveyc.BuildListSurveyInvitesPayload(*surveyListSurveyInvitesSurveyUIDFlag, *surveyListSurveyInvitesStatusFlag, *surveyListSurveyInvitesTokenFlag)
+			case "get-survey-invite-settings":
+				endpoint = c.GetSurveyInviteSettings()
+				data, err = surveyc.BuildGetSurveyInviteSettingsPayload(*surveyGetSurveyInviteSettingsSurveyUIDFlag, *surveyGetSurveyInviteSettingsTokenFlag)
+			case "update-survey-invite-settings":
+				endpoint = c.UpdateSurveyInviteSettings()
+				data, err = surveyc.BuildUpdateSurveyInviteSettingsPayload(*surveyUpdateSurveyInviteSettingsBodyFlag, *surveyUpdateSurveyInviteSettingsSurveyUIDFlag, *surveyUpdateSurveyInviteSettingsTokenFlag)
+			case "delete-survey-invite-settings":
+				endpoint = c.DeleteSurveyInviteSettings()
+				data, err = surveyc.BuildDeleteSurveyInviteSettingsPayload(*surveyDeleteSurveyInviteSettingsSurveyUIDFlag, *surveyDeleteSurveyInviteSettingsTokenFlag)
+			case "get-project-invite-settings":
+				endpoint = c.GetProjectInviteSettings()
+				data, err = surveyc.BuildGetProjectInviteSettingsPayload(*surveyGetProjectInviteSettingsProjectUIDFlag, *surveyGetProjectInviteSettingsTokenFlag)
+			case "update-project-invite-settings":
+				endpoint = c.UpdateProjectInviteSettings()
+				data, err = surveyc.BuildUpdateProjectInviteSettingsPayload(*surveyUpdateProjectInviteSettingsBodyFlag, *surveyUpdateProjectInviteSettingsProjectUIDFlag, *surveyUpdateProjectInviteSettingsTokenFlag)
+			case "delete-project-invite-settings":
+				endpoint = c.DeleteProjectInviteSettings()
+				data, err = surveyc.BuildDeleteProjectInviteSettingsPayload(*surveyDeleteProjectInviteSettingsProjectUIDFlag, *surveyDeleteProjectInviteSettingsTokenFlag)
+			case "get-survey-settings":
+				endpoint = c.GetSurveySettings()
+				data, err = surveyc.BuildGetSurveySettingsPayload(*surveyGetSurveySettingsSurveyUIDFlag, *surveyGetSurveySettingsTokenFlag)
+			case "update-survey-settings":
+				endpoint = c.UpdateSurveySettings()
+				data, err = surveyc.BuildUpdateSurveySettingsPayload(*surveyUpdateSurveySettingsBodyFlag, *surveyUpdateSurveySettingsSurveyUIDFlag, *surveyUpdateSurveySettingsTokenFlag)
+			case "get-survey-results":
+				endpoint = c.GetSurveyResults()
+				data, err = surveyc.BuildGetSurveyResultsPayload(*surveyGetSurveyResultsSurveyUIDFlag, *surveyGetSurveyResultsTokenFlag)
 			case "validate-email":
 				endpoint = c.ValidateEmail()
 				data, err = surveyc.BuildValidateEmailPayload(*surveyValidateEmailBodyFlag, *surveyValidateEmailTokenFlag)
+			case "list-survey-templates":
+				endpoint = c.ListSurveyTemplates()
+				data, err = surveyc.BuildListSurveyTemplatesPayload(*surveyListSurveyTemplatesTitleFlag, *surveyListSurveyTemplatesCategoryFlag, *surveyListSurveyTemplatesLanguageFlag, *surveyListSurveyTemplatesOffsetFlag, *surveyListSurveyTemplatesLimitFlag, *surveyListSurveyTemplatesTokenFlag)
+			case "get-survey-template":
+				endpoint = c.GetSurveyTemplate()
+				data, err = surveyc.BuildGetSurveyTemplatePayload(*surveyGetSurveyTemplateTemplateIDFlag, *surveyGetSurveyTemplateTokenFlag)
+			case "list-dead-letters":
+				endpoint = c.ListDeadLetters()
+				data, err = surveyc.BuildListDeadLettersPayload(*surveyListDeadLettersKeyPrefixFlag, *surveyListDeadLettersAfterFlag, *surveyListDeadLettersLimitFlag, *surveyListDeadLettersTokenFlag)
+			case "get-dead-letter":
+				endpoint = c.GetDeadLetter()
+				data, err = surveyc.BuildGetDeadLetterPayload(*surveyGetDeadLetterSequenceFlag, *surveyGetDeadLetterTokenFlag)
+			case "replay-dead-letter":
+				endpoint = c.ReplayDeadLetter()
+				data, err = surveyc.BuildReplayDeadLetterPayload(*surveyReplayDeadLetterSequenceFlag, *surveyReplayDeadLetterTokenFlag)
+			case "start-reindex":
+				endpoint = c.StartReindex()
+				data, err = surveyc.BuildStartReindexPayload(*surveyStartReindexBodyFlag, *surveyStartReindexTokenFlag)
+			case "get-reindex":
+				endpoint = c.GetReindex()
+				data, err = surveyc.BuildGetReindexPayload(*surveyGetReindexTokenFlag)
+			case "cancel-reindex":
+				endpoint = c.CancelReindex()
+				data, err = surveyc.BuildCancelReindexPayload(*surveyCancelReindexTokenFlag)
+			case "rerun-invite-enrichment":
+				endpoint = c.RerunInviteEnrichment()
+				data, err = surveyc.BuildRerunInviteEnrichmentPayload(*surveyRerunInviteEnrichmentBodyFlag, *surveyRerunInviteEnrichmentTokenFlag)
+			case "start-invite-backfill":
+				endpoint = c.StartInviteBackfill()
+				data, err = surveyc.BuildStartInviteBackfillPayload(*surveyStartInviteBackfillBodyFlag, *surveyStartInviteBackfillTokenFlag)
+			case "get-invite-backfill":
+				endpoint = c.GetInviteBackfill()
+				data, err = surveyc.BuildGetInviteBackfillPayload(*surveyGetInviteBackfillTokenFlag)
+			case "cancel-invite-backfill":
+				endpoint = c.CancelInviteBackfill()
+				data, err = surveyc.BuildCancelInviteBackfillPayload(*surveyCancelInviteBackfillTokenFlag)
 			}
 		}
 	}
@@ -328,7 +601,31 @@ func surveyUsage() {
 	fmt.Fprintln(os.Stderr, `    get-exclusion: Get exclusion by ID (proxies to ITX GET /v2/surveys/exclusion/{exclusion_id})`)
 	fmt.Fprintln(os.Stderr, `    delete-exclusion-by-id: Delete exclusion by ID (proxies to ITX DELETE /v2/surveys/exclusion/{exclusion_id})`)
 	fmt.Fprintln(os.Stderr, `    list-survey-responses: List individual per-recipient responses for a survey (proxies to ITX GET /v2/surveys/{survey_uid}/responses)`)
+	fmt.Fprintln(os.Stderr, `    create-survey-response: Submit a survey response (proxies to ITX POST /v2/surveys/responses). Answers are validated against the survey's template questions first; the caller must be the respondent the response belongs to`)
+	fmt.Fprintln(os.Stderr, `    update-survey-response: Replace the answers of a survey response (proxies to ITX PUT /v2/surveys/responses/{response_id}). Answers are validated against the survey's template questions first`)
+	fmt.Fprintln(os.Stderr, `    list-survey-invites: List the LFID invites sent to recipients of a survey, with counts by status`)
+	fmt.Fprintln(os.Stderr, `    get-survey-invite-settings: Get the LFID invite settings stored for a survey`)
+	fmt.Fprintln(os.Stderr, `    update-survey-invite-settings: Replace the LFID invite settings of a survey; unset fields inherit from the project`)
+	fmt.Fprintln(os.Stderr, `    delete-survey-invite-settings: Remove the LFID invite settings of a survey so that its invites inherit again`)
+	fmt.Fprintln(os.Stderr, `    get-project-invite-settings: Get the LFID invite settings stored for a project`)
+	fmt.Fprintln(os.Stderr, `    update-project-invite-settings: Replace the LFID invite settings of a project; unset fields inherit the service defaults`)
+	fmt.Fprintln(os.Stderr, `    delete-project-invite-settings: Remove the LFID invite settings of a project so that its invites inherit again`)
+	fmt.Fprintln(os.Stderr, `    get-survey-settings: Get the settings this service keeps for a survey`)
+	fmt.Fprintln(os.Stderr, `    update-survey-settings: Replace the settings of a survey. Turning share_results on grants every respondent with an LFX username the results_viewer relation on the survey; turning it off revokes it. Turning anonymous on republishes the survey's responses de-identified; it cannot be turned off.`)
+	fmt.Fprintln(os.Stderr, `    get-survey-results: Get the aggregate results of a survey (proxies to ITX GET /v2/surveys/{survey_id}/results without free-text comments). Open to respondents of surveys that share results.`)
 	fmt.Fprintln(os.Stderr, `    validate-email: Validate email template body and subject (proxies to ITX POST /v2/surveys/validate_email)`)
+	fmt.Fprintln(os.Stderr, `    list-survey-templates: Search the catalog of SurveyMonkey surveys that surveys can be scheduled from`)
+	fmt.Fprintln(os.Stderr, `    get-survey-template: Get a SurveyMonkey survey from the template catalog`)
+	fmt.Fprintln(os.Stderr, `    list-dead-letters: List v1-objects KV events that exhausted their retries or failed conversion`)
+	fmt.Fprintln(os.Stderr, `    get-dead-letter: Inspect a dead-lettered KV event, including its original payload and headers`)
+	fmt.Fprintln(os.Stderr, `    replay-dead-letter: Re-run a dead-lettered KV event through the event handlers; the entry is removed when it succeeds`)
+	fmt.Fprintln(os.Stderr, `    start-reindex: Replay v1-objects survey entries through the event handlers in the background, to rebuild downstream documents`)
+	fmt.Fprintln(os.Stderr, `    get-reindex: Report the progress of the current or most recent reindex on this instance`)
+	fmt.Fprintln(os.Stderr, `    cancel-reindex: Stop the running reindex; entries already replayed are not rolled back`)
+	fmt.Fprintln(os.Stderr, `    rerun-invite-enrichment: Re-run the ITX survey response enrichment for an accepted LFID invite, e.g. after its invite_accepted event was dead-lettered`)
+	fmt.Fprintln(os.Stderr, `    start-invite-backfill: Send LFID invites in the background to participants of open surveys who have an email but no username and were never invited, e.g. because they were synced before invites were enabled`)
+	fmt.Fprintln(os.Stderr, `    get-invite-backfill: Report the progress of the current or most recent invite backfill on this instance`)
+	fmt.Fprintln(os.Stderr, `    cancel-invite-backfill: Stop the running invite backfill; invites already sent are not withdrawn`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
 	fmt.Fprintf(os.Stderr, "    %s survey COMMAND --help\n", os.Args[0])
@@ -350,7 +647,7 @@ func surveyScheduleSurveyUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey schedule-survey --body '{\n      \"committee_uid\": \"qa1e8536-a985-4cf5-b981-a170927a1d11\",\n      \"committee_voting_enabled\": true,\n      \"creator_id\": \"Quam esse saepe eum non omnis amet.\",\n      \"creator_name\": \"Consequatur quod numquam et provident dolorem voluptatem.\",\n      \"creator_username\": \"Numquam eum suscipit labore odio rerum.\",\n      \"email_body\": \"Repellendus sunt omnis voluptate minima possimus.\",\n      \"email_body_text\": \"Occaecati sunt odit quia quia fugiat est.\",\n      \"email_subject\": \"Quis vero voluptatem et temporibus.\",\n      \"is_project_survey\": true,\n      \"send_immediately\": true,\n      \"stage_filter\": \"Dolorem quia et in.\",\n      \"survey_cutoff_date\": \"Omnis sint reprehenderit.\",\n      \"survey_monkey_id\": \"Omnis est at omnis.\",\n      \"survey_reminder_rate_days\": 3291766316701683331,\n      \"survey_send_date\": \"Et nulla quia.\",\n      \"survey_title\": \"Dolorum cumque.\"\n   }' --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey schedule-survey --body '{\n      \"committee_uid\": \"qa1e8536-a985-4cf5-b981-a170927a1d11\",\n      \"committee_voting_enabled\": false,\n      \"creator_id\": \"Dolorem est ut sunt dolore.\",\n      \"creator_name\": \"Consectetur atque.\",\n      \"creator_username\": \"Omnis tempore odio.\",\n      \"email_body\": \"Ea deleniti consectetur in nam.\",\n      \"email_body_text\": \"Voluptatem laboriosam quo delectus aspernatur.\",\n      \"email_subject\": \"Consequuntur laborum harum.\",\n      \"is_project_survey\": true,\n      \"send_immediately\": false,\n      \"stage_filter\": \"Sit quis maxime sunt in laudantium.\",\n      \"survey_cutoff_date\": \"Et esse labore unde.\",\n      \"survey_monkey_id\": \"Soluta nam adipisci.\",\n      \"survey_reminder_rate_days\": 2027057317300210423,\n      \"survey_send_date\": \"Voluptatem officia dolores quasi.\",\n      \"survey_title\": \"Cumque nemo eligendi quasi ut consequatur.\"\n   }' --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
 }
 
 func surveyGetSurveyUsage() {
@@ -396,7 +693,7 @@ func surveyUpdateSurveyUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey update-survey --body '{\n      \"committee_uid\": \"qa1e8536-a985-4cf5-b981-a170927a1d11\",\n      \"committee_voting_enabled\": true,\n      \"creator_id\": \"Qui distinctio dolorum quia non quaerat nostrum.\",\n      \"email_body\": \"Veniam consequuntur sed praesentium.\",\n      \"email_body_text\": \"Similique et sapiente sunt sit.\",\n      \"email_subject\": \"Quo omnis maiores impedit.\",\n      \"survey_cutoff_date\": \"Consequatur rem et voluptatem et.\",\n      \"survey_reminder_rate_days\": 464515454408226755,\n      \"survey_send_date\": \"Sed in perspiciatis odit nesciunt.\",\n      \"survey_title\": \"Ut dicta eum ut vero animi.\"\n   }' --survey-uid \"b03cdbaf-53b1-4d47-bc04-dd7e459dd309\" --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey update-survey --body '{\n      \"committee_uid\": \"qa1e8536-a985-4cf5-b981-a170927a1d11\",\n      \"committee_voting_enabled\": true,\n      \"creator_id\": \"Et ut iste et laborum.\",\n      \"email_body\": \"Quisquam ducimus labore voluptatem perspiciatis esse.\",\n      \"email_body_text\": \"Et omnis unde.\",\n      \"email_subject\": \"Placeat perferendis sint sint dolor tempore.\",\n      \"survey_cutoff_date\": \"Ullam aliquid sequi porro laboriosam quia.\",\n      \"survey_reminder_rate_days\": 5990665214703261454,\n      \"survey_send_date\": \"Id laboriosam est officiis.\",\n      \"survey_title\": \"Error cumque maxime ex fugiat asperiores totam.\"\n   }' --survey-uid \"b03cdbaf-53b1-4d47-bc04-dd7e459dd309\" --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
 }
 
 func surveyDeleteSurveyUsage() {
@@ -572,7 +869,7 @@ func surveyCreateExclusionUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey create-exclusion --body '{\n      \"committee_uid\": \"Tenetur omnis.\",\n      \"email\": \"Vel ipsam laborum quos provident ad.\",\n      \"global_exclusion\": \"Amet deleniti aut.\",\n      \"survey_uid\": \"Nihil eos molestiae numquam.\",\n      \"user_id\": \"Id minima id.\"\n   }' --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey create-exclusion --body '{\n      \"committee_uid\": \"Veniam aut molestiae.\",\n      \"email\": \"Quis enim.\",\n      \"global_exclusion\": \"Enim ducimus corrupti.\",\n      \"survey_uid\": \"Dolores debitis expedita quam.\",\n      \"user_id\": \"Molestiae dolores laboriosam enim.\"\n   }' --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
 }
 
 func surveyDeleteExclusionUsage() {
@@ -592,7 +889,7 @@ func surveyDeleteExclusionUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey delete-exclusion --body '{\n      \"committee_uid\": \"Asperiores at dicta iusto adipisci est est.\",\n      \"email\": \"Repellendus modi illo voluptatem ab possimus.\",\n      \"global_exclusion\": \"Soluta facilis rerum exercitationem.\",\n      \"survey_uid\": \"Incidunt libero quo voluptates accusamus omnis saepe.\",\n      \"user_id\": \"Dolorem voluptatum ab accusantium magni nesciunt deleniti.\"\n   }' --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey delete-exclusion --body '{\n      \"committee_uid\": \"Sed in omnis ipsa fugit iusto.\",\n      \"email\": \"Quos minima odio.\",\n      \"global_exclusion\": \"Facilis sint.\",\n      \"survey_uid\": \"Quod porro dignissimos qui sint quod.\",\n      \"user_id\": \"Necessitatibus molestias aperiam ea illum delectus optio.\"\n   }' --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
 }
 
 func surveyGetExclusionUsage() {
@@ -663,6 +960,260 @@ func surveyListSurveyResponsesUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey list-survey-responses --survey-uid \"b03cdbaf-53b1-4d47-bc04-dd7e459dd309\" --page-token \"page-2-token\" --per-page \"25\" --project-uid \"qa1e8536-a985-4cf5-b981-a170927a1d11\" --project-uids \"qa1e8536-a985-4cf5-b981-a170927a1d11,qa1e8536-a985-4cf5-b981-a170927a1d12\" --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
 }
 
+func surveyCreateSurveyResponseUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] survey create-survey-response", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -survey-uid STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Submit a survey response (proxies to ITX POST /v2/surveys/responses). Answers are validated against the survey's template questions first; the caller must be the respondent the response belongs to`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -survey-uid STRING: Survey identifier`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey create-survey-response --body '{\n      \"answers\": [\n         {\n            \"answer_text\": \"More meetup content\",\n            \"choice_ids\": [\n               \"987654321\"\n            ],\n            \"question_id\": \"123456789\",\n            \"rating_value\": 9,\n            \"yes_no_value\": true\n         },\n         {\n            \"answer_text\": \"More meetup content\",\n            \"choice_ids\": [\n               \"987654321\"\n            ],\n            \"question_id\": \"123456789\",\n            \"rating_value\": 9,\n            \"yes_no_value\": true\n         },\n         {\n            \"answer_text\": \"More meetup content\",\n            \"choice_ids\": [\n               \"987654321\"\n            ],\n            \"question_id\": \"123456789\",\n            \"rating_value\": 9,\n            \"yes_no_value\": true\n         }\n      ],\n      \"survey_response_uid\": \"cba14f40-1636-11ec-9621-0242ac130002\"\n   }' --survey-uid \"b03cdbaf-53b1-4d47-bc04-dd7e459dd309\" --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+}
+
+func surveyUpdateSurveyResponseUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] survey update-survey-response", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -survey-uid STRING")
+	fmt.Fprint(os.Stderr, " -response-id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Replace the answers of a survey response (proxies to ITX PUT /v2/surveys/responses/{response_id}). Answers are validated against the survey's template questions first`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -survey-uid STRING: Survey identifier`)
+	fmt.Fprintln(os.Stderr, `    -response-id STRING: Response identifier`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey update-survey-response --body '{\n      \"answers\": [\n         {\n            \"answer_text\": \"More meetup content\",\n            \"choice_ids\": [\n               \"987654321\"\n            ],\n            \"question_id\": \"123456789\",\n            \"rating_value\": 9,\n            \"yes_no_value\": true\n         },\n         {\n            \"answer_text\": \"More meetup content\",\n            \"choice_ids\": [\n               \"987654321\"\n            ],\n            \"question_id\": \"123456789\",\n            \"rating_value\": 9,\n            \"yes_no_value\": true\n         },\n         {\n            \"answer_text\": \"More meetup content\",\n            \"choice_ids\": [\n               \"987654321\"\n            ],\n            \"question_id\": \"123456789\",\n            \"rating_value\": 9,\n            \"yes_no_value\": true\n         }\n      ]\n   }' --survey-uid \"b03cdbaf-53b1-4d47-bc04-dd7e459dd309\" --response-id \"cba14f40-1636-11ec-9621-0242ac130002\" --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+}
+
+func surveyListSurveyInvitesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] survey list-survey-invites", os.Args[0])
+	fmt.Fprint(os.Stderr, " -survey-uid STRING")
+	fmt.Fprint(os.Stderr, " -status STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the LFID invites sent to recipients of a survey, with counts by status`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -survey-uid STRING: Survey identifier`)
+	fmt.Fprintln(os.Stderr, `    -status STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey list-survey-invites --survey-uid \"b03cdbaf-53b1-4d47-bc04-dd7e459dd309\" --status \"failed\" --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+}
+
+func surveyGetSurveyInviteSettingsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] survey get-survey-invite-settings", os.Args[0])
+	fmt.Fprint(os.Stderr, " -survey-uid STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get the LFID invite settings stored for a survey`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -survey-uid STRING: Survey identifier`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey get-survey-invite-settings --survey-uid \"b03cdbaf-53b1-4d47-bc04-dd7e459dd309\" --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+}
+
+func surveyUpdateSurveyInviteSettingsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] survey update-survey-invite-settings", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -survey-uid STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Replace the LFID invite settings of a survey; unset fields inherit from the project`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -survey-uid STRING: Survey identifier`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey update-survey-invite-settings --body '{\n      \"display_name_fallback\": \"Member Survey\",\n      \"enabled\": true,\n      \"expiration_days\": 30,\n      \"locale\": \"ja-JP\",\n      \"return_url_template\": \"{base_url}/{locale}/surveys/{survey_uid}\",\n      \"role\": \"Participant\"\n   }' --survey-uid \"b03cdbaf-53b1-4d47-bc04-dd7e459dd309\" --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+}
+
+func surveyDeleteSurveyInviteSettingsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] survey delete-survey-invite-settings", os.Args[0])
+	fmt.Fprint(os.Stderr, " -survey-uid STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Remove the LFID invite settings of a survey so that its invites inherit again`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -survey-uid STRING: Survey identifier`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey delete-survey-invite-settings --survey-uid \"b03cdbaf-53b1-4d47-bc04-dd7e459dd309\" --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+}
+
+func surveyGetProjectInviteSettingsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] survey get-project-invite-settings", os.Args[0])
+	fmt.Fprint(os.Stderr, " -project-uid STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get the LFID invite settings stored for a project`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -project-uid STRING: Project identifier`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey get-project-invite-settings --project-uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+}
+
+func surveyUpdateProjectInviteSettingsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] survey update-project-invite-settings", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -project-uid STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Replace the LFID invite settings of a project; unset fields inherit the service defaults`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -project-uid STRING: Project identifier`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey update-project-invite-settings --body '{\n      \"display_name_fallback\": \"Member Survey\",\n      \"enabled\": true,\n      \"expiration_days\": 30,\n      \"locale\": \"ja-JP\",\n      \"return_url_template\": \"{base_url}/{locale}/surveys/{survey_uid}\",\n      \"role\": \"Participant\"\n   }' --project-uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+}
+
+func surveyDeleteProjectInviteSettingsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] survey delete-project-invite-settings", os.Args[0])
+	fmt.Fprint(os.Stderr, " -project-uid STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Remove the LFID invite settings of a project so that its invites inherit again`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -project-uid STRING: Project identifier`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey delete-project-invite-settings --project-uid \"7cad5a8d-19d0-41a4-81a6-043453daf9ee\" --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+}
+
+func surveyGetSurveySettingsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] survey get-survey-settings", os.Args[0])
+	fmt.Fprint(os.Stderr, " -survey-uid STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get the settings this service keeps for a survey`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -survey-uid STRING: Survey identifier`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey get-survey-settings --survey-uid \"b03cdbaf-53b1-4d47-bc04-dd7e459dd309\" --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+}
+
+func surveyUpdateSurveySettingsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] survey update-survey-settings", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -survey-uid STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Replace the settings of a survey. Turning share_results on grants every respondent with an LFX username the results_viewer relation on the survey; turning it off revokes it. Turning anonymous on republishes the survey's responses de-identified; it cannot be turned off.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -survey-uid STRING: Survey identifier`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey update-survey-settings --body '{\n      \"anonymous\": false,\n      \"share_results\": true\n   }' --survey-uid \"b03cdbaf-53b1-4d47-bc04-dd7e459dd309\" --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+}
+
+func surveyGetSurveyResultsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] survey get-survey-results", os.Args[0])
+	fmt.Fprint(os.Stderr, " -survey-uid STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get the aggregate results of a survey (proxies to ITX GET /v2/surveys/{survey_id}/results without free-text comments). Open to respondents of surveys that share results.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -survey-uid STRING: Survey identifier`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey get-survey-results --survey-uid \"b03cdbaf-53b1-4d47-bc04-dd7e459dd309\" --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+}
+
 func surveyValidateEmailUsage() {
 	// Header with flags
 	fmt.Fprintf(os.Stderr, "%s [flags] survey validate-email", os.Args[0])
@@ -680,5 +1231,249 @@ func surveyValidateEmailUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey validate-email --body '{\n      \"body\": \"Quia rerum esse adipisci quia.\",\n      \"subject\": \"Qui est sint.\"\n   }' --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey validate-email --body '{\n      \"body\": \"Quo non.\",\n      \"subject\": \"Tempora et ut.\"\n   }' --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+}
+
+func surveyListSurveyTemplatesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] survey list-survey-templates", os.Args[0])
+	fmt.Fprint(os.Stderr, " -title STRING")
+	fmt.Fprint(os.Stderr, " -category STRING")
+	fmt.Fprint(os.Stderr, " -language STRING")
+	fmt.Fprint(os.Stderr, " -offset INT")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Search the catalog of SurveyMonkey surveys that surveys can be scheduled from`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -title STRING: `)
+	fmt.Fprintln(os.Stderr, `    -category STRING: `)
+	fmt.Fprintln(os.Stderr, `    -language STRING: `)
+	fmt.Fprintln(os.Stderr, `    -offset INT: `)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey list-survey-templates --title \"NPS\" --category \"community\" --language \"en\" --offset 0 --limit 50 --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+}
+
+func surveyGetSurveyTemplateUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] survey get-survey-template", os.Args[0])
+	fmt.Fprint(os.Stderr, " -template-id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get a SurveyMonkey survey from the template catalog`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -template-id STRING: SurveyMonkey survey ID, as passed to schedule_survey as survey_monkey_id`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey get-survey-template --template-id \"508563520\" --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+}
+
+func surveyListDeadLettersUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] survey list-dead-letters", os.Args[0])
+	fmt.Fprint(os.Stderr, " -key-prefix STRING")
+	fmt.Fprint(os.Stderr, " -after UINT64")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List v1-objects KV events that exhausted their retries or failed conversion`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -key-prefix STRING: `)
+	fmt.Fprintln(os.Stderr, `    -after UINT64: `)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey list-dead-letters --key-prefix \"itx-survey-responses.\" --after 42 --limit 50 --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+}
+
+func surveyGetDeadLetterUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] survey get-dead-letter", os.Args[0])
+	fmt.Fprint(os.Stderr, " -sequence UINT64")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Inspect a dead-lettered KV event, including its original payload and headers`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -sequence UINT64: Dead-letter stream sequence`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey get-dead-letter --sequence 42 --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+}
+
+func surveyReplayDeadLetterUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] survey replay-dead-letter", os.Args[0])
+	fmt.Fprint(os.Stderr, " -sequence UINT64")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Re-run a dead-lettered KV event through the event handlers; the entry is removed when it succeeds`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -sequence UINT64: Dead-letter stream sequence`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey replay-dead-letter --sequence 42 --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+}
+
+func surveyStartReindexUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] survey start-reindex", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Replay v1-objects survey entries through the event handlers in the background, to rebuild downstream documents`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey start-reindex --body '{\n      \"dry_run\": false,\n      \"force\": false,\n      \"key_prefixes\": [\n         \"itx-survey-responses.\"\n      ],\n      \"modified_since\": \"2026-01-01T00:00:00Z\",\n      \"modified_until\": \"2026-02-01T00:00:00Z\",\n      \"rate_per_second\": 50\n   }' --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+}
+
+func surveyGetReindexUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] survey get-reindex", os.Args[0])
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Report the progress of the current or most recent reindex on this instance`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey get-reindex --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+}
+
+func surveyCancelReindexUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] survey cancel-reindex", os.Args[0])
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Stop the running reindex; entries already replayed are not rolled back`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey cancel-reindex --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+}
+
+func surveyRerunInviteEnrichmentUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] survey rerun-invite-enrichment", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Re-run the ITX survey response enrichment for an accepted LFID invite, e.g. after its invite_accepted event was dead-lettered`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey rerun-invite-enrichment --body '{\n      \"email\": \"jane@example.com\",\n      \"username\": \"jdoe\"\n   }' --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+}
+
+func surveyStartInviteBackfillUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] survey start-invite-backfill", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Send LFID invites in the background to participants of open surveys who have an email but no username and were never invited, e.g. because they were synced before invites were enabled`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey start-invite-backfill --body '{\n      \"dry_run\": false,\n      \"rate_per_second\": 5,\n      \"survey_uids\": [\n         \"b03cdbaf-53b1-4d47-bc04-dd7e459dd309\"\n      ]\n   }' --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+}
+
+func surveyGetInviteBackfillUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] survey get-invite-backfill", os.Args[0])
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Report the progress of the current or most recent invite backfill on this instance`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey get-invite-backfill --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
+}
+
+func surveyCancelInviteBackfillUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] survey cancel-invite-backfill", os.Args[0])
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Stop the running invite backfill; invites already sent are not withdrawn`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "survey cancel-invite-backfill --token \"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\"")
 }
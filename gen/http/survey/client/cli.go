@@ -11,6 +11,7 @@ package client
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	survey "github.com/linuxfoundation/lfx-v2-survey-service/gen/survey"
 	goa "goa.design/goa/v3/pkg"
@@ -24,7 +25,7 @@ func BuildScheduleSurveyPayload(surveyScheduleSurveyBody string, surveyScheduleS
 	{
 		err = json.Unmarshal([]byte(surveyScheduleSurveyBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"committee_uid\": \"qa1e8536-a985-4cf5-b981-a170927a1d11\",\n      \"committee_voting_enabled\": true,\n      \"creator_id\": \"Quam esse saepe eum non omnis amet.\",\n      \"creator_name\": \"Consequatur quod numquam et provident dolorem voluptatem.\",\n      \"creator_username\": \"Numquam eum suscipit labore odio rerum.\",\n      \"email_body\": \"Repellendus sunt omnis voluptate minima possimus.\",\n      \"email_body_text\": \"Occaecati sunt odit quia quia fugiat est.\",\n      \"email_subject\": \"Quis vero voluptatem et temporibus.\",\n      \"is_project_survey\": true,\n      \"send_immediately\": true,\n      \"stage_filter\": \"Dolorem quia et in.\",\n      \"survey_cutoff_date\": \"Omnis sint reprehenderit.\",\n      \"survey_monkey_id\": \"Omnis est at omnis.\",\n      \"survey_reminder_rate_days\": 3291766316701683331,\n      \"survey_send_date\": \"Et nulla quia.\",\n      \"survey_title\": \"Dolorum cumque.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"committee_uid\": \"qa1e8536-a985-4cf5-b981-a170927a1d11\",\n      \"committee_voting_enabled\": false,\n      \"creator_id\": \"Dolorem est ut sunt dolore.\",\n      \"creator_name\": \"Consectetur atque.\",\n      \"creator_username\": \"Omnis tempore odio.\",\n      \"email_body\": \"Ea deleniti consectetur in nam.\",\n      \"email_body_text\": \"Voluptatem laboriosam quo delectus aspernatur.\",\n      \"email_subject\": \"Consequuntur laborum harum.\",\n      \"is_project_survey\": true,\n      \"send_immediately\": false,\n      \"stage_filter\": \"Sit quis maxime sunt in laudantium.\",\n      \"survey_cutoff_date\": \"Et esse labore unde.\",\n      \"survey_monkey_id\": \"Soluta nam adipisci.\",\n      \"survey_reminder_rate_days\": 2027057317300210423,\n      \"survey_send_date\": \"Voluptatem officia dolores quasi.\",\n      \"survey_title\": \"Cumque nemo eligendi quasi ut consequatur.\"\n   }'")
 		}
 	}
 	var token *string
@@ -98,7 +99,7 @@ func BuildUpdateSurveyPayload(surveyUpdateSurveyBody string, surveyUpdateSurveyS
 	{
 		err = json.Unmarshal([]byte(surveyUpdateSurveyBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"committee_uid\": \"qa1e8536-a985-4cf5-b981-a170927a1d11\",\n      \"committee_voting_enabled\": true,\n      \"creator_id\": \"Qui distinctio dolorum quia non quaerat nostrum.\",\n      \"email_body\": \"Veniam consequuntur sed praesentium.\",\n      \"email_body_text\": \"Similique et sapiente sunt sit.\",\n      \"email_subject\": \"Quo omnis maiores impedit.\",\n      \"survey_cutoff_date\": \"Consequatur rem et voluptatem et.\",\n      \"survey_reminder_rate_days\": 464515454408226755,\n      \"survey_send_date\": \"Sed in perspiciatis odit nesciunt.\",\n      \"survey_title\": \"Ut dicta eum ut vero animi.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"committee_uid\": \"qa1e8536-a985-4cf5-b981-a170927a1d11\",\n      \"committee_voting_enabled\": true,\n      \"creator_id\": \"Et ut iste et laborum.\",\n      \"email_body\": \"Quisquam ducimus labore voluptatem perspiciatis esse.\",\n      \"email_body_text\": \"Et omnis unde.\",\n      \"email_subject\": \"Placeat perferendis sint sint dolor tempore.\",\n      \"survey_cutoff_date\": \"Ullam aliquid sequi porro laboriosam quia.\",\n      \"survey_reminder_rate_days\": 5990665214703261454,\n      \"survey_send_date\": \"Id laboriosam est officiis.\",\n      \"survey_title\": \"Error cumque maxime ex fugiat asperiores totam.\"\n   }'")
 		}
 	}
 	var surveyUID string
@@ -344,7 +345,7 @@ func BuildCreateExclusionPayload(surveyCreateExclusionBody string, surveyCreateE
 	{
 		err = json.Unmarshal([]byte(surveyCreateExclusionBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"committee_uid\": \"Tenetur omnis.\",\n      \"email\": \"Vel ipsam laborum quos provident ad.\",\n      \"global_exclusion\": \"Amet deleniti aut.\",\n      \"survey_uid\": \"Nihil eos molestiae numquam.\",\n      \"user_id\": \"Id minima id.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"committee_uid\": \"Veniam aut molestiae.\",\n      \"email\": \"Quis enim.\",\n      \"global_exclusion\": \"Enim ducimus corrupti.\",\n      \"survey_uid\": \"Dolores debitis expedita quam.\",\n      \"user_id\": \"Molestiae dolores laboriosam enim.\"\n   }'")
 		}
 	}
 	var token *string
@@ -373,7 +374,7 @@ func BuildDeleteExclusionPayload(surveyDeleteExclusionBody string, surveyDeleteE
 	{
 		err = json.Unmarshal([]byte(surveyDeleteExclusionBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"committee_uid\": \"Asperiores at dicta iusto adipisci est est.\",\n      \"email\": \"Repellendus modi illo voluptatem ab possimus.\",\n      \"global_exclusion\": \"Soluta facilis rerum exercitationem.\",\n      \"survey_uid\": \"Incidunt libero quo voluptates accusamus omnis saepe.\",\n      \"user_id\": \"Dolorem voluptatum ab accusantium magni nesciunt deleniti.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"committee_uid\": \"Sed in omnis ipsa fugit iusto.\",\n      \"email\": \"Quos minima odio.\",\n      \"global_exclusion\": \"Facilis sint.\",\n      \"survey_uid\": \"Quod porro dignissimos qui sint quod.\",\n      \"user_id\": \"Necessitatibus molestias aperiam ea illum delectus optio.\"\n   }'")
 		}
 	}
 	var token *string
@@ -482,6 +483,408 @@ func BuildListSurveyResponsesPayload(surveyListSurveyResponsesSurveyUID string,
 	return v, nil
 }
 
+// BuildCreateSurveyResponsePayload builds the payload for the survey
+// create_survey_response endpoint from CLI flags.
+func BuildCreateSurveyResponsePayload(surveyCreateSurveyResponseBody string, surveyCreateSurveyResponseSurveyUID string, surveyCreateSurveyResponseToken string) (*survey.CreateSurveyResponsePayload, error) {
+	var err error
+	var body CreateSurveyResponseRequestBody
+	{
+		err = json.Unmarshal([]byte(surveyCreateSurveyResponseBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"answers\": [\n         {\n            \"answer_text\": \"More meetup content\",\n            \"choice_ids\": [\n               \"987654321\"\n            ],\n            \"question_id\": \"123456789\",\n            \"rating_value\": 9,\n            \"yes_no_value\": true\n         },\n         {\n            \"answer_text\": \"More meetup content\",\n            \"choice_ids\": [\n               \"987654321\"\n            ],\n            \"question_id\": \"123456789\",\n            \"rating_value\": 9,\n            \"yes_no_value\": true\n         },\n         {\n            \"answer_text\": \"More meetup content\",\n            \"choice_ids\": [\n               \"987654321\"\n            ],\n            \"question_id\": \"123456789\",\n            \"rating_value\": 9,\n            \"yes_no_value\": true\n         }\n      ],\n      \"survey_response_uid\": \"cba14f40-1636-11ec-9621-0242ac130002\"\n   }'")
+		}
+		if body.Answers == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("answers", "body"))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var surveyUID string
+	{
+		surveyUID = surveyCreateSurveyResponseSurveyUID
+	}
+	var token *string
+	{
+		if surveyCreateSurveyResponseToken != "" {
+			token = &surveyCreateSurveyResponseToken
+		}
+	}
+	v := &survey.CreateSurveyResponsePayload{
+		SurveyResponseUID: body.SurveyResponseUID,
+	}
+	if body.Answers != nil {
+		v.Answers = make([]*survey.SurveyAnswer, len(body.Answers))
+		for i, val := range body.Answers {
+			if val == nil {
+				v.Answers[i] = nil
+				continue
+			}
+			v.Answers[i] = marshalSurveyAnswerRequestBodyToSurveySurveyAnswer(val)
+		}
+	} else {
+		v.Answers = []*survey.SurveyAnswer{}
+	}
+	v.SurveyUID = surveyUID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildUpdateSurveyResponsePayload builds the payload for the survey
+// update_survey_response endpoint from CLI flags.
+func BuildUpdateSurveyResponsePayload(surveyUpdateSurveyResponseBody string, surveyUpdateSurveyResponseSurveyUID string, surveyUpdateSurveyResponseResponseID string, surveyUpdateSurveyResponseToken string) (*survey.UpdateSurveyResponsePayload, error) {
+	var err error
+	var body UpdateSurveyResponseRequestBody
+	{
+		err = json.Unmarshal([]byte(surveyUpdateSurveyResponseBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"answers\": [\n         {\n            \"answer_text\": \"More meetup content\",\n            \"choice_ids\": [\n               \"987654321\"\n            ],\n            \"question_id\": \"123456789\",\n            \"rating_value\": 9,\n            \"yes_no_value\": true\n         },\n         {\n            \"answer_text\": \"More meetup content\",\n            \"choice_ids\": [\n               \"987654321\"\n            ],\n            \"question_id\": \"123456789\",\n            \"rating_value\": 9,\n            \"yes_no_value\": true\n         },\n         {\n            \"answer_text\": \"More meetup content\",\n            \"choice_ids\": [\n               \"987654321\"\n            ],\n            \"question_id\": \"123456789\",\n            \"rating_value\": 9,\n            \"yes_no_value\": true\n         }\n      ]\n   }'")
+		}
+		if body.Answers == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("answers", "body"))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var surveyUID string
+	{
+		surveyUID = surveyUpdateSurveyResponseSurveyUID
+	}
+	var responseID string
+	{
+		responseID = surveyUpdateSurveyResponseResponseID
+	}
+	var token *string
+	{
+		if surveyUpdateSurveyResponseToken != "" {
+			token = &surveyUpdateSurveyResponseToken
+		}
+	}
+	v := &survey.UpdateSurveyResponsePayload{}
+	if body.Answers != nil {
+		v.Answers = make([]*survey.SurveyAnswer, len(body.Answers))
+		for i, val := range body.Answers {
+			if val == nil {
+				v.Answers[i] = nil
+				continue
+			}
+			v.Answers[i] = marshalSurveyAnswerRequestBodyToSurveySurveyAnswer(val)
+		}
+	} else {
+		v.Answers = []*survey.SurveyAnswer{}
+	}
+	v.SurveyUID = surveyUID
+	v.ResponseID = responseID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListSurveyInvitesPayload builds the payload for the survey
+// list_survey_invites endpoint from CLI flags.
+func BuildListSurveyInvitesPayload(surveyListSurveyInvitesSurveyUID string, surveyListSurveyInvitesStatus string, surveyListSurveyInvitesToken string) (*survey.ListSurveyInvitesPayload, error) {
+	var err error
+	var surveyUID string
+	{
+		surveyUID = surveyListSurveyInvitesSurveyUID
+	}
+	var status *string
+	{
+		if surveyListSurveyInvitesStatus != "" {
+			status = &surveyListSurveyInvitesStatus
+			if !(*status == "pending" || *status == "sent" || *status == "failed" || *status == "accepted" || *status == "expired") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", *status, []any{"pending", "sent", "failed", "accepted", "expired"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token *string
+	{
+		if surveyListSurveyInvitesToken != "" {
+			token = &surveyListSurveyInvitesToken
+		}
+	}
+	v := &survey.ListSurveyInvitesPayload{}
+	v.SurveyUID = surveyUID
+	v.Status = status
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildGetSurveyInviteSettingsPayload builds the payload for the survey
+// get_survey_invite_settings endpoint from CLI flags.
+func BuildGetSurveyInviteSettingsPayload(surveyGetSurveyInviteSettingsSurveyUID string, surveyGetSurveyInviteSettingsToken string) (*survey.GetSurveyInviteSettingsPayload, error) {
+	var surveyUID string
+	{
+		surveyUID = surveyGetSurveyInviteSettingsSurveyUID
+	}
+	var token *string
+	{
+		if surveyGetSurveyInviteSettingsToken != "" {
+			token = &surveyGetSurveyInviteSettingsToken
+		}
+	}
+	v := &survey.GetSurveyInviteSettingsPayload{}
+	v.SurveyUID = surveyUID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildUpdateSurveyInviteSettingsPayload builds the payload for the survey
+// update_survey_invite_settings endpoint from CLI flags.
+func BuildUpdateSurveyInviteSettingsPayload(surveyUpdateSurveyInviteSettingsBody string, surveyUpdateSurveyInviteSettingsSurveyUID string, surveyUpdateSurveyInviteSettingsToken string) (*survey.UpdateSurveyInviteSettingsPayload, error) {
+	var err error
+	var body UpdateSurveyInviteSettingsRequestBody
+	{
+		err = json.Unmarshal([]byte(surveyUpdateSurveyInviteSettingsBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"display_name_fallback\": \"Member Survey\",\n      \"enabled\": true,\n      \"expiration_days\": 30,\n      \"locale\": \"ja-JP\",\n      \"return_url_template\": \"{base_url}/{locale}/surveys/{survey_uid}\",\n      \"role\": \"Participant\"\n   }'")
+		}
+		if body.Role != nil {
+			if !(*body.Role == "Participant") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.role", *body.Role, []any{"Participant"}))
+			}
+		}
+		if body.ExpirationDays != nil {
+			if *body.ExpirationDays < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.expiration_days", *body.ExpirationDays, 1, true))
+			}
+		}
+		if body.ExpirationDays != nil {
+			if *body.ExpirationDays > 90 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.expiration_days", *body.ExpirationDays, 90, false))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var surveyUID string
+	{
+		surveyUID = surveyUpdateSurveyInviteSettingsSurveyUID
+	}
+	var token *string
+	{
+		if surveyUpdateSurveyInviteSettingsToken != "" {
+			token = &surveyUpdateSurveyInviteSettingsToken
+		}
+	}
+	v := &survey.UpdateSurveyInviteSettingsPayload{
+		Enabled:             body.Enabled,
+		Role:                body.Role,
+		ExpirationDays:      body.ExpirationDays,
+		ReturnURLTemplate:   body.ReturnURLTemplate,
+		DisplayNameFallback: body.DisplayNameFallback,
+		Locale:              body.Locale,
+	}
+	v.SurveyUID = surveyUID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildDeleteSurveyInviteSettingsPayload builds the payload for the survey
+// delete_survey_invite_settings endpoint from CLI flags.
+func BuildDeleteSurveyInviteSettingsPayload(surveyDeleteSurveyInviteSettingsSurveyUID string, surveyDeleteSurveyInviteSettingsToken string) (*survey.DeleteSurveyInviteSettingsPayload, error) {
+	var surveyUID string
+	{
+		surveyUID = surveyDeleteSurveyInviteSettingsSurveyUID
+	}
+	var token *string
+	{
+		if surveyDeleteSurveyInviteSettingsToken != "" {
+			token = &surveyDeleteSurveyInviteSettingsToken
+		}
+	}
+	v := &survey.DeleteSurveyInviteSettingsPayload{}
+	v.SurveyUID = surveyUID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildGetProjectInviteSettingsPayload builds the payload for the survey
+// get_project_invite_settings endpoint from CLI flags.
+func BuildGetProjectInviteSettingsPayload(surveyGetProjectInviteSettingsProjectUID string, surveyGetProjectInviteSettingsToken string) (*survey.GetProjectInviteSettingsPayload, error) {
+	var projectUID string
+	{
+		projectUID = surveyGetProjectInviteSettingsProjectUID
+	}
+	var token *string
+	{
+		if surveyGetProjectInviteSettingsToken != "" {
+			token = &surveyGetProjectInviteSettingsToken
+		}
+	}
+	v := &survey.GetProjectInviteSettingsPayload{}
+	v.ProjectUID = projectUID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildUpdateProjectInviteSettingsPayload builds the payload for the survey
+// update_project_invite_settings endpoint from CLI flags.
+func BuildUpdateProjectInviteSettingsPayload(surveyUpdateProjectInviteSettingsBody string, surveyUpdateProjectInviteSettingsProjectUID string, surveyUpdateProjectInviteSettingsToken string) (*survey.UpdateProjectInviteSettingsPayload, error) {
+	var err error
+	var body UpdateProjectInviteSettingsRequestBody
+	{
+		err = json.Unmarshal([]byte(surveyUpdateProjectInviteSettingsBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"display_name_fallback\": \"Member Survey\",\n      \"enabled\": true,\n      \"expiration_days\": 30,\n      \"locale\": \"ja-JP\",\n      \"return_url_template\": \"{base_url}/{locale}/surveys/{survey_uid}\",\n      \"role\": \"Participant\"\n   }'")
+		}
+		if body.Role != nil {
+			if !(*body.Role == "Participant") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.role", *body.Role, []any{"Participant"}))
+			}
+		}
+		if body.ExpirationDays != nil {
+			if *body.ExpirationDays < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.expiration_days", *body.ExpirationDays, 1, true))
+			}
+		}
+		if body.ExpirationDays != nil {
+			if *body.ExpirationDays > 90 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.expiration_days", *body.ExpirationDays, 90, false))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var projectUID string
+	{
+		projectUID = surveyUpdateProjectInviteSettingsProjectUID
+	}
+	var token *string
+	{
+		if surveyUpdateProjectInviteSettingsToken != "" {
+			token = &surveyUpdateProjectInviteSettingsToken
+		}
+	}
+	v := &survey.UpdateProjectInviteSettingsPayload{
+		Enabled:             body.Enabled,
+		Role:                body.Role,
+		ExpirationDays:      body.ExpirationDays,
+		ReturnURLTemplate:   body.ReturnURLTemplate,
+		DisplayNameFallback: body.DisplayNameFallback,
+		Locale:              body.Locale,
+	}
+	v.ProjectUID = projectUID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildDeleteProjectInviteSettingsPayload builds the payload for the survey
+// delete_project_invite_settings endpoint from CLI flags.
+func BuildDeleteProjectInviteSettingsPayload(surveyDeleteProjectInviteSettingsProjectUID string, surveyDeleteProjectInviteSettingsToken string) (*survey.DeleteProjectInviteSettingsPayload, error) {
+	var projectUID string
+	{
+		projectUID = surveyDeleteProjectInviteSettingsProjectUID
+	}
+	var token *string
+	{
+		if surveyDeleteProjectInviteSettingsToken != "" {
+			token = &surveyDeleteProjectInviteSettingsToken
+		}
+	}
+	v := &survey.DeleteProjectInviteSettingsPayload{}
+	v.ProjectUID = projectUID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildGetSurveySettingsPayload builds the payload for the survey
+// get_survey_settings endpoint from CLI flags.
+func BuildGetSurveySettingsPayload(surveyGetSurveySettingsSurveyUID string, surveyGetSurveySettingsToken string) (*survey.GetSurveySettingsPayload, error) {
+	var surveyUID string
+	{
+		surveyUID = surveyGetSurveySettingsSurveyUID
+	}
+	var token *string
+	{
+		if surveyGetSurveySettingsToken != "" {
+			token = &surveyGetSurveySettingsToken
+		}
+	}
+	v := &survey.GetSurveySettingsPayload{}
+	v.SurveyUID = surveyUID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildUpdateSurveySettingsPayload builds the payload for the survey
+// update_survey_settings endpoint from CLI flags.
+func BuildUpdateSurveySettingsPayload(surveyUpdateSurveySettingsBody string, surveyUpdateSurveySettingsSurveyUID string, surveyUpdateSurveySettingsToken string) (*survey.UpdateSurveySettingsPayload, error) {
+	var err error
+	var body UpdateSurveySettingsRequestBody
+	{
+		err = json.Unmarshal([]byte(surveyUpdateSurveySettingsBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"anonymous\": false,\n      \"share_results\": true\n   }'")
+		}
+	}
+	var surveyUID string
+	{
+		surveyUID = surveyUpdateSurveySettingsSurveyUID
+	}
+	var token *string
+	{
+		if surveyUpdateSurveySettingsToken != "" {
+			token = &surveyUpdateSurveySettingsToken
+		}
+	}
+	v := &survey.UpdateSurveySettingsPayload{
+		ShareResults: body.ShareResults,
+		Anonymous:    body.Anonymous,
+	}
+	{
+		var zero bool
+		if v.ShareResults == zero {
+			v.ShareResults = false
+		}
+	}
+	{
+		var zero bool
+		if v.Anonymous == zero {
+			v.Anonymous = false
+		}
+	}
+	v.SurveyUID = surveyUID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildGetSurveyResultsPayload builds the payload for the survey
+// get_survey_results endpoint from CLI flags.
+func BuildGetSurveyResultsPayload(surveyGetSurveyResultsSurveyUID string, surveyGetSurveyResultsToken string) (*survey.GetSurveyResultsPayload, error) {
+	var surveyUID string
+	{
+		surveyUID = surveyGetSurveyResultsSurveyUID
+	}
+	var token *string
+	{
+		if surveyGetSurveyResultsToken != "" {
+			token = &surveyGetSurveyResultsToken
+		}
+	}
+	v := &survey.GetSurveyResultsPayload{}
+	v.SurveyUID = surveyUID
+	v.Token = token
+
+	return v, nil
+}
+
 // BuildValidateEmailPayload builds the payload for the survey validate_email
 // endpoint from CLI flags.
 func BuildValidateEmailPayload(surveyValidateEmailBody string, surveyValidateEmailToken string) (*survey.ValidateEmailPayload, error) {
@@ -490,7 +893,7 @@ func BuildValidateEmailPayload(surveyValidateEmailBody string, surveyValidateEma
 	{
 		err = json.Unmarshal([]byte(surveyValidateEmailBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"body\": \"Quia rerum esse adipisci quia.\",\n      \"subject\": \"Qui est sint.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"body\": \"Quo non.\",\n      \"subject\": \"Tempora et ut.\"\n   }'")
 		}
 	}
 	var token *string
@@ -507,3 +910,389 @@ func BuildValidateEmailPayload(surveyValidateEmailBody string, surveyValidateEma
 
 	return v, nil
 }
+
+// BuildListSurveyTemplatesPayload builds the payload for the survey
+// list_survey_templates endpoint from CLI flags.
+func BuildListSurveyTemplatesPayload(surveyListSurveyTemplatesTitle string, surveyListSurveyTemplatesCategory string, surveyListSurveyTemplatesLanguage string, surveyListSurveyTemplatesOffset string, surveyListSurveyTemplatesLimit string, surveyListSurveyTemplatesToken string) (*survey.ListSurveyTemplatesPayload, error) {
+	var err error
+	var title *string
+	{
+		if surveyListSurveyTemplatesTitle != "" {
+			title = &surveyListSurveyTemplatesTitle
+		}
+	}
+	var category *string
+	{
+		if surveyListSurveyTemplatesCategory != "" {
+			category = &surveyListSurveyTemplatesCategory
+		}
+	}
+	var language *string
+	{
+		if surveyListSurveyTemplatesLanguage != "" {
+			language = &surveyListSurveyTemplatesLanguage
+		}
+	}
+	var offset int
+	{
+		if surveyListSurveyTemplatesOffset != "" {
+			var v int64
+			v, err = strconv.ParseInt(surveyListSurveyTemplatesOffset, 10, strconv.IntSize)
+			offset = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for offset, must be INT")
+			}
+			if offset < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("offset", offset, 0, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var limit int
+	{
+		if surveyListSurveyTemplatesLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(surveyListSurveyTemplatesLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 200 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 200, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token *string
+	{
+		if surveyListSurveyTemplatesToken != "" {
+			token = &surveyListSurveyTemplatesToken
+		}
+	}
+	v := &survey.ListSurveyTemplatesPayload{}
+	v.Title = title
+	v.Category = category
+	v.Language = language
+	v.Offset = offset
+	v.Limit = limit
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildGetSurveyTemplatePayload builds the payload for the survey
+// get_survey_template endpoint from CLI flags.
+func BuildGetSurveyTemplatePayload(surveyGetSurveyTemplateTemplateID string, surveyGetSurveyTemplateToken string) (*survey.GetSurveyTemplatePayload, error) {
+	var templateID string
+	{
+		templateID = surveyGetSurveyTemplateTemplateID
+	}
+	var token *string
+	{
+		if surveyGetSurveyTemplateToken != "" {
+			token = &surveyGetSurveyTemplateToken
+		}
+	}
+	v := &survey.GetSurveyTemplatePayload{}
+	v.TemplateID = templateID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListDeadLettersPayload builds the payload for the survey
+// list_dead_letters endpoint from CLI flags.
+func BuildListDeadLettersPayload(surveyListDeadLettersKeyPrefix string, surveyListDeadLettersAfter string, surveyListDeadLettersLimit string, surveyListDeadLettersToken string) (*survey.ListDeadLettersPayload, error) {
+	var err error
+	var keyPrefix *string
+	{
+		if surveyListDeadLettersKeyPrefix != "" {
+			keyPrefix = &surveyListDeadLettersKeyPrefix
+		}
+	}
+	var after *uint64
+	{
+		if surveyListDeadLettersAfter != "" {
+			val, err := strconv.ParseUint(surveyListDeadLettersAfter, 10, 64)
+			after = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for after, must be UINT64")
+			}
+		}
+	}
+	var limit int
+	{
+		if surveyListDeadLettersLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(surveyListDeadLettersLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 500 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 500, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token *string
+	{
+		if surveyListDeadLettersToken != "" {
+			token = &surveyListDeadLettersToken
+		}
+	}
+	v := &survey.ListDeadLettersPayload{}
+	v.KeyPrefix = keyPrefix
+	v.After = after
+	v.Limit = limit
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildGetDeadLetterPayload builds the payload for the survey get_dead_letter
+// endpoint from CLI flags.
+func BuildGetDeadLetterPayload(surveyGetDeadLetterSequence string, surveyGetDeadLetterToken string) (*survey.GetDeadLetterPayload, error) {
+	var err error
+	var sequence uint64
+	{
+		sequence, err = strconv.ParseUint(surveyGetDeadLetterSequence, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for sequence, must be UINT64")
+		}
+	}
+	var token *string
+	{
+		if surveyGetDeadLetterToken != "" {
+			token = &surveyGetDeadLetterToken
+		}
+	}
+	v := &survey.GetDeadLetterPayload{}
+	v.Sequence = sequence
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildReplayDeadLetterPayload builds the payload for the survey
+// replay_dead_letter endpoint from CLI flags.
+func BuildReplayDeadLetterPayload(surveyReplayDeadLetterSequence string, surveyReplayDeadLetterToken string) (*survey.ReplayDeadLetterPayload, error) {
+	var err error
+	var sequence uint64
+	{
+		sequence, err = strconv.ParseUint(surveyReplayDeadLetterSequence, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for sequence, must be UINT64")
+		}
+	}
+	var token *string
+	{
+		if surveyReplayDeadLetterToken != "" {
+			token = &surveyReplayDeadLetterToken
+		}
+	}
+	v := &survey.ReplayDeadLetterPayload{}
+	v.Sequence = sequence
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildStartReindexPayload builds the payload for the survey start_reindex
+// endpoint from CLI flags.
+func BuildStartReindexPayload(surveyStartReindexBody string, surveyStartReindexToken string) (*survey.StartReindexPayload, error) {
+	var err error
+	var body StartReindexRequestBody
+	{
+		err = json.Unmarshal([]byte(surveyStartReindexBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"dry_run\": false,\n      \"force\": false,\n      \"key_prefixes\": [\n         \"itx-survey-responses.\"\n      ],\n      \"modified_since\": \"2026-01-01T00:00:00Z\",\n      \"modified_until\": \"2026-02-01T00:00:00Z\",\n      \"rate_per_second\": 50\n   }'")
+		}
+	}
+	var token *string
+	{
+		if surveyStartReindexToken != "" {
+			token = &surveyStartReindexToken
+		}
+	}
+	v := &survey.StartReindexPayload{
+		ModifiedSince: body.ModifiedSince,
+		ModifiedUntil: body.ModifiedUntil,
+		DryRun:        body.DryRun,
+		Force:         body.Force,
+		RatePerSecond: body.RatePerSecond,
+	}
+	if body.KeyPrefixes != nil {
+		v.KeyPrefixes = make([]string, len(body.KeyPrefixes))
+		for i, val := range body.KeyPrefixes {
+			v.KeyPrefixes[i] = val
+		}
+	}
+	{
+		var zero bool
+		if v.DryRun == zero {
+			v.DryRun = false
+		}
+	}
+	{
+		var zero bool
+		if v.Force == zero {
+			v.Force = false
+		}
+	}
+	{
+		var zero int
+		if v.RatePerSecond == zero {
+			v.RatePerSecond = 50
+		}
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildGetReindexPayload builds the payload for the survey get_reindex
+// endpoint from CLI flags.
+func BuildGetReindexPayload(surveyGetReindexToken string) (*survey.GetReindexPayload, error) {
+	var token *string
+	{
+		if surveyGetReindexToken != "" {
+			token = &surveyGetReindexToken
+		}
+	}
+	v := &survey.GetReindexPayload{}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCancelReindexPayload builds the payload for the survey cancel_reindex
+// endpoint from CLI flags.
+func BuildCancelReindexPayload(surveyCancelReindexToken string) (*survey.CancelReindexPayload, error) {
+	var token *string
+	{
+		if surveyCancelReindexToken != "" {
+			token = &surveyCancelReindexToken
+		}
+	}
+	v := &survey.CancelReindexPayload{}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildRerunInviteEnrichmentPayload builds the payload for the survey
+// rerun_invite_enrichment endpoint from CLI flags.
+func BuildRerunInviteEnrichmentPayload(surveyRerunInviteEnrichmentBody string, surveyRerunInviteEnrichmentToken string) (*survey.RerunInviteEnrichmentPayload, error) {
+	var err error
+	var body RerunInviteEnrichmentRequestBody
+	{
+		err = json.Unmarshal([]byte(surveyRerunInviteEnrichmentBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"email\": \"jane@example.com\",\n      \"username\": \"jdoe\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", body.Email, goa.FormatEmail))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token *string
+	{
+		if surveyRerunInviteEnrichmentToken != "" {
+			token = &surveyRerunInviteEnrichmentToken
+		}
+	}
+	v := &survey.RerunInviteEnrichmentPayload{
+		Email:    body.Email,
+		Username: body.Username,
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildStartInviteBackfillPayload builds the payload for the survey
+// start_invite_backfill endpoint from CLI flags.
+func BuildStartInviteBackfillPayload(surveyStartInviteBackfillBody string, surveyStartInviteBackfillToken string) (*survey.StartInviteBackfillPayload, error) {
+	var err error
+	var body StartInviteBackfillRequestBody
+	{
+		err = json.Unmarshal([]byte(surveyStartInviteBackfillBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"dry_run\": false,\n      \"rate_per_second\": 5,\n      \"survey_uids\": [\n         \"b03cdbaf-53b1-4d47-bc04-dd7e459dd309\"\n      ]\n   }'")
+		}
+	}
+	var token *string
+	{
+		if surveyStartInviteBackfillToken != "" {
+			token = &surveyStartInviteBackfillToken
+		}
+	}
+	v := &survey.StartInviteBackfillPayload{
+		DryRun:        body.DryRun,
+		RatePerSecond: body.RatePerSecond,
+	}
+	if body.SurveyUids != nil {
+		v.SurveyUids = make([]string, len(body.SurveyUids))
+		for i, val := range body.SurveyUids {
+			v.SurveyUids[i] = val
+		}
+	}
+	{
+		var zero bool
+		if v.DryRun == zero {
+			v.DryRun = false
+		}
+	}
+	{
+		var zero int
+		if v.RatePerSecond == zero {
+			v.RatePerSecond = 5
+		}
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildGetInviteBackfillPayload builds the payload for the survey
+// get_invite_backfill endpoint from CLI flags.
+func BuildGetInviteBackfillPayload(surveyGetInviteBackfillToken string) (*survey.GetInviteBackfillPayload, error) {
+	var token *string
+	{
+		if surveyGetInviteBackfillToken != "" {
+			token = &surveyGetInviteBackfillToken
+		}
+	}
+	v := &survey.GetInviteBackfillPayload{}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCancelInviteBackfillPayload builds the payload for the survey
+// cancel_invite_backfill endpoint from CLI flags.
+func BuildCancelInviteBackfillPayload(surveyCancelInviteBackfillToken string) (*survey.CancelInviteBackfillPayload, error) {
+	var token *string
+	{
+		if surveyCancelInviteBackfillToken != "" {
+			token = &surveyCancelInviteBackfillToken
+		}
+	}
+	v := &survey.CancelInviteBackfillPayload{}
+	v.Token = token
+
+	return v, nil
+}
@@ -78,10 +78,106 @@ type Client struct {
 	// list_survey_responses endpoint.
 	ListSurveyResponsesDoer goahttp.Doer
 
+	// CreateSurveyResponse Doer is the HTTP client used to make requests to the
+	// create_survey_response endpoint.
+	CreateSurveyResponseDoer goahttp.Doer
+
+	// UpdateSurveyResponse Doer is the HTTP client used to make requests to the
+	// update_survey_response endpoint.
+	UpdateSurveyResponseDoer goahttp.Doer
+
+	// ListSurveyInvites Doer is the HTTP client used to make requests to the
+	// list_survey_invites endpoint.
+	ListSurveyInvitesDoer goahttp.Doer
+
+	// GetSurveyInviteSettings Doer is the HTTP client used to make requests to the
+	// get_survey_invite_settings endpoint.
+	GetSurveyInviteSettingsDoer goahttp.Doer
+
+	// UpdateSurveyInviteSettings Doer is the HTTP client used to make requests to
+	// the update_survey_invite_settings endpoint.
+	UpdateSurveyInviteSettingsDoer goahttp.Doer
+
+	// DeleteSurveyInviteSettings Doer is the HTTP client used to make requests to
+	// the delete_survey_invite_settings endpoint.
+	DeleteSurveyInviteSettingsDoer goahttp.Doer
+
+	// GetProjectInviteSettings Doer is the HTTP client used to make requests to
+	// the get_project_invite_settings endpoint.
+	GetProjectInviteSettingsDoer goahttp.Doer
+
+	// UpdateProjectInviteSettings Doer is the HTTP client used to make requests to
+	// the update_project_invite_settings endpoint.
+	UpdateProjectInviteSettingsDoer goahttp.Doer
+
+	// DeleteProjectInviteSettings Doer is the HTTP client used to make requests to
+	// the delete_project_invite_settings endpoint.
+	DeleteProjectInviteSettingsDoer goahttp.Doer
+
+	// GetSurveySettings Doer is the HTTP client used to make requests to the
+	// get_survey_settings endpoint.
+	GetSurveySettingsDoer goahttp.Doer
+
+	// UpdateSurveySettings Doer is the HTTP client used to make requests to the
+	// update_survey_settings endpoint.
+	UpdateSurveySettingsDoer goahttp.Doer
+
+	// GetSurveyResults Doer is the HTTP client used to make requests to the
+	// get_survey_results endpoint.
+	GetSurveyResultsDoer goahttp.Doer
+
 	// ValidateEmail Doer is the HTTP client used to make requests to the
 	// validate_email endpoint.
 	ValidateEmailDoer goahttp.Doer
 
+	// ListSurveyTemplates Doer is the HTTP client used to make requests to the
+	// list_survey_templates endpoint.
+	ListSurveyTemplatesDoer goahttp.Doer
+
+	// GetSurveyTemplate Doer is the HTTP client used to make requests to the
+	// get_survey_template endpoint.
+	GetSurveyTemplateDoer goahttp.Doer
+
+	// ListDeadLetters Doer is the HTTP client used to make requests to the
+	// list_dead_letters endpoint.
+	ListDeadLettersDoer goahttp.Doer
+
+	// GetDeadLetter Doer is the HTTP client used to make requests to the
+	// get_dead_letter endpoint.
+	GetDeadLetterDoer goahttp.Doer
+
+	// ReplayDeadLetter Doer is the HTTP client used to make requests to the
+	// replay_dead_letter endpoint.
+	ReplayDeadLetterDoer goahttp.Doer
+
+	// StartReindex Doer is the HTTP client used to make requests to the
+	// start_reindex endpoint.
+	StartReindexDoer goahttp.Doer
+
+	// GetReindex Doer is the HTTP client used to make requests to the get_reindex
+	// endpoint.
+	GetReindexDoer goahttp.Doer
+
+	// CancelReindex Doer is the HTTP client used to make requests to the
+	// cancel_reindex endpoint.
+	CancelReindexDoer goahttp.Doer
+
+	// RerunInviteEnrichment Doer is the HTTP client used to make requests to the
+	// rerun_invite_enrichment endpoint.
+	RerunInviteEnrichmentDoer goahttp.Doer
+
+	// StartInviteBackfill Doer is the HTTP client used to make requests to the
+	// start_invite_backfill endpoint.
+	StartInviteBackfillDoer goahttp.Doer
+
+	// GetInviteBackfill Doer is the HTTP client used to make requests to the
+	// get_invite_backfill endpoint.
+	GetInviteBackfillDoer goahttp.Doer
+
+	// CancelInviteBackfill Doer is the HTTP client used to make requests to the
+	// cancel_invite_backfill endpoint.
+	CancelInviteBackfillDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -102,27 +198,51 @@ func NewClient(
 	restoreBody bool,
 ) *Client {
 	return &Client{
-		ScheduleSurveyDoer:        doer,
-		GetSurveyDoer:             doer,
-		UpdateSurveyDoer:          doer,
-		DeleteSurveyDoer:          doer,
-		BulkResendSurveyDoer:      doer,
-		PreviewSendSurveyDoer:     doer,
-		SendMissingRecipientsDoer: doer,
-		DeleteSurveyResponseDoer:  doer,
-		ResendSurveyResponseDoer:  doer,
-		DeleteRecipientGroupDoer:  doer,
-		CreateExclusionDoer:       doer,
-		DeleteExclusionDoer:       doer,
-		GetExclusionDoer:          doer,
-		DeleteExclusionByIDDoer:   doer,
-		ListSurveyResponsesDoer:   doer,
-		ValidateEmailDoer:         doer,
-		RestoreResponseBody:       restoreBody,
-		scheme:                    scheme,
-		host:                      host,
-		decoder:                   dec,
-		encoder:                   enc,
+		ScheduleSurveyDoer:              doer,
+		GetSurveyDoer:                   doer,
+		UpdateSurveyDoer:                doer,
+		DeleteSurveyDoer:                doer,
+		BulkResendSurveyDoer:            doer,
+		PreviewSendSurveyDoer:           doer,
+		SendMissingRecipientsDoer:       doer,
+		DeleteSurveyResponseDoer:        doer,
+		ResendSurveyResponseDoer:        doer,
+		DeleteRecipientGroupDoer:        doer,
+		CreateExclusionDoer:             doer,
+		DeleteExclusionDoer:             doer,
+		GetExclusionDoer:                doer,
+		DeleteExclusionByIDDoer:         doer,
+		ListSurveyResponsesDoer:         doer,
+		CreateSurveyResponseDoer:        doer,
+		UpdateSurveyResponseDoer:        doer,
+		ListSurveyInvitesDoer:           doer,
+		GetSurveyInviteSettingsDoer:     doer,
+		UpdateSurveyInviteSettingsDoer:  doer,
+		DeleteSurveyInviteSettingsDoer:  doer,
+		GetProjectInviteSettingsDoer:    doer,
+		UpdateProjectInviteSettingsDoer: doer,
+		DeleteProjectInviteSettingsDoer: doer,
+		GetSurveySettingsDoer:           doer,
+		UpdateSurveySettingsDoer:        doer,
+		GetSurveyResultsDoer:            doer,
+		ValidateEmailDoer:               doer,
+		ListSurveyTemplatesDoer:         doer,
+		GetSurveyTemplateDoer:           doer,
+		ListDeadLettersDoer:             doer,
+		GetDeadLetterDoer:               doer,
+		ReplayDeadLetterDoer:            doer,
+		StartReindexDoer:                doer,
+		GetReindexDoer:                  doer,
+		CancelReindexDoer:               doer,
+		RerunInviteEnrichmentDoer:       doer,
+		StartInviteBackfillDoer:         doer,
+		GetInviteBackfillDoer:           doer,
+		CancelInviteBackfillDoer:        doer,
+		RestoreResponseBody:             restoreBody,
+		scheme:                          scheme,
+		host:                            host,
+		decoder:                         dec,
+		encoder:                         enc,
 	}
 }
 
@@ -486,6 +606,294 @@ func (c *Client) ListSurveyResponses() goa.Endpoint {
 	}
 }
 
+// CreateSurveyResponse returns an endpoint that makes HTTP requests to the
+// survey service create_survey_response server.
+func (c *Client) CreateSurveyResponse() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateSurveyResponseRequest(c.encoder)
+		decodeResponse = DecodeCreateSurveyResponseResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateSurveyResponseRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateSurveyResponseDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("survey", "create_survey_response", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateSurveyResponse returns an endpoint that makes HTTP requests to the
+// survey service update_survey_response server.
+func (c *Client) UpdateSurveyResponse() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateSurveyResponseRequest(c.encoder)
+		decodeResponse = DecodeUpdateSurveyResponseResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateSurveyResponseRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateSurveyResponseDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("survey", "update_survey_response", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListSurveyInvites returns an endpoint that makes HTTP requests to the survey
+// service list_survey_invites server.
+func (c *Client) ListSurveyInvites() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListSurveyInvitesRequest(c.encoder)
+		decodeResponse = DecodeListSurveyInvitesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListSurveyInvitesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListSurveyInvitesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("survey", "list_survey_invites", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetSurveyInviteSettings returns an endpoint that makes HTTP requests to the
+// survey service get_survey_invite_settings server.
+func (c *Client) GetSurveyInviteSettings() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetSurveyInviteSettingsRequest(c.encoder)
+		decodeResponse = DecodeGetSurveyInviteSettingsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetSurveyInviteSettingsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetSurveyInviteSettingsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("survey", "get_survey_invite_settings", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateSurveyInviteSettings returns an endpoint that makes HTTP requests to
+// the survey service update_survey_invite_settings server.
+func (c *Client) UpdateSurveyInviteSettings() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateSurveyInviteSettingsRequest(c.encoder)
+		decodeResponse = DecodeUpdateSurveyInviteSettingsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateSurveyInviteSettingsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateSurveyInviteSettingsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("survey", "update_survey_invite_settings", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteSurveyInviteSettings returns an endpoint that makes HTTP requests to
+// the survey service delete_survey_invite_settings server.
+func (c *Client) DeleteSurveyInviteSettings() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteSurveyInviteSettingsRequest(c.encoder)
+		decodeResponse = DecodeDeleteSurveyInviteSettingsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteSurveyInviteSettingsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteSurveyInviteSettingsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("survey", "delete_survey_invite_settings", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetProjectInviteSettings returns an endpoint that makes HTTP requests to the
+// survey service get_project_invite_settings server.
+func (c *Client) GetProjectInviteSettings() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetProjectInviteSettingsRequest(c.encoder)
+		decodeResponse = DecodeGetProjectInviteSettingsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetProjectInviteSettingsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetProjectInviteSettingsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("survey", "get_project_invite_settings", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateProjectInviteSettings returns an endpoint that makes HTTP requests to
+// the survey service update_project_invite_settings server.
+func (c *Client) UpdateProjectInviteSettings() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateProjectInviteSettingsRequest(c.encoder)
+		decodeResponse = DecodeUpdateProjectInviteSettingsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateProjectInviteSettingsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateProjectInviteSettingsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("survey", "update_project_invite_settings", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteProjectInviteSettings returns an endpoint that makes HTTP requests to
+// the survey service delete_project_invite_settings server.
+func (c *Client) DeleteProjectInviteSettings() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteProjectInviteSettingsRequest(c.encoder)
+		decodeResponse = DecodeDeleteProjectInviteSettingsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteProjectInviteSettingsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteProjectInviteSettingsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("survey", "delete_project_invite_settings", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetSurveySettings returns an endpoint that makes HTTP requests to the survey
+// service get_survey_settings server.
+func (c *Client) GetSurveySettings() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetSurveySettingsRequest(c.encoder)
+		decodeResponse = DecodeGetSurveySettingsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetSurveySettingsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetSurveySettingsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("survey", "get_survey_settings", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateSurveySettings returns an endpoint that makes HTTP requests to the
+// survey service update_survey_settings server.
+func (c *Client) UpdateSurveySettings() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateSurveySettingsRequest(c.encoder)
+		decodeResponse = DecodeUpdateSurveySettingsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateSurveySettingsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateSurveySettingsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("survey", "update_survey_settings", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetSurveyResults returns an endpoint that makes HTTP requests to the survey
+// service get_survey_results server.
+func (c *Client) GetSurveyResults() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetSurveyResultsRequest(c.encoder)
+		decodeResponse = DecodeGetSurveyResultsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetSurveyResultsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetSurveyResultsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("survey", "get_survey_results", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // ValidateEmail returns an endpoint that makes HTTP requests to the survey
 // service validate_email server.
 func (c *Client) ValidateEmail() goa.Endpoint {
@@ -509,3 +917,291 @@ func (c *Client) ValidateEmail() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// ListSurveyTemplates returns an endpoint that makes HTTP requests to the
+// survey service list_survey_templates server.
+func (c *Client) ListSurveyTemplates() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListSurveyTemplatesRequest(c.encoder)
+		decodeResponse = DecodeListSurveyTemplatesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListSurveyTemplatesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListSurveyTemplatesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("survey", "list_survey_templates", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetSurveyTemplate returns an endpoint that makes HTTP requests to the survey
+// service get_survey_template server.
+func (c *Client) GetSurveyTemplate() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetSurveyTemplateRequest(c.encoder)
+		decodeResponse = DecodeGetSurveyTemplateResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetSurveyTemplateRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetSurveyTemplateDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("survey", "get_survey_template", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListDeadLetters returns an endpoint that makes HTTP requests to the survey
+// service list_dead_letters server.
+func (c *Client) ListDeadLetters() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListDeadLettersRequest(c.encoder)
+		decodeResponse = DecodeListDeadLettersResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListDeadLettersRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListDeadLettersDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("survey", "list_dead_letters", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetDeadLetter returns an endpoint that makes HTTP requests to the survey
+// service get_dead_letter server.
+func (c *Client) GetDeadLetter() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetDeadLetterRequest(c.encoder)
+		decodeResponse = DecodeGetDeadLetterResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetDeadLetterRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetDeadLetterDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("survey", "get_dead_letter", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ReplayDeadLetter returns an endpoint that makes HTTP requests to the survey
+// service replay_dead_letter server.
+func (c *Client) ReplayDeadLetter() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeReplayDeadLetterRequest(c.encoder)
+		decodeResponse = DecodeReplayDeadLetterResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildReplayDeadLetterRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ReplayDeadLetterDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("survey", "replay_dead_letter", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// StartReindex returns an endpoint that makes HTTP requests to the survey
+// service start_reindex server.
+func (c *Client) StartReindex() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeStartReindexRequest(c.encoder)
+		decodeResponse = DecodeStartReindexResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildStartReindexRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.StartReindexDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("survey", "start_reindex", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetReindex returns an endpoint that makes HTTP requests to the survey
+// service get_reindex server.
+func (c *Client) GetReindex() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetReindexRequest(c.encoder)
+		decodeResponse = DecodeGetReindexResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetReindexRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetReindexDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("survey", "get_reindex", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CancelReindex returns an endpoint that makes HTTP requests to the survey
+// service cancel_reindex server.
+func (c *Client) CancelReindex() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCancelReindexRequest(c.encoder)
+		decodeResponse = DecodeCancelReindexResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCancelReindexRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CancelReindexDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("survey", "cancel_reindex", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RerunInviteEnrichment returns an endpoint that makes HTTP requests to the
+// survey service rerun_invite_enrichment server.
+func (c *Client) RerunInviteEnrichment() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRerunInviteEnrichmentRequest(c.encoder)
+		decodeResponse = DecodeRerunInviteEnrichmentResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRerunInviteEnrichmentRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RerunInviteEnrichmentDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("survey", "rerun_invite_enrichment", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// StartInviteBackfill returns an endpoint that makes HTTP requests to the
+// survey service start_invite_backfill server.
+func (c *Client) StartInviteBackfill() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeStartInviteBackfillRequest(c.encoder)
+		decodeResponse = DecodeStartInviteBackfillResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildStartInviteBackfillRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.StartInviteBackfillDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("survey", "start_invite_backfill", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetInviteBackfill returns an endpoint that makes HTTP requests to the survey
+// service get_invite_backfill server.
+func (c *Client) GetInviteBackfill() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetInviteBackfillRequest(c.encoder)
+		decodeResponse = DecodeGetInviteBackfillResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetInviteBackfillRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetInviteBackfillDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("survey", "get_invite_backfill", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CancelInviteBackfill returns an endpoint that makes HTTP requests to the
+// survey service cancel_invite_backfill server.
+func (c *Client) CancelInviteBackfill() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCancelInviteBackfillRequest(c.encoder)
+		decodeResponse = DecodeCancelInviteBackfillResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCancelInviteBackfillRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CancelInviteBackfillDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("survey", "cancel_invite_backfill", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -11,6 +11,7 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -2494,13 +2495,3939 @@ func DecodeListSurveyResponsesResponse(decoder func(*http.Response) goahttp.Deco
 	}
 }
 
+// BuildCreateSurveyResponseRequest instantiates a HTTP request object with
+// method and path set to call the "survey" service "create_survey_response"
+// endpoint
+func (c *Client) BuildCreateSurveyResponseRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		surveyUID string
+	)
+	{
+		p, ok := v.(*survey.CreateSurveyResponsePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("survey", "create_survey_response", "*survey.CreateSurveyResponsePayload", v)
+		}
+		surveyUID = p.SurveyUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateSurveyResponseSurveyPath(surveyUID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("survey", "create_survey_response", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateSurveyResponseRequest returns an encoder for requests sent to
+// the survey create_survey_response server.
+func EncodeCreateSurveyResponseRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*survey.CreateSurveyResponsePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("survey", "create_survey_response", "*survey.CreateSurveyResponsePayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewCreateSurveyResponseRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("survey", "create_survey_response", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateSurveyResponseResponse returns a decoder for responses returned
+// by the survey create_survey_response endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeCreateSurveyResponseResponse may return the following errors:
+//   - "BadRequest" (type *survey.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *survey.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *survey.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *survey.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *survey.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *survey.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeCreateSurveyResponseResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body CreateSurveyResponseBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "create_survey_response", err)
+			}
+			err = ValidateCreateSurveyResponseBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "create_survey_response", err)
+			}
+			return nil, NewCreateSurveyResponseBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body CreateSurveyResponseForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "create_survey_response", err)
+			}
+			err = ValidateCreateSurveyResponseForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "create_survey_response", err)
+			}
+			return nil, NewCreateSurveyResponseForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CreateSurveyResponseInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "create_survey_response", err)
+			}
+			err = ValidateCreateSurveyResponseInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "create_survey_response", err)
+			}
+			return nil, NewCreateSurveyResponseInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body CreateSurveyResponseNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "create_survey_response", err)
+			}
+			err = ValidateCreateSurveyResponseNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "create_survey_response", err)
+			}
+			return nil, NewCreateSurveyResponseNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body CreateSurveyResponseServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "create_survey_response", err)
+			}
+			err = ValidateCreateSurveyResponseServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "create_survey_response", err)
+			}
+			return nil, NewCreateSurveyResponseServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body CreateSurveyResponseUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "create_survey_response", err)
+			}
+			err = ValidateCreateSurveyResponseUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "create_survey_response", err)
+			}
+			return nil, NewCreateSurveyResponseUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("survey", "create_survey_response", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateSurveyResponseRequest instantiates a HTTP request object with
+// method and path set to call the "survey" service "update_survey_response"
+// endpoint
+func (c *Client) BuildUpdateSurveyResponseRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		surveyUID  string
+		responseID string
+	)
+	{
+		p, ok := v.(*survey.UpdateSurveyResponsePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("survey", "update_survey_response", "*survey.UpdateSurveyResponsePayload", v)
+		}
+		surveyUID = p.SurveyUID
+		responseID = p.ResponseID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateSurveyResponseSurveyPath(surveyUID, responseID)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("survey", "update_survey_response", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateSurveyResponseRequest returns an encoder for requests sent to
+// the survey update_survey_response server.
+func EncodeUpdateSurveyResponseRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*survey.UpdateSurveyResponsePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("survey", "update_survey_response", "*survey.UpdateSurveyResponsePayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewUpdateSurveyResponseRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("survey", "update_survey_response", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateSurveyResponseResponse returns a decoder for responses returned
+// by the survey update_survey_response endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeUpdateSurveyResponseResponse may return the following errors:
+//   - "BadRequest" (type *survey.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *survey.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *survey.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *survey.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *survey.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *survey.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeUpdateSurveyResponseResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body UpdateSurveyResponseBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_survey_response", err)
+			}
+			err = ValidateUpdateSurveyResponseBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_survey_response", err)
+			}
+			return nil, NewUpdateSurveyResponseBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body UpdateSurveyResponseForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_survey_response", err)
+			}
+			err = ValidateUpdateSurveyResponseForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_survey_response", err)
+			}
+			return nil, NewUpdateSurveyResponseForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UpdateSurveyResponseInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_survey_response", err)
+			}
+			err = ValidateUpdateSurveyResponseInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_survey_response", err)
+			}
+			return nil, NewUpdateSurveyResponseInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body UpdateSurveyResponseNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_survey_response", err)
+			}
+			err = ValidateUpdateSurveyResponseNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_survey_response", err)
+			}
+			return nil, NewUpdateSurveyResponseNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body UpdateSurveyResponseServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_survey_response", err)
+			}
+			err = ValidateUpdateSurveyResponseServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_survey_response", err)
+			}
+			return nil, NewUpdateSurveyResponseServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body UpdateSurveyResponseUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_survey_response", err)
+			}
+			err = ValidateUpdateSurveyResponseUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_survey_response", err)
+			}
+			return nil, NewUpdateSurveyResponseUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("survey", "update_survey_response", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListSurveyInvitesRequest instantiates a HTTP request object with method
+// and path set to call the "survey" service "list_survey_invites" endpoint
+func (c *Client) BuildListSurveyInvitesRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		surveyUID string
+	)
+	{
+		p, ok := v.(*survey.ListSurveyInvitesPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("survey", "list_survey_invites", "*survey.ListSurveyInvitesPayload", v)
+		}
+		surveyUID = p.SurveyUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListSurveyInvitesSurveyPath(surveyUID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("survey", "list_survey_invites", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListSurveyInvitesRequest returns an encoder for requests sent to the
+// survey list_survey_invites server.
+func EncodeListSurveyInvitesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*survey.ListSurveyInvitesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("survey", "list_survey_invites", "*survey.ListSurveyInvitesPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Status != nil {
+			values.Add("status", *p.Status)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListSurveyInvitesResponse returns a decoder for responses returned by
+// the survey list_survey_invites endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeListSurveyInvitesResponse may return the following errors:
+//   - "BadRequest" (type *survey.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *survey.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *survey.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *survey.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *survey.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *survey.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListSurveyInvitesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListSurveyInvitesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "list_survey_invites", err)
+			}
+			err = ValidateListSurveyInvitesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "list_survey_invites", err)
+			}
+			res := NewListSurveyInvitesSurveyInviteListOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListSurveyInvitesBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "list_survey_invites", err)
+			}
+			err = ValidateListSurveyInvitesBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "list_survey_invites", err)
+			}
+			return nil, NewListSurveyInvitesBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body ListSurveyInvitesForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "list_survey_invites", err)
+			}
+			err = ValidateListSurveyInvitesForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "list_survey_invites", err)
+			}
+			return nil, NewListSurveyInvitesForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListSurveyInvitesInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "list_survey_invites", err)
+			}
+			err = ValidateListSurveyInvitesInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "list_survey_invites", err)
+			}
+			return nil, NewListSurveyInvitesInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ListSurveyInvitesNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "list_survey_invites", err)
+			}
+			err = ValidateListSurveyInvitesNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "list_survey_invites", err)
+			}
+			return nil, NewListSurveyInvitesNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ListSurveyInvitesServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "list_survey_invites", err)
+			}
+			err = ValidateListSurveyInvitesServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "list_survey_invites", err)
+			}
+			return nil, NewListSurveyInvitesServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListSurveyInvitesUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "list_survey_invites", err)
+			}
+			err = ValidateListSurveyInvitesUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "list_survey_invites", err)
+			}
+			return nil, NewListSurveyInvitesUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("survey", "list_survey_invites", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetSurveyInviteSettingsRequest instantiates a HTTP request object with
+// method and path set to call the "survey" service
+// "get_survey_invite_settings" endpoint
+func (c *Client) BuildGetSurveyInviteSettingsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		surveyUID string
+	)
+	{
+		p, ok := v.(*survey.GetSurveyInviteSettingsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("survey", "get_survey_invite_settings", "*survey.GetSurveyInviteSettingsPayload", v)
+		}
+		surveyUID = p.SurveyUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetSurveyInviteSettingsSurveyPath(surveyUID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("survey", "get_survey_invite_settings", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetSurveyInviteSettingsRequest returns an encoder for requests sent to
+// the survey get_survey_invite_settings server.
+func EncodeGetSurveyInviteSettingsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*survey.GetSurveyInviteSettingsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("survey", "get_survey_invite_settings", "*survey.GetSurveyInviteSettingsPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetSurveyInviteSettingsResponse returns a decoder for responses
+// returned by the survey get_survey_invite_settings endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeGetSurveyInviteSettingsResponse may return the following errors:
+//   - "BadRequest" (type *survey.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *survey.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *survey.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *survey.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *survey.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *survey.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetSurveyInviteSettingsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetSurveyInviteSettingsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_invite_settings", err)
+			}
+			err = ValidateGetSurveyInviteSettingsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_invite_settings", err)
+			}
+			res := NewGetSurveyInviteSettingsInviteSettingsOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetSurveyInviteSettingsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_invite_settings", err)
+			}
+			err = ValidateGetSurveyInviteSettingsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_invite_settings", err)
+			}
+			return nil, NewGetSurveyInviteSettingsBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body GetSurveyInviteSettingsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_invite_settings", err)
+			}
+			err = ValidateGetSurveyInviteSettingsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_invite_settings", err)
+			}
+			return nil, NewGetSurveyInviteSettingsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetSurveyInviteSettingsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_invite_settings", err)
+			}
+			err = ValidateGetSurveyInviteSettingsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_invite_settings", err)
+			}
+			return nil, NewGetSurveyInviteSettingsInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetSurveyInviteSettingsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_invite_settings", err)
+			}
+			err = ValidateGetSurveyInviteSettingsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_invite_settings", err)
+			}
+			return nil, NewGetSurveyInviteSettingsNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetSurveyInviteSettingsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_invite_settings", err)
+			}
+			err = ValidateGetSurveyInviteSettingsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_invite_settings", err)
+			}
+			return nil, NewGetSurveyInviteSettingsServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body GetSurveyInviteSettingsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_invite_settings", err)
+			}
+			err = ValidateGetSurveyInviteSettingsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_invite_settings", err)
+			}
+			return nil, NewGetSurveyInviteSettingsUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("survey", "get_survey_invite_settings", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateSurveyInviteSettingsRequest instantiates a HTTP request object
+// with method and path set to call the "survey" service
+// "update_survey_invite_settings" endpoint
+func (c *Client) BuildUpdateSurveyInviteSettingsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		surveyUID string
+	)
+	{
+		p, ok := v.(*survey.UpdateSurveyInviteSettingsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("survey", "update_survey_invite_settings", "*survey.UpdateSurveyInviteSettingsPayload", v)
+		}
+		surveyUID = p.SurveyUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateSurveyInviteSettingsSurveyPath(surveyUID)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("survey", "update_survey_invite_settings", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateSurveyInviteSettingsRequest returns an encoder for requests sent
+// to the survey update_survey_invite_settings server.
+func EncodeUpdateSurveyInviteSettingsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*survey.UpdateSurveyInviteSettingsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("survey", "update_survey_invite_settings", "*survey.UpdateSurveyInviteSettingsPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewUpdateSurveyInviteSettingsRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("survey", "update_survey_invite_settings", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateSurveyInviteSettingsResponse returns a decoder for responses
+// returned by the survey update_survey_invite_settings endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeUpdateSurveyInviteSettingsResponse may return the following errors:
+//   - "BadRequest" (type *survey.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *survey.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *survey.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *survey.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *survey.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeUpdateSurveyInviteSettingsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateSurveyInviteSettingsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_survey_invite_settings", err)
+			}
+			err = ValidateUpdateSurveyInviteSettingsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_survey_invite_settings", err)
+			}
+			res := NewUpdateSurveyInviteSettingsInviteSettingsOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body UpdateSurveyInviteSettingsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_survey_invite_settings", err)
+			}
+			err = ValidateUpdateSurveyInviteSettingsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_survey_invite_settings", err)
+			}
+			return nil, NewUpdateSurveyInviteSettingsBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body UpdateSurveyInviteSettingsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_survey_invite_settings", err)
+			}
+			err = ValidateUpdateSurveyInviteSettingsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_survey_invite_settings", err)
+			}
+			return nil, NewUpdateSurveyInviteSettingsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UpdateSurveyInviteSettingsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_survey_invite_settings", err)
+			}
+			err = ValidateUpdateSurveyInviteSettingsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_survey_invite_settings", err)
+			}
+			return nil, NewUpdateSurveyInviteSettingsInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body UpdateSurveyInviteSettingsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_survey_invite_settings", err)
+			}
+			err = ValidateUpdateSurveyInviteSettingsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_survey_invite_settings", err)
+			}
+			return nil, NewUpdateSurveyInviteSettingsServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body UpdateSurveyInviteSettingsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_survey_invite_settings", err)
+			}
+			err = ValidateUpdateSurveyInviteSettingsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_survey_invite_settings", err)
+			}
+			return nil, NewUpdateSurveyInviteSettingsUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("survey", "update_survey_invite_settings", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteSurveyInviteSettingsRequest instantiates a HTTP request object
+// with method and path set to call the "survey" service
+// "delete_survey_invite_settings" endpoint
+func (c *Client) BuildDeleteSurveyInviteSettingsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		surveyUID string
+	)
+	{
+		p, ok := v.(*survey.DeleteSurveyInviteSettingsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("survey", "delete_survey_invite_settings", "*survey.DeleteSurveyInviteSettingsPayload", v)
+		}
+		surveyUID = p.SurveyUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteSurveyInviteSettingsSurveyPath(surveyUID)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("survey", "delete_survey_invite_settings", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteSurveyInviteSettingsRequest returns an encoder for requests sent
+// to the survey delete_survey_invite_settings server.
+func EncodeDeleteSurveyInviteSettingsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*survey.DeleteSurveyInviteSettingsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("survey", "delete_survey_invite_settings", "*survey.DeleteSurveyInviteSettingsPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteSurveyInviteSettingsResponse returns a decoder for responses
+// returned by the survey delete_survey_invite_settings endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeDeleteSurveyInviteSettingsResponse may return the following errors:
+//   - "BadRequest" (type *survey.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *survey.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *survey.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *survey.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *survey.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *survey.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDeleteSurveyInviteSettingsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body DeleteSurveyInviteSettingsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "delete_survey_invite_settings", err)
+			}
+			err = ValidateDeleteSurveyInviteSettingsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "delete_survey_invite_settings", err)
+			}
+			return nil, NewDeleteSurveyInviteSettingsBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body DeleteSurveyInviteSettingsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "delete_survey_invite_settings", err)
+			}
+			err = ValidateDeleteSurveyInviteSettingsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "delete_survey_invite_settings", err)
+			}
+			return nil, NewDeleteSurveyInviteSettingsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DeleteSurveyInviteSettingsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "delete_survey_invite_settings", err)
+			}
+			err = ValidateDeleteSurveyInviteSettingsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "delete_survey_invite_settings", err)
+			}
+			return nil, NewDeleteSurveyInviteSettingsInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body DeleteSurveyInviteSettingsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "delete_survey_invite_settings", err)
+			}
+			err = ValidateDeleteSurveyInviteSettingsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "delete_survey_invite_settings", err)
+			}
+			return nil, NewDeleteSurveyInviteSettingsNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body DeleteSurveyInviteSettingsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "delete_survey_invite_settings", err)
+			}
+			err = ValidateDeleteSurveyInviteSettingsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "delete_survey_invite_settings", err)
+			}
+			return nil, NewDeleteSurveyInviteSettingsServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body DeleteSurveyInviteSettingsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "delete_survey_invite_settings", err)
+			}
+			err = ValidateDeleteSurveyInviteSettingsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "delete_survey_invite_settings", err)
+			}
+			return nil, NewDeleteSurveyInviteSettingsUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("survey", "delete_survey_invite_settings", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetProjectInviteSettingsRequest instantiates a HTTP request object with
+// method and path set to call the "survey" service
+// "get_project_invite_settings" endpoint
+func (c *Client) BuildGetProjectInviteSettingsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		projectUID string
+	)
+	{
+		p, ok := v.(*survey.GetProjectInviteSettingsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("survey", "get_project_invite_settings", "*survey.GetProjectInviteSettingsPayload", v)
+		}
+		projectUID = p.ProjectUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetProjectInviteSettingsSurveyPath(projectUID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("survey", "get_project_invite_settings", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetProjectInviteSettingsRequest returns an encoder for requests sent
+// to the survey get_project_invite_settings server.
+func EncodeGetProjectInviteSettingsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*survey.GetProjectInviteSettingsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("survey", "get_project_invite_settings", "*survey.GetProjectInviteSettingsPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetProjectInviteSettingsResponse returns a decoder for responses
+// returned by the survey get_project_invite_settings endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeGetProjectInviteSettingsResponse may return the following errors:
+//   - "BadRequest" (type *survey.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *survey.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *survey.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *survey.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *survey.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *survey.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetProjectInviteSettingsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetProjectInviteSettingsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_project_invite_settings", err)
+			}
+			err = ValidateGetProjectInviteSettingsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_project_invite_settings", err)
+			}
+			res := NewGetProjectInviteSettingsInviteSettingsOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetProjectInviteSettingsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_project_invite_settings", err)
+			}
+			err = ValidateGetProjectInviteSettingsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_project_invite_settings", err)
+			}
+			return nil, NewGetProjectInviteSettingsBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body GetProjectInviteSettingsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_project_invite_settings", err)
+			}
+			err = ValidateGetProjectInviteSettingsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_project_invite_settings", err)
+			}
+			return nil, NewGetProjectInviteSettingsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetProjectInviteSettingsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_project_invite_settings", err)
+			}
+			err = ValidateGetProjectInviteSettingsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_project_invite_settings", err)
+			}
+			return nil, NewGetProjectInviteSettingsInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetProjectInviteSettingsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_project_invite_settings", err)
+			}
+			err = ValidateGetProjectInviteSettingsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_project_invite_settings", err)
+			}
+			return nil, NewGetProjectInviteSettingsNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetProjectInviteSettingsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_project_invite_settings", err)
+			}
+			err = ValidateGetProjectInviteSettingsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_project_invite_settings", err)
+			}
+			return nil, NewGetProjectInviteSettingsServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body GetProjectInviteSettingsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_project_invite_settings", err)
+			}
+			err = ValidateGetProjectInviteSettingsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_project_invite_settings", err)
+			}
+			return nil, NewGetProjectInviteSettingsUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("survey", "get_project_invite_settings", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateProjectInviteSettingsRequest instantiates a HTTP request object
+// with method and path set to call the "survey" service
+// "update_project_invite_settings" endpoint
+func (c *Client) BuildUpdateProjectInviteSettingsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		projectUID string
+	)
+	{
+		p, ok := v.(*survey.UpdateProjectInviteSettingsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("survey", "update_project_invite_settings", "*survey.UpdateProjectInviteSettingsPayload", v)
+		}
+		projectUID = p.ProjectUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateProjectInviteSettingsSurveyPath(projectUID)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("survey", "update_project_invite_settings", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateProjectInviteSettingsRequest returns an encoder for requests
+// sent to the survey update_project_invite_settings server.
+func EncodeUpdateProjectInviteSettingsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*survey.UpdateProjectInviteSettingsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("survey", "update_project_invite_settings", "*survey.UpdateProjectInviteSettingsPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewUpdateProjectInviteSettingsRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("survey", "update_project_invite_settings", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateProjectInviteSettingsResponse returns a decoder for responses
+// returned by the survey update_project_invite_settings endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeUpdateProjectInviteSettingsResponse may return the following errors:
+//   - "BadRequest" (type *survey.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *survey.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *survey.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *survey.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *survey.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeUpdateProjectInviteSettingsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateProjectInviteSettingsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_project_invite_settings", err)
+			}
+			err = ValidateUpdateProjectInviteSettingsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_project_invite_settings", err)
+			}
+			res := NewUpdateProjectInviteSettingsInviteSettingsOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body UpdateProjectInviteSettingsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_project_invite_settings", err)
+			}
+			err = ValidateUpdateProjectInviteSettingsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_project_invite_settings", err)
+			}
+			return nil, NewUpdateProjectInviteSettingsBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body UpdateProjectInviteSettingsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_project_invite_settings", err)
+			}
+			err = ValidateUpdateProjectInviteSettingsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_project_invite_settings", err)
+			}
+			return nil, NewUpdateProjectInviteSettingsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UpdateProjectInviteSettingsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_project_invite_settings", err)
+			}
+			err = ValidateUpdateProjectInviteSettingsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_project_invite_settings", err)
+			}
+			return nil, NewUpdateProjectInviteSettingsInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body UpdateProjectInviteSettingsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_project_invite_settings", err)
+			}
+			err = ValidateUpdateProjectInviteSettingsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_project_invite_settings", err)
+			}
+			return nil, NewUpdateProjectInviteSettingsServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body UpdateProjectInviteSettingsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_project_invite_settings", err)
+			}
+			err = ValidateUpdateProjectInviteSettingsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_project_invite_settings", err)
+			}
+			return nil, NewUpdateProjectInviteSettingsUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("survey", "update_project_invite_settings", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteProjectInviteSettingsRequest instantiates a HTTP request object
+// with method and path set to call the "survey" service
+// "delete_project_invite_settings" endpoint
+func (c *Client) BuildDeleteProjectInviteSettingsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		projectUID string
+	)
+	{
+		p, ok := v.(*survey.DeleteProjectInviteSettingsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("survey", "delete_project_invite_settings", "*survey.DeleteProjectInviteSettingsPayload", v)
+		}
+		projectUID = p.ProjectUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteProjectInviteSettingsSurveyPath(projectUID)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("survey", "delete_project_invite_settings", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteProjectInviteSettingsRequest returns an encoder for requests
+// sent to the survey delete_project_invite_settings server.
+func EncodeDeleteProjectInviteSettingsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*survey.DeleteProjectInviteSettingsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("survey", "delete_project_invite_settings", "*survey.DeleteProjectInviteSettingsPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteProjectInviteSettingsResponse returns a decoder for responses
+// returned by the survey delete_project_invite_settings endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeDeleteProjectInviteSettingsResponse may return the following errors:
+//   - "BadRequest" (type *survey.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *survey.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *survey.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *survey.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *survey.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *survey.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDeleteProjectInviteSettingsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body DeleteProjectInviteSettingsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "delete_project_invite_settings", err)
+			}
+			err = ValidateDeleteProjectInviteSettingsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "delete_project_invite_settings", err)
+			}
+			return nil, NewDeleteProjectInviteSettingsBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body DeleteProjectInviteSettingsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "delete_project_invite_settings", err)
+			}
+			err = ValidateDeleteProjectInviteSettingsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "delete_project_invite_settings", err)
+			}
+			return nil, NewDeleteProjectInviteSettingsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DeleteProjectInviteSettingsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "delete_project_invite_settings", err)
+			}
+			err = ValidateDeleteProjectInviteSettingsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "delete_project_invite_settings", err)
+			}
+			return nil, NewDeleteProjectInviteSettingsInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body DeleteProjectInviteSettingsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "delete_project_invite_settings", err)
+			}
+			err = ValidateDeleteProjectInviteSettingsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "delete_project_invite_settings", err)
+			}
+			return nil, NewDeleteProjectInviteSettingsNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body DeleteProjectInviteSettingsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "delete_project_invite_settings", err)
+			}
+			err = ValidateDeleteProjectInviteSettingsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "delete_project_invite_settings", err)
+			}
+			return nil, NewDeleteProjectInviteSettingsServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body DeleteProjectInviteSettingsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "delete_project_invite_settings", err)
+			}
+			err = ValidateDeleteProjectInviteSettingsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "delete_project_invite_settings", err)
+			}
+			return nil, NewDeleteProjectInviteSettingsUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("survey", "delete_project_invite_settings", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetSurveySettingsRequest instantiates a HTTP request object with method
+// and path set to call the "survey" service "get_survey_settings" endpoint
+func (c *Client) BuildGetSurveySettingsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		surveyUID string
+	)
+	{
+		p, ok := v.(*survey.GetSurveySettingsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("survey", "get_survey_settings", "*survey.GetSurveySettingsPayload", v)
+		}
+		surveyUID = p.SurveyUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetSurveySettingsSurveyPath(surveyUID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("survey", "get_survey_settings", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetSurveySettingsRequest returns an encoder for requests sent to the
+// survey get_survey_settings server.
+func EncodeGetSurveySettingsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*survey.GetSurveySettingsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("survey", "get_survey_settings", "*survey.GetSurveySettingsPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetSurveySettingsResponse returns a decoder for responses returned by
+// the survey get_survey_settings endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetSurveySettingsResponse may return the following errors:
+//   - "BadRequest" (type *survey.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *survey.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *survey.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *survey.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *survey.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetSurveySettingsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetSurveySettingsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_settings", err)
+			}
+			err = ValidateGetSurveySettingsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_settings", err)
+			}
+			res := NewGetSurveySettingsSurveySettingsOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetSurveySettingsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_settings", err)
+			}
+			err = ValidateGetSurveySettingsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_settings", err)
+			}
+			return nil, NewGetSurveySettingsBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body GetSurveySettingsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_settings", err)
+			}
+			err = ValidateGetSurveySettingsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_settings", err)
+			}
+			return nil, NewGetSurveySettingsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetSurveySettingsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_settings", err)
+			}
+			err = ValidateGetSurveySettingsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_settings", err)
+			}
+			return nil, NewGetSurveySettingsInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetSurveySettingsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_settings", err)
+			}
+			err = ValidateGetSurveySettingsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_settings", err)
+			}
+			return nil, NewGetSurveySettingsServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body GetSurveySettingsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_settings", err)
+			}
+			err = ValidateGetSurveySettingsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_settings", err)
+			}
+			return nil, NewGetSurveySettingsUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("survey", "get_survey_settings", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateSurveySettingsRequest instantiates a HTTP request object with
+// method and path set to call the "survey" service "update_survey_settings"
+// endpoint
+func (c *Client) BuildUpdateSurveySettingsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		surveyUID string
+	)
+	{
+		p, ok := v.(*survey.UpdateSurveySettingsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("survey", "update_survey_settings", "*survey.UpdateSurveySettingsPayload", v)
+		}
+		surveyUID = p.SurveyUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateSurveySettingsSurveyPath(surveyUID)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("survey", "update_survey_settings", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateSurveySettingsRequest returns an encoder for requests sent to
+// the survey update_survey_settings server.
+func EncodeUpdateSurveySettingsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*survey.UpdateSurveySettingsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("survey", "update_survey_settings", "*survey.UpdateSurveySettingsPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewUpdateSurveySettingsRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("survey", "update_survey_settings", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateSurveySettingsResponse returns a decoder for responses returned
+// by the survey update_survey_settings endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeUpdateSurveySettingsResponse may return the following errors:
+//   - "BadRequest" (type *survey.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *survey.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *survey.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *survey.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *survey.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *survey.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *survey.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeUpdateSurveySettingsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateSurveySettingsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_survey_settings", err)
+			}
+			err = ValidateUpdateSurveySettingsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_survey_settings", err)
+			}
+			res := NewUpdateSurveySettingsSurveySettingsOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body UpdateSurveySettingsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_survey_settings", err)
+			}
+			err = ValidateUpdateSurveySettingsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_survey_settings", err)
+			}
+			return nil, NewUpdateSurveySettingsBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body UpdateSurveySettingsConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_survey_settings", err)
+			}
+			err = ValidateUpdateSurveySettingsConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_survey_settings", err)
+			}
+			return nil, NewUpdateSurveySettingsConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body UpdateSurveySettingsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_survey_settings", err)
+			}
+			err = ValidateUpdateSurveySettingsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_survey_settings", err)
+			}
+			return nil, NewUpdateSurveySettingsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UpdateSurveySettingsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_survey_settings", err)
+			}
+			err = ValidateUpdateSurveySettingsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_survey_settings", err)
+			}
+			return nil, NewUpdateSurveySettingsInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body UpdateSurveySettingsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_survey_settings", err)
+			}
+			err = ValidateUpdateSurveySettingsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_survey_settings", err)
+			}
+			return nil, NewUpdateSurveySettingsNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body UpdateSurveySettingsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_survey_settings", err)
+			}
+			err = ValidateUpdateSurveySettingsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_survey_settings", err)
+			}
+			return nil, NewUpdateSurveySettingsServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body UpdateSurveySettingsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "update_survey_settings", err)
+			}
+			err = ValidateUpdateSurveySettingsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "update_survey_settings", err)
+			}
+			return nil, NewUpdateSurveySettingsUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("survey", "update_survey_settings", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetSurveyResultsRequest instantiates a HTTP request object with method
+// and path set to call the "survey" service "get_survey_results" endpoint
+func (c *Client) BuildGetSurveyResultsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		surveyUID string
+	)
+	{
+		p, ok := v.(*survey.GetSurveyResultsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("survey", "get_survey_results", "*survey.GetSurveyResultsPayload", v)
+		}
+		surveyUID = p.SurveyUID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetSurveyResultsSurveyPath(surveyUID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("survey", "get_survey_results", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetSurveyResultsRequest returns an encoder for requests sent to the
+// survey get_survey_results server.
+func EncodeGetSurveyResultsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*survey.GetSurveyResultsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("survey", "get_survey_results", "*survey.GetSurveyResultsPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetSurveyResultsResponse returns a decoder for responses returned by
+// the survey get_survey_results endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetSurveyResultsResponse may return the following errors:
+//   - "BadRequest" (type *survey.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *survey.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *survey.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *survey.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *survey.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *survey.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetSurveyResultsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetSurveyResultsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_results", err)
+			}
+			err = ValidateGetSurveyResultsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_results", err)
+			}
+			res := NewGetSurveyResultsSurveyResultsOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetSurveyResultsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_results", err)
+			}
+			err = ValidateGetSurveyResultsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_results", err)
+			}
+			return nil, NewGetSurveyResultsBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body GetSurveyResultsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_results", err)
+			}
+			err = ValidateGetSurveyResultsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_results", err)
+			}
+			return nil, NewGetSurveyResultsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetSurveyResultsInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_results", err)
+			}
+			err = ValidateGetSurveyResultsInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_results", err)
+			}
+			return nil, NewGetSurveyResultsInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetSurveyResultsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_results", err)
+			}
+			err = ValidateGetSurveyResultsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_results", err)
+			}
+			return nil, NewGetSurveyResultsNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetSurveyResultsServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_results", err)
+			}
+			err = ValidateGetSurveyResultsServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_results", err)
+			}
+			return nil, NewGetSurveyResultsServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body GetSurveyResultsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_results", err)
+			}
+			err = ValidateGetSurveyResultsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_results", err)
+			}
+			return nil, NewGetSurveyResultsUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("survey", "get_survey_results", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // BuildValidateEmailRequest instantiates a HTTP request object with method and
 // path set to call the "survey" service "validate_email" endpoint
 func (c *Client) BuildValidateEmailRequest(ctx context.Context, v any) (*http.Request, error) {
 	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ValidateEmailSurveyPath()}
 	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("survey", "validate_email", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("survey", "validate_email", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeValidateEmailRequest returns an encoder for requests sent to the
+// survey validate_email server.
+func EncodeValidateEmailRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*survey.ValidateEmailPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("survey", "validate_email", "*survey.ValidateEmailPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewValidateEmailRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("survey", "validate_email", err)
+		}
+		return nil
+	}
+}
+
+// DecodeValidateEmailResponse returns a decoder for responses returned by the
+// survey validate_email endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeValidateEmailResponse may return the following errors:
+//   - "BadRequest" (type *survey.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *survey.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *survey.InternalServerError): http.StatusInternalServerError
+//   - "Unauthorized" (type *survey.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeValidateEmailResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ValidateEmailResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "validate_email", err)
+			}
+			err = ValidateValidateEmailResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "validate_email", err)
+			}
+			res := NewValidateEmailResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ValidateEmailBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "validate_email", err)
+			}
+			err = ValidateValidateEmailBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "validate_email", err)
+			}
+			return nil, NewValidateEmailBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body ValidateEmailForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "validate_email", err)
+			}
+			err = ValidateValidateEmailForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "validate_email", err)
+			}
+			return nil, NewValidateEmailForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ValidateEmailInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "validate_email", err)
+			}
+			err = ValidateValidateEmailInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "validate_email", err)
+			}
+			return nil, NewValidateEmailInternalServerError(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ValidateEmailUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "validate_email", err)
+			}
+			err = ValidateValidateEmailUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "validate_email", err)
+			}
+			return nil, NewValidateEmailUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("survey", "validate_email", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListSurveyTemplatesRequest instantiates a HTTP request object with
+// method and path set to call the "survey" service "list_survey_templates"
+// endpoint
+func (c *Client) BuildListSurveyTemplatesRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListSurveyTemplatesSurveyPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("survey", "list_survey_templates", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListSurveyTemplatesRequest returns an encoder for requests sent to the
+// survey list_survey_templates server.
+func EncodeListSurveyTemplatesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*survey.ListSurveyTemplatesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("survey", "list_survey_templates", "*survey.ListSurveyTemplatesPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Title != nil {
+			values.Add("title", *p.Title)
+		}
+		if p.Category != nil {
+			values.Add("category", *p.Category)
+		}
+		if p.Language != nil {
+			values.Add("language", *p.Language)
+		}
+		values.Add("offset", fmt.Sprintf("%v", p.Offset))
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListSurveyTemplatesResponse returns a decoder for responses returned
+// by the survey list_survey_templates endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeListSurveyTemplatesResponse may return the following errors:
+//   - "BadRequest" (type *survey.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *survey.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *survey.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *survey.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *survey.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListSurveyTemplatesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListSurveyTemplatesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "list_survey_templates", err)
+			}
+			err = ValidateListSurveyTemplatesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "list_survey_templates", err)
+			}
+			res := NewListSurveyTemplatesSurveyTemplateListOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListSurveyTemplatesBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "list_survey_templates", err)
+			}
+			err = ValidateListSurveyTemplatesBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "list_survey_templates", err)
+			}
+			return nil, NewListSurveyTemplatesBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body ListSurveyTemplatesForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "list_survey_templates", err)
+			}
+			err = ValidateListSurveyTemplatesForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "list_survey_templates", err)
+			}
+			return nil, NewListSurveyTemplatesForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListSurveyTemplatesInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "list_survey_templates", err)
+			}
+			err = ValidateListSurveyTemplatesInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "list_survey_templates", err)
+			}
+			return nil, NewListSurveyTemplatesInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ListSurveyTemplatesServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "list_survey_templates", err)
+			}
+			err = ValidateListSurveyTemplatesServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "list_survey_templates", err)
+			}
+			return nil, NewListSurveyTemplatesServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListSurveyTemplatesUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "list_survey_templates", err)
+			}
+			err = ValidateListSurveyTemplatesUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "list_survey_templates", err)
+			}
+			return nil, NewListSurveyTemplatesUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("survey", "list_survey_templates", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetSurveyTemplateRequest instantiates a HTTP request object with method
+// and path set to call the "survey" service "get_survey_template" endpoint
+func (c *Client) BuildGetSurveyTemplateRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		templateID string
+	)
+	{
+		p, ok := v.(*survey.GetSurveyTemplatePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("survey", "get_survey_template", "*survey.GetSurveyTemplatePayload", v)
+		}
+		templateID = p.TemplateID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetSurveyTemplateSurveyPath(templateID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("survey", "get_survey_template", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetSurveyTemplateRequest returns an encoder for requests sent to the
+// survey get_survey_template server.
+func EncodeGetSurveyTemplateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*survey.GetSurveyTemplatePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("survey", "get_survey_template", "*survey.GetSurveyTemplatePayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetSurveyTemplateResponse returns a decoder for responses returned by
+// the survey get_survey_template endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetSurveyTemplateResponse may return the following errors:
+//   - "BadRequest" (type *survey.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *survey.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *survey.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *survey.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *survey.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *survey.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetSurveyTemplateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetSurveyTemplateResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_template", err)
+			}
+			err = ValidateGetSurveyTemplateResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_template", err)
+			}
+			res := NewGetSurveyTemplateSurveyTemplateOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetSurveyTemplateBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_template", err)
+			}
+			err = ValidateGetSurveyTemplateBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_template", err)
+			}
+			return nil, NewGetSurveyTemplateBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body GetSurveyTemplateForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_template", err)
+			}
+			err = ValidateGetSurveyTemplateForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_template", err)
+			}
+			return nil, NewGetSurveyTemplateForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetSurveyTemplateInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_template", err)
+			}
+			err = ValidateGetSurveyTemplateInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_template", err)
+			}
+			return nil, NewGetSurveyTemplateInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetSurveyTemplateNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_template", err)
+			}
+			err = ValidateGetSurveyTemplateNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_template", err)
+			}
+			return nil, NewGetSurveyTemplateNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetSurveyTemplateServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_template", err)
+			}
+			err = ValidateGetSurveyTemplateServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_template", err)
+			}
+			return nil, NewGetSurveyTemplateServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body GetSurveyTemplateUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_survey_template", err)
+			}
+			err = ValidateGetSurveyTemplateUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_survey_template", err)
+			}
+			return nil, NewGetSurveyTemplateUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("survey", "get_survey_template", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListDeadLettersRequest instantiates a HTTP request object with method
+// and path set to call the "survey" service "list_dead_letters" endpoint
+func (c *Client) BuildListDeadLettersRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListDeadLettersSurveyPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("survey", "list_dead_letters", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListDeadLettersRequest returns an encoder for requests sent to the
+// survey list_dead_letters server.
+func EncodeListDeadLettersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*survey.ListDeadLettersPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("survey", "list_dead_letters", "*survey.ListDeadLettersPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.KeyPrefix != nil {
+			values.Add("key_prefix", *p.KeyPrefix)
+		}
+		if p.After != nil {
+			values.Add("after", fmt.Sprintf("%v", *p.After))
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListDeadLettersResponse returns a decoder for responses returned by
+// the survey list_dead_letters endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeListDeadLettersResponse may return the following errors:
+//   - "BadRequest" (type *survey.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *survey.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *survey.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *survey.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *survey.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListDeadLettersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListDeadLettersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "list_dead_letters", err)
+			}
+			err = ValidateListDeadLettersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "list_dead_letters", err)
+			}
+			res := NewListDeadLettersDeadLetterListOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListDeadLettersBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "list_dead_letters", err)
+			}
+			err = ValidateListDeadLettersBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "list_dead_letters", err)
+			}
+			return nil, NewListDeadLettersBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body ListDeadLettersForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "list_dead_letters", err)
+			}
+			err = ValidateListDeadLettersForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "list_dead_letters", err)
+			}
+			return nil, NewListDeadLettersForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListDeadLettersInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "list_dead_letters", err)
+			}
+			err = ValidateListDeadLettersInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "list_dead_letters", err)
+			}
+			return nil, NewListDeadLettersInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ListDeadLettersServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "list_dead_letters", err)
+			}
+			err = ValidateListDeadLettersServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "list_dead_letters", err)
+			}
+			return nil, NewListDeadLettersServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListDeadLettersUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "list_dead_letters", err)
+			}
+			err = ValidateListDeadLettersUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "list_dead_letters", err)
+			}
+			return nil, NewListDeadLettersUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("survey", "list_dead_letters", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetDeadLetterRequest instantiates a HTTP request object with method and
+// path set to call the "survey" service "get_dead_letter" endpoint
+func (c *Client) BuildGetDeadLetterRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		sequence uint64
+	)
+	{
+		p, ok := v.(*survey.GetDeadLetterPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("survey", "get_dead_letter", "*survey.GetDeadLetterPayload", v)
+		}
+		sequence = p.Sequence
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetDeadLetterSurveyPath(sequence)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("survey", "get_dead_letter", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetDeadLetterRequest returns an encoder for requests sent to the
+// survey get_dead_letter server.
+func EncodeGetDeadLetterRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*survey.GetDeadLetterPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("survey", "get_dead_letter", "*survey.GetDeadLetterPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetDeadLetterResponse returns a decoder for responses returned by the
+// survey get_dead_letter endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeGetDeadLetterResponse may return the following errors:
+//   - "BadRequest" (type *survey.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *survey.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *survey.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *survey.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *survey.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *survey.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetDeadLetterResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetDeadLetterResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_dead_letter", err)
+			}
+			err = ValidateGetDeadLetterResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_dead_letter", err)
+			}
+			res := NewGetDeadLetterDeadLetterEntryOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetDeadLetterBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_dead_letter", err)
+			}
+			err = ValidateGetDeadLetterBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_dead_letter", err)
+			}
+			return nil, NewGetDeadLetterBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body GetDeadLetterForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_dead_letter", err)
+			}
+			err = ValidateGetDeadLetterForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_dead_letter", err)
+			}
+			return nil, NewGetDeadLetterForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetDeadLetterInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_dead_letter", err)
+			}
+			err = ValidateGetDeadLetterInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_dead_letter", err)
+			}
+			return nil, NewGetDeadLetterInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetDeadLetterNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_dead_letter", err)
+			}
+			err = ValidateGetDeadLetterNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_dead_letter", err)
+			}
+			return nil, NewGetDeadLetterNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetDeadLetterServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_dead_letter", err)
+			}
+			err = ValidateGetDeadLetterServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_dead_letter", err)
+			}
+			return nil, NewGetDeadLetterServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body GetDeadLetterUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_dead_letter", err)
+			}
+			err = ValidateGetDeadLetterUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_dead_letter", err)
+			}
+			return nil, NewGetDeadLetterUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("survey", "get_dead_letter", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildReplayDeadLetterRequest instantiates a HTTP request object with method
+// and path set to call the "survey" service "replay_dead_letter" endpoint
+func (c *Client) BuildReplayDeadLetterRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		sequence uint64
+	)
+	{
+		p, ok := v.(*survey.ReplayDeadLetterPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("survey", "replay_dead_letter", "*survey.ReplayDeadLetterPayload", v)
+		}
+		sequence = p.Sequence
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ReplayDeadLetterSurveyPath(sequence)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("survey", "replay_dead_letter", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeReplayDeadLetterRequest returns an encoder for requests sent to the
+// survey replay_dead_letter server.
+func EncodeReplayDeadLetterRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*survey.ReplayDeadLetterPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("survey", "replay_dead_letter", "*survey.ReplayDeadLetterPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeReplayDeadLetterResponse returns a decoder for responses returned by
+// the survey replay_dead_letter endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeReplayDeadLetterResponse may return the following errors:
+//   - "BadRequest" (type *survey.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *survey.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *survey.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *survey.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *survey.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *survey.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeReplayDeadLetterResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ReplayDeadLetterResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "replay_dead_letter", err)
+			}
+			err = ValidateReplayDeadLetterResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "replay_dead_letter", err)
+			}
+			res := NewReplayDeadLetterDeadLetterReplayResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ReplayDeadLetterBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "replay_dead_letter", err)
+			}
+			err = ValidateReplayDeadLetterBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "replay_dead_letter", err)
+			}
+			return nil, NewReplayDeadLetterBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body ReplayDeadLetterForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "replay_dead_letter", err)
+			}
+			err = ValidateReplayDeadLetterForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "replay_dead_letter", err)
+			}
+			return nil, NewReplayDeadLetterForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ReplayDeadLetterInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "replay_dead_letter", err)
+			}
+			err = ValidateReplayDeadLetterInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "replay_dead_letter", err)
+			}
+			return nil, NewReplayDeadLetterInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body ReplayDeadLetterNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "replay_dead_letter", err)
+			}
+			err = ValidateReplayDeadLetterNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "replay_dead_letter", err)
+			}
+			return nil, NewReplayDeadLetterNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body ReplayDeadLetterServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "replay_dead_letter", err)
+			}
+			err = ValidateReplayDeadLetterServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "replay_dead_letter", err)
+			}
+			return nil, NewReplayDeadLetterServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ReplayDeadLetterUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "replay_dead_letter", err)
+			}
+			err = ValidateReplayDeadLetterUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "replay_dead_letter", err)
+			}
+			return nil, NewReplayDeadLetterUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("survey", "replay_dead_letter", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildStartReindexRequest instantiates a HTTP request object with method and
+// path set to call the "survey" service "start_reindex" endpoint
+func (c *Client) BuildStartReindexRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: StartReindexSurveyPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("survey", "start_reindex", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeStartReindexRequest returns an encoder for requests sent to the survey
+// start_reindex server.
+func EncodeStartReindexRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*survey.StartReindexPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("survey", "start_reindex", "*survey.StartReindexPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewStartReindexRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("survey", "start_reindex", err)
+		}
+		return nil
+	}
+}
+
+// DecodeStartReindexResponse returns a decoder for responses returned by the
+// survey start_reindex endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeStartReindexResponse may return the following errors:
+//   - "BadRequest" (type *survey.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *survey.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *survey.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *survey.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *survey.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *survey.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeStartReindexResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusAccepted:
+			var (
+				body StartReindexResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "start_reindex", err)
+			}
+			err = ValidateStartReindexResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "start_reindex", err)
+			}
+			res := NewStartReindexReindexStatusAccepted(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body StartReindexBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "start_reindex", err)
+			}
+			err = ValidateStartReindexBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "start_reindex", err)
+			}
+			return nil, NewStartReindexBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body StartReindexConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "start_reindex", err)
+			}
+			err = ValidateStartReindexConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "start_reindex", err)
+			}
+			return nil, NewStartReindexConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body StartReindexForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "start_reindex", err)
+			}
+			err = ValidateStartReindexForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "start_reindex", err)
+			}
+			return nil, NewStartReindexForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body StartReindexInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "start_reindex", err)
+			}
+			err = ValidateStartReindexInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "start_reindex", err)
+			}
+			return nil, NewStartReindexInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body StartReindexServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "start_reindex", err)
+			}
+			err = ValidateStartReindexServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "start_reindex", err)
+			}
+			return nil, NewStartReindexServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body StartReindexUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "start_reindex", err)
+			}
+			err = ValidateStartReindexUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "start_reindex", err)
+			}
+			return nil, NewStartReindexUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("survey", "start_reindex", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetReindexRequest instantiates a HTTP request object with method and
+// path set to call the "survey" service "get_reindex" endpoint
+func (c *Client) BuildGetReindexRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetReindexSurveyPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("survey", "get_reindex", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetReindexRequest returns an encoder for requests sent to the survey
+// get_reindex server.
+func EncodeGetReindexRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*survey.GetReindexPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("survey", "get_reindex", "*survey.GetReindexPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetReindexResponse returns a decoder for responses returned by the
+// survey get_reindex endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeGetReindexResponse may return the following errors:
+//   - "BadRequest" (type *survey.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *survey.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *survey.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *survey.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *survey.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *survey.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetReindexResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetReindexResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_reindex", err)
+			}
+			err = ValidateGetReindexResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_reindex", err)
+			}
+			res := NewGetReindexReindexStatusOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetReindexBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_reindex", err)
+			}
+			err = ValidateGetReindexBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_reindex", err)
+			}
+			return nil, NewGetReindexBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body GetReindexForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_reindex", err)
+			}
+			err = ValidateGetReindexForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_reindex", err)
+			}
+			return nil, NewGetReindexForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetReindexInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_reindex", err)
+			}
+			err = ValidateGetReindexInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_reindex", err)
+			}
+			return nil, NewGetReindexInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetReindexNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_reindex", err)
+			}
+			err = ValidateGetReindexNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_reindex", err)
+			}
+			return nil, NewGetReindexNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetReindexServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_reindex", err)
+			}
+			err = ValidateGetReindexServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_reindex", err)
+			}
+			return nil, NewGetReindexServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body GetReindexUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_reindex", err)
+			}
+			err = ValidateGetReindexUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_reindex", err)
+			}
+			return nil, NewGetReindexUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("survey", "get_reindex", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCancelReindexRequest instantiates a HTTP request object with method and
+// path set to call the "survey" service "cancel_reindex" endpoint
+func (c *Client) BuildCancelReindexRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CancelReindexSurveyPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("survey", "cancel_reindex", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCancelReindexRequest returns an encoder for requests sent to the
+// survey cancel_reindex server.
+func EncodeCancelReindexRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*survey.CancelReindexPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("survey", "cancel_reindex", "*survey.CancelReindexPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeCancelReindexResponse returns a decoder for responses returned by the
+// survey cancel_reindex endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeCancelReindexResponse may return the following errors:
+//   - "BadRequest" (type *survey.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *survey.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *survey.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *survey.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *survey.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *survey.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeCancelReindexResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body CancelReindexResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "cancel_reindex", err)
+			}
+			err = ValidateCancelReindexResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "cancel_reindex", err)
+			}
+			res := NewCancelReindexReindexStatusOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body CancelReindexBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "cancel_reindex", err)
+			}
+			err = ValidateCancelReindexBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "cancel_reindex", err)
+			}
+			return nil, NewCancelReindexBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body CancelReindexConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "cancel_reindex", err)
+			}
+			err = ValidateCancelReindexConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "cancel_reindex", err)
+			}
+			return nil, NewCancelReindexConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body CancelReindexForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "cancel_reindex", err)
+			}
+			err = ValidateCancelReindexForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "cancel_reindex", err)
+			}
+			return nil, NewCancelReindexForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CancelReindexInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "cancel_reindex", err)
+			}
+			err = ValidateCancelReindexInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "cancel_reindex", err)
+			}
+			return nil, NewCancelReindexInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body CancelReindexServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "cancel_reindex", err)
+			}
+			err = ValidateCancelReindexServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "cancel_reindex", err)
+			}
+			return nil, NewCancelReindexServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body CancelReindexUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "cancel_reindex", err)
+			}
+			err = ValidateCancelReindexUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "cancel_reindex", err)
+			}
+			return nil, NewCancelReindexUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("survey", "cancel_reindex", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRerunInviteEnrichmentRequest instantiates a HTTP request object with
+// method and path set to call the "survey" service "rerun_invite_enrichment"
+// endpoint
+func (c *Client) BuildRerunInviteEnrichmentRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RerunInviteEnrichmentSurveyPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("survey", "rerun_invite_enrichment", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRerunInviteEnrichmentRequest returns an encoder for requests sent to
+// the survey rerun_invite_enrichment server.
+func EncodeRerunInviteEnrichmentRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*survey.RerunInviteEnrichmentPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("survey", "rerun_invite_enrichment", "*survey.RerunInviteEnrichmentPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewRerunInviteEnrichmentRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("survey", "rerun_invite_enrichment", err)
+		}
+		return nil
+	}
+}
+
+// DecodeRerunInviteEnrichmentResponse returns a decoder for responses returned
+// by the survey rerun_invite_enrichment endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeRerunInviteEnrichmentResponse may return the following errors:
+//   - "BadRequest" (type *survey.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *survey.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *survey.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *survey.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *survey.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *survey.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeRerunInviteEnrichmentResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RerunInviteEnrichmentResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "rerun_invite_enrichment", err)
+			}
+			err = ValidateRerunInviteEnrichmentResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "rerun_invite_enrichment", err)
+			}
+			res := NewRerunInviteEnrichmentInviteEnrichmentResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body RerunInviteEnrichmentBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "rerun_invite_enrichment", err)
+			}
+			err = ValidateRerunInviteEnrichmentBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "rerun_invite_enrichment", err)
+			}
+			return nil, NewRerunInviteEnrichmentBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body RerunInviteEnrichmentForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "rerun_invite_enrichment", err)
+			}
+			err = ValidateRerunInviteEnrichmentForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "rerun_invite_enrichment", err)
+			}
+			return nil, NewRerunInviteEnrichmentForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RerunInviteEnrichmentInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "rerun_invite_enrichment", err)
+			}
+			err = ValidateRerunInviteEnrichmentInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "rerun_invite_enrichment", err)
+			}
+			return nil, NewRerunInviteEnrichmentInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body RerunInviteEnrichmentNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "rerun_invite_enrichment", err)
+			}
+			err = ValidateRerunInviteEnrichmentNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "rerun_invite_enrichment", err)
+			}
+			return nil, NewRerunInviteEnrichmentNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body RerunInviteEnrichmentServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "rerun_invite_enrichment", err)
+			}
+			err = ValidateRerunInviteEnrichmentServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "rerun_invite_enrichment", err)
+			}
+			return nil, NewRerunInviteEnrichmentServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body RerunInviteEnrichmentUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "rerun_invite_enrichment", err)
+			}
+			err = ValidateRerunInviteEnrichmentUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "rerun_invite_enrichment", err)
+			}
+			return nil, NewRerunInviteEnrichmentUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("survey", "rerun_invite_enrichment", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildStartInviteBackfillRequest instantiates a HTTP request object with
+// method and path set to call the "survey" service "start_invite_backfill"
+// endpoint
+func (c *Client) BuildStartInviteBackfillRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: StartInviteBackfillSurveyPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("survey", "start_invite_backfill", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeStartInviteBackfillRequest returns an encoder for requests sent to the
+// survey start_invite_backfill server.
+func EncodeStartInviteBackfillRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*survey.StartInviteBackfillPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("survey", "start_invite_backfill", "*survey.StartInviteBackfillPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewStartInviteBackfillRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("survey", "start_invite_backfill", err)
+		}
+		return nil
+	}
+}
+
+// DecodeStartInviteBackfillResponse returns a decoder for responses returned
+// by the survey start_invite_backfill endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeStartInviteBackfillResponse may return the following errors:
+//   - "BadRequest" (type *survey.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *survey.ConflictError): http.StatusConflict
+//   - "Forbidden" (type *survey.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *survey.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *survey.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *survey.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeStartInviteBackfillResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusAccepted:
+			var (
+				body StartInviteBackfillResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "start_invite_backfill", err)
+			}
+			err = ValidateStartInviteBackfillResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "start_invite_backfill", err)
+			}
+			res := NewStartInviteBackfillInviteBackfillStatusAccepted(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body StartInviteBackfillBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "start_invite_backfill", err)
+			}
+			err = ValidateStartInviteBackfillBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "start_invite_backfill", err)
+			}
+			return nil, NewStartInviteBackfillBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body StartInviteBackfillConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "start_invite_backfill", err)
+			}
+			err = ValidateStartInviteBackfillConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "start_invite_backfill", err)
+			}
+			return nil, NewStartInviteBackfillConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body StartInviteBackfillForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "start_invite_backfill", err)
+			}
+			err = ValidateStartInviteBackfillForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "start_invite_backfill", err)
+			}
+			return nil, NewStartInviteBackfillForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body StartInviteBackfillInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "start_invite_backfill", err)
+			}
+			err = ValidateStartInviteBackfillInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "start_invite_backfill", err)
+			}
+			return nil, NewStartInviteBackfillInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body StartInviteBackfillServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "start_invite_backfill", err)
+			}
+			err = ValidateStartInviteBackfillServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "start_invite_backfill", err)
+			}
+			return nil, NewStartInviteBackfillServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body StartInviteBackfillUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "start_invite_backfill", err)
+			}
+			err = ValidateStartInviteBackfillUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "start_invite_backfill", err)
+			}
+			return nil, NewStartInviteBackfillUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("survey", "start_invite_backfill", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetInviteBackfillRequest instantiates a HTTP request object with method
+// and path set to call the "survey" service "get_invite_backfill" endpoint
+func (c *Client) BuildGetInviteBackfillRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetInviteBackfillSurveyPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("survey", "get_invite_backfill", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -2509,13 +6436,13 @@ func (c *Client) BuildValidateEmailRequest(ctx context.Context, v any) (*http.Re
 	return req, nil
 }
 
-// EncodeValidateEmailRequest returns an encoder for requests sent to the
-// survey validate_email server.
-func EncodeValidateEmailRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeGetInviteBackfillRequest returns an encoder for requests sent to the
+// survey get_invite_backfill server.
+func EncodeGetInviteBackfillRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*survey.ValidateEmailPayload)
+		p, ok := v.(*survey.GetInviteBackfillPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("survey", "validate_email", "*survey.ValidateEmailPayload", v)
+			return goahttp.ErrInvalidType("survey", "get_invite_backfill", "*survey.GetInviteBackfillPayload", v)
 		}
 		if p.Token != nil {
 			head := *p.Token
@@ -2525,24 +6452,190 @@ func EncodeValidateEmailRequest(encoder func(*http.Request) goahttp.Encoder) fun
 				req.Header.Set("Authorization", head)
 			}
 		}
-		body := NewValidateEmailRequestBody(p)
-		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("survey", "validate_email", err)
+		return nil
+	}
+}
+
+// DecodeGetInviteBackfillResponse returns a decoder for responses returned by
+// the survey get_invite_backfill endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetInviteBackfillResponse may return the following errors:
+//   - "BadRequest" (type *survey.BadRequestError): http.StatusBadRequest
+//   - "Forbidden" (type *survey.ForbiddenError): http.StatusForbidden
+//   - "InternalServerError" (type *survey.InternalServerError): http.StatusInternalServerError
+//   - "NotFound" (type *survey.NotFoundError): http.StatusNotFound
+//   - "ServiceUnavailable" (type *survey.ServiceUnavailableError): http.StatusServiceUnavailable
+//   - "Unauthorized" (type *survey.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetInviteBackfillResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetInviteBackfillResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_invite_backfill", err)
+			}
+			err = ValidateGetInviteBackfillResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_invite_backfill", err)
+			}
+			res := NewGetInviteBackfillInviteBackfillStatusOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetInviteBackfillBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_invite_backfill", err)
+			}
+			err = ValidateGetInviteBackfillBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_invite_backfill", err)
+			}
+			return nil, NewGetInviteBackfillBadRequest(&body)
+		case http.StatusForbidden:
+			var (
+				body GetInviteBackfillForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_invite_backfill", err)
+			}
+			err = ValidateGetInviteBackfillForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_invite_backfill", err)
+			}
+			return nil, NewGetInviteBackfillForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetInviteBackfillInternalServerErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_invite_backfill", err)
+			}
+			err = ValidateGetInviteBackfillInternalServerErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_invite_backfill", err)
+			}
+			return nil, NewGetInviteBackfillInternalServerError(&body)
+		case http.StatusNotFound:
+			var (
+				body GetInviteBackfillNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_invite_backfill", err)
+			}
+			err = ValidateGetInviteBackfillNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_invite_backfill", err)
+			}
+			return nil, NewGetInviteBackfillNotFound(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body GetInviteBackfillServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_invite_backfill", err)
+			}
+			err = ValidateGetInviteBackfillServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_invite_backfill", err)
+			}
+			return nil, NewGetInviteBackfillServiceUnavailable(&body)
+		case http.StatusUnauthorized:
+			var (
+				body GetInviteBackfillUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "get_invite_backfill", err)
+			}
+			err = ValidateGetInviteBackfillUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "get_invite_backfill", err)
+			}
+			return nil, NewGetInviteBackfillUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("survey", "get_invite_backfill", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCancelInviteBackfillRequest instantiates a HTTP request object with
+// method and path set to call the "survey" service "cancel_invite_backfill"
+// endpoint
+func (c *Client) BuildCancelInviteBackfillRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CancelInviteBackfillSurveyPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("survey", "cancel_invite_backfill", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCancelInviteBackfillRequest returns an encoder for requests sent to
+// the survey cancel_invite_backfill server.
+func EncodeCancelInviteBackfillRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*survey.CancelInviteBackfillPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("survey", "cancel_invite_backfill", "*survey.CancelInviteBackfillPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
 		}
 		return nil
 	}
 }
 
-// DecodeValidateEmailResponse returns a decoder for responses returned by the
-// survey validate_email endpoint. restoreBody controls whether the response
-// body should be restored after having been read.
-// DecodeValidateEmailResponse may return the following errors:
+// DecodeCancelInviteBackfillResponse returns a decoder for responses returned
+// by the survey cancel_invite_backfill endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeCancelInviteBackfillResponse may return the following errors:
 //   - "BadRequest" (type *survey.BadRequestError): http.StatusBadRequest
+//   - "Conflict" (type *survey.ConflictError): http.StatusConflict
 //   - "Forbidden" (type *survey.ForbiddenError): http.StatusForbidden
 //   - "InternalServerError" (type *survey.InternalServerError): http.StatusInternalServerError
+//   - "ServiceUnavailable" (type *survey.ServiceUnavailableError): http.StatusServiceUnavailable
 //   - "Unauthorized" (type *survey.UnauthorizedError): http.StatusUnauthorized
 //   - error: internal error
-func DecodeValidateEmailResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeCancelInviteBackfillResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -2559,78 +6652,106 @@ func DecodeValidateEmailResponse(decoder func(*http.Response) goahttp.Decoder, r
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body ValidateEmailResponseBody
+				body CancelInviteBackfillResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("survey", "validate_email", err)
+				return nil, goahttp.ErrDecodingError("survey", "cancel_invite_backfill", err)
 			}
-			err = ValidateValidateEmailResponseBody(&body)
+			err = ValidateCancelInviteBackfillResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("survey", "validate_email", err)
+				return nil, goahttp.ErrValidationError("survey", "cancel_invite_backfill", err)
 			}
-			res := NewValidateEmailResultOK(&body)
+			res := NewCancelInviteBackfillInviteBackfillStatusOK(&body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body ValidateEmailBadRequestResponseBody
+				body CancelInviteBackfillBadRequestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("survey", "validate_email", err)
+				return nil, goahttp.ErrDecodingError("survey", "cancel_invite_backfill", err)
 			}
-			err = ValidateValidateEmailBadRequestResponseBody(&body)
+			err = ValidateCancelInviteBackfillBadRequestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("survey", "validate_email", err)
+				return nil, goahttp.ErrValidationError("survey", "cancel_invite_backfill", err)
 			}
-			return nil, NewValidateEmailBadRequest(&body)
+			return nil, NewCancelInviteBackfillBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body CancelInviteBackfillConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "cancel_invite_backfill", err)
+			}
+			err = ValidateCancelInviteBackfillConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "cancel_invite_backfill", err)
+			}
+			return nil, NewCancelInviteBackfillConflict(&body)
 		case http.StatusForbidden:
 			var (
-				body ValidateEmailForbiddenResponseBody
+				body CancelInviteBackfillForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("survey", "validate_email", err)
+				return nil, goahttp.ErrDecodingError("survey", "cancel_invite_backfill", err)
 			}
-			err = ValidateValidateEmailForbiddenResponseBody(&body)
+			err = ValidateCancelInviteBackfillForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("survey", "validate_email", err)
+				return nil, goahttp.ErrValidationError("survey", "cancel_invite_backfill", err)
 			}
-			return nil, NewValidateEmailForbidden(&body)
+			return nil, NewCancelInviteBackfillForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body ValidateEmailInternalServerErrorResponseBody
+				body CancelInviteBackfillInternalServerErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("survey", "validate_email", err)
+				return nil, goahttp.ErrDecodingError("survey", "cancel_invite_backfill", err)
 			}
-			err = ValidateValidateEmailInternalServerErrorResponseBody(&body)
+			err = ValidateCancelInviteBackfillInternalServerErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("survey", "validate_email", err)
+				return nil, goahttp.ErrValidationError("survey", "cancel_invite_backfill", err)
 			}
-			return nil, NewValidateEmailInternalServerError(&body)
+			return nil, NewCancelInviteBackfillInternalServerError(&body)
+		case http.StatusServiceUnavailable:
+			var (
+				body CancelInviteBackfillServiceUnavailableResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("survey", "cancel_invite_backfill", err)
+			}
+			err = ValidateCancelInviteBackfillServiceUnavailableResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("survey", "cancel_invite_backfill", err)
+			}
+			return nil, NewCancelInviteBackfillServiceUnavailable(&body)
 		case http.StatusUnauthorized:
 			var (
-				body ValidateEmailUnauthorizedResponseBody
+				body CancelInviteBackfillUnauthorizedResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("survey", "validate_email", err)
+				return nil, goahttp.ErrDecodingError("survey", "cancel_invite_backfill", err)
 			}
-			err = ValidateValidateEmailUnauthorizedResponseBody(&body)
+			err = ValidateCancelInviteBackfillUnauthorizedResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("survey", "validate_email", err)
+				return nil, goahttp.ErrValidationError("survey", "cancel_invite_backfill", err)
 			}
-			return nil, NewValidateEmailUnauthorized(&body)
+			return nil, NewCancelInviteBackfillUnauthorized(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("survey", "validate_email", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("survey", "cancel_invite_backfill", resp.StatusCode, string(body))
 		}
 	}
 }
@@ -2656,6 +6777,20 @@ func unmarshalSurveyCommitteeResponseBodyToSurveySurveyCommittee(v *SurveyCommit
 	return res
 }
 
+// unmarshalQuestionErrorResponseBodyToSurveyQuestionError builds a value of
+// type *survey.QuestionError from a value of type *QuestionErrorResponseBody.
+func unmarshalQuestionErrorResponseBodyToSurveyQuestionError(v *QuestionErrorResponseBody) *survey.QuestionError {
+	if v == nil {
+		return nil
+	}
+	res := &survey.QuestionError{
+		QuestionID: *v.QuestionID,
+		Message:    *v.Message,
+	}
+
+	return res
+}
+
 // unmarshalLFXProjectResponseBodyToSurveyLFXProject builds a value of type
 // *survey.LFXProject from a value of type *LFXProjectResponseBody.
 func unmarshalLFXProjectResponseBodyToSurveyLFXProject(v *LFXProjectResponseBody) *survey.LFXProject {
@@ -2892,3 +7027,208 @@ func unmarshalSurveyResponsePageMetaResponseBodyToSurveySurveyResponsePageMeta(v
 
 	return res
 }
+
+// marshalSurveySurveyAnswerToSurveyAnswerRequestBody builds a value of type
+// *SurveyAnswerRequestBody from a value of type *survey.SurveyAnswer.
+func marshalSurveySurveyAnswerToSurveyAnswerRequestBody(v *survey.SurveyAnswer) *SurveyAnswerRequestBody {
+	res := &SurveyAnswerRequestBody{
+		QuestionID:  v.QuestionID,
+		AnswerText:  v.AnswerText,
+		RatingValue: v.RatingValue,
+		YesNoValue:  v.YesNoValue,
+	}
+	if v.ChoiceIds != nil {
+		res.ChoiceIds = make([]string, len(v.ChoiceIds))
+		for i, val := range v.ChoiceIds {
+			res.ChoiceIds[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalSurveyAnswerRequestBodyToSurveySurveyAnswer builds a value of type
+// *survey.SurveyAnswer from a value of type *SurveyAnswerRequestBody.
+func marshalSurveyAnswerRequestBodyToSurveySurveyAnswer(v *SurveyAnswerRequestBody) *survey.SurveyAnswer {
+	res := &survey.SurveyAnswer{
+		QuestionID:  v.QuestionID,
+		AnswerText:  v.AnswerText,
+		RatingValue: v.RatingValue,
+		YesNoValue:  v.YesNoValue,
+	}
+	if v.ChoiceIds != nil {
+		res.ChoiceIds = make([]string, len(v.ChoiceIds))
+		for i, val := range v.ChoiceIds {
+			res.ChoiceIds[i] = val
+		}
+	}
+
+	return res
+}
+
+// unmarshalSurveyInviteCountsResponseBodyToSurveySurveyInviteCounts builds a
+// value of type *survey.SurveyInviteCounts from a value of type
+// *SurveyInviteCountsResponseBody.
+func unmarshalSurveyInviteCountsResponseBodyToSurveySurveyInviteCounts(v *SurveyInviteCountsResponseBody) *survey.SurveyInviteCounts {
+	res := &survey.SurveyInviteCounts{
+		Total:    *v.Total,
+		Pending:  *v.Pending,
+		Sent:     *v.Sent,
+		Failed:   *v.Failed,
+		Accepted: *v.Accepted,
+		Expired:  *v.Expired,
+	}
+
+	return res
+}
+
+// unmarshalSurveyInviteResponseBodyToSurveySurveyInvite builds a value of type
+// *survey.SurveyInvite from a value of type *SurveyInviteResponseBody.
+func unmarshalSurveyInviteResponseBodyToSurveySurveyInvite(v *SurveyInviteResponseBody) *survey.SurveyInvite {
+	res := &survey.SurveyInvite{
+		SurveyResponseUID: *v.SurveyResponseUID,
+		Email:             *v.Email,
+		Name:              v.Name,
+		Status:            *v.Status,
+		InviteUID:         v.InviteUID,
+		Error:             v.Error,
+		CreatedAt:         *v.CreatedAt,
+		SentAt:            v.SentAt,
+		FailedAt:          v.FailedAt,
+		ExpiresAt:         v.ExpiresAt,
+		AcceptedAt:        v.AcceptedAt,
+		AcceptedBy:        v.AcceptedBy,
+		Reinvites:         *v.Reinvites,
+		AttachedTo:        v.AttachedTo,
+		UpdatedAt:         *v.UpdatedAt,
+	}
+	if v.Attempts != nil {
+		res.Attempts = make([]*survey.InviteAttempt, len(v.Attempts))
+		for i, val := range v.Attempts {
+			if val == nil {
+				res.Attempts[i] = nil
+				continue
+			}
+			res.Attempts[i] = unmarshalInviteAttemptResponseBodyToSurveyInviteAttempt(val)
+		}
+	}
+
+	return res
+}
+
+// unmarshalInviteAttemptResponseBodyToSurveyInviteAttempt builds a value of
+// type *survey.InviteAttempt from a value of type *InviteAttemptResponseBody.
+func unmarshalInviteAttemptResponseBodyToSurveyInviteAttempt(v *InviteAttemptResponseBody) *survey.InviteAttempt {
+	if v == nil {
+		return nil
+	}
+	res := &survey.InviteAttempt{
+		At:        *v.At,
+		InviteUID: v.InviteUID,
+		ExpiresAt: v.ExpiresAt,
+		Error:     v.Error,
+	}
+
+	return res
+}
+
+// unmarshalResultsAccessSyncResponseBodyToSurveyResultsAccessSync builds a
+// value of type *survey.ResultsAccessSync from a value of type
+// *ResultsAccessSyncResponseBody.
+func unmarshalResultsAccessSyncResponseBodyToSurveyResultsAccessSync(v *ResultsAccessSyncResponseBody) *survey.ResultsAccessSync {
+	if v == nil {
+		return nil
+	}
+	res := &survey.ResultsAccessSync{
+		Granted: *v.Granted,
+		Revoked: *v.Revoked,
+		Failed:  *v.Failed,
+	}
+
+	return res
+}
+
+// unmarshalSurveyQuestionResultResponseBodyToSurveySurveyQuestionResult builds
+// a value of type *survey.SurveyQuestionResult from a value of type
+// *SurveyQuestionResultResponseBody.
+func unmarshalSurveyQuestionResultResponseBodyToSurveySurveyQuestionResult(v *SurveyQuestionResultResponseBody) *survey.SurveyQuestionResult {
+	res := &survey.SurveyQuestionResult{
+		QuestionID:   *v.QuestionID,
+		QuestionText: v.QuestionText,
+		QuestionType: v.QuestionType,
+	}
+	res.Answers = make([]*survey.SurveyAnswerResult, len(v.Answers))
+	for i, val := range v.Answers {
+		if val == nil {
+			res.Answers[i] = nil
+			continue
+		}
+		res.Answers[i] = unmarshalSurveyAnswerResultResponseBodyToSurveySurveyAnswerResult(val)
+	}
+
+	return res
+}
+
+// unmarshalSurveyAnswerResultResponseBodyToSurveySurveyAnswerResult builds a
+// value of type *survey.SurveyAnswerResult from a value of type
+// *SurveyAnswerResultResponseBody.
+func unmarshalSurveyAnswerResultResponseBodyToSurveySurveyAnswerResult(v *SurveyAnswerResultResponseBody) *survey.SurveyAnswerResult {
+	res := &survey.SurveyAnswerResult{
+		Answer:     *v.Answer,
+		Count:      *v.Count,
+		Percentage: *v.Percentage,
+		Suppressed: v.Suppressed,
+	}
+
+	return res
+}
+
+// unmarshalSurveyTemplateResponseBodyToSurveySurveyTemplate builds a value of
+// type *survey.SurveyTemplate from a value of type *SurveyTemplateResponseBody.
+func unmarshalSurveyTemplateResponseBodyToSurveySurveyTemplate(v *SurveyTemplateResponseBody) *survey.SurveyTemplate {
+	res := &survey.SurveyTemplate{
+		ID:            *v.ID,
+		Title:         *v.Title,
+		Nickname:      v.Nickname,
+		Category:      v.Category,
+		Language:      v.Language,
+		QuestionCount: v.QuestionCount,
+		PageCount:     v.PageCount,
+		PreviewURL:    v.PreviewURL,
+		CollectURL:    v.CollectURL,
+		DateCreated:   v.DateCreated,
+		DateModified:  v.DateModified,
+	}
+
+	return res
+}
+
+// unmarshalDeadLetterEntryResponseBodyToSurveyDeadLetterEntry builds a value
+// of type *survey.DeadLetterEntry from a value of type
+// *DeadLetterEntryResponseBody.
+func unmarshalDeadLetterEntryResponseBodyToSurveyDeadLetterEntry(v *DeadLetterEntryResponseBody) *survey.DeadLetterEntry {
+	res := &survey.DeadLetterEntry{
+		Sequence:       *v.Sequence,
+		Key:            *v.Key,
+		Operation:      *v.Operation,
+		Revision:       v.Revision,
+		Reason:         *v.Reason,
+		Error:          *v.Error,
+		Attempts:       *v.Attempts,
+		DeadLetteredAt: *v.DeadLetteredAt,
+		Payload:        v.Payload,
+	}
+	if v.Headers != nil {
+		res.Headers = make(map[string][]string, len(v.Headers))
+		for key, val := range v.Headers {
+			tk := key
+			tv := make([]string, len(val))
+			for i, val := range val {
+				tv[i] = val
+			}
+			res.Headers[tk] = tv
+		}
+	}
+
+	return res
+}
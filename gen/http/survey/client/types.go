@@ -112,6 +112,72 @@ type DeleteExclusionRequestBody struct {
 	GlobalExclusion *string `form:"global_exclusion,omitempty" json:"global_exclusion,omitempty" xml:"global_exclusion,omitempty"`
 }
 
+// CreateSurveyResponseRequestBody is the type of the "survey" service
+// "create_survey_response" endpoint HTTP request body.
+type CreateSurveyResponseRequestBody struct {
+	// Survey response identifier
+	SurveyResponseUID string `form:"survey_response_uid" json:"survey_response_uid" xml:"survey_response_uid"`
+	// Answers to the survey questions
+	Answers []*SurveyAnswerRequestBody `form:"answers" json:"answers" xml:"answers"`
+}
+
+// UpdateSurveyResponseRequestBody is the type of the "survey" service
+// "update_survey_response" endpoint HTTP request body.
+type UpdateSurveyResponseRequestBody struct {
+	// Answers to the survey questions
+	Answers []*SurveyAnswerRequestBody `form:"answers" json:"answers" xml:"answers"`
+}
+
+// UpdateSurveyInviteSettingsRequestBody is the type of the "survey" service
+// "update_survey_invite_settings" endpoint HTTP request body.
+type UpdateSurveyInviteSettingsRequestBody struct {
+	// Send LFID invites; false turns them off and true turns them back on for a
+	// survey of a disabled project. Omit to inherit.
+	Enabled *bool `form:"enabled,omitempty" json:"enabled,omitempty" xml:"enabled,omitempty"`
+	// Invite service role granted when the invite is accepted. Omit to inherit.
+	Role *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	// Days an invite stays valid. Omit to inherit.
+	ExpirationDays *int `form:"expiration_days,omitempty" json:"expiration_days,omitempty" xml:"expiration_days,omitempty"`
+	// Where the recipient lands after accepting; {base_url}, {survey_uid},
+	// {project_uid} and {locale} are substituted. Omit to inherit.
+	ReturnURLTemplate *string `form:"return_url_template,omitempty" json:"return_url_template,omitempty" xml:"return_url_template,omitempty"`
+	// Survey name used in the invite when the survey has none. Omit to inherit.
+	DisplayNameFallback *string `form:"display_name_fallback,omitempty" json:"display_name_fallback,omitempty" xml:"display_name_fallback,omitempty"`
+	// Recipient language as a BCP 47 tag, substituted for {locale} in the return
+	// URL. Omit to inherit.
+	Locale *string `form:"locale,omitempty" json:"locale,omitempty" xml:"locale,omitempty"`
+}
+
+// UpdateProjectInviteSettingsRequestBody is the type of the "survey" service
+// "update_project_invite_settings" endpoint HTTP request body.
+type UpdateProjectInviteSettingsRequestBody struct {
+	// Send LFID invites; false turns them off and true turns them back on for a
+	// survey of a disabled project. Omit to inherit.
+	Enabled *bool `form:"enabled,omitempty" json:"enabled,omitempty" xml:"enabled,omitempty"`
+	// Invite service role granted when the invite is accepted. Omit to inherit.
+	Role *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	// Days an invite stays valid. Omit to inherit.
+	ExpirationDays *int `form:"expiration_days,omitempty" json:"expiration_days,omitempty" xml:"expiration_days,omitempty"`
+	// Where the recipient lands after accepting; {base_url}, {survey_uid},
+	// {project_uid} and {locale} are substituted. Omit to inherit.
+	ReturnURLTemplate *string `form:"return_url_template,omitempty" json:"return_url_template,omitempty" xml:"return_url_template,omitempty"`
+	// Survey name used in the invite when the survey has none. Omit to inherit.
+	DisplayNameFallback *string `form:"display_name_fallback,omitempty" json:"display_name_fallback,omitempty" xml:"display_name_fallback,omitempty"`
+	// Recipient language as a BCP 47 tag, substituted for {locale} in the return
+	// URL. Omit to inherit.
+	Locale *string `form:"locale,omitempty" json:"locale,omitempty" xml:"locale,omitempty"`
+}
+
+// UpdateSurveySettingsRequestBody is the type of the "survey" service
+// "update_survey_settings" endpoint HTTP request body.
+type UpdateSurveySettingsRequestBody struct {
+	// Let respondents view the survey's aggregate results
+	ShareResults bool `form:"share_results" json:"share_results" xml:"share_results"`
+	// Keep respondent identity and individual answers from organizers; cannot be
+	// turned off once on
+	Anonymous bool `form:"anonymous" json:"anonymous" xml:"anonymous"`
+}
+
 // ValidateEmailRequestBody is the type of the "survey" service
 // "validate_email" endpoint HTTP request body.
 type ValidateEmailRequestBody struct {
@@ -121,6 +187,46 @@ type ValidateEmailRequestBody struct {
 	Subject *string `form:"subject,omitempty" json:"subject,omitempty" xml:"subject,omitempty"`
 }
 
+// StartReindexRequestBody is the type of the "survey" service "start_reindex"
+// endpoint HTTP request body.
+type StartReindexRequestBody struct {
+	// Only replay keys starting with one of these prefixes; each must begin with
+	// itx-surveys, itx-survey-responses, itx-survey-exclusions or
+	// surveymonkey-surveys. Defaults to all of them.
+	KeyPrefixes []string `form:"key_prefixes,omitempty" json:"key_prefixes,omitempty" xml:"key_prefixes,omitempty"`
+	// Only replay entries last written at or after this time (RFC3339)
+	ModifiedSince *string `form:"modified_since,omitempty" json:"modified_since,omitempty" xml:"modified_since,omitempty"`
+	// Only replay entries last written before this time (RFC3339)
+	ModifiedUntil *string `form:"modified_until,omitempty" json:"modified_until,omitempty" xml:"modified_until,omitempty"`
+	// Count matching entries without running the handlers
+	DryRun bool `form:"dry_run" json:"dry_run" xml:"dry_run"`
+	// Republish entries even when their converted content is unchanged since the
+	// last publish
+	Force bool `form:"force" json:"force" xml:"force"`
+	// Maximum entries run through the handlers per second
+	RatePerSecond int `form:"rate_per_second" json:"rate_per_second" xml:"rate_per_second"`
+}
+
+// RerunInviteEnrichmentRequestBody is the type of the "survey" service
+// "rerun_invite_enrichment" endpoint HTTP request body.
+type RerunInviteEnrichmentRequestBody struct {
+	// Email the invite was accepted for
+	Email string `form:"email" json:"email" xml:"email"`
+	// LFX username of the acceptor; looked up by email when omitted
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+}
+
+// StartInviteBackfillRequestBody is the type of the "survey" service
+// "start_invite_backfill" endpoint HTTP request body.
+type StartInviteBackfillRequestBody struct {
+	// Only backfill the responses of these surveys. Defaults to every open survey.
+	SurveyUids []string `form:"survey_uids,omitempty" json:"survey_uids,omitempty" xml:"survey_uids,omitempty"`
+	// Report the responses that would be invited without sending anything
+	DryRun bool `form:"dry_run" json:"dry_run" xml:"dry_run"`
+	// Maximum candidates checked and invited per second
+	RatePerSecond int `form:"rate_per_second" json:"rate_per_second" xml:"rate_per_second"`
+}
+
 // ScheduleSurveyResponseBody is the type of the "survey" service
 // "schedule_survey" endpoint HTTP response body.
 type ScheduleSurveyResponseBody struct {
@@ -408,6 +514,183 @@ type ListSurveyResponsesResponseBody struct {
 	Meta *SurveyResponsePageMetaResponseBody `form:"meta,omitempty" json:"meta,omitempty" xml:"meta,omitempty"`
 }
 
+// ListSurveyInvitesResponseBody is the type of the "survey" service
+// "list_survey_invites" endpoint HTTP response body.
+type ListSurveyInvitesResponseBody struct {
+	// Counts over all invites of the survey, regardless of the status filter
+	Counts *SurveyInviteCountsResponseBody `form:"counts,omitempty" json:"counts,omitempty" xml:"counts,omitempty"`
+	// Invites ordered by email
+	Invites []*SurveyInviteResponseBody `form:"invites,omitempty" json:"invites,omitempty" xml:"invites,omitempty"`
+}
+
+// GetSurveyInviteSettingsResponseBody is the type of the "survey" service
+// "get_survey_invite_settings" endpoint HTTP response body.
+type GetSurveyInviteSettingsResponseBody struct {
+	// What the settings apply to
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Project or survey identifier
+	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
+	// Send LFID invites; false turns them off and true turns them back on for a
+	// survey of a disabled project. Omit to inherit.
+	Enabled *bool `form:"enabled,omitempty" json:"enabled,omitempty" xml:"enabled,omitempty"`
+	// Invite service role granted when the invite is accepted. Omit to inherit.
+	Role *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	// Days an invite stays valid. Omit to inherit.
+	ExpirationDays *int `form:"expiration_days,omitempty" json:"expiration_days,omitempty" xml:"expiration_days,omitempty"`
+	// Where the recipient lands after accepting; {base_url}, {survey_uid},
+	// {project_uid} and {locale} are substituted. Omit to inherit.
+	ReturnURLTemplate *string `form:"return_url_template,omitempty" json:"return_url_template,omitempty" xml:"return_url_template,omitempty"`
+	// Survey name used in the invite when the survey has none. Omit to inherit.
+	DisplayNameFallback *string `form:"display_name_fallback,omitempty" json:"display_name_fallback,omitempty" xml:"display_name_fallback,omitempty"`
+	// Recipient language as a BCP 47 tag, substituted for {locale} in the return
+	// URL. Omit to inherit.
+	Locale *string `form:"locale,omitempty" json:"locale,omitempty" xml:"locale,omitempty"`
+	// When the settings were last changed (RFC3339)
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Who last changed the settings
+	UpdatedBy *string `form:"updated_by,omitempty" json:"updated_by,omitempty" xml:"updated_by,omitempty"`
+}
+
+// UpdateSurveyInviteSettingsResponseBody is the type of the "survey" service
+// "update_survey_invite_settings" endpoint HTTP response body.
+type UpdateSurveyInviteSettingsResponseBody struct {
+	// What the settings apply to
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Project or survey identifier
+	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
+	// Send LFID invites; false turns them off and true turns them back on for a
+	// survey of a disabled project. Omit to inherit.
+	Enabled *bool `form:"enabled,omitempty" json:"enabled,omitempty" xml:"enabled,omitempty"`
+	// Invite service role granted when the invite is accepted. Omit to inherit.
+	Role *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	// Days an invite stays valid. Omit to inherit.
+	ExpirationDays *int `form:"expiration_days,omitempty" json:"expiration_days,omitempty" xml:"expiration_days,omitempty"`
+	// Where the recipient lands after accepting; {base_url}, {survey_uid},
+	// {project_uid} and {locale} are substituted. Omit to inherit.
+	ReturnURLTemplate *string `form:"return_url_template,omitempty" json:"return_url_template,omitempty" xml:"return_url_template,omitempty"`
+	// Survey name used in the invite when the survey has none. Omit to inherit.
+	DisplayNameFallback *string `form:"display_name_fallback,omitempty" json:"display_name_fallback,omitempty" xml:"display_name_fallback,omitempty"`
+	// Recipient language as a BCP 47 tag, substituted for {locale} in the return
+	// URL. Omit to inherit.
+	Locale *string `form:"locale,omitempty" json:"locale,omitempty" xml:"locale,omitempty"`
+	// When the settings were last changed (RFC3339)
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Who last changed the settings
+	UpdatedBy *string `form:"updated_by,omitempty" json:"updated_by,omitempty" xml:"updated_by,omitempty"`
+}
+
+// GetProjectInviteSettingsResponseBody is the type of the "survey" service
+// "get_project_invite_settings" endpoint HTTP response body.
+type GetProjectInviteSettingsResponseBody struct {
+	// What the settings apply to
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Project or survey identifier
+	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
+	// Send LFID invites; false turns them off and true turns them back on for a
+	// survey of a disabled project. Omit to inherit.
+	Enabled *bool `form:"enabled,omitempty" json:"enabled,omitempty" xml:"enabled,omitempty"`
+	// Invite service role granted when the invite is accepted. Omit to inherit.
+	Role *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	// Days an invite stays valid. Omit to inherit.
+	ExpirationDays *int `form:"expiration_days,omitempty" json:"expiration_days,omitempty" xml:"expiration_days,omitempty"`
+	// Where the recipient lands after accepting; {base_url}, {survey_uid},
+	// {project_uid} and {locale} are substituted. Omit to inherit.
+	ReturnURLTemplate *string `form:"return_url_template,omitempty" json:"return_url_template,omitempty" xml:"return_url_template,omitempty"`
+	// Survey name used in the invite when the survey has none. Omit to inherit.
+	DisplayNameFallback *string `form:"display_name_fallback,omitempty" json:"display_name_fallback,omitempty" xml:"display_name_fallback,omitempty"`
+	// Recipient language as a BCP 47 tag, substituted for {locale} in the return
+	// URL. Omit to inherit.
+	Locale *string `form:"locale,omitempty" json:"locale,omitempty" xml:"locale,omitempty"`
+	// When the settings were last changed (RFC3339)
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Who last changed the settings
+	UpdatedBy *string `form:"updated_by,omitempty" json:"updated_by,omitempty" xml:"updated_by,omitempty"`
+}
+
+// UpdateProjectInviteSettingsResponseBody is the type of the "survey" service
+// "update_project_invite_settings" endpoint HTTP response body.
+type UpdateProjectInviteSettingsResponseBody struct {
+	// What the settings apply to
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Project or survey identifier
+	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
+	// Send LFID invites; false turns them off and true turns them back on for a
+	// survey of a disabled project. Omit to inherit.
+	Enabled *bool `form:"enabled,omitempty" json:"enabled,omitempty" xml:"enabled,omitempty"`
+	// Invite service role granted when the invite is accepted. Omit to inherit.
+	Role *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	// Days an invite stays valid. Omit to inherit.
+	ExpirationDays *int `form:"expiration_days,omitempty" json:"expiration_days,omitempty" xml:"expiration_days,omitempty"`
+	// Where the recipient lands after accepting; {base_url}, {survey_uid},
+	// {project_uid} and {locale} are substituted. Omit to inherit.
+	ReturnURLTemplate *string `form:"return_url_template,omitempty" json:"return_url_template,omitempty" xml:"return_url_template,omitempty"`
+	// Survey name used in the invite when the survey has none. Omit to inherit.
+	DisplayNameFallback *string `form:"display_name_fallback,omitempty" json:"display_name_fallback,omitempty" xml:"display_name_fallback,omitempty"`
+	// Recipient language as a BCP 47 tag, substituted for {locale} in the return
+	// URL. Omit to inherit.
+	Locale *string `form:"locale,omitempty" json:"locale,omitempty" xml:"locale,omitempty"`
+	// When the settings were last changed (RFC3339)
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Who last changed the settings
+	UpdatedBy *string `form:"updated_by,omitempty" json:"updated_by,omitempty" xml:"updated_by,omitempty"`
+}
+
+// GetSurveySettingsResponseBody is the type of the "survey" service
+// "get_survey_settings" endpoint HTTP response body.
+type GetSurveySettingsResponseBody struct {
+	// Survey identifier
+	SurveyUID *string `form:"survey_uid,omitempty" json:"survey_uid,omitempty" xml:"survey_uid,omitempty"`
+	// Whether respondents can view the survey's aggregate results
+	ShareResults *bool `form:"share_results,omitempty" json:"share_results,omitempty" xml:"share_results,omitempty"`
+	// Whether responses are kept de-identified from organizers
+	Anonymous *bool `form:"anonymous,omitempty" json:"anonymous,omitempty" xml:"anonymous,omitempty"`
+	// When the settings were last changed (RFC3339); unset until they are first
+	// stored
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Who last changed the settings
+	UpdatedBy *string `form:"updated_by,omitempty" json:"updated_by,omitempty" xml:"updated_by,omitempty"`
+	// results_viewer changes made by this update; only set on update
+	ResultsAccess *ResultsAccessSyncResponseBody `form:"results_access,omitempty" json:"results_access,omitempty" xml:"results_access,omitempty"`
+}
+
+// UpdateSurveySettingsResponseBody is the type of the "survey" service
+// "update_survey_settings" endpoint HTTP response body.
+type UpdateSurveySettingsResponseBody struct {
+	// Survey identifier
+	SurveyUID *string `form:"survey_uid,omitempty" json:"survey_uid,omitempty" xml:"survey_uid,omitempty"`
+	// Whether respondents can view the survey's aggregate results
+	ShareResults *bool `form:"share_results,omitempty" json:"share_results,omitempty" xml:"share_results,omitempty"`
+	// Whether responses are kept de-identified from organizers
+	Anonymous *bool `form:"anonymous,omitempty" json:"anonymous,omitempty" xml:"anonymous,omitempty"`
+	// When the settings were last changed (RFC3339); unset until they are first
+	// stored
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Who last changed the settings
+	UpdatedBy *string `form:"updated_by,omitempty" json:"updated_by,omitempty" xml:"updated_by,omitempty"`
+	// results_viewer changes made by this update; only set on update
+	ResultsAccess *ResultsAccessSyncResponseBody `form:"results_access,omitempty" json:"results_access,omitempty" xml:"results_access,omitempty"`
+}
+
+// GetSurveyResultsResponseBody is the type of the "survey" service
+// "get_survey_results" endpoint HTTP response body.
+type GetSurveyResultsResponseBody struct {
+	// Survey identifier
+	SurveyUID *string `form:"survey_uid,omitempty" json:"survey_uid,omitempty" xml:"survey_uid,omitempty"`
+	// Number of recipients
+	NumRecipients *int `form:"num_recipients,omitempty" json:"num_recipients,omitempty" xml:"num_recipients,omitempty"`
+	// Number of responses
+	NumResponses *int `form:"num_responses,omitempty" json:"num_responses,omitempty" xml:"num_responses,omitempty"`
+	// When the survey closes (RFC3339)
+	SurveyEndTime *string `form:"survey_end_time,omitempty" json:"survey_end_time,omitempty" xml:"survey_end_time,omitempty"`
+	// Answer counts per question
+	Questions []*SurveyQuestionResultResponseBody `form:"questions,omitempty" json:"questions,omitempty" xml:"questions,omitempty"`
+	// Set for anonymous surveys: answers given by fewer respondents are suppressed
+	MinCellSize *int `form:"min_cell_size,omitempty" json:"min_cell_size,omitempty" xml:"min_cell_size,omitempty"`
+	// Set for anonymous surveys with fewer than min_cell_size responses: every
+	// answer is suppressed
+	Suppressed *bool `form:"suppressed,omitempty" json:"suppressed,omitempty" xml:"suppressed,omitempty"`
+}
+
 // ValidateEmailResponseBody is the type of the "survey" service
 // "validate_email" endpoint HTTP response body.
 type ValidateEmailResponseBody struct {
@@ -417,6 +700,338 @@ type ValidateEmailResponseBody struct {
 	Subject *string `form:"subject,omitempty" json:"subject,omitempty" xml:"subject,omitempty"`
 }
 
+// ListSurveyTemplatesResponseBody is the type of the "survey" service
+// "list_survey_templates" endpoint HTTP response body.
+type ListSurveyTemplatesResponseBody struct {
+	// Matching templates
+	Templates []*SurveyTemplateResponseBody `form:"templates,omitempty" json:"templates,omitempty" xml:"templates,omitempty"`
+	// Number of matching templates across all pages
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+	// Pass as offset to fetch the next page; absent on the last page
+	NextOffset *int `form:"next_offset,omitempty" json:"next_offset,omitempty" xml:"next_offset,omitempty"`
+}
+
+// GetSurveyTemplateResponseBody is the type of the "survey" service
+// "get_survey_template" endpoint HTTP response body.
+type GetSurveyTemplateResponseBody struct {
+	// SurveyMonkey survey ID; pass as survey_monkey_id to schedule_survey
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Survey title
+	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+	// Internal nickname
+	Nickname *string `form:"nickname,omitempty" json:"nickname,omitempty" xml:"nickname,omitempty"`
+	// Survey category
+	Category *string `form:"category,omitempty" json:"category,omitempty" xml:"category,omitempty"`
+	// Survey language
+	Language *string `form:"language,omitempty" json:"language,omitempty" xml:"language,omitempty"`
+	// Number of questions
+	QuestionCount *int `form:"question_count,omitempty" json:"question_count,omitempty" xml:"question_count,omitempty"`
+	// Number of pages
+	PageCount *int `form:"page_count,omitempty" json:"page_count,omitempty" xml:"page_count,omitempty"`
+	// URL to preview the survey
+	PreviewURL *string `form:"preview_url,omitempty" json:"preview_url,omitempty" xml:"preview_url,omitempty"`
+	// URL of the survey collectors
+	CollectURL *string `form:"collect_url,omitempty" json:"collect_url,omitempty" xml:"collect_url,omitempty"`
+	// When the survey was created in SurveyMonkey
+	DateCreated *string `form:"date_created,omitempty" json:"date_created,omitempty" xml:"date_created,omitempty"`
+	// When the survey was last modified in SurveyMonkey
+	DateModified *string `form:"date_modified,omitempty" json:"date_modified,omitempty" xml:"date_modified,omitempty"`
+}
+
+// ListDeadLettersResponseBody is the type of the "survey" service
+// "list_dead_letters" endpoint HTTP response body.
+type ListDeadLettersResponseBody struct {
+	// Dead-lettered events
+	Entries []*DeadLetterEntryResponseBody `form:"entries,omitempty" json:"entries,omitempty" xml:"entries,omitempty"`
+	// Pass as after to fetch the next page; absent on the last page
+	NextAfter *uint64 `form:"next_after,omitempty" json:"next_after,omitempty" xml:"next_after,omitempty"`
+}
+
+// GetDeadLetterResponseBody is the type of the "survey" service
+// "get_dead_letter" endpoint HTTP response body.
+type GetDeadLetterResponseBody struct {
+	// Dead-letter stream sequence, used as the entry identifier
+	Sequence *uint64 `form:"sequence,omitempty" json:"sequence,omitempty" xml:"sequence,omitempty"`
+	// Original v1-objects KV key
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Original KV operation
+	Operation *string `form:"operation,omitempty" json:"operation,omitempty" xml:"operation,omitempty"`
+	// KV revision of the original event
+	Revision *uint64 `form:"revision,omitempty" json:"revision,omitempty" xml:"revision,omitempty"`
+	// Why the event was dead-lettered
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Last processing error
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Number of delivery attempts made
+	Attempts *uint64 `form:"attempts,omitempty" json:"attempts,omitempty" xml:"attempts,omitempty"`
+	// When the event was dead-lettered (RFC3339)
+	DeadLetteredAt *string `form:"dead_lettered_at,omitempty" json:"dead_lettered_at,omitempty" xml:"dead_lettered_at,omitempty"`
+	// Original KV value (JSON or msgpack); only returned when inspecting a single
+	// entry
+	Payload []byte `form:"payload,omitempty" json:"payload,omitempty" xml:"payload,omitempty"`
+	// Original message headers; only returned when inspecting a single entry
+	Headers map[string][]string `form:"headers,omitempty" json:"headers,omitempty" xml:"headers,omitempty"`
+}
+
+// ReplayDeadLetterResponseBody is the type of the "survey" service
+// "replay_dead_letter" endpoint HTTP response body.
+type ReplayDeadLetterResponseBody struct {
+	// Dead-letter stream sequence
+	Sequence *uint64 `form:"sequence,omitempty" json:"sequence,omitempty" xml:"sequence,omitempty"`
+	// Original v1-objects KV key
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// replayed: the key's current value, or the delete while the key is gone, was
+	// run through the handlers; superseded or key_deleted: the key has since been
+	// written again or deleted, so the event was dropped without a replay
+	Outcome *string `form:"outcome,omitempty" json:"outcome,omitempty" xml:"outcome,omitempty"`
+	// True when the event was resolved and removed from the dead-letter stream
+	Succeeded *bool `form:"succeeded,omitempty" json:"succeeded,omitempty" xml:"succeeded,omitempty"`
+	// Why the replay failed; the entry is kept for another attempt
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// StartReindexResponseBody is the type of the "survey" service "start_reindex"
+// endpoint HTTP response body.
+type StartReindexResponseBody struct {
+	// Run state
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// True when entries are only counted
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty" xml:"dry_run,omitempty"`
+	// True when unchanged entries are republished
+	Force *bool `form:"force,omitempty" json:"force,omitempty" xml:"force,omitempty"`
+	// Key prefixes the run is limited to; absent when it covers every survey
+	// object type
+	KeyPrefixes []string `form:"key_prefixes,omitempty" json:"key_prefixes,omitempty" xml:"key_prefixes,omitempty"`
+	// Lower bound on the entry write time (RFC3339)
+	ModifiedSince *string `form:"modified_since,omitempty" json:"modified_since,omitempty" xml:"modified_since,omitempty"`
+	// Upper bound on the entry write time (RFC3339)
+	ModifiedUntil *string `form:"modified_until,omitempty" json:"modified_until,omitempty" xml:"modified_until,omitempty"`
+	// Handler rate limit
+	RatePerSecond *int `form:"rate_per_second,omitempty" json:"rate_per_second,omitempty" xml:"rate_per_second,omitempty"`
+	// When the run started (RFC3339)
+	StartedAt *string `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// When the run ended (RFC3339); absent while running
+	FinishedAt *string `form:"finished_at,omitempty" json:"finished_at,omitempty" xml:"finished_at,omitempty"`
+	// Keys under the selected prefixes
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+	// Keys read so far
+	Scanned *int `form:"scanned,omitempty" json:"scanned,omitempty" xml:"scanned,omitempty"`
+	// Scanned keys inside the time range
+	Matched *int `form:"matched,omitempty" json:"matched,omitempty" xml:"matched,omitempty"`
+	// Entries the handlers processed
+	Processed *int `form:"processed,omitempty" json:"processed,omitempty" xml:"processed,omitempty"`
+	// Entries that could not be read or processed
+	Failed *int `form:"failed,omitempty" json:"failed,omitempty" xml:"failed,omitempty"`
+	// First keys that failed (capped at 100)
+	FailedKeys []string `form:"failed_keys,omitempty" json:"failed_keys,omitempty" xml:"failed_keys,omitempty"`
+	// Why the run failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// GetReindexResponseBody is the type of the "survey" service "get_reindex"
+// endpoint HTTP response body.
+type GetReindexResponseBody struct {
+	// Run state
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// True when entries are only counted
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty" xml:"dry_run,omitempty"`
+	// True when unchanged entries are republished
+	Force *bool `form:"force,omitempty" json:"force,omitempty" xml:"force,omitempty"`
+	// Key prefixes the run is limited to; absent when it covers every survey
+	// object type
+	KeyPrefixes []string `form:"key_prefixes,omitempty" json:"key_prefixes,omitempty" xml:"key_prefixes,omitempty"`
+	// Lower bound on the entry write time (RFC3339)
+	ModifiedSince *string `form:"modified_since,omitempty" json:"modified_since,omitempty" xml:"modified_since,omitempty"`
+	// Upper bound on the entry write time (RFC3339)
+	ModifiedUntil *string `form:"modified_until,omitempty" json:"modified_until,omitempty" xml:"modified_until,omitempty"`
+	// Handler rate limit
+	RatePerSecond *int `form:"rate_per_second,omitempty" json:"rate_per_second,omitempty" xml:"rate_per_second,omitempty"`
+	// When the run started (RFC3339)
+	StartedAt *string `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// When the run ended (RFC3339); absent while running
+	FinishedAt *string `form:"finished_at,omitempty" json:"finished_at,omitempty" xml:"finished_at,omitempty"`
+	// Keys under the selected prefixes
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+	// Keys read so far
+	Scanned *int `form:"scanned,omitempty" json:"scanned,omitempty" xml:"scanned,omitempty"`
+	// Scanned keys inside the time range
+	Matched *int `form:"matched,omitempty" json:"matched,omitempty" xml:"matched,omitempty"`
+	// Entries the handlers processed
+	Processed *int `form:"processed,omitempty" json:"processed,omitempty" xml:"processed,omitempty"`
+	// Entries that could not be read or processed
+	Failed *int `form:"failed,omitempty" json:"failed,omitempty" xml:"failed,omitempty"`
+	// First keys that failed (capped at 100)
+	FailedKeys []string `form:"failed_keys,omitempty" json:"failed_keys,omitempty" xml:"failed_keys,omitempty"`
+	// Why the run failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// CancelReindexResponseBody is the type of the "survey" service
+// "cancel_reindex" endpoint HTTP response body.
+type CancelReindexResponseBody struct {
+	// Run state
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// True when entries are only counted
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty" xml:"dry_run,omitempty"`
+	// True when unchanged entries are republished
+	Force *bool `form:"force,omitempty" json:"force,omitempty" xml:"force,omitempty"`
+	// Key prefixes the run is limited to; absent when it covers every survey
+	// object type
+	KeyPrefixes []string `form:"key_prefixes,omitempty" json:"key_prefixes,omitempty" xml:"key_prefixes,omitempty"`
+	// Lower bound on the entry write time (RFC3339)
+	ModifiedSince *string `form:"modified_since,omitempty" json:"modified_since,omitempty" xml:"modified_since,omitempty"`
+	// Upper bound on the entry write time (RFC3339)
+	ModifiedUntil *string `form:"modified_until,omitempty" json:"modified_until,omitempty" xml:"modified_until,omitempty"`
+	// Handler rate limit
+	RatePerSecond *int `form:"rate_per_second,omitempty" json:"rate_per_second,omitempty" xml:"rate_per_second,omitempty"`
+	// When the run started (RFC3339)
+	StartedAt *string `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// When the run ended (RFC3339); absent while running
+	FinishedAt *string `form:"finished_at,omitempty" json:"finished_at,omitempty" xml:"finished_at,omitempty"`
+	// Keys under the selected prefixes
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+	// Keys read so far
+	Scanned *int `form:"scanned,omitempty" json:"scanned,omitempty" xml:"scanned,omitempty"`
+	// Scanned keys inside the time range
+	Matched *int `form:"matched,omitempty" json:"matched,omitempty" xml:"matched,omitempty"`
+	// Entries the handlers processed
+	Processed *int `form:"processed,omitempty" json:"processed,omitempty" xml:"processed,omitempty"`
+	// Entries that could not be read or processed
+	Failed *int `form:"failed,omitempty" json:"failed,omitempty" xml:"failed,omitempty"`
+	// First keys that failed (capped at 100)
+	FailedKeys []string `form:"failed_keys,omitempty" json:"failed_keys,omitempty" xml:"failed_keys,omitempty"`
+	// Why the run failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// RerunInviteEnrichmentResponseBody is the type of the "survey" service
+// "rerun_invite_enrichment" endpoint HTTP response body.
+type RerunInviteEnrichmentResponseBody struct {
+	// Acceptor email
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// LFX username written to the survey responses
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// Dead-lettered invite_accepted events of the email removed after the run
+	DeadLettersCleared *uint64 `form:"dead_letters_cleared,omitempty" json:"dead_letters_cleared,omitempty" xml:"dead_letters_cleared,omitempty"`
+}
+
+// StartInviteBackfillResponseBody is the type of the "survey" service
+// "start_invite_backfill" endpoint HTTP response body.
+type StartInviteBackfillResponseBody struct {
+	// Run state
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// True when candidates are only reported
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty" xml:"dry_run,omitempty"`
+	// Surveys the run is limited to; absent when it covers every open survey
+	SurveyUids []string `form:"survey_uids,omitempty" json:"survey_uids,omitempty" xml:"survey_uids,omitempty"`
+	// Candidate rate limit
+	RatePerSecond *int `form:"rate_per_second,omitempty" json:"rate_per_second,omitempty" xml:"rate_per_second,omitempty"`
+	// When the run started (RFC3339)
+	StartedAt *string `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// When the run ended (RFC3339); absent while running
+	FinishedAt *string `form:"finished_at,omitempty" json:"finished_at,omitempty" xml:"finished_at,omitempty"`
+	// Survey responses listed
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+	// Survey responses read so far
+	Scanned *int `form:"scanned,omitempty" json:"scanned,omitempty" xml:"scanned,omitempty"`
+	// Responses that need an invite
+	Candidates *int `form:"candidates,omitempty" json:"candidates,omitempty" xml:"candidates,omitempty"`
+	// Distinct emails among the candidates; at most this many invites are sent
+	Recipients *int `form:"recipients,omitempty" json:"recipients,omitempty" xml:"recipients,omitempty"`
+	// Candidates sent a new invite
+	Invited *int `form:"invited,omitempty" json:"invited,omitempty" xml:"invited,omitempty"`
+	// Candidates attached to an outstanding invite of their email
+	Attached *int `form:"attached,omitempty" json:"attached,omitempty" xml:"attached,omitempty"`
+	// Candidates whose invite could not be sent
+	Failed *int `form:"failed,omitempty" json:"failed,omitempty" xml:"failed,omitempty"`
+	// Responses passed over, by reason: no_email, has_username, already_invited,
+	// survey_not_open, no_survey_name, invites_disabled, not_synced, has_lfid or
+	// unreadable
+	Skipped map[string]int `form:"skipped,omitempty" json:"skipped,omitempty" xml:"skipped,omitempty"`
+	// First candidates found (capped at 100)
+	CandidateResponseUids []string `form:"candidate_response_uids,omitempty" json:"candidate_response_uids,omitempty" xml:"candidate_response_uids,omitempty"`
+	// Why the run failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// GetInviteBackfillResponseBody is the type of the "survey" service
+// "get_invite_backfill" endpoint HTTP response body.
+type GetInviteBackfillResponseBody struct {
+	// Run state
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// True when candidates are only reported
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty" xml:"dry_run,omitempty"`
+	// Surveys the run is limited to; absent when it covers every open survey
+	SurveyUids []string `form:"survey_uids,omitempty" json:"survey_uids,omitempty" xml:"survey_uids,omitempty"`
+	// Candidate rate limit
+	RatePerSecond *int `form:"rate_per_second,omitempty" json:"rate_per_second,omitempty" xml:"rate_per_second,omitempty"`
+	// When the run started (RFC3339)
+	StartedAt *string `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// When the run ended (RFC3339); absent while running
+	FinishedAt *string `form:"finished_at,omitempty" json:"finished_at,omitempty" xml:"finished_at,omitempty"`
+	// Survey responses listed
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+	// Survey responses read so far
+	Scanned *int `form:"scanned,omitempty" json:"scanned,omitempty" xml:"scanned,omitempty"`
+	// Responses that need an invite
+	Candidates *int `form:"candidates,omitempty" json:"candidates,omitempty" xml:"candidates,omitempty"`
+	// Distinct emails among the candidates; at most this many invites are sent
+	Recipients *int `form:"recipients,omitempty" json:"recipients,omitempty" xml:"recipients,omitempty"`
+	// Candidates sent a new invite
+	Invited *int `form:"invited,omitempty" json:"invited,omitempty" xml:"invited,omitempty"`
+	// Candidates attached to an outstanding invite of their email
+	Attached *int `form:"attached,omitempty" json:"attached,omitempty" xml:"attached,omitempty"`
+	// Candidates whose invite could not be sent
+	Failed *int `form:"failed,omitempty" json:"failed,omitempty" xml:"failed,omitempty"`
+	// Responses passed over, by reason: no_email, has_username, already_invited,
+	// survey_not_open, no_survey_name, invites_disabled, not_synced, has_lfid or
+	// unreadable
+	Skipped map[string]int `form:"skipped,omitempty" json:"skipped,omitempty" xml:"skipped,omitempty"`
+	// First candidates found (capped at 100)
+	CandidateResponseUids []string `form:"candidate_response_uids,omitempty" json:"candidate_response_uids,omitempty" xml:"candidate_response_uids,omitempty"`
+	// Why the run failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// CancelInviteBackfillResponseBody is the type of the "survey" service
+// "cancel_invite_backfill" endpoint HTTP response body.
+type CancelInviteBackfillResponseBody struct {
+	// Run state
+	State *string `form:"state,omitempty" json:"state,omitempty" xml:"state,omitempty"`
+	// True when candidates are only reported
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty" xml:"dry_run,omitempty"`
+	// Surveys the run is limited to; absent when it covers every open survey
+	SurveyUids []string `form:"survey_uids,omitempty" json:"survey_uids,omitempty" xml:"survey_uids,omitempty"`
+	// Candidate rate limit
+	RatePerSecond *int `form:"rate_per_second,omitempty" json:"rate_per_second,omitempty" xml:"rate_per_second,omitempty"`
+	// When the run started (RFC3339)
+	StartedAt *string `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// When the run ended (RFC3339); absent while running
+	FinishedAt *string `form:"finished_at,omitempty" json:"finished_at,omitempty" xml:"finished_at,omitempty"`
+	// Survey responses listed
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+	// Survey responses read so far
+	Scanned *int `form:"scanned,omitempty" json:"scanned,omitempty" xml:"scanned,omitempty"`
+	// Responses that need an invite
+	Candidates *int `form:"candidates,omitempty" json:"candidates,omitempty" xml:"candidates,omitempty"`
+	// Distinct emails among the candidates; at most this many invites are sent
+	Recipients *int `form:"recipients,omitempty" json:"recipients,omitempty" xml:"recipients,omitempty"`
+	// Candidates sent a new invite
+	Invited *int `form:"invited,omitempty" json:"invited,omitempty" xml:"invited,omitempty"`
+	// Candidates attached to an outstanding invite of their email
+	Attached *int `form:"attached,omitempty" json:"attached,omitempty" xml:"attached,omitempty"`
+	// Candidates whose invite could not be sent
+	Failed *int `form:"failed,omitempty" json:"failed,omitempty" xml:"failed,omitempty"`
+	// Responses passed over, by reason: no_email, has_username, already_invited,
+	// survey_not_open, no_survey_name, invites_disabled, not_synced, has_lfid or
+	// unreadable
+	Skipped map[string]int `form:"skipped,omitempty" json:"skipped,omitempty" xml:"skipped,omitempty"`
+	// First candidates found (capped at 100)
+	CandidateResponseUids []string `form:"candidate_response_uids,omitempty" json:"candidate_response_uids,omitempty" xml:"candidate_response_uids,omitempty"`
+	// Why the run failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
 // ScheduleSurveyBadRequestResponseBody is the type of the "survey" service
 // "schedule_survey" endpoint HTTP response body for the "BadRequest" error.
 type ScheduleSurveyBadRequestResponseBody struct {
@@ -424,6 +1039,8 @@ type ScheduleSurveyBadRequestResponseBody struct {
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // ScheduleSurveyForbiddenResponseBody is the type of the "survey" service
@@ -471,6 +1088,8 @@ type GetSurveyBadRequestResponseBody struct {
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // GetSurveyForbiddenResponseBody is the type of the "survey" service
@@ -525,6 +1144,8 @@ type UpdateSurveyBadRequestResponseBody struct {
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // UpdateSurveyForbiddenResponseBody is the type of the "survey" service
@@ -581,6 +1202,8 @@ type DeleteSurveyBadRequestResponseBody struct {
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // DeleteSurveyForbiddenResponseBody is the type of the "survey" service
@@ -637,6 +1260,8 @@ type BulkResendSurveyBadRequestResponseBody struct {
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // BulkResendSurveyForbiddenResponseBody is the type of the "survey" service
@@ -694,6 +1319,8 @@ type PreviewSendSurveyBadRequestResponseBody struct {
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // PreviewSendSurveyForbiddenResponseBody is the type of the "survey" service
@@ -752,6 +1379,8 @@ type SendMissingRecipientsBadRequestResponseBody struct {
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // SendMissingRecipientsForbiddenResponseBody is the type of the "survey"
@@ -812,6 +1441,8 @@ type DeleteSurveyResponseBadRequestResponseBody struct {
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // DeleteSurveyResponseForbiddenResponseBody is the type of the "survey"
@@ -872,6 +1503,8 @@ type ResendSurveyResponseBadRequestResponseBody struct {
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // ResendSurveyResponseForbiddenResponseBody is the type of the "survey"
@@ -932,6 +1565,8 @@ type DeleteRecipientGroupBadRequestResponseBody struct {
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // DeleteRecipientGroupForbiddenResponseBody is the type of the "survey"
@@ -991,6 +1626,8 @@ type CreateExclusionBadRequestResponseBody struct {
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // CreateExclusionForbiddenResponseBody is the type of the "survey" service
@@ -1028,6 +1665,8 @@ type DeleteExclusionBadRequestResponseBody struct {
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // DeleteExclusionForbiddenResponseBody is the type of the "survey" service
@@ -1065,6 +1704,8 @@ type GetExclusionBadRequestResponseBody struct {
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // GetExclusionForbiddenResponseBody is the type of the "survey" service
@@ -1112,6 +1753,8 @@ type DeleteExclusionByIDBadRequestResponseBody struct {
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // DeleteExclusionByIDForbiddenResponseBody is the type of the "survey" service
@@ -1152,6 +1795,8 @@ type ListSurveyResponsesBadRequestResponseBody struct {
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // ListSurveyResponsesForbiddenResponseBody is the type of the "survey" service
@@ -1203,1736 +1848,8518 @@ type ListSurveyResponsesUnauthorizedResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// ValidateEmailBadRequestResponseBody is the type of the "survey" service
-// "validate_email" endpoint HTTP response body for the "BadRequest" error.
-type ValidateEmailBadRequestResponseBody struct {
+// CreateSurveyResponseBadRequestResponseBody is the type of the "survey"
+// service "create_survey_response" endpoint HTTP response body for the
+// "BadRequest" error.
+type CreateSurveyResponseBadRequestResponseBody struct {
 	// HTTP status code
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
-// ValidateEmailForbiddenResponseBody is the type of the "survey" service
-// "validate_email" endpoint HTTP response body for the "Forbidden" error.
-type ValidateEmailForbiddenResponseBody struct {
+// CreateSurveyResponseForbiddenResponseBody is the type of the "survey"
+// service "create_survey_response" endpoint HTTP response body for the
+// "Forbidden" error.
+type CreateSurveyResponseForbiddenResponseBody struct {
 	// HTTP status code
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// ValidateEmailInternalServerErrorResponseBody is the type of the "survey"
-// service "validate_email" endpoint HTTP response body for the
-// "InternalServerError" error.
-type ValidateEmailInternalServerErrorResponseBody struct {
+// CreateSurveyResponseInternalServerErrorResponseBody is the type of the
+// "survey" service "create_survey_response" endpoint HTTP response body for
+// the "InternalServerError" error.
+type CreateSurveyResponseInternalServerErrorResponseBody struct {
 	// HTTP status code
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// ValidateEmailUnauthorizedResponseBody is the type of the "survey" service
-// "validate_email" endpoint HTTP response body for the "Unauthorized" error.
-type ValidateEmailUnauthorizedResponseBody struct {
+// CreateSurveyResponseNotFoundResponseBody is the type of the "survey" service
+// "create_survey_response" endpoint HTTP response body for the "NotFound"
+// error.
+type CreateSurveyResponseNotFoundResponseBody struct {
 	// HTTP status code
 	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
 	// Error message
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// SurveyCommitteeResponseBody is used to define fields on response body types.
-type SurveyCommitteeResponseBody struct {
-	// Committee name
-	CommitteeName *string `form:"committee_name,omitempty" json:"committee_name,omitempty" xml:"committee_name,omitempty"`
-	// Committee UID
-	CommitteeUID *string `form:"committee_uid,omitempty" json:"committee_uid,omitempty" xml:"committee_uid,omitempty"`
-	// Project UID
-	ProjectUID *string `form:"project_uid,omitempty" json:"project_uid,omitempty" xml:"project_uid,omitempty"`
-	// Project name
-	ProjectName *string `form:"project_name,omitempty" json:"project_name,omitempty" xml:"project_name,omitempty"`
-	// Survey URL for this committee
-	SurveyURL *string `form:"survey_url,omitempty" json:"survey_url,omitempty" xml:"survey_url,omitempty"`
-	// Total recipients for this committee
-	TotalRecipients *int `form:"total_recipients,omitempty" json:"total_recipients,omitempty" xml:"total_recipients,omitempty"`
-	// Total responses for this committee
-	TotalResponses *int `form:"total_responses,omitempty" json:"total_responses,omitempty" xml:"total_responses,omitempty"`
-	// NPS value for this committee
-	NpsValue *float64 `form:"nps_value,omitempty" json:"nps_value,omitempty" xml:"nps_value,omitempty"`
+// CreateSurveyResponseServiceUnavailableResponseBody is the type of the
+// "survey" service "create_survey_response" endpoint HTTP response body for
+// the "ServiceUnavailable" error.
+type CreateSurveyResponseServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// LFXProjectResponseBody is used to define fields on response body types.
-type LFXProjectResponseBody struct {
-	// Project ID
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Project name
-	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// Project slug
-	Slug *string `form:"slug,omitempty" json:"slug,omitempty" xml:"slug,omitempty"`
-	// Project status/stage
-	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
-	// Project logo URL
-	LogoURL *string `form:"logo_url,omitempty" json:"logo_url,omitempty" xml:"logo_url,omitempty"`
+// CreateSurveyResponseUnauthorizedResponseBody is the type of the "survey"
+// service "create_survey_response" endpoint HTTP response body for the
+// "Unauthorized" error.
+type CreateSurveyResponseUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// ExcludedCommitteeResponseBody is used to define fields on response body
-// types.
-type ExcludedCommitteeResponseBody struct {
-	// Project UID
-	ProjectUID *string `form:"project_uid,omitempty" json:"project_uid,omitempty" xml:"project_uid,omitempty"`
-	// Project name
-	ProjectName *string `form:"project_name,omitempty" json:"project_name,omitempty" xml:"project_name,omitempty"`
-	// Committee UID
-	CommitteeUID *string `form:"committee_uid,omitempty" json:"committee_uid,omitempty" xml:"committee_uid,omitempty"`
-	// Committee name
-	CommitteeName *string `form:"committee_name,omitempty" json:"committee_name,omitempty" xml:"committee_name,omitempty"`
-	// Committee category
-	CommitteeCategory *string `form:"committee_category,omitempty" json:"committee_category,omitempty" xml:"committee_category,omitempty"`
+// UpdateSurveyResponseBadRequestResponseBody is the type of the "survey"
+// service "update_survey_response" endpoint HTTP response body for the
+// "BadRequest" error.
+type UpdateSurveyResponseBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
-// ITXPreviewRecipientResponseBody is used to define fields on response body
-// types.
-type ITXPreviewRecipientResponseBody struct {
-	// LF user ID
-	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
-	// User full name
-	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// User first name
-	FirstName *string `form:"first_name,omitempty" json:"first_name,omitempty" xml:"first_name,omitempty"`
-	// User last name
-	LastName *string `form:"last_name,omitempty" json:"last_name,omitempty" xml:"last_name,omitempty"`
-	// Linux Foundation ID
-	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
-	// Email address
-	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
-	// Role in committee
-	Role *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+// UpdateSurveyResponseForbiddenResponseBody is the type of the "survey"
+// service "update_survey_response" endpoint HTTP response body for the
+// "Forbidden" error.
+type UpdateSurveyResponseForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// ExclusionUserResponseBody is used to define fields on response body types.
-type ExclusionUserResponseBody struct {
-	// User ID
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Username
-	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
-	// User emails
-	Emails []*UserEmailResponseBody `form:"emails,omitempty" json:"emails,omitempty" xml:"emails,omitempty"`
+// UpdateSurveyResponseInternalServerErrorResponseBody is the type of the
+// "survey" service "update_survey_response" endpoint HTTP response body for
+// the "InternalServerError" error.
+type UpdateSurveyResponseInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// UserEmailResponseBody is used to define fields on response body types.
-type UserEmailResponseBody struct {
-	// Email ID
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Email address
-	EmailAddress *string `form:"email_address,omitempty" json:"email_address,omitempty" xml:"email_address,omitempty"`
-	// Whether this is the primary email
-	IsPrimary *bool `form:"is_primary,omitempty" json:"is_primary,omitempty" xml:"is_primary,omitempty"`
+// UpdateSurveyResponseNotFoundResponseBody is the type of the "survey" service
+// "update_survey_response" endpoint HTTP response body for the "NotFound"
+// error.
+type UpdateSurveyResponseNotFoundResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// SurveyResponseItemResponseBody is used to define fields on response body
-// types.
-type SurveyResponseItemResponseBody struct {
-	// Response identifier
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Survey identifier
-	SurveyUID *string `form:"survey_uid,omitempty" json:"survey_uid,omitempty" xml:"survey_uid,omitempty"`
-	// Personal survey link for this recipient
-	SurveyLink *string `form:"survey_link,omitempty" json:"survey_link,omitempty" xml:"survey_link,omitempty"`
-	// Committee UID (V2)
-	CommitteeUID *string `form:"committee_uid,omitempty" json:"committee_uid,omitempty" xml:"committee_uid,omitempty"`
-	// Recipient email address
-	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
-	// Recipient first name
-	FirstName *string `form:"first_name,omitempty" json:"first_name,omitempty" xml:"first_name,omitempty"`
-	// Recipient last name
-	LastName *string `form:"last_name,omitempty" json:"last_name,omitempty" xml:"last_name,omitempty"`
-	// Linux Foundation username
-	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
-	// Recipient's role in the committee
-	Role *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
-	// Recipient's job title
-	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
-	// Recipient's membership tier
-	MembershipTier *string `form:"membership_tier,omitempty" json:"membership_tier,omitempty" xml:"membership_tier,omitempty"`
-	// Recipient's voting status
-	VotingStatus *string `form:"voting_status,omitempty" json:"voting_status,omitempty" xml:"voting_status,omitempty"`
-	// Recipient's organization
-	Organization *SurveyResponseOrgResponseBody `form:"organization,omitempty" json:"organization,omitempty" xml:"organization,omitempty"`
-	// Project this response belongs to
-	Project *SurveyResponseProjResponseBody `form:"project,omitempty" json:"project,omitempty" xml:"project,omitempty"`
-	// Response delivery/completion status
-	ResponseStatus *string `form:"response_status,omitempty" json:"response_status,omitempty" xml:"response_status,omitempty"`
-	// When the response record was created (RFC3339)
-	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
-	// When the recipient submitted their response (RFC3339)
-	ResponseDatetime *string `form:"response_datetime,omitempty" json:"response_datetime,omitempty" xml:"response_datetime,omitempty"`
-	// Last time a survey email was received (RFC3339)
-	LastReceivedTime *string `form:"last_received_time,omitempty" json:"last_received_time,omitempty" xml:"last_received_time,omitempty"`
-	// Number of automated reminder emails received
-	NumAutomatedRemindersReceived *int `form:"num_automated_reminders_received,omitempty" json:"num_automated_reminders_received,omitempty" xml:"num_automated_reminders_received,omitempty"`
-	// NPS score given by the recipient (0-10)
-	NpsValue *float64 `form:"nps_value,omitempty" json:"nps_value,omitempty" xml:"nps_value,omitempty"`
-	// SurveyMonkey respondent identifier
-	SurveyMonkeyRespondentID *string `form:"survey_monkey_respondent_id,omitempty" json:"survey_monkey_respondent_id,omitempty" xml:"survey_monkey_respondent_id,omitempty"`
-	// Per-question answers submitted by the recipient
-	SurveyMonkeyQuestionAnswers []*SurveyQuestionAnswerResponseBody `form:"survey_monkey_question_answers,omitempty" json:"survey_monkey_question_answers,omitempty" xml:"survey_monkey_question_answers,omitempty"`
-	// SES message identifier
-	SesMessageID *string `form:"ses_message_id,omitempty" json:"ses_message_id,omitempty" xml:"ses_message_id,omitempty"`
-	// Whether SES delivery succeeded
-	SesDeliverySuccessful *bool `form:"ses_delivery_successful,omitempty" json:"ses_delivery_successful,omitempty" xml:"ses_delivery_successful,omitempty"`
-	// SES bounce type (Undetermined, Permanent, Transient)
-	SesBounceType *string `form:"ses_bounce_type,omitempty" json:"ses_bounce_type,omitempty" xml:"ses_bounce_type,omitempty"`
-	// SES bounce subtype
-	SesBounceSubtype *string `form:"ses_bounce_subtype,omitempty" json:"ses_bounce_subtype,omitempty" xml:"ses_bounce_subtype,omitempty"`
-	// SES bounce diagnostic code
-	SesBounceDiagnosticCode *string `form:"ses_bounce_diagnostic_code,omitempty" json:"ses_bounce_diagnostic_code,omitempty" xml:"ses_bounce_diagnostic_code,omitempty"`
-	// Whether a spam complaint was filed
-	SesComplaintExists *bool `form:"ses_complaint_exists,omitempty" json:"ses_complaint_exists,omitempty" xml:"ses_complaint_exists,omitempty"`
-	// SES complaint type
-	SesComplaintType *string `form:"ses_complaint_type,omitempty" json:"ses_complaint_type,omitempty" xml:"ses_complaint_type,omitempty"`
-	// When the SES complaint was filed (RFC3339)
-	SesComplaintDate *string `form:"ses_complaint_date,omitempty" json:"ses_complaint_date,omitempty" xml:"ses_complaint_date,omitempty"`
-	// Whether the recipient opened the survey email
-	SesEmailOpened *bool `form:"ses_email_opened,omitempty" json:"ses_email_opened,omitempty" xml:"ses_email_opened,omitempty"`
-	// Last time the email was opened (RFC3339)
-	SesEmailOpenedLastTime *string `form:"ses_email_opened_last_time,omitempty" json:"ses_email_opened_last_time,omitempty" xml:"ses_email_opened_last_time,omitempty"`
-	// Whether the recipient clicked the survey link
-	SesLinkClicked *bool `form:"ses_link_clicked,omitempty" json:"ses_link_clicked,omitempty" xml:"ses_link_clicked,omitempty"`
-	// Last time the survey link was clicked (RFC3339)
-	SesLinkClickedLastTime *string `form:"ses_link_clicked_last_time,omitempty" json:"ses_link_clicked_last_time,omitempty" xml:"ses_link_clicked_last_time,omitempty"`
+// UpdateSurveyResponseServiceUnavailableResponseBody is the type of the
+// "survey" service "update_survey_response" endpoint HTTP response body for
+// the "ServiceUnavailable" error.
+type UpdateSurveyResponseServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// SurveyResponseOrgResponseBody is used to define fields on response body
-// types.
-type SurveyResponseOrgResponseBody struct {
-	// Organization ID
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Organization name
-	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+// UpdateSurveyResponseUnauthorizedResponseBody is the type of the "survey"
+// service "update_survey_response" endpoint HTTP response body for the
+// "Unauthorized" error.
+type UpdateSurveyResponseUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
-// SurveyResponseProjResponseBody is used to define fields on response body
-// types.
-type SurveyResponseProjResponseBody struct {
-	// Project UID (V2)
-	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
-	// Project name
-	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+// ListSurveyInvitesBadRequestResponseBody is the type of the "survey" service
+// "list_survey_invites" endpoint HTTP response body for the "BadRequest" error.
+type ListSurveyInvitesBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// ListSurveyInvitesForbiddenResponseBody is the type of the "survey" service
+// "list_survey_invites" endpoint HTTP response body for the "Forbidden" error.
+type ListSurveyInvitesForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListSurveyInvitesInternalServerErrorResponseBody is the type of the "survey"
+// service "list_survey_invites" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ListSurveyInvitesInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListSurveyInvitesNotFoundResponseBody is the type of the "survey" service
+// "list_survey_invites" endpoint HTTP response body for the "NotFound" error.
+type ListSurveyInvitesNotFoundResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListSurveyInvitesServiceUnavailableResponseBody is the type of the "survey"
+// service "list_survey_invites" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type ListSurveyInvitesServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListSurveyInvitesUnauthorizedResponseBody is the type of the "survey"
+// service "list_survey_invites" endpoint HTTP response body for the
+// "Unauthorized" error.
+type ListSurveyInvitesUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetSurveyInviteSettingsBadRequestResponseBody is the type of the "survey"
+// service "get_survey_invite_settings" endpoint HTTP response body for the
+// "BadRequest" error.
+type GetSurveyInviteSettingsBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// GetSurveyInviteSettingsForbiddenResponseBody is the type of the "survey"
+// service "get_survey_invite_settings" endpoint HTTP response body for the
+// "Forbidden" error.
+type GetSurveyInviteSettingsForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetSurveyInviteSettingsInternalServerErrorResponseBody is the type of the
+// "survey" service "get_survey_invite_settings" endpoint HTTP response body
+// for the "InternalServerError" error.
+type GetSurveyInviteSettingsInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetSurveyInviteSettingsNotFoundResponseBody is the type of the "survey"
+// service "get_survey_invite_settings" endpoint HTTP response body for the
+// "NotFound" error.
+type GetSurveyInviteSettingsNotFoundResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetSurveyInviteSettingsServiceUnavailableResponseBody is the type of the
+// "survey" service "get_survey_invite_settings" endpoint HTTP response body
+// for the "ServiceUnavailable" error.
+type GetSurveyInviteSettingsServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetSurveyInviteSettingsUnauthorizedResponseBody is the type of the "survey"
+// service "get_survey_invite_settings" endpoint HTTP response body for the
+// "Unauthorized" error.
+type GetSurveyInviteSettingsUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpdateSurveyInviteSettingsBadRequestResponseBody is the type of the "survey"
+// service "update_survey_invite_settings" endpoint HTTP response body for the
+// "BadRequest" error.
+type UpdateSurveyInviteSettingsBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// UpdateSurveyInviteSettingsForbiddenResponseBody is the type of the "survey"
+// service "update_survey_invite_settings" endpoint HTTP response body for the
+// "Forbidden" error.
+type UpdateSurveyInviteSettingsForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpdateSurveyInviteSettingsInternalServerErrorResponseBody is the type of the
+// "survey" service "update_survey_invite_settings" endpoint HTTP response body
+// for the "InternalServerError" error.
+type UpdateSurveyInviteSettingsInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpdateSurveyInviteSettingsServiceUnavailableResponseBody is the type of the
+// "survey" service "update_survey_invite_settings" endpoint HTTP response body
+// for the "ServiceUnavailable" error.
+type UpdateSurveyInviteSettingsServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpdateSurveyInviteSettingsUnauthorizedResponseBody is the type of the
+// "survey" service "update_survey_invite_settings" endpoint HTTP response body
+// for the "Unauthorized" error.
+type UpdateSurveyInviteSettingsUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteSurveyInviteSettingsBadRequestResponseBody is the type of the "survey"
+// service "delete_survey_invite_settings" endpoint HTTP response body for the
+// "BadRequest" error.
+type DeleteSurveyInviteSettingsBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// DeleteSurveyInviteSettingsForbiddenResponseBody is the type of the "survey"
+// service "delete_survey_invite_settings" endpoint HTTP response body for the
+// "Forbidden" error.
+type DeleteSurveyInviteSettingsForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteSurveyInviteSettingsInternalServerErrorResponseBody is the type of the
+// "survey" service "delete_survey_invite_settings" endpoint HTTP response body
+// for the "InternalServerError" error.
+type DeleteSurveyInviteSettingsInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteSurveyInviteSettingsNotFoundResponseBody is the type of the "survey"
+// service "delete_survey_invite_settings" endpoint HTTP response body for the
+// "NotFound" error.
+type DeleteSurveyInviteSettingsNotFoundResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteSurveyInviteSettingsServiceUnavailableResponseBody is the type of the
+// "survey" service "delete_survey_invite_settings" endpoint HTTP response body
+// for the "ServiceUnavailable" error.
+type DeleteSurveyInviteSettingsServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteSurveyInviteSettingsUnauthorizedResponseBody is the type of the
+// "survey" service "delete_survey_invite_settings" endpoint HTTP response body
+// for the "Unauthorized" error.
+type DeleteSurveyInviteSettingsUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetProjectInviteSettingsBadRequestResponseBody is the type of the "survey"
+// service "get_project_invite_settings" endpoint HTTP response body for the
+// "BadRequest" error.
+type GetProjectInviteSettingsBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// GetProjectInviteSettingsForbiddenResponseBody is the type of the "survey"
+// service "get_project_invite_settings" endpoint HTTP response body for the
+// "Forbidden" error.
+type GetProjectInviteSettingsForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetProjectInviteSettingsInternalServerErrorResponseBody is the type of the
+// "survey" service "get_project_invite_settings" endpoint HTTP response body
+// for the "InternalServerError" error.
+type GetProjectInviteSettingsInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetProjectInviteSettingsNotFoundResponseBody is the type of the "survey"
+// service "get_project_invite_settings" endpoint HTTP response body for the
+// "NotFound" error.
+type GetProjectInviteSettingsNotFoundResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetProjectInviteSettingsServiceUnavailableResponseBody is the type of the
+// "survey" service "get_project_invite_settings" endpoint HTTP response body
+// for the "ServiceUnavailable" error.
+type GetProjectInviteSettingsServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetProjectInviteSettingsUnauthorizedResponseBody is the type of the "survey"
+// service "get_project_invite_settings" endpoint HTTP response body for the
+// "Unauthorized" error.
+type GetProjectInviteSettingsUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpdateProjectInviteSettingsBadRequestResponseBody is the type of the
+// "survey" service "update_project_invite_settings" endpoint HTTP response
+// body for the "BadRequest" error.
+type UpdateProjectInviteSettingsBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// UpdateProjectInviteSettingsForbiddenResponseBody is the type of the "survey"
+// service "update_project_invite_settings" endpoint HTTP response body for the
+// "Forbidden" error.
+type UpdateProjectInviteSettingsForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpdateProjectInviteSettingsInternalServerErrorResponseBody is the type of
+// the "survey" service "update_project_invite_settings" endpoint HTTP response
+// body for the "InternalServerError" error.
+type UpdateProjectInviteSettingsInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpdateProjectInviteSettingsServiceUnavailableResponseBody is the type of the
+// "survey" service "update_project_invite_settings" endpoint HTTP response
+// body for the "ServiceUnavailable" error.
+type UpdateProjectInviteSettingsServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpdateProjectInviteSettingsUnauthorizedResponseBody is the type of the
+// "survey" service "update_project_invite_settings" endpoint HTTP response
+// body for the "Unauthorized" error.
+type UpdateProjectInviteSettingsUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteProjectInviteSettingsBadRequestResponseBody is the type of the
+// "survey" service "delete_project_invite_settings" endpoint HTTP response
+// body for the "BadRequest" error.
+type DeleteProjectInviteSettingsBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// DeleteProjectInviteSettingsForbiddenResponseBody is the type of the "survey"
+// service "delete_project_invite_settings" endpoint HTTP response body for the
+// "Forbidden" error.
+type DeleteProjectInviteSettingsForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteProjectInviteSettingsInternalServerErrorResponseBody is the type of
+// the "survey" service "delete_project_invite_settings" endpoint HTTP response
+// body for the "InternalServerError" error.
+type DeleteProjectInviteSettingsInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteProjectInviteSettingsNotFoundResponseBody is the type of the "survey"
+// service "delete_project_invite_settings" endpoint HTTP response body for the
+// "NotFound" error.
+type DeleteProjectInviteSettingsNotFoundResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteProjectInviteSettingsServiceUnavailableResponseBody is the type of the
+// "survey" service "delete_project_invite_settings" endpoint HTTP response
+// body for the "ServiceUnavailable" error.
+type DeleteProjectInviteSettingsServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteProjectInviteSettingsUnauthorizedResponseBody is the type of the
+// "survey" service "delete_project_invite_settings" endpoint HTTP response
+// body for the "Unauthorized" error.
+type DeleteProjectInviteSettingsUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetSurveySettingsBadRequestResponseBody is the type of the "survey" service
+// "get_survey_settings" endpoint HTTP response body for the "BadRequest" error.
+type GetSurveySettingsBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// GetSurveySettingsForbiddenResponseBody is the type of the "survey" service
+// "get_survey_settings" endpoint HTTP response body for the "Forbidden" error.
+type GetSurveySettingsForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetSurveySettingsInternalServerErrorResponseBody is the type of the "survey"
+// service "get_survey_settings" endpoint HTTP response body for the
+// "InternalServerError" error.
+type GetSurveySettingsInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetSurveySettingsServiceUnavailableResponseBody is the type of the "survey"
+// service "get_survey_settings" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type GetSurveySettingsServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetSurveySettingsUnauthorizedResponseBody is the type of the "survey"
+// service "get_survey_settings" endpoint HTTP response body for the
+// "Unauthorized" error.
+type GetSurveySettingsUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpdateSurveySettingsBadRequestResponseBody is the type of the "survey"
+// service "update_survey_settings" endpoint HTTP response body for the
+// "BadRequest" error.
+type UpdateSurveySettingsBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// UpdateSurveySettingsConflictResponseBody is the type of the "survey" service
+// "update_survey_settings" endpoint HTTP response body for the "Conflict"
+// error.
+type UpdateSurveySettingsConflictResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpdateSurveySettingsForbiddenResponseBody is the type of the "survey"
+// service "update_survey_settings" endpoint HTTP response body for the
+// "Forbidden" error.
+type UpdateSurveySettingsForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpdateSurveySettingsInternalServerErrorResponseBody is the type of the
+// "survey" service "update_survey_settings" endpoint HTTP response body for
+// the "InternalServerError" error.
+type UpdateSurveySettingsInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpdateSurveySettingsNotFoundResponseBody is the type of the "survey" service
+// "update_survey_settings" endpoint HTTP response body for the "NotFound"
+// error.
+type UpdateSurveySettingsNotFoundResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpdateSurveySettingsServiceUnavailableResponseBody is the type of the
+// "survey" service "update_survey_settings" endpoint HTTP response body for
+// the "ServiceUnavailable" error.
+type UpdateSurveySettingsServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpdateSurveySettingsUnauthorizedResponseBody is the type of the "survey"
+// service "update_survey_settings" endpoint HTTP response body for the
+// "Unauthorized" error.
+type UpdateSurveySettingsUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetSurveyResultsBadRequestResponseBody is the type of the "survey" service
+// "get_survey_results" endpoint HTTP response body for the "BadRequest" error.
+type GetSurveyResultsBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// GetSurveyResultsForbiddenResponseBody is the type of the "survey" service
+// "get_survey_results" endpoint HTTP response body for the "Forbidden" error.
+type GetSurveyResultsForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetSurveyResultsInternalServerErrorResponseBody is the type of the "survey"
+// service "get_survey_results" endpoint HTTP response body for the
+// "InternalServerError" error.
+type GetSurveyResultsInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetSurveyResultsNotFoundResponseBody is the type of the "survey" service
+// "get_survey_results" endpoint HTTP response body for the "NotFound" error.
+type GetSurveyResultsNotFoundResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetSurveyResultsServiceUnavailableResponseBody is the type of the "survey"
+// service "get_survey_results" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type GetSurveyResultsServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetSurveyResultsUnauthorizedResponseBody is the type of the "survey" service
+// "get_survey_results" endpoint HTTP response body for the "Unauthorized"
+// error.
+type GetSurveyResultsUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ValidateEmailBadRequestResponseBody is the type of the "survey" service
+// "validate_email" endpoint HTTP response body for the "BadRequest" error.
+type ValidateEmailBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// ValidateEmailForbiddenResponseBody is the type of the "survey" service
+// "validate_email" endpoint HTTP response body for the "Forbidden" error.
+type ValidateEmailForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ValidateEmailInternalServerErrorResponseBody is the type of the "survey"
+// service "validate_email" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ValidateEmailInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ValidateEmailUnauthorizedResponseBody is the type of the "survey" service
+// "validate_email" endpoint HTTP response body for the "Unauthorized" error.
+type ValidateEmailUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListSurveyTemplatesBadRequestResponseBody is the type of the "survey"
+// service "list_survey_templates" endpoint HTTP response body for the
+// "BadRequest" error.
+type ListSurveyTemplatesBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// ListSurveyTemplatesForbiddenResponseBody is the type of the "survey" service
+// "list_survey_templates" endpoint HTTP response body for the "Forbidden"
+// error.
+type ListSurveyTemplatesForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListSurveyTemplatesInternalServerErrorResponseBody is the type of the
+// "survey" service "list_survey_templates" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ListSurveyTemplatesInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListSurveyTemplatesServiceUnavailableResponseBody is the type of the
+// "survey" service "list_survey_templates" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type ListSurveyTemplatesServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListSurveyTemplatesUnauthorizedResponseBody is the type of the "survey"
+// service "list_survey_templates" endpoint HTTP response body for the
+// "Unauthorized" error.
+type ListSurveyTemplatesUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetSurveyTemplateBadRequestResponseBody is the type of the "survey" service
+// "get_survey_template" endpoint HTTP response body for the "BadRequest" error.
+type GetSurveyTemplateBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// GetSurveyTemplateForbiddenResponseBody is the type of the "survey" service
+// "get_survey_template" endpoint HTTP response body for the "Forbidden" error.
+type GetSurveyTemplateForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetSurveyTemplateInternalServerErrorResponseBody is the type of the "survey"
+// service "get_survey_template" endpoint HTTP response body for the
+// "InternalServerError" error.
+type GetSurveyTemplateInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetSurveyTemplateNotFoundResponseBody is the type of the "survey" service
+// "get_survey_template" endpoint HTTP response body for the "NotFound" error.
+type GetSurveyTemplateNotFoundResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetSurveyTemplateServiceUnavailableResponseBody is the type of the "survey"
+// service "get_survey_template" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type GetSurveyTemplateServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetSurveyTemplateUnauthorizedResponseBody is the type of the "survey"
+// service "get_survey_template" endpoint HTTP response body for the
+// "Unauthorized" error.
+type GetSurveyTemplateUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListDeadLettersBadRequestResponseBody is the type of the "survey" service
+// "list_dead_letters" endpoint HTTP response body for the "BadRequest" error.
+type ListDeadLettersBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// ListDeadLettersForbiddenResponseBody is the type of the "survey" service
+// "list_dead_letters" endpoint HTTP response body for the "Forbidden" error.
+type ListDeadLettersForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListDeadLettersInternalServerErrorResponseBody is the type of the "survey"
+// service "list_dead_letters" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ListDeadLettersInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListDeadLettersServiceUnavailableResponseBody is the type of the "survey"
+// service "list_dead_letters" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type ListDeadLettersServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListDeadLettersUnauthorizedResponseBody is the type of the "survey" service
+// "list_dead_letters" endpoint HTTP response body for the "Unauthorized" error.
+type ListDeadLettersUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetDeadLetterBadRequestResponseBody is the type of the "survey" service
+// "get_dead_letter" endpoint HTTP response body for the "BadRequest" error.
+type GetDeadLetterBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// GetDeadLetterForbiddenResponseBody is the type of the "survey" service
+// "get_dead_letter" endpoint HTTP response body for the "Forbidden" error.
+type GetDeadLetterForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetDeadLetterInternalServerErrorResponseBody is the type of the "survey"
+// service "get_dead_letter" endpoint HTTP response body for the
+// "InternalServerError" error.
+type GetDeadLetterInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetDeadLetterNotFoundResponseBody is the type of the "survey" service
+// "get_dead_letter" endpoint HTTP response body for the "NotFound" error.
+type GetDeadLetterNotFoundResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetDeadLetterServiceUnavailableResponseBody is the type of the "survey"
+// service "get_dead_letter" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type GetDeadLetterServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetDeadLetterUnauthorizedResponseBody is the type of the "survey" service
+// "get_dead_letter" endpoint HTTP response body for the "Unauthorized" error.
+type GetDeadLetterUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ReplayDeadLetterBadRequestResponseBody is the type of the "survey" service
+// "replay_dead_letter" endpoint HTTP response body for the "BadRequest" error.
+type ReplayDeadLetterBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// ReplayDeadLetterForbiddenResponseBody is the type of the "survey" service
+// "replay_dead_letter" endpoint HTTP response body for the "Forbidden" error.
+type ReplayDeadLetterForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ReplayDeadLetterInternalServerErrorResponseBody is the type of the "survey"
+// service "replay_dead_letter" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ReplayDeadLetterInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ReplayDeadLetterNotFoundResponseBody is the type of the "survey" service
+// "replay_dead_letter" endpoint HTTP response body for the "NotFound" error.
+type ReplayDeadLetterNotFoundResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ReplayDeadLetterServiceUnavailableResponseBody is the type of the "survey"
+// service "replay_dead_letter" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type ReplayDeadLetterServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ReplayDeadLetterUnauthorizedResponseBody is the type of the "survey" service
+// "replay_dead_letter" endpoint HTTP response body for the "Unauthorized"
+// error.
+type ReplayDeadLetterUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// StartReindexBadRequestResponseBody is the type of the "survey" service
+// "start_reindex" endpoint HTTP response body for the "BadRequest" error.
+type StartReindexBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// StartReindexConflictResponseBody is the type of the "survey" service
+// "start_reindex" endpoint HTTP response body for the "Conflict" error.
+type StartReindexConflictResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// StartReindexForbiddenResponseBody is the type of the "survey" service
+// "start_reindex" endpoint HTTP response body for the "Forbidden" error.
+type StartReindexForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// StartReindexInternalServerErrorResponseBody is the type of the "survey"
+// service "start_reindex" endpoint HTTP response body for the
+// "InternalServerError" error.
+type StartReindexInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// StartReindexServiceUnavailableResponseBody is the type of the "survey"
+// service "start_reindex" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type StartReindexServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// StartReindexUnauthorizedResponseBody is the type of the "survey" service
+// "start_reindex" endpoint HTTP response body for the "Unauthorized" error.
+type StartReindexUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetReindexBadRequestResponseBody is the type of the "survey" service
+// "get_reindex" endpoint HTTP response body for the "BadRequest" error.
+type GetReindexBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// GetReindexForbiddenResponseBody is the type of the "survey" service
+// "get_reindex" endpoint HTTP response body for the "Forbidden" error.
+type GetReindexForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetReindexInternalServerErrorResponseBody is the type of the "survey"
+// service "get_reindex" endpoint HTTP response body for the
+// "InternalServerError" error.
+type GetReindexInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetReindexNotFoundResponseBody is the type of the "survey" service
+// "get_reindex" endpoint HTTP response body for the "NotFound" error.
+type GetReindexNotFoundResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetReindexServiceUnavailableResponseBody is the type of the "survey" service
+// "get_reindex" endpoint HTTP response body for the "ServiceUnavailable" error.
+type GetReindexServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetReindexUnauthorizedResponseBody is the type of the "survey" service
+// "get_reindex" endpoint HTTP response body for the "Unauthorized" error.
+type GetReindexUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CancelReindexBadRequestResponseBody is the type of the "survey" service
+// "cancel_reindex" endpoint HTTP response body for the "BadRequest" error.
+type CancelReindexBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// CancelReindexConflictResponseBody is the type of the "survey" service
+// "cancel_reindex" endpoint HTTP response body for the "Conflict" error.
+type CancelReindexConflictResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CancelReindexForbiddenResponseBody is the type of the "survey" service
+// "cancel_reindex" endpoint HTTP response body for the "Forbidden" error.
+type CancelReindexForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CancelReindexInternalServerErrorResponseBody is the type of the "survey"
+// service "cancel_reindex" endpoint HTTP response body for the
+// "InternalServerError" error.
+type CancelReindexInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CancelReindexServiceUnavailableResponseBody is the type of the "survey"
+// service "cancel_reindex" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type CancelReindexServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CancelReindexUnauthorizedResponseBody is the type of the "survey" service
+// "cancel_reindex" endpoint HTTP response body for the "Unauthorized" error.
+type CancelReindexUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RerunInviteEnrichmentBadRequestResponseBody is the type of the "survey"
+// service "rerun_invite_enrichment" endpoint HTTP response body for the
+// "BadRequest" error.
+type RerunInviteEnrichmentBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// RerunInviteEnrichmentForbiddenResponseBody is the type of the "survey"
+// service "rerun_invite_enrichment" endpoint HTTP response body for the
+// "Forbidden" error.
+type RerunInviteEnrichmentForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RerunInviteEnrichmentInternalServerErrorResponseBody is the type of the
+// "survey" service "rerun_invite_enrichment" endpoint HTTP response body for
+// the "InternalServerError" error.
+type RerunInviteEnrichmentInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RerunInviteEnrichmentNotFoundResponseBody is the type of the "survey"
+// service "rerun_invite_enrichment" endpoint HTTP response body for the
+// "NotFound" error.
+type RerunInviteEnrichmentNotFoundResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RerunInviteEnrichmentServiceUnavailableResponseBody is the type of the
+// "survey" service "rerun_invite_enrichment" endpoint HTTP response body for
+// the "ServiceUnavailable" error.
+type RerunInviteEnrichmentServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RerunInviteEnrichmentUnauthorizedResponseBody is the type of the "survey"
+// service "rerun_invite_enrichment" endpoint HTTP response body for the
+// "Unauthorized" error.
+type RerunInviteEnrichmentUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// StartInviteBackfillBadRequestResponseBody is the type of the "survey"
+// service "start_invite_backfill" endpoint HTTP response body for the
+// "BadRequest" error.
+type StartInviteBackfillBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// StartInviteBackfillConflictResponseBody is the type of the "survey" service
+// "start_invite_backfill" endpoint HTTP response body for the "Conflict" error.
+type StartInviteBackfillConflictResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// StartInviteBackfillForbiddenResponseBody is the type of the "survey" service
+// "start_invite_backfill" endpoint HTTP response body for the "Forbidden"
+// error.
+type StartInviteBackfillForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// StartInviteBackfillInternalServerErrorResponseBody is the type of the
+// "survey" service "start_invite_backfill" endpoint HTTP response body for the
+// "InternalServerError" error.
+type StartInviteBackfillInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// StartInviteBackfillServiceUnavailableResponseBody is the type of the
+// "survey" service "start_invite_backfill" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type StartInviteBackfillServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// StartInviteBackfillUnauthorizedResponseBody is the type of the "survey"
+// service "start_invite_backfill" endpoint HTTP response body for the
+// "Unauthorized" error.
+type StartInviteBackfillUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetInviteBackfillBadRequestResponseBody is the type of the "survey" service
+// "get_invite_backfill" endpoint HTTP response body for the "BadRequest" error.
+type GetInviteBackfillBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// GetInviteBackfillForbiddenResponseBody is the type of the "survey" service
+// "get_invite_backfill" endpoint HTTP response body for the "Forbidden" error.
+type GetInviteBackfillForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetInviteBackfillInternalServerErrorResponseBody is the type of the "survey"
+// service "get_invite_backfill" endpoint HTTP response body for the
+// "InternalServerError" error.
+type GetInviteBackfillInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetInviteBackfillNotFoundResponseBody is the type of the "survey" service
+// "get_invite_backfill" endpoint HTTP response body for the "NotFound" error.
+type GetInviteBackfillNotFoundResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetInviteBackfillServiceUnavailableResponseBody is the type of the "survey"
+// service "get_invite_backfill" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type GetInviteBackfillServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetInviteBackfillUnauthorizedResponseBody is the type of the "survey"
+// service "get_invite_backfill" endpoint HTTP response body for the
+// "Unauthorized" error.
+type GetInviteBackfillUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CancelInviteBackfillBadRequestResponseBody is the type of the "survey"
+// service "cancel_invite_backfill" endpoint HTTP response body for the
+// "BadRequest" error.
+type CancelInviteBackfillBadRequestResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// CancelInviteBackfillConflictResponseBody is the type of the "survey" service
+// "cancel_invite_backfill" endpoint HTTP response body for the "Conflict"
+// error.
+type CancelInviteBackfillConflictResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CancelInviteBackfillForbiddenResponseBody is the type of the "survey"
+// service "cancel_invite_backfill" endpoint HTTP response body for the
+// "Forbidden" error.
+type CancelInviteBackfillForbiddenResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CancelInviteBackfillInternalServerErrorResponseBody is the type of the
+// "survey" service "cancel_invite_backfill" endpoint HTTP response body for
+// the "InternalServerError" error.
+type CancelInviteBackfillInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CancelInviteBackfillServiceUnavailableResponseBody is the type of the
+// "survey" service "cancel_invite_backfill" endpoint HTTP response body for
+// the "ServiceUnavailable" error.
+type CancelInviteBackfillServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CancelInviteBackfillUnauthorizedResponseBody is the type of the "survey"
+// service "cancel_invite_backfill" endpoint HTTP response body for the
+// "Unauthorized" error.
+type CancelInviteBackfillUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// SurveyCommitteeResponseBody is used to define fields on response body types.
+type SurveyCommitteeResponseBody struct {
+	// Committee name
+	CommitteeName *string `form:"committee_name,omitempty" json:"committee_name,omitempty" xml:"committee_name,omitempty"`
+	// Committee UID
+	CommitteeUID *string `form:"committee_uid,omitempty" json:"committee_uid,omitempty" xml:"committee_uid,omitempty"`
+	// Project UID
+	ProjectUID *string `form:"project_uid,omitempty" json:"project_uid,omitempty" xml:"project_uid,omitempty"`
+	// Project name
+	ProjectName *string `form:"project_name,omitempty" json:"project_name,omitempty" xml:"project_name,omitempty"`
+	// Survey URL for this committee
+	SurveyURL *string `form:"survey_url,omitempty" json:"survey_url,omitempty" xml:"survey_url,omitempty"`
+	// Total recipients for this committee
+	TotalRecipients *int `form:"total_recipients,omitempty" json:"total_recipients,omitempty" xml:"total_recipients,omitempty"`
+	// Total responses for this committee
+	TotalResponses *int `form:"total_responses,omitempty" json:"total_responses,omitempty" xml:"total_responses,omitempty"`
+	// NPS value for this committee
+	NpsValue *float64 `form:"nps_value,omitempty" json:"nps_value,omitempty" xml:"nps_value,omitempty"`
+}
+
+// QuestionErrorResponseBody is used to define fields on response body types.
+type QuestionErrorResponseBody struct {
+	// SurveyMonkey question ID
+	QuestionID *string `form:"question_id,omitempty" json:"question_id,omitempty" xml:"question_id,omitempty"`
+	// Why the answer was rejected
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// LFXProjectResponseBody is used to define fields on response body types.
+type LFXProjectResponseBody struct {
+	// Project ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Project name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Project slug
+	Slug *string `form:"slug,omitempty" json:"slug,omitempty" xml:"slug,omitempty"`
+	// Project status/stage
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Project logo URL
+	LogoURL *string `form:"logo_url,omitempty" json:"logo_url,omitempty" xml:"logo_url,omitempty"`
+}
+
+// ExcludedCommitteeResponseBody is used to define fields on response body
+// types.
+type ExcludedCommitteeResponseBody struct {
+	// Project UID
+	ProjectUID *string `form:"project_uid,omitempty" json:"project_uid,omitempty" xml:"project_uid,omitempty"`
+	// Project name
+	ProjectName *string `form:"project_name,omitempty" json:"project_name,omitempty" xml:"project_name,omitempty"`
+	// Committee UID
+	CommitteeUID *string `form:"committee_uid,omitempty" json:"committee_uid,omitempty" xml:"committee_uid,omitempty"`
+	// Committee name
+	CommitteeName *string `form:"committee_name,omitempty" json:"committee_name,omitempty" xml:"committee_name,omitempty"`
+	// Committee category
+	CommitteeCategory *string `form:"committee_category,omitempty" json:"committee_category,omitempty" xml:"committee_category,omitempty"`
+}
+
+// ITXPreviewRecipientResponseBody is used to define fields on response body
+// types.
+type ITXPreviewRecipientResponseBody struct {
+	// LF user ID
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// User full name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// User first name
+	FirstName *string `form:"first_name,omitempty" json:"first_name,omitempty" xml:"first_name,omitempty"`
+	// User last name
+	LastName *string `form:"last_name,omitempty" json:"last_name,omitempty" xml:"last_name,omitempty"`
+	// Linux Foundation ID
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// Email address
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// Role in committee
+	Role *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+}
+
+// ExclusionUserResponseBody is used to define fields on response body types.
+type ExclusionUserResponseBody struct {
+	// User ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Username
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// User emails
+	Emails []*UserEmailResponseBody `form:"emails,omitempty" json:"emails,omitempty" xml:"emails,omitempty"`
+}
+
+// UserEmailResponseBody is used to define fields on response body types.
+type UserEmailResponseBody struct {
+	// Email ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Email address
+	EmailAddress *string `form:"email_address,omitempty" json:"email_address,omitempty" xml:"email_address,omitempty"`
+	// Whether this is the primary email
+	IsPrimary *bool `form:"is_primary,omitempty" json:"is_primary,omitempty" xml:"is_primary,omitempty"`
+}
+
+// SurveyResponseItemResponseBody is used to define fields on response body
+// types.
+type SurveyResponseItemResponseBody struct {
+	// Response identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Survey identifier
+	SurveyUID *string `form:"survey_uid,omitempty" json:"survey_uid,omitempty" xml:"survey_uid,omitempty"`
+	// Personal survey link for this recipient
+	SurveyLink *string `form:"survey_link,omitempty" json:"survey_link,omitempty" xml:"survey_link,omitempty"`
+	// Committee UID (V2)
+	CommitteeUID *string `form:"committee_uid,omitempty" json:"committee_uid,omitempty" xml:"committee_uid,omitempty"`
+	// Recipient email address
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// Recipient first name
+	FirstName *string `form:"first_name,omitempty" json:"first_name,omitempty" xml:"first_name,omitempty"`
+	// Recipient last name
+	LastName *string `form:"last_name,omitempty" json:"last_name,omitempty" xml:"last_name,omitempty"`
+	// Linux Foundation username
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// Recipient's role in the committee
+	Role *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	// Recipient's job title
+	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
+	// Recipient's membership tier
+	MembershipTier *string `form:"membership_tier,omitempty" json:"membership_tier,omitempty" xml:"membership_tier,omitempty"`
+	// Recipient's voting status
+	VotingStatus *string `form:"voting_status,omitempty" json:"voting_status,omitempty" xml:"voting_status,omitempty"`
+	// Recipient's organization
+	Organization *SurveyResponseOrgResponseBody `form:"organization,omitempty" json:"organization,omitempty" xml:"organization,omitempty"`
+	// Project this response belongs to
+	Project *SurveyResponseProjResponseBody `form:"project,omitempty" json:"project,omitempty" xml:"project,omitempty"`
+	// Response delivery/completion status
+	ResponseStatus *string `form:"response_status,omitempty" json:"response_status,omitempty" xml:"response_status,omitempty"`
+	// When the response record was created (RFC3339)
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// When the recipient submitted their response (RFC3339)
+	ResponseDatetime *string `form:"response_datetime,omitempty" json:"response_datetime,omitempty" xml:"response_datetime,omitempty"`
+	// Last time a survey email was received (RFC3339)
+	LastReceivedTime *string `form:"last_received_time,omitempty" json:"last_received_time,omitempty" xml:"last_received_time,omitempty"`
+	// Number of automated reminder emails received
+	NumAutomatedRemindersReceived *int `form:"num_automated_reminders_received,omitempty" json:"num_automated_reminders_received,omitempty" xml:"num_automated_reminders_received,omitempty"`
+	// NPS score given by the recipient (0-10)
+	NpsValue *float64 `form:"nps_value,omitempty" json:"nps_value,omitempty" xml:"nps_value,omitempty"`
+	// SurveyMonkey respondent identifier
+	SurveyMonkeyRespondentID *string `form:"survey_monkey_respondent_id,omitempty" json:"survey_monkey_respondent_id,omitempty" xml:"survey_monkey_respondent_id,omitempty"`
+	// Per-question answers submitted by the recipient
+	SurveyMonkeyQuestionAnswers []*SurveyQuestionAnswerResponseBody `form:"survey_monkey_question_answers,omitempty" json:"survey_monkey_question_answers,omitempty" xml:"survey_monkey_question_answers,omitempty"`
+	// SES message identifier
+	SesMessageID *string `form:"ses_message_id,omitempty" json:"ses_message_id,omitempty" xml:"ses_message_id,omitempty"`
+	// Whether SES delivery succeeded
+	SesDeliverySuccessful *bool `form:"ses_delivery_successful,omitempty" json:"ses_delivery_successful,omitempty" xml:"ses_delivery_successful,omitempty"`
+	// SES bounce type (Undetermined, Permanent, Transient)
+	SesBounceType *string `form:"ses_bounce_type,omitempty" json:"ses_bounce_type,omitempty" xml:"ses_bounce_type,omitempty"`
+	// SES bounce subtype
+	SesBounceSubtype *string `form:"ses_bounce_subtype,omitempty" json:"ses_bounce_subtype,omitempty" xml:"ses_bounce_subtype,omitempty"`
+	// SES bounce diagnostic code
+	SesBounceDiagnosticCode *string `form:"ses_bounce_diagnostic_code,omitempty" json:"ses_bounce_diagnostic_code,omitempty" xml:"ses_bounce_diagnostic_code,omitempty"`
+	// Whether a spam complaint was filed
+	SesComplaintExists *bool `form:"ses_complaint_exists,omitempty" json:"ses_complaint_exists,omitempty" xml:"ses_complaint_exists,omitempty"`
+	// SES complaint type
+	SesComplaintType *string `form:"ses_complaint_type,omitempty" json:"ses_complaint_type,omitempty" xml:"ses_complaint_type,omitempty"`
+	// When the SES complaint was filed (RFC3339)
+	SesComplaintDate *string `form:"ses_complaint_date,omitempty" json:"ses_complaint_date,omitempty" xml:"ses_complaint_date,omitempty"`
+	// Whether the recipient opened the survey email
+	SesEmailOpened *bool `form:"ses_email_opened,omitempty" json:"ses_email_opened,omitempty" xml:"ses_email_opened,omitempty"`
+	// Last time the email was opened (RFC3339)
+	SesEmailOpenedLastTime *string `form:"ses_email_opened_last_time,omitempty" json:"ses_email_opened_last_time,omitempty" xml:"ses_email_opened_last_time,omitempty"`
+	// Whether the recipient clicked the survey link
+	SesLinkClicked *bool `form:"ses_link_clicked,omitempty" json:"ses_link_clicked,omitempty" xml:"ses_link_clicked,omitempty"`
+	// Last time the survey link was clicked (RFC3339)
+	SesLinkClickedLastTime *string `form:"ses_link_clicked_last_time,omitempty" json:"ses_link_clicked_last_time,omitempty" xml:"ses_link_clicked_last_time,omitempty"`
+}
+
+// SurveyResponseOrgResponseBody is used to define fields on response body
+// types.
+type SurveyResponseOrgResponseBody struct {
+	// Organization ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Organization name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+}
+
+// SurveyResponseProjResponseBody is used to define fields on response body
+// types.
+type SurveyResponseProjResponseBody struct {
+	// Project UID (V2)
+	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
+	// Project name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+}
+
+// SurveyQuestionAnswerResponseBody is used to define fields on response body
+// types.
+type SurveyQuestionAnswerResponseBody struct {
+	// Question identifier
+	QuestionID *string `form:"question_id,omitempty" json:"question_id,omitempty" xml:"question_id,omitempty"`
+	// Question text as shown to the recipient
+	QuestionText *string `form:"question_text,omitempty" json:"question_text,omitempty" xml:"question_text,omitempty"`
+	// Question type family (e.g. rating, open_ended, single_choice)
+	QuestionFamily *string `form:"question_family,omitempty" json:"question_family,omitempty" xml:"question_family,omitempty"`
+	// Question subtype within the family
+	QuestionSubtype *string `form:"question_subtype,omitempty" json:"question_subtype,omitempty" xml:"question_subtype,omitempty"`
+	// Answers selected or entered by the recipient
+	Answers []*SurveyAnswerChoiceResponseBody `form:"answers,omitempty" json:"answers,omitempty" xml:"answers,omitempty"`
+}
+
+// SurveyAnswerChoiceResponseBody is used to define fields on response body
+// types.
+type SurveyAnswerChoiceResponseBody struct {
+	// Choice identifier (for multiple-choice questions)
+	ChoiceID *string `form:"choice_id,omitempty" json:"choice_id,omitempty" xml:"choice_id,omitempty"`
+	// Answer text (for open-ended questions or choice label)
+	Text *string `form:"text,omitempty" json:"text,omitempty" xml:"text,omitempty"`
+}
+
+// SurveyResponsePageMetaResponseBody is used to define fields on response body
+// types.
+type SurveyResponsePageMetaResponseBody struct {
+	// Opaque token for the next page; empty string on the last page
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty" xml:"page_token,omitempty"`
+	// Total number of pages
+	TotalPages *int `form:"total_pages,omitempty" json:"total_pages,omitempty" xml:"total_pages,omitempty"`
+	// Total number of responses across all pages
+	TotalResults *int `form:"total_results,omitempty" json:"total_results,omitempty" xml:"total_results,omitempty"`
+	// Number of results per page
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty" xml:"per_page,omitempty"`
+}
+
+// SurveyAnswerRequestBody is used to define fields on request body types.
+type SurveyAnswerRequestBody struct {
+	// SurveyMonkey question ID
+	QuestionID string `form:"question_id" json:"question_id" xml:"question_id"`
+	// Text answer for open-ended, demographic and date questions
+	AnswerText *string `form:"answer_text,omitempty" json:"answer_text,omitempty" xml:"answer_text,omitempty"`
+	// Selected choice IDs for choice and matrix questions
+	ChoiceIds []string `form:"choice_ids,omitempty" json:"choice_ids,omitempty" xml:"choice_ids,omitempty"`
+	// Rating for rating questions
+	RatingValue *int `form:"rating_value,omitempty" json:"rating_value,omitempty" xml:"rating_value,omitempty"`
+	// Answer for single choice questions with two choices
+	YesNoValue *bool `form:"yes_no_value,omitempty" json:"yes_no_value,omitempty" xml:"yes_no_value,omitempty"`
+}
+
+// SurveyInviteCountsResponseBody is used to define fields on response body
+// types.
+type SurveyInviteCountsResponseBody struct {
+	// All invites
+	Total *int `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+	// Invites interrupted before the invite service replied
+	Pending *int `form:"pending,omitempty" json:"pending,omitempty" xml:"pending,omitempty"`
+	// Invites awaiting acceptance
+	Sent *int `form:"sent,omitempty" json:"sent,omitempty" xml:"sent,omitempty"`
+	// Invites the invite service rejected or did not answer
+	Failed *int `form:"failed,omitempty" json:"failed,omitempty" xml:"failed,omitempty"`
+	// Accepted invites
+	Accepted *int `form:"accepted,omitempty" json:"accepted,omitempty" xml:"accepted,omitempty"`
+	// Sent invites past their expiry
+	Expired *int `form:"expired,omitempty" json:"expired,omitempty" xml:"expired,omitempty"`
+}
+
+// SurveyInviteResponseBody is used to define fields on response body types.
+type SurveyInviteResponseBody struct {
+	// Survey response the invite was sent for
+	SurveyResponseUID *string `form:"survey_response_uid,omitempty" json:"survey_response_uid,omitempty" xml:"survey_response_uid,omitempty"`
+	// Recipient email
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// Recipient name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Invite status; sent invites past their expiry are reported as expired
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Invite service invite UID
+	InviteUID *string `form:"invite_uid,omitempty" json:"invite_uid,omitempty" xml:"invite_uid,omitempty"`
+	// Why the last send failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// When the invite was first attempted (RFC3339)
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// When the invite service accepted the invite (RFC3339)
+	SentAt *string `form:"sent_at,omitempty" json:"sent_at,omitempty" xml:"sent_at,omitempty"`
+	// When the last send failed (RFC3339)
+	FailedAt *string `form:"failed_at,omitempty" json:"failed_at,omitempty" xml:"failed_at,omitempty"`
+	// When the invite expires (RFC3339)
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+	// When the invite was accepted (RFC3339)
+	AcceptedAt *string `form:"accepted_at,omitempty" json:"accepted_at,omitempty" xml:"accepted_at,omitempty"`
+	// LFX username of the acceptor
+	AcceptedBy *string `form:"accepted_by,omitempty" json:"accepted_by,omitempty" xml:"accepted_by,omitempty"`
+	// Times the invite was re-sent after expiring unaccepted
+	Reinvites *int `form:"reinvites,omitempty" json:"reinvites,omitempty" xml:"reinvites,omitempty"`
+	// Every send of the invite, oldest first
+	Attempts []*InviteAttemptResponseBody `form:"attempts,omitempty" json:"attempts,omitempty" xml:"attempts,omitempty"`
+	// Survey response whose invite the recipient shares instead of receiving a
+	// separate one
+	AttachedTo *string `form:"attached_to,omitempty" json:"attached_to,omitempty" xml:"attached_to,omitempty"`
+	// When the invite state last changed (RFC3339)
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// InviteAttemptResponseBody is used to define fields on response body types.
+type InviteAttemptResponseBody struct {
+	// When the send was attempted (RFC3339)
+	At *string `form:"at,omitempty" json:"at,omitempty" xml:"at,omitempty"`
+	// Invite UID issued by a successful send
+	InviteUID *string `form:"invite_uid,omitempty" json:"invite_uid,omitempty" xml:"invite_uid,omitempty"`
+	// When the issued invite expires (RFC3339)
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+	// Why the send failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// ResultsAccessSyncResponseBody is used to define fields on response body
+// types.
+type ResultsAccessSyncResponseBody struct {
+	// Respondents granted results access
+	Granted *int `form:"granted,omitempty" json:"granted,omitempty" xml:"granted,omitempty"`
+	// Respondents whose results access was revoked
+	Revoked *int `form:"revoked,omitempty" json:"revoked,omitempty" xml:"revoked,omitempty"`
+	// Changes that failed; update the settings again to retry them
+	Failed *int `form:"failed,omitempty" json:"failed,omitempty" xml:"failed,omitempty"`
+}
+
+// SurveyQuestionResultResponseBody is used to define fields on response body
+// types.
+type SurveyQuestionResultResponseBody struct {
+	// Question identifier
+	QuestionID *string `form:"question_id,omitempty" json:"question_id,omitempty" xml:"question_id,omitempty"`
+	// Question text
+	QuestionText *string `form:"question_text,omitempty" json:"question_text,omitempty" xml:"question_text,omitempty"`
+	// Question type
+	QuestionType *string `form:"question_type,omitempty" json:"question_type,omitempty" xml:"question_type,omitempty"`
+	// Counts per answer
+	Answers []*SurveyAnswerResultResponseBody `form:"answers,omitempty" json:"answers,omitempty" xml:"answers,omitempty"`
+}
+
+// SurveyAnswerResultResponseBody is used to define fields on response body
+// types.
+type SurveyAnswerResultResponseBody struct {
+	// Answer
+	Answer *string `form:"answer,omitempty" json:"answer,omitempty" xml:"answer,omitempty"`
+	// Number of responses with the answer
+	Count *int `form:"count,omitempty" json:"count,omitempty" xml:"count,omitempty"`
+	// Share of the question's responses with the answer
+	Percentage *float64 `form:"percentage,omitempty" json:"percentage,omitempty" xml:"percentage,omitempty"`
+	// The answer was given by too few respondents of an anonymous survey; count
+	// and percentage are zero
+	Suppressed *bool `form:"suppressed,omitempty" json:"suppressed,omitempty" xml:"suppressed,omitempty"`
+}
+
+// SurveyTemplateResponseBody is used to define fields on response body types.
+type SurveyTemplateResponseBody struct {
+	// SurveyMonkey survey ID; pass as survey_monkey_id to schedule_survey
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Survey title
+	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+	// Internal nickname
+	Nickname *string `form:"nickname,omitempty" json:"nickname,omitempty" xml:"nickname,omitempty"`
+	// Survey category
+	Category *string `form:"category,omitempty" json:"category,omitempty" xml:"category,omitempty"`
+	// Survey language
+	Language *string `form:"language,omitempty" json:"language,omitempty" xml:"language,omitempty"`
+	// Number of questions
+	QuestionCount *int `form:"question_count,omitempty" json:"question_count,omitempty" xml:"question_count,omitempty"`
+	// Number of pages
+	PageCount *int `form:"page_count,omitempty" json:"page_count,omitempty" xml:"page_count,omitempty"`
+	// URL to preview the survey
+	PreviewURL *string `form:"preview_url,omitempty" json:"preview_url,omitempty" xml:"preview_url,omitempty"`
+	// URL of the survey collectors
+	CollectURL *string `form:"collect_url,omitempty" json:"collect_url,omitempty" xml:"collect_url,omitempty"`
+	// When the survey was created in SurveyMonkey
+	DateCreated *string `form:"date_created,omitempty" json:"date_created,omitempty" xml:"date_created,omitempty"`
+	// When the survey was last modified in SurveyMonkey
+	DateModified *string `form:"date_modified,omitempty" json:"date_modified,omitempty" xml:"date_modified,omitempty"`
+}
+
+// DeadLetterEntryResponseBody is used to define fields on response body types.
+type DeadLetterEntryResponseBody struct {
+	// Dead-letter stream sequence, used as the entry identifier
+	Sequence *uint64 `form:"sequence,omitempty" json:"sequence,omitempty" xml:"sequence,omitempty"`
+	// Original v1-objects KV key
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Original KV operation
+	Operation *string `form:"operation,omitempty" json:"operation,omitempty" xml:"operation,omitempty"`
+	// KV revision of the original event
+	Revision *uint64 `form:"revision,omitempty" json:"revision,omitempty" xml:"revision,omitempty"`
+	// Why the event was dead-lettered
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Last processing error
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Number of delivery attempts made
+	Attempts *uint64 `form:"attempts,omitempty" json:"attempts,omitempty" xml:"attempts,omitempty"`
+	// When the event was dead-lettered (RFC3339)
+	DeadLetteredAt *string `form:"dead_lettered_at,omitempty" json:"dead_lettered_at,omitempty" xml:"dead_lettered_at,omitempty"`
+	// Original KV value (JSON or msgpack); only returned when inspecting a single
+	// entry
+	Payload []byte `form:"payload,omitempty" json:"payload,omitempty" xml:"payload,omitempty"`
+	// Original message headers; only returned when inspecting a single entry
+	Headers map[string][]string `form:"headers,omitempty" json:"headers,omitempty" xml:"headers,omitempty"`
+}
+
+// NewScheduleSurveyRequestBody builds the HTTP request body from the payload
+// of the "schedule_survey" endpoint of the "survey" service.
+func NewScheduleSurveyRequestBody(p *survey.ScheduleSurveyPayload) *ScheduleSurveyRequestBody {
+	body := &ScheduleSurveyRequestBody{
+		CommitteeUID:           p.CommitteeUID,
+		IsProjectSurvey:        p.IsProjectSurvey,
+		StageFilter:            p.StageFilter,
+		CreatorUsername:        p.CreatorUsername,
+		CreatorName:            p.CreatorName,
+		CreatorID:              p.CreatorID,
+		SurveyMonkeyID:         p.SurveyMonkeyID,
+		SurveyTitle:            p.SurveyTitle,
+		SendImmediately:        p.SendImmediately,
+		SurveySendDate:         p.SurveySendDate,
+		SurveyCutoffDate:       p.SurveyCutoffDate,
+		SurveyReminderRateDays: p.SurveyReminderRateDays,
+		EmailSubject:           p.EmailSubject,
+		EmailBody:              p.EmailBody,
+		EmailBodyText:          p.EmailBodyText,
+		CommitteeVotingEnabled: p.CommitteeVotingEnabled,
+	}
+	return body
+}
+
+// NewUpdateSurveyRequestBody builds the HTTP request body from the payload of
+// the "update_survey" endpoint of the "survey" service.
+func NewUpdateSurveyRequestBody(p *survey.UpdateSurveyPayload) *UpdateSurveyRequestBody {
+	body := &UpdateSurveyRequestBody{
+		CommitteeUID:           p.CommitteeUID,
+		CreatorID:              p.CreatorID,
+		SurveyTitle:            p.SurveyTitle,
+		SurveySendDate:         p.SurveySendDate,
+		SurveyCutoffDate:       p.SurveyCutoffDate,
+		SurveyReminderRateDays: p.SurveyReminderRateDays,
+		EmailSubject:           p.EmailSubject,
+		EmailBody:              p.EmailBody,
+		EmailBodyText:          p.EmailBodyText,
+		CommitteeVotingEnabled: p.CommitteeVotingEnabled,
+	}
+	return body
+}
+
+// NewBulkResendSurveyRequestBody builds the HTTP request body from the payload
+// of the "bulk_resend_survey" endpoint of the "survey" service.
+func NewBulkResendSurveyRequestBody(p *survey.BulkResendSurveyPayload) *BulkResendSurveyRequestBody {
+	body := &BulkResendSurveyRequestBody{}
+	if p.RecipientIds != nil {
+		body.RecipientIds = make([]string, len(p.RecipientIds))
+		for i, val := range p.RecipientIds {
+			body.RecipientIds[i] = val
+		}
+	} else {
+		body.RecipientIds = []string{}
+	}
+	return body
+}
+
+// NewCreateExclusionRequestBody builds the HTTP request body from the payload
+// of the "create_exclusion" endpoint of the "survey" service.
+func NewCreateExclusionRequestBody(p *survey.CreateExclusionPayload) *CreateExclusionRequestBody {
+	body := &CreateExclusionRequestBody{
+		Email:           p.Email,
+		UserID:          p.UserID,
+		SurveyUID:       p.SurveyUID,
+		CommitteeUID:    p.CommitteeUID,
+		GlobalExclusion: p.GlobalExclusion,
+	}
+	return body
+}
+
+// NewDeleteExclusionRequestBody builds the HTTP request body from the payload
+// of the "delete_exclusion" endpoint of the "survey" service.
+func NewDeleteExclusionRequestBody(p *survey.DeleteExclusionPayload) *DeleteExclusionRequestBody {
+	body := &DeleteExclusionRequestBody{
+		Email:           p.Email,
+		UserID:          p.UserID,
+		SurveyUID:       p.SurveyUID,
+		CommitteeUID:    p.CommitteeUID,
+		GlobalExclusion: p.GlobalExclusion,
+	}
+	return body
+}
+
+// NewCreateSurveyResponseRequestBody builds the HTTP request body from the
+// payload of the "create_survey_response" endpoint of the "survey" service.
+func NewCreateSurveyResponseRequestBody(p *survey.CreateSurveyResponsePayload) *CreateSurveyResponseRequestBody {
+	body := &CreateSurveyResponseRequestBody{
+		SurveyResponseUID: p.SurveyResponseUID,
+	}
+	if p.Answers != nil {
+		body.Answers = make([]*SurveyAnswerRequestBody, len(p.Answers))
+		for i, val := range p.Answers {
+			if val == nil {
+				body.Answers[i] = nil
+				continue
+			}
+			body.Answers[i] = marshalSurveySurveyAnswerToSurveyAnswerRequestBody(val)
+		}
+	} else {
+		body.Answers = []*SurveyAnswerRequestBody{}
+	}
+	return body
+}
+
+// NewUpdateSurveyResponseRequestBody builds the HTTP request body from the
+// payload of the "update_survey_response" endpoint of the "survey" service.
+func NewUpdateSurveyResponseRequestBody(p *survey.UpdateSurveyResponsePayload) *UpdateSurveyResponseRequestBody {
+	body := &UpdateSurveyResponseRequestBody{}
+	if p.Answers != nil {
+		body.Answers = make([]*SurveyAnswerRequestBody, len(p.Answers))
+		for i, val := range p.Answers {
+			if val == nil {
+				body.Answers[i] = nil
+				continue
+			}
+			body.Answers[i] = marshalSurveySurveyAnswerToSurveyAnswerRequestBody(val)
+		}
+	} else {
+		body.Answers = []*SurveyAnswerRequestBody{}
+	}
+	return body
+}
+
+// NewUpdateSurveyInviteSettingsRequestBody builds the HTTP request body from
+// the payload of the "update_survey_invite_settings" endpoint of the "survey"
+// service.
+func NewUpdateSurveyInviteSettingsRequestBody(p *survey.UpdateSurveyInviteSettingsPayload) *UpdateSurveyInviteSettingsRequestBody {
+	body := &UpdateSurveyInviteSettingsRequestBody{
+		Enabled:             p.Enabled,
+		Role:                p.Role,
+		ExpirationDays:      p.ExpirationDays,
+		ReturnURLTemplate:   p.ReturnURLTemplate,
+		DisplayNameFallback: p.DisplayNameFallback,
+		Locale:              p.Locale,
+	}
+	return body
+}
+
+// NewUpdateProjectInviteSettingsRequestBody builds the HTTP request body from
+// the payload of the "update_project_invite_settings" endpoint of the "survey"
+// service.
+func NewUpdateProjectInviteSettingsRequestBody(p *survey.UpdateProjectInviteSettingsPayload) *UpdateProjectInviteSettingsRequestBody {
+	body := &UpdateProjectInviteSettingsRequestBody{
+		Enabled:             p.Enabled,
+		Role:                p.Role,
+		ExpirationDays:      p.ExpirationDays,
+		ReturnURLTemplate:   p.ReturnURLTemplate,
+		DisplayNameFallback: p.DisplayNameFallback,
+		Locale:              p.Locale,
+	}
+	return body
+}
+
+// NewUpdateSurveySettingsRequestBody builds the HTTP request body from the
+// payload of the "update_survey_settings" endpoint of the "survey" service.
+func NewUpdateSurveySettingsRequestBody(p *survey.UpdateSurveySettingsPayload) *UpdateSurveySettingsRequestBody {
+	body := &UpdateSurveySettingsRequestBody{
+		ShareResults: p.ShareResults,
+		Anonymous:    p.Anonymous,
+	}
+	{
+		var zero bool
+		if body.ShareResults == zero {
+			body.ShareResults = false
+		}
+	}
+	{
+		var zero bool
+		if body.Anonymous == zero {
+			body.Anonymous = false
+		}
+	}
+	return body
+}
+
+// NewValidateEmailRequestBody builds the HTTP request body from the payload of
+// the "validate_email" endpoint of the "survey" service.
+func NewValidateEmailRequestBody(p *survey.ValidateEmailPayload) *ValidateEmailRequestBody {
+	body := &ValidateEmailRequestBody{
+		Body:    p.Body,
+		Subject: p.Subject,
+	}
+	return body
+}
+
+// NewStartReindexRequestBody builds the HTTP request body from the payload of
+// the "start_reindex" endpoint of the "survey" service.
+func NewStartReindexRequestBody(p *survey.StartReindexPayload) *StartReindexRequestBody {
+	body := &StartReindexRequestBody{
+		ModifiedSince: p.ModifiedSince,
+		ModifiedUntil: p.ModifiedUntil,
+		DryRun:        p.DryRun,
+		Force:         p.Force,
+		RatePerSecond: p.RatePerSecond,
+	}
+	if p.KeyPrefixes != nil {
+		body.KeyPrefixes = make([]string, len(p.KeyPrefixes))
+		for i, val := range p.KeyPrefixes {
+			body.KeyPrefixes[i] = val
+		}
+	}
+	{
+		var zero bool
+		if body.DryRun == zero {
+			body.DryRun = false
+		}
+	}
+	{
+		var zero bool
+		if body.Force == zero {
+			body.Force = false
+		}
+	}
+	{
+		var zero int
+		if body.RatePerSecond == zero {
+			body.RatePerSecond = 50
+		}
+	}
+	return body
+}
+
+// NewRerunInviteEnrichmentRequestBody builds the HTTP request body from the
+// payload of the "rerun_invite_enrichment" endpoint of the "survey" service.
+func NewRerunInviteEnrichmentRequestBody(p *survey.RerunInviteEnrichmentPayload) *RerunInviteEnrichmentRequestBody {
+	body := &RerunInviteEnrichmentRequestBody{
+		Email:    p.Email,
+		Username: p.Username,
+	}
+	return body
+}
+
+// NewStartInviteBackfillRequestBody builds the HTTP request body from the
+// payload of the "start_invite_backfill" endpoint of the "survey" service.
+func NewStartInviteBackfillRequestBody(p *survey.StartInviteBackfillPayload) *StartInviteBackfillRequestBody {
+	body := &StartInviteBackfillRequestBody{
+		DryRun:        p.DryRun,
+		RatePerSecond: p.RatePerSecond,
+	}
+	if p.SurveyUids != nil {
+		body.SurveyUids = make([]string, len(p.SurveyUids))
+		for i, val := range p.SurveyUids {
+			body.SurveyUids[i] = val
+		}
+	}
+	{
+		var zero bool
+		if body.DryRun == zero {
+			body.DryRun = false
+		}
+	}
+	{
+		var zero int
+		if body.RatePerSecond == zero {
+			body.RatePerSecond = 5
+		}
+	}
+	return body
+}
+
+// NewScheduleSurveySurveyScheduleResultCreated builds a "survey" service
+// "schedule_survey" endpoint result from a HTTP "Created" response.
+func NewScheduleSurveySurveyScheduleResultCreated(body *ScheduleSurveyResponseBody) *survey.SurveyScheduleResult {
+	v := &survey.SurveyScheduleResult{
+		UID:                           *body.UID,
+		SurveyMonkeyID:                body.SurveyMonkeyID,
+		IsProjectSurvey:               body.IsProjectSurvey,
+		StageFilter:                   body.StageFilter,
+		CreatorUsername:               body.CreatorUsername,
+		CreatorName:                   body.CreatorName,
+		CreatorID:                     body.CreatorID,
+		CreatedAt:                     body.CreatedAt,
+		LastModifiedAt:                body.LastModifiedAt,
+		LastModifiedBy:                body.LastModifiedBy,
+		SurveyTitle:                   body.SurveyTitle,
+		SurveyStatus:                  *body.SurveyStatus,
+		ResponseStatus:                body.ResponseStatus,
+		SurveySendDate:                body.SurveySendDate,
+		SurveyCutoffDate:              body.SurveyCutoffDate,
+		SurveyReminderRateDays:        body.SurveyReminderRateDays,
+		EmailSubject:                  body.EmailSubject,
+		EmailBody:                     body.EmailBody,
+		EmailBodyText:                 body.EmailBodyText,
+		CommitteeCategory:             body.CommitteeCategory,
+		CommitteeVotingEnabled:        body.CommitteeVotingEnabled,
+		SurveyURL:                     body.SurveyURL,
+		SendImmediately:               body.SendImmediately,
+		TotalRecipients:               body.TotalRecipients,
+		TotalResponses:                body.TotalResponses,
+		IsNpsSurvey:                   body.IsNpsSurvey,
+		NpsValue:                      body.NpsValue,
+		NumPromoters:                  body.NumPromoters,
+		NumPassives:                   body.NumPassives,
+		NumDetractors:                 body.NumDetractors,
+		TotalBouncedEmails:            body.TotalBouncedEmails,
+		NumAutomatedRemindersToSend:   body.NumAutomatedRemindersToSend,
+		NumAutomatedRemindersSent:     body.NumAutomatedRemindersSent,
+		NextAutomatedReminderAt:       body.NextAutomatedReminderAt,
+		LatestAutomatedReminderSentAt: body.LatestAutomatedReminderSentAt,
+	}
+	if body.Committees != nil {
+		v.Committees = make([]*survey.SurveyCommittee, len(body.Committees))
+		for i, val := range body.Committees {
+			if val == nil {
+				v.Committees[i] = nil
+				continue
+			}
+			v.Committees[i] = unmarshalSurveyCommitteeResponseBodyToSurveySurveyCommittee(val)
+		}
+	}
+
+	return v
+}
+
+// NewScheduleSurveyBadRequest builds a survey service schedule_survey endpoint
+// BadRequest error.
+func NewScheduleSurveyBadRequest(body *ScheduleSurveyBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewScheduleSurveyForbidden builds a survey service schedule_survey endpoint
+// Forbidden error.
+func NewScheduleSurveyForbidden(body *ScheduleSurveyForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewScheduleSurveyInternalServerError builds a survey service schedule_survey
+// endpoint InternalServerError error.
+func NewScheduleSurveyInternalServerError(body *ScheduleSurveyInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewScheduleSurveyServiceUnavailable builds a survey service schedule_survey
+// endpoint ServiceUnavailable error.
+func NewScheduleSurveyServiceUnavailable(body *ScheduleSurveyServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewScheduleSurveyUnauthorized builds a survey service schedule_survey
+// endpoint Unauthorized error.
+func NewScheduleSurveyUnauthorized(body *ScheduleSurveyUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetSurveySurveyScheduleResultOK builds a "survey" service "get_survey"
+// endpoint result from a HTTP "OK" response.
+func NewGetSurveySurveyScheduleResultOK(body *GetSurveyResponseBody) *survey.SurveyScheduleResult {
+	v := &survey.SurveyScheduleResult{
+		UID:                           *body.UID,
+		SurveyMonkeyID:                body.SurveyMonkeyID,
+		IsProjectSurvey:               body.IsProjectSurvey,
+		StageFilter:                   body.StageFilter,
+		CreatorUsername:               body.CreatorUsername,
+		CreatorName:                   body.CreatorName,
+		CreatorID:                     body.CreatorID,
+		CreatedAt:                     body.CreatedAt,
+		LastModifiedAt:                body.LastModifiedAt,
+		LastModifiedBy:                body.LastModifiedBy,
+		SurveyTitle:                   body.SurveyTitle,
+		SurveyStatus:                  *body.SurveyStatus,
+		ResponseStatus:                body.ResponseStatus,
+		SurveySendDate:                body.SurveySendDate,
+		SurveyCutoffDate:              body.SurveyCutoffDate,
+		SurveyReminderRateDays:        body.SurveyReminderRateDays,
+		EmailSubject:                  body.EmailSubject,
+		EmailBody:                     body.EmailBody,
+		EmailBodyText:                 body.EmailBodyText,
+		CommitteeCategory:             body.CommitteeCategory,
+		CommitteeVotingEnabled:        body.CommitteeVotingEnabled,
+		SurveyURL:                     body.SurveyURL,
+		SendImmediately:               body.SendImmediately,
+		TotalRecipients:               body.TotalRecipients,
+		TotalResponses:                body.TotalResponses,
+		IsNpsSurvey:                   body.IsNpsSurvey,
+		NpsValue:                      body.NpsValue,
+		NumPromoters:                  body.NumPromoters,
+		NumPassives:                   body.NumPassives,
+		NumDetractors:                 body.NumDetractors,
+		TotalBouncedEmails:            body.TotalBouncedEmails,
+		NumAutomatedRemindersToSend:   body.NumAutomatedRemindersToSend,
+		NumAutomatedRemindersSent:     body.NumAutomatedRemindersSent,
+		NextAutomatedReminderAt:       body.NextAutomatedReminderAt,
+		LatestAutomatedReminderSentAt: body.LatestAutomatedReminderSentAt,
+	}
+	if body.Committees != nil {
+		v.Committees = make([]*survey.SurveyCommittee, len(body.Committees))
+		for i, val := range body.Committees {
+			if val == nil {
+				v.Committees[i] = nil
+				continue
+			}
+			v.Committees[i] = unmarshalSurveyCommitteeResponseBodyToSurveySurveyCommittee(val)
+		}
+	}
+
+	return v
+}
+
+// NewGetSurveyBadRequest builds a survey service get_survey endpoint
+// BadRequest error.
+func NewGetSurveyBadRequest(body *GetSurveyBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewGetSurveyForbidden builds a survey service get_survey endpoint Forbidden
+// error.
+func NewGetSurveyForbidden(body *GetSurveyForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetSurveyInternalServerError builds a survey service get_survey endpoint
+// InternalServerError error.
+func NewGetSurveyInternalServerError(body *GetSurveyInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetSurveyNotFound builds a survey service get_survey endpoint NotFound
+// error.
+func NewGetSurveyNotFound(body *GetSurveyNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetSurveyServiceUnavailable builds a survey service get_survey endpoint
+// ServiceUnavailable error.
+func NewGetSurveyServiceUnavailable(body *GetSurveyServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetSurveyUnauthorized builds a survey service get_survey endpoint
+// Unauthorized error.
+func NewGetSurveyUnauthorized(body *GetSurveyUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateSurveySurveyScheduleResultOK builds a "survey" service
+// "update_survey" endpoint result from a HTTP "OK" response.
+func NewUpdateSurveySurveyScheduleResultOK(body *UpdateSurveyResponseBody) *survey.SurveyScheduleResult {
+	v := &survey.SurveyScheduleResult{
+		UID:                           *body.UID,
+		SurveyMonkeyID:                body.SurveyMonkeyID,
+		IsProjectSurvey:               body.IsProjectSurvey,
+		StageFilter:                   body.StageFilter,
+		CreatorUsername:               body.CreatorUsername,
+		CreatorName:                   body.CreatorName,
+		CreatorID:                     body.CreatorID,
+		CreatedAt:                     body.CreatedAt,
+		LastModifiedAt:                body.LastModifiedAt,
+		LastModifiedBy:                body.LastModifiedBy,
+		SurveyTitle:                   body.SurveyTitle,
+		SurveyStatus:                  *body.SurveyStatus,
+		ResponseStatus:                body.ResponseStatus,
+		SurveySendDate:                body.SurveySendDate,
+		SurveyCutoffDate:              body.SurveyCutoffDate,
+		SurveyReminderRateDays:        body.SurveyReminderRateDays,
+		EmailSubject:                  body.EmailSubject,
+		EmailBody:                     body.EmailBody,
+		EmailBodyText:                 body.EmailBodyText,
+		CommitteeCategory:             body.CommitteeCategory,
+		CommitteeVotingEnabled:        body.CommitteeVotingEnabled,
+		SurveyURL:                     body.SurveyURL,
+		SendImmediately:               body.SendImmediately,
+		TotalRecipients:               body.TotalRecipients,
+		TotalResponses:                body.TotalResponses,
+		IsNpsSurvey:                   body.IsNpsSurvey,
+		NpsValue:                      body.NpsValue,
+		NumPromoters:                  body.NumPromoters,
+		NumPassives:                   body.NumPassives,
+		NumDetractors:                 body.NumDetractors,
+		TotalBouncedEmails:            body.TotalBouncedEmails,
+		NumAutomatedRemindersToSend:   body.NumAutomatedRemindersToSend,
+		NumAutomatedRemindersSent:     body.NumAutomatedRemindersSent,
+		NextAutomatedReminderAt:       body.NextAutomatedReminderAt,
+		LatestAutomatedReminderSentAt: body.LatestAutomatedReminderSentAt,
+	}
+	if body.Committees != nil {
+		v.Committees = make([]*survey.SurveyCommittee, len(body.Committees))
+		for i, val := range body.Committees {
+			if val == nil {
+				v.Committees[i] = nil
+				continue
+			}
+			v.Committees[i] = unmarshalSurveyCommitteeResponseBodyToSurveySurveyCommittee(val)
+		}
+	}
+
+	return v
+}
+
+// NewUpdateSurveyBadRequest builds a survey service update_survey endpoint
+// BadRequest error.
+func NewUpdateSurveyBadRequest(body *UpdateSurveyBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewUpdateSurveyForbidden builds a survey service update_survey endpoint
+// Forbidden error.
+func NewUpdateSurveyForbidden(body *UpdateSurveyForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateSurveyInternalServerError builds a survey service update_survey
+// endpoint InternalServerError error.
+func NewUpdateSurveyInternalServerError(body *UpdateSurveyInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateSurveyNotFound builds a survey service update_survey endpoint
+// NotFound error.
+func NewUpdateSurveyNotFound(body *UpdateSurveyNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateSurveyServiceUnavailable builds a survey service update_survey
+// endpoint ServiceUnavailable error.
+func NewUpdateSurveyServiceUnavailable(body *UpdateSurveyServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateSurveyUnauthorized builds a survey service update_survey endpoint
+// Unauthorized error.
+func NewUpdateSurveyUnauthorized(body *UpdateSurveyUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteSurveyBadRequest builds a survey service delete_survey endpoint
+// BadRequest error.
+func NewDeleteSurveyBadRequest(body *DeleteSurveyBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewDeleteSurveyForbidden builds a survey service delete_survey endpoint
+// Forbidden error.
+func NewDeleteSurveyForbidden(body *DeleteSurveyForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteSurveyInternalServerError builds a survey service delete_survey
+// endpoint InternalServerError error.
+func NewDeleteSurveyInternalServerError(body *DeleteSurveyInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteSurveyNotFound builds a survey service delete_survey endpoint
+// NotFound error.
+func NewDeleteSurveyNotFound(body *DeleteSurveyNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteSurveyServiceUnavailable builds a survey service delete_survey
+// endpoint ServiceUnavailable error.
+func NewDeleteSurveyServiceUnavailable(body *DeleteSurveyServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteSurveyUnauthorized builds a survey service delete_survey endpoint
+// Unauthorized error.
+func NewDeleteSurveyUnauthorized(body *DeleteSurveyUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewBulkResendSurveyBadRequest builds a survey service bulk_resend_survey
+// endpoint BadRequest error.
+func NewBulkResendSurveyBadRequest(body *BulkResendSurveyBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewBulkResendSurveyForbidden builds a survey service bulk_resend_survey
+// endpoint Forbidden error.
+func NewBulkResendSurveyForbidden(body *BulkResendSurveyForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewBulkResendSurveyInternalServerError builds a survey service
+// bulk_resend_survey endpoint InternalServerError error.
+func NewBulkResendSurveyInternalServerError(body *BulkResendSurveyInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewBulkResendSurveyNotFound builds a survey service bulk_resend_survey
+// endpoint NotFound error.
+func NewBulkResendSurveyNotFound(body *BulkResendSurveyNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewBulkResendSurveyServiceUnavailable builds a survey service
+// bulk_resend_survey endpoint ServiceUnavailable error.
+func NewBulkResendSurveyServiceUnavailable(body *BulkResendSurveyServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewBulkResendSurveyUnauthorized builds a survey service bulk_resend_survey
+// endpoint Unauthorized error.
+func NewBulkResendSurveyUnauthorized(body *BulkResendSurveyUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPreviewSendSurveyPreviewSendResultOK builds a "survey" service
+// "preview_send_survey" endpoint result from a HTTP "OK" response.
+func NewPreviewSendSurveyPreviewSendResultOK(body *PreviewSendSurveyResponseBody) *survey.PreviewSendResult {
+	v := &survey.PreviewSendResult{}
+	if body.AffectedProjects != nil {
+		v.AffectedProjects = make([]*survey.LFXProject, len(body.AffectedProjects))
+		for i, val := range body.AffectedProjects {
+			if val == nil {
+				v.AffectedProjects[i] = nil
+				continue
+			}
+			v.AffectedProjects[i] = unmarshalLFXProjectResponseBodyToSurveyLFXProject(val)
+		}
+	}
+	if body.AffectedCommittees != nil {
+		v.AffectedCommittees = make([]*survey.ExcludedCommittee, len(body.AffectedCommittees))
+		for i, val := range body.AffectedCommittees {
+			if val == nil {
+				v.AffectedCommittees[i] = nil
+				continue
+			}
+			v.AffectedCommittees[i] = unmarshalExcludedCommitteeResponseBodyToSurveyExcludedCommittee(val)
+		}
+	}
+	if body.AffectedRecipients != nil {
+		v.AffectedRecipients = make([]*survey.ITXPreviewRecipient, len(body.AffectedRecipients))
+		for i, val := range body.AffectedRecipients {
+			if val == nil {
+				v.AffectedRecipients[i] = nil
+				continue
+			}
+			v.AffectedRecipients[i] = unmarshalITXPreviewRecipientResponseBodyToSurveyITXPreviewRecipient(val)
+		}
+	}
+
+	return v
+}
+
+// NewPreviewSendSurveyBadRequest builds a survey service preview_send_survey
+// endpoint BadRequest error.
+func NewPreviewSendSurveyBadRequest(body *PreviewSendSurveyBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewPreviewSendSurveyForbidden builds a survey service preview_send_survey
+// endpoint Forbidden error.
+func NewPreviewSendSurveyForbidden(body *PreviewSendSurveyForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPreviewSendSurveyInternalServerError builds a survey service
+// preview_send_survey endpoint InternalServerError error.
+func NewPreviewSendSurveyInternalServerError(body *PreviewSendSurveyInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPreviewSendSurveyNotFound builds a survey service preview_send_survey
+// endpoint NotFound error.
+func NewPreviewSendSurveyNotFound(body *PreviewSendSurveyNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPreviewSendSurveyServiceUnavailable builds a survey service
+// preview_send_survey endpoint ServiceUnavailable error.
+func NewPreviewSendSurveyServiceUnavailable(body *PreviewSendSurveyServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPreviewSendSurveyUnauthorized builds a survey service preview_send_survey
+// endpoint Unauthorized error.
+func NewPreviewSendSurveyUnauthorized(body *PreviewSendSurveyUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewSendMissingRecipientsBadRequest builds a survey service
+// send_missing_recipients endpoint BadRequest error.
+func NewSendMissingRecipientsBadRequest(body *SendMissingRecipientsBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewSendMissingRecipientsForbidden builds a survey service
+// send_missing_recipients endpoint Forbidden error.
+func NewSendMissingRecipientsForbidden(body *SendMissingRecipientsForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewSendMissingRecipientsInternalServerError builds a survey service
+// send_missing_recipients endpoint InternalServerError error.
+func NewSendMissingRecipientsInternalServerError(body *SendMissingRecipientsInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewSendMissingRecipientsNotFound builds a survey service
+// send_missing_recipients endpoint NotFound error.
+func NewSendMissingRecipientsNotFound(body *SendMissingRecipientsNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewSendMissingRecipientsServiceUnavailable builds a survey service
+// send_missing_recipients endpoint ServiceUnavailable error.
+func NewSendMissingRecipientsServiceUnavailable(body *SendMissingRecipientsServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewSendMissingRecipientsUnauthorized builds a survey service
+// send_missing_recipients endpoint Unauthorized error.
+func NewSendMissingRecipientsUnauthorized(body *SendMissingRecipientsUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteSurveyResponseBadRequest builds a survey service
+// delete_survey_response endpoint BadRequest error.
+func NewDeleteSurveyResponseBadRequest(body *DeleteSurveyResponseBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewDeleteSurveyResponseForbidden builds a survey service
+// delete_survey_response endpoint Forbidden error.
+func NewDeleteSurveyResponseForbidden(body *DeleteSurveyResponseForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteSurveyResponseInternalServerError builds a survey service
+// delete_survey_response endpoint InternalServerError error.
+func NewDeleteSurveyResponseInternalServerError(body *DeleteSurveyResponseInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteSurveyResponseNotFound builds a survey service
+// delete_survey_response endpoint NotFound error.
+func NewDeleteSurveyResponseNotFound(body *DeleteSurveyResponseNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteSurveyResponseServiceUnavailable builds a survey service
+// delete_survey_response endpoint ServiceUnavailable error.
+func NewDeleteSurveyResponseServiceUnavailable(body *DeleteSurveyResponseServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteSurveyResponseUnauthorized builds a survey service
+// delete_survey_response endpoint Unauthorized error.
+func NewDeleteSurveyResponseUnauthorized(body *DeleteSurveyResponseUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewResendSurveyResponseBadRequest builds a survey service
+// resend_survey_response endpoint BadRequest error.
+func NewResendSurveyResponseBadRequest(body *ResendSurveyResponseBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewResendSurveyResponseForbidden builds a survey service
+// resend_survey_response endpoint Forbidden error.
+func NewResendSurveyResponseForbidden(body *ResendSurveyResponseForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewResendSurveyResponseInternalServerError builds a survey service
+// resend_survey_response endpoint InternalServerError error.
+func NewResendSurveyResponseInternalServerError(body *ResendSurveyResponseInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewResendSurveyResponseNotFound builds a survey service
+// resend_survey_response endpoint NotFound error.
+func NewResendSurveyResponseNotFound(body *ResendSurveyResponseNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewResendSurveyResponseServiceUnavailable builds a survey service
+// resend_survey_response endpoint ServiceUnavailable error.
+func NewResendSurveyResponseServiceUnavailable(body *ResendSurveyResponseServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewResendSurveyResponseUnauthorized builds a survey service
+// resend_survey_response endpoint Unauthorized error.
+func NewResendSurveyResponseUnauthorized(body *ResendSurveyResponseUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteRecipientGroupBadRequest builds a survey service
+// delete_recipient_group endpoint BadRequest error.
+func NewDeleteRecipientGroupBadRequest(body *DeleteRecipientGroupBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewDeleteRecipientGroupForbidden builds a survey service
+// delete_recipient_group endpoint Forbidden error.
+func NewDeleteRecipientGroupForbidden(body *DeleteRecipientGroupForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteRecipientGroupInternalServerError builds a survey service
+// delete_recipient_group endpoint InternalServerError error.
+func NewDeleteRecipientGroupInternalServerError(body *DeleteRecipientGroupInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteRecipientGroupNotFound builds a survey service
+// delete_recipient_group endpoint NotFound error.
+func NewDeleteRecipientGroupNotFound(body *DeleteRecipientGroupNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteRecipientGroupServiceUnavailable builds a survey service
+// delete_recipient_group endpoint ServiceUnavailable error.
+func NewDeleteRecipientGroupServiceUnavailable(body *DeleteRecipientGroupServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteRecipientGroupUnauthorized builds a survey service
+// delete_recipient_group endpoint Unauthorized error.
+func NewDeleteRecipientGroupUnauthorized(body *DeleteRecipientGroupUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateExclusionExclusionResultCreated builds a "survey" service
+// "create_exclusion" endpoint result from a HTTP "Created" response.
+func NewCreateExclusionExclusionResultCreated(body *CreateExclusionResponseBody) *survey.ExclusionResult {
+	v := &survey.ExclusionResult{
+		UID:             *body.UID,
+		Email:           body.Email,
+		SurveyUID:       body.SurveyUID,
+		CommitteeUID:    body.CommitteeUID,
+		GlobalExclusion: body.GlobalExclusion,
+		UserID:          body.UserID,
+	}
+
+	return v
+}
+
+// NewCreateExclusionBadRequest builds a survey service create_exclusion
+// endpoint BadRequest error.
+func NewCreateExclusionBadRequest(body *CreateExclusionBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewCreateExclusionForbidden builds a survey service create_exclusion
+// endpoint Forbidden error.
+func NewCreateExclusionForbidden(body *CreateExclusionForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateExclusionInternalServerError builds a survey service
+// create_exclusion endpoint InternalServerError error.
+func NewCreateExclusionInternalServerError(body *CreateExclusionInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateExclusionUnauthorized builds a survey service create_exclusion
+// endpoint Unauthorized error.
+func NewCreateExclusionUnauthorized(body *CreateExclusionUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteExclusionBadRequest builds a survey service delete_exclusion
+// endpoint BadRequest error.
+func NewDeleteExclusionBadRequest(body *DeleteExclusionBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewDeleteExclusionForbidden builds a survey service delete_exclusion
+// endpoint Forbidden error.
+func NewDeleteExclusionForbidden(body *DeleteExclusionForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteExclusionInternalServerError builds a survey service
+// delete_exclusion endpoint InternalServerError error.
+func NewDeleteExclusionInternalServerError(body *DeleteExclusionInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteExclusionUnauthorized builds a survey service delete_exclusion
+// endpoint Unauthorized error.
+func NewDeleteExclusionUnauthorized(body *DeleteExclusionUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetExclusionExtendedExclusionResultOK builds a "survey" service
+// "get_exclusion" endpoint result from a HTTP "OK" response.
+func NewGetExclusionExtendedExclusionResultOK(body *GetExclusionResponseBody) *survey.ExtendedExclusionResult {
+	v := &survey.ExtendedExclusionResult{
+		UID:             *body.UID,
+		Email:           body.Email,
+		SurveyUID:       body.SurveyUID,
+		CommitteeUID:    body.CommitteeUID,
+		GlobalExclusion: body.GlobalExclusion,
+		UserID:          body.UserID,
+	}
+	if body.User != nil {
+		v.User = unmarshalExclusionUserResponseBodyToSurveyExclusionUser(body.User)
+	}
+
+	return v
+}
+
+// NewGetExclusionBadRequest builds a survey service get_exclusion endpoint
+// BadRequest error.
+func NewGetExclusionBadRequest(body *GetExclusionBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewGetExclusionForbidden builds a survey service get_exclusion endpoint
+// Forbidden error.
+func NewGetExclusionForbidden(body *GetExclusionForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetExclusionInternalServerError builds a survey service get_exclusion
+// endpoint InternalServerError error.
+func NewGetExclusionInternalServerError(body *GetExclusionInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetExclusionNotFound builds a survey service get_exclusion endpoint
+// NotFound error.
+func NewGetExclusionNotFound(body *GetExclusionNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetExclusionUnauthorized builds a survey service get_exclusion endpoint
+// Unauthorized error.
+func NewGetExclusionUnauthorized(body *GetExclusionUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteExclusionByIDBadRequest builds a survey service
+// delete_exclusion_by_id endpoint BadRequest error.
+func NewDeleteExclusionByIDBadRequest(body *DeleteExclusionByIDBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewDeleteExclusionByIDForbidden builds a survey service
+// delete_exclusion_by_id endpoint Forbidden error.
+func NewDeleteExclusionByIDForbidden(body *DeleteExclusionByIDForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteExclusionByIDInternalServerError builds a survey service
+// delete_exclusion_by_id endpoint InternalServerError error.
+func NewDeleteExclusionByIDInternalServerError(body *DeleteExclusionByIDInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteExclusionByIDUnauthorized builds a survey service
+// delete_exclusion_by_id endpoint Unauthorized error.
+func NewDeleteExclusionByIDUnauthorized(body *DeleteExclusionByIDUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListSurveyResponsesSurveyResponsesPageOK builds a "survey" service
+// "list_survey_responses" endpoint result from a HTTP "OK" response.
+func NewListSurveyResponsesSurveyResponsesPageOK(body *ListSurveyResponsesResponseBody) *survey.SurveyResponsesPage {
+	v := &survey.SurveyResponsesPage{}
+	v.Data = make([]*survey.SurveyResponseItem, len(body.Data))
+	for i, val := range body.Data {
+		if val == nil {
+			v.Data[i] = nil
+			continue
+		}
+		v.Data[i] = unmarshalSurveyResponseItemResponseBodyToSurveySurveyResponseItem(val)
+	}
+	v.Meta = unmarshalSurveyResponsePageMetaResponseBodyToSurveySurveyResponsePageMeta(body.Meta)
+
+	return v
+}
+
+// NewListSurveyResponsesBadRequest builds a survey service
+// list_survey_responses endpoint BadRequest error.
+func NewListSurveyResponsesBadRequest(body *ListSurveyResponsesBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewListSurveyResponsesForbidden builds a survey service
+// list_survey_responses endpoint Forbidden error.
+func NewListSurveyResponsesForbidden(body *ListSurveyResponsesForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListSurveyResponsesInternalServerError builds a survey service
+// list_survey_responses endpoint InternalServerError error.
+func NewListSurveyResponsesInternalServerError(body *ListSurveyResponsesInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListSurveyResponsesNotFound builds a survey service list_survey_responses
+// endpoint NotFound error.
+func NewListSurveyResponsesNotFound(body *ListSurveyResponsesNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListSurveyResponsesServiceUnavailable builds a survey service
+// list_survey_responses endpoint ServiceUnavailable error.
+func NewListSurveyResponsesServiceUnavailable(body *ListSurveyResponsesServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListSurveyResponsesUnauthorized builds a survey service
+// list_survey_responses endpoint Unauthorized error.
+func NewListSurveyResponsesUnauthorized(body *ListSurveyResponsesUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateSurveyResponseBadRequest builds a survey service
+// create_survey_response endpoint BadRequest error.
+func NewCreateSurveyResponseBadRequest(body *CreateSurveyResponseBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewCreateSurveyResponseForbidden builds a survey service
+// create_survey_response endpoint Forbidden error.
+func NewCreateSurveyResponseForbidden(body *CreateSurveyResponseForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateSurveyResponseInternalServerError builds a survey service
+// create_survey_response endpoint InternalServerError error.
+func NewCreateSurveyResponseInternalServerError(body *CreateSurveyResponseInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateSurveyResponseNotFound builds a survey service
+// create_survey_response endpoint NotFound error.
+func NewCreateSurveyResponseNotFound(body *CreateSurveyResponseNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateSurveyResponseServiceUnavailable builds a survey service
+// create_survey_response endpoint ServiceUnavailable error.
+func NewCreateSurveyResponseServiceUnavailable(body *CreateSurveyResponseServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateSurveyResponseUnauthorized builds a survey service
+// create_survey_response endpoint Unauthorized error.
+func NewCreateSurveyResponseUnauthorized(body *CreateSurveyResponseUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateSurveyResponseBadRequest builds a survey service
+// update_survey_response endpoint BadRequest error.
+func NewUpdateSurveyResponseBadRequest(body *UpdateSurveyResponseBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewUpdateSurveyResponseForbidden builds a survey service
+// update_survey_response endpoint Forbidden error.
+func NewUpdateSurveyResponseForbidden(body *UpdateSurveyResponseForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateSurveyResponseInternalServerError builds a survey service
+// update_survey_response endpoint InternalServerError error.
+func NewUpdateSurveyResponseInternalServerError(body *UpdateSurveyResponseInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateSurveyResponseNotFound builds a survey service
+// update_survey_response endpoint NotFound error.
+func NewUpdateSurveyResponseNotFound(body *UpdateSurveyResponseNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateSurveyResponseServiceUnavailable builds a survey service
+// update_survey_response endpoint ServiceUnavailable error.
+func NewUpdateSurveyResponseServiceUnavailable(body *UpdateSurveyResponseServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateSurveyResponseUnauthorized builds a survey service
+// update_survey_response endpoint Unauthorized error.
+func NewUpdateSurveyResponseUnauthorized(body *UpdateSurveyResponseUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListSurveyInvitesSurveyInviteListOK builds a "survey" service
+// "list_survey_invites" endpoint result from a HTTP "OK" response.
+func NewListSurveyInvitesSurveyInviteListOK(body *ListSurveyInvitesResponseBody) *survey.SurveyInviteList {
+	v := &survey.SurveyInviteList{}
+	v.Counts = unmarshalSurveyInviteCountsResponseBodyToSurveySurveyInviteCounts(body.Counts)
+	v.Invites = make([]*survey.SurveyInvite, len(body.Invites))
+	for i, val := range body.Invites {
+		if val == nil {
+			v.Invites[i] = nil
+			continue
+		}
+		v.Invites[i] = unmarshalSurveyInviteResponseBodyToSurveySurveyInvite(val)
+	}
+
+	return v
+}
+
+// NewListSurveyInvitesBadRequest builds a survey service list_survey_invites
+// endpoint BadRequest error.
+func NewListSurveyInvitesBadRequest(body *ListSurveyInvitesBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewListSurveyInvitesForbidden builds a survey service list_survey_invites
+// endpoint Forbidden error.
+func NewListSurveyInvitesForbidden(body *ListSurveyInvitesForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListSurveyInvitesInternalServerError builds a survey service
+// list_survey_invites endpoint InternalServerError error.
+func NewListSurveyInvitesInternalServerError(body *ListSurveyInvitesInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListSurveyInvitesNotFound builds a survey service list_survey_invites
+// endpoint NotFound error.
+func NewListSurveyInvitesNotFound(body *ListSurveyInvitesNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListSurveyInvitesServiceUnavailable builds a survey service
+// list_survey_invites endpoint ServiceUnavailable error.
+func NewListSurveyInvitesServiceUnavailable(body *ListSurveyInvitesServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListSurveyInvitesUnauthorized builds a survey service list_survey_invites
+// endpoint Unauthorized error.
+func NewListSurveyInvitesUnauthorized(body *ListSurveyInvitesUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetSurveyInviteSettingsInviteSettingsOK builds a "survey" service
+// "get_survey_invite_settings" endpoint result from a HTTP "OK" response.
+func NewGetSurveyInviteSettingsInviteSettingsOK(body *GetSurveyInviteSettingsResponseBody) *survey.InviteSettings {
+	v := &survey.InviteSettings{
+		Scope:               *body.Scope,
+		UID:                 *body.UID,
+		Enabled:             body.Enabled,
+		Role:                body.Role,
+		ExpirationDays:      body.ExpirationDays,
+		ReturnURLTemplate:   body.ReturnURLTemplate,
+		DisplayNameFallback: body.DisplayNameFallback,
+		Locale:              body.Locale,
+		UpdatedAt:           *body.UpdatedAt,
+		UpdatedBy:           body.UpdatedBy,
+	}
+
+	return v
+}
+
+// NewGetSurveyInviteSettingsBadRequest builds a survey service
+// get_survey_invite_settings endpoint BadRequest error.
+func NewGetSurveyInviteSettingsBadRequest(body *GetSurveyInviteSettingsBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewGetSurveyInviteSettingsForbidden builds a survey service
+// get_survey_invite_settings endpoint Forbidden error.
+func NewGetSurveyInviteSettingsForbidden(body *GetSurveyInviteSettingsForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetSurveyInviteSettingsInternalServerError builds a survey service
+// get_survey_invite_settings endpoint InternalServerError error.
+func NewGetSurveyInviteSettingsInternalServerError(body *GetSurveyInviteSettingsInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetSurveyInviteSettingsNotFound builds a survey service
+// get_survey_invite_settings endpoint NotFound error.
+func NewGetSurveyInviteSettingsNotFound(body *GetSurveyInviteSettingsNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetSurveyInviteSettingsServiceUnavailable builds a survey service
+// get_survey_invite_settings endpoint ServiceUnavailable error.
+func NewGetSurveyInviteSettingsServiceUnavailable(body *GetSurveyInviteSettingsServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetSurveyInviteSettingsUnauthorized builds a survey service
+// get_survey_invite_settings endpoint Unauthorized error.
+func NewGetSurveyInviteSettingsUnauthorized(body *GetSurveyInviteSettingsUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateSurveyInviteSettingsInviteSettingsOK builds a "survey" service
+// "update_survey_invite_settings" endpoint result from a HTTP "OK" response.
+func NewUpdateSurveyInviteSettingsInviteSettingsOK(body *UpdateSurveyInviteSettingsResponseBody) *survey.InviteSettings {
+	v := &survey.InviteSettings{
+		Scope:               *body.Scope,
+		UID:                 *body.UID,
+		Enabled:             body.Enabled,
+		Role:                body.Role,
+		ExpirationDays:      body.ExpirationDays,
+		ReturnURLTemplate:   body.ReturnURLTemplate,
+		DisplayNameFallback: body.DisplayNameFallback,
+		Locale:              body.Locale,
+		UpdatedAt:           *body.UpdatedAt,
+		UpdatedBy:           body.UpdatedBy,
+	}
+
+	return v
+}
+
+// NewUpdateSurveyInviteSettingsBadRequest builds a survey service
+// update_survey_invite_settings endpoint BadRequest error.
+func NewUpdateSurveyInviteSettingsBadRequest(body *UpdateSurveyInviteSettingsBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewUpdateSurveyInviteSettingsForbidden builds a survey service
+// update_survey_invite_settings endpoint Forbidden error.
+func NewUpdateSurveyInviteSettingsForbidden(body *UpdateSurveyInviteSettingsForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateSurveyInviteSettingsInternalServerError builds a survey service
+// update_survey_invite_settings endpoint InternalServerError error.
+func NewUpdateSurveyInviteSettingsInternalServerError(body *UpdateSurveyInviteSettingsInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateSurveyInviteSettingsServiceUnavailable builds a survey service
+// update_survey_invite_settings endpoint ServiceUnavailable error.
+func NewUpdateSurveyInviteSettingsServiceUnavailable(body *UpdateSurveyInviteSettingsServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateSurveyInviteSettingsUnauthorized builds a survey service
+// update_survey_invite_settings endpoint Unauthorized error.
+func NewUpdateSurveyInviteSettingsUnauthorized(body *UpdateSurveyInviteSettingsUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteSurveyInviteSettingsBadRequest builds a survey service
+// delete_survey_invite_settings endpoint BadRequest error.
+func NewDeleteSurveyInviteSettingsBadRequest(body *DeleteSurveyInviteSettingsBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewDeleteSurveyInviteSettingsForbidden builds a survey service
+// delete_survey_invite_settings endpoint Forbidden error.
+func NewDeleteSurveyInviteSettingsForbidden(body *DeleteSurveyInviteSettingsForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteSurveyInviteSettingsInternalServerError builds a survey service
+// delete_survey_invite_settings endpoint InternalServerError error.
+func NewDeleteSurveyInviteSettingsInternalServerError(body *DeleteSurveyInviteSettingsInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteSurveyInviteSettingsNotFound builds a survey service
+// delete_survey_invite_settings endpoint NotFound error.
+func NewDeleteSurveyInviteSettingsNotFound(body *DeleteSurveyInviteSettingsNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteSurveyInviteSettingsServiceUnavailable builds a survey service
+// delete_survey_invite_settings endpoint ServiceUnavailable error.
+func NewDeleteSurveyInviteSettingsServiceUnavailable(body *DeleteSurveyInviteSettingsServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteSurveyInviteSettingsUnauthorized builds a survey service
+// delete_survey_invite_settings endpoint Unauthorized error.
+func NewDeleteSurveyInviteSettingsUnauthorized(body *DeleteSurveyInviteSettingsUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetProjectInviteSettingsInviteSettingsOK builds a "survey" service
+// "get_project_invite_settings" endpoint result from a HTTP "OK" response.
+func NewGetProjectInviteSettingsInviteSettingsOK(body *GetProjectInviteSettingsResponseBody) *survey.InviteSettings {
+	v := &survey.InviteSettings{
+		Scope:               *body.Scope,
+		UID:                 *body.UID,
+		Enabled:             body.Enabled,
+		Role:                body.Role,
+		ExpirationDays:      body.ExpirationDays,
+		ReturnURLTemplate:   body.ReturnURLTemplate,
+		DisplayNameFallback: body.DisplayNameFallback,
+		Locale:              body.Locale,
+		UpdatedAt:           *body.UpdatedAt,
+		UpdatedBy:           body.UpdatedBy,
+	}
+
+	return v
+}
+
+// NewGetProjectInviteSettingsBadRequest builds a survey service
+// get_project_invite_settings endpoint BadRequest error.
+func NewGetProjectInviteSettingsBadRequest(body *GetProjectInviteSettingsBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewGetProjectInviteSettingsForbidden builds a survey service
+// get_project_invite_settings endpoint Forbidden error.
+func NewGetProjectInviteSettingsForbidden(body *GetProjectInviteSettingsForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetProjectInviteSettingsInternalServerError builds a survey service
+// get_project_invite_settings endpoint InternalServerError error.
+func NewGetProjectInviteSettingsInternalServerError(body *GetProjectInviteSettingsInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetProjectInviteSettingsNotFound builds a survey service
+// get_project_invite_settings endpoint NotFound error.
+func NewGetProjectInviteSettingsNotFound(body *GetProjectInviteSettingsNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetProjectInviteSettingsServiceUnavailable builds a survey service
+// get_project_invite_settings endpoint ServiceUnavailable error.
+func NewGetProjectInviteSettingsServiceUnavailable(body *GetProjectInviteSettingsServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetProjectInviteSettingsUnauthorized builds a survey service
+// get_project_invite_settings endpoint Unauthorized error.
+func NewGetProjectInviteSettingsUnauthorized(body *GetProjectInviteSettingsUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateProjectInviteSettingsInviteSettingsOK builds a "survey" service
+// "update_project_invite_settings" endpoint result from a HTTP "OK" response.
+func NewUpdateProjectInviteSettingsInviteSettingsOK(body *UpdateProjectInviteSettingsResponseBody) *survey.InviteSettings {
+	v := &survey.InviteSettings{
+		Scope:               *body.Scope,
+		UID:                 *body.UID,
+		Enabled:             body.Enabled,
+		Role:                body.Role,
+		ExpirationDays:      body.ExpirationDays,
+		ReturnURLTemplate:   body.ReturnURLTemplate,
+		DisplayNameFallback: body.DisplayNameFallback,
+		Locale:              body.Locale,
+		UpdatedAt:           *body.UpdatedAt,
+		UpdatedBy:           body.UpdatedBy,
+	}
+
+	return v
+}
+
+// NewUpdateProjectInviteSettingsBadRequest builds a survey service
+// update_project_invite_settings endpoint BadRequest error.
+func NewUpdateProjectInviteSettingsBadRequest(body *UpdateProjectInviteSettingsBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewUpdateProjectInviteSettingsForbidden builds a survey service
+// update_project_invite_settings endpoint Forbidden error.
+func NewUpdateProjectInviteSettingsForbidden(body *UpdateProjectInviteSettingsForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateProjectInviteSettingsInternalServerError builds a survey service
+// update_project_invite_settings endpoint InternalServerError error.
+func NewUpdateProjectInviteSettingsInternalServerError(body *UpdateProjectInviteSettingsInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateProjectInviteSettingsServiceUnavailable builds a survey service
+// update_project_invite_settings endpoint ServiceUnavailable error.
+func NewUpdateProjectInviteSettingsServiceUnavailable(body *UpdateProjectInviteSettingsServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateProjectInviteSettingsUnauthorized builds a survey service
+// update_project_invite_settings endpoint Unauthorized error.
+func NewUpdateProjectInviteSettingsUnauthorized(body *UpdateProjectInviteSettingsUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteProjectInviteSettingsBadRequest builds a survey service
+// delete_project_invite_settings endpoint BadRequest error.
+func NewDeleteProjectInviteSettingsBadRequest(body *DeleteProjectInviteSettingsBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewDeleteProjectInviteSettingsForbidden builds a survey service
+// delete_project_invite_settings endpoint Forbidden error.
+func NewDeleteProjectInviteSettingsForbidden(body *DeleteProjectInviteSettingsForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteProjectInviteSettingsInternalServerError builds a survey service
+// delete_project_invite_settings endpoint InternalServerError error.
+func NewDeleteProjectInviteSettingsInternalServerError(body *DeleteProjectInviteSettingsInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteProjectInviteSettingsNotFound builds a survey service
+// delete_project_invite_settings endpoint NotFound error.
+func NewDeleteProjectInviteSettingsNotFound(body *DeleteProjectInviteSettingsNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteProjectInviteSettingsServiceUnavailable builds a survey service
+// delete_project_invite_settings endpoint ServiceUnavailable error.
+func NewDeleteProjectInviteSettingsServiceUnavailable(body *DeleteProjectInviteSettingsServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteProjectInviteSettingsUnauthorized builds a survey service
+// delete_project_invite_settings endpoint Unauthorized error.
+func NewDeleteProjectInviteSettingsUnauthorized(body *DeleteProjectInviteSettingsUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetSurveySettingsSurveySettingsOK builds a "survey" service
+// "get_survey_settings" endpoint result from a HTTP "OK" response.
+func NewGetSurveySettingsSurveySettingsOK(body *GetSurveySettingsResponseBody) *survey.SurveySettings {
+	v := &survey.SurveySettings{
+		SurveyUID:    *body.SurveyUID,
+		ShareResults: *body.ShareResults,
+		Anonymous:    *body.Anonymous,
+		UpdatedAt:    body.UpdatedAt,
+		UpdatedBy:    body.UpdatedBy,
+	}
+	if body.ResultsAccess != nil {
+		v.ResultsAccess = unmarshalResultsAccessSyncResponseBodyToSurveyResultsAccessSync(body.ResultsAccess)
+	}
+
+	return v
+}
+
+// NewGetSurveySettingsBadRequest builds a survey service get_survey_settings
+// endpoint BadRequest error.
+func NewGetSurveySettingsBadRequest(body *GetSurveySettingsBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewGetSurveySettingsForbidden builds a survey service get_survey_settings
+// endpoint Forbidden error.
+func NewGetSurveySettingsForbidden(body *GetSurveySettingsForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetSurveySettingsInternalServerError builds a survey service
+// get_survey_settings endpoint InternalServerError error.
+func NewGetSurveySettingsInternalServerError(body *GetSurveySettingsInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetSurveySettingsServiceUnavailable builds a survey service
+// get_survey_settings endpoint ServiceUnavailable error.
+func NewGetSurveySettingsServiceUnavailable(body *GetSurveySettingsServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetSurveySettingsUnauthorized builds a survey service get_survey_settings
+// endpoint Unauthorized error.
+func NewGetSurveySettingsUnauthorized(body *GetSurveySettingsUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateSurveySettingsSurveySettingsOK builds a "survey" service
+// "update_survey_settings" endpoint result from a HTTP "OK" response.
+func NewUpdateSurveySettingsSurveySettingsOK(body *UpdateSurveySettingsResponseBody) *survey.SurveySettings {
+	v := &survey.SurveySettings{
+		SurveyUID:    *body.SurveyUID,
+		ShareResults: *body.ShareResults,
+		Anonymous:    *body.Anonymous,
+		UpdatedAt:    body.UpdatedAt,
+		UpdatedBy:    body.UpdatedBy,
+	}
+	if body.ResultsAccess != nil {
+		v.ResultsAccess = unmarshalResultsAccessSyncResponseBodyToSurveyResultsAccessSync(body.ResultsAccess)
+	}
+
+	return v
+}
+
+// NewUpdateSurveySettingsBadRequest builds a survey service
+// update_survey_settings endpoint BadRequest error.
+func NewUpdateSurveySettingsBadRequest(body *UpdateSurveySettingsBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewUpdateSurveySettingsConflict builds a survey service
+// update_survey_settings endpoint Conflict error.
+func NewUpdateSurveySettingsConflict(body *UpdateSurveySettingsConflictResponseBody) *survey.ConflictError {
+	v := &survey.ConflictError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateSurveySettingsForbidden builds a survey service
+// update_survey_settings endpoint Forbidden error.
+func NewUpdateSurveySettingsForbidden(body *UpdateSurveySettingsForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateSurveySettingsInternalServerError builds a survey service
+// update_survey_settings endpoint InternalServerError error.
+func NewUpdateSurveySettingsInternalServerError(body *UpdateSurveySettingsInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateSurveySettingsNotFound builds a survey service
+// update_survey_settings endpoint NotFound error.
+func NewUpdateSurveySettingsNotFound(body *UpdateSurveySettingsNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateSurveySettingsServiceUnavailable builds a survey service
+// update_survey_settings endpoint ServiceUnavailable error.
+func NewUpdateSurveySettingsServiceUnavailable(body *UpdateSurveySettingsServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateSurveySettingsUnauthorized builds a survey service
+// update_survey_settings endpoint Unauthorized error.
+func NewUpdateSurveySettingsUnauthorized(body *UpdateSurveySettingsUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetSurveyResultsSurveyResultsOK builds a "survey" service
+// "get_survey_results" endpoint result from a HTTP "OK" response.
+func NewGetSurveyResultsSurveyResultsOK(body *GetSurveyResultsResponseBody) *survey.SurveyResults {
+	v := &survey.SurveyResults{
+		SurveyUID:     *body.SurveyUID,
+		NumRecipients: *body.NumRecipients,
+		NumResponses:  *body.NumResponses,
+		SurveyEndTime: body.SurveyEndTime,
+		MinCellSize:   body.MinCellSize,
+		Suppressed:    body.Suppressed,
+	}
+	v.Questions = make([]*survey.SurveyQuestionResult, len(body.Questions))
+	for i, val := range body.Questions {
+		if val == nil {
+			v.Questions[i] = nil
+			continue
+		}
+		v.Questions[i] = unmarshalSurveyQuestionResultResponseBodyToSurveySurveyQuestionResult(val)
+	}
+
+	return v
+}
+
+// NewGetSurveyResultsBadRequest builds a survey service get_survey_results
+// endpoint BadRequest error.
+func NewGetSurveyResultsBadRequest(body *GetSurveyResultsBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewGetSurveyResultsForbidden builds a survey service get_survey_results
+// endpoint Forbidden error.
+func NewGetSurveyResultsForbidden(body *GetSurveyResultsForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetSurveyResultsInternalServerError builds a survey service
+// get_survey_results endpoint InternalServerError error.
+func NewGetSurveyResultsInternalServerError(body *GetSurveyResultsInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetSurveyResultsNotFound builds a survey service get_survey_results
+// endpoint NotFound error.
+func NewGetSurveyResultsNotFound(body *GetSurveyResultsNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetSurveyResultsServiceUnavailable builds a survey service
+// get_survey_results endpoint ServiceUnavailable error.
+func NewGetSurveyResultsServiceUnavailable(body *GetSurveyResultsServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetSurveyResultsUnauthorized builds a survey service get_survey_results
+// endpoint Unauthorized error.
+func NewGetSurveyResultsUnauthorized(body *GetSurveyResultsUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewValidateEmailResultOK builds a "survey" service "validate_email" endpoint
+// result from a HTTP "OK" response.
+func NewValidateEmailResultOK(body *ValidateEmailResponseBody) *survey.ValidateEmailResult {
+	v := &survey.ValidateEmailResult{
+		Body:    *body.Body,
+		Subject: *body.Subject,
+	}
+
+	return v
+}
+
+// NewValidateEmailBadRequest builds a survey service validate_email endpoint
+// BadRequest error.
+func NewValidateEmailBadRequest(body *ValidateEmailBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewValidateEmailForbidden builds a survey service validate_email endpoint
+// Forbidden error.
+func NewValidateEmailForbidden(body *ValidateEmailForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewValidateEmailInternalServerError builds a survey service validate_email
+// endpoint InternalServerError error.
+func NewValidateEmailInternalServerError(body *ValidateEmailInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewValidateEmailUnauthorized builds a survey service validate_email endpoint
+// Unauthorized error.
+func NewValidateEmailUnauthorized(body *ValidateEmailUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListSurveyTemplatesSurveyTemplateListOK builds a "survey" service
+// "list_survey_templates" endpoint result from a HTTP "OK" response.
+func NewListSurveyTemplatesSurveyTemplateListOK(body *ListSurveyTemplatesResponseBody) *survey.SurveyTemplateList {
+	v := &survey.SurveyTemplateList{
+		Total:      *body.Total,
+		NextOffset: body.NextOffset,
+	}
+	v.Templates = make([]*survey.SurveyTemplate, len(body.Templates))
+	for i, val := range body.Templates {
+		if val == nil {
+			v.Templates[i] = nil
+			continue
+		}
+		v.Templates[i] = unmarshalSurveyTemplateResponseBodyToSurveySurveyTemplate(val)
+	}
+
+	return v
+}
+
+// NewListSurveyTemplatesBadRequest builds a survey service
+// list_survey_templates endpoint BadRequest error.
+func NewListSurveyTemplatesBadRequest(body *ListSurveyTemplatesBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewListSurveyTemplatesForbidden builds a survey service
+// list_survey_templates endpoint Forbidden error.
+func NewListSurveyTemplatesForbidden(body *ListSurveyTemplatesForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListSurveyTemplatesInternalServerError builds a survey service
+// list_survey_templates endpoint InternalServerError error.
+func NewListSurveyTemplatesInternalServerError(body *ListSurveyTemplatesInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListSurveyTemplatesServiceUnavailable builds a survey service
+// list_survey_templates endpoint ServiceUnavailable error.
+func NewListSurveyTemplatesServiceUnavailable(body *ListSurveyTemplatesServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListSurveyTemplatesUnauthorized builds a survey service
+// list_survey_templates endpoint Unauthorized error.
+func NewListSurveyTemplatesUnauthorized(body *ListSurveyTemplatesUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetSurveyTemplateSurveyTemplateOK builds a "survey" service
+// "get_survey_template" endpoint result from a HTTP "OK" response.
+func NewGetSurveyTemplateSurveyTemplateOK(body *GetSurveyTemplateResponseBody) *survey.SurveyTemplate {
+	v := &survey.SurveyTemplate{
+		ID:            *body.ID,
+		Title:         *body.Title,
+		Nickname:      body.Nickname,
+		Category:      body.Category,
+		Language:      body.Language,
+		QuestionCount: body.QuestionCount,
+		PageCount:     body.PageCount,
+		PreviewURL:    body.PreviewURL,
+		CollectURL:    body.CollectURL,
+		DateCreated:   body.DateCreated,
+		DateModified:  body.DateModified,
+	}
+
+	return v
+}
+
+// NewGetSurveyTemplateBadRequest builds a survey service get_survey_template
+// endpoint BadRequest error.
+func NewGetSurveyTemplateBadRequest(body *GetSurveyTemplateBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewGetSurveyTemplateForbidden builds a survey service get_survey_template
+// endpoint Forbidden error.
+func NewGetSurveyTemplateForbidden(body *GetSurveyTemplateForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetSurveyTemplateInternalServerError builds a survey service
+// get_survey_template endpoint InternalServerError error.
+func NewGetSurveyTemplateInternalServerError(body *GetSurveyTemplateInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetSurveyTemplateNotFound builds a survey service get_survey_template
+// endpoint NotFound error.
+func NewGetSurveyTemplateNotFound(body *GetSurveyTemplateNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetSurveyTemplateServiceUnavailable builds a survey service
+// get_survey_template endpoint ServiceUnavailable error.
+func NewGetSurveyTemplateServiceUnavailable(body *GetSurveyTemplateServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetSurveyTemplateUnauthorized builds a survey service get_survey_template
+// endpoint Unauthorized error.
+func NewGetSurveyTemplateUnauthorized(body *GetSurveyTemplateUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListDeadLettersDeadLetterListOK builds a "survey" service
+// "list_dead_letters" endpoint result from a HTTP "OK" response.
+func NewListDeadLettersDeadLetterListOK(body *ListDeadLettersResponseBody) *survey.DeadLetterList {
+	v := &survey.DeadLetterList{
+		NextAfter: body.NextAfter,
+	}
+	v.Entries = make([]*survey.DeadLetterEntry, len(body.Entries))
+	for i, val := range body.Entries {
+		if val == nil {
+			v.Entries[i] = nil
+			continue
+		}
+		v.Entries[i] = unmarshalDeadLetterEntryResponseBodyToSurveyDeadLetterEntry(val)
+	}
+
+	return v
+}
+
+// NewListDeadLettersBadRequest builds a survey service list_dead_letters
+// endpoint BadRequest error.
+func NewListDeadLettersBadRequest(body *ListDeadLettersBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewListDeadLettersForbidden builds a survey service list_dead_letters
+// endpoint Forbidden error.
+func NewListDeadLettersForbidden(body *ListDeadLettersForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListDeadLettersInternalServerError builds a survey service
+// list_dead_letters endpoint InternalServerError error.
+func NewListDeadLettersInternalServerError(body *ListDeadLettersInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListDeadLettersServiceUnavailable builds a survey service
+// list_dead_letters endpoint ServiceUnavailable error.
+func NewListDeadLettersServiceUnavailable(body *ListDeadLettersServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListDeadLettersUnauthorized builds a survey service list_dead_letters
+// endpoint Unauthorized error.
+func NewListDeadLettersUnauthorized(body *ListDeadLettersUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetDeadLetterDeadLetterEntryOK builds a "survey" service
+// "get_dead_letter" endpoint result from a HTTP "OK" response.
+func NewGetDeadLetterDeadLetterEntryOK(body *GetDeadLetterResponseBody) *survey.DeadLetterEntry {
+	v := &survey.DeadLetterEntry{
+		Sequence:       *body.Sequence,
+		Key:            *body.Key,
+		Operation:      *body.Operation,
+		Revision:       body.Revision,
+		Reason:         *body.Reason,
+		Error:          *body.Error,
+		Attempts:       *body.Attempts,
+		DeadLetteredAt: *body.DeadLetteredAt,
+		Payload:        body.Payload,
+	}
+	if body.Headers != nil {
+		v.Headers = make(map[string][]string, len(body.Headers))
+		for key, val := range body.Headers {
+			tk := key
+			tv := make([]string, len(val))
+			for i, val := range val {
+				tv[i] = val
+			}
+			v.Headers[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetDeadLetterBadRequest builds a survey service get_dead_letter endpoint
+// BadRequest error.
+func NewGetDeadLetterBadRequest(body *GetDeadLetterBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewGetDeadLetterForbidden builds a survey service get_dead_letter endpoint
+// Forbidden error.
+func NewGetDeadLetterForbidden(body *GetDeadLetterForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetDeadLetterInternalServerError builds a survey service get_dead_letter
+// endpoint InternalServerError error.
+func NewGetDeadLetterInternalServerError(body *GetDeadLetterInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetDeadLetterNotFound builds a survey service get_dead_letter endpoint
+// NotFound error.
+func NewGetDeadLetterNotFound(body *GetDeadLetterNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetDeadLetterServiceUnavailable builds a survey service get_dead_letter
+// endpoint ServiceUnavailable error.
+func NewGetDeadLetterServiceUnavailable(body *GetDeadLetterServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetDeadLetterUnauthorized builds a survey service get_dead_letter
+// endpoint Unauthorized error.
+func NewGetDeadLetterUnauthorized(body *GetDeadLetterUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewReplayDeadLetterDeadLetterReplayResultOK builds a "survey" service
+// "replay_dead_letter" endpoint result from a HTTP "OK" response.
+func NewReplayDeadLetterDeadLetterReplayResultOK(body *ReplayDeadLetterResponseBody) *survey.DeadLetterReplayResult {
+	v := &survey.DeadLetterReplayResult{
+		Sequence:  *body.Sequence,
+		Key:       *body.Key,
+		Outcome:   *body.Outcome,
+		Succeeded: *body.Succeeded,
+		Error:     body.Error,
+	}
+
+	return v
+}
+
+// NewReplayDeadLetterBadRequest builds a survey service replay_dead_letter
+// endpoint BadRequest error.
+func NewReplayDeadLetterBadRequest(body *ReplayDeadLetterBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewReplayDeadLetterForbidden builds a survey service replay_dead_letter
+// endpoint Forbidden error.
+func NewReplayDeadLetterForbidden(body *ReplayDeadLetterForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewReplayDeadLetterInternalServerError builds a survey service
+// replay_dead_letter endpoint InternalServerError error.
+func NewReplayDeadLetterInternalServerError(body *ReplayDeadLetterInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewReplayDeadLetterNotFound builds a survey service replay_dead_letter
+// endpoint NotFound error.
+func NewReplayDeadLetterNotFound(body *ReplayDeadLetterNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewReplayDeadLetterServiceUnavailable builds a survey service
+// replay_dead_letter endpoint ServiceUnavailable error.
+func NewReplayDeadLetterServiceUnavailable(body *ReplayDeadLetterServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewReplayDeadLetterUnauthorized builds a survey service replay_dead_letter
+// endpoint Unauthorized error.
+func NewReplayDeadLetterUnauthorized(body *ReplayDeadLetterUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewStartReindexReindexStatusAccepted builds a "survey" service
+// "start_reindex" endpoint result from a HTTP "Accepted" response.
+func NewStartReindexReindexStatusAccepted(body *StartReindexResponseBody) *survey.ReindexStatus {
+	v := &survey.ReindexStatus{
+		State:         *body.State,
+		DryRun:        *body.DryRun,
+		Force:         *body.Force,
+		ModifiedSince: body.ModifiedSince,
+		ModifiedUntil: body.ModifiedUntil,
+		RatePerSecond: *body.RatePerSecond,
+		StartedAt:     *body.StartedAt,
+		FinishedAt:    body.FinishedAt,
+		Total:         *body.Total,
+		Scanned:       *body.Scanned,
+		Matched:       *body.Matched,
+		Processed:     *body.Processed,
+		Failed:        *body.Failed,
+		Error:         body.Error,
+	}
+	if body.KeyPrefixes != nil {
+		v.KeyPrefixes = make([]string, len(body.KeyPrefixes))
+		for i, val := range body.KeyPrefixes {
+			v.KeyPrefixes[i] = val
+		}
+	}
+	if body.FailedKeys != nil {
+		v.FailedKeys = make([]string, len(body.FailedKeys))
+		for i, val := range body.FailedKeys {
+			v.FailedKeys[i] = val
+		}
+	}
+
+	return v
+}
+
+// NewStartReindexBadRequest builds a survey service start_reindex endpoint
+// BadRequest error.
+func NewStartReindexBadRequest(body *StartReindexBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewStartReindexConflict builds a survey service start_reindex endpoint
+// Conflict error.
+func NewStartReindexConflict(body *StartReindexConflictResponseBody) *survey.ConflictError {
+	v := &survey.ConflictError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewStartReindexForbidden builds a survey service start_reindex endpoint
+// Forbidden error.
+func NewStartReindexForbidden(body *StartReindexForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewStartReindexInternalServerError builds a survey service start_reindex
+// endpoint InternalServerError error.
+func NewStartReindexInternalServerError(body *StartReindexInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewStartReindexServiceUnavailable builds a survey service start_reindex
+// endpoint ServiceUnavailable error.
+func NewStartReindexServiceUnavailable(body *StartReindexServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewStartReindexUnauthorized builds a survey service start_reindex endpoint
+// Unauthorized error.
+func NewStartReindexUnauthorized(body *StartReindexUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetReindexReindexStatusOK builds a "survey" service "get_reindex"
+// endpoint result from a HTTP "OK" response.
+func NewGetReindexReindexStatusOK(body *GetReindexResponseBody) *survey.ReindexStatus {
+	v := &survey.ReindexStatus{
+		State:         *body.State,
+		DryRun:        *body.DryRun,
+		Force:         *body.Force,
+		ModifiedSince: body.ModifiedSince,
+		ModifiedUntil: body.ModifiedUntil,
+		RatePerSecond: *body.RatePerSecond,
+		StartedAt:     *body.StartedAt,
+		FinishedAt:    body.FinishedAt,
+		Total:         *body.Total,
+		Scanned:       *body.Scanned,
+		Matched:       *body.Matched,
+		Processed:     *body.Processed,
+		Failed:        *body.Failed,
+		Error:         body.Error,
+	}
+	if body.KeyPrefixes != nil {
+		v.KeyPrefixes = make([]string, len(body.KeyPrefixes))
+		for i, val := range body.KeyPrefixes {
+			v.KeyPrefixes[i] = val
+		}
+	}
+	if body.FailedKeys != nil {
+		v.FailedKeys = make([]string, len(body.FailedKeys))
+		for i, val := range body.FailedKeys {
+			v.FailedKeys[i] = val
+		}
+	}
+
+	return v
+}
+
+// NewGetReindexBadRequest builds a survey service get_reindex endpoint
+// BadRequest error.
+func NewGetReindexBadRequest(body *GetReindexBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewGetReindexForbidden builds a survey service get_reindex endpoint
+// Forbidden error.
+func NewGetReindexForbidden(body *GetReindexForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetReindexInternalServerError builds a survey service get_reindex
+// endpoint InternalServerError error.
+func NewGetReindexInternalServerError(body *GetReindexInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetReindexNotFound builds a survey service get_reindex endpoint NotFound
+// error.
+func NewGetReindexNotFound(body *GetReindexNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetReindexServiceUnavailable builds a survey service get_reindex endpoint
+// ServiceUnavailable error.
+func NewGetReindexServiceUnavailable(body *GetReindexServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetReindexUnauthorized builds a survey service get_reindex endpoint
+// Unauthorized error.
+func NewGetReindexUnauthorized(body *GetReindexUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCancelReindexReindexStatusOK builds a "survey" service "cancel_reindex"
+// endpoint result from a HTTP "OK" response.
+func NewCancelReindexReindexStatusOK(body *CancelReindexResponseBody) *survey.ReindexStatus {
+	v := &survey.ReindexStatus{
+		State:         *body.State,
+		DryRun:        *body.DryRun,
+		Force:         *body.Force,
+		ModifiedSince: body.ModifiedSince,
+		ModifiedUntil: body.ModifiedUntil,
+		RatePerSecond: *body.RatePerSecond,
+		StartedAt:     *body.StartedAt,
+		FinishedAt:    body.FinishedAt,
+		Total:         *body.Total,
+		Scanned:       *body.Scanned,
+		Matched:       *body.Matched,
+		Processed:     *body.Processed,
+		Failed:        *body.Failed,
+		Error:         body.Error,
+	}
+	if body.KeyPrefixes != nil {
+		v.KeyPrefixes = make([]string, len(body.KeyPrefixes))
+		for i, val := range body.KeyPrefixes {
+			v.KeyPrefixes[i] = val
+		}
+	}
+	if body.FailedKeys != nil {
+		v.FailedKeys = make([]string, len(body.FailedKeys))
+		for i, val := range body.FailedKeys {
+			v.FailedKeys[i] = val
+		}
+	}
+
+	return v
+}
+
+// NewCancelReindexBadRequest builds a survey service cancel_reindex endpoint
+// BadRequest error.
+func NewCancelReindexBadRequest(body *CancelReindexBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewCancelReindexConflict builds a survey service cancel_reindex endpoint
+// Conflict error.
+func NewCancelReindexConflict(body *CancelReindexConflictResponseBody) *survey.ConflictError {
+	v := &survey.ConflictError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCancelReindexForbidden builds a survey service cancel_reindex endpoint
+// Forbidden error.
+func NewCancelReindexForbidden(body *CancelReindexForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCancelReindexInternalServerError builds a survey service cancel_reindex
+// endpoint InternalServerError error.
+func NewCancelReindexInternalServerError(body *CancelReindexInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCancelReindexServiceUnavailable builds a survey service cancel_reindex
+// endpoint ServiceUnavailable error.
+func NewCancelReindexServiceUnavailable(body *CancelReindexServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCancelReindexUnauthorized builds a survey service cancel_reindex endpoint
+// Unauthorized error.
+func NewCancelReindexUnauthorized(body *CancelReindexUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRerunInviteEnrichmentInviteEnrichmentResultOK builds a "survey" service
+// "rerun_invite_enrichment" endpoint result from a HTTP "OK" response.
+func NewRerunInviteEnrichmentInviteEnrichmentResultOK(body *RerunInviteEnrichmentResponseBody) *survey.InviteEnrichmentResult {
+	v := &survey.InviteEnrichmentResult{
+		Email:              *body.Email,
+		Username:           *body.Username,
+		DeadLettersCleared: *body.DeadLettersCleared,
+	}
+
+	return v
+}
+
+// NewRerunInviteEnrichmentBadRequest builds a survey service
+// rerun_invite_enrichment endpoint BadRequest error.
+func NewRerunInviteEnrichmentBadRequest(body *RerunInviteEnrichmentBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewRerunInviteEnrichmentForbidden builds a survey service
+// rerun_invite_enrichment endpoint Forbidden error.
+func NewRerunInviteEnrichmentForbidden(body *RerunInviteEnrichmentForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRerunInviteEnrichmentInternalServerError builds a survey service
+// rerun_invite_enrichment endpoint InternalServerError error.
+func NewRerunInviteEnrichmentInternalServerError(body *RerunInviteEnrichmentInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRerunInviteEnrichmentNotFound builds a survey service
+// rerun_invite_enrichment endpoint NotFound error.
+func NewRerunInviteEnrichmentNotFound(body *RerunInviteEnrichmentNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRerunInviteEnrichmentServiceUnavailable builds a survey service
+// rerun_invite_enrichment endpoint ServiceUnavailable error.
+func NewRerunInviteEnrichmentServiceUnavailable(body *RerunInviteEnrichmentServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRerunInviteEnrichmentUnauthorized builds a survey service
+// rerun_invite_enrichment endpoint Unauthorized error.
+func NewRerunInviteEnrichmentUnauthorized(body *RerunInviteEnrichmentUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewStartInviteBackfillInviteBackfillStatusAccepted builds a "survey" service
+// "start_invite_backfill" endpoint result from a HTTP "Accepted" response.
+func NewStartInviteBackfillInviteBackfillStatusAccepted(body *StartInviteBackfillResponseBody) *survey.InviteBackfillStatus {
+	v := &survey.InviteBackfillStatus{
+		State:         *body.State,
+		DryRun:        *body.DryRun,
+		RatePerSecond: *body.RatePerSecond,
+		StartedAt:     *body.StartedAt,
+		FinishedAt:    body.FinishedAt,
+		Total:         *body.Total,
+		Scanned:       *body.Scanned,
+		Candidates:    *body.Candidates,
+		Recipients:    *body.Recipients,
+		Invited:       *body.Invited,
+		Attached:      *body.Attached,
+		Failed:        *body.Failed,
+		Error:         body.Error,
+	}
+	if body.SurveyUids != nil {
+		v.SurveyUids = make([]string, len(body.SurveyUids))
+		for i, val := range body.SurveyUids {
+			v.SurveyUids[i] = val
+		}
+	}
+	v.Skipped = make(map[string]int, len(body.Skipped))
+	for key, val := range body.Skipped {
+		tk := key
+		tv := val
+		v.Skipped[tk] = tv
+	}
+	if body.CandidateResponseUids != nil {
+		v.CandidateResponseUids = make([]string, len(body.CandidateResponseUids))
+		for i, val := range body.CandidateResponseUids {
+			v.CandidateResponseUids[i] = val
+		}
+	}
+
+	return v
+}
+
+// NewStartInviteBackfillBadRequest builds a survey service
+// start_invite_backfill endpoint BadRequest error.
+func NewStartInviteBackfillBadRequest(body *StartInviteBackfillBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewStartInviteBackfillConflict builds a survey service start_invite_backfill
+// endpoint Conflict error.
+func NewStartInviteBackfillConflict(body *StartInviteBackfillConflictResponseBody) *survey.ConflictError {
+	v := &survey.ConflictError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewStartInviteBackfillForbidden builds a survey service
+// start_invite_backfill endpoint Forbidden error.
+func NewStartInviteBackfillForbidden(body *StartInviteBackfillForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewStartInviteBackfillInternalServerError builds a survey service
+// start_invite_backfill endpoint InternalServerError error.
+func NewStartInviteBackfillInternalServerError(body *StartInviteBackfillInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewStartInviteBackfillServiceUnavailable builds a survey service
+// start_invite_backfill endpoint ServiceUnavailable error.
+func NewStartInviteBackfillServiceUnavailable(body *StartInviteBackfillServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewStartInviteBackfillUnauthorized builds a survey service
+// start_invite_backfill endpoint Unauthorized error.
+func NewStartInviteBackfillUnauthorized(body *StartInviteBackfillUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetInviteBackfillInviteBackfillStatusOK builds a "survey" service
+// "get_invite_backfill" endpoint result from a HTTP "OK" response.
+func NewGetInviteBackfillInviteBackfillStatusOK(body *GetInviteBackfillResponseBody) *survey.InviteBackfillStatus {
+	v := &survey.InviteBackfillStatus{
+		State:         *body.State,
+		DryRun:        *body.DryRun,
+		RatePerSecond: *body.RatePerSecond,
+		StartedAt:     *body.StartedAt,
+		FinishedAt:    body.FinishedAt,
+		Total:         *body.Total,
+		Scanned:       *body.Scanned,
+		Candidates:    *body.Candidates,
+		Recipients:    *body.Recipients,
+		Invited:       *body.Invited,
+		Attached:      *body.Attached,
+		Failed:        *body.Failed,
+		Error:         body.Error,
+	}
+	if body.SurveyUids != nil {
+		v.SurveyUids = make([]string, len(body.SurveyUids))
+		for i, val := range body.SurveyUids {
+			v.SurveyUids[i] = val
+		}
+	}
+	v.Skipped = make(map[string]int, len(body.Skipped))
+	for key, val := range body.Skipped {
+		tk := key
+		tv := val
+		v.Skipped[tk] = tv
+	}
+	if body.CandidateResponseUids != nil {
+		v.CandidateResponseUids = make([]string, len(body.CandidateResponseUids))
+		for i, val := range body.CandidateResponseUids {
+			v.CandidateResponseUids[i] = val
+		}
+	}
+
+	return v
+}
+
+// NewGetInviteBackfillBadRequest builds a survey service get_invite_backfill
+// endpoint BadRequest error.
+func NewGetInviteBackfillBadRequest(body *GetInviteBackfillBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewGetInviteBackfillForbidden builds a survey service get_invite_backfill
+// endpoint Forbidden error.
+func NewGetInviteBackfillForbidden(body *GetInviteBackfillForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetInviteBackfillInternalServerError builds a survey service
+// get_invite_backfill endpoint InternalServerError error.
+func NewGetInviteBackfillInternalServerError(body *GetInviteBackfillInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetInviteBackfillNotFound builds a survey service get_invite_backfill
+// endpoint NotFound error.
+func NewGetInviteBackfillNotFound(body *GetInviteBackfillNotFoundResponseBody) *survey.NotFoundError {
+	v := &survey.NotFoundError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetInviteBackfillServiceUnavailable builds a survey service
+// get_invite_backfill endpoint ServiceUnavailable error.
+func NewGetInviteBackfillServiceUnavailable(body *GetInviteBackfillServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewGetInviteBackfillUnauthorized builds a survey service get_invite_backfill
+// endpoint Unauthorized error.
+func NewGetInviteBackfillUnauthorized(body *GetInviteBackfillUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCancelInviteBackfillInviteBackfillStatusOK builds a "survey" service
+// "cancel_invite_backfill" endpoint result from a HTTP "OK" response.
+func NewCancelInviteBackfillInviteBackfillStatusOK(body *CancelInviteBackfillResponseBody) *survey.InviteBackfillStatus {
+	v := &survey.InviteBackfillStatus{
+		State:         *body.State,
+		DryRun:        *body.DryRun,
+		RatePerSecond: *body.RatePerSecond,
+		StartedAt:     *body.StartedAt,
+		FinishedAt:    body.FinishedAt,
+		Total:         *body.Total,
+		Scanned:       *body.Scanned,
+		Candidates:    *body.Candidates,
+		Recipients:    *body.Recipients,
+		Invited:       *body.Invited,
+		Attached:      *body.Attached,
+		Failed:        *body.Failed,
+		Error:         body.Error,
+	}
+	if body.SurveyUids != nil {
+		v.SurveyUids = make([]string, len(body.SurveyUids))
+		for i, val := range body.SurveyUids {
+			v.SurveyUids[i] = val
+		}
+	}
+	v.Skipped = make(map[string]int, len(body.Skipped))
+	for key, val := range body.Skipped {
+		tk := key
+		tv := val
+		v.Skipped[tk] = tv
+	}
+	if body.CandidateResponseUids != nil {
+		v.CandidateResponseUids = make([]string, len(body.CandidateResponseUids))
+		for i, val := range body.CandidateResponseUids {
+			v.CandidateResponseUids[i] = val
+		}
+	}
+
+	return v
+}
+
+// NewCancelInviteBackfillBadRequest builds a survey service
+// cancel_invite_backfill endpoint BadRequest error.
+func NewCancelInviteBackfillBadRequest(body *CancelInviteBackfillBadRequestResponseBody) *survey.BadRequestError {
+	v := &survey.BadRequestError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+	if body.QuestionErrors != nil {
+		v.QuestionErrors = make([]*survey.QuestionError, len(body.QuestionErrors))
+		for i, val := range body.QuestionErrors {
+			if val == nil {
+				v.QuestionErrors[i] = nil
+				continue
+			}
+			v.QuestionErrors[i] = unmarshalQuestionErrorResponseBodyToSurveyQuestionError(val)
+		}
+	}
+
+	return v
+}
+
+// NewCancelInviteBackfillConflict builds a survey service
+// cancel_invite_backfill endpoint Conflict error.
+func NewCancelInviteBackfillConflict(body *CancelInviteBackfillConflictResponseBody) *survey.ConflictError {
+	v := &survey.ConflictError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCancelInviteBackfillForbidden builds a survey service
+// cancel_invite_backfill endpoint Forbidden error.
+func NewCancelInviteBackfillForbidden(body *CancelInviteBackfillForbiddenResponseBody) *survey.ForbiddenError {
+	v := &survey.ForbiddenError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCancelInviteBackfillInternalServerError builds a survey service
+// cancel_invite_backfill endpoint InternalServerError error.
+func NewCancelInviteBackfillInternalServerError(body *CancelInviteBackfillInternalServerErrorResponseBody) *survey.InternalServerError {
+	v := &survey.InternalServerError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCancelInviteBackfillServiceUnavailable builds a survey service
+// cancel_invite_backfill endpoint ServiceUnavailable error.
+func NewCancelInviteBackfillServiceUnavailable(body *CancelInviteBackfillServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
+	v := &survey.ServiceUnavailableError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCancelInviteBackfillUnauthorized builds a survey service
+// cancel_invite_backfill endpoint Unauthorized error.
+func NewCancelInviteBackfillUnauthorized(body *CancelInviteBackfillUnauthorizedResponseBody) *survey.UnauthorizedError {
+	v := &survey.UnauthorizedError{
+		Code:    *body.Code,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// ValidateScheduleSurveyResponseBody runs the validations defined on
+// schedule_survey_response_body
+func ValidateScheduleSurveyResponseBody(body *ScheduleSurveyResponseBody) (err error) {
+	if body.UID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
+	}
+	if body.SurveyStatus == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("survey_status", "body"))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.LastModifiedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.last_modified_at", *body.LastModifiedAt, goa.FormatDateTime))
+	}
+	if body.SurveyStatus != nil {
+		if !(*body.SurveyStatus == "scheduled" || *body.SurveyStatus == "sending" || *body.SurveyStatus == "sent" || *body.SurveyStatus == "cancelled") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.survey_status", *body.SurveyStatus, []any{"scheduled", "sending", "sent", "cancelled"}))
+		}
+	}
+	if body.ResponseStatus != nil {
+		if !(*body.ResponseStatus == "scheduled" || *body.ResponseStatus == "open" || *body.ResponseStatus == "closed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.response_status", *body.ResponseStatus, []any{"scheduled", "open", "closed"}))
+		}
+	}
+	if body.SurveySendDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.survey_send_date", *body.SurveySendDate, goa.FormatDateTime))
+	}
+	if body.SurveyCutoffDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.survey_cutoff_date", *body.SurveyCutoffDate, goa.FormatDateTime))
+	}
+	if body.NextAutomatedReminderAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.next_automated_reminder_at", *body.NextAutomatedReminderAt, goa.FormatDateTime))
+	}
+	if body.LatestAutomatedReminderSentAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.latest_automated_reminder_sent_at", *body.LatestAutomatedReminderSentAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateGetSurveyResponseBody runs the validations defined on
+// get_survey_response_body
+func ValidateGetSurveyResponseBody(body *GetSurveyResponseBody) (err error) {
+	if body.UID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
+	}
+	if body.SurveyStatus == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("survey_status", "body"))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.LastModifiedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.last_modified_at", *body.LastModifiedAt, goa.FormatDateTime))
+	}
+	if body.SurveyStatus != nil {
+		if !(*body.SurveyStatus == "scheduled" || *body.SurveyStatus == "sending" || *body.SurveyStatus == "sent" || *body.SurveyStatus == "cancelled") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.survey_status", *body.SurveyStatus, []any{"scheduled", "sending", "sent", "cancelled"}))
+		}
+	}
+	if body.ResponseStatus != nil {
+		if !(*body.ResponseStatus == "scheduled" || *body.ResponseStatus == "open" || *body.ResponseStatus == "closed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.response_status", *body.ResponseStatus, []any{"scheduled", "open", "closed"}))
+		}
+	}
+	if body.SurveySendDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.survey_send_date", *body.SurveySendDate, goa.FormatDateTime))
+	}
+	if body.SurveyCutoffDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.survey_cutoff_date", *body.SurveyCutoffDate, goa.FormatDateTime))
+	}
+	if body.NextAutomatedReminderAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.next_automated_reminder_at", *body.NextAutomatedReminderAt, goa.FormatDateTime))
+	}
+	if body.LatestAutomatedReminderSentAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.latest_automated_reminder_sent_at", *body.LatestAutomatedReminderSentAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateUpdateSurveyResponseBody runs the validations defined on
+// update_survey_response_body
+func ValidateUpdateSurveyResponseBody(body *UpdateSurveyResponseBody) (err error) {
+	if body.UID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
+	}
+	if body.SurveyStatus == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("survey_status", "body"))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.LastModifiedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.last_modified_at", *body.LastModifiedAt, goa.FormatDateTime))
+	}
+	if body.SurveyStatus != nil {
+		if !(*body.SurveyStatus == "scheduled" || *body.SurveyStatus == "sending" || *body.SurveyStatus == "sent" || *body.SurveyStatus == "cancelled") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.survey_status", *body.SurveyStatus, []any{"scheduled", "sending", "sent", "cancelled"}))
+		}
+	}
+	if body.ResponseStatus != nil {
+		if !(*body.ResponseStatus == "scheduled" || *body.ResponseStatus == "open" || *body.ResponseStatus == "closed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.response_status", *body.ResponseStatus, []any{"scheduled", "open", "closed"}))
+		}
+	}
+	if body.SurveySendDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.survey_send_date", *body.SurveySendDate, goa.FormatDateTime))
+	}
+	if body.SurveyCutoffDate != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.survey_cutoff_date", *body.SurveyCutoffDate, goa.FormatDateTime))
+	}
+	if body.NextAutomatedReminderAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.next_automated_reminder_at", *body.NextAutomatedReminderAt, goa.FormatDateTime))
+	}
+	if body.LatestAutomatedReminderSentAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.latest_automated_reminder_sent_at", *body.LatestAutomatedReminderSentAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidatePreviewSendSurveyResponseBody runs the validations defined on
+// preview_send_survey_response_body
+func ValidatePreviewSendSurveyResponseBody(body *PreviewSendSurveyResponseBody) (err error) {
+	for _, e := range body.AffectedProjects {
+		if e != nil {
+			if err2 := ValidateLFXProjectResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.AffectedCommittees {
+		if e != nil {
+			if err2 := ValidateExcludedCommitteeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.AffectedRecipients {
+		if e != nil {
+			if err2 := ValidateITXPreviewRecipientResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCreateExclusionResponseBody runs the validations defined on
+// create_exclusion_response_body
+func ValidateCreateExclusionResponseBody(body *CreateExclusionResponseBody) (err error) {
+	if body.UID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
+	}
+	return
+}
+
+// ValidateGetExclusionResponseBody runs the validations defined on
+// get_exclusion_response_body
+func ValidateGetExclusionResponseBody(body *GetExclusionResponseBody) (err error) {
+	if body.UID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
+	}
+	return
+}
+
+// ValidateListSurveyResponsesResponseBody runs the validations defined on
+// list_survey_responses_response_body
+func ValidateListSurveyResponsesResponseBody(body *ListSurveyResponsesResponseBody) (err error) {
+	if body.Data == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("data", "body"))
+	}
+	if body.Meta == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("meta", "body"))
+	}
+	for _, e := range body.Data {
+		if e != nil {
+			if err2 := ValidateSurveyResponseItemResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListSurveyInvitesResponseBody runs the validations defined on
+// list_survey_invites_response_body
+func ValidateListSurveyInvitesResponseBody(body *ListSurveyInvitesResponseBody) (err error) {
+	if body.Counts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("counts", "body"))
+	}
+	if body.Invites == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("invites", "body"))
+	}
+	if body.Counts != nil {
+		if err2 := ValidateSurveyInviteCountsResponseBody(body.Counts); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.Invites {
+		if e != nil {
+			if err2 := ValidateSurveyInviteResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetSurveyInviteSettingsResponseBody runs the validations defined on
+// get_survey_invite_settings_response_body
+func ValidateGetSurveyInviteSettingsResponseBody(body *GetSurveyInviteSettingsResponseBody) (err error) {
+	if body.Scope == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scope", "body"))
+	}
+	if body.UID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.Scope != nil {
+		if !(*body.Scope == "project" || *body.Scope == "survey") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.scope", *body.Scope, []any{"project", "survey"}))
+		}
+	}
+	if body.Role != nil {
+		if !(*body.Role == "Participant") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.role", *body.Role, []any{"Participant"}))
+		}
+	}
+	if body.ExpirationDays != nil {
+		if *body.ExpirationDays < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.expiration_days", *body.ExpirationDays, 1, true))
+		}
+	}
+	if body.ExpirationDays != nil {
+		if *body.ExpirationDays > 90 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.expiration_days", *body.ExpirationDays, 90, false))
+		}
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateUpdateSurveyInviteSettingsResponseBody runs the validations defined
+// on update_survey_invite_settings_response_body
+func ValidateUpdateSurveyInviteSettingsResponseBody(body *UpdateSurveyInviteSettingsResponseBody) (err error) {
+	if body.Scope == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scope", "body"))
+	}
+	if body.UID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.Scope != nil {
+		if !(*body.Scope == "project" || *body.Scope == "survey") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.scope", *body.Scope, []any{"project", "survey"}))
+		}
+	}
+	if body.Role != nil {
+		if !(*body.Role == "Participant") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.role", *body.Role, []any{"Participant"}))
+		}
+	}
+	if body.ExpirationDays != nil {
+		if *body.ExpirationDays < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.expiration_days", *body.ExpirationDays, 1, true))
+		}
+	}
+	if body.ExpirationDays != nil {
+		if *body.ExpirationDays > 90 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.expiration_days", *body.ExpirationDays, 90, false))
+		}
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateGetProjectInviteSettingsResponseBody runs the validations defined on
+// get_project_invite_settings_response_body
+func ValidateGetProjectInviteSettingsResponseBody(body *GetProjectInviteSettingsResponseBody) (err error) {
+	if body.Scope == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scope", "body"))
+	}
+	if body.UID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.Scope != nil {
+		if !(*body.Scope == "project" || *body.Scope == "survey") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.scope", *body.Scope, []any{"project", "survey"}))
+		}
+	}
+	if body.Role != nil {
+		if !(*body.Role == "Participant") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.role", *body.Role, []any{"Participant"}))
+		}
+	}
+	if body.ExpirationDays != nil {
+		if *body.ExpirationDays < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.expiration_days", *body.ExpirationDays, 1, true))
+		}
+	}
+	if body.ExpirationDays != nil {
+		if *body.ExpirationDays > 90 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.expiration_days", *body.ExpirationDays, 90, false))
+		}
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateUpdateProjectInviteSettingsResponseBody runs the validations defined
+// on update_project_invite_settings_response_body
+func ValidateUpdateProjectInviteSettingsResponseBody(body *UpdateProjectInviteSettingsResponseBody) (err error) {
+	if body.Scope == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scope", "body"))
+	}
+	if body.UID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.Scope != nil {
+		if !(*body.Scope == "project" || *body.Scope == "survey") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.scope", *body.Scope, []any{"project", "survey"}))
+		}
+	}
+	if body.Role != nil {
+		if !(*body.Role == "Participant") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.role", *body.Role, []any{"Participant"}))
+		}
+	}
+	if body.ExpirationDays != nil {
+		if *body.ExpirationDays < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.expiration_days", *body.ExpirationDays, 1, true))
+		}
+	}
+	if body.ExpirationDays != nil {
+		if *body.ExpirationDays > 90 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.expiration_days", *body.ExpirationDays, 90, false))
+		}
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateGetSurveySettingsResponseBody runs the validations defined on
+// get_survey_settings_response_body
+func ValidateGetSurveySettingsResponseBody(body *GetSurveySettingsResponseBody) (err error) {
+	if body.SurveyUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("survey_uid", "body"))
+	}
+	if body.ShareResults == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("share_results", "body"))
+	}
+	if body.Anonymous == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("anonymous", "body"))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	if body.ResultsAccess != nil {
+		if err2 := ValidateResultsAccessSyncResponseBody(body.ResultsAccess); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateUpdateSurveySettingsResponseBody runs the validations defined on
+// update_survey_settings_response_body
+func ValidateUpdateSurveySettingsResponseBody(body *UpdateSurveySettingsResponseBody) (err error) {
+	if body.SurveyUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("survey_uid", "body"))
+	}
+	if body.ShareResults == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("share_results", "body"))
+	}
+	if body.Anonymous == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("anonymous", "body"))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	if body.ResultsAccess != nil {
+		if err2 := ValidateResultsAccessSyncResponseBody(body.ResultsAccess); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateGetSurveyResultsResponseBody runs the validations defined on
+// get_survey_results_response_body
+func ValidateGetSurveyResultsResponseBody(body *GetSurveyResultsResponseBody) (err error) {
+	if body.SurveyUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("survey_uid", "body"))
+	}
+	if body.NumRecipients == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("num_recipients", "body"))
+	}
+	if body.NumResponses == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("num_responses", "body"))
+	}
+	if body.Questions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("questions", "body"))
+	}
+	if body.SurveyEndTime != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.survey_end_time", *body.SurveyEndTime, goa.FormatDateTime))
+	}
+	for _, e := range body.Questions {
+		if e != nil {
+			if err2 := ValidateSurveyQuestionResultResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateValidateEmailResponseBody runs the validations defined on
+// validate_email_response_body
+func ValidateValidateEmailResponseBody(body *ValidateEmailResponseBody) (err error) {
+	if body.Body == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("body", "body"))
+	}
+	if body.Subject == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("subject", "body"))
+	}
+	return
+}
+
+// ValidateListSurveyTemplatesResponseBody runs the validations defined on
+// list_survey_templates_response_body
+func ValidateListSurveyTemplatesResponseBody(body *ListSurveyTemplatesResponseBody) (err error) {
+	if body.Templates == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("templates", "body"))
+	}
+	if body.Total == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total", "body"))
+	}
+	for _, e := range body.Templates {
+		if e != nil {
+			if err2 := ValidateSurveyTemplateResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetSurveyTemplateResponseBody runs the validations defined on
+// get_survey_template_response_body
+func ValidateGetSurveyTemplateResponseBody(body *GetSurveyTemplateResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Title == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("title", "body"))
+	}
+	return
+}
+
+// ValidateListDeadLettersResponseBody runs the validations defined on
+// list_dead_letters_response_body
+func ValidateListDeadLettersResponseBody(body *ListDeadLettersResponseBody) (err error) {
+	if body.Entries == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("entries", "body"))
+	}
+	for _, e := range body.Entries {
+		if e != nil {
+			if err2 := ValidateDeadLetterEntryResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetDeadLetterResponseBody runs the validations defined on
+// get_dead_letter_response_body
+func ValidateGetDeadLetterResponseBody(body *GetDeadLetterResponseBody) (err error) {
+	if body.Sequence == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sequence", "body"))
+	}
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.Operation == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("operation", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.Error == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("error", "body"))
+	}
+	if body.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "body"))
+	}
+	if body.DeadLetteredAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("dead_lettered_at", "body"))
+	}
+	if body.Operation != nil {
+		if !(*body.Operation == "PUT" || *body.Operation == "DEL" || *body.Operation == "PURGE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.operation", *body.Operation, []any{"PUT", "DEL", "PURGE"}))
+		}
+	}
+	if body.Reason != nil {
+		if !(*body.Reason == "max_deliveries" || *body.Reason == "conversion_error") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.reason", *body.Reason, []any{"max_deliveries", "conversion_error"}))
+		}
+	}
+	if body.DeadLetteredAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.dead_lettered_at", *body.DeadLetteredAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateReplayDeadLetterResponseBody runs the validations defined on
+// replay_dead_letter_response_body
+func ValidateReplayDeadLetterResponseBody(body *ReplayDeadLetterResponseBody) (err error) {
+	if body.Sequence == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sequence", "body"))
+	}
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.Outcome == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("outcome", "body"))
+	}
+	if body.Succeeded == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("succeeded", "body"))
+	}
+	if body.Outcome != nil {
+		if !(*body.Outcome == "replayed" || *body.Outcome == "superseded" || *body.Outcome == "key_deleted") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.outcome", *body.Outcome, []any{"replayed", "superseded", "key_deleted"}))
+		}
+	}
+	return
+}
+
+// ValidateStartReindexResponseBody runs the validations defined on
+// start_reindex_response_body
+func ValidateStartReindexResponseBody(body *StartReindexResponseBody) (err error) {
+	if body.State == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("state", "body"))
+	}
+	if body.DryRun == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("dry_run", "body"))
+	}
+	if body.Force == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("force", "body"))
+	}
+	if body.RatePerSecond == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rate_per_second", "body"))
+	}
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("started_at", "body"))
+	}
+	if body.Total == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total", "body"))
+	}
+	if body.Scanned == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scanned", "body"))
+	}
+	if body.Matched == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("matched", "body"))
+	}
+	if body.Processed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("processed", "body"))
+	}
+	if body.Failed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("failed", "body"))
+	}
+	if body.State != nil {
+		if !(*body.State == "running" || *body.State == "completed" || *body.State == "cancelled" || *body.State == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.state", *body.State, []any{"running", "completed", "cancelled", "failed"}))
+		}
+	}
+	if body.ModifiedSince != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.modified_since", *body.ModifiedSince, goa.FormatDateTime))
+	}
+	if body.ModifiedUntil != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.modified_until", *body.ModifiedUntil, goa.FormatDateTime))
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.started_at", *body.StartedAt, goa.FormatDateTime))
+	}
+	if body.FinishedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.finished_at", *body.FinishedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateGetReindexResponseBody runs the validations defined on
+// get_reindex_response_body
+func ValidateGetReindexResponseBody(body *GetReindexResponseBody) (err error) {
+	if body.State == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("state", "body"))
+	}
+	if body.DryRun == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("dry_run", "body"))
+	}
+	if body.Force == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("force", "body"))
+	}
+	if body.RatePerSecond == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rate_per_second", "body"))
+	}
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("started_at", "body"))
+	}
+	if body.Total == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total", "body"))
+	}
+	if body.Scanned == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scanned", "body"))
+	}
+	if body.Matched == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("matched", "body"))
+	}
+	if body.Processed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("processed", "body"))
+	}
+	if body.Failed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("failed", "body"))
+	}
+	if body.State != nil {
+		if !(*body.State == "running" || *body.State == "completed" || *body.State == "cancelled" || *body.State == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.state", *body.State, []any{"running", "completed", "cancelled", "failed"}))
+		}
+	}
+	if body.ModifiedSince != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.modified_since", *body.ModifiedSince, goa.FormatDateTime))
+	}
+	if body.ModifiedUntil != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.modified_until", *body.ModifiedUntil, goa.FormatDateTime))
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.started_at", *body.StartedAt, goa.FormatDateTime))
+	}
+	if body.FinishedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.finished_at", *body.FinishedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateCancelReindexResponseBody runs the validations defined on
+// cancel_reindex_response_body
+func ValidateCancelReindexResponseBody(body *CancelReindexResponseBody) (err error) {
+	if body.State == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("state", "body"))
+	}
+	if body.DryRun == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("dry_run", "body"))
+	}
+	if body.Force == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("force", "body"))
+	}
+	if body.RatePerSecond == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rate_per_second", "body"))
+	}
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("started_at", "body"))
+	}
+	if body.Total == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total", "body"))
+	}
+	if body.Scanned == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scanned", "body"))
+	}
+	if body.Matched == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("matched", "body"))
+	}
+	if body.Processed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("processed", "body"))
+	}
+	if body.Failed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("failed", "body"))
+	}
+	if body.State != nil {
+		if !(*body.State == "running" || *body.State == "completed" || *body.State == "cancelled" || *body.State == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.state", *body.State, []any{"running", "completed", "cancelled", "failed"}))
+		}
+	}
+	if body.ModifiedSince != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.modified_since", *body.ModifiedSince, goa.FormatDateTime))
+	}
+	if body.ModifiedUntil != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.modified_until", *body.ModifiedUntil, goa.FormatDateTime))
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.started_at", *body.StartedAt, goa.FormatDateTime))
+	}
+	if body.FinishedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.finished_at", *body.FinishedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateRerunInviteEnrichmentResponseBody runs the validations defined on
+// rerun_invite_enrichment_response_body
+func ValidateRerunInviteEnrichmentResponseBody(body *RerunInviteEnrichmentResponseBody) (err error) {
+	if body.Email == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("email", "body"))
+	}
+	if body.Username == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("username", "body"))
+	}
+	if body.DeadLettersCleared == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("dead_letters_cleared", "body"))
+	}
+	return
+}
+
+// ValidateStartInviteBackfillResponseBody runs the validations defined on
+// start_invite_backfill_response_body
+func ValidateStartInviteBackfillResponseBody(body *StartInviteBackfillResponseBody) (err error) {
+	if body.State == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("state", "body"))
+	}
+	if body.DryRun == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("dry_run", "body"))
+	}
+	if body.RatePerSecond == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rate_per_second", "body"))
+	}
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("started_at", "body"))
+	}
+	if body.Total == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total", "body"))
+	}
+	if body.Scanned == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scanned", "body"))
+	}
+	if body.Candidates == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("candidates", "body"))
+	}
+	if body.Recipients == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("recipients", "body"))
+	}
+	if body.Invited == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("invited", "body"))
+	}
+	if body.Attached == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attached", "body"))
+	}
+	if body.Failed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("failed", "body"))
+	}
+	if body.Skipped == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("skipped", "body"))
+	}
+	if body.State != nil {
+		if !(*body.State == "running" || *body.State == "completed" || *body.State == "cancelled" || *body.State == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.state", *body.State, []any{"running", "completed", "cancelled", "failed"}))
+		}
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.started_at", *body.StartedAt, goa.FormatDateTime))
+	}
+	if body.FinishedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.finished_at", *body.FinishedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateGetInviteBackfillResponseBody runs the validations defined on
+// get_invite_backfill_response_body
+func ValidateGetInviteBackfillResponseBody(body *GetInviteBackfillResponseBody) (err error) {
+	if body.State == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("state", "body"))
+	}
+	if body.DryRun == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("dry_run", "body"))
+	}
+	if body.RatePerSecond == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rate_per_second", "body"))
+	}
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("started_at", "body"))
+	}
+	if body.Total == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total", "body"))
+	}
+	if body.Scanned == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scanned", "body"))
+	}
+	if body.Candidates == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("candidates", "body"))
+	}
+	if body.Recipients == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("recipients", "body"))
+	}
+	if body.Invited == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("invited", "body"))
+	}
+	if body.Attached == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attached", "body"))
+	}
+	if body.Failed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("failed", "body"))
+	}
+	if body.Skipped == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("skipped", "body"))
+	}
+	if body.State != nil {
+		if !(*body.State == "running" || *body.State == "completed" || *body.State == "cancelled" || *body.State == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.state", *body.State, []any{"running", "completed", "cancelled", "failed"}))
+		}
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.started_at", *body.StartedAt, goa.FormatDateTime))
+	}
+	if body.FinishedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.finished_at", *body.FinishedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateCancelInviteBackfillResponseBody runs the validations defined on
+// cancel_invite_backfill_response_body
+func ValidateCancelInviteBackfillResponseBody(body *CancelInviteBackfillResponseBody) (err error) {
+	if body.State == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("state", "body"))
+	}
+	if body.DryRun == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("dry_run", "body"))
+	}
+	if body.RatePerSecond == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rate_per_second", "body"))
+	}
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("started_at", "body"))
+	}
+	if body.Total == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total", "body"))
+	}
+	if body.Scanned == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scanned", "body"))
+	}
+	if body.Candidates == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("candidates", "body"))
+	}
+	if body.Recipients == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("recipients", "body"))
+	}
+	if body.Invited == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("invited", "body"))
+	}
+	if body.Attached == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attached", "body"))
+	}
+	if body.Failed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("failed", "body"))
+	}
+	if body.Skipped == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("skipped", "body"))
+	}
+	if body.State != nil {
+		if !(*body.State == "running" || *body.State == "completed" || *body.State == "cancelled" || *body.State == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.state", *body.State, []any{"running", "completed", "cancelled", "failed"}))
+		}
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.started_at", *body.StartedAt, goa.FormatDateTime))
+	}
+	if body.FinishedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.finished_at", *body.FinishedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateScheduleSurveyBadRequestResponseBody runs the validations defined on
+// schedule_survey_BadRequest_response_body
+func ValidateScheduleSurveyBadRequestResponseBody(body *ScheduleSurveyBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateScheduleSurveyForbiddenResponseBody runs the validations defined on
+// schedule_survey_Forbidden_response_body
+func ValidateScheduleSurveyForbiddenResponseBody(body *ScheduleSurveyForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateScheduleSurveyInternalServerErrorResponseBody runs the validations
+// defined on schedule_survey_InternalServerError_response_body
+func ValidateScheduleSurveyInternalServerErrorResponseBody(body *ScheduleSurveyInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateScheduleSurveyServiceUnavailableResponseBody runs the validations
+// defined on schedule_survey_ServiceUnavailable_response_body
+func ValidateScheduleSurveyServiceUnavailableResponseBody(body *ScheduleSurveyServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateScheduleSurveyUnauthorizedResponseBody runs the validations defined
+// on schedule_survey_Unauthorized_response_body
+func ValidateScheduleSurveyUnauthorizedResponseBody(body *ScheduleSurveyUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// SurveyQuestionAnswerResponseBody is used to define fields on response body
-// types.
-type SurveyQuestionAnswerResponseBody struct {
-	// Question identifier
-	QuestionID *string `form:"question_id,omitempty" json:"question_id,omitempty" xml:"question_id,omitempty"`
-	// Question text as shown to the recipient
-	QuestionText *string `form:"question_text,omitempty" json:"question_text,omitempty" xml:"question_text,omitempty"`
-	// Question type family (e.g. rating, open_ended, single_choice)
-	QuestionFamily *string `form:"question_family,omitempty" json:"question_family,omitempty" xml:"question_family,omitempty"`
-	// Question subtype within the family
-	QuestionSubtype *string `form:"question_subtype,omitempty" json:"question_subtype,omitempty" xml:"question_subtype,omitempty"`
-	// Answers selected or entered by the recipient
-	Answers []*SurveyAnswerChoiceResponseBody `form:"answers,omitempty" json:"answers,omitempty" xml:"answers,omitempty"`
+// ValidateGetSurveyBadRequestResponseBody runs the validations defined on
+// get_survey_BadRequest_response_body
+func ValidateGetSurveyBadRequestResponseBody(body *GetSurveyBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
 }
 
-// SurveyAnswerChoiceResponseBody is used to define fields on response body
-// types.
-type SurveyAnswerChoiceResponseBody struct {
-	// Choice identifier (for multiple-choice questions)
-	ChoiceID *string `form:"choice_id,omitempty" json:"choice_id,omitempty" xml:"choice_id,omitempty"`
-	// Answer text (for open-ended questions or choice label)
-	Text *string `form:"text,omitempty" json:"text,omitempty" xml:"text,omitempty"`
+// ValidateGetSurveyForbiddenResponseBody runs the validations defined on
+// get_survey_Forbidden_response_body
+func ValidateGetSurveyForbiddenResponseBody(body *GetSurveyForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// SurveyResponsePageMetaResponseBody is used to define fields on response body
-// types.
-type SurveyResponsePageMetaResponseBody struct {
-	// Opaque token for the next page; empty string on the last page
-	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty" xml:"page_token,omitempty"`
-	// Total number of pages
-	TotalPages *int `form:"total_pages,omitempty" json:"total_pages,omitempty" xml:"total_pages,omitempty"`
-	// Total number of responses across all pages
-	TotalResults *int `form:"total_results,omitempty" json:"total_results,omitempty" xml:"total_results,omitempty"`
-	// Number of results per page
-	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty" xml:"per_page,omitempty"`
+// ValidateGetSurveyInternalServerErrorResponseBody runs the validations
+// defined on get_survey_InternalServerError_response_body
+func ValidateGetSurveyInternalServerErrorResponseBody(body *GetSurveyInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewScheduleSurveyRequestBody builds the HTTP request body from the payload
-// of the "schedule_survey" endpoint of the "survey" service.
-func NewScheduleSurveyRequestBody(p *survey.ScheduleSurveyPayload) *ScheduleSurveyRequestBody {
-	body := &ScheduleSurveyRequestBody{
-		CommitteeUID:           p.CommitteeUID,
-		IsProjectSurvey:        p.IsProjectSurvey,
-		StageFilter:            p.StageFilter,
-		CreatorUsername:        p.CreatorUsername,
-		CreatorName:            p.CreatorName,
-		CreatorID:              p.CreatorID,
-		SurveyMonkeyID:         p.SurveyMonkeyID,
-		SurveyTitle:            p.SurveyTitle,
-		SendImmediately:        p.SendImmediately,
-		SurveySendDate:         p.SurveySendDate,
-		SurveyCutoffDate:       p.SurveyCutoffDate,
-		SurveyReminderRateDays: p.SurveyReminderRateDays,
-		EmailSubject:           p.EmailSubject,
-		EmailBody:              p.EmailBody,
-		EmailBodyText:          p.EmailBodyText,
-		CommitteeVotingEnabled: p.CommitteeVotingEnabled,
+// ValidateGetSurveyNotFoundResponseBody runs the validations defined on
+// get_survey_NotFound_response_body
+func ValidateGetSurveyNotFoundResponseBody(body *GetSurveyNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	return body
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewUpdateSurveyRequestBody builds the HTTP request body from the payload of
-// the "update_survey" endpoint of the "survey" service.
-func NewUpdateSurveyRequestBody(p *survey.UpdateSurveyPayload) *UpdateSurveyRequestBody {
-	body := &UpdateSurveyRequestBody{
-		CommitteeUID:           p.CommitteeUID,
-		CreatorID:              p.CreatorID,
-		SurveyTitle:            p.SurveyTitle,
-		SurveySendDate:         p.SurveySendDate,
-		SurveyCutoffDate:       p.SurveyCutoffDate,
-		SurveyReminderRateDays: p.SurveyReminderRateDays,
-		EmailSubject:           p.EmailSubject,
-		EmailBody:              p.EmailBody,
-		EmailBodyText:          p.EmailBodyText,
-		CommitteeVotingEnabled: p.CommitteeVotingEnabled,
+// ValidateGetSurveyServiceUnavailableResponseBody runs the validations defined
+// on get_survey_ServiceUnavailable_response_body
+func ValidateGetSurveyServiceUnavailableResponseBody(body *GetSurveyServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	return body
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewBulkResendSurveyRequestBody builds the HTTP request body from the payload
-// of the "bulk_resend_survey" endpoint of the "survey" service.
-func NewBulkResendSurveyRequestBody(p *survey.BulkResendSurveyPayload) *BulkResendSurveyRequestBody {
-	body := &BulkResendSurveyRequestBody{}
-	if p.RecipientIds != nil {
-		body.RecipientIds = make([]string, len(p.RecipientIds))
-		for i, val := range p.RecipientIds {
-			body.RecipientIds[i] = val
+// ValidateGetSurveyUnauthorizedResponseBody runs the validations defined on
+// get_survey_Unauthorized_response_body
+func ValidateGetSurveyUnauthorizedResponseBody(body *GetSurveyUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateSurveyBadRequestResponseBody runs the validations defined on
+// update_survey_BadRequest_response_body
+func ValidateUpdateSurveyBadRequestResponseBody(body *UpdateSurveyBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
 		}
-	} else {
-		body.RecipientIds = []string{}
 	}
-	return body
+	return
+}
+
+// ValidateUpdateSurveyForbiddenResponseBody runs the validations defined on
+// update_survey_Forbidden_response_body
+func ValidateUpdateSurveyForbiddenResponseBody(body *UpdateSurveyForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateSurveyInternalServerErrorResponseBody runs the validations
+// defined on update_survey_InternalServerError_response_body
+func ValidateUpdateSurveyInternalServerErrorResponseBody(body *UpdateSurveyInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateSurveyNotFoundResponseBody runs the validations defined on
+// update_survey_NotFound_response_body
+func ValidateUpdateSurveyNotFoundResponseBody(body *UpdateSurveyNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateSurveyServiceUnavailableResponseBody runs the validations
+// defined on update_survey_ServiceUnavailable_response_body
+func ValidateUpdateSurveyServiceUnavailableResponseBody(body *UpdateSurveyServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateSurveyUnauthorizedResponseBody runs the validations defined on
+// update_survey_Unauthorized_response_body
+func ValidateUpdateSurveyUnauthorizedResponseBody(body *UpdateSurveyUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteSurveyBadRequestResponseBody runs the validations defined on
+// delete_survey_BadRequest_response_body
+func ValidateDeleteSurveyBadRequestResponseBody(body *DeleteSurveyBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateDeleteSurveyForbiddenResponseBody runs the validations defined on
+// delete_survey_Forbidden_response_body
+func ValidateDeleteSurveyForbiddenResponseBody(body *DeleteSurveyForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteSurveyInternalServerErrorResponseBody runs the validations
+// defined on delete_survey_InternalServerError_response_body
+func ValidateDeleteSurveyInternalServerErrorResponseBody(body *DeleteSurveyInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewCreateExclusionRequestBody builds the HTTP request body from the payload
-// of the "create_exclusion" endpoint of the "survey" service.
-func NewCreateExclusionRequestBody(p *survey.CreateExclusionPayload) *CreateExclusionRequestBody {
-	body := &CreateExclusionRequestBody{
-		Email:           p.Email,
-		UserID:          p.UserID,
-		SurveyUID:       p.SurveyUID,
-		CommitteeUID:    p.CommitteeUID,
-		GlobalExclusion: p.GlobalExclusion,
+// ValidateDeleteSurveyNotFoundResponseBody runs the validations defined on
+// delete_survey_NotFound_response_body
+func ValidateDeleteSurveyNotFoundResponseBody(body *DeleteSurveyNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	return body
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewDeleteExclusionRequestBody builds the HTTP request body from the payload
-// of the "delete_exclusion" endpoint of the "survey" service.
-func NewDeleteExclusionRequestBody(p *survey.DeleteExclusionPayload) *DeleteExclusionRequestBody {
-	body := &DeleteExclusionRequestBody{
-		Email:           p.Email,
-		UserID:          p.UserID,
-		SurveyUID:       p.SurveyUID,
-		CommitteeUID:    p.CommitteeUID,
-		GlobalExclusion: p.GlobalExclusion,
+// ValidateDeleteSurveyServiceUnavailableResponseBody runs the validations
+// defined on delete_survey_ServiceUnavailable_response_body
+func ValidateDeleteSurveyServiceUnavailableResponseBody(body *DeleteSurveyServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	return body
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewValidateEmailRequestBody builds the HTTP request body from the payload of
-// the "validate_email" endpoint of the "survey" service.
-func NewValidateEmailRequestBody(p *survey.ValidateEmailPayload) *ValidateEmailRequestBody {
-	body := &ValidateEmailRequestBody{
-		Body:    p.Body,
-		Subject: p.Subject,
+// ValidateDeleteSurveyUnauthorizedResponseBody runs the validations defined on
+// delete_survey_Unauthorized_response_body
+func ValidateDeleteSurveyUnauthorizedResponseBody(body *DeleteSurveyUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	return body
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewScheduleSurveySurveyScheduleResultCreated builds a "survey" service
-// "schedule_survey" endpoint result from a HTTP "Created" response.
-func NewScheduleSurveySurveyScheduleResultCreated(body *ScheduleSurveyResponseBody) *survey.SurveyScheduleResult {
-	v := &survey.SurveyScheduleResult{
-		UID:                           *body.UID,
-		SurveyMonkeyID:                body.SurveyMonkeyID,
-		IsProjectSurvey:               body.IsProjectSurvey,
-		StageFilter:                   body.StageFilter,
-		CreatorUsername:               body.CreatorUsername,
-		CreatorName:                   body.CreatorName,
-		CreatorID:                     body.CreatorID,
-		CreatedAt:                     body.CreatedAt,
-		LastModifiedAt:                body.LastModifiedAt,
-		LastModifiedBy:                body.LastModifiedBy,
-		SurveyTitle:                   body.SurveyTitle,
-		SurveyStatus:                  *body.SurveyStatus,
-		ResponseStatus:                body.ResponseStatus,
-		SurveySendDate:                body.SurveySendDate,
-		SurveyCutoffDate:              body.SurveyCutoffDate,
-		SurveyReminderRateDays:        body.SurveyReminderRateDays,
-		EmailSubject:                  body.EmailSubject,
-		EmailBody:                     body.EmailBody,
-		EmailBodyText:                 body.EmailBodyText,
-		CommitteeCategory:             body.CommitteeCategory,
-		CommitteeVotingEnabled:        body.CommitteeVotingEnabled,
-		SurveyURL:                     body.SurveyURL,
-		SendImmediately:               body.SendImmediately,
-		TotalRecipients:               body.TotalRecipients,
-		TotalResponses:                body.TotalResponses,
-		IsNpsSurvey:                   body.IsNpsSurvey,
-		NpsValue:                      body.NpsValue,
-		NumPromoters:                  body.NumPromoters,
-		NumPassives:                   body.NumPassives,
-		NumDetractors:                 body.NumDetractors,
-		TotalBouncedEmails:            body.TotalBouncedEmails,
-		NumAutomatedRemindersToSend:   body.NumAutomatedRemindersToSend,
-		NumAutomatedRemindersSent:     body.NumAutomatedRemindersSent,
-		NextAutomatedReminderAt:       body.NextAutomatedReminderAt,
-		LatestAutomatedReminderSentAt: body.LatestAutomatedReminderSentAt,
+// ValidateBulkResendSurveyBadRequestResponseBody runs the validations defined
+// on bulk_resend_survey_BadRequest_response_body
+func ValidateBulkResendSurveyBadRequestResponseBody(body *BulkResendSurveyBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.Committees != nil {
-		v.Committees = make([]*survey.SurveyCommittee, len(body.Committees))
-		for i, val := range body.Committees {
-			if val == nil {
-				v.Committees[i] = nil
-				continue
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
 			}
-			v.Committees[i] = unmarshalSurveyCommitteeResponseBodyToSurveySurveyCommittee(val)
 		}
 	}
-
-	return v
+	return
 }
 
-// NewScheduleSurveyBadRequest builds a survey service schedule_survey endpoint
-// BadRequest error.
-func NewScheduleSurveyBadRequest(body *ScheduleSurveyBadRequestResponseBody) *survey.BadRequestError {
-	v := &survey.BadRequestError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateBulkResendSurveyForbiddenResponseBody runs the validations defined
+// on bulk_resend_survey_Forbidden_response_body
+func ValidateBulkResendSurveyForbiddenResponseBody(body *BulkResendSurveyForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewScheduleSurveyForbidden builds a survey service schedule_survey endpoint
-// Forbidden error.
-func NewScheduleSurveyForbidden(body *ScheduleSurveyForbiddenResponseBody) *survey.ForbiddenError {
-	v := &survey.ForbiddenError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateBulkResendSurveyInternalServerErrorResponseBody runs the validations
+// defined on bulk_resend_survey_InternalServerError_response_body
+func ValidateBulkResendSurveyInternalServerErrorResponseBody(body *BulkResendSurveyInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewScheduleSurveyInternalServerError builds a survey service schedule_survey
-// endpoint InternalServerError error.
-func NewScheduleSurveyInternalServerError(body *ScheduleSurveyInternalServerErrorResponseBody) *survey.InternalServerError {
-	v := &survey.InternalServerError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateBulkResendSurveyNotFoundResponseBody runs the validations defined on
+// bulk_resend_survey_NotFound_response_body
+func ValidateBulkResendSurveyNotFoundResponseBody(body *BulkResendSurveyNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewScheduleSurveyServiceUnavailable builds a survey service schedule_survey
-// endpoint ServiceUnavailable error.
-func NewScheduleSurveyServiceUnavailable(body *ScheduleSurveyServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
-	v := &survey.ServiceUnavailableError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateBulkResendSurveyServiceUnavailableResponseBody runs the validations
+// defined on bulk_resend_survey_ServiceUnavailable_response_body
+func ValidateBulkResendSurveyServiceUnavailableResponseBody(body *BulkResendSurveyServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewScheduleSurveyUnauthorized builds a survey service schedule_survey
-// endpoint Unauthorized error.
-func NewScheduleSurveyUnauthorized(body *ScheduleSurveyUnauthorizedResponseBody) *survey.UnauthorizedError {
-	v := &survey.UnauthorizedError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateBulkResendSurveyUnauthorizedResponseBody runs the validations
+// defined on bulk_resend_survey_Unauthorized_response_body
+func ValidateBulkResendSurveyUnauthorizedResponseBody(body *BulkResendSurveyUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewGetSurveySurveyScheduleResultOK builds a "survey" service "get_survey"
-// endpoint result from a HTTP "OK" response.
-func NewGetSurveySurveyScheduleResultOK(body *GetSurveyResponseBody) *survey.SurveyScheduleResult {
-	v := &survey.SurveyScheduleResult{
-		UID:                           *body.UID,
-		SurveyMonkeyID:                body.SurveyMonkeyID,
-		IsProjectSurvey:               body.IsProjectSurvey,
-		StageFilter:                   body.StageFilter,
-		CreatorUsername:               body.CreatorUsername,
-		CreatorName:                   body.CreatorName,
-		CreatorID:                     body.CreatorID,
-		CreatedAt:                     body.CreatedAt,
-		LastModifiedAt:                body.LastModifiedAt,
-		LastModifiedBy:                body.LastModifiedBy,
-		SurveyTitle:                   body.SurveyTitle,
-		SurveyStatus:                  *body.SurveyStatus,
-		ResponseStatus:                body.ResponseStatus,
-		SurveySendDate:                body.SurveySendDate,
-		SurveyCutoffDate:              body.SurveyCutoffDate,
-		SurveyReminderRateDays:        body.SurveyReminderRateDays,
-		EmailSubject:                  body.EmailSubject,
-		EmailBody:                     body.EmailBody,
-		EmailBodyText:                 body.EmailBodyText,
-		CommitteeCategory:             body.CommitteeCategory,
-		CommitteeVotingEnabled:        body.CommitteeVotingEnabled,
-		SurveyURL:                     body.SurveyURL,
-		SendImmediately:               body.SendImmediately,
-		TotalRecipients:               body.TotalRecipients,
-		TotalResponses:                body.TotalResponses,
-		IsNpsSurvey:                   body.IsNpsSurvey,
-		NpsValue:                      body.NpsValue,
-		NumPromoters:                  body.NumPromoters,
-		NumPassives:                   body.NumPassives,
-		NumDetractors:                 body.NumDetractors,
-		TotalBouncedEmails:            body.TotalBouncedEmails,
-		NumAutomatedRemindersToSend:   body.NumAutomatedRemindersToSend,
-		NumAutomatedRemindersSent:     body.NumAutomatedRemindersSent,
-		NextAutomatedReminderAt:       body.NextAutomatedReminderAt,
-		LatestAutomatedReminderSentAt: body.LatestAutomatedReminderSentAt,
+// ValidatePreviewSendSurveyBadRequestResponseBody runs the validations defined
+// on preview_send_survey_BadRequest_response_body
+func ValidatePreviewSendSurveyBadRequestResponseBody(body *PreviewSendSurveyBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.Committees != nil {
-		v.Committees = make([]*survey.SurveyCommittee, len(body.Committees))
-		for i, val := range body.Committees {
-			if val == nil {
-				v.Committees[i] = nil
-				continue
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
 			}
-			v.Committees[i] = unmarshalSurveyCommitteeResponseBodyToSurveySurveyCommittee(val)
 		}
 	}
-
-	return v
+	return
 }
 
-// NewGetSurveyBadRequest builds a survey service get_survey endpoint
-// BadRequest error.
-func NewGetSurveyBadRequest(body *GetSurveyBadRequestResponseBody) *survey.BadRequestError {
-	v := &survey.BadRequestError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidatePreviewSendSurveyForbiddenResponseBody runs the validations defined
+// on preview_send_survey_Forbidden_response_body
+func ValidatePreviewSendSurveyForbiddenResponseBody(body *PreviewSendSurveyForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewGetSurveyForbidden builds a survey service get_survey endpoint Forbidden
-// error.
-func NewGetSurveyForbidden(body *GetSurveyForbiddenResponseBody) *survey.ForbiddenError {
-	v := &survey.ForbiddenError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidatePreviewSendSurveyInternalServerErrorResponseBody runs the
+// validations defined on preview_send_survey_InternalServerError_response_body
+func ValidatePreviewSendSurveyInternalServerErrorResponseBody(body *PreviewSendSurveyInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	return
+}
 
-	return v
+// ValidatePreviewSendSurveyNotFoundResponseBody runs the validations defined
+// on preview_send_survey_NotFound_response_body
+func ValidatePreviewSendSurveyNotFoundResponseBody(body *PreviewSendSurveyNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewGetSurveyInternalServerError builds a survey service get_survey endpoint
-// InternalServerError error.
-func NewGetSurveyInternalServerError(body *GetSurveyInternalServerErrorResponseBody) *survey.InternalServerError {
-	v := &survey.InternalServerError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidatePreviewSendSurveyServiceUnavailableResponseBody runs the validations
+// defined on preview_send_survey_ServiceUnavailable_response_body
+func ValidatePreviewSendSurveyServiceUnavailableResponseBody(body *PreviewSendSurveyServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	return
+}
 
-	return v
+// ValidatePreviewSendSurveyUnauthorizedResponseBody runs the validations
+// defined on preview_send_survey_Unauthorized_response_body
+func ValidatePreviewSendSurveyUnauthorizedResponseBody(body *PreviewSendSurveyUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewGetSurveyNotFound builds a survey service get_survey endpoint NotFound
-// error.
-func NewGetSurveyNotFound(body *GetSurveyNotFoundResponseBody) *survey.NotFoundError {
-	v := &survey.NotFoundError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateSendMissingRecipientsBadRequestResponseBody runs the validations
+// defined on send_missing_recipients_BadRequest_response_body
+func ValidateSendMissingRecipientsBadRequestResponseBody(body *SendMissingRecipientsBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
 
-	return v
+// ValidateSendMissingRecipientsForbiddenResponseBody runs the validations
+// defined on send_missing_recipients_Forbidden_response_body
+func ValidateSendMissingRecipientsForbiddenResponseBody(body *SendMissingRecipientsForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewGetSurveyServiceUnavailable builds a survey service get_survey endpoint
-// ServiceUnavailable error.
-func NewGetSurveyServiceUnavailable(body *GetSurveyServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
-	v := &survey.ServiceUnavailableError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateSendMissingRecipientsInternalServerErrorResponseBody runs the
+// validations defined on
+// send_missing_recipients_InternalServerError_response_body
+func ValidateSendMissingRecipientsInternalServerErrorResponseBody(body *SendMissingRecipientsInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	return
+}
 
-	return v
+// ValidateSendMissingRecipientsNotFoundResponseBody runs the validations
+// defined on send_missing_recipients_NotFound_response_body
+func ValidateSendMissingRecipientsNotFoundResponseBody(body *SendMissingRecipientsNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewGetSurveyUnauthorized builds a survey service get_survey endpoint
-// Unauthorized error.
-func NewGetSurveyUnauthorized(body *GetSurveyUnauthorizedResponseBody) *survey.UnauthorizedError {
-	v := &survey.UnauthorizedError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateSendMissingRecipientsServiceUnavailableResponseBody runs the
+// validations defined on
+// send_missing_recipients_ServiceUnavailable_response_body
+func ValidateSendMissingRecipientsServiceUnavailableResponseBody(body *SendMissingRecipientsServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	return
+}
 
-	return v
+// ValidateSendMissingRecipientsUnauthorizedResponseBody runs the validations
+// defined on send_missing_recipients_Unauthorized_response_body
+func ValidateSendMissingRecipientsUnauthorizedResponseBody(body *SendMissingRecipientsUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewUpdateSurveySurveyScheduleResultOK builds a "survey" service
-// "update_survey" endpoint result from a HTTP "OK" response.
-func NewUpdateSurveySurveyScheduleResultOK(body *UpdateSurveyResponseBody) *survey.SurveyScheduleResult {
-	v := &survey.SurveyScheduleResult{
-		UID:                           *body.UID,
-		SurveyMonkeyID:                body.SurveyMonkeyID,
-		IsProjectSurvey:               body.IsProjectSurvey,
-		StageFilter:                   body.StageFilter,
-		CreatorUsername:               body.CreatorUsername,
-		CreatorName:                   body.CreatorName,
-		CreatorID:                     body.CreatorID,
-		CreatedAt:                     body.CreatedAt,
-		LastModifiedAt:                body.LastModifiedAt,
-		LastModifiedBy:                body.LastModifiedBy,
-		SurveyTitle:                   body.SurveyTitle,
-		SurveyStatus:                  *body.SurveyStatus,
-		ResponseStatus:                body.ResponseStatus,
-		SurveySendDate:                body.SurveySendDate,
-		SurveyCutoffDate:              body.SurveyCutoffDate,
-		SurveyReminderRateDays:        body.SurveyReminderRateDays,
-		EmailSubject:                  body.EmailSubject,
-		EmailBody:                     body.EmailBody,
-		EmailBodyText:                 body.EmailBodyText,
-		CommitteeCategory:             body.CommitteeCategory,
-		CommitteeVotingEnabled:        body.CommitteeVotingEnabled,
-		SurveyURL:                     body.SurveyURL,
-		SendImmediately:               body.SendImmediately,
-		TotalRecipients:               body.TotalRecipients,
-		TotalResponses:                body.TotalResponses,
-		IsNpsSurvey:                   body.IsNpsSurvey,
-		NpsValue:                      body.NpsValue,
-		NumPromoters:                  body.NumPromoters,
-		NumPassives:                   body.NumPassives,
-		NumDetractors:                 body.NumDetractors,
-		TotalBouncedEmails:            body.TotalBouncedEmails,
-		NumAutomatedRemindersToSend:   body.NumAutomatedRemindersToSend,
-		NumAutomatedRemindersSent:     body.NumAutomatedRemindersSent,
-		NextAutomatedReminderAt:       body.NextAutomatedReminderAt,
-		LatestAutomatedReminderSentAt: body.LatestAutomatedReminderSentAt,
+// ValidateDeleteSurveyResponseBadRequestResponseBody runs the validations
+// defined on delete_survey_response_BadRequest_response_body
+func ValidateDeleteSurveyResponseBadRequestResponseBody(body *DeleteSurveyResponseBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.Committees != nil {
-		v.Committees = make([]*survey.SurveyCommittee, len(body.Committees))
-		for i, val := range body.Committees {
-			if val == nil {
-				v.Committees[i] = nil
-				continue
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
 			}
-			v.Committees[i] = unmarshalSurveyCommitteeResponseBodyToSurveySurveyCommittee(val)
 		}
 	}
-
-	return v
+	return
 }
 
-// NewUpdateSurveyBadRequest builds a survey service update_survey endpoint
-// BadRequest error.
-func NewUpdateSurveyBadRequest(body *UpdateSurveyBadRequestResponseBody) *survey.BadRequestError {
-	v := &survey.BadRequestError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateDeleteSurveyResponseForbiddenResponseBody runs the validations
+// defined on delete_survey_response_Forbidden_response_body
+func ValidateDeleteSurveyResponseForbiddenResponseBody(body *DeleteSurveyResponseForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
-}
-
-// NewUpdateSurveyForbidden builds a survey service update_survey endpoint
-// Forbidden error.
-func NewUpdateSurveyForbidden(body *UpdateSurveyForbiddenResponseBody) *survey.ForbiddenError {
-	v := &survey.ForbiddenError{
-		Code:    *body.Code,
-		Message: *body.Message,
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-
-	return v
+	return
 }
 
-// NewUpdateSurveyInternalServerError builds a survey service update_survey
-// endpoint InternalServerError error.
-func NewUpdateSurveyInternalServerError(body *UpdateSurveyInternalServerErrorResponseBody) *survey.InternalServerError {
-	v := &survey.InternalServerError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateDeleteSurveyResponseInternalServerErrorResponseBody runs the
+// validations defined on
+// delete_survey_response_InternalServerError_response_body
+func ValidateDeleteSurveyResponseInternalServerErrorResponseBody(body *DeleteSurveyResponseInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewUpdateSurveyNotFound builds a survey service update_survey endpoint
-// NotFound error.
-func NewUpdateSurveyNotFound(body *UpdateSurveyNotFoundResponseBody) *survey.NotFoundError {
-	v := &survey.NotFoundError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateDeleteSurveyResponseNotFoundResponseBody runs the validations
+// defined on delete_survey_response_NotFound_response_body
+func ValidateDeleteSurveyResponseNotFoundResponseBody(body *DeleteSurveyResponseNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewUpdateSurveyServiceUnavailable builds a survey service update_survey
-// endpoint ServiceUnavailable error.
-func NewUpdateSurveyServiceUnavailable(body *UpdateSurveyServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
-	v := &survey.ServiceUnavailableError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateDeleteSurveyResponseServiceUnavailableResponseBody runs the
+// validations defined on
+// delete_survey_response_ServiceUnavailable_response_body
+func ValidateDeleteSurveyResponseServiceUnavailableResponseBody(body *DeleteSurveyResponseServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewUpdateSurveyUnauthorized builds a survey service update_survey endpoint
-// Unauthorized error.
-func NewUpdateSurveyUnauthorized(body *UpdateSurveyUnauthorizedResponseBody) *survey.UnauthorizedError {
-	v := &survey.UnauthorizedError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateDeleteSurveyResponseUnauthorizedResponseBody runs the validations
+// defined on delete_survey_response_Unauthorized_response_body
+func ValidateDeleteSurveyResponseUnauthorizedResponseBody(body *DeleteSurveyResponseUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewDeleteSurveyBadRequest builds a survey service delete_survey endpoint
-// BadRequest error.
-func NewDeleteSurveyBadRequest(body *DeleteSurveyBadRequestResponseBody) *survey.BadRequestError {
-	v := &survey.BadRequestError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateResendSurveyResponseBadRequestResponseBody runs the validations
+// defined on resend_survey_response_BadRequest_response_body
+func ValidateResendSurveyResponseBadRequestResponseBody(body *ResendSurveyResponseBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
 }
 
-// NewDeleteSurveyForbidden builds a survey service delete_survey endpoint
-// Forbidden error.
-func NewDeleteSurveyForbidden(body *DeleteSurveyForbiddenResponseBody) *survey.ForbiddenError {
-	v := &survey.ForbiddenError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateResendSurveyResponseForbiddenResponseBody runs the validations
+// defined on resend_survey_response_Forbidden_response_body
+func ValidateResendSurveyResponseForbiddenResponseBody(body *ResendSurveyResponseForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewDeleteSurveyInternalServerError builds a survey service delete_survey
-// endpoint InternalServerError error.
-func NewDeleteSurveyInternalServerError(body *DeleteSurveyInternalServerErrorResponseBody) *survey.InternalServerError {
-	v := &survey.InternalServerError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateResendSurveyResponseInternalServerErrorResponseBody runs the
+// validations defined on
+// resend_survey_response_InternalServerError_response_body
+func ValidateResendSurveyResponseInternalServerErrorResponseBody(body *ResendSurveyResponseInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewDeleteSurveyNotFound builds a survey service delete_survey endpoint
-// NotFound error.
-func NewDeleteSurveyNotFound(body *DeleteSurveyNotFoundResponseBody) *survey.NotFoundError {
-	v := &survey.NotFoundError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateResendSurveyResponseNotFoundResponseBody runs the validations
+// defined on resend_survey_response_NotFound_response_body
+func ValidateResendSurveyResponseNotFoundResponseBody(body *ResendSurveyResponseNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewDeleteSurveyServiceUnavailable builds a survey service delete_survey
-// endpoint ServiceUnavailable error.
-func NewDeleteSurveyServiceUnavailable(body *DeleteSurveyServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
-	v := &survey.ServiceUnavailableError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateResendSurveyResponseServiceUnavailableResponseBody runs the
+// validations defined on
+// resend_survey_response_ServiceUnavailable_response_body
+func ValidateResendSurveyResponseServiceUnavailableResponseBody(body *ResendSurveyResponseServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewDeleteSurveyUnauthorized builds a survey service delete_survey endpoint
-// Unauthorized error.
-func NewDeleteSurveyUnauthorized(body *DeleteSurveyUnauthorizedResponseBody) *survey.UnauthorizedError {
-	v := &survey.UnauthorizedError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateResendSurveyResponseUnauthorizedResponseBody runs the validations
+// defined on resend_survey_response_Unauthorized_response_body
+func ValidateResendSurveyResponseUnauthorizedResponseBody(body *ResendSurveyResponseUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewBulkResendSurveyBadRequest builds a survey service bulk_resend_survey
-// endpoint BadRequest error.
-func NewBulkResendSurveyBadRequest(body *BulkResendSurveyBadRequestResponseBody) *survey.BadRequestError {
-	v := &survey.BadRequestError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateDeleteRecipientGroupBadRequestResponseBody runs the validations
+// defined on delete_recipient_group_BadRequest_response_body
+func ValidateDeleteRecipientGroupBadRequestResponseBody(body *DeleteRecipientGroupBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
 }
 
-// NewBulkResendSurveyForbidden builds a survey service bulk_resend_survey
-// endpoint Forbidden error.
-func NewBulkResendSurveyForbidden(body *BulkResendSurveyForbiddenResponseBody) *survey.ForbiddenError {
-	v := &survey.ForbiddenError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateDeleteRecipientGroupForbiddenResponseBody runs the validations
+// defined on delete_recipient_group_Forbidden_response_body
+func ValidateDeleteRecipientGroupForbiddenResponseBody(body *DeleteRecipientGroupForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewBulkResendSurveyInternalServerError builds a survey service
-// bulk_resend_survey endpoint InternalServerError error.
-func NewBulkResendSurveyInternalServerError(body *BulkResendSurveyInternalServerErrorResponseBody) *survey.InternalServerError {
-	v := &survey.InternalServerError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateDeleteRecipientGroupInternalServerErrorResponseBody runs the
+// validations defined on
+// delete_recipient_group_InternalServerError_response_body
+func ValidateDeleteRecipientGroupInternalServerErrorResponseBody(body *DeleteRecipientGroupInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewBulkResendSurveyNotFound builds a survey service bulk_resend_survey
-// endpoint NotFound error.
-func NewBulkResendSurveyNotFound(body *BulkResendSurveyNotFoundResponseBody) *survey.NotFoundError {
-	v := &survey.NotFoundError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateDeleteRecipientGroupNotFoundResponseBody runs the validations
+// defined on delete_recipient_group_NotFound_response_body
+func ValidateDeleteRecipientGroupNotFoundResponseBody(body *DeleteRecipientGroupNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewBulkResendSurveyServiceUnavailable builds a survey service
-// bulk_resend_survey endpoint ServiceUnavailable error.
-func NewBulkResendSurveyServiceUnavailable(body *BulkResendSurveyServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
-	v := &survey.ServiceUnavailableError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateDeleteRecipientGroupServiceUnavailableResponseBody runs the
+// validations defined on
+// delete_recipient_group_ServiceUnavailable_response_body
+func ValidateDeleteRecipientGroupServiceUnavailableResponseBody(body *DeleteRecipientGroupServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewBulkResendSurveyUnauthorized builds a survey service bulk_resend_survey
-// endpoint Unauthorized error.
-func NewBulkResendSurveyUnauthorized(body *BulkResendSurveyUnauthorizedResponseBody) *survey.UnauthorizedError {
-	v := &survey.UnauthorizedError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateDeleteRecipientGroupUnauthorizedResponseBody runs the validations
+// defined on delete_recipient_group_Unauthorized_response_body
+func ValidateDeleteRecipientGroupUnauthorizedResponseBody(body *DeleteRecipientGroupUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewPreviewSendSurveyPreviewSendResultOK builds a "survey" service
-// "preview_send_survey" endpoint result from a HTTP "OK" response.
-func NewPreviewSendSurveyPreviewSendResultOK(body *PreviewSendSurveyResponseBody) *survey.PreviewSendResult {
-	v := &survey.PreviewSendResult{}
-	if body.AffectedProjects != nil {
-		v.AffectedProjects = make([]*survey.LFXProject, len(body.AffectedProjects))
-		for i, val := range body.AffectedProjects {
-			if val == nil {
-				v.AffectedProjects[i] = nil
-				continue
-			}
-			v.AffectedProjects[i] = unmarshalLFXProjectResponseBodyToSurveyLFXProject(val)
-		}
+// ValidateCreateExclusionBadRequestResponseBody runs the validations defined
+// on create_exclusion_BadRequest_response_body
+func ValidateCreateExclusionBadRequestResponseBody(body *CreateExclusionBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.AffectedCommittees != nil {
-		v.AffectedCommittees = make([]*survey.ExcludedCommittee, len(body.AffectedCommittees))
-		for i, val := range body.AffectedCommittees {
-			if val == nil {
-				v.AffectedCommittees[i] = nil
-				continue
-			}
-			v.AffectedCommittees[i] = unmarshalExcludedCommitteeResponseBodyToSurveyExcludedCommittee(val)
-		}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.AffectedRecipients != nil {
-		v.AffectedRecipients = make([]*survey.ITXPreviewRecipient, len(body.AffectedRecipients))
-		for i, val := range body.AffectedRecipients {
-			if val == nil {
-				v.AffectedRecipients[i] = nil
-				continue
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
 			}
-			v.AffectedRecipients[i] = unmarshalITXPreviewRecipientResponseBodyToSurveyITXPreviewRecipient(val)
 		}
 	}
-
-	return v
+	return
 }
 
-// NewPreviewSendSurveyBadRequest builds a survey service preview_send_survey
-// endpoint BadRequest error.
-func NewPreviewSendSurveyBadRequest(body *PreviewSendSurveyBadRequestResponseBody) *survey.BadRequestError {
-	v := &survey.BadRequestError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateCreateExclusionForbiddenResponseBody runs the validations defined on
+// create_exclusion_Forbidden_response_body
+func ValidateCreateExclusionForbiddenResponseBody(body *CreateExclusionForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
-}
-
-// NewPreviewSendSurveyForbidden builds a survey service preview_send_survey
-// endpoint Forbidden error.
-func NewPreviewSendSurveyForbidden(body *PreviewSendSurveyForbiddenResponseBody) *survey.ForbiddenError {
-	v := &survey.ForbiddenError{
-		Code:    *body.Code,
-		Message: *body.Message,
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-
-	return v
+	return
 }
 
-// NewPreviewSendSurveyInternalServerError builds a survey service
-// preview_send_survey endpoint InternalServerError error.
-func NewPreviewSendSurveyInternalServerError(body *PreviewSendSurveyInternalServerErrorResponseBody) *survey.InternalServerError {
-	v := &survey.InternalServerError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateCreateExclusionInternalServerErrorResponseBody runs the validations
+// defined on create_exclusion_InternalServerError_response_body
+func ValidateCreateExclusionInternalServerErrorResponseBody(body *CreateExclusionInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
-}
-
-// NewPreviewSendSurveyNotFound builds a survey service preview_send_survey
-// endpoint NotFound error.
-func NewPreviewSendSurveyNotFound(body *PreviewSendSurveyNotFoundResponseBody) *survey.NotFoundError {
-	v := &survey.NotFoundError{
-		Code:    *body.Code,
-		Message: *body.Message,
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-
-	return v
+	return
 }
 
-// NewPreviewSendSurveyServiceUnavailable builds a survey service
-// preview_send_survey endpoint ServiceUnavailable error.
-func NewPreviewSendSurveyServiceUnavailable(body *PreviewSendSurveyServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
-	v := &survey.ServiceUnavailableError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateCreateExclusionUnauthorizedResponseBody runs the validations defined
+// on create_exclusion_Unauthorized_response_body
+func ValidateCreateExclusionUnauthorizedResponseBody(body *CreateExclusionUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewPreviewSendSurveyUnauthorized builds a survey service preview_send_survey
-// endpoint Unauthorized error.
-func NewPreviewSendSurveyUnauthorized(body *PreviewSendSurveyUnauthorizedResponseBody) *survey.UnauthorizedError {
-	v := &survey.UnauthorizedError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateDeleteExclusionBadRequestResponseBody runs the validations defined
+// on delete_exclusion_BadRequest_response_body
+func ValidateDeleteExclusionBadRequestResponseBody(body *DeleteExclusionBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
 }
 
-// NewSendMissingRecipientsBadRequest builds a survey service
-// send_missing_recipients endpoint BadRequest error.
-func NewSendMissingRecipientsBadRequest(body *SendMissingRecipientsBadRequestResponseBody) *survey.BadRequestError {
-	v := &survey.BadRequestError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateDeleteExclusionForbiddenResponseBody runs the validations defined on
+// delete_exclusion_Forbidden_response_body
+func ValidateDeleteExclusionForbiddenResponseBody(body *DeleteExclusionForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewSendMissingRecipientsForbidden builds a survey service
-// send_missing_recipients endpoint Forbidden error.
-func NewSendMissingRecipientsForbidden(body *SendMissingRecipientsForbiddenResponseBody) *survey.ForbiddenError {
-	v := &survey.ForbiddenError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateDeleteExclusionInternalServerErrorResponseBody runs the validations
+// defined on delete_exclusion_InternalServerError_response_body
+func ValidateDeleteExclusionInternalServerErrorResponseBody(body *DeleteExclusionInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewSendMissingRecipientsInternalServerError builds a survey service
-// send_missing_recipients endpoint InternalServerError error.
-func NewSendMissingRecipientsInternalServerError(body *SendMissingRecipientsInternalServerErrorResponseBody) *survey.InternalServerError {
-	v := &survey.InternalServerError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateDeleteExclusionUnauthorizedResponseBody runs the validations defined
+// on delete_exclusion_Unauthorized_response_body
+func ValidateDeleteExclusionUnauthorizedResponseBody(body *DeleteExclusionUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewSendMissingRecipientsNotFound builds a survey service
-// send_missing_recipients endpoint NotFound error.
-func NewSendMissingRecipientsNotFound(body *SendMissingRecipientsNotFoundResponseBody) *survey.NotFoundError {
-	v := &survey.NotFoundError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateGetExclusionBadRequestResponseBody runs the validations defined on
+// get_exclusion_BadRequest_response_body
+func ValidateGetExclusionBadRequestResponseBody(body *GetExclusionBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
 }
 
-// NewSendMissingRecipientsServiceUnavailable builds a survey service
-// send_missing_recipients endpoint ServiceUnavailable error.
-func NewSendMissingRecipientsServiceUnavailable(body *SendMissingRecipientsServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
-	v := &survey.ServiceUnavailableError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateGetExclusionForbiddenResponseBody runs the validations defined on
+// get_exclusion_Forbidden_response_body
+func ValidateGetExclusionForbiddenResponseBody(body *GetExclusionForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewSendMissingRecipientsUnauthorized builds a survey service
-// send_missing_recipients endpoint Unauthorized error.
-func NewSendMissingRecipientsUnauthorized(body *SendMissingRecipientsUnauthorizedResponseBody) *survey.UnauthorizedError {
-	v := &survey.UnauthorizedError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateGetExclusionInternalServerErrorResponseBody runs the validations
+// defined on get_exclusion_InternalServerError_response_body
+func ValidateGetExclusionInternalServerErrorResponseBody(body *GetExclusionInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewDeleteSurveyResponseBadRequest builds a survey service
-// delete_survey_response endpoint BadRequest error.
-func NewDeleteSurveyResponseBadRequest(body *DeleteSurveyResponseBadRequestResponseBody) *survey.BadRequestError {
-	v := &survey.BadRequestError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateGetExclusionNotFoundResponseBody runs the validations defined on
+// get_exclusion_NotFound_response_body
+func ValidateGetExclusionNotFoundResponseBody(body *GetExclusionNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewDeleteSurveyResponseForbidden builds a survey service
-// delete_survey_response endpoint Forbidden error.
-func NewDeleteSurveyResponseForbidden(body *DeleteSurveyResponseForbiddenResponseBody) *survey.ForbiddenError {
-	v := &survey.ForbiddenError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateGetExclusionUnauthorizedResponseBody runs the validations defined on
+// get_exclusion_Unauthorized_response_body
+func ValidateGetExclusionUnauthorizedResponseBody(body *GetExclusionUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewDeleteSurveyResponseInternalServerError builds a survey service
-// delete_survey_response endpoint InternalServerError error.
-func NewDeleteSurveyResponseInternalServerError(body *DeleteSurveyResponseInternalServerErrorResponseBody) *survey.InternalServerError {
-	v := &survey.InternalServerError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateDeleteExclusionByIDBadRequestResponseBody runs the validations
+// defined on delete_exclusion_by_id_BadRequest_response_body
+func ValidateDeleteExclusionByIDBadRequestResponseBody(body *DeleteExclusionByIDBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
 }
 
-// NewDeleteSurveyResponseNotFound builds a survey service
-// delete_survey_response endpoint NotFound error.
-func NewDeleteSurveyResponseNotFound(body *DeleteSurveyResponseNotFoundResponseBody) *survey.NotFoundError {
-	v := &survey.NotFoundError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateDeleteExclusionByIDForbiddenResponseBody runs the validations
+// defined on delete_exclusion_by_id_Forbidden_response_body
+func ValidateDeleteExclusionByIDForbiddenResponseBody(body *DeleteExclusionByIDForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewDeleteSurveyResponseServiceUnavailable builds a survey service
-// delete_survey_response endpoint ServiceUnavailable error.
-func NewDeleteSurveyResponseServiceUnavailable(body *DeleteSurveyResponseServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
-	v := &survey.ServiceUnavailableError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateDeleteExclusionByIDInternalServerErrorResponseBody runs the
+// validations defined on
+// delete_exclusion_by_id_InternalServerError_response_body
+func ValidateDeleteExclusionByIDInternalServerErrorResponseBody(body *DeleteExclusionByIDInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewDeleteSurveyResponseUnauthorized builds a survey service
-// delete_survey_response endpoint Unauthorized error.
-func NewDeleteSurveyResponseUnauthorized(body *DeleteSurveyResponseUnauthorizedResponseBody) *survey.UnauthorizedError {
-	v := &survey.UnauthorizedError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateDeleteExclusionByIDUnauthorizedResponseBody runs the validations
+// defined on delete_exclusion_by_id_Unauthorized_response_body
+func ValidateDeleteExclusionByIDUnauthorizedResponseBody(body *DeleteExclusionByIDUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewResendSurveyResponseBadRequest builds a survey service
-// resend_survey_response endpoint BadRequest error.
-func NewResendSurveyResponseBadRequest(body *ResendSurveyResponseBadRequestResponseBody) *survey.BadRequestError {
-	v := &survey.BadRequestError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateListSurveyResponsesBadRequestResponseBody runs the validations
+// defined on list_survey_responses_BadRequest_response_body
+func ValidateListSurveyResponsesBadRequestResponseBody(body *ListSurveyResponsesBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
 }
 
-// NewResendSurveyResponseForbidden builds a survey service
-// resend_survey_response endpoint Forbidden error.
-func NewResendSurveyResponseForbidden(body *ResendSurveyResponseForbiddenResponseBody) *survey.ForbiddenError {
-	v := &survey.ForbiddenError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateListSurveyResponsesForbiddenResponseBody runs the validations
+// defined on list_survey_responses_Forbidden_response_body
+func ValidateListSurveyResponsesForbiddenResponseBody(body *ListSurveyResponsesForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewResendSurveyResponseInternalServerError builds a survey service
-// resend_survey_response endpoint InternalServerError error.
-func NewResendSurveyResponseInternalServerError(body *ResendSurveyResponseInternalServerErrorResponseBody) *survey.InternalServerError {
-	v := &survey.InternalServerError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateListSurveyResponsesInternalServerErrorResponseBody runs the
+// validations defined on
+// list_survey_responses_InternalServerError_response_body
+func ValidateListSurveyResponsesInternalServerErrorResponseBody(body *ListSurveyResponsesInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewResendSurveyResponseNotFound builds a survey service
-// resend_survey_response endpoint NotFound error.
-func NewResendSurveyResponseNotFound(body *ResendSurveyResponseNotFoundResponseBody) *survey.NotFoundError {
-	v := &survey.NotFoundError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateListSurveyResponsesNotFoundResponseBody runs the validations defined
+// on list_survey_responses_NotFound_response_body
+func ValidateListSurveyResponsesNotFoundResponseBody(body *ListSurveyResponsesNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewResendSurveyResponseServiceUnavailable builds a survey service
-// resend_survey_response endpoint ServiceUnavailable error.
-func NewResendSurveyResponseServiceUnavailable(body *ResendSurveyResponseServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
-	v := &survey.ServiceUnavailableError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateListSurveyResponsesServiceUnavailableResponseBody runs the
+// validations defined on list_survey_responses_ServiceUnavailable_response_body
+func ValidateListSurveyResponsesServiceUnavailableResponseBody(body *ListSurveyResponsesServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewResendSurveyResponseUnauthorized builds a survey service
-// resend_survey_response endpoint Unauthorized error.
-func NewResendSurveyResponseUnauthorized(body *ResendSurveyResponseUnauthorizedResponseBody) *survey.UnauthorizedError {
-	v := &survey.UnauthorizedError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateListSurveyResponsesUnauthorizedResponseBody runs the validations
+// defined on list_survey_responses_Unauthorized_response_body
+func ValidateListSurveyResponsesUnauthorizedResponseBody(body *ListSurveyResponsesUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewDeleteRecipientGroupBadRequest builds a survey service
-// delete_recipient_group endpoint BadRequest error.
-func NewDeleteRecipientGroupBadRequest(body *DeleteRecipientGroupBadRequestResponseBody) *survey.BadRequestError {
-	v := &survey.BadRequestError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateCreateSurveyResponseBadRequestResponseBody runs the validations
+// defined on create_survey_response_BadRequest_response_body
+func ValidateCreateSurveyResponseBadRequestResponseBody(body *CreateSurveyResponseBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 	}
+	return
+}
 
-	return v
+// ValidateCreateSurveyResponseForbiddenResponseBody runs the validations
+// defined on create_survey_response_Forbidden_response_body
+func ValidateCreateSurveyResponseForbiddenResponseBody(body *CreateSurveyResponseForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewDeleteRecipientGroupForbidden builds a survey service
-// delete_recipient_group endpoint Forbidden error.
-func NewDeleteRecipientGroupForbidden(body *DeleteRecipientGroupForbiddenResponseBody) *survey.ForbiddenError {
-	v := &survey.ForbiddenError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateCreateSurveyResponseInternalServerErrorResponseBody runs the
+// validations defined on
+// create_survey_response_InternalServerError_response_body
+func ValidateCreateSurveyResponseInternalServerErrorResponseBody(body *CreateSurveyResponseInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewDeleteRecipientGroupInternalServerError builds a survey service
-// delete_recipient_group endpoint InternalServerError error.
-func NewDeleteRecipientGroupInternalServerError(body *DeleteRecipientGroupInternalServerErrorResponseBody) *survey.InternalServerError {
-	v := &survey.InternalServerError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateCreateSurveyResponseNotFoundResponseBody runs the validations
+// defined on create_survey_response_NotFound_response_body
+func ValidateCreateSurveyResponseNotFoundResponseBody(body *CreateSurveyResponseNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewDeleteRecipientGroupNotFound builds a survey service
-// delete_recipient_group endpoint NotFound error.
-func NewDeleteRecipientGroupNotFound(body *DeleteRecipientGroupNotFoundResponseBody) *survey.NotFoundError {
-	v := &survey.NotFoundError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateCreateSurveyResponseServiceUnavailableResponseBody runs the
+// validations defined on
+// create_survey_response_ServiceUnavailable_response_body
+func ValidateCreateSurveyResponseServiceUnavailableResponseBody(body *CreateSurveyResponseServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewDeleteRecipientGroupServiceUnavailable builds a survey service
-// delete_recipient_group endpoint ServiceUnavailable error.
-func NewDeleteRecipientGroupServiceUnavailable(body *DeleteRecipientGroupServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
-	v := &survey.ServiceUnavailableError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateCreateSurveyResponseUnauthorizedResponseBody runs the validations
+// defined on create_survey_response_Unauthorized_response_body
+func ValidateCreateSurveyResponseUnauthorizedResponseBody(body *CreateSurveyResponseUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewDeleteRecipientGroupUnauthorized builds a survey service
-// delete_recipient_group endpoint Unauthorized error.
-func NewDeleteRecipientGroupUnauthorized(body *DeleteRecipientGroupUnauthorizedResponseBody) *survey.UnauthorizedError {
-	v := &survey.UnauthorizedError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateUpdateSurveyResponseBadRequestResponseBody runs the validations
+// defined on update_survey_response_BadRequest_response_body
+func ValidateUpdateSurveyResponseBadRequestResponseBody(body *UpdateSurveyResponseBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
 }
 
-// NewCreateExclusionExclusionResultCreated builds a "survey" service
-// "create_exclusion" endpoint result from a HTTP "Created" response.
-func NewCreateExclusionExclusionResultCreated(body *CreateExclusionResponseBody) *survey.ExclusionResult {
-	v := &survey.ExclusionResult{
-		UID:             *body.UID,
-		Email:           body.Email,
-		SurveyUID:       body.SurveyUID,
-		CommitteeUID:    body.CommitteeUID,
-		GlobalExclusion: body.GlobalExclusion,
-		UserID:          body.UserID,
+// ValidateUpdateSurveyResponseForbiddenResponseBody runs the validations
+// defined on update_survey_response_Forbidden_response_body
+func ValidateUpdateSurveyResponseForbiddenResponseBody(body *UpdateSurveyResponseForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewCreateExclusionBadRequest builds a survey service create_exclusion
-// endpoint BadRequest error.
-func NewCreateExclusionBadRequest(body *CreateExclusionBadRequestResponseBody) *survey.BadRequestError {
-	v := &survey.BadRequestError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateUpdateSurveyResponseInternalServerErrorResponseBody runs the
+// validations defined on
+// update_survey_response_InternalServerError_response_body
+func ValidateUpdateSurveyResponseInternalServerErrorResponseBody(body *UpdateSurveyResponseInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewCreateExclusionForbidden builds a survey service create_exclusion
-// endpoint Forbidden error.
-func NewCreateExclusionForbidden(body *CreateExclusionForbiddenResponseBody) *survey.ForbiddenError {
-	v := &survey.ForbiddenError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateUpdateSurveyResponseNotFoundResponseBody runs the validations
+// defined on update_survey_response_NotFound_response_body
+func ValidateUpdateSurveyResponseNotFoundResponseBody(body *UpdateSurveyResponseNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewCreateExclusionInternalServerError builds a survey service
-// create_exclusion endpoint InternalServerError error.
-func NewCreateExclusionInternalServerError(body *CreateExclusionInternalServerErrorResponseBody) *survey.InternalServerError {
-	v := &survey.InternalServerError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateUpdateSurveyResponseServiceUnavailableResponseBody runs the
+// validations defined on
+// update_survey_response_ServiceUnavailable_response_body
+func ValidateUpdateSurveyResponseServiceUnavailableResponseBody(body *UpdateSurveyResponseServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewCreateExclusionUnauthorized builds a survey service create_exclusion
-// endpoint Unauthorized error.
-func NewCreateExclusionUnauthorized(body *CreateExclusionUnauthorizedResponseBody) *survey.UnauthorizedError {
-	v := &survey.UnauthorizedError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateUpdateSurveyResponseUnauthorizedResponseBody runs the validations
+// defined on update_survey_response_Unauthorized_response_body
+func ValidateUpdateSurveyResponseUnauthorizedResponseBody(body *UpdateSurveyResponseUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewDeleteExclusionBadRequest builds a survey service delete_exclusion
-// endpoint BadRequest error.
-func NewDeleteExclusionBadRequest(body *DeleteExclusionBadRequestResponseBody) *survey.BadRequestError {
-	v := &survey.BadRequestError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateListSurveyInvitesBadRequestResponseBody runs the validations defined
+// on list_survey_invites_BadRequest_response_body
+func ValidateListSurveyInvitesBadRequestResponseBody(body *ListSurveyInvitesBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
 }
 
-// NewDeleteExclusionForbidden builds a survey service delete_exclusion
-// endpoint Forbidden error.
-func NewDeleteExclusionForbidden(body *DeleteExclusionForbiddenResponseBody) *survey.ForbiddenError {
-	v := &survey.ForbiddenError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateListSurveyInvitesForbiddenResponseBody runs the validations defined
+// on list_survey_invites_Forbidden_response_body
+func ValidateListSurveyInvitesForbiddenResponseBody(body *ListSurveyInvitesForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewDeleteExclusionInternalServerError builds a survey service
-// delete_exclusion endpoint InternalServerError error.
-func NewDeleteExclusionInternalServerError(body *DeleteExclusionInternalServerErrorResponseBody) *survey.InternalServerError {
-	v := &survey.InternalServerError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateListSurveyInvitesInternalServerErrorResponseBody runs the
+// validations defined on list_survey_invites_InternalServerError_response_body
+func ValidateListSurveyInvitesInternalServerErrorResponseBody(body *ListSurveyInvitesInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewDeleteExclusionUnauthorized builds a survey service delete_exclusion
-// endpoint Unauthorized error.
-func NewDeleteExclusionUnauthorized(body *DeleteExclusionUnauthorizedResponseBody) *survey.UnauthorizedError {
-	v := &survey.UnauthorizedError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateListSurveyInvitesNotFoundResponseBody runs the validations defined
+// on list_survey_invites_NotFound_response_body
+func ValidateListSurveyInvitesNotFoundResponseBody(body *ListSurveyInvitesNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewGetExclusionExtendedExclusionResultOK builds a "survey" service
-// "get_exclusion" endpoint result from a HTTP "OK" response.
-func NewGetExclusionExtendedExclusionResultOK(body *GetExclusionResponseBody) *survey.ExtendedExclusionResult {
-	v := &survey.ExtendedExclusionResult{
-		UID:             *body.UID,
-		Email:           body.Email,
-		SurveyUID:       body.SurveyUID,
-		CommitteeUID:    body.CommitteeUID,
-		GlobalExclusion: body.GlobalExclusion,
-		UserID:          body.UserID,
+// ValidateListSurveyInvitesServiceUnavailableResponseBody runs the validations
+// defined on list_survey_invites_ServiceUnavailable_response_body
+func ValidateListSurveyInvitesServiceUnavailableResponseBody(body *ListSurveyInvitesServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.User != nil {
-		v.User = unmarshalExclusionUserResponseBodyToSurveyExclusionUser(body.User)
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-
-	return v
+	return
 }
 
-// NewGetExclusionBadRequest builds a survey service get_exclusion endpoint
-// BadRequest error.
-func NewGetExclusionBadRequest(body *GetExclusionBadRequestResponseBody) *survey.BadRequestError {
-	v := &survey.BadRequestError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateListSurveyInvitesUnauthorizedResponseBody runs the validations
+// defined on list_survey_invites_Unauthorized_response_body
+func ValidateListSurveyInvitesUnauthorizedResponseBody(body *ListSurveyInvitesUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewGetExclusionForbidden builds a survey service get_exclusion endpoint
-// Forbidden error.
-func NewGetExclusionForbidden(body *GetExclusionForbiddenResponseBody) *survey.ForbiddenError {
-	v := &survey.ForbiddenError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateGetSurveyInviteSettingsBadRequestResponseBody runs the validations
+// defined on get_survey_invite_settings_BadRequest_response_body
+func ValidateGetSurveyInviteSettingsBadRequestResponseBody(body *GetSurveyInviteSettingsBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
 }
 
-// NewGetExclusionInternalServerError builds a survey service get_exclusion
-// endpoint InternalServerError error.
-func NewGetExclusionInternalServerError(body *GetExclusionInternalServerErrorResponseBody) *survey.InternalServerError {
-	v := &survey.InternalServerError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateGetSurveyInviteSettingsForbiddenResponseBody runs the validations
+// defined on get_survey_invite_settings_Forbidden_response_body
+func ValidateGetSurveyInviteSettingsForbiddenResponseBody(body *GetSurveyInviteSettingsForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewGetExclusionNotFound builds a survey service get_exclusion endpoint
-// NotFound error.
-func NewGetExclusionNotFound(body *GetExclusionNotFoundResponseBody) *survey.NotFoundError {
-	v := &survey.NotFoundError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateGetSurveyInviteSettingsInternalServerErrorResponseBody runs the
+// validations defined on
+// get_survey_invite_settings_InternalServerError_response_body
+func ValidateGetSurveyInviteSettingsInternalServerErrorResponseBody(body *GetSurveyInviteSettingsInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewGetExclusionUnauthorized builds a survey service get_exclusion endpoint
-// Unauthorized error.
-func NewGetExclusionUnauthorized(body *GetExclusionUnauthorizedResponseBody) *survey.UnauthorizedError {
-	v := &survey.UnauthorizedError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateGetSurveyInviteSettingsNotFoundResponseBody runs the validations
+// defined on get_survey_invite_settings_NotFound_response_body
+func ValidateGetSurveyInviteSettingsNotFoundResponseBody(body *GetSurveyInviteSettingsNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewDeleteExclusionByIDBadRequest builds a survey service
-// delete_exclusion_by_id endpoint BadRequest error.
-func NewDeleteExclusionByIDBadRequest(body *DeleteExclusionByIDBadRequestResponseBody) *survey.BadRequestError {
-	v := &survey.BadRequestError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateGetSurveyInviteSettingsServiceUnavailableResponseBody runs the
+// validations defined on
+// get_survey_invite_settings_ServiceUnavailable_response_body
+func ValidateGetSurveyInviteSettingsServiceUnavailableResponseBody(body *GetSurveyInviteSettingsServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewDeleteExclusionByIDForbidden builds a survey service
-// delete_exclusion_by_id endpoint Forbidden error.
-func NewDeleteExclusionByIDForbidden(body *DeleteExclusionByIDForbiddenResponseBody) *survey.ForbiddenError {
-	v := &survey.ForbiddenError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateGetSurveyInviteSettingsUnauthorizedResponseBody runs the validations
+// defined on get_survey_invite_settings_Unauthorized_response_body
+func ValidateGetSurveyInviteSettingsUnauthorizedResponseBody(body *GetSurveyInviteSettingsUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewDeleteExclusionByIDInternalServerError builds a survey service
-// delete_exclusion_by_id endpoint InternalServerError error.
-func NewDeleteExclusionByIDInternalServerError(body *DeleteExclusionByIDInternalServerErrorResponseBody) *survey.InternalServerError {
-	v := &survey.InternalServerError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateUpdateSurveyInviteSettingsBadRequestResponseBody runs the
+// validations defined on update_survey_invite_settings_BadRequest_response_body
+func ValidateUpdateSurveyInviteSettingsBadRequestResponseBody(body *UpdateSurveyInviteSettingsBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
 }
 
-// NewDeleteExclusionByIDUnauthorized builds a survey service
-// delete_exclusion_by_id endpoint Unauthorized error.
-func NewDeleteExclusionByIDUnauthorized(body *DeleteExclusionByIDUnauthorizedResponseBody) *survey.UnauthorizedError {
-	v := &survey.UnauthorizedError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateUpdateSurveyInviteSettingsForbiddenResponseBody runs the validations
+// defined on update_survey_invite_settings_Forbidden_response_body
+func ValidateUpdateSurveyInviteSettingsForbiddenResponseBody(body *UpdateSurveyInviteSettingsForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewListSurveyResponsesSurveyResponsesPageOK builds a "survey" service
-// "list_survey_responses" endpoint result from a HTTP "OK" response.
-func NewListSurveyResponsesSurveyResponsesPageOK(body *ListSurveyResponsesResponseBody) *survey.SurveyResponsesPage {
-	v := &survey.SurveyResponsesPage{}
-	v.Data = make([]*survey.SurveyResponseItem, len(body.Data))
-	for i, val := range body.Data {
-		if val == nil {
-			v.Data[i] = nil
-			continue
-		}
-		v.Data[i] = unmarshalSurveyResponseItemResponseBodyToSurveySurveyResponseItem(val)
+// ValidateUpdateSurveyInviteSettingsInternalServerErrorResponseBody runs the
+// validations defined on
+// update_survey_invite_settings_InternalServerError_response_body
+func ValidateUpdateSurveyInviteSettingsInternalServerErrorResponseBody(body *UpdateSurveyInviteSettingsInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	v.Meta = unmarshalSurveyResponsePageMetaResponseBodyToSurveySurveyResponsePageMeta(body.Meta)
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewListSurveyResponsesBadRequest builds a survey service
-// list_survey_responses endpoint BadRequest error.
-func NewListSurveyResponsesBadRequest(body *ListSurveyResponsesBadRequestResponseBody) *survey.BadRequestError {
-	v := &survey.BadRequestError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateUpdateSurveyInviteSettingsServiceUnavailableResponseBody runs the
+// validations defined on
+// update_survey_invite_settings_ServiceUnavailable_response_body
+func ValidateUpdateSurveyInviteSettingsServiceUnavailableResponseBody(body *UpdateSurveyInviteSettingsServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewListSurveyResponsesForbidden builds a survey service
-// list_survey_responses endpoint Forbidden error.
-func NewListSurveyResponsesForbidden(body *ListSurveyResponsesForbiddenResponseBody) *survey.ForbiddenError {
-	v := &survey.ForbiddenError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateUpdateSurveyInviteSettingsUnauthorizedResponseBody runs the
+// validations defined on
+// update_survey_invite_settings_Unauthorized_response_body
+func ValidateUpdateSurveyInviteSettingsUnauthorizedResponseBody(body *UpdateSurveyInviteSettingsUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewListSurveyResponsesInternalServerError builds a survey service
-// list_survey_responses endpoint InternalServerError error.
-func NewListSurveyResponsesInternalServerError(body *ListSurveyResponsesInternalServerErrorResponseBody) *survey.InternalServerError {
-	v := &survey.InternalServerError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateDeleteSurveyInviteSettingsBadRequestResponseBody runs the
+// validations defined on delete_survey_invite_settings_BadRequest_response_body
+func ValidateDeleteSurveyInviteSettingsBadRequestResponseBody(body *DeleteSurveyInviteSettingsBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
 }
 
-// NewListSurveyResponsesNotFound builds a survey service list_survey_responses
-// endpoint NotFound error.
-func NewListSurveyResponsesNotFound(body *ListSurveyResponsesNotFoundResponseBody) *survey.NotFoundError {
-	v := &survey.NotFoundError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateDeleteSurveyInviteSettingsForbiddenResponseBody runs the validations
+// defined on delete_survey_invite_settings_Forbidden_response_body
+func ValidateDeleteSurveyInviteSettingsForbiddenResponseBody(body *DeleteSurveyInviteSettingsForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewListSurveyResponsesServiceUnavailable builds a survey service
-// list_survey_responses endpoint ServiceUnavailable error.
-func NewListSurveyResponsesServiceUnavailable(body *ListSurveyResponsesServiceUnavailableResponseBody) *survey.ServiceUnavailableError {
-	v := &survey.ServiceUnavailableError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateDeleteSurveyInviteSettingsInternalServerErrorResponseBody runs the
+// validations defined on
+// delete_survey_invite_settings_InternalServerError_response_body
+func ValidateDeleteSurveyInviteSettingsInternalServerErrorResponseBody(body *DeleteSurveyInviteSettingsInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewListSurveyResponsesUnauthorized builds a survey service
-// list_survey_responses endpoint Unauthorized error.
-func NewListSurveyResponsesUnauthorized(body *ListSurveyResponsesUnauthorizedResponseBody) *survey.UnauthorizedError {
-	v := &survey.UnauthorizedError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateDeleteSurveyInviteSettingsNotFoundResponseBody runs the validations
+// defined on delete_survey_invite_settings_NotFound_response_body
+func ValidateDeleteSurveyInviteSettingsNotFoundResponseBody(body *DeleteSurveyInviteSettingsNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewValidateEmailResultOK builds a "survey" service "validate_email" endpoint
-// result from a HTTP "OK" response.
-func NewValidateEmailResultOK(body *ValidateEmailResponseBody) *survey.ValidateEmailResult {
-	v := &survey.ValidateEmailResult{
-		Body:    *body.Body,
-		Subject: *body.Subject,
+// ValidateDeleteSurveyInviteSettingsServiceUnavailableResponseBody runs the
+// validations defined on
+// delete_survey_invite_settings_ServiceUnavailable_response_body
+func ValidateDeleteSurveyInviteSettingsServiceUnavailableResponseBody(body *DeleteSurveyInviteSettingsServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	return
+}
 
-	return v
+// ValidateDeleteSurveyInviteSettingsUnauthorizedResponseBody runs the
+// validations defined on
+// delete_survey_invite_settings_Unauthorized_response_body
+func ValidateDeleteSurveyInviteSettingsUnauthorizedResponseBody(body *DeleteSurveyInviteSettingsUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewValidateEmailBadRequest builds a survey service validate_email endpoint
-// BadRequest error.
-func NewValidateEmailBadRequest(body *ValidateEmailBadRequestResponseBody) *survey.BadRequestError {
-	v := &survey.BadRequestError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateGetProjectInviteSettingsBadRequestResponseBody runs the validations
+// defined on get_project_invite_settings_BadRequest_response_body
+func ValidateGetProjectInviteSettingsBadRequestResponseBody(body *GetProjectInviteSettingsBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 	}
-
-	return v
+	return
 }
 
-// NewValidateEmailForbidden builds a survey service validate_email endpoint
-// Forbidden error.
-func NewValidateEmailForbidden(body *ValidateEmailForbiddenResponseBody) *survey.ForbiddenError {
-	v := &survey.ForbiddenError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateGetProjectInviteSettingsForbiddenResponseBody runs the validations
+// defined on get_project_invite_settings_Forbidden_response_body
+func ValidateGetProjectInviteSettingsForbiddenResponseBody(body *GetProjectInviteSettingsForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewValidateEmailInternalServerError builds a survey service validate_email
-// endpoint InternalServerError error.
-func NewValidateEmailInternalServerError(body *ValidateEmailInternalServerErrorResponseBody) *survey.InternalServerError {
-	v := &survey.InternalServerError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateGetProjectInviteSettingsInternalServerErrorResponseBody runs the
+// validations defined on
+// get_project_invite_settings_InternalServerError_response_body
+func ValidateGetProjectInviteSettingsInternalServerErrorResponseBody(body *GetProjectInviteSettingsInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// NewValidateEmailUnauthorized builds a survey service validate_email endpoint
-// Unauthorized error.
-func NewValidateEmailUnauthorized(body *ValidateEmailUnauthorizedResponseBody) *survey.UnauthorizedError {
-	v := &survey.UnauthorizedError{
-		Code:    *body.Code,
-		Message: *body.Message,
+// ValidateGetProjectInviteSettingsNotFoundResponseBody runs the validations
+// defined on get_project_invite_settings_NotFound_response_body
+func ValidateGetProjectInviteSettingsNotFoundResponseBody(body *GetProjectInviteSettingsNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
 }
 
-// ValidateScheduleSurveyResponseBody runs the validations defined on
-// schedule_survey_response_body
-func ValidateScheduleSurveyResponseBody(body *ScheduleSurveyResponseBody) (err error) {
-	if body.UID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
-	}
-	if body.SurveyStatus == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("survey_status", "body"))
+// ValidateGetProjectInviteSettingsServiceUnavailableResponseBody runs the
+// validations defined on
+// get_project_invite_settings_ServiceUnavailable_response_body
+func ValidateGetProjectInviteSettingsServiceUnavailableResponseBody(body *GetProjectInviteSettingsServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.CreatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.LastModifiedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.last_modified_at", *body.LastModifiedAt, goa.FormatDateTime))
+	return
+}
+
+// ValidateGetProjectInviteSettingsUnauthorizedResponseBody runs the
+// validations defined on get_project_invite_settings_Unauthorized_response_body
+func ValidateGetProjectInviteSettingsUnauthorizedResponseBody(body *GetProjectInviteSettingsUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.SurveyStatus != nil {
-		if !(*body.SurveyStatus == "scheduled" || *body.SurveyStatus == "sending" || *body.SurveyStatus == "sent" || *body.SurveyStatus == "cancelled") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.survey_status", *body.SurveyStatus, []any{"scheduled", "sending", "sent", "cancelled"}))
-		}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.ResponseStatus != nil {
-		if !(*body.ResponseStatus == "scheduled" || *body.ResponseStatus == "open" || *body.ResponseStatus == "closed") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.response_status", *body.ResponseStatus, []any{"scheduled", "open", "closed"}))
-		}
+	return
+}
+
+// ValidateUpdateProjectInviteSettingsBadRequestResponseBody runs the
+// validations defined on
+// update_project_invite_settings_BadRequest_response_body
+func ValidateUpdateProjectInviteSettingsBadRequestResponseBody(body *UpdateProjectInviteSettingsBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.SurveySendDate != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.survey_send_date", *body.SurveySendDate, goa.FormatDateTime))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.SurveyCutoffDate != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.survey_cutoff_date", *body.SurveyCutoffDate, goa.FormatDateTime))
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 	}
-	if body.NextAutomatedReminderAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.next_automated_reminder_at", *body.NextAutomatedReminderAt, goa.FormatDateTime))
+	return
+}
+
+// ValidateUpdateProjectInviteSettingsForbiddenResponseBody runs the
+// validations defined on update_project_invite_settings_Forbidden_response_body
+func ValidateUpdateProjectInviteSettingsForbiddenResponseBody(body *UpdateProjectInviteSettingsForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.LatestAutomatedReminderSentAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.latest_automated_reminder_sent_at", *body.LatestAutomatedReminderSentAt, goa.FormatDateTime))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetSurveyResponseBody runs the validations defined on
-// get_survey_response_body
-func ValidateGetSurveyResponseBody(body *GetSurveyResponseBody) (err error) {
-	if body.UID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
-	}
-	if body.SurveyStatus == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("survey_status", "body"))
+// ValidateUpdateProjectInviteSettingsInternalServerErrorResponseBody runs the
+// validations defined on
+// update_project_invite_settings_InternalServerError_response_body
+func ValidateUpdateProjectInviteSettingsInternalServerErrorResponseBody(body *UpdateProjectInviteSettingsInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.CreatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.LastModifiedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.last_modified_at", *body.LastModifiedAt, goa.FormatDateTime))
+	return
+}
+
+// ValidateUpdateProjectInviteSettingsServiceUnavailableResponseBody runs the
+// validations defined on
+// update_project_invite_settings_ServiceUnavailable_response_body
+func ValidateUpdateProjectInviteSettingsServiceUnavailableResponseBody(body *UpdateProjectInviteSettingsServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.SurveyStatus != nil {
-		if !(*body.SurveyStatus == "scheduled" || *body.SurveyStatus == "sending" || *body.SurveyStatus == "sent" || *body.SurveyStatus == "cancelled") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.survey_status", *body.SurveyStatus, []any{"scheduled", "sending", "sent", "cancelled"}))
-		}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.ResponseStatus != nil {
-		if !(*body.ResponseStatus == "scheduled" || *body.ResponseStatus == "open" || *body.ResponseStatus == "closed") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.response_status", *body.ResponseStatus, []any{"scheduled", "open", "closed"}))
-		}
+	return
+}
+
+// ValidateUpdateProjectInviteSettingsUnauthorizedResponseBody runs the
+// validations defined on
+// update_project_invite_settings_Unauthorized_response_body
+func ValidateUpdateProjectInviteSettingsUnauthorizedResponseBody(body *UpdateProjectInviteSettingsUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.SurveySendDate != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.survey_send_date", *body.SurveySendDate, goa.FormatDateTime))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.SurveyCutoffDate != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.survey_cutoff_date", *body.SurveyCutoffDate, goa.FormatDateTime))
+	return
+}
+
+// ValidateDeleteProjectInviteSettingsBadRequestResponseBody runs the
+// validations defined on
+// delete_project_invite_settings_BadRequest_response_body
+func ValidateDeleteProjectInviteSettingsBadRequestResponseBody(body *DeleteProjectInviteSettingsBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.NextAutomatedReminderAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.next_automated_reminder_at", *body.NextAutomatedReminderAt, goa.FormatDateTime))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.LatestAutomatedReminderSentAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.latest_automated_reminder_sent_at", *body.LatestAutomatedReminderSentAt, goa.FormatDateTime))
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 	}
 	return
 }
 
-// ValidateUpdateSurveyResponseBody runs the validations defined on
-// update_survey_response_body
-func ValidateUpdateSurveyResponseBody(body *UpdateSurveyResponseBody) (err error) {
-	if body.UID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
+// ValidateDeleteProjectInviteSettingsForbiddenResponseBody runs the
+// validations defined on delete_project_invite_settings_Forbidden_response_body
+func ValidateDeleteProjectInviteSettingsForbiddenResponseBody(body *DeleteProjectInviteSettingsForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.SurveyStatus == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("survey_status", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.CreatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	return
+}
+
+// ValidateDeleteProjectInviteSettingsInternalServerErrorResponseBody runs the
+// validations defined on
+// delete_project_invite_settings_InternalServerError_response_body
+func ValidateDeleteProjectInviteSettingsInternalServerErrorResponseBody(body *DeleteProjectInviteSettingsInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.LastModifiedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.last_modified_at", *body.LastModifiedAt, goa.FormatDateTime))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.SurveyStatus != nil {
-		if !(*body.SurveyStatus == "scheduled" || *body.SurveyStatus == "sending" || *body.SurveyStatus == "sent" || *body.SurveyStatus == "cancelled") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.survey_status", *body.SurveyStatus, []any{"scheduled", "sending", "sent", "cancelled"}))
-		}
+	return
+}
+
+// ValidateDeleteProjectInviteSettingsNotFoundResponseBody runs the validations
+// defined on delete_project_invite_settings_NotFound_response_body
+func ValidateDeleteProjectInviteSettingsNotFoundResponseBody(body *DeleteProjectInviteSettingsNotFoundResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.ResponseStatus != nil {
-		if !(*body.ResponseStatus == "scheduled" || *body.ResponseStatus == "open" || *body.ResponseStatus == "closed") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.response_status", *body.ResponseStatus, []any{"scheduled", "open", "closed"}))
-		}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.SurveySendDate != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.survey_send_date", *body.SurveySendDate, goa.FormatDateTime))
+	return
+}
+
+// ValidateDeleteProjectInviteSettingsServiceUnavailableResponseBody runs the
+// validations defined on
+// delete_project_invite_settings_ServiceUnavailable_response_body
+func ValidateDeleteProjectInviteSettingsServiceUnavailableResponseBody(body *DeleteProjectInviteSettingsServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.SurveyCutoffDate != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.survey_cutoff_date", *body.SurveyCutoffDate, goa.FormatDateTime))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.NextAutomatedReminderAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.next_automated_reminder_at", *body.NextAutomatedReminderAt, goa.FormatDateTime))
+	return
+}
+
+// ValidateDeleteProjectInviteSettingsUnauthorizedResponseBody runs the
+// validations defined on
+// delete_project_invite_settings_Unauthorized_response_body
+func ValidateDeleteProjectInviteSettingsUnauthorizedResponseBody(body *DeleteProjectInviteSettingsUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.LatestAutomatedReminderSentAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.latest_automated_reminder_sent_at", *body.LatestAutomatedReminderSentAt, goa.FormatDateTime))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidatePreviewSendSurveyResponseBody runs the validations defined on
-// preview_send_survey_response_body
-func ValidatePreviewSendSurveyResponseBody(body *PreviewSendSurveyResponseBody) (err error) {
-	for _, e := range body.AffectedProjects {
-		if e != nil {
-			if err2 := ValidateLFXProjectResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+// ValidateGetSurveySettingsBadRequestResponseBody runs the validations defined
+// on get_survey_settings_BadRequest_response_body
+func ValidateGetSurveySettingsBadRequestResponseBody(body *GetSurveySettingsBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	for _, e := range body.AffectedCommittees {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	for _, e := range body.QuestionErrors {
 		if e != nil {
-			if err2 := ValidateExcludedCommitteeResponseBody(e); err2 != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
 				err = goa.MergeErrors(err, err2)
 			}
 		}
 	}
-	for _, e := range body.AffectedRecipients {
-		if e != nil {
-			if err2 := ValidateITXPreviewRecipientResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	return
+}
+
+// ValidateGetSurveySettingsForbiddenResponseBody runs the validations defined
+// on get_survey_settings_Forbidden_response_body
+func ValidateGetSurveySettingsForbiddenResponseBody(body *GetSurveySettingsForbiddenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetSurveySettingsInternalServerErrorResponseBody runs the
+// validations defined on get_survey_settings_InternalServerError_response_body
+func ValidateGetSurveySettingsInternalServerErrorResponseBody(body *GetSurveySettingsInternalServerErrorResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateCreateExclusionResponseBody runs the validations defined on
-// create_exclusion_response_body
-func ValidateCreateExclusionResponseBody(body *CreateExclusionResponseBody) (err error) {
-	if body.UID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
+// ValidateGetSurveySettingsServiceUnavailableResponseBody runs the validations
+// defined on get_survey_settings_ServiceUnavailable_response_body
+func ValidateGetSurveySettingsServiceUnavailableResponseBody(body *GetSurveySettingsServiceUnavailableResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetExclusionResponseBody runs the validations defined on
-// get_exclusion_response_body
-func ValidateGetExclusionResponseBody(body *GetExclusionResponseBody) (err error) {
-	if body.UID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("uid", "body"))
+// ValidateGetSurveySettingsUnauthorizedResponseBody runs the validations
+// defined on get_survey_settings_Unauthorized_response_body
+func ValidateGetSurveySettingsUnauthorizedResponseBody(body *GetSurveySettingsUnauthorizedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateListSurveyResponsesResponseBody runs the validations defined on
-// list_survey_responses_response_body
-func ValidateListSurveyResponsesResponseBody(body *ListSurveyResponsesResponseBody) (err error) {
-	if body.Data == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("data", "body"))
+// ValidateUpdateSurveySettingsBadRequestResponseBody runs the validations
+// defined on update_survey_settings_BadRequest_response_body
+func ValidateUpdateSurveySettingsBadRequestResponseBody(body *UpdateSurveySettingsBadRequestResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.Meta == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("meta", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	for _, e := range body.Data {
+	for _, e := range body.QuestionErrors {
 		if e != nil {
-			if err2 := ValidateSurveyResponseItemResponseBody(e); err2 != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
 				err = goa.MergeErrors(err, err2)
 			}
 		}
@@ -2940,21 +10367,21 @@ func ValidateListSurveyResponsesResponseBody(body *ListSurveyResponsesResponseBo
 	return
 }
 
-// ValidateValidateEmailResponseBody runs the validations defined on
-// validate_email_response_body
-func ValidateValidateEmailResponseBody(body *ValidateEmailResponseBody) (err error) {
-	if body.Body == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("body", "body"))
+// ValidateUpdateSurveySettingsConflictResponseBody runs the validations
+// defined on update_survey_settings_Conflict_response_body
+func ValidateUpdateSurveySettingsConflictResponseBody(body *UpdateSurveySettingsConflictResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.Subject == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("subject", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateScheduleSurveyBadRequestResponseBody runs the validations defined on
-// schedule_survey_BadRequest_response_body
-func ValidateScheduleSurveyBadRequestResponseBody(body *ScheduleSurveyBadRequestResponseBody) (err error) {
+// ValidateUpdateSurveySettingsForbiddenResponseBody runs the validations
+// defined on update_survey_settings_Forbidden_response_body
+func ValidateUpdateSurveySettingsForbiddenResponseBody(body *UpdateSurveySettingsForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -2964,9 +10391,10 @@ func ValidateScheduleSurveyBadRequestResponseBody(body *ScheduleSurveyBadRequest
 	return
 }
 
-// ValidateScheduleSurveyForbiddenResponseBody runs the validations defined on
-// schedule_survey_Forbidden_response_body
-func ValidateScheduleSurveyForbiddenResponseBody(body *ScheduleSurveyForbiddenResponseBody) (err error) {
+// ValidateUpdateSurveySettingsInternalServerErrorResponseBody runs the
+// validations defined on
+// update_survey_settings_InternalServerError_response_body
+func ValidateUpdateSurveySettingsInternalServerErrorResponseBody(body *UpdateSurveySettingsInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -2976,9 +10404,9 @@ func ValidateScheduleSurveyForbiddenResponseBody(body *ScheduleSurveyForbiddenRe
 	return
 }
 
-// ValidateScheduleSurveyInternalServerErrorResponseBody runs the validations
-// defined on schedule_survey_InternalServerError_response_body
-func ValidateScheduleSurveyInternalServerErrorResponseBody(body *ScheduleSurveyInternalServerErrorResponseBody) (err error) {
+// ValidateUpdateSurveySettingsNotFoundResponseBody runs the validations
+// defined on update_survey_settings_NotFound_response_body
+func ValidateUpdateSurveySettingsNotFoundResponseBody(body *UpdateSurveySettingsNotFoundResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -2988,9 +10416,10 @@ func ValidateScheduleSurveyInternalServerErrorResponseBody(body *ScheduleSurveyI
 	return
 }
 
-// ValidateScheduleSurveyServiceUnavailableResponseBody runs the validations
-// defined on schedule_survey_ServiceUnavailable_response_body
-func ValidateScheduleSurveyServiceUnavailableResponseBody(body *ScheduleSurveyServiceUnavailableResponseBody) (err error) {
+// ValidateUpdateSurveySettingsServiceUnavailableResponseBody runs the
+// validations defined on
+// update_survey_settings_ServiceUnavailable_response_body
+func ValidateUpdateSurveySettingsServiceUnavailableResponseBody(body *UpdateSurveySettingsServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3000,9 +10429,9 @@ func ValidateScheduleSurveyServiceUnavailableResponseBody(body *ScheduleSurveySe
 	return
 }
 
-// ValidateScheduleSurveyUnauthorizedResponseBody runs the validations defined
-// on schedule_survey_Unauthorized_response_body
-func ValidateScheduleSurveyUnauthorizedResponseBody(body *ScheduleSurveyUnauthorizedResponseBody) (err error) {
+// ValidateUpdateSurveySettingsUnauthorizedResponseBody runs the validations
+// defined on update_survey_settings_Unauthorized_response_body
+func ValidateUpdateSurveySettingsUnauthorizedResponseBody(body *UpdateSurveySettingsUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3012,21 +10441,28 @@ func ValidateScheduleSurveyUnauthorizedResponseBody(body *ScheduleSurveyUnauthor
 	return
 }
 
-// ValidateGetSurveyBadRequestResponseBody runs the validations defined on
-// get_survey_BadRequest_response_body
-func ValidateGetSurveyBadRequestResponseBody(body *GetSurveyBadRequestResponseBody) (err error) {
+// ValidateGetSurveyResultsBadRequestResponseBody runs the validations defined
+// on get_survey_results_BadRequest_response_body
+func ValidateGetSurveyResultsBadRequestResponseBody(body *GetSurveyResultsBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
-// ValidateGetSurveyForbiddenResponseBody runs the validations defined on
-// get_survey_Forbidden_response_body
-func ValidateGetSurveyForbiddenResponseBody(body *GetSurveyForbiddenResponseBody) (err error) {
+// ValidateGetSurveyResultsForbiddenResponseBody runs the validations defined
+// on get_survey_results_Forbidden_response_body
+func ValidateGetSurveyResultsForbiddenResponseBody(body *GetSurveyResultsForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3036,9 +10472,9 @@ func ValidateGetSurveyForbiddenResponseBody(body *GetSurveyForbiddenResponseBody
 	return
 }
 
-// ValidateGetSurveyInternalServerErrorResponseBody runs the validations
-// defined on get_survey_InternalServerError_response_body
-func ValidateGetSurveyInternalServerErrorResponseBody(body *GetSurveyInternalServerErrorResponseBody) (err error) {
+// ValidateGetSurveyResultsInternalServerErrorResponseBody runs the validations
+// defined on get_survey_results_InternalServerError_response_body
+func ValidateGetSurveyResultsInternalServerErrorResponseBody(body *GetSurveyResultsInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3048,9 +10484,9 @@ func ValidateGetSurveyInternalServerErrorResponseBody(body *GetSurveyInternalSer
 	return
 }
 
-// ValidateGetSurveyNotFoundResponseBody runs the validations defined on
-// get_survey_NotFound_response_body
-func ValidateGetSurveyNotFoundResponseBody(body *GetSurveyNotFoundResponseBody) (err error) {
+// ValidateGetSurveyResultsNotFoundResponseBody runs the validations defined on
+// get_survey_results_NotFound_response_body
+func ValidateGetSurveyResultsNotFoundResponseBody(body *GetSurveyResultsNotFoundResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3060,9 +10496,9 @@ func ValidateGetSurveyNotFoundResponseBody(body *GetSurveyNotFoundResponseBody)
 	return
 }
 
-// ValidateGetSurveyServiceUnavailableResponseBody runs the validations defined
-// on get_survey_ServiceUnavailable_response_body
-func ValidateGetSurveyServiceUnavailableResponseBody(body *GetSurveyServiceUnavailableResponseBody) (err error) {
+// ValidateGetSurveyResultsServiceUnavailableResponseBody runs the validations
+// defined on get_survey_results_ServiceUnavailable_response_body
+func ValidateGetSurveyResultsServiceUnavailableResponseBody(body *GetSurveyResultsServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3072,9 +10508,9 @@ func ValidateGetSurveyServiceUnavailableResponseBody(body *GetSurveyServiceUnava
 	return
 }
 
-// ValidateGetSurveyUnauthorizedResponseBody runs the validations defined on
-// get_survey_Unauthorized_response_body
-func ValidateGetSurveyUnauthorizedResponseBody(body *GetSurveyUnauthorizedResponseBody) (err error) {
+// ValidateGetSurveyResultsUnauthorizedResponseBody runs the validations
+// defined on get_survey_results_Unauthorized_response_body
+func ValidateGetSurveyResultsUnauthorizedResponseBody(body *GetSurveyResultsUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3084,21 +10520,28 @@ func ValidateGetSurveyUnauthorizedResponseBody(body *GetSurveyUnauthorizedRespon
 	return
 }
 
-// ValidateUpdateSurveyBadRequestResponseBody runs the validations defined on
-// update_survey_BadRequest_response_body
-func ValidateUpdateSurveyBadRequestResponseBody(body *UpdateSurveyBadRequestResponseBody) (err error) {
+// ValidateValidateEmailBadRequestResponseBody runs the validations defined on
+// validate_email_BadRequest_response_body
+func ValidateValidateEmailBadRequestResponseBody(body *ValidateEmailBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
-// ValidateUpdateSurveyForbiddenResponseBody runs the validations defined on
-// update_survey_Forbidden_response_body
-func ValidateUpdateSurveyForbiddenResponseBody(body *UpdateSurveyForbiddenResponseBody) (err error) {
+// ValidateValidateEmailForbiddenResponseBody runs the validations defined on
+// validate_email_Forbidden_response_body
+func ValidateValidateEmailForbiddenResponseBody(body *ValidateEmailForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3108,9 +10551,9 @@ func ValidateUpdateSurveyForbiddenResponseBody(body *UpdateSurveyForbiddenRespon
 	return
 }
 
-// ValidateUpdateSurveyInternalServerErrorResponseBody runs the validations
-// defined on update_survey_InternalServerError_response_body
-func ValidateUpdateSurveyInternalServerErrorResponseBody(body *UpdateSurveyInternalServerErrorResponseBody) (err error) {
+// ValidateValidateEmailInternalServerErrorResponseBody runs the validations
+// defined on validate_email_InternalServerError_response_body
+func ValidateValidateEmailInternalServerErrorResponseBody(body *ValidateEmailInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3120,9 +10563,9 @@ func ValidateUpdateSurveyInternalServerErrorResponseBody(body *UpdateSurveyInter
 	return
 }
 
-// ValidateUpdateSurveyNotFoundResponseBody runs the validations defined on
-// update_survey_NotFound_response_body
-func ValidateUpdateSurveyNotFoundResponseBody(body *UpdateSurveyNotFoundResponseBody) (err error) {
+// ValidateValidateEmailUnauthorizedResponseBody runs the validations defined
+// on validate_email_Unauthorized_response_body
+func ValidateValidateEmailUnauthorizedResponseBody(body *ValidateEmailUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3132,21 +10575,28 @@ func ValidateUpdateSurveyNotFoundResponseBody(body *UpdateSurveyNotFoundResponse
 	return
 }
 
-// ValidateUpdateSurveyServiceUnavailableResponseBody runs the validations
-// defined on update_survey_ServiceUnavailable_response_body
-func ValidateUpdateSurveyServiceUnavailableResponseBody(body *UpdateSurveyServiceUnavailableResponseBody) (err error) {
+// ValidateListSurveyTemplatesBadRequestResponseBody runs the validations
+// defined on list_survey_templates_BadRequest_response_body
+func ValidateListSurveyTemplatesBadRequestResponseBody(body *ListSurveyTemplatesBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
-// ValidateUpdateSurveyUnauthorizedResponseBody runs the validations defined on
-// update_survey_Unauthorized_response_body
-func ValidateUpdateSurveyUnauthorizedResponseBody(body *UpdateSurveyUnauthorizedResponseBody) (err error) {
+// ValidateListSurveyTemplatesForbiddenResponseBody runs the validations
+// defined on list_survey_templates_Forbidden_response_body
+func ValidateListSurveyTemplatesForbiddenResponseBody(body *ListSurveyTemplatesForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3156,9 +10606,10 @@ func ValidateUpdateSurveyUnauthorizedResponseBody(body *UpdateSurveyUnauthorized
 	return
 }
 
-// ValidateDeleteSurveyBadRequestResponseBody runs the validations defined on
-// delete_survey_BadRequest_response_body
-func ValidateDeleteSurveyBadRequestResponseBody(body *DeleteSurveyBadRequestResponseBody) (err error) {
+// ValidateListSurveyTemplatesInternalServerErrorResponseBody runs the
+// validations defined on
+// list_survey_templates_InternalServerError_response_body
+func ValidateListSurveyTemplatesInternalServerErrorResponseBody(body *ListSurveyTemplatesInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3168,9 +10619,9 @@ func ValidateDeleteSurveyBadRequestResponseBody(body *DeleteSurveyBadRequestResp
 	return
 }
 
-// ValidateDeleteSurveyForbiddenResponseBody runs the validations defined on
-// delete_survey_Forbidden_response_body
-func ValidateDeleteSurveyForbiddenResponseBody(body *DeleteSurveyForbiddenResponseBody) (err error) {
+// ValidateListSurveyTemplatesServiceUnavailableResponseBody runs the
+// validations defined on list_survey_templates_ServiceUnavailable_response_body
+func ValidateListSurveyTemplatesServiceUnavailableResponseBody(body *ListSurveyTemplatesServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3180,9 +10631,9 @@ func ValidateDeleteSurveyForbiddenResponseBody(body *DeleteSurveyForbiddenRespon
 	return
 }
 
-// ValidateDeleteSurveyInternalServerErrorResponseBody runs the validations
-// defined on delete_survey_InternalServerError_response_body
-func ValidateDeleteSurveyInternalServerErrorResponseBody(body *DeleteSurveyInternalServerErrorResponseBody) (err error) {
+// ValidateListSurveyTemplatesUnauthorizedResponseBody runs the validations
+// defined on list_survey_templates_Unauthorized_response_body
+func ValidateListSurveyTemplatesUnauthorizedResponseBody(body *ListSurveyTemplatesUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3192,21 +10643,28 @@ func ValidateDeleteSurveyInternalServerErrorResponseBody(body *DeleteSurveyInter
 	return
 }
 
-// ValidateDeleteSurveyNotFoundResponseBody runs the validations defined on
-// delete_survey_NotFound_response_body
-func ValidateDeleteSurveyNotFoundResponseBody(body *DeleteSurveyNotFoundResponseBody) (err error) {
+// ValidateGetSurveyTemplateBadRequestResponseBody runs the validations defined
+// on get_survey_template_BadRequest_response_body
+func ValidateGetSurveyTemplateBadRequestResponseBody(body *GetSurveyTemplateBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
-// ValidateDeleteSurveyServiceUnavailableResponseBody runs the validations
-// defined on delete_survey_ServiceUnavailable_response_body
-func ValidateDeleteSurveyServiceUnavailableResponseBody(body *DeleteSurveyServiceUnavailableResponseBody) (err error) {
+// ValidateGetSurveyTemplateForbiddenResponseBody runs the validations defined
+// on get_survey_template_Forbidden_response_body
+func ValidateGetSurveyTemplateForbiddenResponseBody(body *GetSurveyTemplateForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3216,9 +10674,9 @@ func ValidateDeleteSurveyServiceUnavailableResponseBody(body *DeleteSurveyServic
 	return
 }
 
-// ValidateDeleteSurveyUnauthorizedResponseBody runs the validations defined on
-// delete_survey_Unauthorized_response_body
-func ValidateDeleteSurveyUnauthorizedResponseBody(body *DeleteSurveyUnauthorizedResponseBody) (err error) {
+// ValidateGetSurveyTemplateInternalServerErrorResponseBody runs the
+// validations defined on get_survey_template_InternalServerError_response_body
+func ValidateGetSurveyTemplateInternalServerErrorResponseBody(body *GetSurveyTemplateInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3228,9 +10686,9 @@ func ValidateDeleteSurveyUnauthorizedResponseBody(body *DeleteSurveyUnauthorized
 	return
 }
 
-// ValidateBulkResendSurveyBadRequestResponseBody runs the validations defined
-// on bulk_resend_survey_BadRequest_response_body
-func ValidateBulkResendSurveyBadRequestResponseBody(body *BulkResendSurveyBadRequestResponseBody) (err error) {
+// ValidateGetSurveyTemplateNotFoundResponseBody runs the validations defined
+// on get_survey_template_NotFound_response_body
+func ValidateGetSurveyTemplateNotFoundResponseBody(body *GetSurveyTemplateNotFoundResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3240,9 +10698,9 @@ func ValidateBulkResendSurveyBadRequestResponseBody(body *BulkResendSurveyBadReq
 	return
 }
 
-// ValidateBulkResendSurveyForbiddenResponseBody runs the validations defined
-// on bulk_resend_survey_Forbidden_response_body
-func ValidateBulkResendSurveyForbiddenResponseBody(body *BulkResendSurveyForbiddenResponseBody) (err error) {
+// ValidateGetSurveyTemplateServiceUnavailableResponseBody runs the validations
+// defined on get_survey_template_ServiceUnavailable_response_body
+func ValidateGetSurveyTemplateServiceUnavailableResponseBody(body *GetSurveyTemplateServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3252,9 +10710,9 @@ func ValidateBulkResendSurveyForbiddenResponseBody(body *BulkResendSurveyForbidd
 	return
 }
 
-// ValidateBulkResendSurveyInternalServerErrorResponseBody runs the validations
-// defined on bulk_resend_survey_InternalServerError_response_body
-func ValidateBulkResendSurveyInternalServerErrorResponseBody(body *BulkResendSurveyInternalServerErrorResponseBody) (err error) {
+// ValidateGetSurveyTemplateUnauthorizedResponseBody runs the validations
+// defined on get_survey_template_Unauthorized_response_body
+func ValidateGetSurveyTemplateUnauthorizedResponseBody(body *GetSurveyTemplateUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3264,21 +10722,28 @@ func ValidateBulkResendSurveyInternalServerErrorResponseBody(body *BulkResendSur
 	return
 }
 
-// ValidateBulkResendSurveyNotFoundResponseBody runs the validations defined on
-// bulk_resend_survey_NotFound_response_body
-func ValidateBulkResendSurveyNotFoundResponseBody(body *BulkResendSurveyNotFoundResponseBody) (err error) {
+// ValidateListDeadLettersBadRequestResponseBody runs the validations defined
+// on list_dead_letters_BadRequest_response_body
+func ValidateListDeadLettersBadRequestResponseBody(body *ListDeadLettersBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
-// ValidateBulkResendSurveyServiceUnavailableResponseBody runs the validations
-// defined on bulk_resend_survey_ServiceUnavailable_response_body
-func ValidateBulkResendSurveyServiceUnavailableResponseBody(body *BulkResendSurveyServiceUnavailableResponseBody) (err error) {
+// ValidateListDeadLettersForbiddenResponseBody runs the validations defined on
+// list_dead_letters_Forbidden_response_body
+func ValidateListDeadLettersForbiddenResponseBody(body *ListDeadLettersForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3288,9 +10753,9 @@ func ValidateBulkResendSurveyServiceUnavailableResponseBody(body *BulkResendSurv
 	return
 }
 
-// ValidateBulkResendSurveyUnauthorizedResponseBody runs the validations
-// defined on bulk_resend_survey_Unauthorized_response_body
-func ValidateBulkResendSurveyUnauthorizedResponseBody(body *BulkResendSurveyUnauthorizedResponseBody) (err error) {
+// ValidateListDeadLettersInternalServerErrorResponseBody runs the validations
+// defined on list_dead_letters_InternalServerError_response_body
+func ValidateListDeadLettersInternalServerErrorResponseBody(body *ListDeadLettersInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3300,9 +10765,9 @@ func ValidateBulkResendSurveyUnauthorizedResponseBody(body *BulkResendSurveyUnau
 	return
 }
 
-// ValidatePreviewSendSurveyBadRequestResponseBody runs the validations defined
-// on preview_send_survey_BadRequest_response_body
-func ValidatePreviewSendSurveyBadRequestResponseBody(body *PreviewSendSurveyBadRequestResponseBody) (err error) {
+// ValidateListDeadLettersServiceUnavailableResponseBody runs the validations
+// defined on list_dead_letters_ServiceUnavailable_response_body
+func ValidateListDeadLettersServiceUnavailableResponseBody(body *ListDeadLettersServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3312,9 +10777,9 @@ func ValidatePreviewSendSurveyBadRequestResponseBody(body *PreviewSendSurveyBadR
 	return
 }
 
-// ValidatePreviewSendSurveyForbiddenResponseBody runs the validations defined
-// on preview_send_survey_Forbidden_response_body
-func ValidatePreviewSendSurveyForbiddenResponseBody(body *PreviewSendSurveyForbiddenResponseBody) (err error) {
+// ValidateListDeadLettersUnauthorizedResponseBody runs the validations defined
+// on list_dead_letters_Unauthorized_response_body
+func ValidateListDeadLettersUnauthorizedResponseBody(body *ListDeadLettersUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3324,21 +10789,28 @@ func ValidatePreviewSendSurveyForbiddenResponseBody(body *PreviewSendSurveyForbi
 	return
 }
 
-// ValidatePreviewSendSurveyInternalServerErrorResponseBody runs the
-// validations defined on preview_send_survey_InternalServerError_response_body
-func ValidatePreviewSendSurveyInternalServerErrorResponseBody(body *PreviewSendSurveyInternalServerErrorResponseBody) (err error) {
+// ValidateGetDeadLetterBadRequestResponseBody runs the validations defined on
+// get_dead_letter_BadRequest_response_body
+func ValidateGetDeadLetterBadRequestResponseBody(body *GetDeadLetterBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
-// ValidatePreviewSendSurveyNotFoundResponseBody runs the validations defined
-// on preview_send_survey_NotFound_response_body
-func ValidatePreviewSendSurveyNotFoundResponseBody(body *PreviewSendSurveyNotFoundResponseBody) (err error) {
+// ValidateGetDeadLetterForbiddenResponseBody runs the validations defined on
+// get_dead_letter_Forbidden_response_body
+func ValidateGetDeadLetterForbiddenResponseBody(body *GetDeadLetterForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3348,9 +10820,9 @@ func ValidatePreviewSendSurveyNotFoundResponseBody(body *PreviewSendSurveyNotFou
 	return
 }
 
-// ValidatePreviewSendSurveyServiceUnavailableResponseBody runs the validations
-// defined on preview_send_survey_ServiceUnavailable_response_body
-func ValidatePreviewSendSurveyServiceUnavailableResponseBody(body *PreviewSendSurveyServiceUnavailableResponseBody) (err error) {
+// ValidateGetDeadLetterInternalServerErrorResponseBody runs the validations
+// defined on get_dead_letter_InternalServerError_response_body
+func ValidateGetDeadLetterInternalServerErrorResponseBody(body *GetDeadLetterInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3360,9 +10832,9 @@ func ValidatePreviewSendSurveyServiceUnavailableResponseBody(body *PreviewSendSu
 	return
 }
 
-// ValidatePreviewSendSurveyUnauthorizedResponseBody runs the validations
-// defined on preview_send_survey_Unauthorized_response_body
-func ValidatePreviewSendSurveyUnauthorizedResponseBody(body *PreviewSendSurveyUnauthorizedResponseBody) (err error) {
+// ValidateGetDeadLetterNotFoundResponseBody runs the validations defined on
+// get_dead_letter_NotFound_response_body
+func ValidateGetDeadLetterNotFoundResponseBody(body *GetDeadLetterNotFoundResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3372,9 +10844,9 @@ func ValidatePreviewSendSurveyUnauthorizedResponseBody(body *PreviewSendSurveyUn
 	return
 }
 
-// ValidateSendMissingRecipientsBadRequestResponseBody runs the validations
-// defined on send_missing_recipients_BadRequest_response_body
-func ValidateSendMissingRecipientsBadRequestResponseBody(body *SendMissingRecipientsBadRequestResponseBody) (err error) {
+// ValidateGetDeadLetterServiceUnavailableResponseBody runs the validations
+// defined on get_dead_letter_ServiceUnavailable_response_body
+func ValidateGetDeadLetterServiceUnavailableResponseBody(body *GetDeadLetterServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3384,9 +10856,9 @@ func ValidateSendMissingRecipientsBadRequestResponseBody(body *SendMissingRecipi
 	return
 }
 
-// ValidateSendMissingRecipientsForbiddenResponseBody runs the validations
-// defined on send_missing_recipients_Forbidden_response_body
-func ValidateSendMissingRecipientsForbiddenResponseBody(body *SendMissingRecipientsForbiddenResponseBody) (err error) {
+// ValidateGetDeadLetterUnauthorizedResponseBody runs the validations defined
+// on get_dead_letter_Unauthorized_response_body
+func ValidateGetDeadLetterUnauthorizedResponseBody(body *GetDeadLetterUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3396,22 +10868,28 @@ func ValidateSendMissingRecipientsForbiddenResponseBody(body *SendMissingRecipie
 	return
 }
 
-// ValidateSendMissingRecipientsInternalServerErrorResponseBody runs the
-// validations defined on
-// send_missing_recipients_InternalServerError_response_body
-func ValidateSendMissingRecipientsInternalServerErrorResponseBody(body *SendMissingRecipientsInternalServerErrorResponseBody) (err error) {
+// ValidateReplayDeadLetterBadRequestResponseBody runs the validations defined
+// on replay_dead_letter_BadRequest_response_body
+func ValidateReplayDeadLetterBadRequestResponseBody(body *ReplayDeadLetterBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
-// ValidateSendMissingRecipientsNotFoundResponseBody runs the validations
-// defined on send_missing_recipients_NotFound_response_body
-func ValidateSendMissingRecipientsNotFoundResponseBody(body *SendMissingRecipientsNotFoundResponseBody) (err error) {
+// ValidateReplayDeadLetterForbiddenResponseBody runs the validations defined
+// on replay_dead_letter_Forbidden_response_body
+func ValidateReplayDeadLetterForbiddenResponseBody(body *ReplayDeadLetterForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3421,10 +10899,9 @@ func ValidateSendMissingRecipientsNotFoundResponseBody(body *SendMissingRecipien
 	return
 }
 
-// ValidateSendMissingRecipientsServiceUnavailableResponseBody runs the
-// validations defined on
-// send_missing_recipients_ServiceUnavailable_response_body
-func ValidateSendMissingRecipientsServiceUnavailableResponseBody(body *SendMissingRecipientsServiceUnavailableResponseBody) (err error) {
+// ValidateReplayDeadLetterInternalServerErrorResponseBody runs the validations
+// defined on replay_dead_letter_InternalServerError_response_body
+func ValidateReplayDeadLetterInternalServerErrorResponseBody(body *ReplayDeadLetterInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3434,9 +10911,9 @@ func ValidateSendMissingRecipientsServiceUnavailableResponseBody(body *SendMissi
 	return
 }
 
-// ValidateSendMissingRecipientsUnauthorizedResponseBody runs the validations
-// defined on send_missing_recipients_Unauthorized_response_body
-func ValidateSendMissingRecipientsUnauthorizedResponseBody(body *SendMissingRecipientsUnauthorizedResponseBody) (err error) {
+// ValidateReplayDeadLetterNotFoundResponseBody runs the validations defined on
+// replay_dead_letter_NotFound_response_body
+func ValidateReplayDeadLetterNotFoundResponseBody(body *ReplayDeadLetterNotFoundResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3446,9 +10923,9 @@ func ValidateSendMissingRecipientsUnauthorizedResponseBody(body *SendMissingReci
 	return
 }
 
-// ValidateDeleteSurveyResponseBadRequestResponseBody runs the validations
-// defined on delete_survey_response_BadRequest_response_body
-func ValidateDeleteSurveyResponseBadRequestResponseBody(body *DeleteSurveyResponseBadRequestResponseBody) (err error) {
+// ValidateReplayDeadLetterServiceUnavailableResponseBody runs the validations
+// defined on replay_dead_letter_ServiceUnavailable_response_body
+func ValidateReplayDeadLetterServiceUnavailableResponseBody(body *ReplayDeadLetterServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3458,9 +10935,9 @@ func ValidateDeleteSurveyResponseBadRequestResponseBody(body *DeleteSurveyRespon
 	return
 }
 
-// ValidateDeleteSurveyResponseForbiddenResponseBody runs the validations
-// defined on delete_survey_response_Forbidden_response_body
-func ValidateDeleteSurveyResponseForbiddenResponseBody(body *DeleteSurveyResponseForbiddenResponseBody) (err error) {
+// ValidateReplayDeadLetterUnauthorizedResponseBody runs the validations
+// defined on replay_dead_letter_Unauthorized_response_body
+func ValidateReplayDeadLetterUnauthorizedResponseBody(body *ReplayDeadLetterUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3470,22 +10947,28 @@ func ValidateDeleteSurveyResponseForbiddenResponseBody(body *DeleteSurveyRespons
 	return
 }
 
-// ValidateDeleteSurveyResponseInternalServerErrorResponseBody runs the
-// validations defined on
-// delete_survey_response_InternalServerError_response_body
-func ValidateDeleteSurveyResponseInternalServerErrorResponseBody(body *DeleteSurveyResponseInternalServerErrorResponseBody) (err error) {
+// ValidateStartReindexBadRequestResponseBody runs the validations defined on
+// start_reindex_BadRequest_response_body
+func ValidateStartReindexBadRequestResponseBody(body *StartReindexBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
-// ValidateDeleteSurveyResponseNotFoundResponseBody runs the validations
-// defined on delete_survey_response_NotFound_response_body
-func ValidateDeleteSurveyResponseNotFoundResponseBody(body *DeleteSurveyResponseNotFoundResponseBody) (err error) {
+// ValidateStartReindexConflictResponseBody runs the validations defined on
+// start_reindex_Conflict_response_body
+func ValidateStartReindexConflictResponseBody(body *StartReindexConflictResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3494,11 +10977,10 @@ func ValidateDeleteSurveyResponseNotFoundResponseBody(body *DeleteSurveyResponse
 	}
 	return
 }
-
-// ValidateDeleteSurveyResponseServiceUnavailableResponseBody runs the
-// validations defined on
-// delete_survey_response_ServiceUnavailable_response_body
-func ValidateDeleteSurveyResponseServiceUnavailableResponseBody(body *DeleteSurveyResponseServiceUnavailableResponseBody) (err error) {
+
+// ValidateStartReindexForbiddenResponseBody runs the validations defined on
+// start_reindex_Forbidden_response_body
+func ValidateStartReindexForbiddenResponseBody(body *StartReindexForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3508,9 +10990,9 @@ func ValidateDeleteSurveyResponseServiceUnavailableResponseBody(body *DeleteSurv
 	return
 }
 
-// ValidateDeleteSurveyResponseUnauthorizedResponseBody runs the validations
-// defined on delete_survey_response_Unauthorized_response_body
-func ValidateDeleteSurveyResponseUnauthorizedResponseBody(body *DeleteSurveyResponseUnauthorizedResponseBody) (err error) {
+// ValidateStartReindexInternalServerErrorResponseBody runs the validations
+// defined on start_reindex_InternalServerError_response_body
+func ValidateStartReindexInternalServerErrorResponseBody(body *StartReindexInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3520,9 +11002,9 @@ func ValidateDeleteSurveyResponseUnauthorizedResponseBody(body *DeleteSurveyResp
 	return
 }
 
-// ValidateResendSurveyResponseBadRequestResponseBody runs the validations
-// defined on resend_survey_response_BadRequest_response_body
-func ValidateResendSurveyResponseBadRequestResponseBody(body *ResendSurveyResponseBadRequestResponseBody) (err error) {
+// ValidateStartReindexServiceUnavailableResponseBody runs the validations
+// defined on start_reindex_ServiceUnavailable_response_body
+func ValidateStartReindexServiceUnavailableResponseBody(body *StartReindexServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3532,9 +11014,9 @@ func ValidateResendSurveyResponseBadRequestResponseBody(body *ResendSurveyRespon
 	return
 }
 
-// ValidateResendSurveyResponseForbiddenResponseBody runs the validations
-// defined on resend_survey_response_Forbidden_response_body
-func ValidateResendSurveyResponseForbiddenResponseBody(body *ResendSurveyResponseForbiddenResponseBody) (err error) {
+// ValidateStartReindexUnauthorizedResponseBody runs the validations defined on
+// start_reindex_Unauthorized_response_body
+func ValidateStartReindexUnauthorizedResponseBody(body *StartReindexUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3544,22 +11026,28 @@ func ValidateResendSurveyResponseForbiddenResponseBody(body *ResendSurveyRespons
 	return
 }
 
-// ValidateResendSurveyResponseInternalServerErrorResponseBody runs the
-// validations defined on
-// resend_survey_response_InternalServerError_response_body
-func ValidateResendSurveyResponseInternalServerErrorResponseBody(body *ResendSurveyResponseInternalServerErrorResponseBody) (err error) {
+// ValidateGetReindexBadRequestResponseBody runs the validations defined on
+// get_reindex_BadRequest_response_body
+func ValidateGetReindexBadRequestResponseBody(body *GetReindexBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
-// ValidateResendSurveyResponseNotFoundResponseBody runs the validations
-// defined on resend_survey_response_NotFound_response_body
-func ValidateResendSurveyResponseNotFoundResponseBody(body *ResendSurveyResponseNotFoundResponseBody) (err error) {
+// ValidateGetReindexForbiddenResponseBody runs the validations defined on
+// get_reindex_Forbidden_response_body
+func ValidateGetReindexForbiddenResponseBody(body *GetReindexForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3569,10 +11057,9 @@ func ValidateResendSurveyResponseNotFoundResponseBody(body *ResendSurveyResponse
 	return
 }
 
-// ValidateResendSurveyResponseServiceUnavailableResponseBody runs the
-// validations defined on
-// resend_survey_response_ServiceUnavailable_response_body
-func ValidateResendSurveyResponseServiceUnavailableResponseBody(body *ResendSurveyResponseServiceUnavailableResponseBody) (err error) {
+// ValidateGetReindexInternalServerErrorResponseBody runs the validations
+// defined on get_reindex_InternalServerError_response_body
+func ValidateGetReindexInternalServerErrorResponseBody(body *GetReindexInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3582,9 +11069,9 @@ func ValidateResendSurveyResponseServiceUnavailableResponseBody(body *ResendSurv
 	return
 }
 
-// ValidateResendSurveyResponseUnauthorizedResponseBody runs the validations
-// defined on resend_survey_response_Unauthorized_response_body
-func ValidateResendSurveyResponseUnauthorizedResponseBody(body *ResendSurveyResponseUnauthorizedResponseBody) (err error) {
+// ValidateGetReindexNotFoundResponseBody runs the validations defined on
+// get_reindex_NotFound_response_body
+func ValidateGetReindexNotFoundResponseBody(body *GetReindexNotFoundResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3594,9 +11081,9 @@ func ValidateResendSurveyResponseUnauthorizedResponseBody(body *ResendSurveyResp
 	return
 }
 
-// ValidateDeleteRecipientGroupBadRequestResponseBody runs the validations
-// defined on delete_recipient_group_BadRequest_response_body
-func ValidateDeleteRecipientGroupBadRequestResponseBody(body *DeleteRecipientGroupBadRequestResponseBody) (err error) {
+// ValidateGetReindexServiceUnavailableResponseBody runs the validations
+// defined on get_reindex_ServiceUnavailable_response_body
+func ValidateGetReindexServiceUnavailableResponseBody(body *GetReindexServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3606,9 +11093,9 @@ func ValidateDeleteRecipientGroupBadRequestResponseBody(body *DeleteRecipientGro
 	return
 }
 
-// ValidateDeleteRecipientGroupForbiddenResponseBody runs the validations
-// defined on delete_recipient_group_Forbidden_response_body
-func ValidateDeleteRecipientGroupForbiddenResponseBody(body *DeleteRecipientGroupForbiddenResponseBody) (err error) {
+// ValidateGetReindexUnauthorizedResponseBody runs the validations defined on
+// get_reindex_Unauthorized_response_body
+func ValidateGetReindexUnauthorizedResponseBody(body *GetReindexUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3618,22 +11105,28 @@ func ValidateDeleteRecipientGroupForbiddenResponseBody(body *DeleteRecipientGrou
 	return
 }
 
-// ValidateDeleteRecipientGroupInternalServerErrorResponseBody runs the
-// validations defined on
-// delete_recipient_group_InternalServerError_response_body
-func ValidateDeleteRecipientGroupInternalServerErrorResponseBody(body *DeleteRecipientGroupInternalServerErrorResponseBody) (err error) {
+// ValidateCancelReindexBadRequestResponseBody runs the validations defined on
+// cancel_reindex_BadRequest_response_body
+func ValidateCancelReindexBadRequestResponseBody(body *CancelReindexBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
-// ValidateDeleteRecipientGroupNotFoundResponseBody runs the validations
-// defined on delete_recipient_group_NotFound_response_body
-func ValidateDeleteRecipientGroupNotFoundResponseBody(body *DeleteRecipientGroupNotFoundResponseBody) (err error) {
+// ValidateCancelReindexConflictResponseBody runs the validations defined on
+// cancel_reindex_Conflict_response_body
+func ValidateCancelReindexConflictResponseBody(body *CancelReindexConflictResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3643,10 +11136,9 @@ func ValidateDeleteRecipientGroupNotFoundResponseBody(body *DeleteRecipientGroup
 	return
 }
 
-// ValidateDeleteRecipientGroupServiceUnavailableResponseBody runs the
-// validations defined on
-// delete_recipient_group_ServiceUnavailable_response_body
-func ValidateDeleteRecipientGroupServiceUnavailableResponseBody(body *DeleteRecipientGroupServiceUnavailableResponseBody) (err error) {
+// ValidateCancelReindexForbiddenResponseBody runs the validations defined on
+// cancel_reindex_Forbidden_response_body
+func ValidateCancelReindexForbiddenResponseBody(body *CancelReindexForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3656,9 +11148,9 @@ func ValidateDeleteRecipientGroupServiceUnavailableResponseBody(body *DeleteReci
 	return
 }
 
-// ValidateDeleteRecipientGroupUnauthorizedResponseBody runs the validations
-// defined on delete_recipient_group_Unauthorized_response_body
-func ValidateDeleteRecipientGroupUnauthorizedResponseBody(body *DeleteRecipientGroupUnauthorizedResponseBody) (err error) {
+// ValidateCancelReindexInternalServerErrorResponseBody runs the validations
+// defined on cancel_reindex_InternalServerError_response_body
+func ValidateCancelReindexInternalServerErrorResponseBody(body *CancelReindexInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3668,9 +11160,9 @@ func ValidateDeleteRecipientGroupUnauthorizedResponseBody(body *DeleteRecipientG
 	return
 }
 
-// ValidateCreateExclusionBadRequestResponseBody runs the validations defined
-// on create_exclusion_BadRequest_response_body
-func ValidateCreateExclusionBadRequestResponseBody(body *CreateExclusionBadRequestResponseBody) (err error) {
+// ValidateCancelReindexServiceUnavailableResponseBody runs the validations
+// defined on cancel_reindex_ServiceUnavailable_response_body
+func ValidateCancelReindexServiceUnavailableResponseBody(body *CancelReindexServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3680,9 +11172,9 @@ func ValidateCreateExclusionBadRequestResponseBody(body *CreateExclusionBadReque
 	return
 }
 
-// ValidateCreateExclusionForbiddenResponseBody runs the validations defined on
-// create_exclusion_Forbidden_response_body
-func ValidateCreateExclusionForbiddenResponseBody(body *CreateExclusionForbiddenResponseBody) (err error) {
+// ValidateCancelReindexUnauthorizedResponseBody runs the validations defined
+// on cancel_reindex_Unauthorized_response_body
+func ValidateCancelReindexUnauthorizedResponseBody(body *CancelReindexUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3692,21 +11184,28 @@ func ValidateCreateExclusionForbiddenResponseBody(body *CreateExclusionForbidden
 	return
 }
 
-// ValidateCreateExclusionInternalServerErrorResponseBody runs the validations
-// defined on create_exclusion_InternalServerError_response_body
-func ValidateCreateExclusionInternalServerErrorResponseBody(body *CreateExclusionInternalServerErrorResponseBody) (err error) {
+// ValidateRerunInviteEnrichmentBadRequestResponseBody runs the validations
+// defined on rerun_invite_enrichment_BadRequest_response_body
+func ValidateRerunInviteEnrichmentBadRequestResponseBody(body *RerunInviteEnrichmentBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
-// ValidateCreateExclusionUnauthorizedResponseBody runs the validations defined
-// on create_exclusion_Unauthorized_response_body
-func ValidateCreateExclusionUnauthorizedResponseBody(body *CreateExclusionUnauthorizedResponseBody) (err error) {
+// ValidateRerunInviteEnrichmentForbiddenResponseBody runs the validations
+// defined on rerun_invite_enrichment_Forbidden_response_body
+func ValidateRerunInviteEnrichmentForbiddenResponseBody(body *RerunInviteEnrichmentForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3716,9 +11215,10 @@ func ValidateCreateExclusionUnauthorizedResponseBody(body *CreateExclusionUnauth
 	return
 }
 
-// ValidateDeleteExclusionBadRequestResponseBody runs the validations defined
-// on delete_exclusion_BadRequest_response_body
-func ValidateDeleteExclusionBadRequestResponseBody(body *DeleteExclusionBadRequestResponseBody) (err error) {
+// ValidateRerunInviteEnrichmentInternalServerErrorResponseBody runs the
+// validations defined on
+// rerun_invite_enrichment_InternalServerError_response_body
+func ValidateRerunInviteEnrichmentInternalServerErrorResponseBody(body *RerunInviteEnrichmentInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3728,9 +11228,9 @@ func ValidateDeleteExclusionBadRequestResponseBody(body *DeleteExclusionBadReque
 	return
 }
 
-// ValidateDeleteExclusionForbiddenResponseBody runs the validations defined on
-// delete_exclusion_Forbidden_response_body
-func ValidateDeleteExclusionForbiddenResponseBody(body *DeleteExclusionForbiddenResponseBody) (err error) {
+// ValidateRerunInviteEnrichmentNotFoundResponseBody runs the validations
+// defined on rerun_invite_enrichment_NotFound_response_body
+func ValidateRerunInviteEnrichmentNotFoundResponseBody(body *RerunInviteEnrichmentNotFoundResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3740,9 +11240,10 @@ func ValidateDeleteExclusionForbiddenResponseBody(body *DeleteExclusionForbidden
 	return
 }
 
-// ValidateDeleteExclusionInternalServerErrorResponseBody runs the validations
-// defined on delete_exclusion_InternalServerError_response_body
-func ValidateDeleteExclusionInternalServerErrorResponseBody(body *DeleteExclusionInternalServerErrorResponseBody) (err error) {
+// ValidateRerunInviteEnrichmentServiceUnavailableResponseBody runs the
+// validations defined on
+// rerun_invite_enrichment_ServiceUnavailable_response_body
+func ValidateRerunInviteEnrichmentServiceUnavailableResponseBody(body *RerunInviteEnrichmentServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3752,9 +11253,9 @@ func ValidateDeleteExclusionInternalServerErrorResponseBody(body *DeleteExclusio
 	return
 }
 
-// ValidateDeleteExclusionUnauthorizedResponseBody runs the validations defined
-// on delete_exclusion_Unauthorized_response_body
-func ValidateDeleteExclusionUnauthorizedResponseBody(body *DeleteExclusionUnauthorizedResponseBody) (err error) {
+// ValidateRerunInviteEnrichmentUnauthorizedResponseBody runs the validations
+// defined on rerun_invite_enrichment_Unauthorized_response_body
+func ValidateRerunInviteEnrichmentUnauthorizedResponseBody(body *RerunInviteEnrichmentUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3764,21 +11265,28 @@ func ValidateDeleteExclusionUnauthorizedResponseBody(body *DeleteExclusionUnauth
 	return
 }
 
-// ValidateGetExclusionBadRequestResponseBody runs the validations defined on
-// get_exclusion_BadRequest_response_body
-func ValidateGetExclusionBadRequestResponseBody(body *GetExclusionBadRequestResponseBody) (err error) {
+// ValidateStartInviteBackfillBadRequestResponseBody runs the validations
+// defined on start_invite_backfill_BadRequest_response_body
+func ValidateStartInviteBackfillBadRequestResponseBody(body *StartInviteBackfillBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
-// ValidateGetExclusionForbiddenResponseBody runs the validations defined on
-// get_exclusion_Forbidden_response_body
-func ValidateGetExclusionForbiddenResponseBody(body *GetExclusionForbiddenResponseBody) (err error) {
+// ValidateStartInviteBackfillConflictResponseBody runs the validations defined
+// on start_invite_backfill_Conflict_response_body
+func ValidateStartInviteBackfillConflictResponseBody(body *StartInviteBackfillConflictResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3788,9 +11296,9 @@ func ValidateGetExclusionForbiddenResponseBody(body *GetExclusionForbiddenRespon
 	return
 }
 
-// ValidateGetExclusionInternalServerErrorResponseBody runs the validations
-// defined on get_exclusion_InternalServerError_response_body
-func ValidateGetExclusionInternalServerErrorResponseBody(body *GetExclusionInternalServerErrorResponseBody) (err error) {
+// ValidateStartInviteBackfillForbiddenResponseBody runs the validations
+// defined on start_invite_backfill_Forbidden_response_body
+func ValidateStartInviteBackfillForbiddenResponseBody(body *StartInviteBackfillForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3800,9 +11308,10 @@ func ValidateGetExclusionInternalServerErrorResponseBody(body *GetExclusionInter
 	return
 }
 
-// ValidateGetExclusionNotFoundResponseBody runs the validations defined on
-// get_exclusion_NotFound_response_body
-func ValidateGetExclusionNotFoundResponseBody(body *GetExclusionNotFoundResponseBody) (err error) {
+// ValidateStartInviteBackfillInternalServerErrorResponseBody runs the
+// validations defined on
+// start_invite_backfill_InternalServerError_response_body
+func ValidateStartInviteBackfillInternalServerErrorResponseBody(body *StartInviteBackfillInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3812,9 +11321,9 @@ func ValidateGetExclusionNotFoundResponseBody(body *GetExclusionNotFoundResponse
 	return
 }
 
-// ValidateGetExclusionUnauthorizedResponseBody runs the validations defined on
-// get_exclusion_Unauthorized_response_body
-func ValidateGetExclusionUnauthorizedResponseBody(body *GetExclusionUnauthorizedResponseBody) (err error) {
+// ValidateStartInviteBackfillServiceUnavailableResponseBody runs the
+// validations defined on start_invite_backfill_ServiceUnavailable_response_body
+func ValidateStartInviteBackfillServiceUnavailableResponseBody(body *StartInviteBackfillServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3824,9 +11333,9 @@ func ValidateGetExclusionUnauthorizedResponseBody(body *GetExclusionUnauthorized
 	return
 }
 
-// ValidateDeleteExclusionByIDBadRequestResponseBody runs the validations
-// defined on delete_exclusion_by_id_BadRequest_response_body
-func ValidateDeleteExclusionByIDBadRequestResponseBody(body *DeleteExclusionByIDBadRequestResponseBody) (err error) {
+// ValidateStartInviteBackfillUnauthorizedResponseBody runs the validations
+// defined on start_invite_backfill_Unauthorized_response_body
+func ValidateStartInviteBackfillUnauthorizedResponseBody(body *StartInviteBackfillUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3836,22 +11345,28 @@ func ValidateDeleteExclusionByIDBadRequestResponseBody(body *DeleteExclusionByID
 	return
 }
 
-// ValidateDeleteExclusionByIDForbiddenResponseBody runs the validations
-// defined on delete_exclusion_by_id_Forbidden_response_body
-func ValidateDeleteExclusionByIDForbiddenResponseBody(body *DeleteExclusionByIDForbiddenResponseBody) (err error) {
+// ValidateGetInviteBackfillBadRequestResponseBody runs the validations defined
+// on get_invite_backfill_BadRequest_response_body
+func ValidateGetInviteBackfillBadRequestResponseBody(body *GetInviteBackfillBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
-// ValidateDeleteExclusionByIDInternalServerErrorResponseBody runs the
-// validations defined on
-// delete_exclusion_by_id_InternalServerError_response_body
-func ValidateDeleteExclusionByIDInternalServerErrorResponseBody(body *DeleteExclusionByIDInternalServerErrorResponseBody) (err error) {
+// ValidateGetInviteBackfillForbiddenResponseBody runs the validations defined
+// on get_invite_backfill_Forbidden_response_body
+func ValidateGetInviteBackfillForbiddenResponseBody(body *GetInviteBackfillForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3861,9 +11376,9 @@ func ValidateDeleteExclusionByIDInternalServerErrorResponseBody(body *DeleteExcl
 	return
 }
 
-// ValidateDeleteExclusionByIDUnauthorizedResponseBody runs the validations
-// defined on delete_exclusion_by_id_Unauthorized_response_body
-func ValidateDeleteExclusionByIDUnauthorizedResponseBody(body *DeleteExclusionByIDUnauthorizedResponseBody) (err error) {
+// ValidateGetInviteBackfillInternalServerErrorResponseBody runs the
+// validations defined on get_invite_backfill_InternalServerError_response_body
+func ValidateGetInviteBackfillInternalServerErrorResponseBody(body *GetInviteBackfillInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3873,9 +11388,9 @@ func ValidateDeleteExclusionByIDUnauthorizedResponseBody(body *DeleteExclusionBy
 	return
 }
 
-// ValidateListSurveyResponsesBadRequestResponseBody runs the validations
-// defined on list_survey_responses_BadRequest_response_body
-func ValidateListSurveyResponsesBadRequestResponseBody(body *ListSurveyResponsesBadRequestResponseBody) (err error) {
+// ValidateGetInviteBackfillNotFoundResponseBody runs the validations defined
+// on get_invite_backfill_NotFound_response_body
+func ValidateGetInviteBackfillNotFoundResponseBody(body *GetInviteBackfillNotFoundResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3885,9 +11400,9 @@ func ValidateListSurveyResponsesBadRequestResponseBody(body *ListSurveyResponses
 	return
 }
 
-// ValidateListSurveyResponsesForbiddenResponseBody runs the validations
-// defined on list_survey_responses_Forbidden_response_body
-func ValidateListSurveyResponsesForbiddenResponseBody(body *ListSurveyResponsesForbiddenResponseBody) (err error) {
+// ValidateGetInviteBackfillServiceUnavailableResponseBody runs the validations
+// defined on get_invite_backfill_ServiceUnavailable_response_body
+func ValidateGetInviteBackfillServiceUnavailableResponseBody(body *GetInviteBackfillServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3897,10 +11412,9 @@ func ValidateListSurveyResponsesForbiddenResponseBody(body *ListSurveyResponsesF
 	return
 }
 
-// ValidateListSurveyResponsesInternalServerErrorResponseBody runs the
-// validations defined on
-// list_survey_responses_InternalServerError_response_body
-func ValidateListSurveyResponsesInternalServerErrorResponseBody(body *ListSurveyResponsesInternalServerErrorResponseBody) (err error) {
+// ValidateGetInviteBackfillUnauthorizedResponseBody runs the validations
+// defined on get_invite_backfill_Unauthorized_response_body
+func ValidateGetInviteBackfillUnauthorizedResponseBody(body *GetInviteBackfillUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3910,21 +11424,28 @@ func ValidateListSurveyResponsesInternalServerErrorResponseBody(body *ListSurvey
 	return
 }
 
-// ValidateListSurveyResponsesNotFoundResponseBody runs the validations defined
-// on list_survey_responses_NotFound_response_body
-func ValidateListSurveyResponsesNotFoundResponseBody(body *ListSurveyResponsesNotFoundResponseBody) (err error) {
+// ValidateCancelInviteBackfillBadRequestResponseBody runs the validations
+// defined on cancel_invite_backfill_BadRequest_response_body
+func ValidateCancelInviteBackfillBadRequestResponseBody(body *CancelInviteBackfillBadRequestResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	for _, e := range body.QuestionErrors {
+		if e != nil {
+			if err2 := ValidateQuestionErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
-// ValidateListSurveyResponsesServiceUnavailableResponseBody runs the
-// validations defined on list_survey_responses_ServiceUnavailable_response_body
-func ValidateListSurveyResponsesServiceUnavailableResponseBody(body *ListSurveyResponsesServiceUnavailableResponseBody) (err error) {
+// ValidateCancelInviteBackfillConflictResponseBody runs the validations
+// defined on cancel_invite_backfill_Conflict_response_body
+func ValidateCancelInviteBackfillConflictResponseBody(body *CancelInviteBackfillConflictResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3934,9 +11455,9 @@ func ValidateListSurveyResponsesServiceUnavailableResponseBody(body *ListSurveyR
 	return
 }
 
-// ValidateListSurveyResponsesUnauthorizedResponseBody runs the validations
-// defined on list_survey_responses_Unauthorized_response_body
-func ValidateListSurveyResponsesUnauthorizedResponseBody(body *ListSurveyResponsesUnauthorizedResponseBody) (err error) {
+// ValidateCancelInviteBackfillForbiddenResponseBody runs the validations
+// defined on cancel_invite_backfill_Forbidden_response_body
+func ValidateCancelInviteBackfillForbiddenResponseBody(body *CancelInviteBackfillForbiddenResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3946,9 +11467,10 @@ func ValidateListSurveyResponsesUnauthorizedResponseBody(body *ListSurveyRespons
 	return
 }
 
-// ValidateValidateEmailBadRequestResponseBody runs the validations defined on
-// validate_email_BadRequest_response_body
-func ValidateValidateEmailBadRequestResponseBody(body *ValidateEmailBadRequestResponseBody) (err error) {
+// ValidateCancelInviteBackfillInternalServerErrorResponseBody runs the
+// validations defined on
+// cancel_invite_backfill_InternalServerError_response_body
+func ValidateCancelInviteBackfillInternalServerErrorResponseBody(body *CancelInviteBackfillInternalServerErrorResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3958,9 +11480,10 @@ func ValidateValidateEmailBadRequestResponseBody(body *ValidateEmailBadRequestRe
 	return
 }
 
-// ValidateValidateEmailForbiddenResponseBody runs the validations defined on
-// validate_email_Forbidden_response_body
-func ValidateValidateEmailForbiddenResponseBody(body *ValidateEmailForbiddenResponseBody) (err error) {
+// ValidateCancelInviteBackfillServiceUnavailableResponseBody runs the
+// validations defined on
+// cancel_invite_backfill_ServiceUnavailable_response_body
+func ValidateCancelInviteBackfillServiceUnavailableResponseBody(body *CancelInviteBackfillServiceUnavailableResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3970,9 +11493,9 @@ func ValidateValidateEmailForbiddenResponseBody(body *ValidateEmailForbiddenResp
 	return
 }
 
-// ValidateValidateEmailInternalServerErrorResponseBody runs the validations
-// defined on validate_email_InternalServerError_response_body
-func ValidateValidateEmailInternalServerErrorResponseBody(body *ValidateEmailInternalServerErrorResponseBody) (err error) {
+// ValidateCancelInviteBackfillUnauthorizedResponseBody runs the validations
+// defined on cancel_invite_backfill_Unauthorized_response_body
+func ValidateCancelInviteBackfillUnauthorizedResponseBody(body *CancelInviteBackfillUnauthorizedResponseBody) (err error) {
 	if body.Code == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
@@ -3982,11 +11505,11 @@ func ValidateValidateEmailInternalServerErrorResponseBody(body *ValidateEmailInt
 	return
 }
 
-// ValidateValidateEmailUnauthorizedResponseBody runs the validations defined
-// on validate_email_Unauthorized_response_body
-func ValidateValidateEmailUnauthorizedResponseBody(body *ValidateEmailUnauthorizedResponseBody) (err error) {
-	if body.Code == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+// ValidateQuestionErrorResponseBody runs the validations defined on
+// QuestionErrorResponseBody
+func ValidateQuestionErrorResponseBody(body *QuestionErrorResponseBody) (err error) {
+	if body.QuestionID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("question_id", "body"))
 	}
 	if body.Message == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
@@ -4116,3 +11639,197 @@ func ValidateSurveyQuestionAnswerResponseBody(body *SurveyQuestionAnswerResponse
 	}
 	return
 }
+
+// ValidateSurveyInviteCountsResponseBody runs the validations defined on
+// SurveyInviteCountsResponseBody
+func ValidateSurveyInviteCountsResponseBody(body *SurveyInviteCountsResponseBody) (err error) {
+	if body.Total == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total", "body"))
+	}
+	if body.Pending == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("pending", "body"))
+	}
+	if body.Sent == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sent", "body"))
+	}
+	if body.Failed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("failed", "body"))
+	}
+	if body.Accepted == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("accepted", "body"))
+	}
+	if body.Expired == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expired", "body"))
+	}
+	return
+}
+
+// ValidateSurveyInviteResponseBody runs the validations defined on
+// SurveyInviteResponseBody
+func ValidateSurveyInviteResponseBody(body *SurveyInviteResponseBody) (err error) {
+	if body.SurveyResponseUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("survey_response_uid", "body"))
+	}
+	if body.Email == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("email", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Reinvites == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reinvites", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "sent" || *body.Status == "failed" || *body.Status == "accepted" || *body.Status == "expired") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "sent", "failed", "accepted", "expired"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.SentAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.sent_at", *body.SentAt, goa.FormatDateTime))
+	}
+	if body.FailedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.failed_at", *body.FailedAt, goa.FormatDateTime))
+	}
+	if body.ExpiresAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.expires_at", *body.ExpiresAt, goa.FormatDateTime))
+	}
+	if body.AcceptedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.accepted_at", *body.AcceptedAt, goa.FormatDateTime))
+	}
+	for _, e := range body.Attempts {
+		if e != nil {
+			if err2 := ValidateInviteAttemptResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateInviteAttemptResponseBody runs the validations defined on
+// InviteAttemptResponseBody
+func ValidateInviteAttemptResponseBody(body *InviteAttemptResponseBody) (err error) {
+	if body.At == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("at", "body"))
+	}
+	if body.At != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.at", *body.At, goa.FormatDateTime))
+	}
+	if body.ExpiresAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.expires_at", *body.ExpiresAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateResultsAccessSyncResponseBody runs the validations defined on
+// ResultsAccessSyncResponseBody
+func ValidateResultsAccessSyncResponseBody(body *ResultsAccessSyncResponseBody) (err error) {
+	if body.Granted == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("granted", "body"))
+	}
+	if body.Revoked == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("revoked", "body"))
+	}
+	if body.Failed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("failed", "body"))
+	}
+	return
+}
+
+// ValidateSurveyQuestionResultResponseBody runs the validations defined on
+// SurveyQuestionResultResponseBody
+func ValidateSurveyQuestionResultResponseBody(body *SurveyQuestionResultResponseBody) (err error) {
+	if body.QuestionID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("question_id", "body"))
+	}
+	if body.Answers == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("answers", "body"))
+	}
+	for _, e := range body.Answers {
+		if e != nil {
+			if err2 := ValidateSurveyAnswerResultResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSurveyAnswerResultResponseBody runs the validations defined on
+// SurveyAnswerResultResponseBody
+func ValidateSurveyAnswerResultResponseBody(body *SurveyAnswerResultResponseBody) (err error) {
+	if body.Answer == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("answer", "body"))
+	}
+	if body.Count == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("count", "body"))
+	}
+	if body.Percentage == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("percentage", "body"))
+	}
+	return
+}
+
+// ValidateSurveyTemplateResponseBody runs the validations defined on
+// SurveyTemplateResponseBody
+func ValidateSurveyTemplateResponseBody(body *SurveyTemplateResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Title == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("title", "body"))
+	}
+	return
+}
+
+// ValidateDeadLetterEntryResponseBody runs the validations defined on
+// DeadLetterEntryResponseBody
+func ValidateDeadLetterEntryResponseBody(body *DeadLetterEntryResponseBody) (err error) {
+	if body.Sequence == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sequence", "body"))
+	}
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.Operation == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("operation", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.Error == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("error", "body"))
+	}
+	if body.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "body"))
+	}
+	if body.DeadLetteredAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("dead_lettered_at", "body"))
+	}
+	if body.Operation != nil {
+		if !(*body.Operation == "PUT" || *body.Operation == "DEL" || *body.Operation == "PURGE") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.operation", *body.Operation, []any{"PUT", "DEL", "PURGE"}))
+		}
+	}
+	if body.Reason != nil {
+		if !(*body.Reason == "max_deliveries" || *body.Reason == "conversion_error") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.reason", *body.Reason, []any{"max_deliveries", "conversion_error"}))
+		}
+	}
+	if body.DeadLetteredAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.dead_lettered_at", *body.DeadLetteredAt, goa.FormatDateTime))
+	}
+	return
+}
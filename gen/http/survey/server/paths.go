@@ -87,7 +87,127 @@ func ListSurveyResponsesSurveyPath(surveyUID string) string {
 	return fmt.Sprintf("/surveys/%v/responses", surveyUID)
 }
 
+// CreateSurveyResponseSurveyPath returns the URL path to the survey service create_survey_response HTTP endpoint.
+func CreateSurveyResponseSurveyPath(surveyUID string) string {
+	return fmt.Sprintf("/surveys/%v/responses", surveyUID)
+}
+
+// UpdateSurveyResponseSurveyPath returns the URL path to the survey service update_survey_response HTTP endpoint.
+func UpdateSurveyResponseSurveyPath(surveyUID string, responseID string) string {
+	return fmt.Sprintf("/surveys/%v/responses/%v", surveyUID, responseID)
+}
+
+// ListSurveyInvitesSurveyPath returns the URL path to the survey service list_survey_invites HTTP endpoint.
+func ListSurveyInvitesSurveyPath(surveyUID string) string {
+	return fmt.Sprintf("/surveys/%v/invites", surveyUID)
+}
+
+// GetSurveyInviteSettingsSurveyPath returns the URL path to the survey service get_survey_invite_settings HTTP endpoint.
+func GetSurveyInviteSettingsSurveyPath(surveyUID string) string {
+	return fmt.Sprintf("/surveys/%v/invite_settings", surveyUID)
+}
+
+// UpdateSurveyInviteSettingsSurveyPath returns the URL path to the survey service update_survey_invite_settings HTTP endpoint.
+func UpdateSurveyInviteSettingsSurveyPath(surveyUID string) string {
+	return fmt.Sprintf("/surveys/%v/invite_settings", surveyUID)
+}
+
+// DeleteSurveyInviteSettingsSurveyPath returns the URL path to the survey service delete_survey_invite_settings HTTP endpoint.
+func DeleteSurveyInviteSettingsSurveyPath(surveyUID string) string {
+	return fmt.Sprintf("/surveys/%v/invite_settings", surveyUID)
+}
+
+// GetProjectInviteSettingsSurveyPath returns the URL path to the survey service get_project_invite_settings HTTP endpoint.
+func GetProjectInviteSettingsSurveyPath(projectUID string) string {
+	return fmt.Sprintf("/surveys/projects/%v/invite_settings", projectUID)
+}
+
+// UpdateProjectInviteSettingsSurveyPath returns the URL path to the survey service update_project_invite_settings HTTP endpoint.
+func UpdateProjectInviteSettingsSurveyPath(projectUID string) string {
+	return fmt.Sprintf("/surveys/projects/%v/invite_settings", projectUID)
+}
+
+// DeleteProjectInviteSettingsSurveyPath returns the URL path to the survey service delete_project_invite_settings HTTP endpoint.
+func DeleteProjectInviteSettingsSurveyPath(projectUID string) string {
+	return fmt.Sprintf("/surveys/projects/%v/invite_settings", projectUID)
+}
+
+// GetSurveySettingsSurveyPath returns the URL path to the survey service get_survey_settings HTTP endpoint.
+func GetSurveySettingsSurveyPath(surveyUID string) string {
+	return fmt.Sprintf("/surveys/%v/settings", surveyUID)
+}
+
+// UpdateSurveySettingsSurveyPath returns the URL path to the survey service update_survey_settings HTTP endpoint.
+func UpdateSurveySettingsSurveyPath(surveyUID string) string {
+	return fmt.Sprintf("/surveys/%v/settings", surveyUID)
+}
+
+// GetSurveyResultsSurveyPath returns the URL path to the survey service get_survey_results HTTP endpoint.
+func GetSurveyResultsSurveyPath(surveyUID string) string {
+	return fmt.Sprintf("/surveys/%v/results", surveyUID)
+}
+
 // ValidateEmailSurveyPath returns the URL path to the survey service validate_email HTTP endpoint.
 func ValidateEmailSurveyPath() string {
 	return "/surveys/validate_email"
 }
+
+// ListSurveyTemplatesSurveyPath returns the URL path to the survey service list_survey_templates HTTP endpoint.
+func ListSurveyTemplatesSurveyPath() string {
+	return "/survey_templates"
+}
+
+// GetSurveyTemplateSurveyPath returns the URL path to the survey service get_survey_template HTTP endpoint.
+func GetSurveyTemplateSurveyPath(templateID string) string {
+	return fmt.Sprintf("/survey_templates/%v", templateID)
+}
+
+// ListDeadLettersSurveyPath returns the URL path to the survey service list_dead_letters HTTP endpoint.
+func ListDeadLettersSurveyPath() string {
+	return "/surveys/admin/dead_letters"
+}
+
+// GetDeadLetterSurveyPath returns the URL path to the survey service get_dead_letter HTTP endpoint.
+func GetDeadLetterSurveyPath(sequence uint64) string {
+	return fmt.Sprintf("/surveys/admin/dead_letters/%v", sequence)
+}
+
+// ReplayDeadLetterSurveyPath returns the URL path to the survey service replay_dead_letter HTTP endpoint.
+func ReplayDeadLetterSurveyPath(sequence uint64) string {
+	return fmt.Sprintf("/surveys/admin/dead_letters/%v/replay", sequence)
+}
+
+// StartReindexSurveyPath returns the URL path to the survey service start_reindex HTTP endpoint.
+func StartReindexSurveyPath() string {
+	return "/surveys/admin/reindex"
+}
+
+// GetReindexSurveyPath returns the URL path to the survey service get_reindex HTTP endpoint.
+func GetReindexSurveyPath() string {
+	return "/surveys/admin/reindex"
+}
+
+// CancelReindexSurveyPath returns the URL path to the survey service cancel_reindex HTTP endpoint.
+func CancelReindexSurveyPath() string {
+	return "/surveys/admin/reindex/cancel"
+}
+
+// RerunInviteEnrichmentSurveyPath returns the URL path to the survey service rerun_invite_enrichment HTTP endpoint.
+func RerunInviteEnrichmentSurveyPath() string {
+	return "/surveys/admin/invite_enrichment"
+}
+
+// StartInviteBackfillSurveyPath returns the URL path to the survey service start_invite_backfill HTTP endpoint.
+func StartInviteBackfillSurveyPath() string {
+	return "/surveys/admin/invite_backfill"
+}
+
+// GetInviteBackfillSurveyPath returns the URL path to the survey service get_invite_backfill HTTP endpoint.
+func GetInviteBackfillSurveyPath() string {
+	return "/surveys/admin/invite_backfill"
+}
+
+// CancelInviteBackfillSurveyPath returns the URL path to the survey service cancel_invite_backfill HTTP endpoint.
+func CancelInviteBackfillSurveyPath() string {
+	return "/surveys/admin/invite_backfill/cancel"
+}
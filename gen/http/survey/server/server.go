@@ -19,23 +19,47 @@ import (
 
 // Server lists the survey service endpoint HTTP handlers.
 type Server struct {
-	Mounts                []*MountPoint
-	ScheduleSurvey        http.Handler
-	GetSurvey             http.Handler
-	UpdateSurvey          http.Handler
-	DeleteSurvey          http.Handler
-	BulkResendSurvey      http.Handler
-	PreviewSendSurvey     http.Handler
-	SendMissingRecipients http.Handler
-	DeleteSurveyResponse  http.Handler
-	ResendSurveyResponse  http.Handler
-	DeleteRecipientGroup  http.Handler
-	CreateExclusion       http.Handler
-	DeleteExclusion       http.Handler
-	GetExclusion          http.Handler
-	DeleteExclusionByID   http.Handler
-	ListSurveyResponses   http.Handler
-	ValidateEmail         http.Handler
+	Mounts                      []*MountPoint
+	ScheduleSurvey              http.Handler
+	GetSurvey                   http.Handler
+	UpdateSurvey                http.Handler
+	DeleteSurvey                http.Handler
+	BulkResendSurvey            http.Handler
+	PreviewSendSurvey           http.Handler
+	SendMissingRecipients       http.Handler
+	DeleteSurveyResponse        http.Handler
+	ResendSurveyResponse        http.Handler
+	DeleteRecipientGroup        http.Handler
+	CreateExclusion             http.Handler
+	DeleteExclusion             http.Handler
+	GetExclusion                http.Handler
+	DeleteExclusionByID         http.Handler
+	ListSurveyResponses         http.Handler
+	CreateSurveyResponse        http.Handler
+	UpdateSurveyResponse        http.Handler
+	ListSurveyInvites           http.Handler
+	GetSurveyInviteSettings     http.Handler
+	UpdateSurveyInviteSettings  http.Handler
+	DeleteSurveyInviteSettings  http.Handler
+	GetProjectInviteSettings    http.Handler
+	UpdateProjectInviteSettings http.Handler
+	DeleteProjectInviteSettings http.Handler
+	GetSurveySettings           http.Handler
+	UpdateSurveySettings        http.Handler
+	GetSurveyResults            http.Handler
+	ValidateEmail               http.Handler
+	ListSurveyTemplates         http.Handler
+	GetSurveyTemplate           http.Handler
+	ListDeadLetters             http.Handler
+	GetDeadLetter               http.Handler
+	ReplayDeadLetter            http.Handler
+	StartReindex                http.Handler
+	GetReindex                  http.Handler
+	CancelReindex               http.Handler
+	RerunInviteEnrichment       http.Handler
+	StartInviteBackfill         http.Handler
+	GetInviteBackfill           http.Handler
+	CancelInviteBackfill        http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -80,24 +104,72 @@ func New(
 			{"GetExclusion", "GET", "/surveys/exclusion/{exclusion_id}"},
 			{"DeleteExclusionByID", "DELETE", "/surveys/exclusion/{exclusion_id}"},
 			{"ListSurveyResponses", "GET", "/surveys/{survey_uid}/responses"},
+			{"CreateSurveyResponse", "POST", "/surveys/{survey_uid}/responses"},
+			{"UpdateSurveyResponse", "PUT", "/surveys/{survey_uid}/responses/{response_id}"},
+			{"ListSurveyInvites", "GET", "/surveys/{survey_uid}/invites"},
+			{"GetSurveyInviteSettings", "GET", "/surveys/{survey_uid}/invite_settings"},
+			{"UpdateSurveyInviteSettings", "PUT", "/surveys/{survey_uid}/invite_settings"},
+			{"DeleteSurveyInviteSettings", "DELETE", "/surveys/{survey_uid}/invite_settings"},
+			{"GetProjectInviteSettings", "GET", "/surveys/projects/{project_uid}/invite_settings"},
+			{"UpdateProjectInviteSettings", "PUT", "/surveys/projects/{project_uid}/invite_settings"},
+			{"DeleteProjectInviteSettings", "DELETE", "/surveys/projects/{project_uid}/invite_settings"},
+			{"GetSurveySettings", "GET", "/surveys/{survey_uid}/settings"},
+			{"UpdateSurveySettings", "PUT", "/surveys/{survey_uid}/settings"},
+			{"GetSurveyResults", "GET", "/surveys/{survey_uid}/results"},
 			{"ValidateEmail", "POST", "/surveys/validate_email"},
+			{"ListSurveyTemplates", "GET", "/survey_templates"},
+			{"GetSurveyTemplate", "GET", "/survey_templates/{template_id}"},
+			{"ListDeadLetters", "GET", "/surveys/admin/dead_letters"},
+			{"GetDeadLetter", "GET", "/surveys/admin/dead_letters/{sequence}"},
+			{"ReplayDeadLetter", "POST", "/surveys/admin/dead_letters/{sequence}/replay"},
+			{"StartReindex", "POST", "/surveys/admin/reindex"},
+			{"GetReindex", "GET", "/surveys/admin/reindex"},
+			{"CancelReindex", "POST", "/surveys/admin/reindex/cancel"},
+			{"RerunInviteEnrichment", "POST", "/surveys/admin/invite_enrichment"},
+			{"StartInviteBackfill", "POST", "/surveys/admin/invite_backfill"},
+			{"GetInviteBackfill", "GET", "/surveys/admin/invite_backfill"},
+			{"CancelInviteBackfill", "POST", "/surveys/admin/invite_backfill/cancel"},
 		},
-		ScheduleSurvey:        NewScheduleSurveyHandler(e.ScheduleSurvey, mux, decoder, encoder, errhandler, formatter),
-		GetSurvey:             NewGetSurveyHandler(e.GetSurvey, mux, decoder, encoder, errhandler, formatter),
-		UpdateSurvey:          NewUpdateSurveyHandler(e.UpdateSurvey, mux, decoder, encoder, errhandler, formatter),
-		DeleteSurvey:          NewDeleteSurveyHandler(e.DeleteSurvey, mux, decoder, encoder, errhandler, formatter),
-		BulkResendSurvey:      NewBulkResendSurveyHandler(e.BulkResendSurvey, mux, decoder, encoder, errhandler, formatter),
-		PreviewSendSurvey:     NewPreviewSendSurveyHandler(e.PreviewSendSurvey, mux, decoder, encoder, errhandler, formatter),
-		SendMissingRecipients: NewSendMissingRecipientsHandler(e.SendMissingRecipients, mux, decoder, encoder, errhandler, formatter),
-		DeleteSurveyResponse:  NewDeleteSurveyResponseHandler(e.DeleteSurveyResponse, mux, decoder, encoder, errhandler, formatter),
-		ResendSurveyResponse:  NewResendSurveyResponseHandler(e.ResendSurveyResponse, mux, decoder, encoder, errhandler, formatter),
-		DeleteRecipientGroup:  NewDeleteRecipientGroupHandler(e.DeleteRecipientGroup, mux, decoder, encoder, errhandler, formatter),
-		CreateExclusion:       NewCreateExclusionHandler(e.CreateExclusion, mux, decoder, encoder, errhandler, formatter),
-		DeleteExclusion:       NewDeleteExclusionHandler(e.DeleteExclusion, mux, decoder, encoder, errhandler, formatter),
-		GetExclusion:          NewGetExclusionHandler(e.GetExclusion, mux, decoder, encoder, errhandler, formatter),
-		DeleteExclusionByID:   NewDeleteExclusionByIDHandler(e.DeleteExclusionByID, mux, decoder, encoder, errhandler, formatter),
-		ListSurveyResponses:   NewListSurveyResponsesHandler(e.ListSurveyResponses, mux, decoder, encoder, errhandler, formatter),
-		ValidateEmail:         NewValidateEmailHandler(e.ValidateEmail, mux, decoder, encoder, errhandler, formatter),
+		ScheduleSurvey:              NewScheduleSurveyHandler(e.ScheduleSurvey, mux, decoder, encoder, errhandler, formatter),
+		GetSurvey:                   NewGetSurveyHandler(e.GetSurvey, mux, decoder, encoder, errhandler, formatter),
+		UpdateSurvey:                NewUpdateSurveyHandler(e.UpdateSurvey, mux, decoder, encoder, errhandler, formatter),
+		DeleteSurvey:                NewDeleteSurveyHandler(e.DeleteSurvey, mux, decoder, encoder, errhandler, formatter),
+		BulkResendSurvey:            NewBulkResendSurveyHandler(e.BulkResendSurvey, mux, decoder, encoder, errhandler, formatter),
+		PreviewSendSurvey:           NewPreviewSendSurveyHandler(e.PreviewSendSurvey, mux, decoder, encoder, errhandler, formatter),
+		SendMissingRecipients:       NewSendMissingRecipientsHandler(e.SendMissingRecipients, mux, decoder, encoder, errhandler, formatter),
+		DeleteSurveyResponse:        NewDeleteSurveyResponseHandler(e.DeleteSurveyResponse, mux, decoder, encoder, errhandler, formatter),
+		ResendSurveyResponse:        NewResendSurveyResponseHandler(e.ResendSurveyResponse, mux, decoder, encoder, errhandler, formatter),
+		DeleteRecipientGroup:        NewDeleteRecipientGroupHandler(e.DeleteRecipientGroup, mux, decoder, encoder, errhandler, formatter),
+		CreateExclusion:             NewCreateExclusionHandler(e.CreateExclusion, mux, decoder, encoder, errhandler, formatter),
+		DeleteExclusion:             NewDeleteExclusionHandler(e.DeleteExclusion, mux, decoder, encoder, errhandler, formatter),
+		GetExclusion:                NewGetExclusionHandler(e.GetExclusion, mux, decoder, encoder, errhandler, formatter),
+		DeleteExclusionByID:         NewDeleteExclusionByIDHandler(e.DeleteExclusionByID, mux, decoder, encoder, errhandler, formatter),
+		ListSurveyResponses:         NewListSurveyResponsesHandler(e.ListSurveyResponses, mux, decoder, encoder, errhandler, formatter),
+		CreateSurveyResponse:        NewCreateSurveyResponseHandler(e.CreateSurveyResponse, mux, decoder, encoder, errhandler, formatter),
+		UpdateSurveyResponse:        NewUpdateSurveyResponseHandler(e.UpdateSurveyResponse, mux, decoder, encoder, errhandler, formatter),
+		ListSurveyInvites:           NewListSurveyInvitesHandler(e.ListSurveyInvites, mux, decoder, encoder, errhandler, formatter),
+		GetSurveyInviteSettings:     NewGetSurveyInviteSettingsHandler(e.GetSurveyInviteSettings, mux, decoder, encoder, errhandler, formatter),
+		UpdateSurveyInviteSettings:  NewUpdateSurveyInviteSettingsHandler(e.UpdateSurveyInviteSettings, mux, decoder, encoder, errhandler, formatter),
+		DeleteSurveyInviteSettings:  NewDeleteSurveyInviteSettingsHandler(e.DeleteSurveyInviteSettings, mux, decoder, encoder, errhandler, formatter),
+		GetProjectInviteSettings:    NewGetProjectInviteSettingsHandler(e.GetProjectInviteSettings, mux, decoder, encoder, errhandler, formatter),
+		UpdateProjectInviteSettings: NewUpdateProjectInviteSettingsHandler(e.UpdateProjectInviteSettings, mux, decoder, encoder, errhandler, formatter),
+		DeleteProjectInviteSettings: NewDeleteProjectInviteSettingsHandler(e.DeleteProjectInviteSettings, mux, decoder, encoder, errhandler, formatter),
+		GetSurveySettings:           NewGetSurveySettingsHandler(e.GetSurveySettings, mux, decoder, encoder, errhandler, formatter),
+		UpdateSurveySettings:        NewUpdateSurveySettingsHandler(e.UpdateSurveySettings, mux, decoder, encoder, errhandler, formatter),
+		GetSurveyResults:            NewGetSurveyResultsHandler(e.GetSurveyResults, mux, decoder, encoder, errhandler, formatter),
+		ValidateEmail:               NewValidateEmailHandler(e.ValidateEmail, mux, decoder, encoder, errhandler, formatter),
+		ListSurveyTemplates:         NewListSurveyTemplatesHandler(e.ListSurveyTemplates, mux, decoder, encoder, errhandler, formatter),
+		GetSurveyTemplate:           NewGetSurveyTemplateHandler(e.GetSurveyTemplate, mux, decoder, encoder, errhandler, formatter),
+		ListDeadLetters:             NewListDeadLettersHandler(e.ListDeadLetters, mux, decoder, encoder, errhandler, formatter),
+		GetDeadLetter:               NewGetDeadLetterHandler(e.GetDeadLetter, mux, decoder, encoder, errhandler, formatter),
+		ReplayDeadLetter:            NewReplayDeadLetterHandler(e.ReplayDeadLetter, mux, decoder, encoder, errhandler, formatter),
+		StartReindex:                NewStartReindexHandler(e.StartReindex, mux, decoder, encoder, errhandler, formatter),
+		GetReindex:                  NewGetReindexHandler(e.GetReindex, mux, decoder, encoder, errhandler, formatter),
+		CancelReindex:               NewCancelReindexHandler(e.CancelReindex, mux, decoder, encoder, errhandler, formatter),
+		RerunInviteEnrichment:       NewRerunInviteEnrichmentHandler(e.RerunInviteEnrichment, mux, decoder, encoder, errhandler, formatter),
+		StartInviteBackfill:         NewStartInviteBackfillHandler(e.StartInviteBackfill, mux, decoder, encoder, errhandler, formatter),
+		GetInviteBackfill:           NewGetInviteBackfillHandler(e.GetInviteBackfill, mux, decoder, encoder, errhandler, formatter),
+		CancelInviteBackfill:        NewCancelInviteBackfillHandler(e.CancelInviteBackfill, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -121,7 +193,31 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.GetExclusion = m(s.GetExclusion)
 	s.DeleteExclusionByID = m(s.DeleteExclusionByID)
 	s.ListSurveyResponses = m(s.ListSurveyResponses)
+	s.CreateSurveyResponse = m(s.CreateSurveyResponse)
+	s.UpdateSurveyResponse = m(s.UpdateSurveyResponse)
+	s.ListSurveyInvites = m(s.ListSurveyInvites)
+	s.GetSurveyInviteSettings = m(s.GetSurveyInviteSettings)
+	s.UpdateSurveyInviteSettings = m(s.UpdateSurveyInviteSettings)
+	s.DeleteSurveyInviteSettings = m(s.DeleteSurveyInviteSettings)
+	s.GetProjectInviteSettings = m(s.GetProjectInviteSettings)
+	s.UpdateProjectInviteSettings = m(s.UpdateProjectInviteSettings)
+	s.DeleteProjectInviteSettings = m(s.DeleteProjectInviteSettings)
+	s.GetSurveySettings = m(s.GetSurveySettings)
+	s.UpdateSurveySettings = m(s.UpdateSurveySettings)
+	s.GetSurveyResults = m(s.GetSurveyResults)
 	s.ValidateEmail = m(s.ValidateEmail)
+	s.ListSurveyTemplates = m(s.ListSurveyTemplates)
+	s.GetSurveyTemplate = m(s.GetSurveyTemplate)
+	s.ListDeadLetters = m(s.ListDeadLetters)
+	s.GetDeadLetter = m(s.GetDeadLetter)
+	s.ReplayDeadLetter = m(s.ReplayDeadLetter)
+	s.StartReindex = m(s.StartReindex)
+	s.GetReindex = m(s.GetReindex)
+	s.CancelReindex = m(s.CancelReindex)
+	s.RerunInviteEnrichment = m(s.RerunInviteEnrichment)
+	s.StartInviteBackfill = m(s.StartInviteBackfill)
+	s.GetInviteBackfill = m(s.GetInviteBackfill)
+	s.CancelInviteBackfill = m(s.CancelInviteBackfill)
 }
 
 // MethodNames returns the methods served.
@@ -144,7 +240,31 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountGetExclusionHandler(mux, h.GetExclusion)
 	MountDeleteExclusionByIDHandler(mux, h.DeleteExclusionByID)
 	MountListSurveyResponsesHandler(mux, h.ListSurveyResponses)
+	MountCreateSurveyResponseHandler(mux, h.CreateSurveyResponse)
+	MountUpdateSurveyResponseHandler(mux, h.UpdateSurveyResponse)
+	MountListSurveyInvitesHandler(mux, h.ListSurveyInvites)
+	MountGetSurveyInviteSettingsHandler(mux, h.GetSurveyInviteSettings)
+	MountUpdateSurveyInviteSettingsHandler(mux, h.UpdateSurveyInviteSettings)
+	MountDeleteSurveyInviteSettingsHandler(mux, h.DeleteSurveyInviteSettings)
+	MountGetProjectInviteSettingsHandler(mux, h.GetProjectInviteSettings)
+	MountUpdateProjectInviteSettingsHandler(mux, h.UpdateProjectInviteSettings)
+	MountDeleteProjectInviteSettingsHandler(mux, h.DeleteProjectInviteSettings)
+	MountGetSurveySettingsHandler(mux, h.GetSurveySettings)
+	MountUpdateSurveySettingsHandler(mux, h.UpdateSurveySettings)
+	MountGetSurveyResultsHandler(mux, h.GetSurveyResults)
 	MountValidateEmailHandler(mux, h.ValidateEmail)
+	MountListSurveyTemplatesHandler(mux, h.ListSurveyTemplates)
+	MountGetSurveyTemplateHandler(mux, h.GetSurveyTemplate)
+	MountListDeadLettersHandler(mux, h.ListDeadLetters)
+	MountGetDeadLetterHandler(mux, h.GetDeadLetter)
+	MountReplayDeadLetterHandler(mux, h.ReplayDeadLetter)
+	MountStartReindexHandler(mux, h.StartReindex)
+	MountGetReindexHandler(mux, h.GetReindex)
+	MountCancelReindexHandler(mux, h.CancelReindex)
+	MountRerunInviteEnrichmentHandler(mux, h.RerunInviteEnrichment)
+	MountStartInviteBackfillHandler(mux, h.StartInviteBackfill)
+	MountGetInviteBackfillHandler(mux, h.GetInviteBackfill)
+	MountCancelInviteBackfillHandler(mux, h.CancelInviteBackfill)
 }
 
 // Mount configures the mux to serve the survey endpoints.
@@ -947,6 +1067,648 @@ func NewListSurveyResponsesHandler(
 	})
 }
 
+// MountCreateSurveyResponseHandler configures the mux to serve the "survey"
+// service "create_survey_response" endpoint.
+func MountCreateSurveyResponseHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/surveys/{survey_uid}/responses", f)
+}
+
+// NewCreateSurveyResponseHandler creates a HTTP handler which loads the HTTP
+// request and calls the "survey" service "create_survey_response" endpoint.
+func NewCreateSurveyResponseHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateSurveyResponseRequest(mux, decoder)
+		encodeResponse = EncodeCreateSurveyResponseResponse(encoder)
+		encodeError    = EncodeCreateSurveyResponseError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "create_survey_response")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "survey")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUpdateSurveyResponseHandler configures the mux to serve the "survey"
+// service "update_survey_response" endpoint.
+func MountUpdateSurveyResponseHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/surveys/{survey_uid}/responses/{response_id}", f)
+}
+
+// NewUpdateSurveyResponseHandler creates a HTTP handler which loads the HTTP
+// request and calls the "survey" service "update_survey_response" endpoint.
+func NewUpdateSurveyResponseHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateSurveyResponseRequest(mux, decoder)
+		encodeResponse = EncodeUpdateSurveyResponseResponse(encoder)
+		encodeError    = EncodeUpdateSurveyResponseError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "update_survey_response")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "survey")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListSurveyInvitesHandler configures the mux to serve the "survey"
+// service "list_survey_invites" endpoint.
+func MountListSurveyInvitesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/surveys/{survey_uid}/invites", f)
+}
+
+// NewListSurveyInvitesHandler creates a HTTP handler which loads the HTTP
+// request and calls the "survey" service "list_survey_invites" endpoint.
+func NewListSurveyInvitesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListSurveyInvitesRequest(mux, decoder)
+		encodeResponse = EncodeListSurveyInvitesResponse(encoder)
+		encodeError    = EncodeListSurveyInvitesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_survey_invites")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "survey")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetSurveyInviteSettingsHandler configures the mux to serve the "survey"
+// service "get_survey_invite_settings" endpoint.
+func MountGetSurveyInviteSettingsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/surveys/{survey_uid}/invite_settings", f)
+}
+
+// NewGetSurveyInviteSettingsHandler creates a HTTP handler which loads the
+// HTTP request and calls the "survey" service "get_survey_invite_settings"
+// endpoint.
+func NewGetSurveyInviteSettingsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetSurveyInviteSettingsRequest(mux, decoder)
+		encodeResponse = EncodeGetSurveyInviteSettingsResponse(encoder)
+		encodeError    = EncodeGetSurveyInviteSettingsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get_survey_invite_settings")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "survey")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUpdateSurveyInviteSettingsHandler configures the mux to serve the
+// "survey" service "update_survey_invite_settings" endpoint.
+func MountUpdateSurveyInviteSettingsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/surveys/{survey_uid}/invite_settings", f)
+}
+
+// NewUpdateSurveyInviteSettingsHandler creates a HTTP handler which loads the
+// HTTP request and calls the "survey" service "update_survey_invite_settings"
+// endpoint.
+func NewUpdateSurveyInviteSettingsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateSurveyInviteSettingsRequest(mux, decoder)
+		encodeResponse = EncodeUpdateSurveyInviteSettingsResponse(encoder)
+		encodeError    = EncodeUpdateSurveyInviteSettingsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "update_survey_invite_settings")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "survey")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountDeleteSurveyInviteSettingsHandler configures the mux to serve the
+// "survey" service "delete_survey_invite_settings" endpoint.
+func MountDeleteSurveyInviteSettingsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/surveys/{survey_uid}/invite_settings", f)
+}
+
+// NewDeleteSurveyInviteSettingsHandler creates a HTTP handler which loads the
+// HTTP request and calls the "survey" service "delete_survey_invite_settings"
+// endpoint.
+func NewDeleteSurveyInviteSettingsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteSurveyInviteSettingsRequest(mux, decoder)
+		encodeResponse = EncodeDeleteSurveyInviteSettingsResponse(encoder)
+		encodeError    = EncodeDeleteSurveyInviteSettingsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete_survey_invite_settings")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "survey")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetProjectInviteSettingsHandler configures the mux to serve the
+// "survey" service "get_project_invite_settings" endpoint.
+func MountGetProjectInviteSettingsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/surveys/projects/{project_uid}/invite_settings", f)
+}
+
+// NewGetProjectInviteSettingsHandler creates a HTTP handler which loads the
+// HTTP request and calls the "survey" service "get_project_invite_settings"
+// endpoint.
+func NewGetProjectInviteSettingsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetProjectInviteSettingsRequest(mux, decoder)
+		encodeResponse = EncodeGetProjectInviteSettingsResponse(encoder)
+		encodeError    = EncodeGetProjectInviteSettingsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get_project_invite_settings")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "survey")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUpdateProjectInviteSettingsHandler configures the mux to serve the
+// "survey" service "update_project_invite_settings" endpoint.
+func MountUpdateProjectInviteSettingsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/surveys/projects/{project_uid}/invite_settings", f)
+}
+
+// NewUpdateProjectInviteSettingsHandler creates a HTTP handler which loads the
+// HTTP request and calls the "survey" service "update_project_invite_settings"
+// endpoint.
+func NewUpdateProjectInviteSettingsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateProjectInviteSettingsRequest(mux, decoder)
+		encodeResponse = EncodeUpdateProjectInviteSettingsResponse(encoder)
+		encodeError    = EncodeUpdateProjectInviteSettingsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "update_project_invite_settings")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "survey")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountDeleteProjectInviteSettingsHandler configures the mux to serve the
+// "survey" service "delete_project_invite_settings" endpoint.
+func MountDeleteProjectInviteSettingsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/surveys/projects/{project_uid}/invite_settings", f)
+}
+
+// NewDeleteProjectInviteSettingsHandler creates a HTTP handler which loads the
+// HTTP request and calls the "survey" service "delete_project_invite_settings"
+// endpoint.
+func NewDeleteProjectInviteSettingsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteProjectInviteSettingsRequest(mux, decoder)
+		encodeResponse = EncodeDeleteProjectInviteSettingsResponse(encoder)
+		encodeError    = EncodeDeleteProjectInviteSettingsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete_project_invite_settings")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "survey")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetSurveySettingsHandler configures the mux to serve the "survey"
+// service "get_survey_settings" endpoint.
+func MountGetSurveySettingsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/surveys/{survey_uid}/settings", f)
+}
+
+// NewGetSurveySettingsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "survey" service "get_survey_settings" endpoint.
+func NewGetSurveySettingsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetSurveySettingsRequest(mux, decoder)
+		encodeResponse = EncodeGetSurveySettingsResponse(encoder)
+		encodeError    = EncodeGetSurveySettingsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get_survey_settings")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "survey")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUpdateSurveySettingsHandler configures the mux to serve the "survey"
+// service "update_survey_settings" endpoint.
+func MountUpdateSurveySettingsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/surveys/{survey_uid}/settings", f)
+}
+
+// NewUpdateSurveySettingsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "survey" service "update_survey_settings" endpoint.
+func NewUpdateSurveySettingsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateSurveySettingsRequest(mux, decoder)
+		encodeResponse = EncodeUpdateSurveySettingsResponse(encoder)
+		encodeError    = EncodeUpdateSurveySettingsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "update_survey_settings")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "survey")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetSurveyResultsHandler configures the mux to serve the "survey"
+// service "get_survey_results" endpoint.
+func MountGetSurveyResultsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/surveys/{survey_uid}/results", f)
+}
+
+// NewGetSurveyResultsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "survey" service "get_survey_results" endpoint.
+func NewGetSurveyResultsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetSurveyResultsRequest(mux, decoder)
+		encodeResponse = EncodeGetSurveyResultsResponse(encoder)
+		encodeError    = EncodeGetSurveyResultsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get_survey_results")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "survey")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountValidateEmailHandler configures the mux to serve the "survey" service
 // "validate_email" endpoint.
 func MountValidateEmailHandler(mux goahttp.Muxer, h http.Handler) {
@@ -956,12 +1718,383 @@ func MountValidateEmailHandler(mux goahttp.Muxer, h http.Handler) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("POST", "/surveys/validate_email", f)
+	mux.Handle("POST", "/surveys/validate_email", f)
+}
+
+// NewValidateEmailHandler creates a HTTP handler which loads the HTTP request
+// and calls the "survey" service "validate_email" endpoint.
+func NewValidateEmailHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeValidateEmailRequest(mux, decoder)
+		encodeResponse = EncodeValidateEmailResponse(encoder)
+		encodeError    = EncodeValidateEmailError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "validate_email")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "survey")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListSurveyTemplatesHandler configures the mux to serve the "survey"
+// service "list_survey_templates" endpoint.
+func MountListSurveyTemplatesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/survey_templates", f)
+}
+
+// NewListSurveyTemplatesHandler creates a HTTP handler which loads the HTTP
+// request and calls the "survey" service "list_survey_templates" endpoint.
+func NewListSurveyTemplatesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListSurveyTemplatesRequest(mux, decoder)
+		encodeResponse = EncodeListSurveyTemplatesResponse(encoder)
+		encodeError    = EncodeListSurveyTemplatesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_survey_templates")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "survey")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetSurveyTemplateHandler configures the mux to serve the "survey"
+// service "get_survey_template" endpoint.
+func MountGetSurveyTemplateHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/survey_templates/{template_id}", f)
+}
+
+// NewGetSurveyTemplateHandler creates a HTTP handler which loads the HTTP
+// request and calls the "survey" service "get_survey_template" endpoint.
+func NewGetSurveyTemplateHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetSurveyTemplateRequest(mux, decoder)
+		encodeResponse = EncodeGetSurveyTemplateResponse(encoder)
+		encodeError    = EncodeGetSurveyTemplateError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get_survey_template")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "survey")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListDeadLettersHandler configures the mux to serve the "survey" service
+// "list_dead_letters" endpoint.
+func MountListDeadLettersHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/surveys/admin/dead_letters", f)
+}
+
+// NewListDeadLettersHandler creates a HTTP handler which loads the HTTP
+// request and calls the "survey" service "list_dead_letters" endpoint.
+func NewListDeadLettersHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListDeadLettersRequest(mux, decoder)
+		encodeResponse = EncodeListDeadLettersResponse(encoder)
+		encodeError    = EncodeListDeadLettersError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_dead_letters")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "survey")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetDeadLetterHandler configures the mux to serve the "survey" service
+// "get_dead_letter" endpoint.
+func MountGetDeadLetterHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/surveys/admin/dead_letters/{sequence}", f)
+}
+
+// NewGetDeadLetterHandler creates a HTTP handler which loads the HTTP request
+// and calls the "survey" service "get_dead_letter" endpoint.
+func NewGetDeadLetterHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetDeadLetterRequest(mux, decoder)
+		encodeResponse = EncodeGetDeadLetterResponse(encoder)
+		encodeError    = EncodeGetDeadLetterError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get_dead_letter")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "survey")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountReplayDeadLetterHandler configures the mux to serve the "survey"
+// service "replay_dead_letter" endpoint.
+func MountReplayDeadLetterHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/surveys/admin/dead_letters/{sequence}/replay", f)
+}
+
+// NewReplayDeadLetterHandler creates a HTTP handler which loads the HTTP
+// request and calls the "survey" service "replay_dead_letter" endpoint.
+func NewReplayDeadLetterHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeReplayDeadLetterRequest(mux, decoder)
+		encodeResponse = EncodeReplayDeadLetterResponse(encoder)
+		encodeError    = EncodeReplayDeadLetterError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "replay_dead_letter")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "survey")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountStartReindexHandler configures the mux to serve the "survey" service
+// "start_reindex" endpoint.
+func MountStartReindexHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/surveys/admin/reindex", f)
+}
+
+// NewStartReindexHandler creates a HTTP handler which loads the HTTP request
+// and calls the "survey" service "start_reindex" endpoint.
+func NewStartReindexHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeStartReindexRequest(mux, decoder)
+		encodeResponse = EncodeStartReindexResponse(encoder)
+		encodeError    = EncodeStartReindexError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "start_reindex")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "survey")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetReindexHandler configures the mux to serve the "survey" service
+// "get_reindex" endpoint.
+func MountGetReindexHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/surveys/admin/reindex", f)
 }
 
-// NewValidateEmailHandler creates a HTTP handler which loads the HTTP request
-// and calls the "survey" service "validate_email" endpoint.
-func NewValidateEmailHandler(
+// NewGetReindexHandler creates a HTTP handler which loads the HTTP request and
+// calls the "survey" service "get_reindex" endpoint.
+func NewGetReindexHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -970,13 +2103,278 @@ func NewValidateEmailHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeValidateEmailRequest(mux, decoder)
-		encodeResponse = EncodeValidateEmailResponse(encoder)
-		encodeError    = EncodeValidateEmailError(encoder, formatter)
+		decodeRequest  = DecodeGetReindexRequest(mux, decoder)
+		encodeResponse = EncodeGetReindexResponse(encoder)
+		encodeError    = EncodeGetReindexError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "validate_email")
+		ctx = context.WithValue(ctx, goa.MethodKey, "get_reindex")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "survey")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCancelReindexHandler configures the mux to serve the "survey" service
+// "cancel_reindex" endpoint.
+func MountCancelReindexHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/surveys/admin/reindex/cancel", f)
+}
+
+// NewCancelReindexHandler creates a HTTP handler which loads the HTTP request
+// and calls the "survey" service "cancel_reindex" endpoint.
+func NewCancelReindexHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCancelReindexRequest(mux, decoder)
+		encodeResponse = EncodeCancelReindexResponse(encoder)
+		encodeError    = EncodeCancelReindexError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "cancel_reindex")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "survey")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRerunInviteEnrichmentHandler configures the mux to serve the "survey"
+// service "rerun_invite_enrichment" endpoint.
+func MountRerunInviteEnrichmentHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/surveys/admin/invite_enrichment", f)
+}
+
+// NewRerunInviteEnrichmentHandler creates a HTTP handler which loads the HTTP
+// request and calls the "survey" service "rerun_invite_enrichment" endpoint.
+func NewRerunInviteEnrichmentHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRerunInviteEnrichmentRequest(mux, decoder)
+		encodeResponse = EncodeRerunInviteEnrichmentResponse(encoder)
+		encodeError    = EncodeRerunInviteEnrichmentError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "rerun_invite_enrichment")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "survey")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountStartInviteBackfillHandler configures the mux to serve the "survey"
+// service "start_invite_backfill" endpoint.
+func MountStartInviteBackfillHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/surveys/admin/invite_backfill", f)
+}
+
+// NewStartInviteBackfillHandler creates a HTTP handler which loads the HTTP
+// request and calls the "survey" service "start_invite_backfill" endpoint.
+func NewStartInviteBackfillHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeStartInviteBackfillRequest(mux, decoder)
+		encodeResponse = EncodeStartInviteBackfillResponse(encoder)
+		encodeError    = EncodeStartInviteBackfillError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "start_invite_backfill")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "survey")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetInviteBackfillHandler configures the mux to serve the "survey"
+// service "get_invite_backfill" endpoint.
+func MountGetInviteBackfillHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/surveys/admin/invite_backfill", f)
+}
+
+// NewGetInviteBackfillHandler creates a HTTP handler which loads the HTTP
+// request and calls the "survey" service "get_invite_backfill" endpoint.
+func NewGetInviteBackfillHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetInviteBackfillRequest(mux, decoder)
+		encodeResponse = EncodeGetInviteBackfillResponse(encoder)
+		encodeError    = EncodeGetInviteBackfillError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get_invite_backfill")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "survey")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCancelInviteBackfillHandler configures the mux to serve the "survey"
+// service "cancel_invite_backfill" endpoint.
+func MountCancelInviteBackfillHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/surveys/admin/invite_backfill/cancel", f)
+}
+
+// NewCancelInviteBackfillHandler creates a HTTP handler which loads the HTTP
+// request and calls the "survey" service "cancel_invite_backfill" endpoint.
+func NewCancelInviteBackfillHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCancelInviteBackfillRequest(mux, decoder)
+		encodeResponse = EncodeCancelInviteBackfillResponse(encoder)
+		encodeError    = EncodeCancelInviteBackfillError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "cancel_invite_backfill")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "survey")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -112,6 +112,72 @@ type DeleteExclusionRequestBody struct {
 	GlobalExclusion *string `form:"global_exclusion,omitempty" json:"global_exclusion,omitempty" xml:"global_exclusion,omitempty"`
 }
 
+// CreateSurveyResponseRequestBody is the type of the "survey" service
+// "create_survey_response" endpoint HTTP request body.
+type CreateSurveyResponseRequestBody struct {
+	// Survey response identifier
+	SurveyResponseUID *string `form:"survey_response_uid,omitempty" json:"survey_response_uid,omitempty" xml:"survey_response_uid,omitempty"`
+	// Answers to the survey questions
+	Answers []*SurveyAnswerRequestBody `form:"answers,omitempty" json:"answers,omitempty" xml:"answers,omitempty"`
+}
+
+// UpdateSurveyResponseRequestBody is the type of the "survey" service
+// "update_survey_response" endpoint HTTP request body.
+type UpdateSurveyResponseRequestBody struct {
+	// Answers to the survey questions
+	Answers []*SurveyAnswerRequestBody `form:"answers,omitempty" json:"answers,omitempty" xml:"answers,omitempty"`
+}
+
+// UpdateSurveyInviteSettingsRequestBody is the type of the "survey" service
+// "update_survey_invite_settings" endpoint HTTP request body.
+type UpdateSurveyInviteSettingsRequestBody struct {
+	// Send LFID invites; false turns them off and true turns them back on for a
+	// survey of a disabled project. Omit to inherit.
+	Enabled *bool `form:"enabled,omitempty" json:"enabled,omitempty" xml:"enabled,omitempty"`
+	// Invite service role granted when the invite is accepted. Omit to inherit.
+	Role *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	// Days an invite stays valid. Omit to inherit.
+	ExpirationDays *int `form:"expiration_days,omitempty" json:"expiration_days,omitempty" xml:"expiration_days,omitempty"`
+	// Where the recipient lands after accepting; {base_url}, {survey_uid},
+	// {project_uid} and {locale} are substituted. Omit to inherit.
+	ReturnURLTemplate *string `form:"return_url_template,omitempty" json:"return_url_template,omitempty" xml:"return_url_template,omitempty"`
+	// Survey name used in the invite when the survey has none. Omit to inherit.
+	DisplayNameFallback *string `form:"display_name_fallback,omitempty" json:"display_name_fallback,omitempty" xml:"display_name_fallback,omitempty"`
+	// Recipient language as a BCP 47 tag, substituted for {locale} in the return
+	// URL. Omit to inherit.
+	Locale *string `form:"locale,omitempty" json:"locale,omitempty" xml:"locale,omitempty"`
+}
+
+// UpdateProjectInviteSettingsRequestBody is the type of the "survey" service
+// "update_project_invite_settings" endpoint HTTP request body.
+type UpdateProjectInviteSettingsRequestBody struct {
+	// Send LFID invites; false turns them off and true turns them back on for a
+	// survey of a disabled project. Omit to inherit.
+	Enabled *bool `form:"enabled,omitempty" json:"enabled,omitempty" xml:"enabled,omitempty"`
+	// Invite service role granted when the invite is accepted. Omit to inherit.
+	Role *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	// Days an invite stays valid. Omit to inherit.
+	ExpirationDays *int `form:"expiration_days,omitempty" json:"expiration_days,omitempty" xml:"expiration_days,omitempty"`
+	// Where the recipient lands after accepting; {base_url}, {survey_uid},
+	// {project_uid} and {locale} are substituted. Omit to inherit.
+	ReturnURLTemplate *string `form:"return_url_template,omitempty" json:"return_url_template,omitempty" xml:"return_url_template,omitempty"`
+	// Survey name used in the invite when the survey has none. Omit to inherit.
+	DisplayNameFallback *string `form:"display_name_fallback,omitempty" json:"display_name_fallback,omitempty" xml:"display_name_fallback,omitempty"`
+	// Recipient language as a BCP 47 tag, substituted for {locale} in the return
+	// URL. Omit to inherit.
+	Locale *string `form:"locale,omitempty" json:"locale,omitempty" xml:"locale,omitempty"`
+}
+
+// UpdateSurveySettingsRequestBody is the type of the "survey" service
+// "update_survey_settings" endpoint HTTP request body.
+type UpdateSurveySettingsRequestBody struct {
+	// Let respondents view the survey's aggregate results
+	ShareResults *bool `form:"share_results,omitempty" json:"share_results,omitempty" xml:"share_results,omitempty"`
+	// Keep respondent identity and individual answers from organizers; cannot be
+	// turned off once on
+	Anonymous *bool `form:"anonymous,omitempty" json:"anonymous,omitempty" xml:"anonymous,omitempty"`
+}
+
 // ValidateEmailRequestBody is the type of the "survey" service
 // "validate_email" endpoint HTTP request body.
 type ValidateEmailRequestBody struct {
@@ -121,6 +187,46 @@ type ValidateEmailRequestBody struct {
 	Subject *string `form:"subject,omitempty" json:"subject,omitempty" xml:"subject,omitempty"`
 }
 
+// StartReindexRequestBody is the type of the "survey" service "start_reindex"
+// endpoint HTTP request body.
+type StartReindexRequestBody struct {
+	// Only replay keys starting with one of these prefixes; each must begin with
+	// itx-surveys, itx-survey-responses, itx-survey-exclusions or
+	// surveymonkey-surveys. Defaults to all of them.
+	KeyPrefixes []string `form:"key_prefixes,omitempty" json:"key_prefixes,omitempty" xml:"key_prefixes,omitempty"`
+	// Only replay entries last written at or after this time (RFC3339)
+	ModifiedSince *string `form:"modified_since,omitempty" json:"modified_since,omitempty" xml:"modified_since,omitempty"`
+	// Only replay entries last written before this time (RFC3339)
+	ModifiedUntil *string `form:"modified_until,omitempty" json:"modified_until,omitempty" xml:"modified_until,omitempty"`
+	// Count matching entries without running the handlers
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty" xml:"dry_run,omitempty"`
+	// Republish entries even when their converted content is unchanged since the
+	// last publish
+	Force *bool `form:"force,omitempty" json:"force,omitempty" xml:"force,omitempty"`
+	// Maximum entries run through the handlers per second
+	RatePerSecond *int `form:"rate_per_second,omitempty" json:"rate_per_second,omitempty" xml:"rate_per_second,omitempty"`
+}
+
+// RerunInviteEnrichmentRequestBody is the type of the "survey" service
+// "rerun_invite_enrichment" endpoint HTTP request body.
+type RerunInviteEnrichmentRequestBody struct {
+	// Email the invite was accepted for
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// LFX username of the acceptor; looked up by email when omitted
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+}
+
+// StartInviteBackfillRequestBody is the type of the "survey" service
+// "start_invite_backfill" endpoint HTTP request body.
+type StartInviteBackfillRequestBody struct {
+	// Only backfill the responses of these surveys. Defaults to every open survey.
+	SurveyUids []string `form:"survey_uids,omitempty" json:"survey_uids,omitempty" xml:"survey_uids,omitempty"`
+	// Report the responses that would be invited without sending anything
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty" xml:"dry_run,omitempty"`
+	// Maximum candidates checked and invited per second
+	RatePerSecond *int `form:"rate_per_second,omitempty" json:"rate_per_second,omitempty" xml:"rate_per_second,omitempty"`
+}
+
 // ScheduleSurveyResponseBody is the type of the "survey" service
 // "schedule_survey" endpoint HTTP response body.
 type ScheduleSurveyResponseBody struct {
@@ -408,6 +514,183 @@ type ListSurveyResponsesResponseBody struct {
 	Meta *SurveyResponsePageMetaResponseBody `form:"meta" json:"meta" xml:"meta"`
 }
 
+// ListSurveyInvitesResponseBody is the type of the "survey" service
+// "list_survey_invites" endpoint HTTP response body.
+type ListSurveyInvitesResponseBody struct {
+	// Counts over all invites of the survey, regardless of the status filter
+	Counts *SurveyInviteCountsResponseBody `form:"counts" json:"counts" xml:"counts"`
+	// Invites ordered by email
+	Invites []*SurveyInviteResponseBody `form:"invites" json:"invites" xml:"invites"`
+}
+
+// GetSurveyInviteSettingsResponseBody is the type of the "survey" service
+// "get_survey_invite_settings" endpoint HTTP response body.
+type GetSurveyInviteSettingsResponseBody struct {
+	// What the settings apply to
+	Scope string `form:"scope" json:"scope" xml:"scope"`
+	// Project or survey identifier
+	UID string `form:"uid" json:"uid" xml:"uid"`
+	// Send LFID invites; false turns them off and true turns them back on for a
+	// survey of a disabled project. Omit to inherit.
+	Enabled *bool `form:"enabled,omitempty" json:"enabled,omitempty" xml:"enabled,omitempty"`
+	// Invite service role granted when the invite is accepted. Omit to inherit.
+	Role *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	// Days an invite stays valid. Omit to inherit.
+	ExpirationDays *int `form:"expiration_days,omitempty" json:"expiration_days,omitempty" xml:"expiration_days,omitempty"`
+	// Where the recipient lands after accepting; {base_url}, {survey_uid},
+	// {project_uid} and {locale} are substituted. Omit to inherit.
+	ReturnURLTemplate *string `form:"return_url_template,omitempty" json:"return_url_template,omitempty" xml:"return_url_template,omitempty"`
+	// Survey name used in the invite when the survey has none. Omit to inherit.
+	DisplayNameFallback *string `form:"display_name_fallback,omitempty" json:"display_name_fallback,omitempty" xml:"display_name_fallback,omitempty"`
+	// Recipient language as a BCP 47 tag, substituted for {locale} in the return
+	// URL. Omit to inherit.
+	Locale *string `form:"locale,omitempty" json:"locale,omitempty" xml:"locale,omitempty"`
+	// When the settings were last changed (RFC3339)
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Who last changed the settings
+	UpdatedBy *string `form:"updated_by,omitempty" json:"updated_by,omitempty" xml:"updated_by,omitempty"`
+}
+
+// UpdateSurveyInviteSettingsResponseBody is the type of the "survey" service
+// "update_survey_invite_settings" endpoint HTTP response body.
+type UpdateSurveyInviteSettingsResponseBody struct {
+	// What the settings apply to
+	Scope string `form:"scope" json:"scope" xml:"scope"`
+	// Project or survey identifier
+	UID string `form:"uid" json:"uid" xml:"uid"`
+	// Send LFID invites; false turns them off and true turns them back on for a
+	// survey of a disabled project. Omit to inherit.
+	Enabled *bool `form:"enabled,omitempty" json:"enabled,omitempty" xml:"enabled,omitempty"`
+	// Invite service role granted when the invite is accepted. Omit to inherit.
+	Role *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	// Days an invite stays valid. Omit to inherit.
+	ExpirationDays *int `form:"expiration_days,omitempty" json:"expiration_days,omitempty" xml:"expiration_days,omitempty"`
+	// Where the recipient lands after accepting; {base_url}, {survey_uid},
+	// {project_uid} and {locale} are substituted. Omit to inherit.
+	ReturnURLTemplate *string `form:"return_url_template,omitempty" json:"return_url_template,omitempty" xml:"return_url_template,omitempty"`
+	// Survey name used in the invite when the survey has none. Omit to inherit.
+	DisplayNameFallback *string `form:"display_name_fallback,omitempty" json:"display_name_fallback,omitempty" xml:"display_name_fallback,omitempty"`
+	// Recipient language as a BCP 47 tag, substituted for {locale} in the return
+	// URL. Omit to inherit.
+	Locale *string `form:"locale,omitempty" json:"locale,omitempty" xml:"locale,omitempty"`
+	// When the settings were last changed (RFC3339)
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Who last changed the settings
+	UpdatedBy *string `form:"updated_by,omitempty" json:"updated_by,omitempty" xml:"updated_by,omitempty"`
+}
+
+// GetProjectInviteSettingsResponseBody is the type of the "survey" service
+// "get_project_invite_settings" endpoint HTTP response body.
+type GetProjectInviteSettingsResponseBody struct {
+	// What the settings apply to
+	Scope string `form:"scope" json:"scope" xml:"scope"`
+	// Project or survey identifier
+	UID string `form:"uid" json:"uid" xml:"uid"`
+	// Send LFID invites; false turns them off and true turns them back on for a
+	// survey of a disabled project. Omit to inherit.
+	Enabled *bool `form:"enabled,omitempty" json:"enabled,omitempty" xml:"enabled,omitempty"`
+	// Invite service role granted when the invite is accepted. Omit to inherit.
+	Role *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	// Days an invite stays valid. Omit to inherit.
+	ExpirationDays *int `form:"expiration_days,omitempty" json:"expiration_days,omitempty" xml:"expiration_days,omitempty"`
+	// Where the recipient lands after accepting; {base_url}, {survey_uid},
+	// {project_uid} and {locale} are substituted. Omit to inherit.
+	ReturnURLTemplate *string `form:"return_url_template,omitempty" json:"return_url_template,omitempty" xml:"return_url_template,omitempty"`
+	// Survey name used in the invite when the survey has none. Omit to inherit.
+	DisplayNameFallback *string `form:"display_name_fallback,omitempty" json:"display_name_fallback,omitempty" xml:"display_name_fallback,omitempty"`
+	// Recipient language as a BCP 47 tag, substituted for {locale} in the return
+	// URL. Omit to inherit.
+	Locale *string `form:"locale,omitempty" json:"locale,omitempty" xml:"locale,omitempty"`
+	// When the settings were last changed (RFC3339)
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Who last changed the settings
+	UpdatedBy *string `form:"updated_by,omitempty" json:"updated_by,omitempty" xml:"updated_by,omitempty"`
+}
+
+// UpdateProjectInviteSettingsResponseBody is the type of the "survey" service
+// "update_project_invite_settings" endpoint HTTP response body.
+type UpdateProjectInviteSettingsResponseBody struct {
+	// What the settings apply to
+	Scope string `form:"scope" json:"scope" xml:"scope"`
+	// Project or survey identifier
+	UID string `form:"uid" json:"uid" xml:"uid"`
+	// Send LFID invites; false turns them off and true turns them back on for a
+	// survey of a disabled project. Omit to inherit.
+	Enabled *bool `form:"enabled,omitempty" json:"enabled,omitempty" xml:"enabled,omitempty"`
+	// Invite service role granted when the invite is accepted. Omit to inherit.
+	Role *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	// Days an invite stays valid. Omit to inherit.
+	ExpirationDays *int `form:"expiration_days,omitempty" json:"expiration_days,omitempty" xml:"expiration_days,omitempty"`
+	// Where the recipient lands after accepting; {base_url}, {survey_uid},
+	// {project_uid} and {locale} are substituted. Omit to inherit.
+	ReturnURLTemplate *string `form:"return_url_template,omitempty" json:"return_url_template,omitempty" xml:"return_url_template,omitempty"`
+	// Survey name used in the invite when the survey has none. Omit to inherit.
+	DisplayNameFallback *string `form:"display_name_fallback,omitempty" json:"display_name_fallback,omitempty" xml:"display_name_fallback,omitempty"`
+	// Recipient language as a BCP 47 tag, substituted for {locale} in the return
+	// URL. Omit to inherit.
+	Locale *string `form:"locale,omitempty" json:"locale,omitempty" xml:"locale,omitempty"`
+	// When the settings were last changed (RFC3339)
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Who last changed the settings
+	UpdatedBy *string `form:"updated_by,omitempty" json:"updated_by,omitempty" xml:"updated_by,omitempty"`
+}
+
+// GetSurveySettingsResponseBody is the type of the "survey" service
+// "get_survey_settings" endpoint HTTP response body.
+type GetSurveySettingsResponseBody struct {
+	// Survey identifier
+	SurveyUID string `form:"survey_uid" json:"survey_uid" xml:"survey_uid"`
+	// Whether respondents can view the survey's aggregate results
+	ShareResults bool `form:"share_results" json:"share_results" xml:"share_results"`
+	// Whether responses are kept de-identified from organizers
+	Anonymous bool `form:"anonymous" json:"anonymous" xml:"anonymous"`
+	// When the settings were last changed (RFC3339); unset until they are first
+	// stored
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Who last changed the settings
+	UpdatedBy *string `form:"updated_by,omitempty" json:"updated_by,omitempty" xml:"updated_by,omitempty"`
+	// results_viewer changes made by this update; only set on update
+	ResultsAccess *ResultsAccessSyncResponseBody `form:"results_access,omitempty" json:"results_access,omitempty" xml:"results_access,omitempty"`
+}
+
+// UpdateSurveySettingsResponseBody is the type of the "survey" service
+// "update_survey_settings" endpoint HTTP response body.
+type UpdateSurveySettingsResponseBody struct {
+	// Survey identifier
+	SurveyUID string `form:"survey_uid" json:"survey_uid" xml:"survey_uid"`
+	// Whether respondents can view the survey's aggregate results
+	ShareResults bool `form:"share_results" json:"share_results" xml:"share_results"`
+	// Whether responses are kept de-identified from organizers
+	Anonymous bool `form:"anonymous" json:"anonymous" xml:"anonymous"`
+	// When the settings were last changed (RFC3339); unset until they are first
+	// stored
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Who last changed the settings
+	UpdatedBy *string `form:"updated_by,omitempty" json:"updated_by,omitempty" xml:"updated_by,omitempty"`
+	// results_viewer changes made by this update; only set on update
+	ResultsAccess *ResultsAccessSyncResponseBody `form:"results_access,omitempty" json:"results_access,omitempty" xml:"results_access,omitempty"`
+}
+
+// GetSurveyResultsResponseBody is the type of the "survey" service
+// "get_survey_results" endpoint HTTP response body.
+type GetSurveyResultsResponseBody struct {
+	// Survey identifier
+	SurveyUID string `form:"survey_uid" json:"survey_uid" xml:"survey_uid"`
+	// Number of recipients
+	NumRecipients int `form:"num_recipients" json:"num_recipients" xml:"num_recipients"`
+	// Number of responses
+	NumResponses int `form:"num_responses" json:"num_responses" xml:"num_responses"`
+	// When the survey closes (RFC3339)
+	SurveyEndTime *string `form:"survey_end_time,omitempty" json:"survey_end_time,omitempty" xml:"survey_end_time,omitempty"`
+	// Answer counts per question
+	Questions []*SurveyQuestionResultResponseBody `form:"questions" json:"questions" xml:"questions"`
+	// Set for anonymous surveys: answers given by fewer respondents are suppressed
+	MinCellSize *int `form:"min_cell_size,omitempty" json:"min_cell_size,omitempty" xml:"min_cell_size,omitempty"`
+	// Set for anonymous surveys with fewer than min_cell_size responses: every
+	// answer is suppressed
+	Suppressed *bool `form:"suppressed,omitempty" json:"suppressed,omitempty" xml:"suppressed,omitempty"`
+}
+
 // ValidateEmailResponseBody is the type of the "survey" service
 // "validate_email" endpoint HTTP response body.
 type ValidateEmailResponseBody struct {
@@ -417,6 +700,338 @@ type ValidateEmailResponseBody struct {
 	Subject string `form:"subject" json:"subject" xml:"subject"`
 }
 
+// ListSurveyTemplatesResponseBody is the type of the "survey" service
+// "list_survey_templates" endpoint HTTP response body.
+type ListSurveyTemplatesResponseBody struct {
+	// Matching templates
+	Templates []*SurveyTemplateResponseBody `form:"templates" json:"templates" xml:"templates"`
+	// Number of matching templates across all pages
+	Total int `form:"total" json:"total" xml:"total"`
+	// Pass as offset to fetch the next page; absent on the last page
+	NextOffset *int `form:"next_offset,omitempty" json:"next_offset,omitempty" xml:"next_offset,omitempty"`
+}
+
+// GetSurveyTemplateResponseBody is the type of the "survey" service
+// "get_survey_template" endpoint HTTP response body.
+type GetSurveyTemplateResponseBody struct {
+	// SurveyMonkey survey ID; pass as survey_monkey_id to schedule_survey
+	ID string `form:"id" json:"id" xml:"id"`
+	// Survey title
+	Title string `form:"title" json:"title" xml:"title"`
+	// Internal nickname
+	Nickname *string `form:"nickname,omitempty" json:"nickname,omitempty" xml:"nickname,omitempty"`
+	// Survey category
+	Category *string `form:"category,omitempty" json:"category,omitempty" xml:"category,omitempty"`
+	// Survey language
+	Language *string `form:"language,omitempty" json:"language,omitempty" xml:"language,omitempty"`
+	// Number of questions
+	QuestionCount *int `form:"question_count,omitempty" json:"question_count,omitempty" xml:"question_count,omitempty"`
+	// Number of pages
+	PageCount *int `form:"page_count,omitempty" json:"page_count,omitempty" xml:"page_count,omitempty"`
+	// URL to preview the survey
+	PreviewURL *string `form:"preview_url,omitempty" json:"preview_url,omitempty" xml:"preview_url,omitempty"`
+	// URL of the survey collectors
+	CollectURL *string `form:"collect_url,omitempty" json:"collect_url,omitempty" xml:"collect_url,omitempty"`
+	// When the survey was created in SurveyMonkey
+	DateCreated *string `form:"date_created,omitempty" json:"date_created,omitempty" xml:"date_created,omitempty"`
+	// When the survey was last modified in SurveyMonkey
+	DateModified *string `form:"date_modified,omitempty" json:"date_modified,omitempty" xml:"date_modified,omitempty"`
+}
+
+// ListDeadLettersResponseBody is the type of the "survey" service
+// "list_dead_letters" endpoint HTTP response body.
+type ListDeadLettersResponseBody struct {
+	// Dead-lettered events
+	Entries []*DeadLetterEntryResponseBody `form:"entries" json:"entries" xml:"entries"`
+	// Pass as after to fetch the next page; absent on the last page
+	NextAfter *uint64 `form:"next_after,omitempty" json:"next_after,omitempty" xml:"next_after,omitempty"`
+}
+
+// GetDeadLetterResponseBody is the type of the "survey" service
+// "get_dead_letter" endpoint HTTP response body.
+type GetDeadLetterResponseBody struct {
+	// Dead-letter stream sequence, used as the entry identifier
+	Sequence uint64 `form:"sequence" json:"sequence" xml:"sequence"`
+	// Original v1-objects KV key
+	Key string `form:"key" json:"key" xml:"key"`
+	// Original KV operation
+	Operation string `form:"operation" json:"operation" xml:"operation"`
+	// KV revision of the original event
+	Revision *uint64 `form:"revision,omitempty" json:"revision,omitempty" xml:"revision,omitempty"`
+	// Why the event was dead-lettered
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+	// Last processing error
+	Error string `form:"error" json:"error" xml:"error"`
+	// Number of delivery attempts made
+	Attempts uint64 `form:"attempts" json:"attempts" xml:"attempts"`
+	// When the event was dead-lettered (RFC3339)
+	DeadLetteredAt string `form:"dead_lettered_at" json:"dead_lettered_at" xml:"dead_lettered_at"`
+	// Original KV value (JSON or msgpack); only returned when inspecting a single
+	// entry
+	Payload []byte `form:"payload,omitempty" json:"payload,omitempty" xml:"payload,omitempty"`
+	// Original message headers; only returned when inspecting a single entry
+	Headers map[string][]string `form:"headers,omitempty" json:"headers,omitempty" xml:"headers,omitempty"`
+}
+
+// ReplayDeadLetterResponseBody is the type of the "survey" service
+// "replay_dead_letter" endpoint HTTP response body.
+type ReplayDeadLetterResponseBody struct {
+	// Dead-letter stream sequence
+	Sequence uint64 `form:"sequence" json:"sequence" xml:"sequence"`
+	// Original v1-objects KV key
+	Key string `form:"key" json:"key" xml:"key"`
+	// replayed: the key's current value, or the delete while the key is gone, was
+	// run through the handlers; superseded or key_deleted: the key has since been
+	// written again or deleted, so the event was dropped without a replay
+	Outcome string `form:"outcome" json:"outcome" xml:"outcome"`
+	// True when the event was resolved and removed from the dead-letter stream
+	Succeeded bool `form:"succeeded" json:"succeeded" xml:"succeeded"`
+	// Why the replay failed; the entry is kept for another attempt
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// StartReindexResponseBody is the type of the "survey" service "start_reindex"
+// endpoint HTTP response body.
+type StartReindexResponseBody struct {
+	// Run state
+	State string `form:"state" json:"state" xml:"state"`
+	// True when entries are only counted
+	DryRun bool `form:"dry_run" json:"dry_run" xml:"dry_run"`
+	// True when unchanged entries are republished
+	Force bool `form:"force" json:"force" xml:"force"`
+	// Key prefixes the run is limited to; absent when it covers every survey
+	// object type
+	KeyPrefixes []string `form:"key_prefixes,omitempty" json:"key_prefixes,omitempty" xml:"key_prefixes,omitempty"`
+	// Lower bound on the entry write time (RFC3339)
+	ModifiedSince *string `form:"modified_since,omitempty" json:"modified_since,omitempty" xml:"modified_since,omitempty"`
+	// Upper bound on the entry write time (RFC3339)
+	ModifiedUntil *string `form:"modified_until,omitempty" json:"modified_until,omitempty" xml:"modified_until,omitempty"`
+	// Handler rate limit
+	RatePerSecond int `form:"rate_per_second" json:"rate_per_second" xml:"rate_per_second"`
+	// When the run started (RFC3339)
+	StartedAt string `form:"started_at" json:"started_at" xml:"started_at"`
+	// When the run ended (RFC3339); absent while running
+	FinishedAt *string `form:"finished_at,omitempty" json:"finished_at,omitempty" xml:"finished_at,omitempty"`
+	// Keys under the selected prefixes
+	Total int `form:"total" json:"total" xml:"total"`
+	// Keys read so far
+	Scanned int `form:"scanned" json:"scanned" xml:"scanned"`
+	// Scanned keys inside the time range
+	Matched int `form:"matched" json:"matched" xml:"matched"`
+	// Entries the handlers processed
+	Processed int `form:"processed" json:"processed" xml:"processed"`
+	// Entries that could not be read or processed
+	Failed int `form:"failed" json:"failed" xml:"failed"`
+	// First keys that failed (capped at 100)
+	FailedKeys []string `form:"failed_keys,omitempty" json:"failed_keys,omitempty" xml:"failed_keys,omitempty"`
+	// Why the run failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// GetReindexResponseBody is the type of the "survey" service "get_reindex"
+// endpoint HTTP response body.
+type GetReindexResponseBody struct {
+	// Run state
+	State string `form:"state" json:"state" xml:"state"`
+	// True when entries are only counted
+	DryRun bool `form:"dry_run" json:"dry_run" xml:"dry_run"`
+	// True when unchanged entries are republished
+	Force bool `form:"force" json:"force" xml:"force"`
+	// Key prefixes the run is limited to; absent when it covers every survey
+	// object type
+	KeyPrefixes []string `form:"key_prefixes,omitempty" json:"key_prefixes,omitempty" xml:"key_prefixes,omitempty"`
+	// Lower bound on the entry write time (RFC3339)
+	ModifiedSince *string `form:"modified_since,omitempty" json:"modified_since,omitempty" xml:"modified_since,omitempty"`
+	// Upper bound on the entry write time (RFC3339)
+	ModifiedUntil *string `form:"modified_until,omitempty" json:"modified_until,omitempty" xml:"modified_until,omitempty"`
+	// Handler rate limit
+	RatePerSecond int `form:"rate_per_second" json:"rate_per_second" xml:"rate_per_second"`
+	// When the run started (RFC3339)
+	StartedAt string `form:"started_at" json:"started_at" xml:"started_at"`
+	// When the run ended (RFC3339); absent while running
+	FinishedAt *string `form:"finished_at,omitempty" json:"finished_at,omitempty" xml:"finished_at,omitempty"`
+	// Keys under the selected prefixes
+	Total int `form:"total" json:"total" xml:"total"`
+	// Keys read so far
+	Scanned int `form:"scanned" json:"scanned" xml:"scanned"`
+	// Scanned keys inside the time range
+	Matched int `form:"matched" json:"matched" xml:"matched"`
+	// Entries the handlers processed
+	Processed int `form:"processed" json:"processed" xml:"processed"`
+	// Entries that could not be read or processed
+	Failed int `form:"failed" json:"failed" xml:"failed"`
+	// First keys that failed (capped at 100)
+	FailedKeys []string `form:"failed_keys,omitempty" json:"failed_keys,omitempty" xml:"failed_keys,omitempty"`
+	// Why the run failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// CancelReindexResponseBody is the type of the "survey" service
+// "cancel_reindex" endpoint HTTP response body.
+type CancelReindexResponseBody struct {
+	// Run state
+	State string `form:"state" json:"state" xml:"state"`
+	// True when entries are only counted
+	DryRun bool `form:"dry_run" json:"dry_run" xml:"dry_run"`
+	// True when unchanged entries are republished
+	Force bool `form:"force" json:"force" xml:"force"`
+	// Key prefixes the run is limited to; absent when it covers every survey
+	// object type
+	KeyPrefixes []string `form:"key_prefixes,omitempty" json:"key_prefixes,omitempty" xml:"key_prefixes,omitempty"`
+	// Lower bound on the entry write time (RFC3339)
+	ModifiedSince *string `form:"modified_since,omitempty" json:"modified_since,omitempty" xml:"modified_since,omitempty"`
+	// Upper bound on the entry write time (RFC3339)
+	ModifiedUntil *string `form:"modified_until,omitempty" json:"modified_until,omitempty" xml:"modified_until,omitempty"`
+	// Handler rate limit
+	RatePerSecond int `form:"rate_per_second" json:"rate_per_second" xml:"rate_per_second"`
+	// When the run started (RFC3339)
+	StartedAt string `form:"started_at" json:"started_at" xml:"started_at"`
+	// When the run ended (RFC3339); absent while running
+	FinishedAt *string `form:"finished_at,omitempty" json:"finished_at,omitempty" xml:"finished_at,omitempty"`
+	// Keys under the selected prefixes
+	Total int `form:"total" json:"total" xml:"total"`
+	// Keys read so far
+	Scanned int `form:"scanned" json:"scanned" xml:"scanned"`
+	// Scanned keys inside the time range
+	Matched int `form:"matched" json:"matched" xml:"matched"`
+	// Entries the handlers processed
+	Processed int `form:"processed" json:"processed" xml:"processed"`
+	// Entries that could not be read or processed
+	Failed int `form:"failed" json:"failed" xml:"failed"`
+	// First keys that failed (capped at 100)
+	FailedKeys []string `form:"failed_keys,omitempty" json:"failed_keys,omitempty" xml:"failed_keys,omitempty"`
+	// Why the run failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// RerunInviteEnrichmentResponseBody is the type of the "survey" service
+// "rerun_invite_enrichment" endpoint HTTP response body.
+type RerunInviteEnrichmentResponseBody struct {
+	// Acceptor email
+	Email string `form:"email" json:"email" xml:"email"`
+	// LFX username written to the survey responses
+	Username string `form:"username" json:"username" xml:"username"`
+	// Dead-lettered invite_accepted events of the email removed after the run
+	DeadLettersCleared uint64 `form:"dead_letters_cleared" json:"dead_letters_cleared" xml:"dead_letters_cleared"`
+}
+
+// StartInviteBackfillResponseBody is the type of the "survey" service
+// "start_invite_backfill" endpoint HTTP response body.
+type StartInviteBackfillResponseBody struct {
+	// Run state
+	State string `form:"state" json:"state" xml:"state"`
+	// True when candidates are only reported
+	DryRun bool `form:"dry_run" json:"dry_run" xml:"dry_run"`
+	// Surveys the run is limited to; absent when it covers every open survey
+	SurveyUids []string `form:"survey_uids,omitempty" json:"survey_uids,omitempty" xml:"survey_uids,omitempty"`
+	// Candidate rate limit
+	RatePerSecond int `form:"rate_per_second" json:"rate_per_second" xml:"rate_per_second"`
+	// When the run started (RFC3339)
+	StartedAt string `form:"started_at" json:"started_at" xml:"started_at"`
+	// When the run ended (RFC3339); absent while running
+	FinishedAt *string `form:"finished_at,omitempty" json:"finished_at,omitempty" xml:"finished_at,omitempty"`
+	// Survey responses listed
+	Total int `form:"total" json:"total" xml:"total"`
+	// Survey responses read so far
+	Scanned int `form:"scanned" json:"scanned" xml:"scanned"`
+	// Responses that need an invite
+	Candidates int `form:"candidates" json:"candidates" xml:"candidates"`
+	// Distinct emails among the candidates; at most this many invites are sent
+	Recipients int `form:"recipients" json:"recipients" xml:"recipients"`
+	// Candidates sent a new invite
+	Invited int `form:"invited" json:"invited" xml:"invited"`
+	// Candidates attached to an outstanding invite of their email
+	Attached int `form:"attached" json:"attached" xml:"attached"`
+	// Candidates whose invite could not be sent
+	Failed int `form:"failed" json:"failed" xml:"failed"`
+	// Responses passed over, by reason: no_email, has_username, already_invited,
+	// survey_not_open, no_survey_name, invites_disabled, not_synced, has_lfid or
+	// unreadable
+	Skipped map[string]int `form:"skipped" json:"skipped" xml:"skipped"`
+	// First candidates found (capped at 100)
+	CandidateResponseUids []string `form:"candidate_response_uids,omitempty" json:"candidate_response_uids,omitempty" xml:"candidate_response_uids,omitempty"`
+	// Why the run failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// GetInviteBackfillResponseBody is the type of the "survey" service
+// "get_invite_backfill" endpoint HTTP response body.
+type GetInviteBackfillResponseBody struct {
+	// Run state
+	State string `form:"state" json:"state" xml:"state"`
+	// True when candidates are only reported
+	DryRun bool `form:"dry_run" json:"dry_run" xml:"dry_run"`
+	// Surveys the run is limited to; absent when it covers every open survey
+	SurveyUids []string `form:"survey_uids,omitempty" json:"survey_uids,omitempty" xml:"survey_uids,omitempty"`
+	// Candidate rate limit
+	RatePerSecond int `form:"rate_per_second" json:"rate_per_second" xml:"rate_per_second"`
+	// When the run started (RFC3339)
+	StartedAt string `form:"started_at" json:"started_at" xml:"started_at"`
+	// When the run ended (RFC3339); absent while running
+	FinishedAt *string `form:"finished_at,omitempty" json:"finished_at,omitempty" xml:"finished_at,omitempty"`
+	// Survey responses listed
+	Total int `form:"total" json:"total" xml:"total"`
+	// Survey responses read so far
+	Scanned int `form:"scanned" json:"scanned" xml:"scanned"`
+	// Responses that need an invite
+	Candidates int `form:"candidates" json:"candidates" xml:"candidates"`
+	// Distinct emails among the candidates; at most this many invites are sent
+	Recipients int `form:"recipients" json:"recipients" xml:"recipients"`
+	// Candidates sent a new invite
+	Invited int `form:"invited" json:"invited" xml:"invited"`
+	// Candidates attached to an outstanding invite of their email
+	Attached int `form:"attached" json:"attached" xml:"attached"`
+	// Candidates whose invite could not be sent
+	Failed int `form:"failed" json:"failed" xml:"failed"`
+	// Responses passed over, by reason: no_email, has_username, already_invited,
+	// survey_not_open, no_survey_name, invites_disabled, not_synced, has_lfid or
+	// unreadable
+	Skipped map[string]int `form:"skipped" json:"skipped" xml:"skipped"`
+	// First candidates found (capped at 100)
+	CandidateResponseUids []string `form:"candidate_response_uids,omitempty" json:"candidate_response_uids,omitempty" xml:"candidate_response_uids,omitempty"`
+	// Why the run failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// CancelInviteBackfillResponseBody is the type of the "survey" service
+// "cancel_invite_backfill" endpoint HTTP response body.
+type CancelInviteBackfillResponseBody struct {
+	// Run state
+	State string `form:"state" json:"state" xml:"state"`
+	// True when candidates are only reported
+	DryRun bool `form:"dry_run" json:"dry_run" xml:"dry_run"`
+	// Surveys the run is limited to; absent when it covers every open survey
+	SurveyUids []string `form:"survey_uids,omitempty" json:"survey_uids,omitempty" xml:"survey_uids,omitempty"`
+	// Candidate rate limit
+	RatePerSecond int `form:"rate_per_second" json:"rate_per_second" xml:"rate_per_second"`
+	// When the run started (RFC3339)
+	StartedAt string `form:"started_at" json:"started_at" xml:"started_at"`
+	// When the run ended (RFC3339); absent while running
+	FinishedAt *string `form:"finished_at,omitempty" json:"finished_at,omitempty" xml:"finished_at,omitempty"`
+	// Survey responses listed
+	Total int `form:"total" json:"total" xml:"total"`
+	// Survey responses read so far
+	Scanned int `form:"scanned" json:"scanned" xml:"scanned"`
+	// Responses that need an invite
+	Candidates int `form:"candidates" json:"candidates" xml:"candidates"`
+	// Distinct emails among the candidates; at most this many invites are sent
+	Recipients int `form:"recipients" json:"recipients" xml:"recipients"`
+	// Candidates sent a new invite
+	Invited int `form:"invited" json:"invited" xml:"invited"`
+	// Candidates attached to an outstanding invite of their email
+	Attached int `form:"attached" json:"attached" xml:"attached"`
+	// Candidates whose invite could not be sent
+	Failed int `form:"failed" json:"failed" xml:"failed"`
+	// Responses passed over, by reason: no_email, has_username, already_invited,
+	// survey_not_open, no_survey_name, invites_disabled, not_synced, has_lfid or
+	// unreadable
+	Skipped map[string]int `form:"skipped" json:"skipped" xml:"skipped"`
+	// First candidates found (capped at 100)
+	CandidateResponseUids []string `form:"candidate_response_uids,omitempty" json:"candidate_response_uids,omitempty" xml:"candidate_response_uids,omitempty"`
+	// Why the run failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
 // ScheduleSurveyBadRequestResponseBody is the type of the "survey" service
 // "schedule_survey" endpoint HTTP response body for the "BadRequest" error.
 type ScheduleSurveyBadRequestResponseBody struct {
@@ -424,6 +1039,8 @@ type ScheduleSurveyBadRequestResponseBody struct {
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // ScheduleSurveyForbiddenResponseBody is the type of the "survey" service
@@ -471,6 +1088,8 @@ type GetSurveyBadRequestResponseBody struct {
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // GetSurveyForbiddenResponseBody is the type of the "survey" service
@@ -525,6 +1144,8 @@ type UpdateSurveyBadRequestResponseBody struct {
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // UpdateSurveyForbiddenResponseBody is the type of the "survey" service
@@ -581,6 +1202,8 @@ type DeleteSurveyBadRequestResponseBody struct {
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // DeleteSurveyForbiddenResponseBody is the type of the "survey" service
@@ -637,6 +1260,8 @@ type BulkResendSurveyBadRequestResponseBody struct {
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // BulkResendSurveyForbiddenResponseBody is the type of the "survey" service
@@ -694,6 +1319,8 @@ type PreviewSendSurveyBadRequestResponseBody struct {
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // PreviewSendSurveyForbiddenResponseBody is the type of the "survey" service
@@ -752,6 +1379,8 @@ type SendMissingRecipientsBadRequestResponseBody struct {
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // SendMissingRecipientsForbiddenResponseBody is the type of the "survey"
@@ -812,6 +1441,8 @@ type DeleteSurveyResponseBadRequestResponseBody struct {
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // DeleteSurveyResponseForbiddenResponseBody is the type of the "survey"
@@ -872,6 +1503,8 @@ type ResendSurveyResponseBadRequestResponseBody struct {
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // ResendSurveyResponseForbiddenResponseBody is the type of the "survey"
@@ -932,6 +1565,8 @@ type DeleteRecipientGroupBadRequestResponseBody struct {
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // DeleteRecipientGroupForbiddenResponseBody is the type of the "survey"
@@ -991,6 +1626,8 @@ type CreateExclusionBadRequestResponseBody struct {
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // CreateExclusionForbiddenResponseBody is the type of the "survey" service
@@ -1028,6 +1665,8 @@ type DeleteExclusionBadRequestResponseBody struct {
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // DeleteExclusionForbiddenResponseBody is the type of the "survey" service
@@ -1065,6 +1704,8 @@ type GetExclusionBadRequestResponseBody struct {
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // GetExclusionForbiddenResponseBody is the type of the "survey" service
@@ -1112,6 +1753,8 @@ type DeleteExclusionByIDBadRequestResponseBody struct {
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // DeleteExclusionByIDForbiddenResponseBody is the type of the "survey" service
@@ -1152,6 +1795,8 @@ type ListSurveyResponsesBadRequestResponseBody struct {
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
 // ListSurveyResponsesForbiddenResponseBody is the type of the "survey" service
@@ -1203,1417 +1848,5401 @@ type ListSurveyResponsesUnauthorizedResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// ValidateEmailBadRequestResponseBody is the type of the "survey" service
-// "validate_email" endpoint HTTP response body for the "BadRequest" error.
-type ValidateEmailBadRequestResponseBody struct {
+// CreateSurveyResponseBadRequestResponseBody is the type of the "survey"
+// service "create_survey_response" endpoint HTTP response body for the
+// "BadRequest" error.
+type CreateSurveyResponseBadRequestResponseBody struct {
 	// HTTP status code
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
-// ValidateEmailForbiddenResponseBody is the type of the "survey" service
-// "validate_email" endpoint HTTP response body for the "Forbidden" error.
-type ValidateEmailForbiddenResponseBody struct {
+// CreateSurveyResponseForbiddenResponseBody is the type of the "survey"
+// service "create_survey_response" endpoint HTTP response body for the
+// "Forbidden" error.
+type CreateSurveyResponseForbiddenResponseBody struct {
 	// HTTP status code
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// ValidateEmailInternalServerErrorResponseBody is the type of the "survey"
-// service "validate_email" endpoint HTTP response body for the
-// "InternalServerError" error.
-type ValidateEmailInternalServerErrorResponseBody struct {
+// CreateSurveyResponseInternalServerErrorResponseBody is the type of the
+// "survey" service "create_survey_response" endpoint HTTP response body for
+// the "InternalServerError" error.
+type CreateSurveyResponseInternalServerErrorResponseBody struct {
 	// HTTP status code
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// ValidateEmailUnauthorizedResponseBody is the type of the "survey" service
-// "validate_email" endpoint HTTP response body for the "Unauthorized" error.
-type ValidateEmailUnauthorizedResponseBody struct {
+// CreateSurveyResponseNotFoundResponseBody is the type of the "survey" service
+// "create_survey_response" endpoint HTTP response body for the "NotFound"
+// error.
+type CreateSurveyResponseNotFoundResponseBody struct {
 	// HTTP status code
 	Code string `form:"code" json:"code" xml:"code"`
 	// Error message
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// SurveyCommitteeResponseBody is used to define fields on response body types.
-type SurveyCommitteeResponseBody struct {
-	// Committee name
-	CommitteeName *string `form:"committee_name,omitempty" json:"committee_name,omitempty" xml:"committee_name,omitempty"`
-	// Committee UID
-	CommitteeUID *string `form:"committee_uid,omitempty" json:"committee_uid,omitempty" xml:"committee_uid,omitempty"`
-	// Project UID
-	ProjectUID *string `form:"project_uid,omitempty" json:"project_uid,omitempty" xml:"project_uid,omitempty"`
-	// Project name
-	ProjectName *string `form:"project_name,omitempty" json:"project_name,omitempty" xml:"project_name,omitempty"`
-	// Survey URL for this committee
-	SurveyURL *string `form:"survey_url,omitempty" json:"survey_url,omitempty" xml:"survey_url,omitempty"`
-	// Total recipients for this committee
-	TotalRecipients *int `form:"total_recipients,omitempty" json:"total_recipients,omitempty" xml:"total_recipients,omitempty"`
-	// Total responses for this committee
-	TotalResponses *int `form:"total_responses,omitempty" json:"total_responses,omitempty" xml:"total_responses,omitempty"`
-	// NPS value for this committee
-	NpsValue *float64 `form:"nps_value,omitempty" json:"nps_value,omitempty" xml:"nps_value,omitempty"`
+// CreateSurveyResponseServiceUnavailableResponseBody is the type of the
+// "survey" service "create_survey_response" endpoint HTTP response body for
+// the "ServiceUnavailable" error.
+type CreateSurveyResponseServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// LFXProjectResponseBody is used to define fields on response body types.
-type LFXProjectResponseBody struct {
-	// Project ID
-	ID string `form:"id" json:"id" xml:"id"`
-	// Project name
-	Name string `form:"name" json:"name" xml:"name"`
-	// Project slug
-	Slug string `form:"slug" json:"slug" xml:"slug"`
-	// Project status/stage
-	Status string `form:"status" json:"status" xml:"status"`
-	// Project logo URL
-	LogoURL *string `form:"logo_url,omitempty" json:"logo_url,omitempty" xml:"logo_url,omitempty"`
+// CreateSurveyResponseUnauthorizedResponseBody is the type of the "survey"
+// service "create_survey_response" endpoint HTTP response body for the
+// "Unauthorized" error.
+type CreateSurveyResponseUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// ExcludedCommitteeResponseBody is used to define fields on response body
-// types.
-type ExcludedCommitteeResponseBody struct {
-	// Project UID
-	ProjectUID string `form:"project_uid" json:"project_uid" xml:"project_uid"`
-	// Project name
-	ProjectName string `form:"project_name" json:"project_name" xml:"project_name"`
-	// Committee UID
-	CommitteeUID string `form:"committee_uid" json:"committee_uid" xml:"committee_uid"`
-	// Committee name
-	CommitteeName string `form:"committee_name" json:"committee_name" xml:"committee_name"`
-	// Committee category
-	CommitteeCategory string `form:"committee_category" json:"committee_category" xml:"committee_category"`
+// UpdateSurveyResponseBadRequestResponseBody is the type of the "survey"
+// service "update_survey_response" endpoint HTTP response body for the
+// "BadRequest" error.
+type UpdateSurveyResponseBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
 }
 
-// ITXPreviewRecipientResponseBody is used to define fields on response body
-// types.
-type ITXPreviewRecipientResponseBody struct {
-	// LF user ID
-	UserID string `form:"user_id" json:"user_id" xml:"user_id"`
-	// User full name
-	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// User first name
-	FirstName *string `form:"first_name,omitempty" json:"first_name,omitempty" xml:"first_name,omitempty"`
-	// User last name
-	LastName *string `form:"last_name,omitempty" json:"last_name,omitempty" xml:"last_name,omitempty"`
-	// Linux Foundation ID
-	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
-	// Email address
-	Email string `form:"email" json:"email" xml:"email"`
-	// Role in committee
-	Role *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+// UpdateSurveyResponseForbiddenResponseBody is the type of the "survey"
+// service "update_survey_response" endpoint HTTP response body for the
+// "Forbidden" error.
+type UpdateSurveyResponseForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// ExclusionUserResponseBody is used to define fields on response body types.
-type ExclusionUserResponseBody struct {
-	// User ID
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Username
-	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
-	// User emails
-	Emails []*UserEmailResponseBody `form:"emails,omitempty" json:"emails,omitempty" xml:"emails,omitempty"`
+// UpdateSurveyResponseInternalServerErrorResponseBody is the type of the
+// "survey" service "update_survey_response" endpoint HTTP response body for
+// the "InternalServerError" error.
+type UpdateSurveyResponseInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// UserEmailResponseBody is used to define fields on response body types.
-type UserEmailResponseBody struct {
-	// Email ID
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Email address
-	EmailAddress *string `form:"email_address,omitempty" json:"email_address,omitempty" xml:"email_address,omitempty"`
-	// Whether this is the primary email
-	IsPrimary *bool `form:"is_primary,omitempty" json:"is_primary,omitempty" xml:"is_primary,omitempty"`
+// UpdateSurveyResponseNotFoundResponseBody is the type of the "survey" service
+// "update_survey_response" endpoint HTTP response body for the "NotFound"
+// error.
+type UpdateSurveyResponseNotFoundResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// SurveyResponseItemResponseBody is used to define fields on response body
-// types.
-type SurveyResponseItemResponseBody struct {
-	// Response identifier
-	ID string `form:"id" json:"id" xml:"id"`
-	// Survey identifier
-	SurveyUID string `form:"survey_uid" json:"survey_uid" xml:"survey_uid"`
-	// Personal survey link for this recipient
-	SurveyLink *string `form:"survey_link,omitempty" json:"survey_link,omitempty" xml:"survey_link,omitempty"`
-	// Committee UID (V2)
-	CommitteeUID *string `form:"committee_uid,omitempty" json:"committee_uid,omitempty" xml:"committee_uid,omitempty"`
-	// Recipient email address
-	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
-	// Recipient first name
-	FirstName *string `form:"first_name,omitempty" json:"first_name,omitempty" xml:"first_name,omitempty"`
-	// Recipient last name
-	LastName *string `form:"last_name,omitempty" json:"last_name,omitempty" xml:"last_name,omitempty"`
-	// Linux Foundation username
-	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
-	// Recipient's role in the committee
-	Role *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
-	// Recipient's job title
-	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
-	// Recipient's membership tier
-	MembershipTier *string `form:"membership_tier,omitempty" json:"membership_tier,omitempty" xml:"membership_tier,omitempty"`
-	// Recipient's voting status
-	VotingStatus *string `form:"voting_status,omitempty" json:"voting_status,omitempty" xml:"voting_status,omitempty"`
-	// Recipient's organization
-	Organization *SurveyResponseOrgResponseBody `form:"organization,omitempty" json:"organization,omitempty" xml:"organization,omitempty"`
-	// Project this response belongs to
-	Project *SurveyResponseProjResponseBody `form:"project,omitempty" json:"project,omitempty" xml:"project,omitempty"`
-	// Response delivery/completion status
-	ResponseStatus *string `form:"response_status,omitempty" json:"response_status,omitempty" xml:"response_status,omitempty"`
-	// When the response record was created (RFC3339)
-	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
-	// When the recipient submitted their response (RFC3339)
-	ResponseDatetime *string `form:"response_datetime,omitempty" json:"response_datetime,omitempty" xml:"response_datetime,omitempty"`
-	// Last time a survey email was received (RFC3339)
-	LastReceivedTime *string `form:"last_received_time,omitempty" json:"last_received_time,omitempty" xml:"last_received_time,omitempty"`
-	// Number of automated reminder emails received
-	NumAutomatedRemindersReceived *int `form:"num_automated_reminders_received,omitempty" json:"num_automated_reminders_received,omitempty" xml:"num_automated_reminders_received,omitempty"`
-	// NPS score given by the recipient (0-10)
-	NpsValue *float64 `form:"nps_value,omitempty" json:"nps_value,omitempty" xml:"nps_value,omitempty"`
-	// SurveyMonkey respondent identifier
-	SurveyMonkeyRespondentID *string `form:"survey_monkey_respondent_id,omitempty" json:"survey_monkey_respondent_id,omitempty" xml:"survey_monkey_respondent_id,omitempty"`
-	// Per-question answers submitted by the recipient
-	SurveyMonkeyQuestionAnswers []*SurveyQuestionAnswerResponseBody `form:"survey_monkey_question_answers,omitempty" json:"survey_monkey_question_answers,omitempty" xml:"survey_monkey_question_answers,omitempty"`
-	// SES message identifier
-	SesMessageID *string `form:"ses_message_id,omitempty" json:"ses_message_id,omitempty" xml:"ses_message_id,omitempty"`
-	// Whether SES delivery succeeded
-	SesDeliverySuccessful *bool `form:"ses_delivery_successful,omitempty" json:"ses_delivery_successful,omitempty" xml:"ses_delivery_successful,omitempty"`
-	// SES bounce type (Undetermined, Permanent, Transient)
-	SesBounceType *string `form:"ses_bounce_type,omitempty" json:"ses_bounce_type,omitempty" xml:"ses_bounce_type,omitempty"`
-	// SES bounce subtype
-	SesBounceSubtype *string `form:"ses_bounce_subtype,omitempty" json:"ses_bounce_subtype,omitempty" xml:"ses_bounce_subtype,omitempty"`
-	// SES bounce diagnostic code
-	SesBounceDiagnosticCode *string `form:"ses_bounce_diagnostic_code,omitempty" json:"ses_bounce_diagnostic_code,omitempty" xml:"ses_bounce_diagnostic_code,omitempty"`
-	// Whether a spam complaint was filed
-	SesComplaintExists *bool `form:"ses_complaint_exists,omitempty" json:"ses_complaint_exists,omitempty" xml:"ses_complaint_exists,omitempty"`
-	// SES complaint type
-	SesComplaintType *string `form:"ses_complaint_type,omitempty" json:"ses_complaint_type,omitempty" xml:"ses_complaint_type,omitempty"`
-	// When the SES complaint was filed (RFC3339)
-	SesComplaintDate *string `form:"ses_complaint_date,omitempty" json:"ses_complaint_date,omitempty" xml:"ses_complaint_date,omitempty"`
-	// Whether the recipient opened the survey email
-	SesEmailOpened *bool `form:"ses_email_opened,omitempty" json:"ses_email_opened,omitempty" xml:"ses_email_opened,omitempty"`
-	// Last time the email was opened (RFC3339)
-	SesEmailOpenedLastTime *string `form:"ses_email_opened_last_time,omitempty" json:"ses_email_opened_last_time,omitempty" xml:"ses_email_opened_last_time,omitempty"`
-	// Whether the recipient clicked the survey link
-	SesLinkClicked *bool `form:"ses_link_clicked,omitempty" json:"ses_link_clicked,omitempty" xml:"ses_link_clicked,omitempty"`
-	// Last time the survey link was clicked (RFC3339)
-	SesLinkClickedLastTime *string `form:"ses_link_clicked_last_time,omitempty" json:"ses_link_clicked_last_time,omitempty" xml:"ses_link_clicked_last_time,omitempty"`
+// UpdateSurveyResponseServiceUnavailableResponseBody is the type of the
+// "survey" service "update_survey_response" endpoint HTTP response body for
+// the "ServiceUnavailable" error.
+type UpdateSurveyResponseServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// SurveyResponseOrgResponseBody is used to define fields on response body
-// types.
-type SurveyResponseOrgResponseBody struct {
-	// Organization ID
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Organization name
-	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+// UpdateSurveyResponseUnauthorizedResponseBody is the type of the "survey"
+// service "update_survey_response" endpoint HTTP response body for the
+// "Unauthorized" error.
+type UpdateSurveyResponseUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
 }
 
-// SurveyResponseProjResponseBody is used to define fields on response body
-// types.
-type SurveyResponseProjResponseBody struct {
-	// Project UID (V2)
-	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
-	// Project name
-	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+// ListSurveyInvitesBadRequestResponseBody is the type of the "survey" service
+// "list_survey_invites" endpoint HTTP response body for the "BadRequest" error.
+type ListSurveyInvitesBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// ListSurveyInvitesForbiddenResponseBody is the type of the "survey" service
+// "list_survey_invites" endpoint HTTP response body for the "Forbidden" error.
+type ListSurveyInvitesForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListSurveyInvitesInternalServerErrorResponseBody is the type of the "survey"
+// service "list_survey_invites" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ListSurveyInvitesInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListSurveyInvitesNotFoundResponseBody is the type of the "survey" service
+// "list_survey_invites" endpoint HTTP response body for the "NotFound" error.
+type ListSurveyInvitesNotFoundResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListSurveyInvitesServiceUnavailableResponseBody is the type of the "survey"
+// service "list_survey_invites" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type ListSurveyInvitesServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListSurveyInvitesUnauthorizedResponseBody is the type of the "survey"
+// service "list_survey_invites" endpoint HTTP response body for the
+// "Unauthorized" error.
+type ListSurveyInvitesUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetSurveyInviteSettingsBadRequestResponseBody is the type of the "survey"
+// service "get_survey_invite_settings" endpoint HTTP response body for the
+// "BadRequest" error.
+type GetSurveyInviteSettingsBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// GetSurveyInviteSettingsForbiddenResponseBody is the type of the "survey"
+// service "get_survey_invite_settings" endpoint HTTP response body for the
+// "Forbidden" error.
+type GetSurveyInviteSettingsForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetSurveyInviteSettingsInternalServerErrorResponseBody is the type of the
+// "survey" service "get_survey_invite_settings" endpoint HTTP response body
+// for the "InternalServerError" error.
+type GetSurveyInviteSettingsInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetSurveyInviteSettingsNotFoundResponseBody is the type of the "survey"
+// service "get_survey_invite_settings" endpoint HTTP response body for the
+// "NotFound" error.
+type GetSurveyInviteSettingsNotFoundResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetSurveyInviteSettingsServiceUnavailableResponseBody is the type of the
+// "survey" service "get_survey_invite_settings" endpoint HTTP response body
+// for the "ServiceUnavailable" error.
+type GetSurveyInviteSettingsServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetSurveyInviteSettingsUnauthorizedResponseBody is the type of the "survey"
+// service "get_survey_invite_settings" endpoint HTTP response body for the
+// "Unauthorized" error.
+type GetSurveyInviteSettingsUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpdateSurveyInviteSettingsBadRequestResponseBody is the type of the "survey"
+// service "update_survey_invite_settings" endpoint HTTP response body for the
+// "BadRequest" error.
+type UpdateSurveyInviteSettingsBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// UpdateSurveyInviteSettingsForbiddenResponseBody is the type of the "survey"
+// service "update_survey_invite_settings" endpoint HTTP response body for the
+// "Forbidden" error.
+type UpdateSurveyInviteSettingsForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpdateSurveyInviteSettingsInternalServerErrorResponseBody is the type of the
+// "survey" service "update_survey_invite_settings" endpoint HTTP response body
+// for the "InternalServerError" error.
+type UpdateSurveyInviteSettingsInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpdateSurveyInviteSettingsServiceUnavailableResponseBody is the type of the
+// "survey" service "update_survey_invite_settings" endpoint HTTP response body
+// for the "ServiceUnavailable" error.
+type UpdateSurveyInviteSettingsServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpdateSurveyInviteSettingsUnauthorizedResponseBody is the type of the
+// "survey" service "update_survey_invite_settings" endpoint HTTP response body
+// for the "Unauthorized" error.
+type UpdateSurveyInviteSettingsUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteSurveyInviteSettingsBadRequestResponseBody is the type of the "survey"
+// service "delete_survey_invite_settings" endpoint HTTP response body for the
+// "BadRequest" error.
+type DeleteSurveyInviteSettingsBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// DeleteSurveyInviteSettingsForbiddenResponseBody is the type of the "survey"
+// service "delete_survey_invite_settings" endpoint HTTP response body for the
+// "Forbidden" error.
+type DeleteSurveyInviteSettingsForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteSurveyInviteSettingsInternalServerErrorResponseBody is the type of the
+// "survey" service "delete_survey_invite_settings" endpoint HTTP response body
+// for the "InternalServerError" error.
+type DeleteSurveyInviteSettingsInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteSurveyInviteSettingsNotFoundResponseBody is the type of the "survey"
+// service "delete_survey_invite_settings" endpoint HTTP response body for the
+// "NotFound" error.
+type DeleteSurveyInviteSettingsNotFoundResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteSurveyInviteSettingsServiceUnavailableResponseBody is the type of the
+// "survey" service "delete_survey_invite_settings" endpoint HTTP response body
+// for the "ServiceUnavailable" error.
+type DeleteSurveyInviteSettingsServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteSurveyInviteSettingsUnauthorizedResponseBody is the type of the
+// "survey" service "delete_survey_invite_settings" endpoint HTTP response body
+// for the "Unauthorized" error.
+type DeleteSurveyInviteSettingsUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetProjectInviteSettingsBadRequestResponseBody is the type of the "survey"
+// service "get_project_invite_settings" endpoint HTTP response body for the
+// "BadRequest" error.
+type GetProjectInviteSettingsBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// GetProjectInviteSettingsForbiddenResponseBody is the type of the "survey"
+// service "get_project_invite_settings" endpoint HTTP response body for the
+// "Forbidden" error.
+type GetProjectInviteSettingsForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetProjectInviteSettingsInternalServerErrorResponseBody is the type of the
+// "survey" service "get_project_invite_settings" endpoint HTTP response body
+// for the "InternalServerError" error.
+type GetProjectInviteSettingsInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetProjectInviteSettingsNotFoundResponseBody is the type of the "survey"
+// service "get_project_invite_settings" endpoint HTTP response body for the
+// "NotFound" error.
+type GetProjectInviteSettingsNotFoundResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetProjectInviteSettingsServiceUnavailableResponseBody is the type of the
+// "survey" service "get_project_invite_settings" endpoint HTTP response body
+// for the "ServiceUnavailable" error.
+type GetProjectInviteSettingsServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetProjectInviteSettingsUnauthorizedResponseBody is the type of the "survey"
+// service "get_project_invite_settings" endpoint HTTP response body for the
+// "Unauthorized" error.
+type GetProjectInviteSettingsUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpdateProjectInviteSettingsBadRequestResponseBody is the type of the
+// "survey" service "update_project_invite_settings" endpoint HTTP response
+// body for the "BadRequest" error.
+type UpdateProjectInviteSettingsBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// UpdateProjectInviteSettingsForbiddenResponseBody is the type of the "survey"
+// service "update_project_invite_settings" endpoint HTTP response body for the
+// "Forbidden" error.
+type UpdateProjectInviteSettingsForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpdateProjectInviteSettingsInternalServerErrorResponseBody is the type of
+// the "survey" service "update_project_invite_settings" endpoint HTTP response
+// body for the "InternalServerError" error.
+type UpdateProjectInviteSettingsInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpdateProjectInviteSettingsServiceUnavailableResponseBody is the type of the
+// "survey" service "update_project_invite_settings" endpoint HTTP response
+// body for the "ServiceUnavailable" error.
+type UpdateProjectInviteSettingsServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpdateProjectInviteSettingsUnauthorizedResponseBody is the type of the
+// "survey" service "update_project_invite_settings" endpoint HTTP response
+// body for the "Unauthorized" error.
+type UpdateProjectInviteSettingsUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteProjectInviteSettingsBadRequestResponseBody is the type of the
+// "survey" service "delete_project_invite_settings" endpoint HTTP response
+// body for the "BadRequest" error.
+type DeleteProjectInviteSettingsBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// DeleteProjectInviteSettingsForbiddenResponseBody is the type of the "survey"
+// service "delete_project_invite_settings" endpoint HTTP response body for the
+// "Forbidden" error.
+type DeleteProjectInviteSettingsForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteProjectInviteSettingsInternalServerErrorResponseBody is the type of
+// the "survey" service "delete_project_invite_settings" endpoint HTTP response
+// body for the "InternalServerError" error.
+type DeleteProjectInviteSettingsInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteProjectInviteSettingsNotFoundResponseBody is the type of the "survey"
+// service "delete_project_invite_settings" endpoint HTTP response body for the
+// "NotFound" error.
+type DeleteProjectInviteSettingsNotFoundResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteProjectInviteSettingsServiceUnavailableResponseBody is the type of the
+// "survey" service "delete_project_invite_settings" endpoint HTTP response
+// body for the "ServiceUnavailable" error.
+type DeleteProjectInviteSettingsServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteProjectInviteSettingsUnauthorizedResponseBody is the type of the
+// "survey" service "delete_project_invite_settings" endpoint HTTP response
+// body for the "Unauthorized" error.
+type DeleteProjectInviteSettingsUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetSurveySettingsBadRequestResponseBody is the type of the "survey" service
+// "get_survey_settings" endpoint HTTP response body for the "BadRequest" error.
+type GetSurveySettingsBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// GetSurveySettingsForbiddenResponseBody is the type of the "survey" service
+// "get_survey_settings" endpoint HTTP response body for the "Forbidden" error.
+type GetSurveySettingsForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetSurveySettingsInternalServerErrorResponseBody is the type of the "survey"
+// service "get_survey_settings" endpoint HTTP response body for the
+// "InternalServerError" error.
+type GetSurveySettingsInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetSurveySettingsServiceUnavailableResponseBody is the type of the "survey"
+// service "get_survey_settings" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type GetSurveySettingsServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetSurveySettingsUnauthorizedResponseBody is the type of the "survey"
+// service "get_survey_settings" endpoint HTTP response body for the
+// "Unauthorized" error.
+type GetSurveySettingsUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpdateSurveySettingsBadRequestResponseBody is the type of the "survey"
+// service "update_survey_settings" endpoint HTTP response body for the
+// "BadRequest" error.
+type UpdateSurveySettingsBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// UpdateSurveySettingsConflictResponseBody is the type of the "survey" service
+// "update_survey_settings" endpoint HTTP response body for the "Conflict"
+// error.
+type UpdateSurveySettingsConflictResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpdateSurveySettingsForbiddenResponseBody is the type of the "survey"
+// service "update_survey_settings" endpoint HTTP response body for the
+// "Forbidden" error.
+type UpdateSurveySettingsForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpdateSurveySettingsInternalServerErrorResponseBody is the type of the
+// "survey" service "update_survey_settings" endpoint HTTP response body for
+// the "InternalServerError" error.
+type UpdateSurveySettingsInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpdateSurveySettingsNotFoundResponseBody is the type of the "survey" service
+// "update_survey_settings" endpoint HTTP response body for the "NotFound"
+// error.
+type UpdateSurveySettingsNotFoundResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpdateSurveySettingsServiceUnavailableResponseBody is the type of the
+// "survey" service "update_survey_settings" endpoint HTTP response body for
+// the "ServiceUnavailable" error.
+type UpdateSurveySettingsServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpdateSurveySettingsUnauthorizedResponseBody is the type of the "survey"
+// service "update_survey_settings" endpoint HTTP response body for the
+// "Unauthorized" error.
+type UpdateSurveySettingsUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetSurveyResultsBadRequestResponseBody is the type of the "survey" service
+// "get_survey_results" endpoint HTTP response body for the "BadRequest" error.
+type GetSurveyResultsBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// GetSurveyResultsForbiddenResponseBody is the type of the "survey" service
+// "get_survey_results" endpoint HTTP response body for the "Forbidden" error.
+type GetSurveyResultsForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetSurveyResultsInternalServerErrorResponseBody is the type of the "survey"
+// service "get_survey_results" endpoint HTTP response body for the
+// "InternalServerError" error.
+type GetSurveyResultsInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetSurveyResultsNotFoundResponseBody is the type of the "survey" service
+// "get_survey_results" endpoint HTTP response body for the "NotFound" error.
+type GetSurveyResultsNotFoundResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetSurveyResultsServiceUnavailableResponseBody is the type of the "survey"
+// service "get_survey_results" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type GetSurveyResultsServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetSurveyResultsUnauthorizedResponseBody is the type of the "survey" service
+// "get_survey_results" endpoint HTTP response body for the "Unauthorized"
+// error.
+type GetSurveyResultsUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ValidateEmailBadRequestResponseBody is the type of the "survey" service
+// "validate_email" endpoint HTTP response body for the "BadRequest" error.
+type ValidateEmailBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// ValidateEmailForbiddenResponseBody is the type of the "survey" service
+// "validate_email" endpoint HTTP response body for the "Forbidden" error.
+type ValidateEmailForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ValidateEmailInternalServerErrorResponseBody is the type of the "survey"
+// service "validate_email" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ValidateEmailInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ValidateEmailUnauthorizedResponseBody is the type of the "survey" service
+// "validate_email" endpoint HTTP response body for the "Unauthorized" error.
+type ValidateEmailUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListSurveyTemplatesBadRequestResponseBody is the type of the "survey"
+// service "list_survey_templates" endpoint HTTP response body for the
+// "BadRequest" error.
+type ListSurveyTemplatesBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// ListSurveyTemplatesForbiddenResponseBody is the type of the "survey" service
+// "list_survey_templates" endpoint HTTP response body for the "Forbidden"
+// error.
+type ListSurveyTemplatesForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListSurveyTemplatesInternalServerErrorResponseBody is the type of the
+// "survey" service "list_survey_templates" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ListSurveyTemplatesInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListSurveyTemplatesServiceUnavailableResponseBody is the type of the
+// "survey" service "list_survey_templates" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type ListSurveyTemplatesServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListSurveyTemplatesUnauthorizedResponseBody is the type of the "survey"
+// service "list_survey_templates" endpoint HTTP response body for the
+// "Unauthorized" error.
+type ListSurveyTemplatesUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetSurveyTemplateBadRequestResponseBody is the type of the "survey" service
+// "get_survey_template" endpoint HTTP response body for the "BadRequest" error.
+type GetSurveyTemplateBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// GetSurveyTemplateForbiddenResponseBody is the type of the "survey" service
+// "get_survey_template" endpoint HTTP response body for the "Forbidden" error.
+type GetSurveyTemplateForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetSurveyTemplateInternalServerErrorResponseBody is the type of the "survey"
+// service "get_survey_template" endpoint HTTP response body for the
+// "InternalServerError" error.
+type GetSurveyTemplateInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetSurveyTemplateNotFoundResponseBody is the type of the "survey" service
+// "get_survey_template" endpoint HTTP response body for the "NotFound" error.
+type GetSurveyTemplateNotFoundResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetSurveyTemplateServiceUnavailableResponseBody is the type of the "survey"
+// service "get_survey_template" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type GetSurveyTemplateServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetSurveyTemplateUnauthorizedResponseBody is the type of the "survey"
+// service "get_survey_template" endpoint HTTP response body for the
+// "Unauthorized" error.
+type GetSurveyTemplateUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListDeadLettersBadRequestResponseBody is the type of the "survey" service
+// "list_dead_letters" endpoint HTTP response body for the "BadRequest" error.
+type ListDeadLettersBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// ListDeadLettersForbiddenResponseBody is the type of the "survey" service
+// "list_dead_letters" endpoint HTTP response body for the "Forbidden" error.
+type ListDeadLettersForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListDeadLettersInternalServerErrorResponseBody is the type of the "survey"
+// service "list_dead_letters" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ListDeadLettersInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListDeadLettersServiceUnavailableResponseBody is the type of the "survey"
+// service "list_dead_letters" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type ListDeadLettersServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListDeadLettersUnauthorizedResponseBody is the type of the "survey" service
+// "list_dead_letters" endpoint HTTP response body for the "Unauthorized" error.
+type ListDeadLettersUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetDeadLetterBadRequestResponseBody is the type of the "survey" service
+// "get_dead_letter" endpoint HTTP response body for the "BadRequest" error.
+type GetDeadLetterBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// GetDeadLetterForbiddenResponseBody is the type of the "survey" service
+// "get_dead_letter" endpoint HTTP response body for the "Forbidden" error.
+type GetDeadLetterForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetDeadLetterInternalServerErrorResponseBody is the type of the "survey"
+// service "get_dead_letter" endpoint HTTP response body for the
+// "InternalServerError" error.
+type GetDeadLetterInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetDeadLetterNotFoundResponseBody is the type of the "survey" service
+// "get_dead_letter" endpoint HTTP response body for the "NotFound" error.
+type GetDeadLetterNotFoundResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetDeadLetterServiceUnavailableResponseBody is the type of the "survey"
+// service "get_dead_letter" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type GetDeadLetterServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetDeadLetterUnauthorizedResponseBody is the type of the "survey" service
+// "get_dead_letter" endpoint HTTP response body for the "Unauthorized" error.
+type GetDeadLetterUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ReplayDeadLetterBadRequestResponseBody is the type of the "survey" service
+// "replay_dead_letter" endpoint HTTP response body for the "BadRequest" error.
+type ReplayDeadLetterBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// ReplayDeadLetterForbiddenResponseBody is the type of the "survey" service
+// "replay_dead_letter" endpoint HTTP response body for the "Forbidden" error.
+type ReplayDeadLetterForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ReplayDeadLetterInternalServerErrorResponseBody is the type of the "survey"
+// service "replay_dead_letter" endpoint HTTP response body for the
+// "InternalServerError" error.
+type ReplayDeadLetterInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ReplayDeadLetterNotFoundResponseBody is the type of the "survey" service
+// "replay_dead_letter" endpoint HTTP response body for the "NotFound" error.
+type ReplayDeadLetterNotFoundResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ReplayDeadLetterServiceUnavailableResponseBody is the type of the "survey"
+// service "replay_dead_letter" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type ReplayDeadLetterServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ReplayDeadLetterUnauthorizedResponseBody is the type of the "survey" service
+// "replay_dead_letter" endpoint HTTP response body for the "Unauthorized"
+// error.
+type ReplayDeadLetterUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// StartReindexBadRequestResponseBody is the type of the "survey" service
+// "start_reindex" endpoint HTTP response body for the "BadRequest" error.
+type StartReindexBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// StartReindexConflictResponseBody is the type of the "survey" service
+// "start_reindex" endpoint HTTP response body for the "Conflict" error.
+type StartReindexConflictResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// StartReindexForbiddenResponseBody is the type of the "survey" service
+// "start_reindex" endpoint HTTP response body for the "Forbidden" error.
+type StartReindexForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// StartReindexInternalServerErrorResponseBody is the type of the "survey"
+// service "start_reindex" endpoint HTTP response body for the
+// "InternalServerError" error.
+type StartReindexInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// StartReindexServiceUnavailableResponseBody is the type of the "survey"
+// service "start_reindex" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type StartReindexServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// StartReindexUnauthorizedResponseBody is the type of the "survey" service
+// "start_reindex" endpoint HTTP response body for the "Unauthorized" error.
+type StartReindexUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetReindexBadRequestResponseBody is the type of the "survey" service
+// "get_reindex" endpoint HTTP response body for the "BadRequest" error.
+type GetReindexBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// GetReindexForbiddenResponseBody is the type of the "survey" service
+// "get_reindex" endpoint HTTP response body for the "Forbidden" error.
+type GetReindexForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetReindexInternalServerErrorResponseBody is the type of the "survey"
+// service "get_reindex" endpoint HTTP response body for the
+// "InternalServerError" error.
+type GetReindexInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetReindexNotFoundResponseBody is the type of the "survey" service
+// "get_reindex" endpoint HTTP response body for the "NotFound" error.
+type GetReindexNotFoundResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetReindexServiceUnavailableResponseBody is the type of the "survey" service
+// "get_reindex" endpoint HTTP response body for the "ServiceUnavailable" error.
+type GetReindexServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetReindexUnauthorizedResponseBody is the type of the "survey" service
+// "get_reindex" endpoint HTTP response body for the "Unauthorized" error.
+type GetReindexUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CancelReindexBadRequestResponseBody is the type of the "survey" service
+// "cancel_reindex" endpoint HTTP response body for the "BadRequest" error.
+type CancelReindexBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// CancelReindexConflictResponseBody is the type of the "survey" service
+// "cancel_reindex" endpoint HTTP response body for the "Conflict" error.
+type CancelReindexConflictResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CancelReindexForbiddenResponseBody is the type of the "survey" service
+// "cancel_reindex" endpoint HTTP response body for the "Forbidden" error.
+type CancelReindexForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CancelReindexInternalServerErrorResponseBody is the type of the "survey"
+// service "cancel_reindex" endpoint HTTP response body for the
+// "InternalServerError" error.
+type CancelReindexInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CancelReindexServiceUnavailableResponseBody is the type of the "survey"
+// service "cancel_reindex" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type CancelReindexServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CancelReindexUnauthorizedResponseBody is the type of the "survey" service
+// "cancel_reindex" endpoint HTTP response body for the "Unauthorized" error.
+type CancelReindexUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RerunInviteEnrichmentBadRequestResponseBody is the type of the "survey"
+// service "rerun_invite_enrichment" endpoint HTTP response body for the
+// "BadRequest" error.
+type RerunInviteEnrichmentBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// RerunInviteEnrichmentForbiddenResponseBody is the type of the "survey"
+// service "rerun_invite_enrichment" endpoint HTTP response body for the
+// "Forbidden" error.
+type RerunInviteEnrichmentForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RerunInviteEnrichmentInternalServerErrorResponseBody is the type of the
+// "survey" service "rerun_invite_enrichment" endpoint HTTP response body for
+// the "InternalServerError" error.
+type RerunInviteEnrichmentInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RerunInviteEnrichmentNotFoundResponseBody is the type of the "survey"
+// service "rerun_invite_enrichment" endpoint HTTP response body for the
+// "NotFound" error.
+type RerunInviteEnrichmentNotFoundResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RerunInviteEnrichmentServiceUnavailableResponseBody is the type of the
+// "survey" service "rerun_invite_enrichment" endpoint HTTP response body for
+// the "ServiceUnavailable" error.
+type RerunInviteEnrichmentServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RerunInviteEnrichmentUnauthorizedResponseBody is the type of the "survey"
+// service "rerun_invite_enrichment" endpoint HTTP response body for the
+// "Unauthorized" error.
+type RerunInviteEnrichmentUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// StartInviteBackfillBadRequestResponseBody is the type of the "survey"
+// service "start_invite_backfill" endpoint HTTP response body for the
+// "BadRequest" error.
+type StartInviteBackfillBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// StartInviteBackfillConflictResponseBody is the type of the "survey" service
+// "start_invite_backfill" endpoint HTTP response body for the "Conflict" error.
+type StartInviteBackfillConflictResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// StartInviteBackfillForbiddenResponseBody is the type of the "survey" service
+// "start_invite_backfill" endpoint HTTP response body for the "Forbidden"
+// error.
+type StartInviteBackfillForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// StartInviteBackfillInternalServerErrorResponseBody is the type of the
+// "survey" service "start_invite_backfill" endpoint HTTP response body for the
+// "InternalServerError" error.
+type StartInviteBackfillInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// StartInviteBackfillServiceUnavailableResponseBody is the type of the
+// "survey" service "start_invite_backfill" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type StartInviteBackfillServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// StartInviteBackfillUnauthorizedResponseBody is the type of the "survey"
+// service "start_invite_backfill" endpoint HTTP response body for the
+// "Unauthorized" error.
+type StartInviteBackfillUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetInviteBackfillBadRequestResponseBody is the type of the "survey" service
+// "get_invite_backfill" endpoint HTTP response body for the "BadRequest" error.
+type GetInviteBackfillBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// GetInviteBackfillForbiddenResponseBody is the type of the "survey" service
+// "get_invite_backfill" endpoint HTTP response body for the "Forbidden" error.
+type GetInviteBackfillForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetInviteBackfillInternalServerErrorResponseBody is the type of the "survey"
+// service "get_invite_backfill" endpoint HTTP response body for the
+// "InternalServerError" error.
+type GetInviteBackfillInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetInviteBackfillNotFoundResponseBody is the type of the "survey" service
+// "get_invite_backfill" endpoint HTTP response body for the "NotFound" error.
+type GetInviteBackfillNotFoundResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetInviteBackfillServiceUnavailableResponseBody is the type of the "survey"
+// service "get_invite_backfill" endpoint HTTP response body for the
+// "ServiceUnavailable" error.
+type GetInviteBackfillServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetInviteBackfillUnauthorizedResponseBody is the type of the "survey"
+// service "get_invite_backfill" endpoint HTTP response body for the
+// "Unauthorized" error.
+type GetInviteBackfillUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CancelInviteBackfillBadRequestResponseBody is the type of the "survey"
+// service "cancel_invite_backfill" endpoint HTTP response body for the
+// "BadRequest" error.
+type CancelInviteBackfillBadRequestResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionErrorResponseBody `form:"question_errors,omitempty" json:"question_errors,omitempty" xml:"question_errors,omitempty"`
+}
+
+// CancelInviteBackfillConflictResponseBody is the type of the "survey" service
+// "cancel_invite_backfill" endpoint HTTP response body for the "Conflict"
+// error.
+type CancelInviteBackfillConflictResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CancelInviteBackfillForbiddenResponseBody is the type of the "survey"
+// service "cancel_invite_backfill" endpoint HTTP response body for the
+// "Forbidden" error.
+type CancelInviteBackfillForbiddenResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CancelInviteBackfillInternalServerErrorResponseBody is the type of the
+// "survey" service "cancel_invite_backfill" endpoint HTTP response body for
+// the "InternalServerError" error.
+type CancelInviteBackfillInternalServerErrorResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CancelInviteBackfillServiceUnavailableResponseBody is the type of the
+// "survey" service "cancel_invite_backfill" endpoint HTTP response body for
+// the "ServiceUnavailable" error.
+type CancelInviteBackfillServiceUnavailableResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CancelInviteBackfillUnauthorizedResponseBody is the type of the "survey"
+// service "cancel_invite_backfill" endpoint HTTP response body for the
+// "Unauthorized" error.
+type CancelInviteBackfillUnauthorizedResponseBody struct {
+	// HTTP status code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// SurveyCommitteeResponseBody is used to define fields on response body types.
+type SurveyCommitteeResponseBody struct {
+	// Committee name
+	CommitteeName *string `form:"committee_name,omitempty" json:"committee_name,omitempty" xml:"committee_name,omitempty"`
+	// Committee UID
+	CommitteeUID *string `form:"committee_uid,omitempty" json:"committee_uid,omitempty" xml:"committee_uid,omitempty"`
+	// Project UID
+	ProjectUID *string `form:"project_uid,omitempty" json:"project_uid,omitempty" xml:"project_uid,omitempty"`
+	// Project name
+	ProjectName *string `form:"project_name,omitempty" json:"project_name,omitempty" xml:"project_name,omitempty"`
+	// Survey URL for this committee
+	SurveyURL *string `form:"survey_url,omitempty" json:"survey_url,omitempty" xml:"survey_url,omitempty"`
+	// Total recipients for this committee
+	TotalRecipients *int `form:"total_recipients,omitempty" json:"total_recipients,omitempty" xml:"total_recipients,omitempty"`
+	// Total responses for this committee
+	TotalResponses *int `form:"total_responses,omitempty" json:"total_responses,omitempty" xml:"total_responses,omitempty"`
+	// NPS value for this committee
+	NpsValue *float64 `form:"nps_value,omitempty" json:"nps_value,omitempty" xml:"nps_value,omitempty"`
+}
+
+// QuestionErrorResponseBody is used to define fields on response body types.
+type QuestionErrorResponseBody struct {
+	// SurveyMonkey question ID
+	QuestionID string `form:"question_id" json:"question_id" xml:"question_id"`
+	// Why the answer was rejected
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// LFXProjectResponseBody is used to define fields on response body types.
+type LFXProjectResponseBody struct {
+	// Project ID
+	ID string `form:"id" json:"id" xml:"id"`
+	// Project name
+	Name string `form:"name" json:"name" xml:"name"`
+	// Project slug
+	Slug string `form:"slug" json:"slug" xml:"slug"`
+	// Project status/stage
+	Status string `form:"status" json:"status" xml:"status"`
+	// Project logo URL
+	LogoURL *string `form:"logo_url,omitempty" json:"logo_url,omitempty" xml:"logo_url,omitempty"`
+}
+
+// ExcludedCommitteeResponseBody is used to define fields on response body
+// types.
+type ExcludedCommitteeResponseBody struct {
+	// Project UID
+	ProjectUID string `form:"project_uid" json:"project_uid" xml:"project_uid"`
+	// Project name
+	ProjectName string `form:"project_name" json:"project_name" xml:"project_name"`
+	// Committee UID
+	CommitteeUID string `form:"committee_uid" json:"committee_uid" xml:"committee_uid"`
+	// Committee name
+	CommitteeName string `form:"committee_name" json:"committee_name" xml:"committee_name"`
+	// Committee category
+	CommitteeCategory string `form:"committee_category" json:"committee_category" xml:"committee_category"`
+}
+
+// ITXPreviewRecipientResponseBody is used to define fields on response body
+// types.
+type ITXPreviewRecipientResponseBody struct {
+	// LF user ID
+	UserID string `form:"user_id" json:"user_id" xml:"user_id"`
+	// User full name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// User first name
+	FirstName *string `form:"first_name,omitempty" json:"first_name,omitempty" xml:"first_name,omitempty"`
+	// User last name
+	LastName *string `form:"last_name,omitempty" json:"last_name,omitempty" xml:"last_name,omitempty"`
+	// Linux Foundation ID
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// Email address
+	Email string `form:"email" json:"email" xml:"email"`
+	// Role in committee
+	Role *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+}
+
+// ExclusionUserResponseBody is used to define fields on response body types.
+type ExclusionUserResponseBody struct {
+	// User ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Username
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// User emails
+	Emails []*UserEmailResponseBody `form:"emails,omitempty" json:"emails,omitempty" xml:"emails,omitempty"`
+}
+
+// UserEmailResponseBody is used to define fields on response body types.
+type UserEmailResponseBody struct {
+	// Email ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Email address
+	EmailAddress *string `form:"email_address,omitempty" json:"email_address,omitempty" xml:"email_address,omitempty"`
+	// Whether this is the primary email
+	IsPrimary *bool `form:"is_primary,omitempty" json:"is_primary,omitempty" xml:"is_primary,omitempty"`
+}
+
+// SurveyResponseItemResponseBody is used to define fields on response body
+// types.
+type SurveyResponseItemResponseBody struct {
+	// Response identifier
+	ID string `form:"id" json:"id" xml:"id"`
+	// Survey identifier
+	SurveyUID string `form:"survey_uid" json:"survey_uid" xml:"survey_uid"`
+	// Personal survey link for this recipient
+	SurveyLink *string `form:"survey_link,omitempty" json:"survey_link,omitempty" xml:"survey_link,omitempty"`
+	// Committee UID (V2)
+	CommitteeUID *string `form:"committee_uid,omitempty" json:"committee_uid,omitempty" xml:"committee_uid,omitempty"`
+	// Recipient email address
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// Recipient first name
+	FirstName *string `form:"first_name,omitempty" json:"first_name,omitempty" xml:"first_name,omitempty"`
+	// Recipient last name
+	LastName *string `form:"last_name,omitempty" json:"last_name,omitempty" xml:"last_name,omitempty"`
+	// Linux Foundation username
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// Recipient's role in the committee
+	Role *string `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	// Recipient's job title
+	JobTitle *string `form:"job_title,omitempty" json:"job_title,omitempty" xml:"job_title,omitempty"`
+	// Recipient's membership tier
+	MembershipTier *string `form:"membership_tier,omitempty" json:"membership_tier,omitempty" xml:"membership_tier,omitempty"`
+	// Recipient's voting status
+	VotingStatus *string `form:"voting_status,omitempty" json:"voting_status,omitempty" xml:"voting_status,omitempty"`
+	// Recipient's organization
+	Organization *SurveyResponseOrgResponseBody `form:"organization,omitempty" json:"organization,omitempty" xml:"organization,omitempty"`
+	// Project this response belongs to
+	Project *SurveyResponseProjResponseBody `form:"project,omitempty" json:"project,omitempty" xml:"project,omitempty"`
+	// Response delivery/completion status
+	ResponseStatus *string `form:"response_status,omitempty" json:"response_status,omitempty" xml:"response_status,omitempty"`
+	// When the response record was created (RFC3339)
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// When the recipient submitted their response (RFC3339)
+	ResponseDatetime *string `form:"response_datetime,omitempty" json:"response_datetime,omitempty" xml:"response_datetime,omitempty"`
+	// Last time a survey email was received (RFC3339)
+	LastReceivedTime *string `form:"last_received_time,omitempty" json:"last_received_time,omitempty" xml:"last_received_time,omitempty"`
+	// Number of automated reminder emails received
+	NumAutomatedRemindersReceived *int `form:"num_automated_reminders_received,omitempty" json:"num_automated_reminders_received,omitempty" xml:"num_automated_reminders_received,omitempty"`
+	// NPS score given by the recipient (0-10)
+	NpsValue *float64 `form:"nps_value,omitempty" json:"nps_value,omitempty" xml:"nps_value,omitempty"`
+	// SurveyMonkey respondent identifier
+	SurveyMonkeyRespondentID *string `form:"survey_monkey_respondent_id,omitempty" json:"survey_monkey_respondent_id,omitempty" xml:"survey_monkey_respondent_id,omitempty"`
+	// Per-question answers submitted by the recipient
+	SurveyMonkeyQuestionAnswers []*SurveyQuestionAnswerResponseBody `form:"survey_monkey_question_answers,omitempty" json:"survey_monkey_question_answers,omitempty" xml:"survey_monkey_question_answers,omitempty"`
+	// SES message identifier
+	SesMessageID *string `form:"ses_message_id,omitempty" json:"ses_message_id,omitempty" xml:"ses_message_id,omitempty"`
+	// Whether SES delivery succeeded
+	SesDeliverySuccessful *bool `form:"ses_delivery_successful,omitempty" json:"ses_delivery_successful,omitempty" xml:"ses_delivery_successful,omitempty"`
+	// SES bounce type (Undetermined, Permanent, Transient)
+	SesBounceType *string `form:"ses_bounce_type,omitempty" json:"ses_bounce_type,omitempty" xml:"ses_bounce_type,omitempty"`
+	// SES bounce subtype
+	SesBounceSubtype *string `form:"ses_bounce_subtype,omitempty" json:"ses_bounce_subtype,omitempty" xml:"ses_bounce_subtype,omitempty"`
+	// SES bounce diagnostic code
+	SesBounceDiagnosticCode *string `form:"ses_bounce_diagnostic_code,omitempty" json:"ses_bounce_diagnostic_code,omitempty" xml:"ses_bounce_diagnostic_code,omitempty"`
+	// Whether a spam complaint was filed
+	SesComplaintExists *bool `form:"ses_complaint_exists,omitempty" json:"ses_complaint_exists,omitempty" xml:"ses_complaint_exists,omitempty"`
+	// SES complaint type
+	SesComplaintType *string `form:"ses_complaint_type,omitempty" json:"ses_complaint_type,omitempty" xml:"ses_complaint_type,omitempty"`
+	// When the SES complaint was filed (RFC3339)
+	SesComplaintDate *string `form:"ses_complaint_date,omitempty" json:"ses_complaint_date,omitempty" xml:"ses_complaint_date,omitempty"`
+	// Whether the recipient opened the survey email
+	SesEmailOpened *bool `form:"ses_email_opened,omitempty" json:"ses_email_opened,omitempty" xml:"ses_email_opened,omitempty"`
+	// Last time the email was opened (RFC3339)
+	SesEmailOpenedLastTime *string `form:"ses_email_opened_last_time,omitempty" json:"ses_email_opened_last_time,omitempty" xml:"ses_email_opened_last_time,omitempty"`
+	// Whether the recipient clicked the survey link
+	SesLinkClicked *bool `form:"ses_link_clicked,omitempty" json:"ses_link_clicked,omitempty" xml:"ses_link_clicked,omitempty"`
+	// Last time the survey link was clicked (RFC3339)
+	SesLinkClickedLastTime *string `form:"ses_link_clicked_last_time,omitempty" json:"ses_link_clicked_last_time,omitempty" xml:"ses_link_clicked_last_time,omitempty"`
+}
+
+// SurveyResponseOrgResponseBody is used to define fields on response body
+// types.
+type SurveyResponseOrgResponseBody struct {
+	// Organization ID
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Organization name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+}
+
+// SurveyResponseProjResponseBody is used to define fields on response body
+// types.
+type SurveyResponseProjResponseBody struct {
+	// Project UID (V2)
+	UID *string `form:"uid,omitempty" json:"uid,omitempty" xml:"uid,omitempty"`
+	// Project name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+}
+
+// SurveyQuestionAnswerResponseBody is used to define fields on response body
+// types.
+type SurveyQuestionAnswerResponseBody struct {
+	// Question identifier
+	QuestionID string `form:"question_id" json:"question_id" xml:"question_id"`
+	// Question text as shown to the recipient
+	QuestionText *string `form:"question_text,omitempty" json:"question_text,omitempty" xml:"question_text,omitempty"`
+	// Question type family (e.g. rating, open_ended, single_choice)
+	QuestionFamily *string `form:"question_family,omitempty" json:"question_family,omitempty" xml:"question_family,omitempty"`
+	// Question subtype within the family
+	QuestionSubtype *string `form:"question_subtype,omitempty" json:"question_subtype,omitempty" xml:"question_subtype,omitempty"`
+	// Answers selected or entered by the recipient
+	Answers []*SurveyAnswerChoiceResponseBody `form:"answers,omitempty" json:"answers,omitempty" xml:"answers,omitempty"`
+}
+
+// SurveyAnswerChoiceResponseBody is used to define fields on response body
+// types.
+type SurveyAnswerChoiceResponseBody struct {
+	// Choice identifier (for multiple-choice questions)
+	ChoiceID *string `form:"choice_id,omitempty" json:"choice_id,omitempty" xml:"choice_id,omitempty"`
+	// Answer text (for open-ended questions or choice label)
+	Text *string `form:"text,omitempty" json:"text,omitempty" xml:"text,omitempty"`
+}
+
+// SurveyResponsePageMetaResponseBody is used to define fields on response body
+// types.
+type SurveyResponsePageMetaResponseBody struct {
+	// Opaque token for the next page; empty string on the last page
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty" xml:"page_token,omitempty"`
+	// Total number of pages
+	TotalPages *int `form:"total_pages,omitempty" json:"total_pages,omitempty" xml:"total_pages,omitempty"`
+	// Total number of responses across all pages
+	TotalResults *int `form:"total_results,omitempty" json:"total_results,omitempty" xml:"total_results,omitempty"`
+	// Number of results per page
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty" xml:"per_page,omitempty"`
+}
+
+// SurveyInviteCountsResponseBody is used to define fields on response body
+// types.
+type SurveyInviteCountsResponseBody struct {
+	// All invites
+	Total int `form:"total" json:"total" xml:"total"`
+	// Invites interrupted before the invite service replied
+	Pending int `form:"pending" json:"pending" xml:"pending"`
+	// Invites awaiting acceptance
+	Sent int `form:"sent" json:"sent" xml:"sent"`
+	// Invites the invite service rejected or did not answer
+	Failed int `form:"failed" json:"failed" xml:"failed"`
+	// Accepted invites
+	Accepted int `form:"accepted" json:"accepted" xml:"accepted"`
+	// Sent invites past their expiry
+	Expired int `form:"expired" json:"expired" xml:"expired"`
+}
+
+// SurveyInviteResponseBody is used to define fields on response body types.
+type SurveyInviteResponseBody struct {
+	// Survey response the invite was sent for
+	SurveyResponseUID string `form:"survey_response_uid" json:"survey_response_uid" xml:"survey_response_uid"`
+	// Recipient email
+	Email string `form:"email" json:"email" xml:"email"`
+	// Recipient name
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Invite status; sent invites past their expiry are reported as expired
+	Status string `form:"status" json:"status" xml:"status"`
+	// Invite service invite UID
+	InviteUID *string `form:"invite_uid,omitempty" json:"invite_uid,omitempty" xml:"invite_uid,omitempty"`
+	// Why the last send failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// When the invite was first attempted (RFC3339)
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// When the invite service accepted the invite (RFC3339)
+	SentAt *string `form:"sent_at,omitempty" json:"sent_at,omitempty" xml:"sent_at,omitempty"`
+	// When the last send failed (RFC3339)
+	FailedAt *string `form:"failed_at,omitempty" json:"failed_at,omitempty" xml:"failed_at,omitempty"`
+	// When the invite expires (RFC3339)
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+	// When the invite was accepted (RFC3339)
+	AcceptedAt *string `form:"accepted_at,omitempty" json:"accepted_at,omitempty" xml:"accepted_at,omitempty"`
+	// LFX username of the acceptor
+	AcceptedBy *string `form:"accepted_by,omitempty" json:"accepted_by,omitempty" xml:"accepted_by,omitempty"`
+	// Times the invite was re-sent after expiring unaccepted
+	Reinvites int `form:"reinvites" json:"reinvites" xml:"reinvites"`
+	// Every send of the invite, oldest first
+	Attempts []*InviteAttemptResponseBody `form:"attempts,omitempty" json:"attempts,omitempty" xml:"attempts,omitempty"`
+	// Survey response whose invite the recipient shares instead of receiving a
+	// separate one
+	AttachedTo *string `form:"attached_to,omitempty" json:"attached_to,omitempty" xml:"attached_to,omitempty"`
+	// When the invite state last changed (RFC3339)
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
+// InviteAttemptResponseBody is used to define fields on response body types.
+type InviteAttemptResponseBody struct {
+	// When the send was attempted (RFC3339)
+	At string `form:"at" json:"at" xml:"at"`
+	// Invite UID issued by a successful send
+	InviteUID *string `form:"invite_uid,omitempty" json:"invite_uid,omitempty" xml:"invite_uid,omitempty"`
+	// When the issued invite expires (RFC3339)
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+	// Why the send failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// ResultsAccessSyncResponseBody is used to define fields on response body
+// types.
+type ResultsAccessSyncResponseBody struct {
+	// Respondents granted results access
+	Granted int `form:"granted" json:"granted" xml:"granted"`
+	// Respondents whose results access was revoked
+	Revoked int `form:"revoked" json:"revoked" xml:"revoked"`
+	// Changes that failed; update the settings again to retry them
+	Failed int `form:"failed" json:"failed" xml:"failed"`
+}
+
+// SurveyQuestionResultResponseBody is used to define fields on response body
+// types.
+type SurveyQuestionResultResponseBody struct {
+	// Question identifier
+	QuestionID string `form:"question_id" json:"question_id" xml:"question_id"`
+	// Question text
+	QuestionText *string `form:"question_text,omitempty" json:"question_text,omitempty" xml:"question_text,omitempty"`
+	// Question type
+	QuestionType *string `form:"question_type,omitempty" json:"question_type,omitempty" xml:"question_type,omitempty"`
+	// Counts per answer
+	Answers []*SurveyAnswerResultResponseBody `form:"answers" json:"answers" xml:"answers"`
+}
+
+// SurveyAnswerResultResponseBody is used to define fields on response body
+// types.
+type SurveyAnswerResultResponseBody struct {
+	// Answer
+	Answer string `form:"answer" json:"answer" xml:"answer"`
+	// Number of responses with the answer
+	Count int `form:"count" json:"count" xml:"count"`
+	// Share of the question's responses with the answer
+	Percentage float64 `form:"percentage" json:"percentage" xml:"percentage"`
+	// The answer was given by too few respondents of an anonymous survey; count
+	// and percentage are zero
+	Suppressed *bool `form:"suppressed,omitempty" json:"suppressed,omitempty" xml:"suppressed,omitempty"`
+}
+
+// SurveyTemplateResponseBody is used to define fields on response body types.
+type SurveyTemplateResponseBody struct {
+	// SurveyMonkey survey ID; pass as survey_monkey_id to schedule_survey
+	ID string `form:"id" json:"id" xml:"id"`
+	// Survey title
+	Title string `form:"title" json:"title" xml:"title"`
+	// Internal nickname
+	Nickname *string `form:"nickname,omitempty" json:"nickname,omitempty" xml:"nickname,omitempty"`
+	// Survey category
+	Category *string `form:"category,omitempty" json:"category,omitempty" xml:"category,omitempty"`
+	// Survey language
+	Language *string `form:"language,omitempty" json:"language,omitempty" xml:"language,omitempty"`
+	// Number of questions
+	QuestionCount *int `form:"question_count,omitempty" json:"question_count,omitempty" xml:"question_count,omitempty"`
+	// Number of pages
+	PageCount *int `form:"page_count,omitempty" json:"page_count,omitempty" xml:"page_count,omitempty"`
+	// URL to preview the survey
+	PreviewURL *string `form:"preview_url,omitempty" json:"preview_url,omitempty" xml:"preview_url,omitempty"`
+	// URL of the survey collectors
+	CollectURL *string `form:"collect_url,omitempty" json:"collect_url,omitempty" xml:"collect_url,omitempty"`
+	// When the survey was created in SurveyMonkey
+	DateCreated *string `form:"date_created,omitempty" json:"date_created,omitempty" xml:"date_created,omitempty"`
+	// When the survey was last modified in SurveyMonkey
+	DateModified *string `form:"date_modified,omitempty" json:"date_modified,omitempty" xml:"date_modified,omitempty"`
+}
+
+// DeadLetterEntryResponseBody is used to define fields on response body types.
+type DeadLetterEntryResponseBody struct {
+	// Dead-letter stream sequence, used as the entry identifier
+	Sequence uint64 `form:"sequence" json:"sequence" xml:"sequence"`
+	// Original v1-objects KV key
+	Key string `form:"key" json:"key" xml:"key"`
+	// Original KV operation
+	Operation string `form:"operation" json:"operation" xml:"operation"`
+	// KV revision of the original event
+	Revision *uint64 `form:"revision,omitempty" json:"revision,omitempty" xml:"revision,omitempty"`
+	// Why the event was dead-lettered
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+	// Last processing error
+	Error string `form:"error" json:"error" xml:"error"`
+	// Number of delivery attempts made
+	Attempts uint64 `form:"attempts" json:"attempts" xml:"attempts"`
+	// When the event was dead-lettered (RFC3339)
+	DeadLetteredAt string `form:"dead_lettered_at" json:"dead_lettered_at" xml:"dead_lettered_at"`
+	// Original KV value (JSON or msgpack); only returned when inspecting a single
+	// entry
+	Payload []byte `form:"payload,omitempty" json:"payload,omitempty" xml:"payload,omitempty"`
+	// Original message headers; only returned when inspecting a single entry
+	Headers map[string][]string `form:"headers,omitempty" json:"headers,omitempty" xml:"headers,omitempty"`
+}
+
+// SurveyAnswerRequestBody is used to define fields on request body types.
+type SurveyAnswerRequestBody struct {
+	// SurveyMonkey question ID
+	QuestionID *string `form:"question_id,omitempty" json:"question_id,omitempty" xml:"question_id,omitempty"`
+	// Text answer for open-ended, demographic and date questions
+	AnswerText *string `form:"answer_text,omitempty" json:"answer_text,omitempty" xml:"answer_text,omitempty"`
+	// Selected choice IDs for choice and matrix questions
+	ChoiceIds []string `form:"choice_ids,omitempty" json:"choice_ids,omitempty" xml:"choice_ids,omitempty"`
+	// Rating for rating questions
+	RatingValue *int `form:"rating_value,omitempty" json:"rating_value,omitempty" xml:"rating_value,omitempty"`
+	// Answer for single choice questions with two choices
+	YesNoValue *bool `form:"yes_no_value,omitempty" json:"yes_no_value,omitempty" xml:"yes_no_value,omitempty"`
+}
+
+// NewScheduleSurveyResponseBody builds the HTTP response body from the result
+// of the "schedule_survey" endpoint of the "survey" service.
+func NewScheduleSurveyResponseBody(res *survey.SurveyScheduleResult) *ScheduleSurveyResponseBody {
+	body := &ScheduleSurveyResponseBody{
+		UID:                           res.UID,
+		SurveyMonkeyID:                res.SurveyMonkeyID,
+		IsProjectSurvey:               res.IsProjectSurvey,
+		StageFilter:                   res.StageFilter,
+		CreatorUsername:               res.CreatorUsername,
+		CreatorName:                   res.CreatorName,
+		CreatorID:                     res.CreatorID,
+		CreatedAt:                     res.CreatedAt,
+		LastModifiedAt:                res.LastModifiedAt,
+		LastModifiedBy:                res.LastModifiedBy,
+		SurveyTitle:                   res.SurveyTitle,
+		SurveyStatus:                  res.SurveyStatus,
+		ResponseStatus:                res.ResponseStatus,
+		SurveySendDate:                res.SurveySendDate,
+		SurveyCutoffDate:              res.SurveyCutoffDate,
+		SurveyReminderRateDays:        res.SurveyReminderRateDays,
+		EmailSubject:                  res.EmailSubject,
+		EmailBody:                     res.EmailBody,
+		EmailBodyText:                 res.EmailBodyText,
+		CommitteeCategory:             res.CommitteeCategory,
+		CommitteeVotingEnabled:        res.CommitteeVotingEnabled,
+		SurveyURL:                     res.SurveyURL,
+		SendImmediately:               res.SendImmediately,
+		TotalRecipients:               res.TotalRecipients,
+		TotalResponses:                res.TotalResponses,
+		IsNpsSurvey:                   res.IsNpsSurvey,
+		NpsValue:                      res.NpsValue,
+		NumPromoters:                  res.NumPromoters,
+		NumPassives:                   res.NumPassives,
+		NumDetractors:                 res.NumDetractors,
+		TotalBouncedEmails:            res.TotalBouncedEmails,
+		NumAutomatedRemindersToSend:   res.NumAutomatedRemindersToSend,
+		NumAutomatedRemindersSent:     res.NumAutomatedRemindersSent,
+		NextAutomatedReminderAt:       res.NextAutomatedReminderAt,
+		LatestAutomatedReminderSentAt: res.LatestAutomatedReminderSentAt,
+	}
+	if res.Committees != nil {
+		body.Committees = make([]*SurveyCommitteeResponseBody, len(res.Committees))
+		for i, val := range res.Committees {
+			if val == nil {
+				body.Committees[i] = nil
+				continue
+			}
+			body.Committees[i] = marshalSurveySurveyCommitteeToSurveyCommitteeResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewGetSurveyResponseBody builds the HTTP response body from the result of
+// the "get_survey" endpoint of the "survey" service.
+func NewGetSurveyResponseBody(res *survey.SurveyScheduleResult) *GetSurveyResponseBody {
+	body := &GetSurveyResponseBody{
+		UID:                           res.UID,
+		SurveyMonkeyID:                res.SurveyMonkeyID,
+		IsProjectSurvey:               res.IsProjectSurvey,
+		StageFilter:                   res.StageFilter,
+		CreatorUsername:               res.CreatorUsername,
+		CreatorName:                   res.CreatorName,
+		CreatorID:                     res.CreatorID,
+		CreatedAt:                     res.CreatedAt,
+		LastModifiedAt:                res.LastModifiedAt,
+		LastModifiedBy:                res.LastModifiedBy,
+		SurveyTitle:                   res.SurveyTitle,
+		SurveyStatus:                  res.SurveyStatus,
+		ResponseStatus:                res.ResponseStatus,
+		SurveySendDate:                res.SurveySendDate,
+		SurveyCutoffDate:              res.SurveyCutoffDate,
+		SurveyReminderRateDays:        res.SurveyReminderRateDays,
+		EmailSubject:                  res.EmailSubject,
+		EmailBody:                     res.EmailBody,
+		EmailBodyText:                 res.EmailBodyText,
+		CommitteeCategory:             res.CommitteeCategory,
+		CommitteeVotingEnabled:        res.CommitteeVotingEnabled,
+		SurveyURL:                     res.SurveyURL,
+		SendImmediately:               res.SendImmediately,
+		TotalRecipients:               res.TotalRecipients,
+		TotalResponses:                res.TotalResponses,
+		IsNpsSurvey:                   res.IsNpsSurvey,
+		NpsValue:                      res.NpsValue,
+		NumPromoters:                  res.NumPromoters,
+		NumPassives:                   res.NumPassives,
+		NumDetractors:                 res.NumDetractors,
+		TotalBouncedEmails:            res.TotalBouncedEmails,
+		NumAutomatedRemindersToSend:   res.NumAutomatedRemindersToSend,
+		NumAutomatedRemindersSent:     res.NumAutomatedRemindersSent,
+		NextAutomatedReminderAt:       res.NextAutomatedReminderAt,
+		LatestAutomatedReminderSentAt: res.LatestAutomatedReminderSentAt,
+	}
+	if res.Committees != nil {
+		body.Committees = make([]*SurveyCommitteeResponseBody, len(res.Committees))
+		for i, val := range res.Committees {
+			if val == nil {
+				body.Committees[i] = nil
+				continue
+			}
+			body.Committees[i] = marshalSurveySurveyCommitteeToSurveyCommitteeResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewUpdateSurveyResponseBody builds the HTTP response body from the result of
+// the "update_survey" endpoint of the "survey" service.
+func NewUpdateSurveyResponseBody(res *survey.SurveyScheduleResult) *UpdateSurveyResponseBody {
+	body := &UpdateSurveyResponseBody{
+		UID:                           res.UID,
+		SurveyMonkeyID:                res.SurveyMonkeyID,
+		IsProjectSurvey:               res.IsProjectSurvey,
+		StageFilter:                   res.StageFilter,
+		CreatorUsername:               res.CreatorUsername,
+		CreatorName:                   res.CreatorName,
+		CreatorID:                     res.CreatorID,
+		CreatedAt:                     res.CreatedAt,
+		LastModifiedAt:                res.LastModifiedAt,
+		LastModifiedBy:                res.LastModifiedBy,
+		SurveyTitle:                   res.SurveyTitle,
+		SurveyStatus:                  res.SurveyStatus,
+		ResponseStatus:                res.ResponseStatus,
+		SurveySendDate:                res.SurveySendDate,
+		SurveyCutoffDate:              res.SurveyCutoffDate,
+		SurveyReminderRateDays:        res.SurveyReminderRateDays,
+		EmailSubject:                  res.EmailSubject,
+		EmailBody:                     res.EmailBody,
+		EmailBodyText:                 res.EmailBodyText,
+		CommitteeCategory:             res.CommitteeCategory,
+		CommitteeVotingEnabled:        res.CommitteeVotingEnabled,
+		SurveyURL:                     res.SurveyURL,
+		SendImmediately:               res.SendImmediately,
+		TotalRecipients:               res.TotalRecipients,
+		TotalResponses:                res.TotalResponses,
+		IsNpsSurvey:                   res.IsNpsSurvey,
+		NpsValue:                      res.NpsValue,
+		NumPromoters:                  res.NumPromoters,
+		NumPassives:                   res.NumPassives,
+		NumDetractors:                 res.NumDetractors,
+		TotalBouncedEmails:            res.TotalBouncedEmails,
+		NumAutomatedRemindersToSend:   res.NumAutomatedRemindersToSend,
+		NumAutomatedRemindersSent:     res.NumAutomatedRemindersSent,
+		NextAutomatedReminderAt:       res.NextAutomatedReminderAt,
+		LatestAutomatedReminderSentAt: res.LatestAutomatedReminderSentAt,
+	}
+	if res.Committees != nil {
+		body.Committees = make([]*SurveyCommitteeResponseBody, len(res.Committees))
+		for i, val := range res.Committees {
+			if val == nil {
+				body.Committees[i] = nil
+				continue
+			}
+			body.Committees[i] = marshalSurveySurveyCommitteeToSurveyCommitteeResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewPreviewSendSurveyResponseBody builds the HTTP response body from the
+// result of the "preview_send_survey" endpoint of the "survey" service.
+func NewPreviewSendSurveyResponseBody(res *survey.PreviewSendResult) *PreviewSendSurveyResponseBody {
+	body := &PreviewSendSurveyResponseBody{}
+	if res.AffectedProjects != nil {
+		body.AffectedProjects = make([]*LFXProjectResponseBody, len(res.AffectedProjects))
+		for i, val := range res.AffectedProjects {
+			if val == nil {
+				body.AffectedProjects[i] = nil
+				continue
+			}
+			body.AffectedProjects[i] = marshalSurveyLFXProjectToLFXProjectResponseBody(val)
+		}
+	}
+	if res.AffectedCommittees != nil {
+		body.AffectedCommittees = make([]*ExcludedCommitteeResponseBody, len(res.AffectedCommittees))
+		for i, val := range res.AffectedCommittees {
+			if val == nil {
+				body.AffectedCommittees[i] = nil
+				continue
+			}
+			body.AffectedCommittees[i] = marshalSurveyExcludedCommitteeToExcludedCommitteeResponseBody(val)
+		}
+	}
+	if res.AffectedRecipients != nil {
+		body.AffectedRecipients = make([]*ITXPreviewRecipientResponseBody, len(res.AffectedRecipients))
+		for i, val := range res.AffectedRecipients {
+			if val == nil {
+				body.AffectedRecipients[i] = nil
+				continue
+			}
+			body.AffectedRecipients[i] = marshalSurveyITXPreviewRecipientToITXPreviewRecipientResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewCreateExclusionResponseBody builds the HTTP response body from the result
+// of the "create_exclusion" endpoint of the "survey" service.
+func NewCreateExclusionResponseBody(res *survey.ExclusionResult) *CreateExclusionResponseBody {
+	body := &CreateExclusionResponseBody{
+		UID:             res.UID,
+		Email:           res.Email,
+		SurveyUID:       res.SurveyUID,
+		CommitteeUID:    res.CommitteeUID,
+		GlobalExclusion: res.GlobalExclusion,
+		UserID:          res.UserID,
+	}
+	return body
+}
+
+// NewGetExclusionResponseBody builds the HTTP response body from the result of
+// the "get_exclusion" endpoint of the "survey" service.
+func NewGetExclusionResponseBody(res *survey.ExtendedExclusionResult) *GetExclusionResponseBody {
+	body := &GetExclusionResponseBody{
+		UID:             res.UID,
+		Email:           res.Email,
+		SurveyUID:       res.SurveyUID,
+		CommitteeUID:    res.CommitteeUID,
+		GlobalExclusion: res.GlobalExclusion,
+		UserID:          res.UserID,
+	}
+	if res.User != nil {
+		body.User = marshalSurveyExclusionUserToExclusionUserResponseBody(res.User)
+	}
+	return body
+}
+
+// NewListSurveyResponsesResponseBody builds the HTTP response body from the
+// result of the "list_survey_responses" endpoint of the "survey" service.
+func NewListSurveyResponsesResponseBody(res *survey.SurveyResponsesPage) *ListSurveyResponsesResponseBody {
+	body := &ListSurveyResponsesResponseBody{}
+	if res.Data != nil {
+		body.Data = make([]*SurveyResponseItemResponseBody, len(res.Data))
+		for i, val := range res.Data {
+			if val == nil {
+				body.Data[i] = nil
+				continue
+			}
+			body.Data[i] = marshalSurveySurveyResponseItemToSurveyResponseItemResponseBody(val)
+		}
+	} else {
+		body.Data = []*SurveyResponseItemResponseBody{}
+	}
+	if res.Meta != nil {
+		body.Meta = marshalSurveySurveyResponsePageMetaToSurveyResponsePageMetaResponseBody(res.Meta)
+	}
+	return body
+}
+
+// NewListSurveyInvitesResponseBody builds the HTTP response body from the
+// result of the "list_survey_invites" endpoint of the "survey" service.
+func NewListSurveyInvitesResponseBody(res *survey.SurveyInviteList) *ListSurveyInvitesResponseBody {
+	body := &ListSurveyInvitesResponseBody{}
+	if res.Counts != nil {
+		body.Counts = marshalSurveySurveyInviteCountsToSurveyInviteCountsResponseBody(res.Counts)
+	}
+	if res.Invites != nil {
+		body.Invites = make([]*SurveyInviteResponseBody, len(res.Invites))
+		for i, val := range res.Invites {
+			if val == nil {
+				body.Invites[i] = nil
+				continue
+			}
+			body.Invites[i] = marshalSurveySurveyInviteToSurveyInviteResponseBody(val)
+		}
+	} else {
+		body.Invites = []*SurveyInviteResponseBody{}
+	}
+	return body
+}
+
+// NewGetSurveyInviteSettingsResponseBody builds the HTTP response body from
+// the result of the "get_survey_invite_settings" endpoint of the "survey"
+// service.
+func NewGetSurveyInviteSettingsResponseBody(res *survey.InviteSettings) *GetSurveyInviteSettingsResponseBody {
+	body := &GetSurveyInviteSettingsResponseBody{
+		Scope:               res.Scope,
+		UID:                 res.UID,
+		Enabled:             res.Enabled,
+		Role:                res.Role,
+		ExpirationDays:      res.ExpirationDays,
+		ReturnURLTemplate:   res.ReturnURLTemplate,
+		DisplayNameFallback: res.DisplayNameFallback,
+		Locale:              res.Locale,
+		UpdatedAt:           res.UpdatedAt,
+		UpdatedBy:           res.UpdatedBy,
+	}
+	return body
+}
+
+// NewUpdateSurveyInviteSettingsResponseBody builds the HTTP response body from
+// the result of the "update_survey_invite_settings" endpoint of the "survey"
+// service.
+func NewUpdateSurveyInviteSettingsResponseBody(res *survey.InviteSettings) *UpdateSurveyInviteSettingsResponseBody {
+	body := &UpdateSurveyInviteSettingsResponseBody{
+		Scope:               res.Scope,
+		UID:                 res.UID,
+		Enabled:             res.Enabled,
+		Role:                res.Role,
+		ExpirationDays:      res.ExpirationDays,
+		ReturnURLTemplate:   res.ReturnURLTemplate,
+		DisplayNameFallback: res.DisplayNameFallback,
+		Locale:              res.Locale,
+		UpdatedAt:           res.UpdatedAt,
+		UpdatedBy:           res.UpdatedBy,
+	}
+	return body
+}
+
+// NewGetProjectInviteSettingsResponseBody builds the HTTP response body from
+// the result of the "get_project_invite_settings" endpoint of the "survey"
+// service.
+func NewGetProjectInviteSettingsResponseBody(res *survey.InviteSettings) *GetProjectInviteSettingsResponseBody {
+	body := &GetProjectInviteSettingsResponseBody{
+		Scope:               res.Scope,
+		UID:                 res.UID,
+		Enabled:             res.Enabled,
+		Role:                res.Role,
+		ExpirationDays:      res.ExpirationDays,
+		ReturnURLTemplate:   res.ReturnURLTemplate,
+		DisplayNameFallback: res.DisplayNameFallback,
+		Locale:              res.Locale,
+		UpdatedAt:           res.UpdatedAt,
+		UpdatedBy:           res.UpdatedBy,
+	}
+	return body
+}
+
+// NewUpdateProjectInviteSettingsResponseBody builds the HTTP response body
+// from the result of the "update_project_invite_settings" endpoint of the
+// "survey" service.
+func NewUpdateProjectInviteSettingsResponseBody(res *survey.InviteSettings) *UpdateProjectInviteSettingsResponseBody {
+	body := &UpdateProjectInviteSettingsResponseBody{
+		Scope:               res.Scope,
+		UID:                 res.UID,
+		Enabled:             res.Enabled,
+		Role:                res.Role,
+		ExpirationDays:      res.ExpirationDays,
+		ReturnURLTemplate:   res.ReturnURLTemplate,
+		DisplayNameFallback: res.DisplayNameFallback,
+		Locale:              res.Locale,
+		UpdatedAt:           res.UpdatedAt,
+		UpdatedBy:           res.UpdatedBy,
+	}
+	return body
+}
+
+// NewGetSurveySettingsResponseBody builds the HTTP response body from the
+// result of the "get_survey_settings" endpoint of the "survey" service.
+func NewGetSurveySettingsResponseBody(res *survey.SurveySettings) *GetSurveySettingsResponseBody {
+	body := &GetSurveySettingsResponseBody{
+		SurveyUID:    res.SurveyUID,
+		ShareResults: res.ShareResults,
+		Anonymous:    res.Anonymous,
+		UpdatedAt:    res.UpdatedAt,
+		UpdatedBy:    res.UpdatedBy,
+	}
+	if res.ResultsAccess != nil {
+		body.ResultsAccess = marshalSurveyResultsAccessSyncToResultsAccessSyncResponseBody(res.ResultsAccess)
+	}
+	return body
+}
+
+// NewUpdateSurveySettingsResponseBody builds the HTTP response body from the
+// result of the "update_survey_settings" endpoint of the "survey" service.
+func NewUpdateSurveySettingsResponseBody(res *survey.SurveySettings) *UpdateSurveySettingsResponseBody {
+	body := &UpdateSurveySettingsResponseBody{
+		SurveyUID:    res.SurveyUID,
+		ShareResults: res.ShareResults,
+		Anonymous:    res.Anonymous,
+		UpdatedAt:    res.UpdatedAt,
+		UpdatedBy:    res.UpdatedBy,
+	}
+	if res.ResultsAccess != nil {
+		body.ResultsAccess = marshalSurveyResultsAccessSyncToResultsAccessSyncResponseBody(res.ResultsAccess)
+	}
+	return body
+}
+
+// NewGetSurveyResultsResponseBody builds the HTTP response body from the
+// result of the "get_survey_results" endpoint of the "survey" service.
+func NewGetSurveyResultsResponseBody(res *survey.SurveyResults) *GetSurveyResultsResponseBody {
+	body := &GetSurveyResultsResponseBody{
+		SurveyUID:     res.SurveyUID,
+		NumRecipients: res.NumRecipients,
+		NumResponses:  res.NumResponses,
+		SurveyEndTime: res.SurveyEndTime,
+		MinCellSize:   res.MinCellSize,
+		Suppressed:    res.Suppressed,
+	}
+	if res.Questions != nil {
+		body.Questions = make([]*SurveyQuestionResultResponseBody, len(res.Questions))
+		for i, val := range res.Questions {
+			if val == nil {
+				body.Questions[i] = nil
+				continue
+			}
+			body.Questions[i] = marshalSurveySurveyQuestionResultToSurveyQuestionResultResponseBody(val)
+		}
+	} else {
+		body.Questions = []*SurveyQuestionResultResponseBody{}
+	}
+	return body
+}
+
+// NewValidateEmailResponseBody builds the HTTP response body from the result
+// of the "validate_email" endpoint of the "survey" service.
+func NewValidateEmailResponseBody(res *survey.ValidateEmailResult) *ValidateEmailResponseBody {
+	body := &ValidateEmailResponseBody{
+		Body:    res.Body,
+		Subject: res.Subject,
+	}
+	return body
+}
+
+// NewListSurveyTemplatesResponseBody builds the HTTP response body from the
+// result of the "list_survey_templates" endpoint of the "survey" service.
+func NewListSurveyTemplatesResponseBody(res *survey.SurveyTemplateList) *ListSurveyTemplatesResponseBody {
+	body := &ListSurveyTemplatesResponseBody{
+		Total:      res.Total,
+		NextOffset: res.NextOffset,
+	}
+	if res.Templates != nil {
+		body.Templates = make([]*SurveyTemplateResponseBody, len(res.Templates))
+		for i, val := range res.Templates {
+			if val == nil {
+				body.Templates[i] = nil
+				continue
+			}
+			body.Templates[i] = marshalSurveySurveyTemplateToSurveyTemplateResponseBody(val)
+		}
+	} else {
+		body.Templates = []*SurveyTemplateResponseBody{}
+	}
+	return body
+}
+
+// NewGetSurveyTemplateResponseBody builds the HTTP response body from the
+// result of the "get_survey_template" endpoint of the "survey" service.
+func NewGetSurveyTemplateResponseBody(res *survey.SurveyTemplate) *GetSurveyTemplateResponseBody {
+	body := &GetSurveyTemplateResponseBody{
+		ID:            res.ID,
+		Title:         res.Title,
+		Nickname:      res.Nickname,
+		Category:      res.Category,
+		Language:      res.Language,
+		QuestionCount: res.QuestionCount,
+		PageCount:     res.PageCount,
+		PreviewURL:    res.PreviewURL,
+		CollectURL:    res.CollectURL,
+		DateCreated:   res.DateCreated,
+		DateModified:  res.DateModified,
+	}
+	return body
+}
+
+// NewListDeadLettersResponseBody builds the HTTP response body from the result
+// of the "list_dead_letters" endpoint of the "survey" service.
+func NewListDeadLettersResponseBody(res *survey.DeadLetterList) *ListDeadLettersResponseBody {
+	body := &ListDeadLettersResponseBody{
+		NextAfter: res.NextAfter,
+	}
+	if res.Entries != nil {
+		body.Entries = make([]*DeadLetterEntryResponseBody, len(res.Entries))
+		for i, val := range res.Entries {
+			if val == nil {
+				body.Entries[i] = nil
+				continue
+			}
+			body.Entries[i] = marshalSurveyDeadLetterEntryToDeadLetterEntryResponseBody(val)
+		}
+	} else {
+		body.Entries = []*DeadLetterEntryResponseBody{}
+	}
+	return body
+}
+
+// NewGetDeadLetterResponseBody builds the HTTP response body from the result
+// of the "get_dead_letter" endpoint of the "survey" service.
+func NewGetDeadLetterResponseBody(res *survey.DeadLetterEntry) *GetDeadLetterResponseBody {
+	body := &GetDeadLetterResponseBody{
+		Sequence:       res.Sequence,
+		Key:            res.Key,
+		Operation:      res.Operation,
+		Revision:       res.Revision,
+		Reason:         res.Reason,
+		Error:          res.Error,
+		Attempts:       res.Attempts,
+		DeadLetteredAt: res.DeadLetteredAt,
+		Payload:        res.Payload,
+	}
+	if res.Headers != nil {
+		body.Headers = make(map[string][]string, len(res.Headers))
+		for key, val := range res.Headers {
+			tk := key
+			tv := make([]string, len(val))
+			for i, val := range val {
+				tv[i] = val
+			}
+			body.Headers[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewReplayDeadLetterResponseBody builds the HTTP response body from the
+// result of the "replay_dead_letter" endpoint of the "survey" service.
+func NewReplayDeadLetterResponseBody(res *survey.DeadLetterReplayResult) *ReplayDeadLetterResponseBody {
+	body := &ReplayDeadLetterResponseBody{
+		Sequence:  res.Sequence,
+		Key:       res.Key,
+		Outcome:   res.Outcome,
+		Succeeded: res.Succeeded,
+		Error:     res.Error,
+	}
+	return body
+}
+
+// NewStartReindexResponseBody builds the HTTP response body from the result of
+// the "start_reindex" endpoint of the "survey" service.
+func NewStartReindexResponseBody(res *survey.ReindexStatus) *StartReindexResponseBody {
+	body := &StartReindexResponseBody{
+		State:         res.State,
+		DryRun:        res.DryRun,
+		Force:         res.Force,
+		ModifiedSince: res.ModifiedSince,
+		ModifiedUntil: res.ModifiedUntil,
+		RatePerSecond: res.RatePerSecond,
+		StartedAt:     res.StartedAt,
+		FinishedAt:    res.FinishedAt,
+		Total:         res.Total,
+		Scanned:       res.Scanned,
+		Matched:       res.Matched,
+		Processed:     res.Processed,
+		Failed:        res.Failed,
+		Error:         res.Error,
+	}
+	if res.KeyPrefixes != nil {
+		body.KeyPrefixes = make([]string, len(res.KeyPrefixes))
+		for i, val := range res.KeyPrefixes {
+			body.KeyPrefixes[i] = val
+		}
+	}
+	if res.FailedKeys != nil {
+		body.FailedKeys = make([]string, len(res.FailedKeys))
+		for i, val := range res.FailedKeys {
+			body.FailedKeys[i] = val
+		}
+	}
+	return body
+}
+
+// NewGetReindexResponseBody builds the HTTP response body from the result of
+// the "get_reindex" endpoint of the "survey" service.
+func NewGetReindexResponseBody(res *survey.ReindexStatus) *GetReindexResponseBody {
+	body := &GetReindexResponseBody{
+		State:         res.State,
+		DryRun:        res.DryRun,
+		Force:         res.Force,
+		ModifiedSince: res.ModifiedSince,
+		ModifiedUntil: res.ModifiedUntil,
+		RatePerSecond: res.RatePerSecond,
+		StartedAt:     res.StartedAt,
+		FinishedAt:    res.FinishedAt,
+		Total:         res.Total,
+		Scanned:       res.Scanned,
+		Matched:       res.Matched,
+		Processed:     res.Processed,
+		Failed:        res.Failed,
+		Error:         res.Error,
+	}
+	if res.KeyPrefixes != nil {
+		body.KeyPrefixes = make([]string, len(res.KeyPrefixes))
+		for i, val := range res.KeyPrefixes {
+			body.KeyPrefixes[i] = val
+		}
+	}
+	if res.FailedKeys != nil {
+		body.FailedKeys = make([]string, len(res.FailedKeys))
+		for i, val := range res.FailedKeys {
+			body.FailedKeys[i] = val
+		}
+	}
+	return body
+}
+
+// NewCancelReindexResponseBody builds the HTTP response body from the result
+// of the "cancel_reindex" endpoint of the "survey" service.
+func NewCancelReindexResponseBody(res *survey.ReindexStatus) *CancelReindexResponseBody {
+	body := &CancelReindexResponseBody{
+		State:         res.State,
+		DryRun:        res.DryRun,
+		Force:         res.Force,
+		ModifiedSince: res.ModifiedSince,
+		ModifiedUntil: res.ModifiedUntil,
+		RatePerSecond: res.RatePerSecond,
+		StartedAt:     res.StartedAt,
+		FinishedAt:    res.FinishedAt,
+		Total:         res.Total,
+		Scanned:       res.Scanned,
+		Matched:       res.Matched,
+		Processed:     res.Processed,
+		Failed:        res.Failed,
+		Error:         res.Error,
+	}
+	if res.KeyPrefixes != nil {
+		body.KeyPrefixes = make([]string, len(res.KeyPrefixes))
+		for i, val := range res.KeyPrefixes {
+			body.KeyPrefixes[i] = val
+		}
+	}
+	if res.FailedKeys != nil {
+		body.FailedKeys = make([]string, len(res.FailedKeys))
+		for i, val := range res.FailedKeys {
+			body.FailedKeys[i] = val
+		}
+	}
+	return body
+}
+
+// NewRerunInviteEnrichmentResponseBody builds the HTTP response body from the
+// result of the "rerun_invite_enrichment" endpoint of the "survey" service.
+func NewRerunInviteEnrichmentResponseBody(res *survey.InviteEnrichmentResult) *RerunInviteEnrichmentResponseBody {
+	body := &RerunInviteEnrichmentResponseBody{
+		Email:              res.Email,
+		Username:           res.Username,
+		DeadLettersCleared: res.DeadLettersCleared,
+	}
+	return body
+}
+
+// NewStartInviteBackfillResponseBody builds the HTTP response body from the
+// result of the "start_invite_backfill" endpoint of the "survey" service.
+func NewStartInviteBackfillResponseBody(res *survey.InviteBackfillStatus) *StartInviteBackfillResponseBody {
+	body := &StartInviteBackfillResponseBody{
+		State:         res.State,
+		DryRun:        res.DryRun,
+		RatePerSecond: res.RatePerSecond,
+		StartedAt:     res.StartedAt,
+		FinishedAt:    res.FinishedAt,
+		Total:         res.Total,
+		Scanned:       res.Scanned,
+		Candidates:    res.Candidates,
+		Recipients:    res.Recipients,
+		Invited:       res.Invited,
+		Attached:      res.Attached,
+		Failed:        res.Failed,
+		Error:         res.Error,
+	}
+	if res.SurveyUids != nil {
+		body.SurveyUids = make([]string, len(res.SurveyUids))
+		for i, val := range res.SurveyUids {
+			body.SurveyUids[i] = val
+		}
+	}
+	if res.Skipped != nil {
+		body.Skipped = make(map[string]int, len(res.Skipped))
+		for key, val := range res.Skipped {
+			tk := key
+			tv := val
+			body.Skipped[tk] = tv
+		}
+	}
+	if res.CandidateResponseUids != nil {
+		body.CandidateResponseUids = make([]string, len(res.CandidateResponseUids))
+		for i, val := range res.CandidateResponseUids {
+			body.CandidateResponseUids[i] = val
+		}
+	}
+	return body
+}
+
+// NewGetInviteBackfillResponseBody builds the HTTP response body from the
+// result of the "get_invite_backfill" endpoint of the "survey" service.
+func NewGetInviteBackfillResponseBody(res *survey.InviteBackfillStatus) *GetInviteBackfillResponseBody {
+	body := &GetInviteBackfillResponseBody{
+		State:         res.State,
+		DryRun:        res.DryRun,
+		RatePerSecond: res.RatePerSecond,
+		StartedAt:     res.StartedAt,
+		FinishedAt:    res.FinishedAt,
+		Total:         res.Total,
+		Scanned:       res.Scanned,
+		Candidates:    res.Candidates,
+		Recipients:    res.Recipients,
+		Invited:       res.Invited,
+		Attached:      res.Attached,
+		Failed:        res.Failed,
+		Error:         res.Error,
+	}
+	if res.SurveyUids != nil {
+		body.SurveyUids = make([]string, len(res.SurveyUids))
+		for i, val := range res.SurveyUids {
+			body.SurveyUids[i] = val
+		}
+	}
+	if res.Skipped != nil {
+		body.Skipped = make(map[string]int, len(res.Skipped))
+		for key, val := range res.Skipped {
+			tk := key
+			tv := val
+			body.Skipped[tk] = tv
+		}
+	}
+	if res.CandidateResponseUids != nil {
+		body.CandidateResponseUids = make([]string, len(res.CandidateResponseUids))
+		for i, val := range res.CandidateResponseUids {
+			body.CandidateResponseUids[i] = val
+		}
+	}
+	return body
+}
+
+// NewCancelInviteBackfillResponseBody builds the HTTP response body from the
+// result of the "cancel_invite_backfill" endpoint of the "survey" service.
+func NewCancelInviteBackfillResponseBody(res *survey.InviteBackfillStatus) *CancelInviteBackfillResponseBody {
+	body := &CancelInviteBackfillResponseBody{
+		State:         res.State,
+		DryRun:        res.DryRun,
+		RatePerSecond: res.RatePerSecond,
+		StartedAt:     res.StartedAt,
+		FinishedAt:    res.FinishedAt,
+		Total:         res.Total,
+		Scanned:       res.Scanned,
+		Candidates:    res.Candidates,
+		Recipients:    res.Recipients,
+		Invited:       res.Invited,
+		Attached:      res.Attached,
+		Failed:        res.Failed,
+		Error:         res.Error,
+	}
+	if res.SurveyUids != nil {
+		body.SurveyUids = make([]string, len(res.SurveyUids))
+		for i, val := range res.SurveyUids {
+			body.SurveyUids[i] = val
+		}
+	}
+	if res.Skipped != nil {
+		body.Skipped = make(map[string]int, len(res.Skipped))
+		for key, val := range res.Skipped {
+			tk := key
+			tv := val
+			body.Skipped[tk] = tv
+		}
+	}
+	if res.CandidateResponseUids != nil {
+		body.CandidateResponseUids = make([]string, len(res.CandidateResponseUids))
+		for i, val := range res.CandidateResponseUids {
+			body.CandidateResponseUids[i] = val
+		}
+	}
+	return body
+}
+
+// NewScheduleSurveyBadRequestResponseBody builds the HTTP response body from
+// the result of the "schedule_survey" endpoint of the "survey" service.
+func NewScheduleSurveyBadRequestResponseBody(res *survey.BadRequestError) *ScheduleSurveyBadRequestResponseBody {
+	body := &ScheduleSurveyBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewScheduleSurveyForbiddenResponseBody builds the HTTP response body from
+// the result of the "schedule_survey" endpoint of the "survey" service.
+func NewScheduleSurveyForbiddenResponseBody(res *survey.ForbiddenError) *ScheduleSurveyForbiddenResponseBody {
+	body := &ScheduleSurveyForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewScheduleSurveyInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "schedule_survey" endpoint of the "survey"
+// service.
+func NewScheduleSurveyInternalServerErrorResponseBody(res *survey.InternalServerError) *ScheduleSurveyInternalServerErrorResponseBody {
+	body := &ScheduleSurveyInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewScheduleSurveyServiceUnavailableResponseBody builds the HTTP response
+// body from the result of the "schedule_survey" endpoint of the "survey"
+// service.
+func NewScheduleSurveyServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *ScheduleSurveyServiceUnavailableResponseBody {
+	body := &ScheduleSurveyServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewScheduleSurveyUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "schedule_survey" endpoint of the "survey" service.
+func NewScheduleSurveyUnauthorizedResponseBody(res *survey.UnauthorizedError) *ScheduleSurveyUnauthorizedResponseBody {
+	body := &ScheduleSurveyUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetSurveyBadRequestResponseBody builds the HTTP response body from the
+// result of the "get_survey" endpoint of the "survey" service.
+func NewGetSurveyBadRequestResponseBody(res *survey.BadRequestError) *GetSurveyBadRequestResponseBody {
+	body := &GetSurveyBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewGetSurveyForbiddenResponseBody builds the HTTP response body from the
+// result of the "get_survey" endpoint of the "survey" service.
+func NewGetSurveyForbiddenResponseBody(res *survey.ForbiddenError) *GetSurveyForbiddenResponseBody {
+	body := &GetSurveyForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetSurveyInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "get_survey" endpoint of the "survey" service.
+func NewGetSurveyInternalServerErrorResponseBody(res *survey.InternalServerError) *GetSurveyInternalServerErrorResponseBody {
+	body := &GetSurveyInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetSurveyNotFoundResponseBody builds the HTTP response body from the
+// result of the "get_survey" endpoint of the "survey" service.
+func NewGetSurveyNotFoundResponseBody(res *survey.NotFoundError) *GetSurveyNotFoundResponseBody {
+	body := &GetSurveyNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetSurveyServiceUnavailableResponseBody builds the HTTP response body
+// from the result of the "get_survey" endpoint of the "survey" service.
+func NewGetSurveyServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *GetSurveyServiceUnavailableResponseBody {
+	body := &GetSurveyServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetSurveyUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "get_survey" endpoint of the "survey" service.
+func NewGetSurveyUnauthorizedResponseBody(res *survey.UnauthorizedError) *GetSurveyUnauthorizedResponseBody {
+	body := &GetSurveyUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateSurveyBadRequestResponseBody builds the HTTP response body from the
+// result of the "update_survey" endpoint of the "survey" service.
+func NewUpdateSurveyBadRequestResponseBody(res *survey.BadRequestError) *UpdateSurveyBadRequestResponseBody {
+	body := &UpdateSurveyBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewUpdateSurveyForbiddenResponseBody builds the HTTP response body from the
+// result of the "update_survey" endpoint of the "survey" service.
+func NewUpdateSurveyForbiddenResponseBody(res *survey.ForbiddenError) *UpdateSurveyForbiddenResponseBody {
+	body := &UpdateSurveyForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateSurveyInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "update_survey" endpoint of the "survey" service.
+func NewUpdateSurveyInternalServerErrorResponseBody(res *survey.InternalServerError) *UpdateSurveyInternalServerErrorResponseBody {
+	body := &UpdateSurveyInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateSurveyNotFoundResponseBody builds the HTTP response body from the
+// result of the "update_survey" endpoint of the "survey" service.
+func NewUpdateSurveyNotFoundResponseBody(res *survey.NotFoundError) *UpdateSurveyNotFoundResponseBody {
+	body := &UpdateSurveyNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateSurveyServiceUnavailableResponseBody builds the HTTP response body
+// from the result of the "update_survey" endpoint of the "survey" service.
+func NewUpdateSurveyServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *UpdateSurveyServiceUnavailableResponseBody {
+	body := &UpdateSurveyServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateSurveyUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "update_survey" endpoint of the "survey" service.
+func NewUpdateSurveyUnauthorizedResponseBody(res *survey.UnauthorizedError) *UpdateSurveyUnauthorizedResponseBody {
+	body := &UpdateSurveyUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteSurveyBadRequestResponseBody builds the HTTP response body from the
+// result of the "delete_survey" endpoint of the "survey" service.
+func NewDeleteSurveyBadRequestResponseBody(res *survey.BadRequestError) *DeleteSurveyBadRequestResponseBody {
+	body := &DeleteSurveyBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewDeleteSurveyForbiddenResponseBody builds the HTTP response body from the
+// result of the "delete_survey" endpoint of the "survey" service.
+func NewDeleteSurveyForbiddenResponseBody(res *survey.ForbiddenError) *DeleteSurveyForbiddenResponseBody {
+	body := &DeleteSurveyForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteSurveyInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "delete_survey" endpoint of the "survey" service.
+func NewDeleteSurveyInternalServerErrorResponseBody(res *survey.InternalServerError) *DeleteSurveyInternalServerErrorResponseBody {
+	body := &DeleteSurveyInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteSurveyNotFoundResponseBody builds the HTTP response body from the
+// result of the "delete_survey" endpoint of the "survey" service.
+func NewDeleteSurveyNotFoundResponseBody(res *survey.NotFoundError) *DeleteSurveyNotFoundResponseBody {
+	body := &DeleteSurveyNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteSurveyServiceUnavailableResponseBody builds the HTTP response body
+// from the result of the "delete_survey" endpoint of the "survey" service.
+func NewDeleteSurveyServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *DeleteSurveyServiceUnavailableResponseBody {
+	body := &DeleteSurveyServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteSurveyUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "delete_survey" endpoint of the "survey" service.
+func NewDeleteSurveyUnauthorizedResponseBody(res *survey.UnauthorizedError) *DeleteSurveyUnauthorizedResponseBody {
+	body := &DeleteSurveyUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewBulkResendSurveyBadRequestResponseBody builds the HTTP response body from
+// the result of the "bulk_resend_survey" endpoint of the "survey" service.
+func NewBulkResendSurveyBadRequestResponseBody(res *survey.BadRequestError) *BulkResendSurveyBadRequestResponseBody {
+	body := &BulkResendSurveyBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewBulkResendSurveyForbiddenResponseBody builds the HTTP response body from
+// the result of the "bulk_resend_survey" endpoint of the "survey" service.
+func NewBulkResendSurveyForbiddenResponseBody(res *survey.ForbiddenError) *BulkResendSurveyForbiddenResponseBody {
+	body := &BulkResendSurveyForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewBulkResendSurveyInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "bulk_resend_survey" endpoint of the "survey"
+// service.
+func NewBulkResendSurveyInternalServerErrorResponseBody(res *survey.InternalServerError) *BulkResendSurveyInternalServerErrorResponseBody {
+	body := &BulkResendSurveyInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewBulkResendSurveyNotFoundResponseBody builds the HTTP response body from
+// the result of the "bulk_resend_survey" endpoint of the "survey" service.
+func NewBulkResendSurveyNotFoundResponseBody(res *survey.NotFoundError) *BulkResendSurveyNotFoundResponseBody {
+	body := &BulkResendSurveyNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewBulkResendSurveyServiceUnavailableResponseBody builds the HTTP response
+// body from the result of the "bulk_resend_survey" endpoint of the "survey"
+// service.
+func NewBulkResendSurveyServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *BulkResendSurveyServiceUnavailableResponseBody {
+	body := &BulkResendSurveyServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewBulkResendSurveyUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "bulk_resend_survey" endpoint of the "survey" service.
+func NewBulkResendSurveyUnauthorizedResponseBody(res *survey.UnauthorizedError) *BulkResendSurveyUnauthorizedResponseBody {
+	body := &BulkResendSurveyUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPreviewSendSurveyBadRequestResponseBody builds the HTTP response body
+// from the result of the "preview_send_survey" endpoint of the "survey"
+// service.
+func NewPreviewSendSurveyBadRequestResponseBody(res *survey.BadRequestError) *PreviewSendSurveyBadRequestResponseBody {
+	body := &PreviewSendSurveyBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewPreviewSendSurveyForbiddenResponseBody builds the HTTP response body from
+// the result of the "preview_send_survey" endpoint of the "survey" service.
+func NewPreviewSendSurveyForbiddenResponseBody(res *survey.ForbiddenError) *PreviewSendSurveyForbiddenResponseBody {
+	body := &PreviewSendSurveyForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPreviewSendSurveyInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "preview_send_survey" endpoint of the "survey"
+// service.
+func NewPreviewSendSurveyInternalServerErrorResponseBody(res *survey.InternalServerError) *PreviewSendSurveyInternalServerErrorResponseBody {
+	body := &PreviewSendSurveyInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPreviewSendSurveyNotFoundResponseBody builds the HTTP response body from
+// the result of the "preview_send_survey" endpoint of the "survey" service.
+func NewPreviewSendSurveyNotFoundResponseBody(res *survey.NotFoundError) *PreviewSendSurveyNotFoundResponseBody {
+	body := &PreviewSendSurveyNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPreviewSendSurveyServiceUnavailableResponseBody builds the HTTP response
+// body from the result of the "preview_send_survey" endpoint of the "survey"
+// service.
+func NewPreviewSendSurveyServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *PreviewSendSurveyServiceUnavailableResponseBody {
+	body := &PreviewSendSurveyServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPreviewSendSurveyUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "preview_send_survey" endpoint of the "survey"
+// service.
+func NewPreviewSendSurveyUnauthorizedResponseBody(res *survey.UnauthorizedError) *PreviewSendSurveyUnauthorizedResponseBody {
+	body := &PreviewSendSurveyUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewSendMissingRecipientsBadRequestResponseBody builds the HTTP response body
+// from the result of the "send_missing_recipients" endpoint of the "survey"
+// service.
+func NewSendMissingRecipientsBadRequestResponseBody(res *survey.BadRequestError) *SendMissingRecipientsBadRequestResponseBody {
+	body := &SendMissingRecipientsBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewSendMissingRecipientsForbiddenResponseBody builds the HTTP response body
+// from the result of the "send_missing_recipients" endpoint of the "survey"
+// service.
+func NewSendMissingRecipientsForbiddenResponseBody(res *survey.ForbiddenError) *SendMissingRecipientsForbiddenResponseBody {
+	body := &SendMissingRecipientsForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewSendMissingRecipientsInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "send_missing_recipients" endpoint of
+// the "survey" service.
+func NewSendMissingRecipientsInternalServerErrorResponseBody(res *survey.InternalServerError) *SendMissingRecipientsInternalServerErrorResponseBody {
+	body := &SendMissingRecipientsInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewSendMissingRecipientsNotFoundResponseBody builds the HTTP response body
+// from the result of the "send_missing_recipients" endpoint of the "survey"
+// service.
+func NewSendMissingRecipientsNotFoundResponseBody(res *survey.NotFoundError) *SendMissingRecipientsNotFoundResponseBody {
+	body := &SendMissingRecipientsNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewSendMissingRecipientsServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "send_missing_recipients" endpoint of
+// the "survey" service.
+func NewSendMissingRecipientsServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *SendMissingRecipientsServiceUnavailableResponseBody {
+	body := &SendMissingRecipientsServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewSendMissingRecipientsUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "send_missing_recipients" endpoint of the
+// "survey" service.
+func NewSendMissingRecipientsUnauthorizedResponseBody(res *survey.UnauthorizedError) *SendMissingRecipientsUnauthorizedResponseBody {
+	body := &SendMissingRecipientsUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteSurveyResponseBadRequestResponseBody builds the HTTP response body
+// from the result of the "delete_survey_response" endpoint of the "survey"
+// service.
+func NewDeleteSurveyResponseBadRequestResponseBody(res *survey.BadRequestError) *DeleteSurveyResponseBadRequestResponseBody {
+	body := &DeleteSurveyResponseBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewDeleteSurveyResponseForbiddenResponseBody builds the HTTP response body
+// from the result of the "delete_survey_response" endpoint of the "survey"
+// service.
+func NewDeleteSurveyResponseForbiddenResponseBody(res *survey.ForbiddenError) *DeleteSurveyResponseForbiddenResponseBody {
+	body := &DeleteSurveyResponseForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteSurveyResponseInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "delete_survey_response" endpoint of
+// the "survey" service.
+func NewDeleteSurveyResponseInternalServerErrorResponseBody(res *survey.InternalServerError) *DeleteSurveyResponseInternalServerErrorResponseBody {
+	body := &DeleteSurveyResponseInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteSurveyResponseNotFoundResponseBody builds the HTTP response body
+// from the result of the "delete_survey_response" endpoint of the "survey"
+// service.
+func NewDeleteSurveyResponseNotFoundResponseBody(res *survey.NotFoundError) *DeleteSurveyResponseNotFoundResponseBody {
+	body := &DeleteSurveyResponseNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteSurveyResponseServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "delete_survey_response" endpoint of
+// the "survey" service.
+func NewDeleteSurveyResponseServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *DeleteSurveyResponseServiceUnavailableResponseBody {
+	body := &DeleteSurveyResponseServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteSurveyResponseUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "delete_survey_response" endpoint of the
+// "survey" service.
+func NewDeleteSurveyResponseUnauthorizedResponseBody(res *survey.UnauthorizedError) *DeleteSurveyResponseUnauthorizedResponseBody {
+	body := &DeleteSurveyResponseUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewResendSurveyResponseBadRequestResponseBody builds the HTTP response body
+// from the result of the "resend_survey_response" endpoint of the "survey"
+// service.
+func NewResendSurveyResponseBadRequestResponseBody(res *survey.BadRequestError) *ResendSurveyResponseBadRequestResponseBody {
+	body := &ResendSurveyResponseBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewResendSurveyResponseForbiddenResponseBody builds the HTTP response body
+// from the result of the "resend_survey_response" endpoint of the "survey"
+// service.
+func NewResendSurveyResponseForbiddenResponseBody(res *survey.ForbiddenError) *ResendSurveyResponseForbiddenResponseBody {
+	body := &ResendSurveyResponseForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewResendSurveyResponseInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "resend_survey_response" endpoint of
+// the "survey" service.
+func NewResendSurveyResponseInternalServerErrorResponseBody(res *survey.InternalServerError) *ResendSurveyResponseInternalServerErrorResponseBody {
+	body := &ResendSurveyResponseInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewResendSurveyResponseNotFoundResponseBody builds the HTTP response body
+// from the result of the "resend_survey_response" endpoint of the "survey"
+// service.
+func NewResendSurveyResponseNotFoundResponseBody(res *survey.NotFoundError) *ResendSurveyResponseNotFoundResponseBody {
+	body := &ResendSurveyResponseNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewResendSurveyResponseServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "resend_survey_response" endpoint of
+// the "survey" service.
+func NewResendSurveyResponseServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *ResendSurveyResponseServiceUnavailableResponseBody {
+	body := &ResendSurveyResponseServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewResendSurveyResponseUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "resend_survey_response" endpoint of the
+// "survey" service.
+func NewResendSurveyResponseUnauthorizedResponseBody(res *survey.UnauthorizedError) *ResendSurveyResponseUnauthorizedResponseBody {
+	body := &ResendSurveyResponseUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteRecipientGroupBadRequestResponseBody builds the HTTP response body
+// from the result of the "delete_recipient_group" endpoint of the "survey"
+// service.
+func NewDeleteRecipientGroupBadRequestResponseBody(res *survey.BadRequestError) *DeleteRecipientGroupBadRequestResponseBody {
+	body := &DeleteRecipientGroupBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewDeleteRecipientGroupForbiddenResponseBody builds the HTTP response body
+// from the result of the "delete_recipient_group" endpoint of the "survey"
+// service.
+func NewDeleteRecipientGroupForbiddenResponseBody(res *survey.ForbiddenError) *DeleteRecipientGroupForbiddenResponseBody {
+	body := &DeleteRecipientGroupForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteRecipientGroupInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "delete_recipient_group" endpoint of
+// the "survey" service.
+func NewDeleteRecipientGroupInternalServerErrorResponseBody(res *survey.InternalServerError) *DeleteRecipientGroupInternalServerErrorResponseBody {
+	body := &DeleteRecipientGroupInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteRecipientGroupNotFoundResponseBody builds the HTTP response body
+// from the result of the "delete_recipient_group" endpoint of the "survey"
+// service.
+func NewDeleteRecipientGroupNotFoundResponseBody(res *survey.NotFoundError) *DeleteRecipientGroupNotFoundResponseBody {
+	body := &DeleteRecipientGroupNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteRecipientGroupServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "delete_recipient_group" endpoint of
+// the "survey" service.
+func NewDeleteRecipientGroupServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *DeleteRecipientGroupServiceUnavailableResponseBody {
+	body := &DeleteRecipientGroupServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteRecipientGroupUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "delete_recipient_group" endpoint of the
+// "survey" service.
+func NewDeleteRecipientGroupUnauthorizedResponseBody(res *survey.UnauthorizedError) *DeleteRecipientGroupUnauthorizedResponseBody {
+	body := &DeleteRecipientGroupUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateExclusionBadRequestResponseBody builds the HTTP response body from
+// the result of the "create_exclusion" endpoint of the "survey" service.
+func NewCreateExclusionBadRequestResponseBody(res *survey.BadRequestError) *CreateExclusionBadRequestResponseBody {
+	body := &CreateExclusionBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewCreateExclusionForbiddenResponseBody builds the HTTP response body from
+// the result of the "create_exclusion" endpoint of the "survey" service.
+func NewCreateExclusionForbiddenResponseBody(res *survey.ForbiddenError) *CreateExclusionForbiddenResponseBody {
+	body := &CreateExclusionForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateExclusionInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "create_exclusion" endpoint of the "survey"
+// service.
+func NewCreateExclusionInternalServerErrorResponseBody(res *survey.InternalServerError) *CreateExclusionInternalServerErrorResponseBody {
+	body := &CreateExclusionInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateExclusionUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "create_exclusion" endpoint of the "survey" service.
+func NewCreateExclusionUnauthorizedResponseBody(res *survey.UnauthorizedError) *CreateExclusionUnauthorizedResponseBody {
+	body := &CreateExclusionUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteExclusionBadRequestResponseBody builds the HTTP response body from
+// the result of the "delete_exclusion" endpoint of the "survey" service.
+func NewDeleteExclusionBadRequestResponseBody(res *survey.BadRequestError) *DeleteExclusionBadRequestResponseBody {
+	body := &DeleteExclusionBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewDeleteExclusionForbiddenResponseBody builds the HTTP response body from
+// the result of the "delete_exclusion" endpoint of the "survey" service.
+func NewDeleteExclusionForbiddenResponseBody(res *survey.ForbiddenError) *DeleteExclusionForbiddenResponseBody {
+	body := &DeleteExclusionForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteExclusionInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "delete_exclusion" endpoint of the "survey"
+// service.
+func NewDeleteExclusionInternalServerErrorResponseBody(res *survey.InternalServerError) *DeleteExclusionInternalServerErrorResponseBody {
+	body := &DeleteExclusionInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteExclusionUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "delete_exclusion" endpoint of the "survey" service.
+func NewDeleteExclusionUnauthorizedResponseBody(res *survey.UnauthorizedError) *DeleteExclusionUnauthorizedResponseBody {
+	body := &DeleteExclusionUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetExclusionBadRequestResponseBody builds the HTTP response body from the
+// result of the "get_exclusion" endpoint of the "survey" service.
+func NewGetExclusionBadRequestResponseBody(res *survey.BadRequestError) *GetExclusionBadRequestResponseBody {
+	body := &GetExclusionBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewGetExclusionForbiddenResponseBody builds the HTTP response body from the
+// result of the "get_exclusion" endpoint of the "survey" service.
+func NewGetExclusionForbiddenResponseBody(res *survey.ForbiddenError) *GetExclusionForbiddenResponseBody {
+	body := &GetExclusionForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetExclusionInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "get_exclusion" endpoint of the "survey" service.
+func NewGetExclusionInternalServerErrorResponseBody(res *survey.InternalServerError) *GetExclusionInternalServerErrorResponseBody {
+	body := &GetExclusionInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetExclusionNotFoundResponseBody builds the HTTP response body from the
+// result of the "get_exclusion" endpoint of the "survey" service.
+func NewGetExclusionNotFoundResponseBody(res *survey.NotFoundError) *GetExclusionNotFoundResponseBody {
+	body := &GetExclusionNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetExclusionUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "get_exclusion" endpoint of the "survey" service.
+func NewGetExclusionUnauthorizedResponseBody(res *survey.UnauthorizedError) *GetExclusionUnauthorizedResponseBody {
+	body := &GetExclusionUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteExclusionByIDBadRequestResponseBody builds the HTTP response body
+// from the result of the "delete_exclusion_by_id" endpoint of the "survey"
+// service.
+func NewDeleteExclusionByIDBadRequestResponseBody(res *survey.BadRequestError) *DeleteExclusionByIDBadRequestResponseBody {
+	body := &DeleteExclusionByIDBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewDeleteExclusionByIDForbiddenResponseBody builds the HTTP response body
+// from the result of the "delete_exclusion_by_id" endpoint of the "survey"
+// service.
+func NewDeleteExclusionByIDForbiddenResponseBody(res *survey.ForbiddenError) *DeleteExclusionByIDForbiddenResponseBody {
+	body := &DeleteExclusionByIDForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteExclusionByIDInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "delete_exclusion_by_id" endpoint of
+// the "survey" service.
+func NewDeleteExclusionByIDInternalServerErrorResponseBody(res *survey.InternalServerError) *DeleteExclusionByIDInternalServerErrorResponseBody {
+	body := &DeleteExclusionByIDInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteExclusionByIDUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "delete_exclusion_by_id" endpoint of the "survey"
+// service.
+func NewDeleteExclusionByIDUnauthorizedResponseBody(res *survey.UnauthorizedError) *DeleteExclusionByIDUnauthorizedResponseBody {
+	body := &DeleteExclusionByIDUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListSurveyResponsesBadRequestResponseBody builds the HTTP response body
+// from the result of the "list_survey_responses" endpoint of the "survey"
+// service.
+func NewListSurveyResponsesBadRequestResponseBody(res *survey.BadRequestError) *ListSurveyResponsesBadRequestResponseBody {
+	body := &ListSurveyResponsesBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewListSurveyResponsesForbiddenResponseBody builds the HTTP response body
+// from the result of the "list_survey_responses" endpoint of the "survey"
+// service.
+func NewListSurveyResponsesForbiddenResponseBody(res *survey.ForbiddenError) *ListSurveyResponsesForbiddenResponseBody {
+	body := &ListSurveyResponsesForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListSurveyResponsesInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "list_survey_responses" endpoint of the
+// "survey" service.
+func NewListSurveyResponsesInternalServerErrorResponseBody(res *survey.InternalServerError) *ListSurveyResponsesInternalServerErrorResponseBody {
+	body := &ListSurveyResponsesInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListSurveyResponsesNotFoundResponseBody builds the HTTP response body
+// from the result of the "list_survey_responses" endpoint of the "survey"
+// service.
+func NewListSurveyResponsesNotFoundResponseBody(res *survey.NotFoundError) *ListSurveyResponsesNotFoundResponseBody {
+	body := &ListSurveyResponsesNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListSurveyResponsesServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "list_survey_responses" endpoint of the
+// "survey" service.
+func NewListSurveyResponsesServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *ListSurveyResponsesServiceUnavailableResponseBody {
+	body := &ListSurveyResponsesServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListSurveyResponsesUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "list_survey_responses" endpoint of the "survey"
+// service.
+func NewListSurveyResponsesUnauthorizedResponseBody(res *survey.UnauthorizedError) *ListSurveyResponsesUnauthorizedResponseBody {
+	body := &ListSurveyResponsesUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateSurveyResponseBadRequestResponseBody builds the HTTP response body
+// from the result of the "create_survey_response" endpoint of the "survey"
+// service.
+func NewCreateSurveyResponseBadRequestResponseBody(res *survey.BadRequestError) *CreateSurveyResponseBadRequestResponseBody {
+	body := &CreateSurveyResponseBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewCreateSurveyResponseForbiddenResponseBody builds the HTTP response body
+// from the result of the "create_survey_response" endpoint of the "survey"
+// service.
+func NewCreateSurveyResponseForbiddenResponseBody(res *survey.ForbiddenError) *CreateSurveyResponseForbiddenResponseBody {
+	body := &CreateSurveyResponseForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateSurveyResponseInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "create_survey_response" endpoint of
+// the "survey" service.
+func NewCreateSurveyResponseInternalServerErrorResponseBody(res *survey.InternalServerError) *CreateSurveyResponseInternalServerErrorResponseBody {
+	body := &CreateSurveyResponseInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateSurveyResponseNotFoundResponseBody builds the HTTP response body
+// from the result of the "create_survey_response" endpoint of the "survey"
+// service.
+func NewCreateSurveyResponseNotFoundResponseBody(res *survey.NotFoundError) *CreateSurveyResponseNotFoundResponseBody {
+	body := &CreateSurveyResponseNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateSurveyResponseServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "create_survey_response" endpoint of
+// the "survey" service.
+func NewCreateSurveyResponseServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *CreateSurveyResponseServiceUnavailableResponseBody {
+	body := &CreateSurveyResponseServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateSurveyResponseUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "create_survey_response" endpoint of the
+// "survey" service.
+func NewCreateSurveyResponseUnauthorizedResponseBody(res *survey.UnauthorizedError) *CreateSurveyResponseUnauthorizedResponseBody {
+	body := &CreateSurveyResponseUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateSurveyResponseBadRequestResponseBody builds the HTTP response body
+// from the result of the "update_survey_response" endpoint of the "survey"
+// service.
+func NewUpdateSurveyResponseBadRequestResponseBody(res *survey.BadRequestError) *UpdateSurveyResponseBadRequestResponseBody {
+	body := &UpdateSurveyResponseBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewUpdateSurveyResponseForbiddenResponseBody builds the HTTP response body
+// from the result of the "update_survey_response" endpoint of the "survey"
+// service.
+func NewUpdateSurveyResponseForbiddenResponseBody(res *survey.ForbiddenError) *UpdateSurveyResponseForbiddenResponseBody {
+	body := &UpdateSurveyResponseForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
 }
 
-// SurveyQuestionAnswerResponseBody is used to define fields on response body
-// types.
-type SurveyQuestionAnswerResponseBody struct {
-	// Question identifier
-	QuestionID string `form:"question_id" json:"question_id" xml:"question_id"`
-	// Question text as shown to the recipient
-	QuestionText *string `form:"question_text,omitempty" json:"question_text,omitempty" xml:"question_text,omitempty"`
-	// Question type family (e.g. rating, open_ended, single_choice)
-	QuestionFamily *string `form:"question_family,omitempty" json:"question_family,omitempty" xml:"question_family,omitempty"`
-	// Question subtype within the family
-	QuestionSubtype *string `form:"question_subtype,omitempty" json:"question_subtype,omitempty" xml:"question_subtype,omitempty"`
-	// Answers selected or entered by the recipient
-	Answers []*SurveyAnswerChoiceResponseBody `form:"answers,omitempty" json:"answers,omitempty" xml:"answers,omitempty"`
+// NewUpdateSurveyResponseInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "update_survey_response" endpoint of
+// the "survey" service.
+func NewUpdateSurveyResponseInternalServerErrorResponseBody(res *survey.InternalServerError) *UpdateSurveyResponseInternalServerErrorResponseBody {
+	body := &UpdateSurveyResponseInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
 }
 
-// SurveyAnswerChoiceResponseBody is used to define fields on response body
-// types.
-type SurveyAnswerChoiceResponseBody struct {
-	// Choice identifier (for multiple-choice questions)
-	ChoiceID *string `form:"choice_id,omitempty" json:"choice_id,omitempty" xml:"choice_id,omitempty"`
-	// Answer text (for open-ended questions or choice label)
-	Text *string `form:"text,omitempty" json:"text,omitempty" xml:"text,omitempty"`
+// NewUpdateSurveyResponseNotFoundResponseBody builds the HTTP response body
+// from the result of the "update_survey_response" endpoint of the "survey"
+// service.
+func NewUpdateSurveyResponseNotFoundResponseBody(res *survey.NotFoundError) *UpdateSurveyResponseNotFoundResponseBody {
+	body := &UpdateSurveyResponseNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
 }
 
-// SurveyResponsePageMetaResponseBody is used to define fields on response body
-// types.
-type SurveyResponsePageMetaResponseBody struct {
-	// Opaque token for the next page; empty string on the last page
-	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty" xml:"page_token,omitempty"`
-	// Total number of pages
-	TotalPages *int `form:"total_pages,omitempty" json:"total_pages,omitempty" xml:"total_pages,omitempty"`
-	// Total number of responses across all pages
-	TotalResults *int `form:"total_results,omitempty" json:"total_results,omitempty" xml:"total_results,omitempty"`
-	// Number of results per page
-	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty" xml:"per_page,omitempty"`
+// NewUpdateSurveyResponseServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "update_survey_response" endpoint of
+// the "survey" service.
+func NewUpdateSurveyResponseServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *UpdateSurveyResponseServiceUnavailableResponseBody {
+	body := &UpdateSurveyResponseServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
 }
 
-// NewScheduleSurveyResponseBody builds the HTTP response body from the result
-// of the "schedule_survey" endpoint of the "survey" service.
-func NewScheduleSurveyResponseBody(res *survey.SurveyScheduleResult) *ScheduleSurveyResponseBody {
-	body := &ScheduleSurveyResponseBody{
-		UID:                           res.UID,
-		SurveyMonkeyID:                res.SurveyMonkeyID,
-		IsProjectSurvey:               res.IsProjectSurvey,
-		StageFilter:                   res.StageFilter,
-		CreatorUsername:               res.CreatorUsername,
-		CreatorName:                   res.CreatorName,
-		CreatorID:                     res.CreatorID,
-		CreatedAt:                     res.CreatedAt,
-		LastModifiedAt:                res.LastModifiedAt,
-		LastModifiedBy:                res.LastModifiedBy,
-		SurveyTitle:                   res.SurveyTitle,
-		SurveyStatus:                  res.SurveyStatus,
-		ResponseStatus:                res.ResponseStatus,
-		SurveySendDate:                res.SurveySendDate,
-		SurveyCutoffDate:              res.SurveyCutoffDate,
-		SurveyReminderRateDays:        res.SurveyReminderRateDays,
-		EmailSubject:                  res.EmailSubject,
-		EmailBody:                     res.EmailBody,
-		EmailBodyText:                 res.EmailBodyText,
-		CommitteeCategory:             res.CommitteeCategory,
-		CommitteeVotingEnabled:        res.CommitteeVotingEnabled,
-		SurveyURL:                     res.SurveyURL,
-		SendImmediately:               res.SendImmediately,
-		TotalRecipients:               res.TotalRecipients,
-		TotalResponses:                res.TotalResponses,
-		IsNpsSurvey:                   res.IsNpsSurvey,
-		NpsValue:                      res.NpsValue,
-		NumPromoters:                  res.NumPromoters,
-		NumPassives:                   res.NumPassives,
-		NumDetractors:                 res.NumDetractors,
-		TotalBouncedEmails:            res.TotalBouncedEmails,
-		NumAutomatedRemindersToSend:   res.NumAutomatedRemindersToSend,
-		NumAutomatedRemindersSent:     res.NumAutomatedRemindersSent,
-		NextAutomatedReminderAt:       res.NextAutomatedReminderAt,
-		LatestAutomatedReminderSentAt: res.LatestAutomatedReminderSentAt,
+// NewUpdateSurveyResponseUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "update_survey_response" endpoint of the
+// "survey" service.
+func NewUpdateSurveyResponseUnauthorizedResponseBody(res *survey.UnauthorizedError) *UpdateSurveyResponseUnauthorizedResponseBody {
+	body := &UpdateSurveyResponseUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListSurveyInvitesBadRequestResponseBody builds the HTTP response body
+// from the result of the "list_survey_invites" endpoint of the "survey"
+// service.
+func NewListSurveyInvitesBadRequestResponseBody(res *survey.BadRequestError) *ListSurveyInvitesBadRequestResponseBody {
+	body := &ListSurveyInvitesBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewListSurveyInvitesForbiddenResponseBody builds the HTTP response body from
+// the result of the "list_survey_invites" endpoint of the "survey" service.
+func NewListSurveyInvitesForbiddenResponseBody(res *survey.ForbiddenError) *ListSurveyInvitesForbiddenResponseBody {
+	body := &ListSurveyInvitesForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListSurveyInvitesInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "list_survey_invites" endpoint of the "survey"
+// service.
+func NewListSurveyInvitesInternalServerErrorResponseBody(res *survey.InternalServerError) *ListSurveyInvitesInternalServerErrorResponseBody {
+	body := &ListSurveyInvitesInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListSurveyInvitesNotFoundResponseBody builds the HTTP response body from
+// the result of the "list_survey_invites" endpoint of the "survey" service.
+func NewListSurveyInvitesNotFoundResponseBody(res *survey.NotFoundError) *ListSurveyInvitesNotFoundResponseBody {
+	body := &ListSurveyInvitesNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListSurveyInvitesServiceUnavailableResponseBody builds the HTTP response
+// body from the result of the "list_survey_invites" endpoint of the "survey"
+// service.
+func NewListSurveyInvitesServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *ListSurveyInvitesServiceUnavailableResponseBody {
+	body := &ListSurveyInvitesServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListSurveyInvitesUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "list_survey_invites" endpoint of the "survey"
+// service.
+func NewListSurveyInvitesUnauthorizedResponseBody(res *survey.UnauthorizedError) *ListSurveyInvitesUnauthorizedResponseBody {
+	body := &ListSurveyInvitesUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetSurveyInviteSettingsBadRequestResponseBody builds the HTTP response
+// body from the result of the "get_survey_invite_settings" endpoint of the
+// "survey" service.
+func NewGetSurveyInviteSettingsBadRequestResponseBody(res *survey.BadRequestError) *GetSurveyInviteSettingsBadRequestResponseBody {
+	body := &GetSurveyInviteSettingsBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewGetSurveyInviteSettingsForbiddenResponseBody builds the HTTP response
+// body from the result of the "get_survey_invite_settings" endpoint of the
+// "survey" service.
+func NewGetSurveyInviteSettingsForbiddenResponseBody(res *survey.ForbiddenError) *GetSurveyInviteSettingsForbiddenResponseBody {
+	body := &GetSurveyInviteSettingsForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetSurveyInviteSettingsInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "get_survey_invite_settings" endpoint
+// of the "survey" service.
+func NewGetSurveyInviteSettingsInternalServerErrorResponseBody(res *survey.InternalServerError) *GetSurveyInviteSettingsInternalServerErrorResponseBody {
+	body := &GetSurveyInviteSettingsInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetSurveyInviteSettingsNotFoundResponseBody builds the HTTP response body
+// from the result of the "get_survey_invite_settings" endpoint of the "survey"
+// service.
+func NewGetSurveyInviteSettingsNotFoundResponseBody(res *survey.NotFoundError) *GetSurveyInviteSettingsNotFoundResponseBody {
+	body := &GetSurveyInviteSettingsNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetSurveyInviteSettingsServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "get_survey_invite_settings" endpoint
+// of the "survey" service.
+func NewGetSurveyInviteSettingsServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *GetSurveyInviteSettingsServiceUnavailableResponseBody {
+	body := &GetSurveyInviteSettingsServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetSurveyInviteSettingsUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "get_survey_invite_settings" endpoint of the
+// "survey" service.
+func NewGetSurveyInviteSettingsUnauthorizedResponseBody(res *survey.UnauthorizedError) *GetSurveyInviteSettingsUnauthorizedResponseBody {
+	body := &GetSurveyInviteSettingsUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateSurveyInviteSettingsBadRequestResponseBody builds the HTTP response
+// body from the result of the "update_survey_invite_settings" endpoint of the
+// "survey" service.
+func NewUpdateSurveyInviteSettingsBadRequestResponseBody(res *survey.BadRequestError) *UpdateSurveyInviteSettingsBadRequestResponseBody {
+	body := &UpdateSurveyInviteSettingsBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewUpdateSurveyInviteSettingsForbiddenResponseBody builds the HTTP response
+// body from the result of the "update_survey_invite_settings" endpoint of the
+// "survey" service.
+func NewUpdateSurveyInviteSettingsForbiddenResponseBody(res *survey.ForbiddenError) *UpdateSurveyInviteSettingsForbiddenResponseBody {
+	body := &UpdateSurveyInviteSettingsForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateSurveyInviteSettingsInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "update_survey_invite_settings"
+// endpoint of the "survey" service.
+func NewUpdateSurveyInviteSettingsInternalServerErrorResponseBody(res *survey.InternalServerError) *UpdateSurveyInviteSettingsInternalServerErrorResponseBody {
+	body := &UpdateSurveyInviteSettingsInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateSurveyInviteSettingsServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "update_survey_invite_settings"
+// endpoint of the "survey" service.
+func NewUpdateSurveyInviteSettingsServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *UpdateSurveyInviteSettingsServiceUnavailableResponseBody {
+	body := &UpdateSurveyInviteSettingsServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateSurveyInviteSettingsUnauthorizedResponseBody builds the HTTP
+// response body from the result of the "update_survey_invite_settings"
+// endpoint of the "survey" service.
+func NewUpdateSurveyInviteSettingsUnauthorizedResponseBody(res *survey.UnauthorizedError) *UpdateSurveyInviteSettingsUnauthorizedResponseBody {
+	body := &UpdateSurveyInviteSettingsUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteSurveyInviteSettingsBadRequestResponseBody builds the HTTP response
+// body from the result of the "delete_survey_invite_settings" endpoint of the
+// "survey" service.
+func NewDeleteSurveyInviteSettingsBadRequestResponseBody(res *survey.BadRequestError) *DeleteSurveyInviteSettingsBadRequestResponseBody {
+	body := &DeleteSurveyInviteSettingsBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewDeleteSurveyInviteSettingsForbiddenResponseBody builds the HTTP response
+// body from the result of the "delete_survey_invite_settings" endpoint of the
+// "survey" service.
+func NewDeleteSurveyInviteSettingsForbiddenResponseBody(res *survey.ForbiddenError) *DeleteSurveyInviteSettingsForbiddenResponseBody {
+	body := &DeleteSurveyInviteSettingsForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteSurveyInviteSettingsInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "delete_survey_invite_settings"
+// endpoint of the "survey" service.
+func NewDeleteSurveyInviteSettingsInternalServerErrorResponseBody(res *survey.InternalServerError) *DeleteSurveyInviteSettingsInternalServerErrorResponseBody {
+	body := &DeleteSurveyInviteSettingsInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteSurveyInviteSettingsNotFoundResponseBody builds the HTTP response
+// body from the result of the "delete_survey_invite_settings" endpoint of the
+// "survey" service.
+func NewDeleteSurveyInviteSettingsNotFoundResponseBody(res *survey.NotFoundError) *DeleteSurveyInviteSettingsNotFoundResponseBody {
+	body := &DeleteSurveyInviteSettingsNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteSurveyInviteSettingsServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "delete_survey_invite_settings"
+// endpoint of the "survey" service.
+func NewDeleteSurveyInviteSettingsServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *DeleteSurveyInviteSettingsServiceUnavailableResponseBody {
+	body := &DeleteSurveyInviteSettingsServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteSurveyInviteSettingsUnauthorizedResponseBody builds the HTTP
+// response body from the result of the "delete_survey_invite_settings"
+// endpoint of the "survey" service.
+func NewDeleteSurveyInviteSettingsUnauthorizedResponseBody(res *survey.UnauthorizedError) *DeleteSurveyInviteSettingsUnauthorizedResponseBody {
+	body := &DeleteSurveyInviteSettingsUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
 	}
-	if res.Committees != nil {
-		body.Committees = make([]*SurveyCommitteeResponseBody, len(res.Committees))
-		for i, val := range res.Committees {
+	return body
+}
+
+// NewGetProjectInviteSettingsBadRequestResponseBody builds the HTTP response
+// body from the result of the "get_project_invite_settings" endpoint of the
+// "survey" service.
+func NewGetProjectInviteSettingsBadRequestResponseBody(res *survey.BadRequestError) *GetProjectInviteSettingsBadRequestResponseBody {
+	body := &GetProjectInviteSettingsBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
 			if val == nil {
-				body.Committees[i] = nil
+				body.QuestionErrors[i] = nil
 				continue
 			}
-			body.Committees[i] = marshalSurveySurveyCommitteeToSurveyCommitteeResponseBody(val)
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
 		}
 	}
 	return body
 }
 
-// NewGetSurveyResponseBody builds the HTTP response body from the result of
-// the "get_survey" endpoint of the "survey" service.
-func NewGetSurveyResponseBody(res *survey.SurveyScheduleResult) *GetSurveyResponseBody {
-	body := &GetSurveyResponseBody{
-		UID:                           res.UID,
-		SurveyMonkeyID:                res.SurveyMonkeyID,
-		IsProjectSurvey:               res.IsProjectSurvey,
-		StageFilter:                   res.StageFilter,
-		CreatorUsername:               res.CreatorUsername,
-		CreatorName:                   res.CreatorName,
-		CreatorID:                     res.CreatorID,
-		CreatedAt:                     res.CreatedAt,
-		LastModifiedAt:                res.LastModifiedAt,
-		LastModifiedBy:                res.LastModifiedBy,
-		SurveyTitle:                   res.SurveyTitle,
-		SurveyStatus:                  res.SurveyStatus,
-		ResponseStatus:                res.ResponseStatus,
-		SurveySendDate:                res.SurveySendDate,
-		SurveyCutoffDate:              res.SurveyCutoffDate,
-		SurveyReminderRateDays:        res.SurveyReminderRateDays,
-		EmailSubject:                  res.EmailSubject,
-		EmailBody:                     res.EmailBody,
-		EmailBodyText:                 res.EmailBodyText,
-		CommitteeCategory:             res.CommitteeCategory,
-		CommitteeVotingEnabled:        res.CommitteeVotingEnabled,
-		SurveyURL:                     res.SurveyURL,
-		SendImmediately:               res.SendImmediately,
-		TotalRecipients:               res.TotalRecipients,
-		TotalResponses:                res.TotalResponses,
-		IsNpsSurvey:                   res.IsNpsSurvey,
-		NpsValue:                      res.NpsValue,
-		NumPromoters:                  res.NumPromoters,
-		NumPassives:                   res.NumPassives,
-		NumDetractors:                 res.NumDetractors,
-		TotalBouncedEmails:            res.TotalBouncedEmails,
-		NumAutomatedRemindersToSend:   res.NumAutomatedRemindersToSend,
-		NumAutomatedRemindersSent:     res.NumAutomatedRemindersSent,
-		NextAutomatedReminderAt:       res.NextAutomatedReminderAt,
-		LatestAutomatedReminderSentAt: res.LatestAutomatedReminderSentAt,
+// NewGetProjectInviteSettingsForbiddenResponseBody builds the HTTP response
+// body from the result of the "get_project_invite_settings" endpoint of the
+// "survey" service.
+func NewGetProjectInviteSettingsForbiddenResponseBody(res *survey.ForbiddenError) *GetProjectInviteSettingsForbiddenResponseBody {
+	body := &GetProjectInviteSettingsForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
 	}
-	if res.Committees != nil {
-		body.Committees = make([]*SurveyCommitteeResponseBody, len(res.Committees))
-		for i, val := range res.Committees {
-			if val == nil {
-				body.Committees[i] = nil
-				continue
-			}
-			body.Committees[i] = marshalSurveySurveyCommitteeToSurveyCommitteeResponseBody(val)
-		}
+	return body
+}
+
+// NewGetProjectInviteSettingsInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "get_project_invite_settings" endpoint
+// of the "survey" service.
+func NewGetProjectInviteSettingsInternalServerErrorResponseBody(res *survey.InternalServerError) *GetProjectInviteSettingsInternalServerErrorResponseBody {
+	body := &GetProjectInviteSettingsInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateSurveyResponseBody builds the HTTP response body from the result of
-// the "update_survey" endpoint of the "survey" service.
-func NewUpdateSurveyResponseBody(res *survey.SurveyScheduleResult) *UpdateSurveyResponseBody {
-	body := &UpdateSurveyResponseBody{
-		UID:                           res.UID,
-		SurveyMonkeyID:                res.SurveyMonkeyID,
-		IsProjectSurvey:               res.IsProjectSurvey,
-		StageFilter:                   res.StageFilter,
-		CreatorUsername:               res.CreatorUsername,
-		CreatorName:                   res.CreatorName,
-		CreatorID:                     res.CreatorID,
-		CreatedAt:                     res.CreatedAt,
-		LastModifiedAt:                res.LastModifiedAt,
-		LastModifiedBy:                res.LastModifiedBy,
-		SurveyTitle:                   res.SurveyTitle,
-		SurveyStatus:                  res.SurveyStatus,
-		ResponseStatus:                res.ResponseStatus,
-		SurveySendDate:                res.SurveySendDate,
-		SurveyCutoffDate:              res.SurveyCutoffDate,
-		SurveyReminderRateDays:        res.SurveyReminderRateDays,
-		EmailSubject:                  res.EmailSubject,
-		EmailBody:                     res.EmailBody,
-		EmailBodyText:                 res.EmailBodyText,
-		CommitteeCategory:             res.CommitteeCategory,
-		CommitteeVotingEnabled:        res.CommitteeVotingEnabled,
-		SurveyURL:                     res.SurveyURL,
-		SendImmediately:               res.SendImmediately,
-		TotalRecipients:               res.TotalRecipients,
-		TotalResponses:                res.TotalResponses,
-		IsNpsSurvey:                   res.IsNpsSurvey,
-		NpsValue:                      res.NpsValue,
-		NumPromoters:                  res.NumPromoters,
-		NumPassives:                   res.NumPassives,
-		NumDetractors:                 res.NumDetractors,
-		TotalBouncedEmails:            res.TotalBouncedEmails,
-		NumAutomatedRemindersToSend:   res.NumAutomatedRemindersToSend,
-		NumAutomatedRemindersSent:     res.NumAutomatedRemindersSent,
-		NextAutomatedReminderAt:       res.NextAutomatedReminderAt,
-		LatestAutomatedReminderSentAt: res.LatestAutomatedReminderSentAt,
+// NewGetProjectInviteSettingsNotFoundResponseBody builds the HTTP response
+// body from the result of the "get_project_invite_settings" endpoint of the
+// "survey" service.
+func NewGetProjectInviteSettingsNotFoundResponseBody(res *survey.NotFoundError) *GetProjectInviteSettingsNotFoundResponseBody {
+	body := &GetProjectInviteSettingsNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
 	}
-	if res.Committees != nil {
-		body.Committees = make([]*SurveyCommitteeResponseBody, len(res.Committees))
-		for i, val := range res.Committees {
-			if val == nil {
-				body.Committees[i] = nil
-				continue
-			}
-			body.Committees[i] = marshalSurveySurveyCommitteeToSurveyCommitteeResponseBody(val)
-		}
+	return body
+}
+
+// NewGetProjectInviteSettingsServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "get_project_invite_settings" endpoint
+// of the "survey" service.
+func NewGetProjectInviteSettingsServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *GetProjectInviteSettingsServiceUnavailableResponseBody {
+	body := &GetProjectInviteSettingsServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewPreviewSendSurveyResponseBody builds the HTTP response body from the
-// result of the "preview_send_survey" endpoint of the "survey" service.
-func NewPreviewSendSurveyResponseBody(res *survey.PreviewSendResult) *PreviewSendSurveyResponseBody {
-	body := &PreviewSendSurveyResponseBody{}
-	if res.AffectedProjects != nil {
-		body.AffectedProjects = make([]*LFXProjectResponseBody, len(res.AffectedProjects))
-		for i, val := range res.AffectedProjects {
-			if val == nil {
-				body.AffectedProjects[i] = nil
-				continue
-			}
-			body.AffectedProjects[i] = marshalSurveyLFXProjectToLFXProjectResponseBody(val)
-		}
+// NewGetProjectInviteSettingsUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "get_project_invite_settings" endpoint of the
+// "survey" service.
+func NewGetProjectInviteSettingsUnauthorizedResponseBody(res *survey.UnauthorizedError) *GetProjectInviteSettingsUnauthorizedResponseBody {
+	body := &GetProjectInviteSettingsUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
 	}
-	if res.AffectedCommittees != nil {
-		body.AffectedCommittees = make([]*ExcludedCommitteeResponseBody, len(res.AffectedCommittees))
-		for i, val := range res.AffectedCommittees {
-			if val == nil {
-				body.AffectedCommittees[i] = nil
-				continue
-			}
-			body.AffectedCommittees[i] = marshalSurveyExcludedCommitteeToExcludedCommitteeResponseBody(val)
-		}
+	return body
+}
+
+// NewUpdateProjectInviteSettingsBadRequestResponseBody builds the HTTP
+// response body from the result of the "update_project_invite_settings"
+// endpoint of the "survey" service.
+func NewUpdateProjectInviteSettingsBadRequestResponseBody(res *survey.BadRequestError) *UpdateProjectInviteSettingsBadRequestResponseBody {
+	body := &UpdateProjectInviteSettingsBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
 	}
-	if res.AffectedRecipients != nil {
-		body.AffectedRecipients = make([]*ITXPreviewRecipientResponseBody, len(res.AffectedRecipients))
-		for i, val := range res.AffectedRecipients {
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
 			if val == nil {
-				body.AffectedRecipients[i] = nil
+				body.QuestionErrors[i] = nil
 				continue
 			}
-			body.AffectedRecipients[i] = marshalSurveyITXPreviewRecipientToITXPreviewRecipientResponseBody(val)
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
 		}
 	}
 	return body
 }
 
-// NewCreateExclusionResponseBody builds the HTTP response body from the result
-// of the "create_exclusion" endpoint of the "survey" service.
-func NewCreateExclusionResponseBody(res *survey.ExclusionResult) *CreateExclusionResponseBody {
-	body := &CreateExclusionResponseBody{
-		UID:             res.UID,
-		Email:           res.Email,
-		SurveyUID:       res.SurveyUID,
-		CommitteeUID:    res.CommitteeUID,
-		GlobalExclusion: res.GlobalExclusion,
-		UserID:          res.UserID,
+// NewUpdateProjectInviteSettingsForbiddenResponseBody builds the HTTP response
+// body from the result of the "update_project_invite_settings" endpoint of the
+// "survey" service.
+func NewUpdateProjectInviteSettingsForbiddenResponseBody(res *survey.ForbiddenError) *UpdateProjectInviteSettingsForbiddenResponseBody {
+	body := &UpdateProjectInviteSettingsForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetExclusionResponseBody builds the HTTP response body from the result of
-// the "get_exclusion" endpoint of the "survey" service.
-func NewGetExclusionResponseBody(res *survey.ExtendedExclusionResult) *GetExclusionResponseBody {
-	body := &GetExclusionResponseBody{
-		UID:             res.UID,
-		Email:           res.Email,
-		SurveyUID:       res.SurveyUID,
-		CommitteeUID:    res.CommitteeUID,
-		GlobalExclusion: res.GlobalExclusion,
-		UserID:          res.UserID,
+// NewUpdateProjectInviteSettingsInternalServerErrorResponseBody builds the
+// HTTP response body from the result of the "update_project_invite_settings"
+// endpoint of the "survey" service.
+func NewUpdateProjectInviteSettingsInternalServerErrorResponseBody(res *survey.InternalServerError) *UpdateProjectInviteSettingsInternalServerErrorResponseBody {
+	body := &UpdateProjectInviteSettingsInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
 	}
-	if res.User != nil {
-		body.User = marshalSurveyExclusionUserToExclusionUserResponseBody(res.User)
+	return body
+}
+
+// NewUpdateProjectInviteSettingsServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "update_project_invite_settings"
+// endpoint of the "survey" service.
+func NewUpdateProjectInviteSettingsServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *UpdateProjectInviteSettingsServiceUnavailableResponseBody {
+	body := &UpdateProjectInviteSettingsServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewListSurveyResponsesResponseBody builds the HTTP response body from the
-// result of the "list_survey_responses" endpoint of the "survey" service.
-func NewListSurveyResponsesResponseBody(res *survey.SurveyResponsesPage) *ListSurveyResponsesResponseBody {
-	body := &ListSurveyResponsesResponseBody{}
-	if res.Data != nil {
-		body.Data = make([]*SurveyResponseItemResponseBody, len(res.Data))
-		for i, val := range res.Data {
+// NewUpdateProjectInviteSettingsUnauthorizedResponseBody builds the HTTP
+// response body from the result of the "update_project_invite_settings"
+// endpoint of the "survey" service.
+func NewUpdateProjectInviteSettingsUnauthorizedResponseBody(res *survey.UnauthorizedError) *UpdateProjectInviteSettingsUnauthorizedResponseBody {
+	body := &UpdateProjectInviteSettingsUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteProjectInviteSettingsBadRequestResponseBody builds the HTTP
+// response body from the result of the "delete_project_invite_settings"
+// endpoint of the "survey" service.
+func NewDeleteProjectInviteSettingsBadRequestResponseBody(res *survey.BadRequestError) *DeleteProjectInviteSettingsBadRequestResponseBody {
+	body := &DeleteProjectInviteSettingsBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
 			if val == nil {
-				body.Data[i] = nil
+				body.QuestionErrors[i] = nil
 				continue
 			}
-			body.Data[i] = marshalSurveySurveyResponseItemToSurveyResponseItemResponseBody(val)
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
 		}
-	} else {
-		body.Data = []*SurveyResponseItemResponseBody{}
 	}
-	if res.Meta != nil {
-		body.Meta = marshalSurveySurveyResponsePageMetaToSurveyResponsePageMetaResponseBody(res.Meta)
+	return body
+}
+
+// NewDeleteProjectInviteSettingsForbiddenResponseBody builds the HTTP response
+// body from the result of the "delete_project_invite_settings" endpoint of the
+// "survey" service.
+func NewDeleteProjectInviteSettingsForbiddenResponseBody(res *survey.ForbiddenError) *DeleteProjectInviteSettingsForbiddenResponseBody {
+	body := &DeleteProjectInviteSettingsForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteProjectInviteSettingsInternalServerErrorResponseBody builds the
+// HTTP response body from the result of the "delete_project_invite_settings"
+// endpoint of the "survey" service.
+func NewDeleteProjectInviteSettingsInternalServerErrorResponseBody(res *survey.InternalServerError) *DeleteProjectInviteSettingsInternalServerErrorResponseBody {
+	body := &DeleteProjectInviteSettingsInternalServerErrorResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteProjectInviteSettingsNotFoundResponseBody builds the HTTP response
+// body from the result of the "delete_project_invite_settings" endpoint of the
+// "survey" service.
+func NewDeleteProjectInviteSettingsNotFoundResponseBody(res *survey.NotFoundError) *DeleteProjectInviteSettingsNotFoundResponseBody {
+	body := &DeleteProjectInviteSettingsNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteProjectInviteSettingsServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "delete_project_invite_settings"
+// endpoint of the "survey" service.
+func NewDeleteProjectInviteSettingsServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *DeleteProjectInviteSettingsServiceUnavailableResponseBody {
+	body := &DeleteProjectInviteSettingsServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteProjectInviteSettingsUnauthorizedResponseBody builds the HTTP
+// response body from the result of the "delete_project_invite_settings"
+// endpoint of the "survey" service.
+func NewDeleteProjectInviteSettingsUnauthorizedResponseBody(res *survey.UnauthorizedError) *DeleteProjectInviteSettingsUnauthorizedResponseBody {
+	body := &DeleteProjectInviteSettingsUnauthorizedResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetSurveySettingsBadRequestResponseBody builds the HTTP response body
+// from the result of the "get_survey_settings" endpoint of the "survey"
+// service.
+func NewGetSurveySettingsBadRequestResponseBody(res *survey.BadRequestError) *GetSurveySettingsBadRequestResponseBody {
+	body := &GetSurveySettingsBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
 	}
 	return body
 }
 
-// NewValidateEmailResponseBody builds the HTTP response body from the result
-// of the "validate_email" endpoint of the "survey" service.
-func NewValidateEmailResponseBody(res *survey.ValidateEmailResult) *ValidateEmailResponseBody {
-	body := &ValidateEmailResponseBody{
-		Body:    res.Body,
-		Subject: res.Subject,
+// NewGetSurveySettingsForbiddenResponseBody builds the HTTP response body from
+// the result of the "get_survey_settings" endpoint of the "survey" service.
+func NewGetSurveySettingsForbiddenResponseBody(res *survey.ForbiddenError) *GetSurveySettingsForbiddenResponseBody {
+	body := &GetSurveySettingsForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewScheduleSurveyBadRequestResponseBody builds the HTTP response body from
-// the result of the "schedule_survey" endpoint of the "survey" service.
-func NewScheduleSurveyBadRequestResponseBody(res *survey.BadRequestError) *ScheduleSurveyBadRequestResponseBody {
-	body := &ScheduleSurveyBadRequestResponseBody{
+// NewGetSurveySettingsInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "get_survey_settings" endpoint of the "survey"
+// service.
+func NewGetSurveySettingsInternalServerErrorResponseBody(res *survey.InternalServerError) *GetSurveySettingsInternalServerErrorResponseBody {
+	body := &GetSurveySettingsInternalServerErrorResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewScheduleSurveyForbiddenResponseBody builds the HTTP response body from
-// the result of the "schedule_survey" endpoint of the "survey" service.
-func NewScheduleSurveyForbiddenResponseBody(res *survey.ForbiddenError) *ScheduleSurveyForbiddenResponseBody {
-	body := &ScheduleSurveyForbiddenResponseBody{
+// NewGetSurveySettingsServiceUnavailableResponseBody builds the HTTP response
+// body from the result of the "get_survey_settings" endpoint of the "survey"
+// service.
+func NewGetSurveySettingsServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *GetSurveySettingsServiceUnavailableResponseBody {
+	body := &GetSurveySettingsServiceUnavailableResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewScheduleSurveyInternalServerErrorResponseBody builds the HTTP response
-// body from the result of the "schedule_survey" endpoint of the "survey"
+// NewGetSurveySettingsUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "get_survey_settings" endpoint of the "survey"
 // service.
-func NewScheduleSurveyInternalServerErrorResponseBody(res *survey.InternalServerError) *ScheduleSurveyInternalServerErrorResponseBody {
-	body := &ScheduleSurveyInternalServerErrorResponseBody{
+func NewGetSurveySettingsUnauthorizedResponseBody(res *survey.UnauthorizedError) *GetSurveySettingsUnauthorizedResponseBody {
+	body := &GetSurveySettingsUnauthorizedResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewScheduleSurveyServiceUnavailableResponseBody builds the HTTP response
-// body from the result of the "schedule_survey" endpoint of the "survey"
+// NewUpdateSurveySettingsBadRequestResponseBody builds the HTTP response body
+// from the result of the "update_survey_settings" endpoint of the "survey"
 // service.
-func NewScheduleSurveyServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *ScheduleSurveyServiceUnavailableResponseBody {
-	body := &ScheduleSurveyServiceUnavailableResponseBody{
+func NewUpdateSurveySettingsBadRequestResponseBody(res *survey.BadRequestError) *UpdateSurveySettingsBadRequestResponseBody {
+	body := &UpdateSurveySettingsBadRequestResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
 	return body
 }
 
-// NewScheduleSurveyUnauthorizedResponseBody builds the HTTP response body from
-// the result of the "schedule_survey" endpoint of the "survey" service.
-func NewScheduleSurveyUnauthorizedResponseBody(res *survey.UnauthorizedError) *ScheduleSurveyUnauthorizedResponseBody {
-	body := &ScheduleSurveyUnauthorizedResponseBody{
+// NewUpdateSurveySettingsConflictResponseBody builds the HTTP response body
+// from the result of the "update_survey_settings" endpoint of the "survey"
+// service.
+func NewUpdateSurveySettingsConflictResponseBody(res *survey.ConflictError) *UpdateSurveySettingsConflictResponseBody {
+	body := &UpdateSurveySettingsConflictResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetSurveyBadRequestResponseBody builds the HTTP response body from the
-// result of the "get_survey" endpoint of the "survey" service.
-func NewGetSurveyBadRequestResponseBody(res *survey.BadRequestError) *GetSurveyBadRequestResponseBody {
-	body := &GetSurveyBadRequestResponseBody{
+// NewUpdateSurveySettingsForbiddenResponseBody builds the HTTP response body
+// from the result of the "update_survey_settings" endpoint of the "survey"
+// service.
+func NewUpdateSurveySettingsForbiddenResponseBody(res *survey.ForbiddenError) *UpdateSurveySettingsForbiddenResponseBody {
+	body := &UpdateSurveySettingsForbiddenResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetSurveyForbiddenResponseBody builds the HTTP response body from the
-// result of the "get_survey" endpoint of the "survey" service.
-func NewGetSurveyForbiddenResponseBody(res *survey.ForbiddenError) *GetSurveyForbiddenResponseBody {
-	body := &GetSurveyForbiddenResponseBody{
+// NewUpdateSurveySettingsInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "update_survey_settings" endpoint of
+// the "survey" service.
+func NewUpdateSurveySettingsInternalServerErrorResponseBody(res *survey.InternalServerError) *UpdateSurveySettingsInternalServerErrorResponseBody {
+	body := &UpdateSurveySettingsInternalServerErrorResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetSurveyInternalServerErrorResponseBody builds the HTTP response body
-// from the result of the "get_survey" endpoint of the "survey" service.
-func NewGetSurveyInternalServerErrorResponseBody(res *survey.InternalServerError) *GetSurveyInternalServerErrorResponseBody {
-	body := &GetSurveyInternalServerErrorResponseBody{
+// NewUpdateSurveySettingsNotFoundResponseBody builds the HTTP response body
+// from the result of the "update_survey_settings" endpoint of the "survey"
+// service.
+func NewUpdateSurveySettingsNotFoundResponseBody(res *survey.NotFoundError) *UpdateSurveySettingsNotFoundResponseBody {
+	body := &UpdateSurveySettingsNotFoundResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetSurveyNotFoundResponseBody builds the HTTP response body from the
-// result of the "get_survey" endpoint of the "survey" service.
-func NewGetSurveyNotFoundResponseBody(res *survey.NotFoundError) *GetSurveyNotFoundResponseBody {
-	body := &GetSurveyNotFoundResponseBody{
+// NewUpdateSurveySettingsServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "update_survey_settings" endpoint of
+// the "survey" service.
+func NewUpdateSurveySettingsServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *UpdateSurveySettingsServiceUnavailableResponseBody {
+	body := &UpdateSurveySettingsServiceUnavailableResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetSurveyServiceUnavailableResponseBody builds the HTTP response body
-// from the result of the "get_survey" endpoint of the "survey" service.
-func NewGetSurveyServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *GetSurveyServiceUnavailableResponseBody {
-	body := &GetSurveyServiceUnavailableResponseBody{
+// NewUpdateSurveySettingsUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "update_survey_settings" endpoint of the
+// "survey" service.
+func NewUpdateSurveySettingsUnauthorizedResponseBody(res *survey.UnauthorizedError) *UpdateSurveySettingsUnauthorizedResponseBody {
+	body := &UpdateSurveySettingsUnauthorizedResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetSurveyUnauthorizedResponseBody builds the HTTP response body from the
-// result of the "get_survey" endpoint of the "survey" service.
-func NewGetSurveyUnauthorizedResponseBody(res *survey.UnauthorizedError) *GetSurveyUnauthorizedResponseBody {
-	body := &GetSurveyUnauthorizedResponseBody{
+// NewGetSurveyResultsBadRequestResponseBody builds the HTTP response body from
+// the result of the "get_survey_results" endpoint of the "survey" service.
+func NewGetSurveyResultsBadRequestResponseBody(res *survey.BadRequestError) *GetSurveyResultsBadRequestResponseBody {
+	body := &GetSurveyResultsBadRequestResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
 	return body
 }
 
-// NewUpdateSurveyBadRequestResponseBody builds the HTTP response body from the
-// result of the "update_survey" endpoint of the "survey" service.
-func NewUpdateSurveyBadRequestResponseBody(res *survey.BadRequestError) *UpdateSurveyBadRequestResponseBody {
-	body := &UpdateSurveyBadRequestResponseBody{
+// NewGetSurveyResultsForbiddenResponseBody builds the HTTP response body from
+// the result of the "get_survey_results" endpoint of the "survey" service.
+func NewGetSurveyResultsForbiddenResponseBody(res *survey.ForbiddenError) *GetSurveyResultsForbiddenResponseBody {
+	body := &GetSurveyResultsForbiddenResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateSurveyForbiddenResponseBody builds the HTTP response body from the
-// result of the "update_survey" endpoint of the "survey" service.
-func NewUpdateSurveyForbiddenResponseBody(res *survey.ForbiddenError) *UpdateSurveyForbiddenResponseBody {
-	body := &UpdateSurveyForbiddenResponseBody{
+// NewGetSurveyResultsInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "get_survey_results" endpoint of the "survey"
+// service.
+func NewGetSurveyResultsInternalServerErrorResponseBody(res *survey.InternalServerError) *GetSurveyResultsInternalServerErrorResponseBody {
+	body := &GetSurveyResultsInternalServerErrorResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateSurveyInternalServerErrorResponseBody builds the HTTP response body
-// from the result of the "update_survey" endpoint of the "survey" service.
-func NewUpdateSurveyInternalServerErrorResponseBody(res *survey.InternalServerError) *UpdateSurveyInternalServerErrorResponseBody {
-	body := &UpdateSurveyInternalServerErrorResponseBody{
+// NewGetSurveyResultsNotFoundResponseBody builds the HTTP response body from
+// the result of the "get_survey_results" endpoint of the "survey" service.
+func NewGetSurveyResultsNotFoundResponseBody(res *survey.NotFoundError) *GetSurveyResultsNotFoundResponseBody {
+	body := &GetSurveyResultsNotFoundResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateSurveyNotFoundResponseBody builds the HTTP response body from the
-// result of the "update_survey" endpoint of the "survey" service.
-func NewUpdateSurveyNotFoundResponseBody(res *survey.NotFoundError) *UpdateSurveyNotFoundResponseBody {
-	body := &UpdateSurveyNotFoundResponseBody{
+// NewGetSurveyResultsServiceUnavailableResponseBody builds the HTTP response
+// body from the result of the "get_survey_results" endpoint of the "survey"
+// service.
+func NewGetSurveyResultsServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *GetSurveyResultsServiceUnavailableResponseBody {
+	body := &GetSurveyResultsServiceUnavailableResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateSurveyServiceUnavailableResponseBody builds the HTTP response body
-// from the result of the "update_survey" endpoint of the "survey" service.
-func NewUpdateSurveyServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *UpdateSurveyServiceUnavailableResponseBody {
-	body := &UpdateSurveyServiceUnavailableResponseBody{
+// NewGetSurveyResultsUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "get_survey_results" endpoint of the "survey" service.
+func NewGetSurveyResultsUnauthorizedResponseBody(res *survey.UnauthorizedError) *GetSurveyResultsUnauthorizedResponseBody {
+	body := &GetSurveyResultsUnauthorizedResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewUpdateSurveyUnauthorizedResponseBody builds the HTTP response body from
-// the result of the "update_survey" endpoint of the "survey" service.
-func NewUpdateSurveyUnauthorizedResponseBody(res *survey.UnauthorizedError) *UpdateSurveyUnauthorizedResponseBody {
-	body := &UpdateSurveyUnauthorizedResponseBody{
+// NewValidateEmailBadRequestResponseBody builds the HTTP response body from
+// the result of the "validate_email" endpoint of the "survey" service.
+func NewValidateEmailBadRequestResponseBody(res *survey.BadRequestError) *ValidateEmailBadRequestResponseBody {
+	body := &ValidateEmailBadRequestResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
 	return body
 }
 
-// NewDeleteSurveyBadRequestResponseBody builds the HTTP response body from the
-// result of the "delete_survey" endpoint of the "survey" service.
-func NewDeleteSurveyBadRequestResponseBody(res *survey.BadRequestError) *DeleteSurveyBadRequestResponseBody {
-	body := &DeleteSurveyBadRequestResponseBody{
+// NewValidateEmailForbiddenResponseBody builds the HTTP response body from the
+// result of the "validate_email" endpoint of the "survey" service.
+func NewValidateEmailForbiddenResponseBody(res *survey.ForbiddenError) *ValidateEmailForbiddenResponseBody {
+	body := &ValidateEmailForbiddenResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteSurveyForbiddenResponseBody builds the HTTP response body from the
-// result of the "delete_survey" endpoint of the "survey" service.
-func NewDeleteSurveyForbiddenResponseBody(res *survey.ForbiddenError) *DeleteSurveyForbiddenResponseBody {
-	body := &DeleteSurveyForbiddenResponseBody{
+// NewValidateEmailInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "validate_email" endpoint of the "survey"
+// service.
+func NewValidateEmailInternalServerErrorResponseBody(res *survey.InternalServerError) *ValidateEmailInternalServerErrorResponseBody {
+	body := &ValidateEmailInternalServerErrorResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteSurveyInternalServerErrorResponseBody builds the HTTP response body
-// from the result of the "delete_survey" endpoint of the "survey" service.
-func NewDeleteSurveyInternalServerErrorResponseBody(res *survey.InternalServerError) *DeleteSurveyInternalServerErrorResponseBody {
-	body := &DeleteSurveyInternalServerErrorResponseBody{
+// NewValidateEmailUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "validate_email" endpoint of the "survey" service.
+func NewValidateEmailUnauthorizedResponseBody(res *survey.UnauthorizedError) *ValidateEmailUnauthorizedResponseBody {
+	body := &ValidateEmailUnauthorizedResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteSurveyNotFoundResponseBody builds the HTTP response body from the
-// result of the "delete_survey" endpoint of the "survey" service.
-func NewDeleteSurveyNotFoundResponseBody(res *survey.NotFoundError) *DeleteSurveyNotFoundResponseBody {
-	body := &DeleteSurveyNotFoundResponseBody{
+// NewListSurveyTemplatesBadRequestResponseBody builds the HTTP response body
+// from the result of the "list_survey_templates" endpoint of the "survey"
+// service.
+func NewListSurveyTemplatesBadRequestResponseBody(res *survey.BadRequestError) *ListSurveyTemplatesBadRequestResponseBody {
+	body := &ListSurveyTemplatesBadRequestResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
 	return body
 }
 
-// NewDeleteSurveyServiceUnavailableResponseBody builds the HTTP response body
-// from the result of the "delete_survey" endpoint of the "survey" service.
-func NewDeleteSurveyServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *DeleteSurveyServiceUnavailableResponseBody {
-	body := &DeleteSurveyServiceUnavailableResponseBody{
+// NewListSurveyTemplatesForbiddenResponseBody builds the HTTP response body
+// from the result of the "list_survey_templates" endpoint of the "survey"
+// service.
+func NewListSurveyTemplatesForbiddenResponseBody(res *survey.ForbiddenError) *ListSurveyTemplatesForbiddenResponseBody {
+	body := &ListSurveyTemplatesForbiddenResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteSurveyUnauthorizedResponseBody builds the HTTP response body from
-// the result of the "delete_survey" endpoint of the "survey" service.
-func NewDeleteSurveyUnauthorizedResponseBody(res *survey.UnauthorizedError) *DeleteSurveyUnauthorizedResponseBody {
-	body := &DeleteSurveyUnauthorizedResponseBody{
+// NewListSurveyTemplatesInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "list_survey_templates" endpoint of the
+// "survey" service.
+func NewListSurveyTemplatesInternalServerErrorResponseBody(res *survey.InternalServerError) *ListSurveyTemplatesInternalServerErrorResponseBody {
+	body := &ListSurveyTemplatesInternalServerErrorResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewBulkResendSurveyBadRequestResponseBody builds the HTTP response body from
-// the result of the "bulk_resend_survey" endpoint of the "survey" service.
-func NewBulkResendSurveyBadRequestResponseBody(res *survey.BadRequestError) *BulkResendSurveyBadRequestResponseBody {
-	body := &BulkResendSurveyBadRequestResponseBody{
+// NewListSurveyTemplatesServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "list_survey_templates" endpoint of the
+// "survey" service.
+func NewListSurveyTemplatesServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *ListSurveyTemplatesServiceUnavailableResponseBody {
+	body := &ListSurveyTemplatesServiceUnavailableResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewBulkResendSurveyForbiddenResponseBody builds the HTTP response body from
-// the result of the "bulk_resend_survey" endpoint of the "survey" service.
-func NewBulkResendSurveyForbiddenResponseBody(res *survey.ForbiddenError) *BulkResendSurveyForbiddenResponseBody {
-	body := &BulkResendSurveyForbiddenResponseBody{
+// NewListSurveyTemplatesUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "list_survey_templates" endpoint of the "survey"
+// service.
+func NewListSurveyTemplatesUnauthorizedResponseBody(res *survey.UnauthorizedError) *ListSurveyTemplatesUnauthorizedResponseBody {
+	body := &ListSurveyTemplatesUnauthorizedResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewBulkResendSurveyInternalServerErrorResponseBody builds the HTTP response
-// body from the result of the "bulk_resend_survey" endpoint of the "survey"
+// NewGetSurveyTemplateBadRequestResponseBody builds the HTTP response body
+// from the result of the "get_survey_template" endpoint of the "survey"
 // service.
-func NewBulkResendSurveyInternalServerErrorResponseBody(res *survey.InternalServerError) *BulkResendSurveyInternalServerErrorResponseBody {
-	body := &BulkResendSurveyInternalServerErrorResponseBody{
+func NewGetSurveyTemplateBadRequestResponseBody(res *survey.BadRequestError) *GetSurveyTemplateBadRequestResponseBody {
+	body := &GetSurveyTemplateBadRequestResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
 	return body
 }
 
-// NewBulkResendSurveyNotFoundResponseBody builds the HTTP response body from
-// the result of the "bulk_resend_survey" endpoint of the "survey" service.
-func NewBulkResendSurveyNotFoundResponseBody(res *survey.NotFoundError) *BulkResendSurveyNotFoundResponseBody {
-	body := &BulkResendSurveyNotFoundResponseBody{
+// NewGetSurveyTemplateForbiddenResponseBody builds the HTTP response body from
+// the result of the "get_survey_template" endpoint of the "survey" service.
+func NewGetSurveyTemplateForbiddenResponseBody(res *survey.ForbiddenError) *GetSurveyTemplateForbiddenResponseBody {
+	body := &GetSurveyTemplateForbiddenResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewBulkResendSurveyServiceUnavailableResponseBody builds the HTTP response
-// body from the result of the "bulk_resend_survey" endpoint of the "survey"
+// NewGetSurveyTemplateInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "get_survey_template" endpoint of the "survey"
 // service.
-func NewBulkResendSurveyServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *BulkResendSurveyServiceUnavailableResponseBody {
-	body := &BulkResendSurveyServiceUnavailableResponseBody{
+func NewGetSurveyTemplateInternalServerErrorResponseBody(res *survey.InternalServerError) *GetSurveyTemplateInternalServerErrorResponseBody {
+	body := &GetSurveyTemplateInternalServerErrorResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewBulkResendSurveyUnauthorizedResponseBody builds the HTTP response body
-// from the result of the "bulk_resend_survey" endpoint of the "survey" service.
-func NewBulkResendSurveyUnauthorizedResponseBody(res *survey.UnauthorizedError) *BulkResendSurveyUnauthorizedResponseBody {
-	body := &BulkResendSurveyUnauthorizedResponseBody{
+// NewGetSurveyTemplateNotFoundResponseBody builds the HTTP response body from
+// the result of the "get_survey_template" endpoint of the "survey" service.
+func NewGetSurveyTemplateNotFoundResponseBody(res *survey.NotFoundError) *GetSurveyTemplateNotFoundResponseBody {
+	body := &GetSurveyTemplateNotFoundResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewPreviewSendSurveyBadRequestResponseBody builds the HTTP response body
-// from the result of the "preview_send_survey" endpoint of the "survey"
+// NewGetSurveyTemplateServiceUnavailableResponseBody builds the HTTP response
+// body from the result of the "get_survey_template" endpoint of the "survey"
 // service.
-func NewPreviewSendSurveyBadRequestResponseBody(res *survey.BadRequestError) *PreviewSendSurveyBadRequestResponseBody {
-	body := &PreviewSendSurveyBadRequestResponseBody{
+func NewGetSurveyTemplateServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *GetSurveyTemplateServiceUnavailableResponseBody {
+	body := &GetSurveyTemplateServiceUnavailableResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewPreviewSendSurveyForbiddenResponseBody builds the HTTP response body from
-// the result of the "preview_send_survey" endpoint of the "survey" service.
-func NewPreviewSendSurveyForbiddenResponseBody(res *survey.ForbiddenError) *PreviewSendSurveyForbiddenResponseBody {
-	body := &PreviewSendSurveyForbiddenResponseBody{
+// NewGetSurveyTemplateUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "get_survey_template" endpoint of the "survey"
+// service.
+func NewGetSurveyTemplateUnauthorizedResponseBody(res *survey.UnauthorizedError) *GetSurveyTemplateUnauthorizedResponseBody {
+	body := &GetSurveyTemplateUnauthorizedResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewPreviewSendSurveyInternalServerErrorResponseBody builds the HTTP response
-// body from the result of the "preview_send_survey" endpoint of the "survey"
-// service.
-func NewPreviewSendSurveyInternalServerErrorResponseBody(res *survey.InternalServerError) *PreviewSendSurveyInternalServerErrorResponseBody {
-	body := &PreviewSendSurveyInternalServerErrorResponseBody{
+// NewListDeadLettersBadRequestResponseBody builds the HTTP response body from
+// the result of the "list_dead_letters" endpoint of the "survey" service.
+func NewListDeadLettersBadRequestResponseBody(res *survey.BadRequestError) *ListDeadLettersBadRequestResponseBody {
+	body := &ListDeadLettersBadRequestResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
 	return body
 }
 
-// NewPreviewSendSurveyNotFoundResponseBody builds the HTTP response body from
-// the result of the "preview_send_survey" endpoint of the "survey" service.
-func NewPreviewSendSurveyNotFoundResponseBody(res *survey.NotFoundError) *PreviewSendSurveyNotFoundResponseBody {
-	body := &PreviewSendSurveyNotFoundResponseBody{
+// NewListDeadLettersForbiddenResponseBody builds the HTTP response body from
+// the result of the "list_dead_letters" endpoint of the "survey" service.
+func NewListDeadLettersForbiddenResponseBody(res *survey.ForbiddenError) *ListDeadLettersForbiddenResponseBody {
+	body := &ListDeadLettersForbiddenResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewPreviewSendSurveyServiceUnavailableResponseBody builds the HTTP response
-// body from the result of the "preview_send_survey" endpoint of the "survey"
+// NewListDeadLettersInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "list_dead_letters" endpoint of the "survey"
 // service.
-func NewPreviewSendSurveyServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *PreviewSendSurveyServiceUnavailableResponseBody {
-	body := &PreviewSendSurveyServiceUnavailableResponseBody{
+func NewListDeadLettersInternalServerErrorResponseBody(res *survey.InternalServerError) *ListDeadLettersInternalServerErrorResponseBody {
+	body := &ListDeadLettersInternalServerErrorResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewPreviewSendSurveyUnauthorizedResponseBody builds the HTTP response body
-// from the result of the "preview_send_survey" endpoint of the "survey"
+// NewListDeadLettersServiceUnavailableResponseBody builds the HTTP response
+// body from the result of the "list_dead_letters" endpoint of the "survey"
 // service.
-func NewPreviewSendSurveyUnauthorizedResponseBody(res *survey.UnauthorizedError) *PreviewSendSurveyUnauthorizedResponseBody {
-	body := &PreviewSendSurveyUnauthorizedResponseBody{
+func NewListDeadLettersServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *ListDeadLettersServiceUnavailableResponseBody {
+	body := &ListDeadLettersServiceUnavailableResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewSendMissingRecipientsBadRequestResponseBody builds the HTTP response body
-// from the result of the "send_missing_recipients" endpoint of the "survey"
-// service.
-func NewSendMissingRecipientsBadRequestResponseBody(res *survey.BadRequestError) *SendMissingRecipientsBadRequestResponseBody {
-	body := &SendMissingRecipientsBadRequestResponseBody{
+// NewListDeadLettersUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "list_dead_letters" endpoint of the "survey" service.
+func NewListDeadLettersUnauthorizedResponseBody(res *survey.UnauthorizedError) *ListDeadLettersUnauthorizedResponseBody {
+	body := &ListDeadLettersUnauthorizedResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewSendMissingRecipientsForbiddenResponseBody builds the HTTP response body
-// from the result of the "send_missing_recipients" endpoint of the "survey"
+// NewGetDeadLetterBadRequestResponseBody builds the HTTP response body from
+// the result of the "get_dead_letter" endpoint of the "survey" service.
+func NewGetDeadLetterBadRequestResponseBody(res *survey.BadRequestError) *GetDeadLetterBadRequestResponseBody {
+	body := &GetDeadLetterBadRequestResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
+	return body
+}
+
+// NewGetDeadLetterForbiddenResponseBody builds the HTTP response body from the
+// result of the "get_dead_letter" endpoint of the "survey" service.
+func NewGetDeadLetterForbiddenResponseBody(res *survey.ForbiddenError) *GetDeadLetterForbiddenResponseBody {
+	body := &GetDeadLetterForbiddenResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetDeadLetterInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "get_dead_letter" endpoint of the "survey"
 // service.
-func NewSendMissingRecipientsForbiddenResponseBody(res *survey.ForbiddenError) *SendMissingRecipientsForbiddenResponseBody {
-	body := &SendMissingRecipientsForbiddenResponseBody{
+func NewGetDeadLetterInternalServerErrorResponseBody(res *survey.InternalServerError) *GetDeadLetterInternalServerErrorResponseBody {
+	body := &GetDeadLetterInternalServerErrorResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewSendMissingRecipientsInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "send_missing_recipients" endpoint of
-// the "survey" service.
-func NewSendMissingRecipientsInternalServerErrorResponseBody(res *survey.InternalServerError) *SendMissingRecipientsInternalServerErrorResponseBody {
-	body := &SendMissingRecipientsInternalServerErrorResponseBody{
+// NewGetDeadLetterNotFoundResponseBody builds the HTTP response body from the
+// result of the "get_dead_letter" endpoint of the "survey" service.
+func NewGetDeadLetterNotFoundResponseBody(res *survey.NotFoundError) *GetDeadLetterNotFoundResponseBody {
+	body := &GetDeadLetterNotFoundResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetDeadLetterServiceUnavailableResponseBody builds the HTTP response body
+// from the result of the "get_dead_letter" endpoint of the "survey" service.
+func NewGetDeadLetterServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *GetDeadLetterServiceUnavailableResponseBody {
+	body := &GetDeadLetterServiceUnavailableResponseBody{
+		Code:    res.Code,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetDeadLetterUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "get_dead_letter" endpoint of the "survey" service.
+func NewGetDeadLetterUnauthorizedResponseBody(res *survey.UnauthorizedError) *GetDeadLetterUnauthorizedResponseBody {
+	body := &GetDeadLetterUnauthorizedResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewSendMissingRecipientsNotFoundResponseBody builds the HTTP response body
-// from the result of the "send_missing_recipients" endpoint of the "survey"
-// service.
-func NewSendMissingRecipientsNotFoundResponseBody(res *survey.NotFoundError) *SendMissingRecipientsNotFoundResponseBody {
-	body := &SendMissingRecipientsNotFoundResponseBody{
+// NewReplayDeadLetterBadRequestResponseBody builds the HTTP response body from
+// the result of the "replay_dead_letter" endpoint of the "survey" service.
+func NewReplayDeadLetterBadRequestResponseBody(res *survey.BadRequestError) *ReplayDeadLetterBadRequestResponseBody {
+	body := &ReplayDeadLetterBadRequestResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
 	return body
 }
 
-// NewSendMissingRecipientsServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "send_missing_recipients" endpoint of
-// the "survey" service.
-func NewSendMissingRecipientsServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *SendMissingRecipientsServiceUnavailableResponseBody {
-	body := &SendMissingRecipientsServiceUnavailableResponseBody{
+// NewReplayDeadLetterForbiddenResponseBody builds the HTTP response body from
+// the result of the "replay_dead_letter" endpoint of the "survey" service.
+func NewReplayDeadLetterForbiddenResponseBody(res *survey.ForbiddenError) *ReplayDeadLetterForbiddenResponseBody {
+	body := &ReplayDeadLetterForbiddenResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewSendMissingRecipientsUnauthorizedResponseBody builds the HTTP response
-// body from the result of the "send_missing_recipients" endpoint of the
-// "survey" service.
-func NewSendMissingRecipientsUnauthorizedResponseBody(res *survey.UnauthorizedError) *SendMissingRecipientsUnauthorizedResponseBody {
-	body := &SendMissingRecipientsUnauthorizedResponseBody{
+// NewReplayDeadLetterInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "replay_dead_letter" endpoint of the "survey"
+// service.
+func NewReplayDeadLetterInternalServerErrorResponseBody(res *survey.InternalServerError) *ReplayDeadLetterInternalServerErrorResponseBody {
+	body := &ReplayDeadLetterInternalServerErrorResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteSurveyResponseBadRequestResponseBody builds the HTTP response body
-// from the result of the "delete_survey_response" endpoint of the "survey"
-// service.
-func NewDeleteSurveyResponseBadRequestResponseBody(res *survey.BadRequestError) *DeleteSurveyResponseBadRequestResponseBody {
-	body := &DeleteSurveyResponseBadRequestResponseBody{
+// NewReplayDeadLetterNotFoundResponseBody builds the HTTP response body from
+// the result of the "replay_dead_letter" endpoint of the "survey" service.
+func NewReplayDeadLetterNotFoundResponseBody(res *survey.NotFoundError) *ReplayDeadLetterNotFoundResponseBody {
+	body := &ReplayDeadLetterNotFoundResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteSurveyResponseForbiddenResponseBody builds the HTTP response body
-// from the result of the "delete_survey_response" endpoint of the "survey"
+// NewReplayDeadLetterServiceUnavailableResponseBody builds the HTTP response
+// body from the result of the "replay_dead_letter" endpoint of the "survey"
 // service.
-func NewDeleteSurveyResponseForbiddenResponseBody(res *survey.ForbiddenError) *DeleteSurveyResponseForbiddenResponseBody {
-	body := &DeleteSurveyResponseForbiddenResponseBody{
+func NewReplayDeadLetterServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *ReplayDeadLetterServiceUnavailableResponseBody {
+	body := &ReplayDeadLetterServiceUnavailableResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteSurveyResponseInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "delete_survey_response" endpoint of
-// the "survey" service.
-func NewDeleteSurveyResponseInternalServerErrorResponseBody(res *survey.InternalServerError) *DeleteSurveyResponseInternalServerErrorResponseBody {
-	body := &DeleteSurveyResponseInternalServerErrorResponseBody{
+// NewReplayDeadLetterUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "replay_dead_letter" endpoint of the "survey" service.
+func NewReplayDeadLetterUnauthorizedResponseBody(res *survey.UnauthorizedError) *ReplayDeadLetterUnauthorizedResponseBody {
+	body := &ReplayDeadLetterUnauthorizedResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteSurveyResponseNotFoundResponseBody builds the HTTP response body
-// from the result of the "delete_survey_response" endpoint of the "survey"
-// service.
-func NewDeleteSurveyResponseNotFoundResponseBody(res *survey.NotFoundError) *DeleteSurveyResponseNotFoundResponseBody {
-	body := &DeleteSurveyResponseNotFoundResponseBody{
+// NewStartReindexBadRequestResponseBody builds the HTTP response body from the
+// result of the "start_reindex" endpoint of the "survey" service.
+func NewStartReindexBadRequestResponseBody(res *survey.BadRequestError) *StartReindexBadRequestResponseBody {
+	body := &StartReindexBadRequestResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
 	return body
 }
 
-// NewDeleteSurveyResponseServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "delete_survey_response" endpoint of
-// the "survey" service.
-func NewDeleteSurveyResponseServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *DeleteSurveyResponseServiceUnavailableResponseBody {
-	body := &DeleteSurveyResponseServiceUnavailableResponseBody{
+// NewStartReindexConflictResponseBody builds the HTTP response body from the
+// result of the "start_reindex" endpoint of the "survey" service.
+func NewStartReindexConflictResponseBody(res *survey.ConflictError) *StartReindexConflictResponseBody {
+	body := &StartReindexConflictResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteSurveyResponseUnauthorizedResponseBody builds the HTTP response
-// body from the result of the "delete_survey_response" endpoint of the
-// "survey" service.
-func NewDeleteSurveyResponseUnauthorizedResponseBody(res *survey.UnauthorizedError) *DeleteSurveyResponseUnauthorizedResponseBody {
-	body := &DeleteSurveyResponseUnauthorizedResponseBody{
+// NewStartReindexForbiddenResponseBody builds the HTTP response body from the
+// result of the "start_reindex" endpoint of the "survey" service.
+func NewStartReindexForbiddenResponseBody(res *survey.ForbiddenError) *StartReindexForbiddenResponseBody {
+	body := &StartReindexForbiddenResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewResendSurveyResponseBadRequestResponseBody builds the HTTP response body
-// from the result of the "resend_survey_response" endpoint of the "survey"
-// service.
-func NewResendSurveyResponseBadRequestResponseBody(res *survey.BadRequestError) *ResendSurveyResponseBadRequestResponseBody {
-	body := &ResendSurveyResponseBadRequestResponseBody{
+// NewStartReindexInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "start_reindex" endpoint of the "survey" service.
+func NewStartReindexInternalServerErrorResponseBody(res *survey.InternalServerError) *StartReindexInternalServerErrorResponseBody {
+	body := &StartReindexInternalServerErrorResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewResendSurveyResponseForbiddenResponseBody builds the HTTP response body
-// from the result of the "resend_survey_response" endpoint of the "survey"
-// service.
-func NewResendSurveyResponseForbiddenResponseBody(res *survey.ForbiddenError) *ResendSurveyResponseForbiddenResponseBody {
-	body := &ResendSurveyResponseForbiddenResponseBody{
+// NewStartReindexServiceUnavailableResponseBody builds the HTTP response body
+// from the result of the "start_reindex" endpoint of the "survey" service.
+func NewStartReindexServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *StartReindexServiceUnavailableResponseBody {
+	body := &StartReindexServiceUnavailableResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewResendSurveyResponseInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "resend_survey_response" endpoint of
-// the "survey" service.
-func NewResendSurveyResponseInternalServerErrorResponseBody(res *survey.InternalServerError) *ResendSurveyResponseInternalServerErrorResponseBody {
-	body := &ResendSurveyResponseInternalServerErrorResponseBody{
+// NewStartReindexUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "start_reindex" endpoint of the "survey" service.
+func NewStartReindexUnauthorizedResponseBody(res *survey.UnauthorizedError) *StartReindexUnauthorizedResponseBody {
+	body := &StartReindexUnauthorizedResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewResendSurveyResponseNotFoundResponseBody builds the HTTP response body
-// from the result of the "resend_survey_response" endpoint of the "survey"
-// service.
-func NewResendSurveyResponseNotFoundResponseBody(res *survey.NotFoundError) *ResendSurveyResponseNotFoundResponseBody {
-	body := &ResendSurveyResponseNotFoundResponseBody{
+// NewGetReindexBadRequestResponseBody builds the HTTP response body from the
+// result of the "get_reindex" endpoint of the "survey" service.
+func NewGetReindexBadRequestResponseBody(res *survey.BadRequestError) *GetReindexBadRequestResponseBody {
+	body := &GetReindexBadRequestResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
 	return body
 }
 
-// NewResendSurveyResponseServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "resend_survey_response" endpoint of
-// the "survey" service.
-func NewResendSurveyResponseServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *ResendSurveyResponseServiceUnavailableResponseBody {
-	body := &ResendSurveyResponseServiceUnavailableResponseBody{
+// NewGetReindexForbiddenResponseBody builds the HTTP response body from the
+// result of the "get_reindex" endpoint of the "survey" service.
+func NewGetReindexForbiddenResponseBody(res *survey.ForbiddenError) *GetReindexForbiddenResponseBody {
+	body := &GetReindexForbiddenResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewResendSurveyResponseUnauthorizedResponseBody builds the HTTP response
-// body from the result of the "resend_survey_response" endpoint of the
-// "survey" service.
-func NewResendSurveyResponseUnauthorizedResponseBody(res *survey.UnauthorizedError) *ResendSurveyResponseUnauthorizedResponseBody {
-	body := &ResendSurveyResponseUnauthorizedResponseBody{
+// NewGetReindexInternalServerErrorResponseBody builds the HTTP response body
+// from the result of the "get_reindex" endpoint of the "survey" service.
+func NewGetReindexInternalServerErrorResponseBody(res *survey.InternalServerError) *GetReindexInternalServerErrorResponseBody {
+	body := &GetReindexInternalServerErrorResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteRecipientGroupBadRequestResponseBody builds the HTTP response body
-// from the result of the "delete_recipient_group" endpoint of the "survey"
-// service.
-func NewDeleteRecipientGroupBadRequestResponseBody(res *survey.BadRequestError) *DeleteRecipientGroupBadRequestResponseBody {
-	body := &DeleteRecipientGroupBadRequestResponseBody{
+// NewGetReindexNotFoundResponseBody builds the HTTP response body from the
+// result of the "get_reindex" endpoint of the "survey" service.
+func NewGetReindexNotFoundResponseBody(res *survey.NotFoundError) *GetReindexNotFoundResponseBody {
+	body := &GetReindexNotFoundResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteRecipientGroupForbiddenResponseBody builds the HTTP response body
-// from the result of the "delete_recipient_group" endpoint of the "survey"
-// service.
-func NewDeleteRecipientGroupForbiddenResponseBody(res *survey.ForbiddenError) *DeleteRecipientGroupForbiddenResponseBody {
-	body := &DeleteRecipientGroupForbiddenResponseBody{
+// NewGetReindexServiceUnavailableResponseBody builds the HTTP response body
+// from the result of the "get_reindex" endpoint of the "survey" service.
+func NewGetReindexServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *GetReindexServiceUnavailableResponseBody {
+	body := &GetReindexServiceUnavailableResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteRecipientGroupInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "delete_recipient_group" endpoint of
-// the "survey" service.
-func NewDeleteRecipientGroupInternalServerErrorResponseBody(res *survey.InternalServerError) *DeleteRecipientGroupInternalServerErrorResponseBody {
-	body := &DeleteRecipientGroupInternalServerErrorResponseBody{
+// NewGetReindexUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "get_reindex" endpoint of the "survey" service.
+func NewGetReindexUnauthorizedResponseBody(res *survey.UnauthorizedError) *GetReindexUnauthorizedResponseBody {
+	body := &GetReindexUnauthorizedResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteRecipientGroupNotFoundResponseBody builds the HTTP response body
-// from the result of the "delete_recipient_group" endpoint of the "survey"
-// service.
-func NewDeleteRecipientGroupNotFoundResponseBody(res *survey.NotFoundError) *DeleteRecipientGroupNotFoundResponseBody {
-	body := &DeleteRecipientGroupNotFoundResponseBody{
+// NewCancelReindexBadRequestResponseBody builds the HTTP response body from
+// the result of the "cancel_reindex" endpoint of the "survey" service.
+func NewCancelReindexBadRequestResponseBody(res *survey.BadRequestError) *CancelReindexBadRequestResponseBody {
+	body := &CancelReindexBadRequestResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
 	return body
 }
 
-// NewDeleteRecipientGroupServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "delete_recipient_group" endpoint of
-// the "survey" service.
-func NewDeleteRecipientGroupServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *DeleteRecipientGroupServiceUnavailableResponseBody {
-	body := &DeleteRecipientGroupServiceUnavailableResponseBody{
+// NewCancelReindexConflictResponseBody builds the HTTP response body from the
+// result of the "cancel_reindex" endpoint of the "survey" service.
+func NewCancelReindexConflictResponseBody(res *survey.ConflictError) *CancelReindexConflictResponseBody {
+	body := &CancelReindexConflictResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteRecipientGroupUnauthorizedResponseBody builds the HTTP response
-// body from the result of the "delete_recipient_group" endpoint of the
-// "survey" service.
-func NewDeleteRecipientGroupUnauthorizedResponseBody(res *survey.UnauthorizedError) *DeleteRecipientGroupUnauthorizedResponseBody {
-	body := &DeleteRecipientGroupUnauthorizedResponseBody{
+// NewCancelReindexForbiddenResponseBody builds the HTTP response body from the
+// result of the "cancel_reindex" endpoint of the "survey" service.
+func NewCancelReindexForbiddenResponseBody(res *survey.ForbiddenError) *CancelReindexForbiddenResponseBody {
+	body := &CancelReindexForbiddenResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewCreateExclusionBadRequestResponseBody builds the HTTP response body from
-// the result of the "create_exclusion" endpoint of the "survey" service.
-func NewCreateExclusionBadRequestResponseBody(res *survey.BadRequestError) *CreateExclusionBadRequestResponseBody {
-	body := &CreateExclusionBadRequestResponseBody{
+// NewCancelReindexInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "cancel_reindex" endpoint of the "survey"
+// service.
+func NewCancelReindexInternalServerErrorResponseBody(res *survey.InternalServerError) *CancelReindexInternalServerErrorResponseBody {
+	body := &CancelReindexInternalServerErrorResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewCreateExclusionForbiddenResponseBody builds the HTTP response body from
-// the result of the "create_exclusion" endpoint of the "survey" service.
-func NewCreateExclusionForbiddenResponseBody(res *survey.ForbiddenError) *CreateExclusionForbiddenResponseBody {
-	body := &CreateExclusionForbiddenResponseBody{
+// NewCancelReindexServiceUnavailableResponseBody builds the HTTP response body
+// from the result of the "cancel_reindex" endpoint of the "survey" service.
+func NewCancelReindexServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *CancelReindexServiceUnavailableResponseBody {
+	body := &CancelReindexServiceUnavailableResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewCreateExclusionInternalServerErrorResponseBody builds the HTTP response
-// body from the result of the "create_exclusion" endpoint of the "survey"
-// service.
-func NewCreateExclusionInternalServerErrorResponseBody(res *survey.InternalServerError) *CreateExclusionInternalServerErrorResponseBody {
-	body := &CreateExclusionInternalServerErrorResponseBody{
+// NewCancelReindexUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "cancel_reindex" endpoint of the "survey" service.
+func NewCancelReindexUnauthorizedResponseBody(res *survey.UnauthorizedError) *CancelReindexUnauthorizedResponseBody {
+	body := &CancelReindexUnauthorizedResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewCreateExclusionUnauthorizedResponseBody builds the HTTP response body
-// from the result of the "create_exclusion" endpoint of the "survey" service.
-func NewCreateExclusionUnauthorizedResponseBody(res *survey.UnauthorizedError) *CreateExclusionUnauthorizedResponseBody {
-	body := &CreateExclusionUnauthorizedResponseBody{
+// NewRerunInviteEnrichmentBadRequestResponseBody builds the HTTP response body
+// from the result of the "rerun_invite_enrichment" endpoint of the "survey"
+// service.
+func NewRerunInviteEnrichmentBadRequestResponseBody(res *survey.BadRequestError) *RerunInviteEnrichmentBadRequestResponseBody {
+	body := &RerunInviteEnrichmentBadRequestResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
 	return body
 }
 
-// NewDeleteExclusionBadRequestResponseBody builds the HTTP response body from
-// the result of the "delete_exclusion" endpoint of the "survey" service.
-func NewDeleteExclusionBadRequestResponseBody(res *survey.BadRequestError) *DeleteExclusionBadRequestResponseBody {
-	body := &DeleteExclusionBadRequestResponseBody{
+// NewRerunInviteEnrichmentForbiddenResponseBody builds the HTTP response body
+// from the result of the "rerun_invite_enrichment" endpoint of the "survey"
+// service.
+func NewRerunInviteEnrichmentForbiddenResponseBody(res *survey.ForbiddenError) *RerunInviteEnrichmentForbiddenResponseBody {
+	body := &RerunInviteEnrichmentForbiddenResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteExclusionForbiddenResponseBody builds the HTTP response body from
-// the result of the "delete_exclusion" endpoint of the "survey" service.
-func NewDeleteExclusionForbiddenResponseBody(res *survey.ForbiddenError) *DeleteExclusionForbiddenResponseBody {
-	body := &DeleteExclusionForbiddenResponseBody{
+// NewRerunInviteEnrichmentInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "rerun_invite_enrichment" endpoint of
+// the "survey" service.
+func NewRerunInviteEnrichmentInternalServerErrorResponseBody(res *survey.InternalServerError) *RerunInviteEnrichmentInternalServerErrorResponseBody {
+	body := &RerunInviteEnrichmentInternalServerErrorResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteExclusionInternalServerErrorResponseBody builds the HTTP response
-// body from the result of the "delete_exclusion" endpoint of the "survey"
+// NewRerunInviteEnrichmentNotFoundResponseBody builds the HTTP response body
+// from the result of the "rerun_invite_enrichment" endpoint of the "survey"
 // service.
-func NewDeleteExclusionInternalServerErrorResponseBody(res *survey.InternalServerError) *DeleteExclusionInternalServerErrorResponseBody {
-	body := &DeleteExclusionInternalServerErrorResponseBody{
+func NewRerunInviteEnrichmentNotFoundResponseBody(res *survey.NotFoundError) *RerunInviteEnrichmentNotFoundResponseBody {
+	body := &RerunInviteEnrichmentNotFoundResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteExclusionUnauthorizedResponseBody builds the HTTP response body
-// from the result of the "delete_exclusion" endpoint of the "survey" service.
-func NewDeleteExclusionUnauthorizedResponseBody(res *survey.UnauthorizedError) *DeleteExclusionUnauthorizedResponseBody {
-	body := &DeleteExclusionUnauthorizedResponseBody{
+// NewRerunInviteEnrichmentServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "rerun_invite_enrichment" endpoint of
+// the "survey" service.
+func NewRerunInviteEnrichmentServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *RerunInviteEnrichmentServiceUnavailableResponseBody {
+	body := &RerunInviteEnrichmentServiceUnavailableResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetExclusionBadRequestResponseBody builds the HTTP response body from the
-// result of the "get_exclusion" endpoint of the "survey" service.
-func NewGetExclusionBadRequestResponseBody(res *survey.BadRequestError) *GetExclusionBadRequestResponseBody {
-	body := &GetExclusionBadRequestResponseBody{
+// NewRerunInviteEnrichmentUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "rerun_invite_enrichment" endpoint of the
+// "survey" service.
+func NewRerunInviteEnrichmentUnauthorizedResponseBody(res *survey.UnauthorizedError) *RerunInviteEnrichmentUnauthorizedResponseBody {
+	body := &RerunInviteEnrichmentUnauthorizedResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetExclusionForbiddenResponseBody builds the HTTP response body from the
-// result of the "get_exclusion" endpoint of the "survey" service.
-func NewGetExclusionForbiddenResponseBody(res *survey.ForbiddenError) *GetExclusionForbiddenResponseBody {
-	body := &GetExclusionForbiddenResponseBody{
+// NewStartInviteBackfillBadRequestResponseBody builds the HTTP response body
+// from the result of the "start_invite_backfill" endpoint of the "survey"
+// service.
+func NewStartInviteBackfillBadRequestResponseBody(res *survey.BadRequestError) *StartInviteBackfillBadRequestResponseBody {
+	body := &StartInviteBackfillBadRequestResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
 	return body
 }
 
-// NewGetExclusionInternalServerErrorResponseBody builds the HTTP response body
-// from the result of the "get_exclusion" endpoint of the "survey" service.
-func NewGetExclusionInternalServerErrorResponseBody(res *survey.InternalServerError) *GetExclusionInternalServerErrorResponseBody {
-	body := &GetExclusionInternalServerErrorResponseBody{
+// NewStartInviteBackfillConflictResponseBody builds the HTTP response body
+// from the result of the "start_invite_backfill" endpoint of the "survey"
+// service.
+func NewStartInviteBackfillConflictResponseBody(res *survey.ConflictError) *StartInviteBackfillConflictResponseBody {
+	body := &StartInviteBackfillConflictResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetExclusionNotFoundResponseBody builds the HTTP response body from the
-// result of the "get_exclusion" endpoint of the "survey" service.
-func NewGetExclusionNotFoundResponseBody(res *survey.NotFoundError) *GetExclusionNotFoundResponseBody {
-	body := &GetExclusionNotFoundResponseBody{
+// NewStartInviteBackfillForbiddenResponseBody builds the HTTP response body
+// from the result of the "start_invite_backfill" endpoint of the "survey"
+// service.
+func NewStartInviteBackfillForbiddenResponseBody(res *survey.ForbiddenError) *StartInviteBackfillForbiddenResponseBody {
+	body := &StartInviteBackfillForbiddenResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetExclusionUnauthorizedResponseBody builds the HTTP response body from
-// the result of the "get_exclusion" endpoint of the "survey" service.
-func NewGetExclusionUnauthorizedResponseBody(res *survey.UnauthorizedError) *GetExclusionUnauthorizedResponseBody {
-	body := &GetExclusionUnauthorizedResponseBody{
+// NewStartInviteBackfillInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "start_invite_backfill" endpoint of the
+// "survey" service.
+func NewStartInviteBackfillInternalServerErrorResponseBody(res *survey.InternalServerError) *StartInviteBackfillInternalServerErrorResponseBody {
+	body := &StartInviteBackfillInternalServerErrorResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteExclusionByIDBadRequestResponseBody builds the HTTP response body
-// from the result of the "delete_exclusion_by_id" endpoint of the "survey"
-// service.
-func NewDeleteExclusionByIDBadRequestResponseBody(res *survey.BadRequestError) *DeleteExclusionByIDBadRequestResponseBody {
-	body := &DeleteExclusionByIDBadRequestResponseBody{
+// NewStartInviteBackfillServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "start_invite_backfill" endpoint of the
+// "survey" service.
+func NewStartInviteBackfillServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *StartInviteBackfillServiceUnavailableResponseBody {
+	body := &StartInviteBackfillServiceUnavailableResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteExclusionByIDForbiddenResponseBody builds the HTTP response body
-// from the result of the "delete_exclusion_by_id" endpoint of the "survey"
+// NewStartInviteBackfillUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "start_invite_backfill" endpoint of the "survey"
 // service.
-func NewDeleteExclusionByIDForbiddenResponseBody(res *survey.ForbiddenError) *DeleteExclusionByIDForbiddenResponseBody {
-	body := &DeleteExclusionByIDForbiddenResponseBody{
+func NewStartInviteBackfillUnauthorizedResponseBody(res *survey.UnauthorizedError) *StartInviteBackfillUnauthorizedResponseBody {
+	body := &StartInviteBackfillUnauthorizedResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewDeleteExclusionByIDInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "delete_exclusion_by_id" endpoint of
-// the "survey" service.
-func NewDeleteExclusionByIDInternalServerErrorResponseBody(res *survey.InternalServerError) *DeleteExclusionByIDInternalServerErrorResponseBody {
-	body := &DeleteExclusionByIDInternalServerErrorResponseBody{
+// NewGetInviteBackfillBadRequestResponseBody builds the HTTP response body
+// from the result of the "get_invite_backfill" endpoint of the "survey"
+// service.
+func NewGetInviteBackfillBadRequestResponseBody(res *survey.BadRequestError) *GetInviteBackfillBadRequestResponseBody {
+	body := &GetInviteBackfillBadRequestResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
 	return body
 }
 
-// NewDeleteExclusionByIDUnauthorizedResponseBody builds the HTTP response body
-// from the result of the "delete_exclusion_by_id" endpoint of the "survey"
-// service.
-func NewDeleteExclusionByIDUnauthorizedResponseBody(res *survey.UnauthorizedError) *DeleteExclusionByIDUnauthorizedResponseBody {
-	body := &DeleteExclusionByIDUnauthorizedResponseBody{
+// NewGetInviteBackfillForbiddenResponseBody builds the HTTP response body from
+// the result of the "get_invite_backfill" endpoint of the "survey" service.
+func NewGetInviteBackfillForbiddenResponseBody(res *survey.ForbiddenError) *GetInviteBackfillForbiddenResponseBody {
+	body := &GetInviteBackfillForbiddenResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListSurveyResponsesBadRequestResponseBody builds the HTTP response body
-// from the result of the "list_survey_responses" endpoint of the "survey"
+// NewGetInviteBackfillInternalServerErrorResponseBody builds the HTTP response
+// body from the result of the "get_invite_backfill" endpoint of the "survey"
 // service.
-func NewListSurveyResponsesBadRequestResponseBody(res *survey.BadRequestError) *ListSurveyResponsesBadRequestResponseBody {
-	body := &ListSurveyResponsesBadRequestResponseBody{
+func NewGetInviteBackfillInternalServerErrorResponseBody(res *survey.InternalServerError) *GetInviteBackfillInternalServerErrorResponseBody {
+	body := &GetInviteBackfillInternalServerErrorResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListSurveyResponsesForbiddenResponseBody builds the HTTP response body
-// from the result of the "list_survey_responses" endpoint of the "survey"
-// service.
-func NewListSurveyResponsesForbiddenResponseBody(res *survey.ForbiddenError) *ListSurveyResponsesForbiddenResponseBody {
-	body := &ListSurveyResponsesForbiddenResponseBody{
+// NewGetInviteBackfillNotFoundResponseBody builds the HTTP response body from
+// the result of the "get_invite_backfill" endpoint of the "survey" service.
+func NewGetInviteBackfillNotFoundResponseBody(res *survey.NotFoundError) *GetInviteBackfillNotFoundResponseBody {
+	body := &GetInviteBackfillNotFoundResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListSurveyResponsesInternalServerErrorResponseBody builds the HTTP
-// response body from the result of the "list_survey_responses" endpoint of the
-// "survey" service.
-func NewListSurveyResponsesInternalServerErrorResponseBody(res *survey.InternalServerError) *ListSurveyResponsesInternalServerErrorResponseBody {
-	body := &ListSurveyResponsesInternalServerErrorResponseBody{
+// NewGetInviteBackfillServiceUnavailableResponseBody builds the HTTP response
+// body from the result of the "get_invite_backfill" endpoint of the "survey"
+// service.
+func NewGetInviteBackfillServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *GetInviteBackfillServiceUnavailableResponseBody {
+	body := &GetInviteBackfillServiceUnavailableResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListSurveyResponsesNotFoundResponseBody builds the HTTP response body
-// from the result of the "list_survey_responses" endpoint of the "survey"
+// NewGetInviteBackfillUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "get_invite_backfill" endpoint of the "survey"
 // service.
-func NewListSurveyResponsesNotFoundResponseBody(res *survey.NotFoundError) *ListSurveyResponsesNotFoundResponseBody {
-	body := &ListSurveyResponsesNotFoundResponseBody{
+func NewGetInviteBackfillUnauthorizedResponseBody(res *survey.UnauthorizedError) *GetInviteBackfillUnauthorizedResponseBody {
+	body := &GetInviteBackfillUnauthorizedResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewListSurveyResponsesServiceUnavailableResponseBody builds the HTTP
-// response body from the result of the "list_survey_responses" endpoint of the
-// "survey" service.
-func NewListSurveyResponsesServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *ListSurveyResponsesServiceUnavailableResponseBody {
-	body := &ListSurveyResponsesServiceUnavailableResponseBody{
+// NewCancelInviteBackfillBadRequestResponseBody builds the HTTP response body
+// from the result of the "cancel_invite_backfill" endpoint of the "survey"
+// service.
+func NewCancelInviteBackfillBadRequestResponseBody(res *survey.BadRequestError) *CancelInviteBackfillBadRequestResponseBody {
+	body := &CancelInviteBackfillBadRequestResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
+	if res.QuestionErrors != nil {
+		body.QuestionErrors = make([]*QuestionErrorResponseBody, len(res.QuestionErrors))
+		for i, val := range res.QuestionErrors {
+			if val == nil {
+				body.QuestionErrors[i] = nil
+				continue
+			}
+			body.QuestionErrors[i] = marshalSurveyQuestionErrorToQuestionErrorResponseBody(val)
+		}
+	}
 	return body
 }
 
-// NewListSurveyResponsesUnauthorizedResponseBody builds the HTTP response body
-// from the result of the "list_survey_responses" endpoint of the "survey"
+// NewCancelInviteBackfillConflictResponseBody builds the HTTP response body
+// from the result of the "cancel_invite_backfill" endpoint of the "survey"
 // service.
-func NewListSurveyResponsesUnauthorizedResponseBody(res *survey.UnauthorizedError) *ListSurveyResponsesUnauthorizedResponseBody {
-	body := &ListSurveyResponsesUnauthorizedResponseBody{
+func NewCancelInviteBackfillConflictResponseBody(res *survey.ConflictError) *CancelInviteBackfillConflictResponseBody {
+	body := &CancelInviteBackfillConflictResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewValidateEmailBadRequestResponseBody builds the HTTP response body from
-// the result of the "validate_email" endpoint of the "survey" service.
-func NewValidateEmailBadRequestResponseBody(res *survey.BadRequestError) *ValidateEmailBadRequestResponseBody {
-	body := &ValidateEmailBadRequestResponseBody{
+// NewCancelInviteBackfillForbiddenResponseBody builds the HTTP response body
+// from the result of the "cancel_invite_backfill" endpoint of the "survey"
+// service.
+func NewCancelInviteBackfillForbiddenResponseBody(res *survey.ForbiddenError) *CancelInviteBackfillForbiddenResponseBody {
+	body := &CancelInviteBackfillForbiddenResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewValidateEmailForbiddenResponseBody builds the HTTP response body from the
-// result of the "validate_email" endpoint of the "survey" service.
-func NewValidateEmailForbiddenResponseBody(res *survey.ForbiddenError) *ValidateEmailForbiddenResponseBody {
-	body := &ValidateEmailForbiddenResponseBody{
+// NewCancelInviteBackfillInternalServerErrorResponseBody builds the HTTP
+// response body from the result of the "cancel_invite_backfill" endpoint of
+// the "survey" service.
+func NewCancelInviteBackfillInternalServerErrorResponseBody(res *survey.InternalServerError) *CancelInviteBackfillInternalServerErrorResponseBody {
+	body := &CancelInviteBackfillInternalServerErrorResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewValidateEmailInternalServerErrorResponseBody builds the HTTP response
-// body from the result of the "validate_email" endpoint of the "survey"
-// service.
-func NewValidateEmailInternalServerErrorResponseBody(res *survey.InternalServerError) *ValidateEmailInternalServerErrorResponseBody {
-	body := &ValidateEmailInternalServerErrorResponseBody{
+// NewCancelInviteBackfillServiceUnavailableResponseBody builds the HTTP
+// response body from the result of the "cancel_invite_backfill" endpoint of
+// the "survey" service.
+func NewCancelInviteBackfillServiceUnavailableResponseBody(res *survey.ServiceUnavailableError) *CancelInviteBackfillServiceUnavailableResponseBody {
+	body := &CancelInviteBackfillServiceUnavailableResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewValidateEmailUnauthorizedResponseBody builds the HTTP response body from
-// the result of the "validate_email" endpoint of the "survey" service.
-func NewValidateEmailUnauthorizedResponseBody(res *survey.UnauthorizedError) *ValidateEmailUnauthorizedResponseBody {
-	body := &ValidateEmailUnauthorizedResponseBody{
+// NewCancelInviteBackfillUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "cancel_invite_backfill" endpoint of the
+// "survey" service.
+func NewCancelInviteBackfillUnauthorizedResponseBody(res *survey.UnauthorizedError) *CancelInviteBackfillUnauthorizedResponseBody {
+	body := &CancelInviteBackfillUnauthorizedResponseBody{
 		Code:    res.Code,
 		Message: res.Message,
 	}
@@ -2823,6 +7452,172 @@ func NewListSurveyResponsesPayload(surveyUID string, pageToken *string, perPage
 	return v
 }
 
+// NewCreateSurveyResponsePayload builds a survey service
+// create_survey_response endpoint payload.
+func NewCreateSurveyResponsePayload(body *CreateSurveyResponseRequestBody, surveyUID string, token *string) *survey.CreateSurveyResponsePayload {
+	v := &survey.CreateSurveyResponsePayload{
+		SurveyResponseUID: *body.SurveyResponseUID,
+	}
+	v.Answers = make([]*survey.SurveyAnswer, len(body.Answers))
+	for i, val := range body.Answers {
+		if val == nil {
+			v.Answers[i] = nil
+			continue
+		}
+		v.Answers[i] = unmarshalSurveyAnswerRequestBodyToSurveySurveyAnswer(val)
+	}
+	v.SurveyUID = surveyUID
+	v.Token = token
+
+	return v
+}
+
+// NewUpdateSurveyResponsePayload builds a survey service
+// update_survey_response endpoint payload.
+func NewUpdateSurveyResponsePayload(body *UpdateSurveyResponseRequestBody, surveyUID string, responseID string, token *string) *survey.UpdateSurveyResponsePayload {
+	v := &survey.UpdateSurveyResponsePayload{}
+	v.Answers = make([]*survey.SurveyAnswer, len(body.Answers))
+	for i, val := range body.Answers {
+		if val == nil {
+			v.Answers[i] = nil
+			continue
+		}
+		v.Answers[i] = unmarshalSurveyAnswerRequestBodyToSurveySurveyAnswer(val)
+	}
+	v.SurveyUID = surveyUID
+	v.ResponseID = responseID
+	v.Token = token
+
+	return v
+}
+
+// NewListSurveyInvitesPayload builds a survey service list_survey_invites
+// endpoint payload.
+func NewListSurveyInvitesPayload(surveyUID string, status *string, token *string) *survey.ListSurveyInvitesPayload {
+	v := &survey.ListSurveyInvitesPayload{}
+	v.SurveyUID = surveyUID
+	v.Status = status
+	v.Token = token
+
+	return v
+}
+
+// NewGetSurveyInviteSettingsPayload builds a survey service
+// get_survey_invite_settings endpoint payload.
+func NewGetSurveyInviteSettingsPayload(surveyUID string, token *string) *survey.GetSurveyInviteSettingsPayload {
+	v := &survey.GetSurveyInviteSettingsPayload{}
+	v.SurveyUID = surveyUID
+	v.Token = token
+
+	return v
+}
+
+// NewUpdateSurveyInviteSettingsPayload builds a survey service
+// update_survey_invite_settings endpoint payload.
+func NewUpdateSurveyInviteSettingsPayload(body *UpdateSurveyInviteSettingsRequestBody, surveyUID string, token *string) *survey.UpdateSurveyInviteSettingsPayload {
+	v := &survey.UpdateSurveyInviteSettingsPayload{
+		Enabled:             body.Enabled,
+		Role:                body.Role,
+		ExpirationDays:      body.ExpirationDays,
+		ReturnURLTemplate:   body.ReturnURLTemplate,
+		DisplayNameFallback: body.DisplayNameFallback,
+		Locale:              body.Locale,
+	}
+	v.SurveyUID = surveyUID
+	v.Token = token
+
+	return v
+}
+
+// NewDeleteSurveyInviteSettingsPayload builds a survey service
+// delete_survey_invite_settings endpoint payload.
+func NewDeleteSurveyInviteSettingsPayload(surveyUID string, token *string) *survey.DeleteSurveyInviteSettingsPayload {
+	v := &survey.DeleteSurveyInviteSettingsPayload{}
+	v.SurveyUID = surveyUID
+	v.Token = token
+
+	return v
+}
+
+// NewGetProjectInviteSettingsPayload builds a survey service
+// get_project_invite_settings endpoint payload.
+func NewGetProjectInviteSettingsPayload(projectUID string, token *string) *survey.GetProjectInviteSettingsPayload {
+	v := &survey.GetProjectInviteSettingsPayload{}
+	v.ProjectUID = projectUID
+	v.Token = token
+
+	return v
+}
+
+// NewUpdateProjectInviteSettingsPayload builds a survey service
+// update_project_invite_settings endpoint payload.
+func NewUpdateProjectInviteSettingsPayload(body *UpdateProjectInviteSettingsRequestBody, projectUID string, token *string) *survey.UpdateProjectInviteSettingsPayload {
+	v := &survey.UpdateProjectInviteSettingsPayload{
+		Enabled:             body.Enabled,
+		Role:                body.Role,
+		ExpirationDays:      body.ExpirationDays,
+		ReturnURLTemplate:   body.ReturnURLTemplate,
+		DisplayNameFallback: body.DisplayNameFallback,
+		Locale:              body.Locale,
+	}
+	v.ProjectUID = projectUID
+	v.Token = token
+
+	return v
+}
+
+// NewDeleteProjectInviteSettingsPayload builds a survey service
+// delete_project_invite_settings endpoint payload.
+func NewDeleteProjectInviteSettingsPayload(projectUID string, token *string) *survey.DeleteProjectInviteSettingsPayload {
+	v := &survey.DeleteProjectInviteSettingsPayload{}
+	v.ProjectUID = projectUID
+	v.Token = token
+
+	return v
+}
+
+// NewGetSurveySettingsPayload builds a survey service get_survey_settings
+// endpoint payload.
+func NewGetSurveySettingsPayload(surveyUID string, token *string) *survey.GetSurveySettingsPayload {
+	v := &survey.GetSurveySettingsPayload{}
+	v.SurveyUID = surveyUID
+	v.Token = token
+
+	return v
+}
+
+// NewUpdateSurveySettingsPayload builds a survey service
+// update_survey_settings endpoint payload.
+func NewUpdateSurveySettingsPayload(body *UpdateSurveySettingsRequestBody, surveyUID string, token *string) *survey.UpdateSurveySettingsPayload {
+	v := &survey.UpdateSurveySettingsPayload{}
+	if body.ShareResults != nil {
+		v.ShareResults = *body.ShareResults
+	}
+	if body.Anonymous != nil {
+		v.Anonymous = *body.Anonymous
+	}
+	if body.ShareResults == nil {
+		v.ShareResults = false
+	}
+	if body.Anonymous == nil {
+		v.Anonymous = false
+	}
+	v.SurveyUID = surveyUID
+	v.Token = token
+
+	return v
+}
+
+// NewGetSurveyResultsPayload builds a survey service get_survey_results
+// endpoint payload.
+func NewGetSurveyResultsPayload(surveyUID string, token *string) *survey.GetSurveyResultsPayload {
+	v := &survey.GetSurveyResultsPayload{}
+	v.SurveyUID = surveyUID
+	v.Token = token
+
+	return v
+}
+
 // NewValidateEmailPayload builds a survey service validate_email endpoint
 // payload.
 func NewValidateEmailPayload(body *ValidateEmailRequestBody, token *string) *survey.ValidateEmailPayload {
@@ -2835,6 +7630,172 @@ func NewValidateEmailPayload(body *ValidateEmailRequestBody, token *string) *sur
 	return v
 }
 
+// NewListSurveyTemplatesPayload builds a survey service list_survey_templates
+// endpoint payload.
+func NewListSurveyTemplatesPayload(title *string, category *string, language *string, offset int, limit int, token *string) *survey.ListSurveyTemplatesPayload {
+	v := &survey.ListSurveyTemplatesPayload{}
+	v.Title = title
+	v.Category = category
+	v.Language = language
+	v.Offset = offset
+	v.Limit = limit
+	v.Token = token
+
+	return v
+}
+
+// NewGetSurveyTemplatePayload builds a survey service get_survey_template
+// endpoint payload.
+func NewGetSurveyTemplatePayload(templateID string, token *string) *survey.GetSurveyTemplatePayload {
+	v := &survey.GetSurveyTemplatePayload{}
+	v.TemplateID = templateID
+	v.Token = token
+
+	return v
+}
+
+// NewListDeadLettersPayload builds a survey service list_dead_letters endpoint
+// payload.
+func NewListDeadLettersPayload(keyPrefix *string, after *uint64, limit int, token *string) *survey.ListDeadLettersPayload {
+	v := &survey.ListDeadLettersPayload{}
+	v.KeyPrefix = keyPrefix
+	v.After = after
+	v.Limit = limit
+	v.Token = token
+
+	return v
+}
+
+// NewGetDeadLetterPayload builds a survey service get_dead_letter endpoint
+// payload.
+func NewGetDeadLetterPayload(sequence uint64, token *string) *survey.GetDeadLetterPayload {
+	v := &survey.GetDeadLetterPayload{}
+	v.Sequence = sequence
+	v.Token = token
+
+	return v
+}
+
+// NewReplayDeadLetterPayload builds a survey service replay_dead_letter
+// endpoint payload.
+func NewReplayDeadLetterPayload(sequence uint64, token *string) *survey.ReplayDeadLetterPayload {
+	v := &survey.ReplayDeadLetterPayload{}
+	v.Sequence = sequence
+	v.Token = token
+
+	return v
+}
+
+// NewStartReindexPayload builds a survey service start_reindex endpoint
+// payload.
+func NewStartReindexPayload(body *StartReindexRequestBody, token *string) *survey.StartReindexPayload {
+	v := &survey.StartReindexPayload{
+		ModifiedSince: body.ModifiedSince,
+		ModifiedUntil: body.ModifiedUntil,
+	}
+	if body.DryRun != nil {
+		v.DryRun = *body.DryRun
+	}
+	if body.Force != nil {
+		v.Force = *body.Force
+	}
+	if body.RatePerSecond != nil {
+		v.RatePerSecond = *body.RatePerSecond
+	}
+	if body.KeyPrefixes != nil {
+		v.KeyPrefixes = make([]string, len(body.KeyPrefixes))
+		for i, val := range body.KeyPrefixes {
+			v.KeyPrefixes[i] = val
+		}
+	}
+	if body.DryRun == nil {
+		v.DryRun = false
+	}
+	if body.Force == nil {
+		v.Force = false
+	}
+	if body.RatePerSecond == nil {
+		v.RatePerSecond = 50
+	}
+	v.Token = token
+
+	return v
+}
+
+// NewGetReindexPayload builds a survey service get_reindex endpoint payload.
+func NewGetReindexPayload(token *string) *survey.GetReindexPayload {
+	v := &survey.GetReindexPayload{}
+	v.Token = token
+
+	return v
+}
+
+// NewCancelReindexPayload builds a survey service cancel_reindex endpoint
+// payload.
+func NewCancelReindexPayload(token *string) *survey.CancelReindexPayload {
+	v := &survey.CancelReindexPayload{}
+	v.Token = token
+
+	return v
+}
+
+// NewRerunInviteEnrichmentPayload builds a survey service
+// rerun_invite_enrichment endpoint payload.
+func NewRerunInviteEnrichmentPayload(body *RerunInviteEnrichmentRequestBody, token *string) *survey.RerunInviteEnrichmentPayload {
+	v := &survey.RerunInviteEnrichmentPayload{
+		Email:    *body.Email,
+		Username: body.Username,
+	}
+	v.Token = token
+
+	return v
+}
+
+// NewStartInviteBackfillPayload builds a survey service start_invite_backfill
+// endpoint payload.
+func NewStartInviteBackfillPayload(body *StartInviteBackfillRequestBody, token *string) *survey.StartInviteBackfillPayload {
+	v := &survey.StartInviteBackfillPayload{}
+	if body.DryRun != nil {
+		v.DryRun = *body.DryRun
+	}
+	if body.RatePerSecond != nil {
+		v.RatePerSecond = *body.RatePerSecond
+	}
+	if body.SurveyUids != nil {
+		v.SurveyUids = make([]string, len(body.SurveyUids))
+		for i, val := range body.SurveyUids {
+			v.SurveyUids[i] = val
+		}
+	}
+	if body.DryRun == nil {
+		v.DryRun = false
+	}
+	if body.RatePerSecond == nil {
+		v.RatePerSecond = 5
+	}
+	v.Token = token
+
+	return v
+}
+
+// NewGetInviteBackfillPayload builds a survey service get_invite_backfill
+// endpoint payload.
+func NewGetInviteBackfillPayload(token *string) *survey.GetInviteBackfillPayload {
+	v := &survey.GetInviteBackfillPayload{}
+	v.Token = token
+
+	return v
+}
+
+// NewCancelInviteBackfillPayload builds a survey service
+// cancel_invite_backfill endpoint payload.
+func NewCancelInviteBackfillPayload(token *string) *survey.CancelInviteBackfillPayload {
+	v := &survey.CancelInviteBackfillPayload{}
+	v.Token = token
+
+	return v
+}
+
 // ValidateScheduleSurveyRequestBody runs the validations defined on
 // schedule_survey_request_body
 func ValidateScheduleSurveyRequestBody(body *ScheduleSurveyRequestBody) (err error) {
@@ -2852,3 +7813,139 @@ func ValidateBulkResendSurveyRequestBody(body *BulkResendSurveyRequestBody) (err
 	}
 	return
 }
+
+// ValidateCreateSurveyResponseRequestBody runs the validations defined on
+// create_survey_response_request_body
+func ValidateCreateSurveyResponseRequestBody(body *CreateSurveyResponseRequestBody) (err error) {
+	if body.SurveyResponseUID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("survey_response_uid", "body"))
+	}
+	if body.Answers == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("answers", "body"))
+	}
+	for _, e := range body.Answers {
+		if e != nil {
+			if err2 := ValidateSurveyAnswerRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateUpdateSurveyResponseRequestBody runs the validations defined on
+// update_survey_response_request_body
+func ValidateUpdateSurveyResponseRequestBody(body *UpdateSurveyResponseRequestBody) (err error) {
+	if body.Answers == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("answers", "body"))
+	}
+	for _, e := range body.Answers {
+		if e != nil {
+			if err2 := ValidateSurveyAnswerRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateUpdateSurveyInviteSettingsRequestBody runs the validations defined
+// on update_survey_invite_settings_request_body
+func ValidateUpdateSurveyInviteSettingsRequestBody(body *UpdateSurveyInviteSettingsRequestBody) (err error) {
+	if body.Role != nil {
+		if !(*body.Role == "Participant") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.role", *body.Role, []any{"Participant"}))
+		}
+	}
+	if body.ExpirationDays != nil {
+		if *body.ExpirationDays < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.expiration_days", *body.ExpirationDays, 1, true))
+		}
+	}
+	if body.ExpirationDays != nil {
+		if *body.ExpirationDays > 90 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.expiration_days", *body.ExpirationDays, 90, false))
+		}
+	}
+	return
+}
+
+// ValidateUpdateProjectInviteSettingsRequestBody runs the validations defined
+// on update_project_invite_settings_request_body
+func ValidateUpdateProjectInviteSettingsRequestBody(body *UpdateProjectInviteSettingsRequestBody) (err error) {
+	if body.Role != nil {
+		if !(*body.Role == "Participant") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.role", *body.Role, []any{"Participant"}))
+		}
+	}
+	if body.ExpirationDays != nil {
+		if *body.ExpirationDays < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.expiration_days", *body.ExpirationDays, 1, true))
+		}
+	}
+	if body.ExpirationDays != nil {
+		if *body.ExpirationDays > 90 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.expiration_days", *body.ExpirationDays, 90, false))
+		}
+	}
+	return
+}
+
+// ValidateStartReindexRequestBody runs the validations defined on
+// start_reindex_request_body
+func ValidateStartReindexRequestBody(body *StartReindexRequestBody) (err error) {
+	if body.ModifiedSince != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.modified_since", *body.ModifiedSince, goa.FormatDateTime))
+	}
+	if body.ModifiedUntil != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.modified_until", *body.ModifiedUntil, goa.FormatDateTime))
+	}
+	if body.RatePerSecond != nil {
+		if *body.RatePerSecond < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.rate_per_second", *body.RatePerSecond, 1, true))
+		}
+	}
+	if body.RatePerSecond != nil {
+		if *body.RatePerSecond > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.rate_per_second", *body.RatePerSecond, 1000, false))
+		}
+	}
+	return
+}
+
+// ValidateRerunInviteEnrichmentRequestBody runs the validations defined on
+// rerun_invite_enrichment_request_body
+func ValidateRerunInviteEnrichmentRequestBody(body *RerunInviteEnrichmentRequestBody) (err error) {
+	if body.Email == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("email", "body"))
+	}
+	if body.Email != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+	}
+	return
+}
+
+// ValidateStartInviteBackfillRequestBody runs the validations defined on
+// start_invite_backfill_request_body
+func ValidateStartInviteBackfillRequestBody(body *StartInviteBackfillRequestBody) (err error) {
+	if body.RatePerSecond != nil {
+		if *body.RatePerSecond < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.rate_per_second", *body.RatePerSecond, 1, true))
+		}
+	}
+	if body.RatePerSecond != nil {
+		if *body.RatePerSecond > 50 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.rate_per_second", *body.RatePerSecond, 50, false))
+		}
+	}
+	return
+}
+
+// ValidateSurveyAnswerRequestBody runs the validations defined on
+// SurveyAnswerRequestBody
+func ValidateSurveyAnswerRequestBody(body *SurveyAnswerRequestBody) (err error) {
+	if body.QuestionID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("question_id", "body"))
+	}
+	return
+}
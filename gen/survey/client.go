@@ -16,43 +16,91 @@ import (
 
 // Client is the "survey" service client.
 type Client struct {
-	ScheduleSurveyEndpoint        goa.Endpoint
-	GetSurveyEndpoint             goa.Endpoint
-	UpdateSurveyEndpoint          goa.Endpoint
-	DeleteSurveyEndpoint          goa.Endpoint
-	BulkResendSurveyEndpoint      goa.Endpoint
-	PreviewSendSurveyEndpoint     goa.Endpoint
-	SendMissingRecipientsEndpoint goa.Endpoint
-	DeleteSurveyResponseEndpoint  goa.Endpoint
-	ResendSurveyResponseEndpoint  goa.Endpoint
-	DeleteRecipientGroupEndpoint  goa.Endpoint
-	CreateExclusionEndpoint       goa.Endpoint
-	DeleteExclusionEndpoint       goa.Endpoint
-	GetExclusionEndpoint          goa.Endpoint
-	DeleteExclusionByIDEndpoint   goa.Endpoint
-	ListSurveyResponsesEndpoint   goa.Endpoint
-	ValidateEmailEndpoint         goa.Endpoint
+	ScheduleSurveyEndpoint              goa.Endpoint
+	GetSurveyEndpoint                   goa.Endpoint
+	UpdateSurveyEndpoint                goa.Endpoint
+	DeleteSurveyEndpoint                goa.Endpoint
+	BulkResendSurveyEndpoint            goa.Endpoint
+	PreviewSendSurveyEndpoint           goa.Endpoint
+	SendMissingRecipientsEndpoint       goa.Endpoint
+	DeleteSurveyResponseEndpoint        goa.Endpoint
+	ResendSurveyResponseEndpoint        goa.Endpoint
+	DeleteRecipientGroupEndpoint        goa.Endpoint
+	CreateExclusionEndpoint             goa.Endpoint
+	DeleteExclusionEndpoint             goa.Endpoint
+	GetExclusionEndpoint                goa.Endpoint
+	DeleteExclusionByIDEndpoint         goa.Endpoint
+	ListSurveyResponsesEndpoint         goa.Endpoint
+	CreateSurveyResponseEndpoint        goa.Endpoint
+	UpdateSurveyResponseEndpoint        goa.Endpoint
+	ListSurveyInvitesEndpoint           goa.Endpoint
+	GetSurveyInviteSettingsEndpoint     goa.Endpoint
+	UpdateSurveyInviteSettingsEndpoint  goa.Endpoint
+	DeleteSurveyInviteSettingsEndpoint  goa.Endpoint
+	GetProjectInviteSettingsEndpoint    goa.Endpoint
+	UpdateProjectInviteSettingsEndpoint goa.Endpoint
+	DeleteProjectInviteSettingsEndpoint goa.Endpoint
+	GetSurveySettingsEndpoint           goa.Endpoint
+	UpdateSurveySettingsEndpoint        goa.Endpoint
+	GetSurveyResultsEndpoint            goa.Endpoint
+	ValidateEmailEndpoint               goa.Endpoint
+	ListSurveyTemplatesEndpoint         goa.Endpoint
+	GetSurveyTemplateEndpoint           goa.Endpoint
+	ListDeadLettersEndpoint             goa.Endpoint
+	GetDeadLetterEndpoint               goa.Endpoint
+	ReplayDeadLetterEndpoint            goa.Endpoint
+	StartReindexEndpoint                goa.Endpoint
+	GetReindexEndpoint                  goa.Endpoint
+	CancelReindexEndpoint               goa.Endpoint
+	RerunInviteEnrichmentEndpoint       goa.Endpoint
+	StartInviteBackfillEndpoint         goa.Endpoint
+	GetInviteBackfillEndpoint           goa.Endpoint
+	CancelInviteBackfillEndpoint        goa.Endpoint
 }
 
 // NewClient initializes a "survey" service client given the endpoints.
-func NewClient(scheduleSurvey, getSurvey, updateSurvey, deleteSurvey, bulkResendSurvey, previewSendSurvey, sendMissingRecipients, deleteSurveyResponse, resendSurveyResponse, deleteRecipientGroup, createExclusion, deleteExclusion, getExclusion, deleteExclusionByID, listSurveyResponses, validateEmail goa.Endpoint) *Client {
+func NewClient(scheduleSurvey, getSurvey, updateSurvey, deleteSurvey, bulkResendSurvey, previewSendSurvey, sendMissingRecipients, deleteSurveyResponse, resendSurveyResponse, deleteRecipientGroup, createExclusion, deleteExclusion, getExclusion, deleteExclusionByID, listSurveyResponses, createSurveyResponse, updateSurveyResponse, listSurveyInvites, getSurveyInviteSettings, updateSurveyInviteSettings, deleteSurveyInviteSettings, getProjectInviteSettings, updateProjectInviteSettings, deleteProjectInviteSettings, getSurveySettings, updateSurveySettings, getSurveyResults, validateEmail, listSurveyTemplates, getSurveyTemplate, listDeadLetters, getDeadLetter, replayDeadLetter, startReindex, getReindex, cancelReindex, rerunInviteEnrichment, startInviteBackfill, getInviteBackfill, cancelInviteBackfill goa.Endpoint) *Client {
 	return &Client{
-		ScheduleSurveyEndpoint:        scheduleSurvey,
-		GetSurveyEndpoint:             getSurvey,
-		UpdateSurveyEndpoint:          updateSurvey,
-		DeleteSurveyEndpoint:          deleteSurvey,
-		BulkResendSurveyEndpoint:      bulkResendSurvey,
-		PreviewSendSurveyEndpoint:     previewSendSurvey,
-		SendMissingRecipientsEndpoint: sendMissingRecipients,
-		DeleteSurveyResponseEndpoint:  deleteSurveyResponse,
-		ResendSurveyResponseEndpoint:  resendSurveyResponse,
-		DeleteRecipientGroupEndpoint:  deleteRecipientGroup,
-		CreateExclusionEndpoint:       createExclusion,
-		DeleteExclusionEndpoint:       deleteExclusion,
-		GetExclusionEndpoint:          getExclusion,
-		DeleteExclusionByIDEndpoint:   deleteExclusionByID,
-		ListSurveyResponsesEndpoint:   listSurveyResponses,
-		ValidateEmailEndpoint:         validateEmail,
+		ScheduleSurveyEndpoint:              scheduleSurvey,
+		GetSurveyEndpoint:                   getSurvey,
+		UpdateSurveyEndpoint:                updateSurvey,
+		DeleteSurveyEndpoint:                deleteSurvey,
+		BulkResendSurveyEndpoint:            bulkResendSurvey,
+		PreviewSendSurveyEndpoint:           previewSendSurvey,
+		SendMissingRecipientsEndpoint:       sendMissingRecipients,
+		DeleteSurveyResponseEndpoint:        deleteSurveyResponse,
+		ResendSurveyResponseEndpoint:        resendSurveyResponse,
+		DeleteRecipientGroupEndpoint:        deleteRecipientGroup,
+		CreateExclusionEndpoint:             createExclusion,
+		DeleteExclusionEndpoint:             deleteExclusion,
+		GetExclusionEndpoint:                getExclusion,
+		DeleteExclusionByIDEndpoint:         deleteExclusionByID,
+		ListSurveyResponsesEndpoint:         listSurveyResponses,
+		CreateSurveyResponseEndpoint:        createSurveyResponse,
+		UpdateSurveyResponseEndpoint:        updateSurveyResponse,
+		ListSurveyInvitesEndpoint:           listSurveyInvites,
+		GetSurveyInviteSettingsEndpoint:     getSurveyInviteSettings,
+		UpdateSurveyInviteSettingsEndpoint:  updateSurveyInviteSettings,
+		DeleteSurveyInviteSettingsEndpoint:  deleteSurveyInviteSettings,
+		GetProjectInviteSettingsEndpoint:    getProjectInviteSettings,
+		UpdateProjectInviteSettingsEndpoint: updateProjectInviteSettings,
+		DeleteProjectInviteSettingsEndpoint: deleteProjectInviteSettings,
+		GetSurveySettingsEndpoint:           getSurveySettings,
+		UpdateSurveySettingsEndpoint:        updateSurveySettings,
+		GetSurveyResultsEndpoint:            getSurveyResults,
+		ValidateEmailEndpoint:               validateEmail,
+		ListSurveyTemplatesEndpoint:         listSurveyTemplates,
+		GetSurveyTemplateEndpoint:           getSurveyTemplate,
+		ListDeadLettersEndpoint:             listDeadLetters,
+		GetDeadLetterEndpoint:               getDeadLetter,
+		ReplayDeadLetterEndpoint:            replayDeadLetter,
+		StartReindexEndpoint:                startReindex,
+		GetReindexEndpoint:                  getReindex,
+		CancelReindexEndpoint:               cancelReindex,
+		RerunInviteEnrichmentEndpoint:       rerunInviteEnrichment,
+		StartInviteBackfillEndpoint:         startInviteBackfill,
+		GetInviteBackfillEndpoint:           getInviteBackfill,
+		CancelInviteBackfillEndpoint:        cancelInviteBackfill,
 	}
 }
 
@@ -319,6 +367,230 @@ func (c *Client) ListSurveyResponses(ctx context.Context, p *ListSurveyResponses
 	return ires.(*SurveyResponsesPage), nil
 }
 
+// CreateSurveyResponse calls the "create_survey_response" endpoint of the
+// "survey" service.
+// CreateSurveyResponse may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) CreateSurveyResponse(ctx context.Context, p *CreateSurveyResponsePayload) (err error) {
+	_, err = c.CreateSurveyResponseEndpoint(ctx, p)
+	return
+}
+
+// UpdateSurveyResponse calls the "update_survey_response" endpoint of the
+// "survey" service.
+// UpdateSurveyResponse may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) UpdateSurveyResponse(ctx context.Context, p *UpdateSurveyResponsePayload) (err error) {
+	_, err = c.UpdateSurveyResponseEndpoint(ctx, p)
+	return
+}
+
+// ListSurveyInvites calls the "list_survey_invites" endpoint of the "survey"
+// service.
+// ListSurveyInvites may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ListSurveyInvites(ctx context.Context, p *ListSurveyInvitesPayload) (res *SurveyInviteList, err error) {
+	var ires any
+	ires, err = c.ListSurveyInvitesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SurveyInviteList), nil
+}
+
+// GetSurveyInviteSettings calls the "get_survey_invite_settings" endpoint of
+// the "survey" service.
+// GetSurveyInviteSettings may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetSurveyInviteSettings(ctx context.Context, p *GetSurveyInviteSettingsPayload) (res *InviteSettings, err error) {
+	var ires any
+	ires, err = c.GetSurveyInviteSettingsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*InviteSettings), nil
+}
+
+// UpdateSurveyInviteSettings calls the "update_survey_invite_settings"
+// endpoint of the "survey" service.
+// UpdateSurveyInviteSettings may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) UpdateSurveyInviteSettings(ctx context.Context, p *UpdateSurveyInviteSettingsPayload) (res *InviteSettings, err error) {
+	var ires any
+	ires, err = c.UpdateSurveyInviteSettingsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*InviteSettings), nil
+}
+
+// DeleteSurveyInviteSettings calls the "delete_survey_invite_settings"
+// endpoint of the "survey" service.
+// DeleteSurveyInviteSettings may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) DeleteSurveyInviteSettings(ctx context.Context, p *DeleteSurveyInviteSettingsPayload) (err error) {
+	_, err = c.DeleteSurveyInviteSettingsEndpoint(ctx, p)
+	return
+}
+
+// GetProjectInviteSettings calls the "get_project_invite_settings" endpoint of
+// the "survey" service.
+// GetProjectInviteSettings may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetProjectInviteSettings(ctx context.Context, p *GetProjectInviteSettingsPayload) (res *InviteSettings, err error) {
+	var ires any
+	ires, err = c.GetProjectInviteSettingsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*InviteSettings), nil
+}
+
+// UpdateProjectInviteSettings calls the "update_project_invite_settings"
+// endpoint of the "survey" service.
+// UpdateProjectInviteSettings may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) UpdateProjectInviteSettings(ctx context.Context, p *UpdateProjectInviteSettingsPayload) (res *InviteSettings, err error) {
+	var ires any
+	ires, err = c.UpdateProjectInviteSettingsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*InviteSettings), nil
+}
+
+// DeleteProjectInviteSettings calls the "delete_project_invite_settings"
+// endpoint of the "survey" service.
+// DeleteProjectInviteSettings may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) DeleteProjectInviteSettings(ctx context.Context, p *DeleteProjectInviteSettingsPayload) (err error) {
+	_, err = c.DeleteProjectInviteSettingsEndpoint(ctx, p)
+	return
+}
+
+// GetSurveySettings calls the "get_survey_settings" endpoint of the "survey"
+// service.
+// GetSurveySettings may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetSurveySettings(ctx context.Context, p *GetSurveySettingsPayload) (res *SurveySettings, err error) {
+	var ires any
+	ires, err = c.GetSurveySettingsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SurveySettings), nil
+}
+
+// UpdateSurveySettings calls the "update_survey_settings" endpoint of the
+// "survey" service.
+// UpdateSurveySettings may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) UpdateSurveySettings(ctx context.Context, p *UpdateSurveySettingsPayload) (res *SurveySettings, err error) {
+	var ires any
+	ires, err = c.UpdateSurveySettingsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SurveySettings), nil
+}
+
+// GetSurveyResults calls the "get_survey_results" endpoint of the "survey"
+// service.
+// GetSurveyResults may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetSurveyResults(ctx context.Context, p *GetSurveyResultsPayload) (res *SurveyResults, err error) {
+	var ires any
+	ires, err = c.GetSurveyResultsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SurveyResults), nil
+}
+
 // ValidateEmail calls the "validate_email" endpoint of the "survey" service.
 // ValidateEmail may return the following errors:
 //   - "BadRequest" (type *BadRequestError): Bad request
@@ -337,3 +609,239 @@ func (c *Client) ValidateEmail(ctx context.Context, p *ValidateEmailPayload) (re
 	}
 	return ires.(*ValidateEmailResult), nil
 }
+
+// ListSurveyTemplates calls the "list_survey_templates" endpoint of the
+// "survey" service.
+// ListSurveyTemplates may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ListSurveyTemplates(ctx context.Context, p *ListSurveyTemplatesPayload) (res *SurveyTemplateList, err error) {
+	var ires any
+	ires, err = c.ListSurveyTemplatesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SurveyTemplateList), nil
+}
+
+// GetSurveyTemplate calls the "get_survey_template" endpoint of the "survey"
+// service.
+// GetSurveyTemplate may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetSurveyTemplate(ctx context.Context, p *GetSurveyTemplatePayload) (res *SurveyTemplate, err error) {
+	var ires any
+	ires, err = c.GetSurveyTemplateEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SurveyTemplate), nil
+}
+
+// ListDeadLetters calls the "list_dead_letters" endpoint of the "survey"
+// service.
+// ListDeadLetters may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ListDeadLetters(ctx context.Context, p *ListDeadLettersPayload) (res *DeadLetterList, err error) {
+	var ires any
+	ires, err = c.ListDeadLettersEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*DeadLetterList), nil
+}
+
+// GetDeadLetter calls the "get_dead_letter" endpoint of the "survey" service.
+// GetDeadLetter may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetDeadLetter(ctx context.Context, p *GetDeadLetterPayload) (res *DeadLetterEntry, err error) {
+	var ires any
+	ires, err = c.GetDeadLetterEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*DeadLetterEntry), nil
+}
+
+// ReplayDeadLetter calls the "replay_dead_letter" endpoint of the "survey"
+// service.
+// ReplayDeadLetter may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) ReplayDeadLetter(ctx context.Context, p *ReplayDeadLetterPayload) (res *DeadLetterReplayResult, err error) {
+	var ires any
+	ires, err = c.ReplayDeadLetterEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*DeadLetterReplayResult), nil
+}
+
+// StartReindex calls the "start_reindex" endpoint of the "survey" service.
+// StartReindex may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) StartReindex(ctx context.Context, p *StartReindexPayload) (res *ReindexStatus, err error) {
+	var ires any
+	ires, err = c.StartReindexEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ReindexStatus), nil
+}
+
+// GetReindex calls the "get_reindex" endpoint of the "survey" service.
+// GetReindex may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetReindex(ctx context.Context, p *GetReindexPayload) (res *ReindexStatus, err error) {
+	var ires any
+	ires, err = c.GetReindexEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ReindexStatus), nil
+}
+
+// CancelReindex calls the "cancel_reindex" endpoint of the "survey" service.
+// CancelReindex may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) CancelReindex(ctx context.Context, p *CancelReindexPayload) (res *ReindexStatus, err error) {
+	var ires any
+	ires, err = c.CancelReindexEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ReindexStatus), nil
+}
+
+// RerunInviteEnrichment calls the "rerun_invite_enrichment" endpoint of the
+// "survey" service.
+// RerunInviteEnrichment may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) RerunInviteEnrichment(ctx context.Context, p *RerunInviteEnrichmentPayload) (res *InviteEnrichmentResult, err error) {
+	var ires any
+	ires, err = c.RerunInviteEnrichmentEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*InviteEnrichmentResult), nil
+}
+
+// StartInviteBackfill calls the "start_invite_backfill" endpoint of the
+// "survey" service.
+// StartInviteBackfill may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) StartInviteBackfill(ctx context.Context, p *StartInviteBackfillPayload) (res *InviteBackfillStatus, err error) {
+	var ires any
+	ires, err = c.StartInviteBackfillEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*InviteBackfillStatus), nil
+}
+
+// GetInviteBackfill calls the "get_invite_backfill" endpoint of the "survey"
+// service.
+// GetInviteBackfill may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) GetInviteBackfill(ctx context.Context, p *GetInviteBackfillPayload) (res *InviteBackfillStatus, err error) {
+	var ires any
+	ires, err = c.GetInviteBackfillEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*InviteBackfillStatus), nil
+}
+
+// CancelInviteBackfill calls the "cancel_invite_backfill" endpoint of the
+// "survey" service.
+// CancelInviteBackfill may return the following errors:
+//   - "BadRequest" (type *BadRequestError): Bad request
+//   - "Unauthorized" (type *UnauthorizedError): Unauthorized
+//   - "Forbidden" (type *ForbiddenError): Forbidden
+//   - "NotFound" (type *NotFoundError): Not found
+//   - "Conflict" (type *ConflictError): Conflict
+//   - "InternalServerError" (type *InternalServerError): Internal server error
+//   - "ServiceUnavailable" (type *ServiceUnavailableError): Service unavailable
+//   - error: internal error
+func (c *Client) CancelInviteBackfill(ctx context.Context, p *CancelInviteBackfillPayload) (res *InviteBackfillStatus, err error) {
+	var ires any
+	ires, err = c.CancelInviteBackfillEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*InviteBackfillStatus), nil
+}
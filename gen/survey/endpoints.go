@@ -17,22 +17,46 @@ import (
 
 // Endpoints wraps the "survey" service endpoints.
 type Endpoints struct {
-	ScheduleSurvey        goa.Endpoint
-	GetSurvey             goa.Endpoint
-	UpdateSurvey          goa.Endpoint
-	DeleteSurvey          goa.Endpoint
-	BulkResendSurvey      goa.Endpoint
-	PreviewSendSurvey     goa.Endpoint
-	SendMissingRecipients goa.Endpoint
-	DeleteSurveyResponse  goa.Endpoint
-	ResendSurveyResponse  goa.Endpoint
-	DeleteRecipientGroup  goa.Endpoint
-	CreateExclusion       goa.Endpoint
-	DeleteExclusion       goa.Endpoint
-	GetExclusion          goa.Endpoint
-	DeleteExclusionByID   goa.Endpoint
-	ListSurveyResponses   goa.Endpoint
-	ValidateEmail         goa.Endpoint
+	ScheduleSurvey              goa.Endpoint
+	GetSurvey                   goa.Endpoint
+	UpdateSurvey                goa.Endpoint
+	DeleteSurvey                goa.Endpoint
+	BulkResendSurvey            goa.Endpoint
+	PreviewSendSurvey           goa.Endpoint
+	SendMissingRecipients       goa.Endpoint
+	DeleteSurveyResponse        goa.Endpoint
+	ResendSurveyResponse        goa.Endpoint
+	DeleteRecipientGroup        goa.Endpoint
+	CreateExclusion             goa.Endpoint
+	DeleteExclusion             goa.Endpoint
+	GetExclusion                goa.Endpoint
+	DeleteExclusionByID         goa.Endpoint
+	ListSurveyResponses         goa.Endpoint
+	CreateSurveyResponse        goa.Endpoint
+	UpdateSurveyResponse        goa.Endpoint
+	ListSurveyInvites           goa.Endpoint
+	GetSurveyInviteSettings     goa.Endpoint
+	UpdateSurveyInviteSettings  goa.Endpoint
+	DeleteSurveyInviteSettings  goa.Endpoint
+	GetProjectInviteSettings    goa.Endpoint
+	UpdateProjectInviteSettings goa.Endpoint
+	DeleteProjectInviteSettings goa.Endpoint
+	GetSurveySettings           goa.Endpoint
+	UpdateSurveySettings        goa.Endpoint
+	GetSurveyResults            goa.Endpoint
+	ValidateEmail               goa.Endpoint
+	ListSurveyTemplates         goa.Endpoint
+	GetSurveyTemplate           goa.Endpoint
+	ListDeadLetters             goa.Endpoint
+	GetDeadLetter               goa.Endpoint
+	ReplayDeadLetter            goa.Endpoint
+	StartReindex                goa.Endpoint
+	GetReindex                  goa.Endpoint
+	CancelReindex               goa.Endpoint
+	RerunInviteEnrichment       goa.Endpoint
+	StartInviteBackfill         goa.Endpoint
+	GetInviteBackfill           goa.Endpoint
+	CancelInviteBackfill        goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "survey" service with endpoints.
@@ -40,22 +64,46 @@ func NewEndpoints(s Service) *Endpoints {
 	// Casting service to Auther interface
 	a := s.(Auther)
 	return &Endpoints{
-		ScheduleSurvey:        NewScheduleSurveyEndpoint(s, a.JWTAuth),
-		GetSurvey:             NewGetSurveyEndpoint(s, a.JWTAuth),
-		UpdateSurvey:          NewUpdateSurveyEndpoint(s, a.JWTAuth),
-		DeleteSurvey:          NewDeleteSurveyEndpoint(s, a.JWTAuth),
-		BulkResendSurvey:      NewBulkResendSurveyEndpoint(s, a.JWTAuth),
-		PreviewSendSurvey:     NewPreviewSendSurveyEndpoint(s, a.JWTAuth),
-		SendMissingRecipients: NewSendMissingRecipientsEndpoint(s, a.JWTAuth),
-		DeleteSurveyResponse:  NewDeleteSurveyResponseEndpoint(s, a.JWTAuth),
-		ResendSurveyResponse:  NewResendSurveyResponseEndpoint(s, a.JWTAuth),
-		DeleteRecipientGroup:  NewDeleteRecipientGroupEndpoint(s, a.JWTAuth),
-		CreateExclusion:       NewCreateExclusionEndpoint(s, a.JWTAuth),
-		DeleteExclusion:       NewDeleteExclusionEndpoint(s, a.JWTAuth),
-		GetExclusion:          NewGetExclusionEndpoint(s, a.JWTAuth),
-		DeleteExclusionByID:   NewDeleteExclusionByIDEndpoint(s, a.JWTAuth),
-		ListSurveyResponses:   NewListSurveyResponsesEndpoint(s, a.JWTAuth),
-		ValidateEmail:         NewValidateEmailEndpoint(s, a.JWTAuth),
+		ScheduleSurvey:              NewScheduleSurveyEndpoint(s, a.JWTAuth),
+		GetSurvey:                   NewGetSurveyEndpoint(s, a.JWTAuth),
+		UpdateSurvey:                NewUpdateSurveyEndpoint(s, a.JWTAuth),
+		DeleteSurvey:                NewDeleteSurveyEndpoint(s, a.JWTAuth),
+		BulkResendSurvey:            NewBulkResendSurveyEndpoint(s, a.JWTAuth),
+		PreviewSendSurvey:           NewPreviewSendSurveyEndpoint(s, a.JWTAuth),
+		SendMissingRecipients:       NewSendMissingRecipientsEndpoint(s, a.JWTAuth),
+		DeleteSurveyResponse:        NewDeleteSurveyResponseEndpoint(s, a.JWTAuth),
+		ResendSurveyResponse:        NewResendSurveyResponseEndpoint(s, a.JWTAuth),
+		DeleteRecipientGroup:        NewDeleteRecipientGroupEndpoint(s, a.JWTAuth),
+		CreateExclusion:             NewCreateExclusionEndpoint(s, a.JWTAuth),
+		DeleteExclusion:             NewDeleteExclusionEndpoint(s, a.JWTAuth),
+		GetExclusion:                NewGetExclusionEndpoint(s, a.JWTAuth),
+		DeleteExclusionByID:         NewDeleteExclusionByIDEndpoint(s, a.JWTAuth),
+		ListSurveyResponses:         NewListSurveyResponsesEndpoint(s, a.JWTAuth),
+		CreateSurveyResponse:        NewCreateSurveyResponseEndpoint(s, a.JWTAuth),
+		UpdateSurveyResponse:        NewUpdateSurveyResponseEndpoint(s, a.JWTAuth),
+		ListSurveyInvites:           NewListSurveyInvitesEndpoint(s, a.JWTAuth),
+		GetSurveyInviteSettings:     NewGetSurveyInviteSettingsEndpoint(s, a.JWTAuth),
+		UpdateSurveyInviteSettings:  NewUpdateSurveyInviteSettingsEndpoint(s, a.JWTAuth),
+		DeleteSurveyInviteSettings:  NewDeleteSurveyInviteSettingsEndpoint(s, a.JWTAuth),
+		GetProjectInviteSettings:    NewGetProjectInviteSettingsEndpoint(s, a.JWTAuth),
+		UpdateProjectInviteSettings: NewUpdateProjectInviteSettingsEndpoint(s, a.JWTAuth),
+		DeleteProjectInviteSettings: NewDeleteProjectInviteSettingsEndpoint(s, a.JWTAuth),
+		GetSurveySettings:           NewGetSurveySettingsEndpoint(s, a.JWTAuth),
+		UpdateSurveySettings:        NewUpdateSurveySettingsEndpoint(s, a.JWTAuth),
+		GetSurveyResults:            NewGetSurveyResultsEndpoint(s, a.JWTAuth),
+		ValidateEmail:               NewValidateEmailEndpoint(s, a.JWTAuth),
+		ListSurveyTemplates:         NewListSurveyTemplatesEndpoint(s, a.JWTAuth),
+		GetSurveyTemplate:           NewGetSurveyTemplateEndpoint(s, a.JWTAuth),
+		ListDeadLetters:             NewListDeadLettersEndpoint(s, a.JWTAuth),
+		GetDeadLetter:               NewGetDeadLetterEndpoint(s, a.JWTAuth),
+		ReplayDeadLetter:            NewReplayDeadLetterEndpoint(s, a.JWTAuth),
+		StartReindex:                NewStartReindexEndpoint(s, a.JWTAuth),
+		GetReindex:                  NewGetReindexEndpoint(s, a.JWTAuth),
+		CancelReindex:               NewCancelReindexEndpoint(s, a.JWTAuth),
+		RerunInviteEnrichment:       NewRerunInviteEnrichmentEndpoint(s, a.JWTAuth),
+		StartInviteBackfill:         NewStartInviteBackfillEndpoint(s, a.JWTAuth),
+		GetInviteBackfill:           NewGetInviteBackfillEndpoint(s, a.JWTAuth),
+		CancelInviteBackfill:        NewCancelInviteBackfillEndpoint(s, a.JWTAuth),
 	}
 }
 
@@ -76,7 +124,31 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.GetExclusion = m(e.GetExclusion)
 	e.DeleteExclusionByID = m(e.DeleteExclusionByID)
 	e.ListSurveyResponses = m(e.ListSurveyResponses)
+	e.CreateSurveyResponse = m(e.CreateSurveyResponse)
+	e.UpdateSurveyResponse = m(e.UpdateSurveyResponse)
+	e.ListSurveyInvites = m(e.ListSurveyInvites)
+	e.GetSurveyInviteSettings = m(e.GetSurveyInviteSettings)
+	e.UpdateSurveyInviteSettings = m(e.UpdateSurveyInviteSettings)
+	e.DeleteSurveyInviteSettings = m(e.DeleteSurveyInviteSettings)
+	e.GetProjectInviteSettings = m(e.GetProjectInviteSettings)
+	e.UpdateProjectInviteSettings = m(e.UpdateProjectInviteSettings)
+	e.DeleteProjectInviteSettings = m(e.DeleteProjectInviteSettings)
+	e.GetSurveySettings = m(e.GetSurveySettings)
+	e.UpdateSurveySettings = m(e.UpdateSurveySettings)
+	e.GetSurveyResults = m(e.GetSurveyResults)
 	e.ValidateEmail = m(e.ValidateEmail)
+	e.ListSurveyTemplates = m(e.ListSurveyTemplates)
+	e.GetSurveyTemplate = m(e.GetSurveyTemplate)
+	e.ListDeadLetters = m(e.ListDeadLetters)
+	e.GetDeadLetter = m(e.GetDeadLetter)
+	e.ReplayDeadLetter = m(e.ReplayDeadLetter)
+	e.StartReindex = m(e.StartReindex)
+	e.GetReindex = m(e.GetReindex)
+	e.CancelReindex = m(e.CancelReindex)
+	e.RerunInviteEnrichment = m(e.RerunInviteEnrichment)
+	e.StartInviteBackfill = m(e.StartInviteBackfill)
+	e.GetInviteBackfill = m(e.GetInviteBackfill)
+	e.CancelInviteBackfill = m(e.CancelInviteBackfill)
 }
 
 // NewScheduleSurveyEndpoint returns an endpoint function that calls the method
@@ -424,6 +496,282 @@ func NewListSurveyResponsesEndpoint(s Service, authJWTFn security.AuthJWTFunc) g
 	}
 }
 
+// NewCreateSurveyResponseEndpoint returns an endpoint function that calls the
+// method "create_survey_response" of service "survey".
+func NewCreateSurveyResponseEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateSurveyResponsePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"read:projects", "manage:projects", "manage:surveys"},
+			RequiredScopes: []string{"manage:projects", "manage:surveys"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.CreateSurveyResponse(ctx, p)
+	}
+}
+
+// NewUpdateSurveyResponseEndpoint returns an endpoint function that calls the
+// method "update_survey_response" of service "survey".
+func NewUpdateSurveyResponseEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdateSurveyResponsePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"read:projects", "manage:projects", "manage:surveys"},
+			RequiredScopes: []string{"manage:projects", "manage:surveys"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.UpdateSurveyResponse(ctx, p)
+	}
+}
+
+// NewListSurveyInvitesEndpoint returns an endpoint function that calls the
+// method "list_survey_invites" of service "survey".
+func NewListSurveyInvitesEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListSurveyInvitesPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"read:projects", "manage:projects", "manage:surveys"},
+			RequiredScopes: []string{"manage:projects", "manage:surveys"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListSurveyInvites(ctx, p)
+	}
+}
+
+// NewGetSurveyInviteSettingsEndpoint returns an endpoint function that calls
+// the method "get_survey_invite_settings" of service "survey".
+func NewGetSurveyInviteSettingsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetSurveyInviteSettingsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"read:projects", "manage:projects", "manage:surveys"},
+			RequiredScopes: []string{"manage:projects", "manage:surveys"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetSurveyInviteSettings(ctx, p)
+	}
+}
+
+// NewUpdateSurveyInviteSettingsEndpoint returns an endpoint function that
+// calls the method "update_survey_invite_settings" of service "survey".
+func NewUpdateSurveyInviteSettingsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdateSurveyInviteSettingsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"read:projects", "manage:projects", "manage:surveys"},
+			RequiredScopes: []string{"manage:projects", "manage:surveys"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.UpdateSurveyInviteSettings(ctx, p)
+	}
+}
+
+// NewDeleteSurveyInviteSettingsEndpoint returns an endpoint function that
+// calls the method "delete_survey_invite_settings" of service "survey".
+func NewDeleteSurveyInviteSettingsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteSurveyInviteSettingsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"read:projects", "manage:projects", "manage:surveys"},
+			RequiredScopes: []string{"manage:projects", "manage:surveys"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.DeleteSurveyInviteSettings(ctx, p)
+	}
+}
+
+// NewGetProjectInviteSettingsEndpoint returns an endpoint function that calls
+// the method "get_project_invite_settings" of service "survey".
+func NewGetProjectInviteSettingsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetProjectInviteSettingsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"read:projects", "manage:projects", "manage:surveys"},
+			RequiredScopes: []string{"manage:projects", "manage:surveys"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetProjectInviteSettings(ctx, p)
+	}
+}
+
+// NewUpdateProjectInviteSettingsEndpoint returns an endpoint function that
+// calls the method "update_project_invite_settings" of service "survey".
+func NewUpdateProjectInviteSettingsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdateProjectInviteSettingsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"read:projects", "manage:projects", "manage:surveys"},
+			RequiredScopes: []string{"manage:projects", "manage:surveys"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.UpdateProjectInviteSettings(ctx, p)
+	}
+}
+
+// NewDeleteProjectInviteSettingsEndpoint returns an endpoint function that
+// calls the method "delete_project_invite_settings" of service "survey".
+func NewDeleteProjectInviteSettingsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteProjectInviteSettingsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"read:projects", "manage:projects", "manage:surveys"},
+			RequiredScopes: []string{"manage:projects", "manage:surveys"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.DeleteProjectInviteSettings(ctx, p)
+	}
+}
+
+// NewGetSurveySettingsEndpoint returns an endpoint function that calls the
+// method "get_survey_settings" of service "survey".
+func NewGetSurveySettingsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetSurveySettingsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"read:projects", "manage:projects", "manage:surveys"},
+			RequiredScopes: []string{"manage:projects", "manage:surveys"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetSurveySettings(ctx, p)
+	}
+}
+
+// NewUpdateSurveySettingsEndpoint returns an endpoint function that calls the
+// method "update_survey_settings" of service "survey".
+func NewUpdateSurveySettingsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdateSurveySettingsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"read:projects", "manage:projects", "manage:surveys"},
+			RequiredScopes: []string{"manage:projects", "manage:surveys"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.UpdateSurveySettings(ctx, p)
+	}
+}
+
+// NewGetSurveyResultsEndpoint returns an endpoint function that calls the
+// method "get_survey_results" of service "survey".
+func NewGetSurveyResultsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetSurveyResultsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"read:projects", "manage:projects", "manage:surveys"},
+			RequiredScopes: []string{"manage:projects", "manage:surveys"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetSurveyResults(ctx, p)
+	}
+}
+
 // NewValidateEmailEndpoint returns an endpoint function that calls the method
 // "validate_email" of service "survey".
 func NewValidateEmailEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
@@ -446,3 +794,279 @@ func NewValidateEmailEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.End
 		return s.ValidateEmail(ctx, p)
 	}
 }
+
+// NewListSurveyTemplatesEndpoint returns an endpoint function that calls the
+// method "list_survey_templates" of service "survey".
+func NewListSurveyTemplatesEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListSurveyTemplatesPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"read:projects", "manage:projects", "manage:surveys"},
+			RequiredScopes: []string{"manage:projects", "manage:surveys"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListSurveyTemplates(ctx, p)
+	}
+}
+
+// NewGetSurveyTemplateEndpoint returns an endpoint function that calls the
+// method "get_survey_template" of service "survey".
+func NewGetSurveyTemplateEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetSurveyTemplatePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"read:projects", "manage:projects", "manage:surveys"},
+			RequiredScopes: []string{"manage:projects", "manage:surveys"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetSurveyTemplate(ctx, p)
+	}
+}
+
+// NewListDeadLettersEndpoint returns an endpoint function that calls the
+// method "list_dead_letters" of service "survey".
+func NewListDeadLettersEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListDeadLettersPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"read:projects", "manage:projects", "manage:surveys"},
+			RequiredScopes: []string{"manage:surveys"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListDeadLetters(ctx, p)
+	}
+}
+
+// NewGetDeadLetterEndpoint returns an endpoint function that calls the method
+// "get_dead_letter" of service "survey".
+func NewGetDeadLetterEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetDeadLetterPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"read:projects", "manage:projects", "manage:surveys"},
+			RequiredScopes: []string{"manage:surveys"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetDeadLetter(ctx, p)
+	}
+}
+
+// NewReplayDeadLetterEndpoint returns an endpoint function that calls the
+// method "replay_dead_letter" of service "survey".
+func NewReplayDeadLetterEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ReplayDeadLetterPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"read:projects", "manage:projects", "manage:surveys"},
+			RequiredScopes: []string{"manage:surveys"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ReplayDeadLetter(ctx, p)
+	}
+}
+
+// NewStartReindexEndpoint returns an endpoint function that calls the method
+// "start_reindex" of service "survey".
+func NewStartReindexEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*StartReindexPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"read:projects", "manage:projects", "manage:surveys"},
+			RequiredScopes: []string{"manage:surveys"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.StartReindex(ctx, p)
+	}
+}
+
+// NewGetReindexEndpoint returns an endpoint function that calls the method
+// "get_reindex" of service "survey".
+func NewGetReindexEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetReindexPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"read:projects", "manage:projects", "manage:surveys"},
+			RequiredScopes: []string{"manage:surveys"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetReindex(ctx, p)
+	}
+}
+
+// NewCancelReindexEndpoint returns an endpoint function that calls the method
+// "cancel_reindex" of service "survey".
+func NewCancelReindexEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CancelReindexPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"read:projects", "manage:projects", "manage:surveys"},
+			RequiredScopes: []string{"manage:surveys"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.CancelReindex(ctx, p)
+	}
+}
+
+// NewRerunInviteEnrichmentEndpoint returns an endpoint function that calls the
+// method "rerun_invite_enrichment" of service "survey".
+func NewRerunInviteEnrichmentEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RerunInviteEnrichmentPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"read:projects", "manage:projects", "manage:surveys"},
+			RequiredScopes: []string{"manage:surveys"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.RerunInviteEnrichment(ctx, p)
+	}
+}
+
+// NewStartInviteBackfillEndpoint returns an endpoint function that calls the
+// method "start_invite_backfill" of service "survey".
+func NewStartInviteBackfillEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*StartInviteBackfillPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"read:projects", "manage:projects", "manage:surveys"},
+			RequiredScopes: []string{"manage:surveys"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.StartInviteBackfill(ctx, p)
+	}
+}
+
+// NewGetInviteBackfillEndpoint returns an endpoint function that calls the
+// method "get_invite_backfill" of service "survey".
+func NewGetInviteBackfillEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetInviteBackfillPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"read:projects", "manage:projects", "manage:surveys"},
+			RequiredScopes: []string{"manage:surveys"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.GetInviteBackfill(ctx, p)
+	}
+}
+
+// NewCancelInviteBackfillEndpoint returns an endpoint function that calls the
+// method "cancel_invite_backfill" of service "survey".
+func NewCancelInviteBackfillEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CancelInviteBackfillPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"read:projects", "manage:projects", "manage:surveys"},
+			RequiredScopes: []string{"manage:surveys"},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.CancelInviteBackfill(ctx, p)
+	}
+}
@@ -61,9 +61,75 @@ type Service interface {
 	// List individual per-recipient responses for a survey (proxies to ITX GET
 	// /v2/surveys/{survey_uid}/responses)
 	ListSurveyResponses(context.Context, *ListSurveyResponsesPayload) (res *SurveyResponsesPage, err error)
+	// Submit a survey response (proxies to ITX POST /v2/surveys/responses).
+	// Answers are validated against the survey's template questions first; the
+	// caller must be the respondent the response belongs to
+	CreateSurveyResponse(context.Context, *CreateSurveyResponsePayload) (err error)
+	// Replace the answers of a survey response (proxies to ITX PUT
+	// /v2/surveys/responses/{response_id}). Answers are validated against the
+	// survey's template questions first
+	UpdateSurveyResponse(context.Context, *UpdateSurveyResponsePayload) (err error)
+	// List the LFID invites sent to recipients of a survey, with counts by status
+	ListSurveyInvites(context.Context, *ListSurveyInvitesPayload) (res *SurveyInviteList, err error)
+	// Get the LFID invite settings stored for a survey
+	GetSurveyInviteSettings(context.Context, *GetSurveyInviteSettingsPayload) (res *InviteSettings, err error)
+	// Replace the LFID invite settings of a survey; unset fields inherit from the
+	// project
+	UpdateSurveyInviteSettings(context.Context, *UpdateSurveyInviteSettingsPayload) (res *InviteSettings, err error)
+	// Remove the LFID invite settings of a survey so that its invites inherit again
+	DeleteSurveyInviteSettings(context.Context, *DeleteSurveyInviteSettingsPayload) (err error)
+	// Get the LFID invite settings stored for a project
+	GetProjectInviteSettings(context.Context, *GetProjectInviteSettingsPayload) (res *InviteSettings, err error)
+	// Replace the LFID invite settings of a project; unset fields inherit the
+	// service defaults
+	UpdateProjectInviteSettings(context.Context, *UpdateProjectInviteSettingsPayload) (res *InviteSettings, err error)
+	// Remove the LFID invite settings of a project so that its invites inherit
+	// again
+	DeleteProjectInviteSettings(context.Context, *DeleteProjectInviteSettingsPayload) (err error)
+	// Get the settings this service keeps for a survey
+	GetSurveySettings(context.Context, *GetSurveySettingsPayload) (res *SurveySettings, err error)
+	// Replace the settings of a survey. Turning share_results on grants every
+	// respondent with an LFX username the results_viewer relation on the survey;
+	// turning it off revokes it. Turning anonymous on republishes the survey's
+	// responses de-identified; it cannot be turned off.
+	UpdateSurveySettings(context.Context, *UpdateSurveySettingsPayload) (res *SurveySettings, err error)
+	// Get the aggregate results of a survey (proxies to ITX GET
+	// /v2/surveys/{survey_id}/results without free-text comments). Open to
+	// respondents of surveys that share results.
+	GetSurveyResults(context.Context, *GetSurveyResultsPayload) (res *SurveyResults, err error)
 	// Validate email template body and subject (proxies to ITX POST
 	// /v2/surveys/validate_email)
 	ValidateEmail(context.Context, *ValidateEmailPayload) (res *ValidateEmailResult, err error)
+	// Search the catalog of SurveyMonkey surveys that surveys can be scheduled from
+	ListSurveyTemplates(context.Context, *ListSurveyTemplatesPayload) (res *SurveyTemplateList, err error)
+	// Get a SurveyMonkey survey from the template catalog
+	GetSurveyTemplate(context.Context, *GetSurveyTemplatePayload) (res *SurveyTemplate, err error)
+	// List v1-objects KV events that exhausted their retries or failed conversion
+	ListDeadLetters(context.Context, *ListDeadLettersPayload) (res *DeadLetterList, err error)
+	// Inspect a dead-lettered KV event, including its original payload and headers
+	GetDeadLetter(context.Context, *GetDeadLetterPayload) (res *DeadLetterEntry, err error)
+	// Re-run a dead-lettered KV event through the event handlers; the entry is
+	// removed when it succeeds
+	ReplayDeadLetter(context.Context, *ReplayDeadLetterPayload) (res *DeadLetterReplayResult, err error)
+	// Replay v1-objects survey entries through the event handlers in the
+	// background, to rebuild downstream documents
+	StartReindex(context.Context, *StartReindexPayload) (res *ReindexStatus, err error)
+	// Report the progress of the current or most recent reindex on this instance
+	GetReindex(context.Context, *GetReindexPayload) (res *ReindexStatus, err error)
+	// Stop the running reindex; entries already replayed are not rolled back
+	CancelReindex(context.Context, *CancelReindexPayload) (res *ReindexStatus, err error)
+	// Re-run the ITX survey response enrichment for an accepted LFID invite, e.g.
+	// after its invite_accepted event was dead-lettered
+	RerunInviteEnrichment(context.Context, *RerunInviteEnrichmentPayload) (res *InviteEnrichmentResult, err error)
+	// Send LFID invites in the background to participants of open surveys who have
+	// an email but no username and were never invited, e.g. because they were
+	// synced before invites were enabled
+	StartInviteBackfill(context.Context, *StartInviteBackfillPayload) (res *InviteBackfillStatus, err error)
+	// Report the progress of the current or most recent invite backfill on this
+	// instance
+	GetInviteBackfill(context.Context, *GetInviteBackfillPayload) (res *InviteBackfillStatus, err error)
+	// Stop the running invite backfill; invites already sent are not withdrawn
+	CancelInviteBackfill(context.Context, *CancelInviteBackfillPayload) (res *InviteBackfillStatus, err error)
 }
 
 // Auther defines the authorization functions to be implemented by the service.
@@ -86,7 +152,7 @@ const ServiceName = "survey"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [16]string{"schedule_survey", "get_survey", "update_survey", "delete_survey", "bulk_resend_survey", "preview_send_survey", "send_missing_recipients", "delete_survey_response", "resend_survey_response", "delete_recipient_group", "create_exclusion", "delete_exclusion", "get_exclusion", "delete_exclusion_by_id", "list_survey_responses", "validate_email"}
+var MethodNames = [40]string{"schedule_survey", "get_survey", "update_survey", "delete_survey", "bulk_resend_survey", "preview_send_survey", "send_missing_recipients", "delete_survey_response", "resend_survey_response", "delete_recipient_group", "create_exclusion", "delete_exclusion", "get_exclusion", "delete_exclusion_by_id", "list_survey_responses", "create_survey_response", "update_survey_response", "list_survey_invites", "get_survey_invite_settings", "update_survey_invite_settings", "delete_survey_invite_settings", "get_project_invite_settings", "update_project_invite_settings", "delete_project_invite_settings", "get_survey_settings", "update_survey_settings", "get_survey_results", "validate_email", "list_survey_templates", "get_survey_template", "list_dead_letters", "get_dead_letter", "replay_dead_letter", "start_reindex", "get_reindex", "cancel_reindex", "rerun_invite_enrichment", "start_invite_backfill", "get_invite_backfill", "cancel_invite_backfill"}
 
 // Bad request error response
 type BadRequestError struct {
@@ -94,6 +160,8 @@ type BadRequestError struct {
 	Code string
 	// Error message
 	Message string
+	// Per-question problems when survey response answers are rejected
+	QuestionErrors []*QuestionError
 }
 
 // BulkResendSurveyPayload is the payload type of the survey service
@@ -107,6 +175,20 @@ type BulkResendSurveyPayload struct {
 	RecipientIds []string
 }
 
+// CancelInviteBackfillPayload is the payload type of the survey service
+// cancel_invite_backfill method.
+type CancelInviteBackfillPayload struct {
+	// JWT token
+	Token *string
+}
+
+// CancelReindexPayload is the payload type of the survey service
+// cancel_reindex method.
+type CancelReindexPayload struct {
+	// JWT token
+	Token *string
+}
+
 // Conflict error response
 type ConflictError struct {
 	// HTTP status code
@@ -132,6 +214,71 @@ type CreateExclusionPayload struct {
 	GlobalExclusion *string
 }
 
+// CreateSurveyResponsePayload is the payload type of the survey service
+// create_survey_response method.
+type CreateSurveyResponsePayload struct {
+	// JWT token
+	Token *string
+	// Survey identifier
+	SurveyUID string
+	// Survey response identifier
+	SurveyResponseUID string
+	// Answers to the survey questions
+	Answers []*SurveyAnswer
+}
+
+// DeadLetterEntry is the result type of the survey service get_dead_letter
+// method.
+type DeadLetterEntry struct {
+	// Dead-letter stream sequence, used as the entry identifier
+	Sequence uint64
+	// Original v1-objects KV key
+	Key string
+	// Original KV operation
+	Operation string
+	// KV revision of the original event
+	Revision *uint64
+	// Why the event was dead-lettered
+	Reason string
+	// Last processing error
+	Error string
+	// Number of delivery attempts made
+	Attempts uint64
+	// When the event was dead-lettered (RFC3339)
+	DeadLetteredAt string
+	// Original KV value (JSON or msgpack); only returned when inspecting a single
+	// entry
+	Payload []byte
+	// Original message headers; only returned when inspecting a single entry
+	Headers map[string][]string
+}
+
+// DeadLetterList is the result type of the survey service list_dead_letters
+// method.
+type DeadLetterList struct {
+	// Dead-lettered events
+	Entries []*DeadLetterEntry
+	// Pass as after to fetch the next page; absent on the last page
+	NextAfter *uint64
+}
+
+// DeadLetterReplayResult is the result type of the survey service
+// replay_dead_letter method.
+type DeadLetterReplayResult struct {
+	// Dead-letter stream sequence
+	Sequence uint64
+	// Original v1-objects KV key
+	Key string
+	// replayed: the key's current value, or the delete while the key is gone, was
+	// run through the handlers; superseded or key_deleted: the key has since been
+	// written again or deleted, so the event was dropped without a replay
+	Outcome string
+	// True when the event was resolved and removed from the dead-letter stream
+	Succeeded bool
+	// Why the replay failed; the entry is kept for another attempt
+	Error *string
+}
+
 // DeleteExclusionByIDPayload is the payload type of the survey service
 // delete_exclusion_by_id method.
 type DeleteExclusionByIDPayload struct {
@@ -158,6 +305,15 @@ type DeleteExclusionPayload struct {
 	GlobalExclusion *string
 }
 
+// DeleteProjectInviteSettingsPayload is the payload type of the survey service
+// delete_project_invite_settings method.
+type DeleteProjectInviteSettingsPayload struct {
+	// JWT token
+	Token *string
+	// Project identifier
+	ProjectUID string
+}
+
 // DeleteRecipientGroupPayload is the payload type of the survey service
 // delete_recipient_group method.
 type DeleteRecipientGroupPayload struct {
@@ -174,6 +330,15 @@ type DeleteRecipientGroupPayload struct {
 	FoundationID *string
 }
 
+// DeleteSurveyInviteSettingsPayload is the payload type of the survey service
+// delete_survey_invite_settings method.
+type DeleteSurveyInviteSettingsPayload struct {
+	// JWT token
+	Token *string
+	// Survey identifier
+	SurveyUID string
+}
+
 // DeleteSurveyPayload is the payload type of the survey service delete_survey
 // method.
 type DeleteSurveyPayload struct {
@@ -262,6 +427,15 @@ type ForbiddenError struct {
 	Message string
 }
 
+// GetDeadLetterPayload is the payload type of the survey service
+// get_dead_letter method.
+type GetDeadLetterPayload struct {
+	// JWT token
+	Token *string
+	// Dead-letter stream sequence
+	Sequence uint64
+}
+
 // GetExclusionPayload is the payload type of the survey service get_exclusion
 // method.
 type GetExclusionPayload struct {
@@ -271,6 +445,38 @@ type GetExclusionPayload struct {
 	ExclusionID string
 }
 
+// GetInviteBackfillPayload is the payload type of the survey service
+// get_invite_backfill method.
+type GetInviteBackfillPayload struct {
+	// JWT token
+	Token *string
+}
+
+// GetProjectInviteSettingsPayload is the payload type of the survey service
+// get_project_invite_settings method.
+type GetProjectInviteSettingsPayload struct {
+	// JWT token
+	Token *string
+	// Project identifier
+	ProjectUID string
+}
+
+// GetReindexPayload is the payload type of the survey service get_reindex
+// method.
+type GetReindexPayload struct {
+	// JWT token
+	Token *string
+}
+
+// GetSurveyInviteSettingsPayload is the payload type of the survey service
+// get_survey_invite_settings method.
+type GetSurveyInviteSettingsPayload struct {
+	// JWT token
+	Token *string
+	// Survey identifier
+	SurveyUID string
+}
+
 // GetSurveyPayload is the payload type of the survey service get_survey method.
 type GetSurveyPayload struct {
 	// JWT token
@@ -284,6 +490,33 @@ type GetSurveyPayload struct {
 	ProjectUids *string
 }
 
+// GetSurveyResultsPayload is the payload type of the survey service
+// get_survey_results method.
+type GetSurveyResultsPayload struct {
+	// JWT token
+	Token *string
+	// Survey identifier
+	SurveyUID string
+}
+
+// GetSurveySettingsPayload is the payload type of the survey service
+// get_survey_settings method.
+type GetSurveySettingsPayload struct {
+	// JWT token
+	Token *string
+	// Survey identifier
+	SurveyUID string
+}
+
+// GetSurveyTemplatePayload is the payload type of the survey service
+// get_survey_template method.
+type GetSurveyTemplatePayload struct {
+	// JWT token
+	Token *string
+	// SurveyMonkey survey ID, as passed to schedule_survey as survey_monkey_id
+	TemplateID string
+}
+
 // Recipient information for preview send
 type ITXPreviewRecipient struct {
 	// LF user ID
@@ -310,6 +543,96 @@ type InternalServerError struct {
 	Message string
 }
 
+// One send of an LFID invite to the invite service
+type InviteAttempt struct {
+	// When the send was attempted (RFC3339)
+	At string
+	// Invite UID issued by a successful send
+	InviteUID *string
+	// When the issued invite expires (RFC3339)
+	ExpiresAt *string
+	// Why the send failed
+	Error *string
+}
+
+// InviteBackfillStatus is the result type of the survey service
+// start_invite_backfill method.
+type InviteBackfillStatus struct {
+	// Run state
+	State string
+	// True when candidates are only reported
+	DryRun bool
+	// Surveys the run is limited to; absent when it covers every open survey
+	SurveyUids []string
+	// Candidate rate limit
+	RatePerSecond int
+	// When the run started (RFC3339)
+	StartedAt string
+	// When the run ended (RFC3339); absent while running
+	FinishedAt *string
+	// Survey responses listed
+	Total int
+	// Survey responses read so far
+	Scanned int
+	// Responses that need an invite
+	Candidates int
+	// Distinct emails among the candidates; at most this many invites are sent
+	Recipients int
+	// Candidates sent a new invite
+	Invited int
+	// Candidates attached to an outstanding invite of their email
+	Attached int
+	// Candidates whose invite could not be sent
+	Failed int
+	// Responses passed over, by reason: no_email, has_username, already_invited,
+	// survey_not_open, no_survey_name, invites_disabled, not_synced, has_lfid or
+	// unreadable
+	Skipped map[string]int
+	// First candidates found (capped at 100)
+	CandidateResponseUids []string
+	// Why the run failed
+	Error *string
+}
+
+// InviteEnrichmentResult is the result type of the survey service
+// rerun_invite_enrichment method.
+type InviteEnrichmentResult struct {
+	// Acceptor email
+	Email string
+	// LFX username written to the survey responses
+	Username string
+	// Dead-lettered invite_accepted events of the email removed after the run
+	DeadLettersCleared uint64
+}
+
+// InviteSettings is the result type of the survey service
+// get_survey_invite_settings method.
+type InviteSettings struct {
+	// What the settings apply to
+	Scope string
+	// Project or survey identifier
+	UID string
+	// Send LFID invites; false turns them off and true turns them back on for a
+	// survey of a disabled project. Omit to inherit.
+	Enabled *bool
+	// Invite service role granted when the invite is accepted. Omit to inherit.
+	Role *string
+	// Days an invite stays valid. Omit to inherit.
+	ExpirationDays *int
+	// Where the recipient lands after accepting; {base_url}, {survey_uid},
+	// {project_uid} and {locale} are substituted. Omit to inherit.
+	ReturnURLTemplate *string
+	// Survey name used in the invite when the survey has none. Omit to inherit.
+	DisplayNameFallback *string
+	// Recipient language as a BCP 47 tag, substituted for {locale} in the return
+	// URL. Omit to inherit.
+	Locale *string
+	// When the settings were last changed (RFC3339)
+	UpdatedAt string
+	// Who last changed the settings
+	UpdatedBy *string
+}
+
 // LFX Project information
 type LFXProject struct {
 	// Project ID
@@ -324,6 +647,30 @@ type LFXProject struct {
 	LogoURL *string
 }
 
+// ListDeadLettersPayload is the payload type of the survey service
+// list_dead_letters method.
+type ListDeadLettersPayload struct {
+	// JWT token
+	Token *string
+	// Only return events whose KV key starts with this prefix
+	KeyPrefix *string
+	// Return entries after this sequence (next_after from the previous page)
+	After *uint64
+	// Maximum number of entries to return
+	Limit int
+}
+
+// ListSurveyInvitesPayload is the payload type of the survey service
+// list_survey_invites method.
+type ListSurveyInvitesPayload struct {
+	// JWT token
+	Token *string
+	// Survey identifier
+	SurveyUID string
+	// Only list invites in this status
+	Status *string
+}
+
 // ListSurveyResponsesPayload is the payload type of the survey service
 // list_survey_responses method.
 type ListSurveyResponsesPayload struct {
@@ -342,6 +689,24 @@ type ListSurveyResponsesPayload struct {
 	ProjectUids *string
 }
 
+// ListSurveyTemplatesPayload is the payload type of the survey service
+// list_survey_templates method.
+type ListSurveyTemplatesPayload struct {
+	// JWT token
+	Token *string
+	// Only return templates whose title or nickname contains this text
+	// (case-insensitive)
+	Title *string
+	// Only return templates in this category
+	Category *string
+	// Only return templates in this language
+	Language *string
+	// Number of matching templates to skip
+	Offset int
+	// Maximum number of templates to return
+	Limit int
+}
+
 // Not found error response
 type NotFoundError struct {
 	// HTTP status code
@@ -372,6 +737,71 @@ type PreviewSendSurveyPayload struct {
 	CommitteeUID *string
 }
 
+// Validation problem with the answer to one survey question
+type QuestionError struct {
+	// SurveyMonkey question ID
+	QuestionID string
+	// Why the answer was rejected
+	Message string
+}
+
+// ReindexStatus is the result type of the survey service start_reindex method.
+type ReindexStatus struct {
+	// Run state
+	State string
+	// True when entries are only counted
+	DryRun bool
+	// True when unchanged entries are republished
+	Force bool
+	// Key prefixes the run is limited to; absent when it covers every survey
+	// object type
+	KeyPrefixes []string
+	// Lower bound on the entry write time (RFC3339)
+	ModifiedSince *string
+	// Upper bound on the entry write time (RFC3339)
+	ModifiedUntil *string
+	// Handler rate limit
+	RatePerSecond int
+	// When the run started (RFC3339)
+	StartedAt string
+	// When the run ended (RFC3339); absent while running
+	FinishedAt *string
+	// Keys under the selected prefixes
+	Total int
+	// Keys read so far
+	Scanned int
+	// Scanned keys inside the time range
+	Matched int
+	// Entries the handlers processed
+	Processed int
+	// Entries that could not be read or processed
+	Failed int
+	// First keys that failed (capped at 100)
+	FailedKeys []string
+	// Why the run failed
+	Error *string
+}
+
+// ReplayDeadLetterPayload is the payload type of the survey service
+// replay_dead_letter method.
+type ReplayDeadLetterPayload struct {
+	// JWT token
+	Token *string
+	// Dead-letter stream sequence
+	Sequence uint64
+}
+
+// RerunInviteEnrichmentPayload is the payload type of the survey service
+// rerun_invite_enrichment method.
+type RerunInviteEnrichmentPayload struct {
+	// JWT token
+	Token *string
+	// Email the invite was accepted for
+	Email string
+	// LFX username of the acceptor; looked up by email when omitted
+	Username *string
+}
+
 // ResendSurveyResponsePayload is the payload type of the survey service
 // resend_survey_response method.
 type ResendSurveyResponsePayload struct {
@@ -383,6 +813,16 @@ type ResendSurveyResponsePayload struct {
 	ResponseID string
 }
 
+// results_viewer grants and revocations made to apply share_results
+type ResultsAccessSync struct {
+	// Respondents granted results access
+	Granted int
+	// Respondents whose results access was revoked
+	Revoked int
+	// Changes that failed; update the settings again to retry them
+	Failed int
+}
+
 // ScheduleSurveyPayload is the payload type of the survey service
 // schedule_survey method.
 type ScheduleSurveyPayload struct {
@@ -441,6 +881,55 @@ type ServiceUnavailableError struct {
 	Message string
 }
 
+// StartInviteBackfillPayload is the payload type of the survey service
+// start_invite_backfill method.
+type StartInviteBackfillPayload struct {
+	// JWT token
+	Token *string
+	// Only backfill the responses of these surveys. Defaults to every open survey.
+	SurveyUids []string
+	// Report the responses that would be invited without sending anything
+	DryRun bool
+	// Maximum candidates checked and invited per second
+	RatePerSecond int
+}
+
+// StartReindexPayload is the payload type of the survey service start_reindex
+// method.
+type StartReindexPayload struct {
+	// JWT token
+	Token *string
+	// Only replay keys starting with one of these prefixes; each must begin with
+	// itx-surveys, itx-survey-responses, itx-survey-exclusions or
+	// surveymonkey-surveys. Defaults to all of them.
+	KeyPrefixes []string
+	// Only replay entries last written at or after this time (RFC3339)
+	ModifiedSince *string
+	// Only replay entries last written before this time (RFC3339)
+	ModifiedUntil *string
+	// Count matching entries without running the handlers
+	DryRun bool
+	// Republish entries even when their converted content is unchanged since the
+	// last publish
+	Force bool
+	// Maximum entries run through the handlers per second
+	RatePerSecond int
+}
+
+// Answer to one survey question
+type SurveyAnswer struct {
+	// SurveyMonkey question ID
+	QuestionID string
+	// Text answer for open-ended, demographic and date questions
+	AnswerText *string
+	// Selected choice IDs for choice and matrix questions
+	ChoiceIds []string
+	// Rating for rating questions
+	RatingValue *int
+	// Answer for single choice questions with two choices
+	YesNoValue *bool
+}
+
 // A single answer choice or text entry for a survey question
 type SurveyAnswerChoice struct {
 	// Choice identifier (for multiple-choice questions)
@@ -449,6 +938,19 @@ type SurveyAnswerChoice struct {
 	Text *string
 }
 
+// How often an answer was given
+type SurveyAnswerResult struct {
+	// Answer
+	Answer string
+	// Number of responses with the answer
+	Count int
+	// Share of the question's responses with the answer
+	Percentage float64
+	// The answer was given by too few respondents of an anonymous survey; count
+	// and percentage are zero
+	Suppressed *bool
+}
+
 // Survey committee details
 type SurveyCommittee struct {
 	// Committee name
@@ -469,6 +971,68 @@ type SurveyCommittee struct {
 	NpsValue *float64
 }
 
+// LFID invite sent to a survey recipient who had no LFID
+type SurveyInvite struct {
+	// Survey response the invite was sent for
+	SurveyResponseUID string
+	// Recipient email
+	Email string
+	// Recipient name
+	Name *string
+	// Invite status; sent invites past their expiry are reported as expired
+	Status string
+	// Invite service invite UID
+	InviteUID *string
+	// Why the last send failed
+	Error *string
+	// When the invite was first attempted (RFC3339)
+	CreatedAt string
+	// When the invite service accepted the invite (RFC3339)
+	SentAt *string
+	// When the last send failed (RFC3339)
+	FailedAt *string
+	// When the invite expires (RFC3339)
+	ExpiresAt *string
+	// When the invite was accepted (RFC3339)
+	AcceptedAt *string
+	// LFX username of the acceptor
+	AcceptedBy *string
+	// Times the invite was re-sent after expiring unaccepted
+	Reinvites int
+	// Every send of the invite, oldest first
+	Attempts []*InviteAttempt
+	// Survey response whose invite the recipient shares instead of receiving a
+	// separate one
+	AttachedTo *string
+	// When the invite state last changed (RFC3339)
+	UpdatedAt string
+}
+
+// Number of invites in each status
+type SurveyInviteCounts struct {
+	// All invites
+	Total int
+	// Invites interrupted before the invite service replied
+	Pending int
+	// Invites awaiting acceptance
+	Sent int
+	// Invites the invite service rejected or did not answer
+	Failed int
+	// Accepted invites
+	Accepted int
+	// Sent invites past their expiry
+	Expired int
+}
+
+// SurveyInviteList is the result type of the survey service
+// list_survey_invites method.
+type SurveyInviteList struct {
+	// Counts over all invites of the survey, regardless of the status filter
+	Counts *SurveyInviteCounts
+	// Invites ordered by email
+	Invites []*SurveyInvite
+}
+
 // A survey question and the answers submitted by the recipient
 type SurveyQuestionAnswer struct {
 	// Question identifier
@@ -483,6 +1047,18 @@ type SurveyQuestionAnswer struct {
 	Answers []*SurveyAnswerChoice
 }
 
+// Answer counts of a survey question
+type SurveyQuestionResult struct {
+	// Question identifier
+	QuestionID string
+	// Question text
+	QuestionText *string
+	// Question type
+	QuestionType *string
+	// Counts per answer
+	Answers []*SurveyAnswerResult
+}
+
 // Individual survey response submitted by a recipient
 type SurveyResponseItem struct {
 	// Response identifier
@@ -592,6 +1168,26 @@ type SurveyResponsesPage struct {
 	Meta *SurveyResponsePageMeta
 }
 
+// SurveyResults is the result type of the survey service get_survey_results
+// method.
+type SurveyResults struct {
+	// Survey identifier
+	SurveyUID string
+	// Number of recipients
+	NumRecipients int
+	// Number of responses
+	NumResponses int
+	// When the survey closes (RFC3339)
+	SurveyEndTime *string
+	// Answer counts per question
+	Questions []*SurveyQuestionResult
+	// Set for anonymous surveys: answers given by fewer respondents are suppressed
+	MinCellSize *int
+	// Set for anonymous surveys with fewer than min_cell_size responses: every
+	// answer is suppressed
+	Suppressed *bool
+}
+
 // SurveyScheduleResult is the result type of the survey service
 // schedule_survey method.
 type SurveyScheduleResult struct {
@@ -669,6 +1265,62 @@ type SurveyScheduleResult struct {
 	LatestAutomatedReminderSentAt *string
 }
 
+// SurveySettings is the result type of the survey service get_survey_settings
+// method.
+type SurveySettings struct {
+	// Survey identifier
+	SurveyUID string
+	// Whether respondents can view the survey's aggregate results
+	ShareResults bool
+	// Whether responses are kept de-identified from organizers
+	Anonymous bool
+	// When the settings were last changed (RFC3339); unset until they are first
+	// stored
+	UpdatedAt *string
+	// Who last changed the settings
+	UpdatedBy *string
+	// results_viewer changes made by this update; only set on update
+	ResultsAccess *ResultsAccessSync
+}
+
+// SurveyTemplate is the result type of the survey service get_survey_template
+// method.
+type SurveyTemplate struct {
+	// SurveyMonkey survey ID; pass as survey_monkey_id to schedule_survey
+	ID string
+	// Survey title
+	Title string
+	// Internal nickname
+	Nickname *string
+	// Survey category
+	Category *string
+	// Survey language
+	Language *string
+	// Number of questions
+	QuestionCount *int
+	// Number of pages
+	PageCount *int
+	// URL to preview the survey
+	PreviewURL *string
+	// URL of the survey collectors
+	CollectURL *string
+	// When the survey was created in SurveyMonkey
+	DateCreated *string
+	// When the survey was last modified in SurveyMonkey
+	DateModified *string
+}
+
+// SurveyTemplateList is the result type of the survey service
+// list_survey_templates method.
+type SurveyTemplateList struct {
+	// Matching templates
+	Templates []*SurveyTemplate
+	// Number of matching templates across all pages
+	Total int
+	// Pass as offset to fetch the next page; absent on the last page
+	NextOffset *int
+}
+
 // Unauthorized error response
 type UnauthorizedError struct {
 	// HTTP status code
@@ -677,6 +1329,54 @@ type UnauthorizedError struct {
 	Message string
 }
 
+// UpdateProjectInviteSettingsPayload is the payload type of the survey service
+// update_project_invite_settings method.
+type UpdateProjectInviteSettingsPayload struct {
+	// JWT token
+	Token *string
+	// Project identifier
+	ProjectUID string
+	// Send LFID invites; false turns them off and true turns them back on for a
+	// survey of a disabled project. Omit to inherit.
+	Enabled *bool
+	// Invite service role granted when the invite is accepted. Omit to inherit.
+	Role *string
+	// Days an invite stays valid. Omit to inherit.
+	ExpirationDays *int
+	// Where the recipient lands after accepting; {base_url}, {survey_uid},
+	// {project_uid} and {locale} are substituted. Omit to inherit.
+	ReturnURLTemplate *string
+	// Survey name used in the invite when the survey has none. Omit to inherit.
+	DisplayNameFallback *string
+	// Recipient language as a BCP 47 tag, substituted for {locale} in the return
+	// URL. Omit to inherit.
+	Locale *string
+}
+
+// UpdateSurveyInviteSettingsPayload is the payload type of the survey service
+// update_survey_invite_settings method.
+type UpdateSurveyInviteSettingsPayload struct {
+	// JWT token
+	Token *string
+	// Survey identifier
+	SurveyUID string
+	// Send LFID invites; false turns them off and true turns them back on for a
+	// survey of a disabled project. Omit to inherit.
+	Enabled *bool
+	// Invite service role granted when the invite is accepted. Omit to inherit.
+	Role *string
+	// Days an invite stays valid. Omit to inherit.
+	ExpirationDays *int
+	// Where the recipient lands after accepting; {base_url}, {survey_uid},
+	// {project_uid} and {locale} are substituted. Omit to inherit.
+	ReturnURLTemplate *string
+	// Survey name used in the invite when the survey has none. Omit to inherit.
+	DisplayNameFallback *string
+	// Recipient language as a BCP 47 tag, substituted for {locale} in the return
+	// URL. Omit to inherit.
+	Locale *string
+}
+
 // UpdateSurveyPayload is the payload type of the survey service update_survey
 // method.
 type UpdateSurveyPayload struct {
@@ -706,6 +1406,33 @@ type UpdateSurveyPayload struct {
 	CommitteeVotingEnabled *bool
 }
 
+// UpdateSurveyResponsePayload is the payload type of the survey service
+// update_survey_response method.
+type UpdateSurveyResponsePayload struct {
+	// JWT token
+	Token *string
+	// Survey identifier
+	SurveyUID string
+	// Response identifier
+	ResponseID string
+	// Answers to the survey questions
+	Answers []*SurveyAnswer
+}
+
+// UpdateSurveySettingsPayload is the payload type of the survey service
+// update_survey_settings method.
+type UpdateSurveySettingsPayload struct {
+	// JWT token
+	Token *string
+	// Survey identifier
+	SurveyUID string
+	// Let respondents view the survey's aggregate results
+	ShareResults bool
+	// Keep respondent identity and individual answers from organizers; cannot be
+	// turned off once on
+	Anonymous bool
+}
+
 // User email information
 type UserEmail struct {
 	// Email ID
@@ -821,6 +1548,23 @@ func (e *NotFoundError) GoaErrorName() string {
 	return "NotFound"
 }
 
+// Error returns an error description.
+func (e *QuestionError) Error() string {
+	return "Validation problem with the answer to one survey question"
+}
+
+// ErrorName returns "QuestionError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *QuestionError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "QuestionError".
+func (e *QuestionError) GoaErrorName() string {
+	return "QuestionError"
+}
+
 // Error returns an error description.
 func (e *ServiceUnavailableError) Error() string {
 	return "Service unavailable error response"
@@ -0,0 +1,78 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// DeadLetterReason explains why a KV event was dead-lettered
+type DeadLetterReason string
+
+const (
+	// DeadLetterReasonMaxDeliveries means the event was still failing on its final delivery attempt
+	DeadLetterReasonMaxDeliveries DeadLetterReason = "max_deliveries"
+	// DeadLetterReasonConversionError means the v1 payload could not be decoded or converted
+	DeadLetterReasonConversionError DeadLetterReason = "conversion_error"
+	// DeadLetterReasonRejected means a downstream call failed with an error retries cannot fix
+	DeadLetterReasonRejected DeadLetterReason = "rejected"
+)
+
+// DeadLetter is a v1-objects KV event that could not be processed and was parked for
+// inspection and replay
+type DeadLetter struct {
+	// Sequence identifies the entry within the dead-letter stream
+	Sequence  uint64
+	Key       string
+	Operation string
+	// Revision is the KV revision of the original event
+	Revision       uint64
+	Payload        []byte
+	Headers        map[string][]string
+	Reason         DeadLetterReason
+	Error          string
+	Attempts       uint64
+	DeadLetteredAt time.Time
+}
+
+// DeadLetterFilter narrows a dead-letter listing
+type DeadLetterFilter struct {
+	// KeyPrefix matches the start of the original KV key, e.g. "itx-survey-responses."
+	KeyPrefix string
+	// AfterSequence resumes a listing after the given entry
+	AfterSequence uint64
+	Limit         int
+}
+
+// DeadLetterReplayOutcome tells what a replay did with a dead-lettered event
+type DeadLetterReplayOutcome string
+
+const (
+	// DeadLetterReplayed means the key's current state in v1-objects was run through the KV handlers
+	DeadLetterReplayed DeadLetterReplayOutcome = "replayed"
+	// DeadLetterReplaySuperseded means the key has a newer revision, which the consumer processes
+	// instead, so the event was dropped without being replayed
+	DeadLetterReplaySuperseded DeadLetterReplayOutcome = "superseded"
+	// DeadLetterReplayKeyDeleted means the key no longer exists, so the update was dropped
+	// without being replayed; the delete event is processed by the consumer
+	DeadLetterReplayKeyDeleted DeadLetterReplayOutcome = "key_deleted"
+)
+
+// DeadLetterReplayResult is the outcome of re-running a dead-lettered event through the KV handlers
+type DeadLetterReplayResult struct {
+	Sequence uint64
+	Key      string
+	Outcome  DeadLetterReplayOutcome
+	// Succeeded is true when the event was resolved and removed from the dead-letter stream
+	Succeeded bool
+	Error     string
+}
+
+// DeadLetterQueue lists, inspects and replays dead-lettered KV events
+type DeadLetterQueue interface {
+	List(ctx context.Context, filter DeadLetterFilter) ([]DeadLetter, error)
+	Get(ctx context.Context, sequence uint64) (*DeadLetter, error)
+	Replay(ctx context.Context, sequence uint64) (*DeadLetterReplayResult, error)
+}
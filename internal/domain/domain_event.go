@@ -0,0 +1,62 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+// DomainEventVersion is the schema version of DomainEvent; it is part of every event subject
+const DomainEventVersion = "v1"
+
+// Domain event types. Each is published on lfx.survey.{version}.{type}.
+const (
+	DomainEventSurveyScheduled = "survey.scheduled"
+	DomainEventSurveyOpened    = "survey.opened"
+	DomainEventSurveyClosed    = "survey.closed"
+
+	DomainEventSurveyResponseDelivered = "survey_response.delivered"
+	DomainEventSurveyResponseOpened    = "survey_response.opened"
+	DomainEventSurveyResponseSubmitted = "survey_response.submitted"
+	DomainEventSurveyResponseBounced   = "survey_response.bounced"
+)
+
+// DomainEvent is a state transition of a survey or survey response, derived from the
+// difference between the previous and new v1 record. Data is a SurveyEventData or a
+// SurveyResponseEventData depending on ObjectType.
+type DomainEvent struct {
+	ID         string `json:"id"` // unique per event, for consumer de-duplication
+	Type       string `json:"type"`
+	Version    string `json:"version"`
+	OccurredAt string `json:"occurred_at"` // RFC3339, when the transition was observed
+	ObjectType string `json:"object_type"` // survey or survey_response
+	ObjectUID  string `json:"object_uid"`
+	Data       any    `json:"data"`
+}
+
+// SurveyEventData is the payload of survey.* events
+type SurveyEventData struct {
+	SurveyUID        string   `json:"survey_uid"`
+	SurveyTitle      string   `json:"survey_title"`
+	PreviousStatus   string   `json:"previous_status"` // empty for a new survey
+	Status           string   `json:"status"`          // lifecycle state: scheduled, open or closed
+	SurveyStatus     string   `json:"survey_status"`   // v1 survey_status
+	SurveySendDate   string   `json:"survey_send_date"`
+	SurveyCutoffDate string   `json:"survey_cutoff_date"`
+	IsNPSSurvey      bool     `json:"is_nps_survey"`
+	CommitteeUIDs    []string `json:"committee_uids"`
+	ProjectUIDs      []string `json:"project_uids"`
+}
+
+// SurveyResponseEventData is the payload of survey_response.* events
+type SurveyResponseEventData struct {
+	SurveyResponseUID    string `json:"survey_response_uid"`
+	SurveyUID            string `json:"survey_uid"`
+	Email                string `json:"email"`
+	Username             string `json:"username"`
+	CommitteeUID         string `json:"committee_uid"`
+	ProjectUID           string `json:"project_uid"`
+	ResponseDatetime     string `json:"response_datetime"`
+	EmailOpenedFirstTime string `json:"email_opened_first_time"`
+	SESBounceType        string `json:"ses_bounce_type"`
+	SESBounceSubtype     string `json:"ses_bounce_subtype"`
+	// Anonymous is set for responses of an anonymous survey; email and username are then empty
+	Anonymous bool `json:"anonymous"`
+}
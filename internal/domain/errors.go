@@ -14,6 +14,7 @@ const (
 	ErrorTypeConflict                     // 409 Conflict
 	ErrorTypeInternal                     // 500 Internal Server Error
 	ErrorTypeUnavailable                  // 503 Service Unavailable
+	ErrorTypeForbidden                    // 403 Forbidden
 )
 
 // DomainError represents a domain-level error with semantic type
@@ -36,15 +37,43 @@ func (e *DomainError) Unwrap() error {
 	return e.Err
 }
 
-// GetErrorType returns the ErrorType from a domain error or defaults to Internal
+// typedError is an error that reports its own ErrorType, e.g. one aggregating several
+// failures of different types
+type typedError interface {
+	error
+	Type() ErrorType
+}
+
+// GetErrorType returns the ErrorType of the first domain error or typedError in err's
+// tree, or defaults to Internal
 func GetErrorType(err error) ErrorType {
-	var domainErr *DomainError
-	if errors.As(err, &domainErr) {
-		return domainErr.Type
+	if t, ok := errorType(err); ok {
+		return t
 	}
 	return ErrorTypeInternal // default fallback
 }
 
+// errorType walks err's tree depth-first, like errors.As
+func errorType(err error) (ErrorType, bool) {
+	switch e := err.(type) {
+	case nil:
+		return 0, false
+	case *DomainError:
+		return e.Type, true
+	case typedError:
+		return e.Type(), true
+	case interface{ Unwrap() error }:
+		return errorType(e.Unwrap())
+	case interface{ Unwrap() []error }:
+		for _, inner := range e.Unwrap() {
+			if t, ok := errorType(inner); ok {
+				return t, true
+			}
+		}
+	}
+	return 0, false
+}
+
 // NewValidationError creates a validation error (400 Bad Request)
 func NewValidationError(message string, err ...error) *DomainError {
 	return &DomainError{
@@ -54,6 +83,15 @@ func NewValidationError(message string, err ...error) *DomainError {
 	}
 }
 
+// NewForbiddenError creates a forbidden error (403 Forbidden)
+func NewForbiddenError(message string, err ...error) *DomainError {
+	return &DomainError{
+		Type:    ErrorTypeForbidden,
+		Message: message,
+		Err:     errors.Join(err...),
+	}
+}
+
 // NewNotFoundError creates a not found error (404 Not Found)
 func NewNotFoundError(message string, err ...error) *DomainError {
 	return &DomainError{
@@ -103,6 +103,8 @@ type SurveyResponseData struct {
 	LinkClickedFirstTime          string                        `json:"link_clicked_first_time"`
 	LinkClickedLastTime           string                        `json:"link_clicked_last_time"`
 	Excluded                      bool                          `json:"excluded"`
+	// Anonymous is set for responses of an anonymous survey, which are indexed de-identified
+	Anonymous bool `json:"anonymous"`
 
 	// Denormalized from parent survey so consumers can render the "My Surveys" list
 	// without a secondary access-controlled fetch of the survey resource. Survey
@@ -170,4 +172,32 @@ type SurveyTemplateData struct {
 	Category        string            `json:"category"`
 	IsOwner         bool              `json:"is_owner"`
 	CustomVariables map[string]string `json:"custom_variables"`
+	// Pages holds the question structure. It is kept in the template catalog for answer
+	// validation and is not part of the indexed document.
+	Pages []SurveyQuestionPage `json:"-"`
+}
+
+// Survey exclusion scopes, from narrowest to broadest
+const (
+	SurveyExclusionScopeSurvey    = "survey"
+	SurveyExclusionScopeCommittee = "committee"
+	SurveyExclusionScopeGlobal    = "global"
+)
+
+// SurveyExclusionData represents a v2 survey exclusion after transformation from v1 format.
+// Scope says why the person is excluded: from one survey, from a committee's surveys, or
+// from all surveys.
+type SurveyExclusionData struct {
+	UID             string `json:"uid"` // v2 UID (same as ID)
+	ID              string `json:"id"`  // v1 ID
+	Email           string `json:"email"`
+	UserID          string `json:"user_id"`
+	Scope           string `json:"scope"`
+	SurveyID        string `json:"survey_id"`     // v1 survey ID
+	SurveyUID       string `json:"survey_uid"`    // v2 survey UID
+	CommitteeID     string `json:"committee_id"`  // v1 SFID
+	CommitteeUID    string `json:"committee_uid"` // v2 UID
+	GlobalExclusion bool   `json:"global_exclusion"`
+	CreatedAt       string `json:"created_at"`
+	LastModifiedAt  string `json:"last_modified_at"`
 }
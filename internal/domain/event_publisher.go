@@ -20,6 +20,17 @@ type EventPublisher interface {
 	// action should be "created", "updated", or "deleted"
 	PublishSurveyTemplateEvent(ctx context.Context, action string, template *SurveyTemplateData) error
 
+	// PublishSurveyExclusionEvent publishes a survey exclusion event to indexer and FGA-sync
+	// action should be "created", "updated", or "deleted"
+	PublishSurveyExclusionEvent(ctx context.Context, action string, exclusion *SurveyExclusionData) error
+
+	// PublishDomainEvent publishes a survey or survey response state transition for
+	// downstream consumers such as notifications and analytics
+	PublishDomainEvent(ctx context.Context, event *DomainEvent) error
+
+	// PublishSurveyResultsViewer grants or revokes a user's results_viewer relation on a survey
+	PublishSurveyResultsViewer(ctx context.Context, surveyUID, username string, granted bool) error
+
 	// Close closes the publisher connection
 	Close() error
 }
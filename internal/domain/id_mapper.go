@@ -3,7 +3,12 @@
 
 package domain
 
-import "context"
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
 
 // IDMapper defines the interface for mapping between LFX v1 and v2 identifiers
 type IDMapper interface {
@@ -18,4 +23,68 @@ type IDMapper interface {
 
 	// MapCommitteeV1ToV2 maps a v1 committee SFID to v2 committee UID
 	MapCommitteeV1ToV2(ctx context.Context, v1SFID string) (string, error)
+
+	// Batch variants resolve many IDs in a single call. The returned map is keyed by
+	// input ID and holds every ID that resolved; empty and duplicate inputs are ignored.
+	// If any ID fails, the error is a *BatchMappingError and the map still carries
+	// the successful results.
+
+	// MapProjectsV2ToV1 maps v2 project UIDs to v1 project SFIDs
+	MapProjectsV2ToV1(ctx context.Context, v2UIDs []string) (map[string]string, error)
+
+	// MapProjectsV1ToV2 maps v1 project SFIDs to v2 project UIDs
+	MapProjectsV1ToV2(ctx context.Context, v1SFIDs []string) (map[string]string, error)
+
+	// MapCommitteesV2ToV1 maps v2 committee UIDs to v1 committee SFIDs
+	MapCommitteesV2ToV1(ctx context.Context, v2UIDs []string) (map[string]string, error)
+
+	// MapCommitteesV1ToV2 maps v1 committee SFIDs to v2 committee UIDs
+	MapCommitteesV1ToV2(ctx context.Context, v1SFIDs []string) (map[string]string, error)
+}
+
+// BatchMappingError reports the IDs that could not be resolved by a batch mapping call
+type BatchMappingError struct {
+	Failed map[string]error // per input ID
+}
+
+// Error implements the error interface
+func (e *BatchMappingError) Error() string {
+	ids := e.FailedIDs()
+	if len(ids) == 1 {
+		return fmt.Sprintf("failed to map ID %s: %v", ids[0], e.Failed[ids[0]])
+	}
+	return fmt.Sprintf("failed to map %d IDs: %s", len(ids), strings.Join(ids, ", "))
+}
+
+// Unwrap returns the individual failures ordered by ID, so errors.As resolves
+// deterministically.
+func (e *BatchMappingError) Unwrap() []error {
+	ids := e.FailedIDs()
+	errs := make([]error, len(ids))
+	for i, id := range ids {
+		errs[i] = e.Failed[id]
+	}
+	return errs
+}
+
+// FailedIDs returns the IDs that could not be mapped, sorted
+func (e *BatchMappingError) FailedIDs() []string {
+	ids := make([]string, 0, len(e.Failed))
+	for id := range e.Failed {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// Type returns ErrorTypeValidation when every failure was a validation error
+// (unknown IDs), otherwise the type of the first other failure in ID order.
+// GetErrorType reports it for the batch.
+func (e *BatchMappingError) Type() ErrorType {
+	for _, err := range e.Unwrap() {
+		if t := GetErrorType(err); t != ErrorTypeValidation {
+			return t
+		}
+	}
+	return ErrorTypeValidation
 }
@@ -9,3 +9,19 @@ import "context"
 type InviteAcceptanceClient interface {
 	AcceptInvite(ctx context.Context, email, username string) error
 }
+
+// InviteEnrichmentResult reports a manual re-run of the invite acceptance enrichment
+type InviteEnrichmentResult struct {
+	Email    string
+	Username string
+	// DeadLettersCleared counts the dead-lettered acceptances of the email removed after the run
+	DeadLettersCleared uint64
+}
+
+// InviteEnricher re-runs the enrichment of an accepted invite, for acceptances whose
+// automatic enrichment was dead-lettered
+type InviteEnricher interface {
+	// EnrichInvitee enriches the survey responses of email with username; an empty
+	// username is looked up by email
+	EnrichInvitee(ctx context.Context, email, username string) (*InviteEnrichmentResult, error)
+}
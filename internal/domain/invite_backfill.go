@@ -0,0 +1,75 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Reasons an invite backfill passes over a survey response
+const (
+	InviteBackfillSkipNoEmail        = "no_email"
+	InviteBackfillSkipHasUsername    = "has_username"
+	InviteBackfillSkipAlreadyInvited = "already_invited"
+	InviteBackfillSkipSurveyNotOpen  = "survey_not_open"
+	InviteBackfillSkipNoSurveyName   = "no_survey_name"
+	InviteBackfillSkipDisabled       = "invites_disabled"
+	InviteBackfillSkipNotSynced      = "not_synced"
+	InviteBackfillSkipHasLFID        = "has_lfid"
+	InviteBackfillSkipUnreadable     = "unreadable"
+)
+
+// InviteBackfillState is the lifecycle state of an invite backfill run
+type InviteBackfillState string
+
+const (
+	InviteBackfillStateRunning   InviteBackfillState = "running"
+	InviteBackfillStateCompleted InviteBackfillState = "completed"
+	InviteBackfillStateCancelled InviteBackfillState = "cancelled"
+	InviteBackfillStateFailed    InviteBackfillState = "failed"
+)
+
+// InviteBackfillOptions selects the survey responses an invite backfill covers
+type InviteBackfillOptions struct {
+	// SurveyUIDs limits the run to these surveys; empty means every open survey
+	SurveyUIDs []string
+	// DryRun reports the responses that would be invited without sending anything
+	DryRun bool
+	// RatePerSecond caps how many candidates are checked and invited each second
+	RatePerSecond int
+}
+
+// InviteBackfillStatus reports the progress of the current or most recent invite backfill
+type InviteBackfillStatus struct {
+	Options    InviteBackfillOptions
+	State      InviteBackfillState
+	StartedAt  time.Time
+	FinishedAt time.Time
+	// Total is the number of survey responses listed; Scanned have been read
+	Total   int
+	Scanned int
+	// Candidates are the responses that need an invite; Recipients counts their
+	// distinct emails, which is how many invites are sent at most
+	Candidates int
+	Recipients int
+	// Invited, Attached and Failed count send outcomes; all stay zero on a dry run.
+	// Attached responses share an outstanding invite of their email.
+	Invited  int
+	Attached int
+	Failed   int
+	// Skipped counts the responses passed over, by reason
+	Skipped map[string]int
+	// CandidateResponseUIDs holds the first candidates for review
+	CandidateResponseUIDs []string
+	Error                 string
+}
+
+// InviteBackfiller sends LFID invites to survey participants that were synced without one
+type InviteBackfiller interface {
+	// Start begins a run in the background; only one run may be active at a time
+	Start(ctx context.Context, opts InviteBackfillOptions) (*InviteBackfillStatus, error)
+	Status(ctx context.Context) (*InviteBackfillStatus, error)
+	Cancel(ctx context.Context) (*InviteBackfillStatus, error)
+}
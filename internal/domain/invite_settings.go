@@ -0,0 +1,50 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// InviteSettingsScope is what a set of invite settings applies to
+type InviteSettingsScope string
+
+const (
+	InviteSettingsScopeProject InviteSettingsScope = "project"
+	InviteSettingsScopeSurvey  InviteSettingsScope = "survey"
+)
+
+// InviteSettings customizes the LFID invites sent for the responses of a project or a
+// survey. Unset fields inherit: survey settings override project settings, which
+// override the service defaults.
+type InviteSettings struct {
+	Scope InviteSettingsScope `json:"scope"`
+	UID   string              `json:"uid"`
+	// Enabled turns invites off, or back on for a survey of a disabled project; nil inherits
+	Enabled *bool `json:"enabled,omitempty"`
+	// Role is the invite-service role granted on acceptance
+	Role string `json:"role,omitempty"`
+	// ExpirationDays is how long an invite stays valid; 0 inherits
+	ExpirationDays int `json:"expiration_days,omitempty"`
+	// ReturnURLTemplate is where the recipient lands after accepting. {base_url},
+	// {survey_uid}, {project_uid} and {locale} are substituted.
+	ReturnURLTemplate string `json:"return_url_template,omitempty"`
+	// DisplayNameFallback names the survey in the invite when the survey record has no name
+	DisplayNameFallback string `json:"display_name_fallback,omitempty"`
+	// Locale is the recipient's language as a BCP 47 tag, e.g. "ja-JP"
+	Locale    string    `json:"locale,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+	UpdatedBy string    `json:"updated_by,omitempty"`
+}
+
+// InviteSettingsStore keeps the invite settings of projects and surveys
+type InviteSettingsStore interface {
+	// GetInviteSettings returns the settings stored for the scope, or a NotFound error
+	GetInviteSettings(ctx context.Context, scope InviteSettingsScope, uid string) (*InviteSettings, error)
+	// PutInviteSettings validates and replaces the settings of the scope
+	PutInviteSettings(ctx context.Context, settings *InviteSettings) (*InviteSettings, error)
+	// DeleteInviteSettings removes the settings of the scope so that it inherits again
+	DeleteInviteSettings(ctx context.Context, scope InviteSettingsScope, uid string) error
+}
@@ -0,0 +1,62 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// ReindexState is the lifecycle state of a reindex run
+type ReindexState string
+
+const (
+	ReindexStateRunning   ReindexState = "running"
+	ReindexStateCompleted ReindexState = "completed"
+	ReindexStateCancelled ReindexState = "cancelled"
+	ReindexStateFailed    ReindexState = "failed"
+)
+
+// ReindexOptions selects which v1-objects entries a reindex run replays
+type ReindexOptions struct {
+	// KeyPrefixes limits the run to keys starting with one of these prefixes, e.g.
+	// "itx-surveys." or "itx-survey-responses.abc". Empty means every survey object type.
+	KeyPrefixes []string
+	// ModifiedSince and ModifiedUntil bound the time the entry was last written; zero is unbounded
+	ModifiedSince time.Time
+	ModifiedUntil time.Time
+	// DryRun counts the matching entries without running the handlers
+	DryRun bool
+	// Force republishes entries whose converted content is unchanged since the last publish
+	Force bool
+	// RatePerSecond caps how many entries are run through the handlers each second
+	RatePerSecond int
+}
+
+// ReindexStatus reports the progress of the current or most recent reindex run
+type ReindexStatus struct {
+	Options    ReindexOptions
+	State      ReindexState
+	StartedAt  time.Time
+	FinishedAt time.Time
+	// Total is the number of keys under the selected prefixes
+	Total int
+	// Scanned keys have been read; Matched is the subset inside the time range
+	Scanned int
+	Matched int
+	// Processed and Failed count handler outcomes; both stay zero on a dry run
+	Processed int
+	Failed    int
+	// FailedKeys holds the first failures for follow-up
+	FailedKeys []string
+	Error      string
+}
+
+// Reindexer replays v1-objects entries through the event handlers
+type Reindexer interface {
+	// Start begins a run in the background; only one run may be active at a time
+	Start(ctx context.Context, opts ReindexOptions) (*ReindexStatus, error)
+	Status(ctx context.Context) (*ReindexStatus, error)
+	Cancel(ctx context.Context) (*ReindexStatus, error)
+}
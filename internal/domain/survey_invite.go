@@ -0,0 +1,78 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// InviteStatus is the lifecycle state of the LFID invite sent for a survey response
+type InviteStatus string
+
+const (
+	// InviteStatusPending is recorded before the invite is handed to the invite service;
+	// an invite that stays pending was interrupted mid-send
+	InviteStatusPending  InviteStatus = "pending"
+	InviteStatusSent     InviteStatus = "sent"
+	InviteStatusFailed   InviteStatus = "failed"
+	InviteStatusAccepted InviteStatus = "accepted"
+	// InviteStatusExpired is a sent invite whose expiry has passed without acceptance
+	InviteStatusExpired InviteStatus = "expired"
+)
+
+// InviteStatuses lists every invite status in lifecycle order
+var InviteStatuses = []InviteStatus{
+	InviteStatusPending,
+	InviteStatusSent,
+	InviteStatusFailed,
+	InviteStatusAccepted,
+	InviteStatusExpired,
+}
+
+// SurveyInvite is the LFID invite state of one survey response recipient
+type SurveyInvite struct {
+	SurveyResponseUID string       `json:"survey_response_uid"`
+	SurveyUID         string       `json:"survey_uid"`
+	ProjectUID        string       `json:"project_uid,omitempty"`
+	Email             string       `json:"email"`
+	Name              string       `json:"name,omitempty"`
+	Status            InviteStatus `json:"status"`
+	InviteUID         string       `json:"invite_uid,omitempty"`
+	// Error is the reason the last send failed
+	Error      string     `json:"error,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	SentAt     *time.Time `json:"sent_at,omitempty"`
+	FailedAt   *time.Time `json:"failed_at,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+	AcceptedBy string     `json:"accepted_by,omitempty"`
+	// Reinvites counts the re-sends after the first invite expired unaccepted
+	Reinvites int `json:"reinvites,omitempty"`
+	// Attempts records every send, oldest first
+	Attempts []InviteAttempt `json:"attempts,omitempty"`
+	// AttachedTo is the survey response whose invite this recipient shares instead of
+	// receiving a separate one
+	AttachedTo string `json:"attached_to,omitempty"`
+	// ClaimedAt is when a re-send claimed the invite; a claim left pending past the
+	// claim timeout was interrupted and is re-sent again
+	ClaimedAt *time.Time `json:"claimed_at,omitempty"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// InviteAttempt is one send of an invite to the invite service
+type InviteAttempt struct {
+	At        time.Time  `json:"at"`
+	InviteUID string     `json:"invite_uid,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Error is set when the send failed
+	Error string `json:"error,omitempty"`
+}
+
+// SurveyInviteReader lists the LFID invites sent for the responses of a survey
+type SurveyInviteReader interface {
+	// ListSurveyInvites returns the invites of a survey ordered by email. Sent invites
+	// past their expiry are reported as InviteStatusExpired.
+	ListSurveyInvites(ctx context.Context, surveyUID string) ([]SurveyInvite, error)
+}
@@ -0,0 +1,44 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// SurveySettings are the options of a survey that this service keeps rather than ITX
+type SurveySettings struct {
+	SurveyUID string `json:"survey_uid"`
+	// ShareResults grants every respondent with a username the results_viewer relation
+	// on the survey
+	ShareResults bool `json:"share_results"`
+	// Anonymous keeps respondent identity and individual answers away from organizers:
+	// responses are indexed and listed de-identified and small results cells are
+	// suppressed. It cannot be turned off again.
+	Anonymous bool      `json:"anonymous"`
+	UpdatedAt time.Time `json:"updated_at"`
+	UpdatedBy string    `json:"updated_by,omitempty"`
+}
+
+// AnonymousMinCellSize is the fewest respondents an answer of an anonymous survey's
+// results must have to be reported
+const AnonymousMinCellSize = 5
+
+// ResultsAccessSync counts the results_viewer changes made to apply ShareResults
+type ResultsAccessSync struct {
+	Granted int
+	Revoked int
+	// Failed changes are retried by storing the settings again
+	Failed int
+}
+
+// SurveySettingsStore keeps the settings of surveys
+type SurveySettingsStore interface {
+	// GetSurveySettings returns the stored settings, or the defaults when none are stored
+	GetSurveySettings(ctx context.Context, surveyUID string) (*SurveySettings, error)
+	// PutSurveySettings replaces the settings, grants or revokes results access to match,
+	// and republishes the responses of an anonymous survey de-identified
+	PutSurveySettings(ctx context.Context, settings *SurveySettings) (*SurveySettings, *ResultsAccessSync, error)
+}
@@ -0,0 +1,101 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package domain
+
+import (
+	"context"
+	"strings"
+)
+
+// SurveyTemplateFilter narrows a survey template catalog listing. Matching is
+// case-insensitive: Title matches any part of the title or nickname, Category and
+// Language must match exactly. Empty fields match everything.
+type SurveyTemplateFilter struct {
+	Title    string
+	Category string
+	Language string
+	Offset   int
+	Limit    int
+}
+
+// SurveyTemplatePage is one page of a survey template catalog listing, ordered by title
+type SurveyTemplatePage struct {
+	Templates []SurveyTemplateData
+	// Total is the number of templates matching the filter across all pages
+	Total int
+}
+
+// SurveyTemplateCatalog is a read model of the SurveyMonkey surveys that surveys can be
+// scheduled from. It reports ErrorTypeUnavailable until it has loaded the feed.
+type SurveyTemplateCatalog interface {
+	List(ctx context.Context, filter SurveyTemplateFilter) (*SurveyTemplatePage, error)
+	Get(ctx context.Context, id string) (*SurveyTemplateData, error)
+}
+
+// SurveyMonkey question families
+const (
+	QuestionFamilySingleChoice   = "single_choice"
+	QuestionFamilyMultipleChoice = "multiple_choice"
+	QuestionFamilyMatrix         = "matrix"
+	QuestionFamilyOpenEnded      = "open_ended"
+	QuestionFamilyDemographic    = "demographic"
+	QuestionFamilyDatetime       = "datetime"
+	QuestionFamilyPresentation   = "presentation"
+)
+
+// SurveyMonkey question subtypes that change how an answer is validated
+const (
+	QuestionSubtypeRating    = "rating"
+	QuestionSubtypeNumerical = "numerical"
+)
+
+// SurveyQuestionPage is one page of a survey template, with its questions in display order
+type SurveyQuestionPage struct {
+	ID        string
+	Title     string
+	Position  int
+	Questions []SurveyQuestion
+}
+
+// SurveyQuestion is one question of a survey template. RatingMin and RatingMax are only
+// set for rating questions (family matrix, subtype rating).
+type SurveyQuestion struct {
+	ID        string
+	Heading   string
+	Family    string
+	Subtype   string
+	Position  int
+	Required  bool
+	Choices   []SurveyQuestionChoice
+	RatingMin int
+	RatingMax int
+}
+
+// SurveyQuestionChoice is one answer option of a choice or matrix question
+type SurveyQuestionChoice struct {
+	ID       string
+	Text     string
+	Position int
+}
+
+// QuestionError explains why the answer to one question was rejected
+type QuestionError struct {
+	QuestionID string
+	Message    string
+}
+
+// AnswerValidationError lists the per-question problems of a survey response. It is
+// wrapped in a validation DomainError so callers can report each question separately.
+type AnswerValidationError struct {
+	Errors []QuestionError
+}
+
+// Error implements the error interface
+func (e *AnswerValidationError) Error() string {
+	parts := make([]string, 0, len(e.Errors))
+	for _, qe := range e.Errors {
+		parts = append(parts, qe.QuestionID+": "+qe.Message)
+	}
+	return strings.Join(parts, "; ")
+}
@@ -7,11 +7,25 @@ import "time"
 
 // Config holds the configuration for the event processor
 type Config struct {
-	NATSURL        string
-	ConsumerName   string
-	StreamName     string
+	NATSURL      string
+	ConsumerName string
+	StreamName   string
+	// FilterSubjects overrides the consumer filter; by default it covers the key prefix of
+	// every registered KV handler
 	FilterSubjects []string
 	MaxDeliver     int
 	AckWait        time.Duration
 	MaxAckPending  int
+	// Workers is the number of KV messages processed concurrently; messages for the same
+	// object, and responses of the same survey, are always processed in order
+	Workers int
+	// DeadLetterStreamName is the stream that receives events which exhaust MaxDeliver
+	// or fail conversion
+	DeadLetterStreamName string
+	// DeadLetterMaxAge is how long dead-lettered events are kept (0 keeps them until replayed)
+	DeadLetterMaxAge time.Duration
+	// PublishMode is PublishModeCore (default) or PublishModeJetStream
+	PublishMode string
+	// PublishAckTimeout bounds the wait for a JetStream publish ack
+	PublishAckTimeout time.Duration
 }
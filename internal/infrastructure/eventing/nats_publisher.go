@@ -10,6 +10,8 @@ import (
 	"log/slog"
 	"regexp"
 	"slices"
+	"strings"
+	"time"
 
 	fgaconstants "github.com/linuxfoundation/lfx-v2-fga-sync/pkg/constants"
 	fgatypes "github.com/linuxfoundation/lfx-v2-fga-sync/pkg/types"
@@ -17,6 +19,7 @@ import (
 	indexerTypes "github.com/linuxfoundation/lfx-v2-indexer-service/pkg/types"
 	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
 	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
@@ -33,18 +36,43 @@ const (
 
 	// IndexSurveyTemplateSubject is the subject for survey template indexing
 	IndexSurveyTemplateSubject = "lfx.index.survey_template"
+
+	// IndexSurveyExclusionSubject is the subject for survey exclusion indexing
+	IndexSurveyExclusionSubject = "lfx.index.survey_exclusion"
+
+	// DomainEventSubjectPrefix prefixes the domain event subjects: lfx.survey.{version}.{type}
+	DomainEventSubjectPrefix = "lfx.survey"
 )
 
+// RelationResultsViewer lets a survey respondent read the survey's aggregate results. It is
+// granted per user with member_put, so survey access updates leave it alone.
+const RelationResultsViewer = "results_viewer"
+
 var lfxUsernamePattern = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
 
 func isValidLFXUsername(username string) bool {
 	return lfxUsernamePattern.MatchString(username)
 }
 
+// Publish modes for NATSPublisher
+const (
+	// PublishModeCore publishes with core NATS: fire-and-forget, lost if nothing is subscribed
+	PublishModeCore = "core"
+	// PublishModeJetStream publishes through JetStream and waits for the stream's ack
+	PublishModeJetStream = "jetstream"
+
+	// DefaultPublishAckTimeout bounds the wait for a JetStream publish ack when unset
+	DefaultPublishAckTimeout = 5 * time.Second
+)
+
 // NATSPublisher implements the EventPublisher interface
 type NATSPublisher struct {
 	conn   *nats.Conn
 	logger *slog.Logger
+
+	// js is set in JetStream mode; publishes then wait up to ackTimeout for an ack
+	js         jetstream.JetStream
+	ackTimeout time.Duration
 }
 
 // NewNATSPublisher creates a new NATS publisher
@@ -55,6 +83,41 @@ func NewNATSPublisher(conn *nats.Conn, logger *slog.Logger) *NATSPublisher {
 	}
 }
 
+// NewJetStreamPublisher creates a publisher that publishes through JetStream and fails
+// when no stream acknowledges the message, so the caller can retry instead of losing it.
+// The indexer and fga-sync subjects must be captured by a stream.
+func NewJetStreamPublisher(conn *nats.Conn, js jetstream.JetStream, ackTimeout time.Duration, logger *slog.Logger) *NATSPublisher {
+	if ackTimeout <= 0 {
+		ackTimeout = DefaultPublishAckTimeout
+	}
+	return &NATSPublisher{
+		conn:       conn,
+		logger:     logger,
+		js:         js,
+		ackTimeout: ackTimeout,
+	}
+}
+
+type sourceRevisionKey struct{}
+
+// WithSourceRevision records the revision of the v1-objects entry being processed.
+// Messages published under ctx carry a Nats-Msg-Id built from the object UID and this
+// revision, so a redelivered entry is dropped by the stream's duplicate window.
+func WithSourceRevision(ctx context.Context, revision uint64) context.Context {
+	return context.WithValue(ctx, sourceRevisionKey{}, revision)
+}
+
+// msgID returns the Nats-Msg-Id for an object's message on subject, or "" when ctx has
+// no source revision. The subject is included because several messages are sent per
+// revision (indexer and access) and may land in the same stream.
+func msgID(ctx context.Context, subject, uid string) string {
+	revision, ok := ctx.Value(sourceRevisionKey{}).(uint64)
+	if !ok || revision == 0 || uid == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s:%s:%d", subject, uid, revision)
+}
+
 // PublishSurveyEvent publishes a survey event to indexer and FGA-sync
 func (p *NATSPublisher) PublishSurveyEvent(ctx context.Context, action string, survey *domain.SurveyData) error {
 	// Send to indexer
@@ -105,9 +168,87 @@ func (p *NATSPublisher) PublishSurveyTemplateEvent(ctx context.Context, action s
 	return nil
 }
 
-// publishWithSpan wraps conn.PublishMsg with an OTel producer span and injects
-// trace context into the NATS message headers.
-func (p *NATSPublisher) publishWithSpan(ctx context.Context, subject string, data []byte) error {
+// PublishSurveyExclusionEvent publishes a survey exclusion event to indexer and FGA-sync
+func (p *NATSPublisher) PublishSurveyExclusionEvent(ctx context.Context, action string, exclusion *domain.SurveyExclusionData) error {
+	if err := p.sendSurveyExclusionIndexerMessage(ctx, IndexSurveyExclusionSubject, indexerConstants.MessageAction(action), exclusion); err != nil {
+		return fmt.Errorf("failed to send survey exclusion indexer message: %w", err)
+	}
+
+	if action == string(indexerConstants.ActionDeleted) {
+		if err := p.sendDeleteAccessMessage(ctx, "survey_exclusion", exclusion.UID); err != nil {
+			return fmt.Errorf("failed to send survey exclusion delete access message: %w", err)
+		}
+	} else {
+		if err := p.sendSurveyExclusionAccessMessage(ctx, exclusion); err != nil {
+			return fmt.Errorf("failed to send survey exclusion access message: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// PublishDomainEvent publishes a survey or survey response state transition
+func (p *NATSPublisher) PublishDomainEvent(ctx context.Context, event *domain.DomainEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s domain event: %w", event.Type, err)
+	}
+	if err := p.publishWithSpan(ctx, DomainEventSubject(event), event.ID, data); err != nil {
+		return fmt.Errorf("failed to send %s domain event: %w", event.Type, err)
+	}
+	return nil
+}
+
+// PublishSurveyResultsViewer grants or revokes a user's results_viewer relation on a survey
+func (p *NATSPublisher) PublishSurveyResultsViewer(ctx context.Context, surveyUID, username string, granted bool) error {
+	if !isValidLFXUsername(username) {
+		p.logger.WarnContext(ctx, "skipping FGA results_viewer relation for invalid LFX username",
+			"survey_uid", surveyUID,
+			"username", username,
+		)
+		return nil
+	}
+
+	operation, subject := "member_put", fgaconstants.GenericMemberPutSubject
+	if !granted {
+		operation, subject = "member_remove", fgaconstants.GenericMemberRemoveSubject
+	}
+	memberMsg := fgatypes.GenericFGAMessage{
+		ObjectType: "survey",
+		Operation:  operation,
+		Data: fgatypes.GenericMemberData{
+			UID:       surveyUID,
+			Username:  username,
+			Relations: []string{RelationResultsViewer},
+		},
+	}
+
+	memberMsgBytes, err := json.Marshal(memberMsg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s message: %w", operation, err)
+	}
+
+	// One survey message fans out to many respondents, so the user is part of the message ID.
+	if err := p.publishObject(ctx, subject, surveyUID+":"+username, memberMsgBytes); err != nil {
+		return fmt.Errorf("failed to send survey results_viewer %s message: %w", operation, err)
+	}
+	return nil
+}
+
+// DomainEventSubject returns the versioned subject an event is published on
+func DomainEventSubject(event *domain.DomainEvent) string {
+	return fmt.Sprintf("%s.%s.%s", DomainEventSubjectPrefix, event.Version, event.Type)
+}
+
+// publishObject publishes a message about the object with this UID, de-duplicated per source revision
+func (p *NATSPublisher) publishObject(ctx context.Context, subject, uid string, data []byte) error {
+	return p.publishWithSpan(ctx, subject, msgID(ctx, subject, uid), data)
+}
+
+// publishWithSpan wraps the publish with an OTel producer span and injects trace context
+// into the NATS message headers. A non-empty id is sent as Nats-Msg-Id. In JetStream mode
+// it waits for the ack and returns an error if none arrives.
+func (p *NATSPublisher) publishWithSpan(ctx context.Context, subject, id string, data []byte) error {
 	ctx, span := tracer.Start(ctx, "nats.publish",
 		trace.WithSpanKind(trace.SpanKindProducer),
 		trace.WithAttributes(
@@ -122,13 +263,37 @@ func (p *NATSPublisher) publishWithSpan(ctx context.Context, subject string, dat
 	msg := nats.NewMsg(subject)
 	msg.Header = make(nats.Header)
 	msg.Data = data
+	if id != "" {
+		msg.Header.Set(jetstream.MsgIDHeader, id)
+		span.SetAttributes(attribute.String("messaging.message.id", id))
+	}
 	otel.GetTextMapPropagator().Inject(ctx, natsHeaderCarrier(msg.Header))
 
-	if err := p.conn.PublishMsg(msg); err != nil {
+	if p.js == nil {
+		if err := p.conn.PublishMsg(msg); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return fmt.Errorf("failed to publish to subject %s: %w", subject, err)
+		}
+		return nil
+	}
+
+	ackCtx, cancel := context.WithTimeout(ctx, p.ackTimeout)
+	defer cancel()
+	ack, err := p.js.PublishMsg(ackCtx, msg)
+	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return fmt.Errorf("failed to publish to subject %s: %w", subject, err)
 	}
+	span.SetAttributes(
+		attribute.String("messaging.nats.stream", ack.Stream),
+		attribute.Int64("messaging.nats.sequence", int64(ack.Sequence)),
+		attribute.Bool("messaging.nats.duplicate", ack.Duplicate),
+	)
+	if ack.Duplicate {
+		p.logger.With("subject", subject, "msg_id", id).DebugContext(ctx, "stream dropped duplicate message")
+	}
 	return nil
 }
 
@@ -221,9 +386,10 @@ func (p *NATSPublisher) sendSurveyAccessMessage(ctx context.Context, survey *dom
 		ObjectType: "survey",
 		Operation:  "update_access",
 		Data: fgatypes.GenericAccessData{
-			UID:        survey.UID,
-			Public:     false,
-			References: references,
+			UID:              survey.UID,
+			Public:           false,
+			References:       references,
+			ExcludeRelations: []string{RelationResultsViewer},
 		},
 	}
 
@@ -232,11 +398,15 @@ func (p *NATSPublisher) sendSurveyAccessMessage(ctx context.Context, survey *dom
 		return fmt.Errorf("failed to marshal access message: %w", err)
 	}
 
-	return p.publishWithSpan(ctx, fgaconstants.GenericUpdateAccessSubject, accessMsgBytes)
+	return p.publishObject(ctx, fgaconstants.GenericUpdateAccessSubject, survey.UID, accessMsgBytes)
 }
 
 // sendSurveyResponseIndexerMessage routes to the appropriate indexer message handler based on action
 func (p *NATSPublisher) sendSurveyResponseIndexerMessage(ctx context.Context, subject string, action indexerConstants.MessageAction, data *domain.SurveyResponseData) error {
+	if data.Anonymous {
+		data = deidentifySurveyResponse(data)
+	}
+
 	// Build IndexingConfig (needed for both create/update and delete)
 	nameAndAliases := []string{}
 	parentRefs := []string{}
@@ -278,6 +448,27 @@ func (p *NATSPublisher) sendSurveyResponseIndexerMessage(ctx context.Context, su
 	return p.sendIndexerCreateUpdateMessage(ctx, subject, action, data, indexingConfig)
 }
 
+// deidentifySurveyResponse returns a copy of a response of an anonymous survey without the
+// fields that identify the respondent or carry their answers. The access message still
+// gets the username, so respondents keep access to their own response.
+func deidentifySurveyResponse(data *domain.SurveyResponseData) *domain.SurveyResponseData {
+	deidentified := *data
+	deidentified.Email = ""
+	deidentified.FirstName = ""
+	deidentified.LastName = ""
+	deidentified.Username = ""
+	deidentified.JobTitle = ""
+	deidentified.CommitteeMemberID = ""
+	deidentified.Organization = domain.SurveyResponseOrgData{}
+	deidentified.SurveyLink = ""
+	deidentified.SurveyMonkeyRespondent = ""
+	deidentified.SurveyMonkeyQuestionAnswers = nil
+	deidentified.NPSValue = 0
+	// Bounce diagnostics quote the recipient address
+	deidentified.SESBounceDiagnosticCode = ""
+	return &deidentified
+}
+
 // sendSurveyTemplateIndexerMessage routes to the appropriate indexer message handler based on action
 func (p *NATSPublisher) sendSurveyTemplateIndexerMessage(ctx context.Context, subject string, action indexerConstants.MessageAction, data *domain.SurveyTemplateData) error {
 	nameAndAliases := []string{}
@@ -306,6 +497,92 @@ func (p *NATSPublisher) sendSurveyTemplateIndexerMessage(ctx context.Context, su
 	return p.sendIndexerCreateUpdateMessage(ctx, subject, action, data, indexingConfig)
 }
 
+// sendSurveyExclusionIndexerMessage routes to the appropriate indexer message handler based on action
+func (p *NATSPublisher) sendSurveyExclusionIndexerMessage(ctx context.Context, subject string, action indexerConstants.MessageAction, data *domain.SurveyExclusionData) error {
+	nameAndAliases := []string{}
+	parentRefs := []string{}
+	tags := []string{}
+
+	if data.Email != "" {
+		nameAndAliases = append(nameAndAliases, data.Email)
+	}
+	if data.UserID != "" {
+		nameAndAliases = append(nameAndAliases, data.UserID)
+	}
+	if data.SurveyUID != "" {
+		parentRefs = append(parentRefs, fmt.Sprintf("survey:%s", data.SurveyUID))
+		tags = append(tags, fmt.Sprintf("survey_uid:%s", data.SurveyUID))
+	}
+	if data.CommitteeUID != "" {
+		parentRefs = append(parentRefs, fmt.Sprintf("committee:%s", data.CommitteeUID))
+		tags = append(tags, fmt.Sprintf("committee_uid:%s", data.CommitteeUID))
+	}
+	if data.Scope != "" {
+		tags = append(tags, fmt.Sprintf("exclusion_scope:%s", data.Scope))
+	}
+
+	// Global exclusions have no survey or committee to inherit access from, so only
+	// platform admins can see them.
+	accessObject := fmt.Sprintf("survey_exclusion:%s", data.UID)
+	accessRelation := "auditor"
+	if data.Scope == domain.SurveyExclusionScopeGlobal {
+		accessObject = "team:global_survey_platform_admins"
+		accessRelation = "member"
+	}
+
+	indexingConfig := &indexerTypes.IndexingConfig{
+		ObjectID:             data.UID,
+		AccessCheckObject:    accessObject,
+		AccessCheckRelation:  accessRelation,
+		HistoryCheckObject:   accessObject,
+		HistoryCheckRelation: accessRelation,
+		SortName:             data.Email,
+		NameAndAliases:       nameAndAliases,
+		ParentRefs:           parentRefs,
+		Tags:                 tags,
+		Fulltext:             strings.TrimSpace(fmt.Sprintf("%s %s %s", data.Email, data.UserID, data.Scope)),
+	}
+
+	if action == indexerConstants.ActionDeleted {
+		return p.sendIndexerDeleteMessage(ctx, subject, action, data.UID, indexingConfig)
+	}
+
+	return p.sendIndexerCreateUpdateMessage(ctx, subject, action, data, indexingConfig)
+}
+
+// sendSurveyExclusionAccessMessage sends the message to the NATS server for the survey exclusion access control
+func (p *NATSPublisher) sendSurveyExclusionAccessMessage(ctx context.Context, data *domain.SurveyExclusionData) error {
+	references := map[string][]string{}
+	if data.SurveyUID != "" {
+		references["survey"] = []string{data.SurveyUID}
+	}
+	if data.CommitteeUID != "" {
+		references["committee"] = []string{data.CommitteeUID}
+	}
+
+	// Skip sending access message if there are no references (global exclusions)
+	if len(references) == 0 {
+		return nil
+	}
+
+	accessMsg := fgatypes.GenericFGAMessage{
+		ObjectType: "survey_exclusion",
+		Operation:  "update_access",
+		Data: fgatypes.GenericAccessData{
+			UID:        data.UID,
+			Public:     false,
+			References: references,
+		},
+	}
+
+	accessMsgBytes, err := json.Marshal(accessMsg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal access message: %w", err)
+	}
+
+	return p.publishObject(ctx, fgaconstants.GenericUpdateAccessSubject, data.UID, accessMsgBytes)
+}
+
 // sendSurveyResponseAccessMessage sends the message to the NATS server for the survey response access control
 func (p *NATSPublisher) sendSurveyResponseAccessMessage(ctx context.Context, data *domain.SurveyResponseData) error {
 	relations := map[string][]string{}
@@ -346,7 +623,7 @@ func (p *NATSPublisher) sendSurveyResponseAccessMessage(ctx context.Context, dat
 		return fmt.Errorf("failed to marshal access message: %w", err)
 	}
 
-	return p.publishWithSpan(ctx, fgaconstants.GenericUpdateAccessSubject, accessMsgBytes)
+	return p.publishObject(ctx, fgaconstants.GenericUpdateAccessSubject, data.UID, accessMsgBytes)
 }
 
 // sendDeleteAccessMessage sends a delete access message to FGA-sync
@@ -363,7 +640,7 @@ func (p *NATSPublisher) sendDeleteAccessMessage(ctx context.Context, objectType
 		return fmt.Errorf("failed to marshal delete access message: %w", err)
 	}
 
-	return p.publishWithSpan(ctx, fgaconstants.GenericDeleteAccessSubject, deleteMsgBytes)
+	return p.publishObject(ctx, fgaconstants.GenericDeleteAccessSubject, uid, deleteMsgBytes)
 }
 
 // sendIndexerDeleteMessage sends a generic delete message to the indexer with just the UID
@@ -384,7 +661,7 @@ func (p *NATSPublisher) sendIndexerDeleteMessage(ctx context.Context, subject st
 
 	p.logger.With("subject", subject, "action", action, "uid", uid).DebugContext(ctx, "constructed indexer delete message")
 
-	return p.publishWithSpan(ctx, subject, messageBytes)
+	return p.publishObject(ctx, subject, uid, messageBytes)
 }
 
 // sendIndexerCreateUpdateMessage sends a generic create/update message to the indexer with full object and IndexingConfig
@@ -409,7 +686,7 @@ func (p *NATSPublisher) sendIndexerCreateUpdateMessage(ctx context.Context, subj
 
 	p.logger.With("subject", subject, "action", action).DebugContext(ctx, "constructed indexer message")
 
-	return p.publishWithSpan(ctx, subject, messageBytes)
+	return p.publishObject(ctx, subject, indexingConfig.ObjectID, messageBytes)
 }
 
 // buildHeaders extracts headers from context for NATS messages
@@ -15,6 +15,7 @@ import (
 	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
 	"github.com/nats-io/nats-server/v2/server"
 	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -159,3 +160,267 @@ func TestSendSurveyResponseAccessMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestSendSurveyResponseIndexerMessage_Anonymous(t *testing.T) {
+	publisher, nc, cleanup := setupTestPublisher(t)
+	defer cleanup()
+
+	sub, err := nc.SubscribeSync(IndexSurveyResponseSubject)
+	require.NoError(t, err)
+
+	data := &domain.SurveyResponseData{
+		UID:          "sr-1",
+		SurveyUID:    "survey-1",
+		Email:        "jane@example.com",
+		FirstName:    "Jane",
+		LastName:     "Doe",
+		Username:     "jdoe",
+		Organization: domain.SurveyResponseOrgData{ID: "org-1", Name: "Example"},
+		NPSValue:     9,
+		SurveyMonkeyQuestionAnswers: []domain.SurveyMonkeyQuestionAnswers{
+			{QuestionID: "q-1", Answers: []domain.SurveyMonkeyAnswer{{Text: "Something personal"}}},
+		},
+		ResponseDatetime: "2026-01-02T03:04:05Z",
+		Anonymous:        true,
+	}
+	err = publisher.sendSurveyResponseIndexerMessage(context.Background(), IndexSurveyResponseSubject, "created", data)
+	require.NoError(t, err)
+	require.NoError(t, nc.Flush())
+
+	msg, err := sub.NextMsg(250 * time.Millisecond)
+	require.NoError(t, err)
+	assert.NotContains(t, string(msg.Data), "jane@example.com")
+	assert.NotContains(t, string(msg.Data), "Something personal")
+
+	var envelope struct {
+		Data           domain.SurveyResponseData `json:"data"`
+		IndexingConfig struct {
+			NameAndAliases []string `json:"name_and_aliases"`
+			ParentRefs     []string `json:"parent_refs"`
+		} `json:"indexing_config"`
+	}
+	require.NoError(t, json.Unmarshal(msg.Data, &envelope))
+	assert.Empty(t, envelope.Data.Username)
+	assert.Empty(t, envelope.Data.FirstName+envelope.Data.LastName)
+	assert.Empty(t, envelope.Data.Organization.Name)
+	assert.Zero(t, envelope.Data.NPSValue)
+	assert.Empty(t, envelope.Data.SurveyMonkeyQuestionAnswers)
+	assert.Equal(t, "2026-01-02T03:04:05Z", envelope.Data.ResponseDatetime)
+	assert.True(t, envelope.Data.Anonymous)
+	assert.Empty(t, envelope.IndexingConfig.NameAndAliases)
+	assert.Contains(t, envelope.IndexingConfig.ParentRefs, "survey:survey-1")
+
+	assert.Equal(t, "jdoe", data.Username, "the caller's data is left for the access message")
+}
+
+func TestSendSurveyExclusionAccessMessage(t *testing.T) {
+	tests := []struct {
+		name             string
+		data             *domain.SurveyExclusionData
+		wantPublished    bool
+		wantSurveyRef    []string
+		wantCommitteeRef []string
+	}{
+		{
+			name: "survey exclusion references the survey and committee",
+			data: &domain.SurveyExclusionData{
+				UID:          "ex-1",
+				Scope:        domain.SurveyExclusionScopeSurvey,
+				SurveyUID:    "survey-1",
+				CommitteeUID: "committee-1",
+			},
+			wantPublished:    true,
+			wantSurveyRef:    []string{"survey-1"},
+			wantCommitteeRef: []string{"committee-1"},
+		},
+		{
+			name: "committee exclusion references the committee",
+			data: &domain.SurveyExclusionData{
+				UID:          "ex-1",
+				Scope:        domain.SurveyExclusionScopeCommittee,
+				CommitteeUID: "committee-1",
+			},
+			wantPublished:    true,
+			wantCommitteeRef: []string{"committee-1"},
+		},
+		{
+			name: "skips publish for global exclusion",
+			data: &domain.SurveyExclusionData{
+				UID:             "ex-1",
+				Scope:           domain.SurveyExclusionScopeGlobal,
+				GlobalExclusion: true,
+			},
+			wantPublished: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			publisher, nc, cleanup := setupTestPublisher(t)
+			defer cleanup()
+
+			sub, err := nc.SubscribeSync(fgaconstants.GenericUpdateAccessSubject)
+			require.NoError(t, err)
+
+			err = publisher.sendSurveyExclusionAccessMessage(context.Background(), tt.data)
+			require.NoError(t, err)
+			require.NoError(t, nc.Flush())
+
+			msg, err := sub.NextMsg(250 * time.Millisecond)
+			if !tt.wantPublished {
+				assert.ErrorIs(t, err, nats.ErrTimeout)
+				return
+			}
+
+			require.NoError(t, err)
+
+			var accessMsg fgatypes.GenericFGAMessage
+			err = json.Unmarshal(msg.Data, &accessMsg)
+			require.NoError(t, err)
+
+			assert.Equal(t, "survey_exclusion", accessMsg.ObjectType)
+			assert.Equal(t, "update_access", accessMsg.Operation)
+
+			var accessData fgatypes.GenericAccessData
+			err = accessMsg.UnmarshalData(&accessData)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.data.UID, accessData.UID)
+			assert.Equal(t, tt.wantSurveyRef, accessData.References["survey"])
+			assert.Equal(t, tt.wantCommitteeRef, accessData.References["committee"])
+		})
+	}
+}
+
+func setupTestJetStreamPublisher(t *testing.T) (*NATSPublisher, jetstream.JetStream) {
+	ns, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      -1,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+	})
+	require.NoError(t, err)
+	go ns.Start()
+	if !ns.ReadyForConnections(4 * time.Second) {
+		t.Fatal("NATS server not ready")
+	}
+
+	nc, err := nats.Connect(ns.ClientURL())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		nc.Close()
+		ns.Shutdown()
+	})
+
+	js, err := jetstream.New(nc)
+	require.NoError(t, err)
+	return NewJetStreamPublisher(nc, js, time.Second, slog.Default()), js
+}
+
+func TestPublishSurveyResultsViewer(t *testing.T) {
+	tests := []struct {
+		name          string
+		username      string
+		granted       bool
+		wantSubject   string
+		wantOperation string
+	}{
+		{
+			name:          "grants with member_put",
+			username:      "testuser",
+			granted:       true,
+			wantSubject:   fgaconstants.GenericMemberPutSubject,
+			wantOperation: "member_put",
+		},
+		{
+			name:          "revokes with member_remove",
+			username:      "testuser",
+			granted:       false,
+			wantSubject:   fgaconstants.GenericMemberRemoveSubject,
+			wantOperation: "member_remove",
+		},
+		{
+			name:     "skips an invalid username",
+			username: "auth0|legacy",
+			granted:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			publisher, nc, cleanup := setupTestPublisher(t)
+			defer cleanup()
+
+			sub, err := nc.SubscribeSync("lfx.fga-sync.>")
+			require.NoError(t, err)
+
+			err = publisher.PublishSurveyResultsViewer(context.Background(), "survey-1", tt.username, tt.granted)
+			require.NoError(t, err)
+			require.NoError(t, nc.Flush())
+
+			msg, err := sub.NextMsg(250 * time.Millisecond)
+			if tt.wantSubject == "" {
+				assert.ErrorIs(t, err, nats.ErrTimeout)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSubject, msg.Subject)
+
+			var memberMsg fgatypes.GenericFGAMessage
+			require.NoError(t, json.Unmarshal(msg.Data, &memberMsg))
+			assert.Equal(t, "survey", memberMsg.ObjectType)
+			assert.Equal(t, tt.wantOperation, memberMsg.Operation)
+
+			var memberData fgatypes.GenericMemberData
+			require.NoError(t, memberMsg.UnmarshalData(&memberData))
+			assert.Equal(t, "survey-1", memberData.UID)
+			assert.Equal(t, tt.username, memberData.Username)
+			assert.Equal(t, []string{RelationResultsViewer}, memberData.Relations)
+		})
+	}
+}
+
+func TestMsgID(t *testing.T) {
+	ctx := context.Background()
+	assert.Empty(t, msgID(ctx, IndexSurveySubject, "s-1"), "no revision")
+
+	ctx = WithSourceRevision(ctx, 42)
+	assert.Equal(t, "lfx.index.survey:s-1:42", msgID(ctx, IndexSurveySubject, "s-1"))
+	assert.NotEqual(t, msgID(ctx, IndexSurveySubject, "s-1"), msgID(ctx, fgaconstants.GenericUpdateAccessSubject, "s-1"))
+	assert.Empty(t, msgID(ctx, IndexSurveySubject, ""), "no uid")
+}
+
+func TestJetStreamPublisher_DedupesRetriedRevision(t *testing.T) {
+	publisher, js := setupTestJetStreamPublisher(t)
+	ctx := context.Background()
+
+	stream, err := js.CreateStream(ctx, jetstream.StreamConfig{
+		Name:     "INDEX",
+		Subjects: []string{"lfx.index.>"},
+	})
+	require.NoError(t, err)
+
+	exclusion := &domain.SurveyExclusionData{UID: "e-1", Scope: domain.SurveyExclusionScopeGlobal}
+	revCtx := WithSourceRevision(ctx, 7)
+	require.NoError(t, publisher.sendSurveyExclusionIndexerMessage(revCtx, IndexSurveyExclusionSubject, "created", exclusion))
+	// A retry of the same KV revision is dropped by the stream
+	require.NoError(t, publisher.sendSurveyExclusionIndexerMessage(revCtx, IndexSurveyExclusionSubject, "created", exclusion))
+
+	info, err := stream.Info(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), info.State.Msgs)
+
+	// A new revision is stored
+	require.NoError(t, publisher.sendSurveyExclusionIndexerMessage(WithSourceRevision(ctx, 8), IndexSurveyExclusionSubject, "updated", exclusion))
+	info, err = stream.Info(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), info.State.Msgs)
+}
+
+func TestJetStreamPublisher_NoStream(t *testing.T) {
+	publisher, _ := setupTestJetStreamPublisher(t)
+
+	err := publisher.PublishDomainEvent(context.Background(), &domain.DomainEvent{ID: "ev-1", Type: domain.DomainEventSurveyOpened})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, jetstream.ErrNoStreamResponse)
+}
@@ -0,0 +1,158 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package idmapper
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// maxBatchKeys bounds the keys read by one filtered watch, keeping the consumer's
+// filter list well under the NATS max payload
+const maxBatchKeys = 256
+
+// mappingKeyChars is the KV key charset
+var mappingKeyChars = regexp.MustCompile(`^[-/_=\.a-zA-Z0-9]+$`)
+
+// validMappingKey reports whether key can be stored in the mappings bucket. Other keys
+// cannot have a mapping and must not reach a watch filter, where "*" and ">" are wildcards.
+func validMappingKey(key string) bool {
+	return mappingKeyChars.MatchString(key) && !strings.HasSuffix(key, ".") && !strings.Contains(key, "..")
+}
+
+// uniqueIDs returns ids without empty values or duplicates, preserving order
+func uniqueIDs(ids []string) []string {
+	seen := make(map[string]struct{}, len(ids))
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == "" {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		out = append(out, id)
+	}
+	return out
+}
+
+// mappingNotFound is the error for an ID with no mapping, shared by every mapper
+func mappingNotFound(key string) error {
+	return domain.NewValidationError(fmt.Sprintf("invalid ID: mapping not found for %s", key))
+}
+
+// mappingBatch is the outcome of resolving a batch from mapping values read in one pass
+type mappingBatch struct {
+	result  map[string]string
+	failed  map[string]error
+	missing []string // IDs with no value, in input order
+}
+
+// resolveBatch maps the unique ids through value, which returns the stored value of a
+// mapping key. decode turns a stored value into the mapped ID.
+func resolveBatch(ids []string, key func(string) string, decode func(string) (string, error), value func(string) (string, bool)) mappingBatch {
+	b := mappingBatch{
+		result: make(map[string]string, len(ids)),
+		failed: make(map[string]error),
+	}
+	for _, id := range ids {
+		stored, ok := value(key(id))
+		if !ok {
+			b.missing = append(b.missing, id)
+			continue
+		}
+		mapped, err := decode(stored)
+		if err != nil {
+			b.failed[id] = err
+			continue
+		}
+		b.result[id] = mapped
+	}
+	return b
+}
+
+// failMissing records every missing ID as "mapping not found"
+func (b *mappingBatch) failMissing(key func(string) string) {
+	for _, id := range b.missing {
+		b.failed[id] = mappingNotFound(key(id))
+	}
+	b.missing = nil
+}
+
+// err returns a *domain.BatchMappingError for the failed IDs, or nil
+func (b *mappingBatch) err() error {
+	if len(b.failed) == 0 {
+		return nil
+	}
+	return &domain.BatchMappingError{Failed: b.failed}
+}
+
+// identity is the decode step for mappings stored as the mapped ID itself
+func identity(value string) (string, error) {
+	return value, nil
+}
+
+// readMappings reads the current values of keys from the mappings bucket. Each chunk of
+// keys is one filtered watch that delivers the latest revision of every key and stops,
+// instead of a request per key. Missing keys and tombstones are left out of the result.
+func readMappings(ctx context.Context, kv jetstream.KeyValue, keys []string) (map[string]string, error) {
+	ctx, span := tracer.Start(ctx, "kv.read",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "nats"),
+			attribute.String("db.namespace", kv.Bucket()),
+			attribute.Int("idmapper.batch.size", len(keys)),
+		),
+	)
+	defer span.End()
+
+	values := make(map[string]string, len(keys))
+	for chunk := range slices.Chunk(keys, maxBatchKeys) {
+		// WatchFiltered rewrites its argument in place.
+		if err := readChunk(ctx, kv, slices.Clone(chunk), values); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+	}
+
+	span.SetStatus(codes.Ok, "")
+	return values, nil
+}
+
+func readChunk(ctx context.Context, kv jetstream.KeyValue, keys []string, values map[string]string) error {
+	watcher, err := kv.WatchFiltered(ctx, keys, jetstream.IgnoreDeletes())
+	if err != nil {
+		return domain.NewUnavailableError("failed to read ID mappings", err)
+	}
+	defer func() { _ = watcher.Stop() }()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return domain.NewUnavailableError("ID mapping read timed out", ctx.Err())
+		case entry, ok := <-watcher.Updates():
+			if !ok {
+				return domain.NewUnavailableError("ID mapping read interrupted")
+			}
+			// A nil entry marks the end of the current values.
+			if entry == nil {
+				return nil
+			}
+			if value := string(entry.Value()); value != "" && value != mappingTombstone {
+				values[entry.Key()] = value
+			}
+		}
+	}
+}
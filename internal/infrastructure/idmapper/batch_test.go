@@ -0,0 +1,128 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package idmapper
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNATSMapper_BatchReadsMappingsBucket(t *testing.T) {
+	kv := setupMappingsBucket(t)
+	ctx := context.Background()
+	for key, value := range map[string]string{
+		"project.sfid.a":       "1",
+		"project.sfid.b":       "2",
+		"project.sfid.gone":    mappingTombstone,
+		"committee.uid.c-v2":   "p-v1:c-v1",
+		"committee.uid.broken": "a:b:c",
+	} {
+		_, err := kv.PutString(ctx, key, value)
+		require.NoError(t, err)
+	}
+	// No connection: batches must not fall back to request/reply.
+	m := &NATSMapper{kv: kv, timeout: 2 * time.Second}
+
+	tests := []struct {
+		name       string
+		batch      func(context.Context, []string) (map[string]string, error)
+		ids        []string
+		want       map[string]string
+		wantFailed []string
+	}{
+		{
+			name:  "maps all IDs and ignores empty and duplicate inputs",
+			batch: m.MapProjectsV1ToV2,
+			ids:   []string{"a", "", "b", "a"},
+			want:  map[string]string{"a": "1", "b": "2"},
+		},
+		{
+			name:  "empty input",
+			batch: m.MapProjectsV1ToV2,
+			want:  map[string]string{},
+		},
+		{
+			name:       "reports missing, tombstoned and invalid IDs alongside partial results",
+			batch:      m.MapProjectsV1ToV2,
+			ids:        []string{"a", "x", "gone", "*", "a.", "b..c"},
+			want:       map[string]string{"a": "1"},
+			wantFailed: []string{"*", "a.", "b..c", "gone", "x"},
+		},
+		{
+			name:       "no keys found",
+			batch:      m.MapProjectsV2ToV1,
+			ids:        []string{"x"},
+			want:       map[string]string{},
+			wantFailed: []string{"x"},
+		},
+		{
+			name:       "decodes committee mappings",
+			batch:      m.MapCommitteesV2ToV1,
+			ids:        []string{"c-v2", "broken"},
+			want:       map[string]string{"c-v2": "c-v1"},
+			wantFailed: []string{"broken"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.batch(ctx, tt.ids)
+
+			assert.Equal(t, tt.want, got)
+			if tt.wantFailed == nil {
+				require.NoError(t, err)
+				return
+			}
+			var batchErr *domain.BatchMappingError
+			require.ErrorAs(t, err, &batchErr)
+			assert.Equal(t, tt.wantFailed, batchErr.FailedIDs())
+		})
+	}
+}
+
+func TestNATSMapper_BatchSpansChunks(t *testing.T) {
+	kv := setupMappingsBucket(t)
+	ctx := context.Background()
+	m := &NATSMapper{kv: kv, timeout: 2 * time.Second}
+
+	ids := make([]string, maxBatchKeys+10)
+	want := make(map[string]string, len(ids))
+	for i := range ids {
+		ids[i] = fmt.Sprintf("p-%d", i)
+		want[ids[i]] = fmt.Sprintf("v1-%d", i)
+		_, err := kv.PutString(ctx, projectUIDKey(ids[i]), want[ids[i]])
+		require.NoError(t, err)
+	}
+
+	got, err := m.MapProjectsV2ToV1(ctx, ids)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestNoOpMapper_Batch(t *testing.T) {
+	got, err := NewNoOpMapper().MapCommitteesV1ToV2(context.Background(), []string{"c1", "", "c2", "c1"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"c1": "c1", "c2": "c2"}, got)
+}
+
+func TestBatchMappingError_ErrorType(t *testing.T) {
+	notFound := mappingNotFound("project.uid.a")
+	unavailable := domain.NewUnavailableError("failed to read ID mappings")
+
+	err := &domain.BatchMappingError{Failed: map[string]error{"a": notFound, "b": unavailable}}
+	assert.Equal(t, domain.ErrorTypeUnavailable, domain.GetErrorType(err), "an unavailable failure outranks unknown IDs")
+	assert.Equal(t, domain.ErrorTypeUnavailable, domain.GetErrorType(fmt.Errorf("mapping projects: %w", err)))
+
+	err = &domain.BatchMappingError{Failed: map[string]error{"a": notFound}}
+	assert.Equal(t, domain.ErrorTypeValidation, domain.GetErrorType(err))
+
+	// A domain error wrapping the batch keeps its own type
+	assert.Equal(t, domain.ErrorTypeInternal, domain.GetErrorType(domain.NewInternalError("wrapped", err)))
+}
@@ -0,0 +1,321 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package idmapper
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// Default maximum number of cached mappings
+	defaultCacheSize = 10000
+
+	// Default lifetime of a successful mapping
+	defaultCacheTTL = 10 * time.Minute
+
+	// Default lifetime of a "mapping not found" result
+	defaultNegativeCacheTTL = time.Minute
+
+	// Default bound on a shared upstream lookup
+	defaultLookupTimeout = 10 * time.Second
+)
+
+// Lookup kinds, used both as cache key prefixes and as the metric "lookup" attribute.
+const (
+	lookupProjectV2ToV1   = "project_v2_to_v1"
+	lookupProjectV1ToV2   = "project_v1_to_v2"
+	lookupCommitteeV2ToV1 = "committee_v2_to_v1"
+	lookupCommitteeV1ToV2 = "committee_v1_to_v2"
+)
+
+// meter follows the same delegating semantics as tracer: instruments created
+// from it forward to whichever MeterProvider is registered at record time.
+var meter = otel.Meter("github.com/linuxfoundation/lfx-v2-survey-service/internal/infrastructure/idmapper")
+
+// CacheConfig holds the configuration for the caching ID mapper
+type CacheConfig struct {
+	// Size is the maximum number of cached mappings; the least recently used entry is evicted first
+	Size int
+	// TTL is how long a successful mapping is served from the cache
+	TTL time.Duration
+	// NegativeTTL is how long a "mapping not found" validation error is served from the cache
+	NegativeTTL time.Duration
+	// LookupTimeout bounds an upstream lookup shared by concurrent callers, which runs
+	// independently of any one caller's context
+	LookupTimeout time.Duration
+}
+
+// cacheEntry is a cached lookup result. A non-nil err marks a negative entry.
+type cacheEntry struct {
+	key       string
+	value     string
+	err       error
+	expiresAt time.Time
+}
+
+// CachingMapper decorates a domain.IDMapper with a bounded LRU cache.
+// Successful lookups and "mapping not found" validation errors are cached;
+// transient errors are never cached so they are retried on the next call.
+// Concurrent lookups of the same ID share a single upstream request.
+type CachingMapper struct {
+	next          domain.IDMapper
+	size          int
+	ttl           time.Duration
+	negativeTTL   time.Duration
+	lookupTimeout time.Duration
+	now           func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+
+	group singleflight.Group
+
+	hits   metric.Int64Counter
+	misses metric.Int64Counter
+}
+
+// NewCachingMapper wraps next with an in-memory cache
+func NewCachingMapper(next domain.IDMapper, cfg CacheConfig) (*CachingMapper, error) {
+	if next == nil {
+		return nil, domain.NewValidationError("caching mapper requires an underlying ID mapper")
+	}
+
+	size := cfg.Size
+	if size <= 0 {
+		size = defaultCacheSize
+	}
+	ttl := cfg.TTL
+	if ttl <= 0 {
+		ttl = defaultCacheTTL
+	}
+	negativeTTL := cfg.NegativeTTL
+	if negativeTTL <= 0 {
+		negativeTTL = defaultNegativeCacheTTL
+	}
+	lookupTimeout := cfg.LookupTimeout
+	if lookupTimeout <= 0 {
+		lookupTimeout = defaultLookupTimeout
+	}
+
+	hits, err := meter.Int64Counter("idmapper.cache.hits",
+		metric.WithDescription("ID mapping lookups served from the cache"),
+		metric.WithUnit("{lookup}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	misses, err := meter.Int64Counter("idmapper.cache.misses",
+		metric.WithDescription("ID mapping lookups forwarded to the underlying mapper"),
+		metric.WithUnit("{lookup}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CachingMapper{
+		next:          next,
+		size:          size,
+		ttl:           ttl,
+		negativeTTL:   negativeTTL,
+		lookupTimeout: lookupTimeout,
+		now:           time.Now,
+		entries:       make(map[string]*list.Element),
+		lru:           list.New(),
+		hits:          hits,
+		misses:        misses,
+	}, nil
+}
+
+// MapProjectV2ToV1 maps a v2 project UID to v1 project SFID, using the cache when possible
+func (m *CachingMapper) MapProjectV2ToV1(ctx context.Context, v2UID string) (string, error) {
+	return m.lookup(ctx, lookupProjectV2ToV1, v2UID, m.next.MapProjectV2ToV1)
+}
+
+// MapProjectV1ToV2 maps a v1 project SFID to v2 project UID, using the cache when possible
+func (m *CachingMapper) MapProjectV1ToV2(ctx context.Context, v1SFID string) (string, error) {
+	return m.lookup(ctx, lookupProjectV1ToV2, v1SFID, m.next.MapProjectV1ToV2)
+}
+
+// MapCommitteeV2ToV1 maps a v2 committee UID to v1 committee SFID, using the cache when possible
+func (m *CachingMapper) MapCommitteeV2ToV1(ctx context.Context, v2UID string) (string, error) {
+	return m.lookup(ctx, lookupCommitteeV2ToV1, v2UID, m.next.MapCommitteeV2ToV1)
+}
+
+// MapCommitteeV1ToV2 maps a v1 committee SFID to v2 committee UID, using the cache when possible
+func (m *CachingMapper) MapCommitteeV1ToV2(ctx context.Context, v1SFID string) (string, error) {
+	return m.lookup(ctx, lookupCommitteeV1ToV2, v1SFID, m.next.MapCommitteeV1ToV2)
+}
+
+// MapProjectsV2ToV1 maps v2 project UIDs to v1 project SFIDs, fetching only uncached IDs
+func (m *CachingMapper) MapProjectsV2ToV1(ctx context.Context, v2UIDs []string) (map[string]string, error) {
+	return m.lookupBatch(ctx, lookupProjectV2ToV1, v2UIDs, m.next.MapProjectsV2ToV1)
+}
+
+// MapProjectsV1ToV2 maps v1 project SFIDs to v2 project UIDs, fetching only uncached IDs
+func (m *CachingMapper) MapProjectsV1ToV2(ctx context.Context, v1SFIDs []string) (map[string]string, error) {
+	return m.lookupBatch(ctx, lookupProjectV1ToV2, v1SFIDs, m.next.MapProjectsV1ToV2)
+}
+
+// MapCommitteesV2ToV1 maps v2 committee UIDs to v1 committee SFIDs, fetching only uncached IDs
+func (m *CachingMapper) MapCommitteesV2ToV1(ctx context.Context, v2UIDs []string) (map[string]string, error) {
+	return m.lookupBatch(ctx, lookupCommitteeV2ToV1, v2UIDs, m.next.MapCommitteesV2ToV1)
+}
+
+// MapCommitteesV1ToV2 maps v1 committee SFIDs to v2 committee UIDs, fetching only uncached IDs
+func (m *CachingMapper) MapCommitteesV1ToV2(ctx context.Context, v1SFIDs []string) (map[string]string, error) {
+	return m.lookupBatch(ctx, lookupCommitteeV1ToV2, v1SFIDs, m.next.MapCommitteesV1ToV2)
+}
+
+// lookup serves kind/id from the cache or resolves it through fetch,
+// collapsing concurrent misses for the same key into one call. The shared call is
+// detached from the caller that started it, so its cancellation does not fail the
+// other waiters; each caller stops waiting when its own context is done.
+func (m *CachingMapper) lookup(ctx context.Context, kind, id string, fetch func(context.Context, string) (string, error)) (string, error) {
+	// Empty IDs are rejected by the underlying mapper; don't spend cache slots on them.
+	if id == "" {
+		return fetch(ctx, id)
+	}
+
+	key := kind + ":" + id
+	if entry, ok := m.get(key); ok {
+		m.hits.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("lookup", kind),
+			attribute.Bool("negative", entry.err != nil),
+		))
+		return entry.value, entry.err
+	}
+	m.misses.Add(ctx, 1, metric.WithAttributes(attribute.String("lookup", kind)))
+
+	ch := m.group.DoChan(key, func() (any, error) {
+		flightCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), m.lookupTimeout)
+		defer cancel()
+		value, err := fetch(flightCtx, id)
+		switch {
+		case err == nil:
+			m.put(key, value, nil, m.ttl)
+		case domain.GetErrorType(err) == domain.ErrorTypeValidation:
+			m.put(key, "", err, m.negativeTTL)
+		}
+		return value, err
+	})
+
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return "", res.Err
+		}
+		return res.Val.(string), nil
+	case <-ctx.Done():
+		return "", domain.NewUnavailableError("ID mapping lookup cancelled", ctx.Err())
+	}
+}
+
+// lookupBatch answers what it can from the cache and forwards the remaining IDs
+// to fetch as one batch. Batch misses are not de-duplicated against in-flight
+// single lookups.
+func (m *CachingMapper) lookupBatch(ctx context.Context, kind string, ids []string, fetch func(context.Context, []string) (map[string]string, error)) (map[string]string, error) {
+	ids = uniqueIDs(ids)
+	result := make(map[string]string, len(ids))
+	failed := make(map[string]error)
+
+	var misses []string
+	var hits, negativeHits int64
+	for _, id := range ids {
+		entry, ok := m.get(kind + ":" + id)
+		switch {
+		case !ok:
+			misses = append(misses, id)
+		case entry.err != nil:
+			negativeHits++
+			failed[id] = entry.err
+		default:
+			hits++
+			result[id] = entry.value
+		}
+	}
+	if hits > 0 {
+		m.hits.Add(ctx, hits, metric.WithAttributes(attribute.String("lookup", kind), attribute.Bool("negative", false)))
+	}
+	if negativeHits > 0 {
+		m.hits.Add(ctx, negativeHits, metric.WithAttributes(attribute.String("lookup", kind), attribute.Bool("negative", true)))
+	}
+
+	if len(misses) > 0 {
+		m.misses.Add(ctx, int64(len(misses)), metric.WithAttributes(attribute.String("lookup", kind)))
+
+		fetched, err := fetch(ctx, misses)
+		for id, value := range fetched {
+			result[id] = value
+			m.put(kind+":"+id, value, nil, m.ttl)
+		}
+		if err != nil {
+			var batchErr *domain.BatchMappingError
+			if !errors.As(err, &batchErr) {
+				return result, err
+			}
+			for id, idErr := range batchErr.Failed {
+				failed[id] = idErr
+				if domain.GetErrorType(idErr) == domain.ErrorTypeValidation {
+					m.put(kind+":"+id, "", idErr, m.negativeTTL)
+				}
+			}
+		}
+	}
+
+	if len(failed) > 0 {
+		return result, &domain.BatchMappingError{Failed: failed}
+	}
+	return result, nil
+}
+
+// get returns the live entry for key and marks it most recently used.
+// Expired entries are dropped.
+func (m *CachingMapper) get(key string) (cacheEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	elem, ok := m.entries[key]
+	if !ok {
+		return cacheEntry{}, false
+	}
+	entry := elem.Value.(*cacheEntry)
+	if !m.now().Before(entry.expiresAt) {
+		m.lru.Remove(elem)
+		delete(m.entries, key)
+		return cacheEntry{}, false
+	}
+	m.lru.MoveToFront(elem)
+	return *entry, true
+}
+
+// put stores a result for key, evicting the least recently used entry when full.
+func (m *CachingMapper) put(key, value string, err error, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry := &cacheEntry{key: key, value: value, err: err, expiresAt: m.now().Add(ttl)}
+	if elem, ok := m.entries[key]; ok {
+		elem.Value = entry
+		m.lru.MoveToFront(elem)
+		return
+	}
+
+	m.entries[key] = m.lru.PushFront(entry)
+	for m.lru.Len() > m.size {
+		oldest := m.lru.Back()
+		m.lru.Remove(oldest)
+		delete(m.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
@@ -0,0 +1,299 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package idmapper
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingMapper resolves IDs from a fixed table and counts upstream calls.
+type countingMapper struct {
+	mappings map[string]string
+	err      error
+	calls    atomic.Int32
+	batches  atomic.Int32
+	release  chan struct{}
+}
+
+func (m *countingMapper) resolve(ctx context.Context, id string) (string, error) {
+	m.calls.Add(1)
+	if m.release != nil {
+		select {
+		case <-m.release:
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+	if m.err != nil {
+		return "", m.err
+	}
+	if v, ok := m.mappings[id]; ok {
+		return v, nil
+	}
+	return "", domain.NewValidationError("invalid ID: mapping not found for " + id)
+}
+
+// resolveAll is the batch form of resolve: one batch call, per-ID failures collected
+func (m *countingMapper) resolveAll(ctx context.Context, ids []string) (map[string]string, error) {
+	m.batches.Add(1)
+	result := make(map[string]string)
+	failed := make(map[string]error)
+	for _, id := range uniqueIDs(ids) {
+		v, err := m.resolve(ctx, id)
+		if err != nil {
+			failed[id] = err
+			continue
+		}
+		result[id] = v
+	}
+	if len(failed) > 0 {
+		return result, &domain.BatchMappingError{Failed: failed}
+	}
+	return result, nil
+}
+
+func (m *countingMapper) MapProjectV2ToV1(ctx context.Context, id string) (string, error) {
+	return m.resolve(ctx, id)
+}
+
+func (m *countingMapper) MapProjectV1ToV2(ctx context.Context, id string) (string, error) {
+	return m.resolve(ctx, id)
+}
+
+func (m *countingMapper) MapCommitteeV2ToV1(ctx context.Context, id string) (string, error) {
+	return m.resolve(ctx, id)
+}
+
+func (m *countingMapper) MapCommitteeV1ToV2(ctx context.Context, id string) (string, error) {
+	return m.resolve(ctx, id)
+}
+
+func (m *countingMapper) MapProjectsV2ToV1(ctx context.Context, ids []string) (map[string]string, error) {
+	return m.resolveAll(ctx, ids)
+}
+
+func (m *countingMapper) MapProjectsV1ToV2(ctx context.Context, ids []string) (map[string]string, error) {
+	return m.resolveAll(ctx, ids)
+}
+
+func (m *countingMapper) MapCommitteesV2ToV1(ctx context.Context, ids []string) (map[string]string, error) {
+	return m.resolveAll(ctx, ids)
+}
+
+func (m *countingMapper) MapCommitteesV1ToV2(ctx context.Context, ids []string) (map[string]string, error) {
+	return m.resolveAll(ctx, ids)
+}
+
+func newTestCachingMapper(t *testing.T, next domain.IDMapper, cfg CacheConfig) *CachingMapper {
+	t.Helper()
+	m, err := NewCachingMapper(next, cfg)
+	require.NoError(t, err)
+	return m
+}
+
+func TestCachingMapper_CachesSuccessfulLookups(t *testing.T) {
+	next := &countingMapper{mappings: map[string]string{"p-v2": "p-v1"}}
+	m := newTestCachingMapper(t, next, CacheConfig{})
+
+	for range 3 {
+		got, err := m.MapProjectV2ToV1(context.Background(), "p-v2")
+		require.NoError(t, err)
+		assert.Equal(t, "p-v1", got)
+	}
+	assert.Equal(t, int32(1), next.calls.Load())
+
+	// A different lookup direction for the same ID is cached separately.
+	_, err := m.MapProjectV1ToV2(context.Background(), "p-v2")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), next.calls.Load())
+}
+
+func TestCachingMapper_Expiry(t *testing.T) {
+	next := &countingMapper{mappings: map[string]string{"c-v2": "c-v1"}}
+	m := newTestCachingMapper(t, next, CacheConfig{TTL: time.Minute, NegativeTTL: 10 * time.Second})
+	now := time.Now()
+	m.now = func() time.Time { return now }
+
+	_, err := m.MapCommitteeV2ToV1(context.Background(), "c-v2")
+	require.NoError(t, err)
+	_, err = m.MapCommitteeV2ToV1(context.Background(), "missing")
+	require.Error(t, err)
+	assert.Equal(t, int32(2), next.calls.Load())
+
+	// Negative entry expires first, positive entry is still served.
+	now = now.Add(30 * time.Second)
+	_, err = m.MapCommitteeV2ToV1(context.Background(), "c-v2")
+	require.NoError(t, err)
+	_, err = m.MapCommitteeV2ToV1(context.Background(), "missing")
+	require.Error(t, err)
+	assert.Equal(t, int32(3), next.calls.Load())
+
+	now = now.Add(time.Minute)
+	_, err = m.MapCommitteeV2ToV1(context.Background(), "c-v2")
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), next.calls.Load())
+}
+
+func TestCachingMapper_ErrorCaching(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		wantType  domain.ErrorType
+		wantCalls int32
+	}{
+		{
+			name:      "caches mapping not found",
+			wantType:  domain.ErrorTypeValidation,
+			wantCalls: 1,
+		},
+		{
+			name:      "does not cache unavailable errors",
+			err:       domain.NewUnavailableError("v1-sync-helper lookup timed out"),
+			wantType:  domain.ErrorTypeUnavailable,
+			wantCalls: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := &countingMapper{err: tt.err}
+			m := newTestCachingMapper(t, next, CacheConfig{})
+
+			for range 2 {
+				_, err := m.MapCommitteeV1ToV2(context.Background(), "unknown")
+				require.Error(t, err)
+				assert.Equal(t, tt.wantType, domain.GetErrorType(err))
+			}
+			assert.Equal(t, tt.wantCalls, next.calls.Load())
+		})
+	}
+}
+
+func TestCachingMapper_EvictsLeastRecentlyUsed(t *testing.T) {
+	next := &countingMapper{mappings: map[string]string{"a": "1", "b": "2", "c": "3"}}
+	m := newTestCachingMapper(t, next, CacheConfig{Size: 2})
+	ctx := context.Background()
+
+	_, _ = m.MapProjectV1ToV2(ctx, "a")
+	_, _ = m.MapProjectV1ToV2(ctx, "b")
+	_, _ = m.MapProjectV1ToV2(ctx, "a") // touch a so b becomes the oldest
+	_, _ = m.MapProjectV1ToV2(ctx, "c") // evicts b
+	assert.Equal(t, int32(3), next.calls.Load())
+	assert.Equal(t, 2, m.lru.Len())
+
+	_, _ = m.MapProjectV1ToV2(ctx, "a")
+	assert.Equal(t, int32(3), next.calls.Load())
+	_, _ = m.MapProjectV1ToV2(ctx, "b")
+	assert.Equal(t, int32(4), next.calls.Load())
+}
+
+func TestCachingMapper_DeduplicatesConcurrentLookups(t *testing.T) {
+	next := &countingMapper{
+		mappings: map[string]string{"p-v2": "p-v1"},
+		release:  make(chan struct{}),
+	}
+	m := newTestCachingMapper(t, next, CacheConfig{})
+
+	const callers = 10
+	var wg sync.WaitGroup
+	results := make([]string, callers)
+	for i := range callers {
+		wg.Go(func() {
+			results[i], _ = m.MapProjectV2ToV1(context.Background(), "p-v2")
+		})
+	}
+
+	// Give every caller a chance to join the in-flight lookup before releasing it.
+	require.Eventually(t, func() bool { return next.calls.Load() == 1 }, time.Second, time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	close(next.release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), next.calls.Load())
+	for _, r := range results {
+		assert.Equal(t, "p-v1", r)
+	}
+}
+
+func TestCachingMapper_SharedLookupOutlivesFirstCaller(t *testing.T) {
+	next := &countingMapper{
+		mappings: map[string]string{"p-v2": "p-v1"},
+		release:  make(chan struct{}),
+	}
+	m := newTestCachingMapper(t, next, CacheConfig{})
+
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := m.MapProjectV2ToV1(firstCtx, "p-v2")
+		firstErr <- err
+	}()
+	require.Eventually(t, func() bool { return next.calls.Load() == 1 }, time.Second, time.Millisecond)
+
+	second := make(chan string, 1)
+	go func() {
+		v, _ := m.MapProjectV2ToV1(context.Background(), "p-v2")
+		second <- v
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	// The first caller gives up; the shared lookup keeps going for the second.
+	cancelFirst()
+	err := <-firstErr
+	assert.Equal(t, domain.ErrorTypeUnavailable, domain.GetErrorType(err))
+
+	close(next.release)
+	assert.Equal(t, "p-v1", <-second)
+	assert.Equal(t, int32(1), next.calls.Load())
+}
+
+func TestCachingMapper_SharedLookupTimesOut(t *testing.T) {
+	next := &countingMapper{release: make(chan struct{})}
+	m := newTestCachingMapper(t, next, CacheConfig{LookupTimeout: 20 * time.Millisecond})
+
+	_, err := m.MapProjectV2ToV1(context.Background(), "p-v2")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestCachingMapper_EmptyIDBypassesCache(t *testing.T) {
+	next := &countingMapper{}
+	m := newTestCachingMapper(t, next, CacheConfig{})
+
+	_, _ = m.MapProjectV2ToV1(context.Background(), "")
+	_, _ = m.MapProjectV2ToV1(context.Background(), "")
+	assert.Equal(t, int32(2), next.calls.Load())
+	assert.Zero(t, m.lru.Len())
+}
+
+func TestCachingMapper_Batch(t *testing.T) {
+	next := &countingMapper{mappings: map[string]string{"a": "1", "b": "2"}}
+	m := newTestCachingMapper(t, next, CacheConfig{})
+	ctx := context.Background()
+
+	_, err := m.MapProjectV1ToV2(ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, int32(1), next.calls.Load())
+
+	// "a" is served from the cache; "b" and "missing" go upstream.
+	got, err := m.MapProjectsV1ToV2(ctx, []string{"a", "b", "missing", "a", ""})
+	assert.Equal(t, map[string]string{"a": "1", "b": "2"}, got)
+	var batchErr *domain.BatchMappingError
+	require.ErrorAs(t, err, &batchErr)
+	assert.Equal(t, []string{"missing"}, batchErr.FailedIDs())
+	assert.Equal(t, int32(3), next.calls.Load())
+
+	// Both the positive and negative results are now cached.
+	got, err = m.MapProjectsV1ToV2(ctx, []string{"b", "missing"})
+	assert.Equal(t, map[string]string{"b": "2"}, got)
+	require.ErrorAs(t, err, &batchErr)
+	assert.Equal(t, int32(3), next.calls.Load())
+}
@@ -0,0 +1,263 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package idmapper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"gopkg.in/yaml.v3"
+)
+
+// Default interval between checks of the mapping file for changes
+const defaultFileReloadInterval = 5 * time.Second
+
+// FileConfig holds the configuration for the file-based ID mapper
+type FileConfig struct {
+	// Path to a JSON (.json) or YAML (.yaml, .yml) mapping file
+	Path string
+	// ReloadInterval is how often the file is checked for changes
+	ReloadInterval time.Duration
+}
+
+// mappingFile is the on-disk format:
+//
+//	projects:
+//	  - v1: a0941000002wBz4AAE                      # project SFID
+//	    v2: 6ba7b810-9dad-11d1-80b4-00c04fd430c8     # project UID
+//	committees:
+//	  - v1: a0941000002wBz4AAE:a2b5e000000abcDAAQ   # project_sfid:committee_sfid, or committee_sfid
+//	    v2: 550e8400-e29b-41d4-a716-446655440000     # committee UID
+type mappingFile struct {
+	Projects   []mappingPair `json:"projects" yaml:"projects"`
+	Committees []mappingPair `json:"committees" yaml:"committees"`
+}
+
+type mappingPair struct {
+	V1 string `json:"v1" yaml:"v1"`
+	V2 string `json:"v2" yaml:"v2"`
+}
+
+// staticMappings is an immutable lookup table keyed like the v1-mappings bucket
+type staticMappings map[string]string
+
+// FileMapper implements IDMapper from a static mapping file, for offline development.
+// The file is polled for changes and reloaded in place; a file that fails to parse
+// leaves the previous mappings active.
+type FileMapper struct {
+	path     string
+	interval time.Duration
+	logger   *slog.Logger
+
+	mappings atomic.Pointer[staticMappings]
+	modTime  time.Time
+	size     int64
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+// NewFileMapper loads the mapping file and starts watching it for changes
+func NewFileMapper(cfg FileConfig, logger *slog.Logger) (*FileMapper, error) {
+	if cfg.Path == "" {
+		return nil, fmt.Errorf("mapping file path is required")
+	}
+	interval := cfg.ReloadInterval
+	if interval <= 0 {
+		interval = defaultFileReloadInterval
+	}
+
+	m := &FileMapper{
+		path:     cfg.Path,
+		interval: interval,
+		logger:   logger,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	if _, err := m.reload(); err != nil {
+		return nil, err
+	}
+
+	go m.watch()
+	return m, nil
+}
+
+// Close stops watching the mapping file
+func (m *FileMapper) Close() {
+	m.stopOnce.Do(func() { close(m.stop) })
+	<-m.done
+}
+
+// watch polls the file's size and modification time and reloads it when either changes
+func (m *FileMapper) watch() {
+	defer close(m.done)
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.stop:
+			return
+		case <-ticker.C:
+			reloaded, err := m.reload()
+			if err != nil {
+				m.logger.With("error", err, "path", m.path).Error("failed to reload ID mapping file, keeping previous mappings")
+				continue
+			}
+			if reloaded {
+				m.logger.Info("reloaded ID mapping file", "path", m.path, "keys", len(*m.mappings.Load()))
+			}
+		}
+	}
+}
+
+// reload parses the file if it changed since the last successful load
+func (m *FileMapper) reload() (bool, error) {
+	info, err := os.Stat(m.path)
+	if err != nil {
+		return false, fmt.Errorf("failed to stat mapping file: %w", err)
+	}
+	if m.mappings.Load() != nil && info.ModTime().Equal(m.modTime) && info.Size() == m.size {
+		return false, nil
+	}
+
+	data, err := os.ReadFile(m.path)
+	if err != nil {
+		return false, fmt.Errorf("failed to read mapping file: %w", err)
+	}
+	mappings, err := parseMappingFile(m.path, data)
+	if err != nil {
+		return false, err
+	}
+
+	m.mappings.Store(&mappings)
+	m.modTime = info.ModTime()
+	m.size = info.Size()
+	return true, nil
+}
+
+// parseMappingFile decodes the file by extension and builds the lookup table
+func parseMappingFile(path string, data []byte) (staticMappings, error) {
+	var file mappingFile
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		if err := json.Unmarshal(data, &file); err != nil {
+			return nil, fmt.Errorf("failed to parse JSON mapping file: %w", err)
+		}
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &file); err != nil {
+			return nil, fmt.Errorf("failed to parse YAML mapping file: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported mapping file extension %q (want .json, .yaml or .yml)", filepath.Ext(path))
+	}
+
+	mappings := make(staticMappings, 2*(len(file.Projects)+len(file.Committees)))
+	for i, p := range file.Projects {
+		if p.V1 == "" || p.V2 == "" {
+			return nil, fmt.Errorf("projects[%d]: both v1 and v2 are required", i)
+		}
+		mappings[projectSFIDKey(p.V1)] = p.V2
+		mappings[projectUIDKey(p.V2)] = p.V1
+	}
+	for i, c := range file.Committees {
+		if c.V1 == "" || c.V2 == "" {
+			return nil, fmt.Errorf("committees[%d]: both v1 and v2 are required", i)
+		}
+		committeeSFID, err := committeeSFIDFromMapping(c.V1)
+		if err != nil {
+			return nil, fmt.Errorf("committees[%d]: %w", i, err)
+		}
+		// Stored like the v1-sync-helper: committee.uid keeps the compound value,
+		// committee.sfid is keyed by the bare committee SFID.
+		mappings[committeeSFIDKey(committeeSFID)] = c.V2
+		mappings[committeeUIDKey(c.V2)] = c.V1
+	}
+	return mappings, nil
+}
+
+func (m *FileMapper) lookup(key string) (string, error) {
+	if value, ok := (*m.mappings.Load())[key]; ok {
+		return value, nil
+	}
+	return "", mappingNotFound(key)
+}
+
+// lookupBatch resolves ids against one snapshot of the mappings
+func (m *FileMapper) lookupBatch(ids []string, key func(string) string, decode func(string) (string, error)) (map[string]string, error) {
+	mappings := *m.mappings.Load()
+	b := resolveBatch(uniqueIDs(ids), key, decode, func(k string) (string, bool) {
+		value, ok := mappings[k]
+		return value, ok
+	})
+	b.failMissing(key)
+	return b.result, b.err()
+}
+
+// MapProjectV2ToV1 maps a v2 project UID to v1 project SFID
+func (m *FileMapper) MapProjectV2ToV1(_ context.Context, v2UID string) (string, error) {
+	if v2UID == "" {
+		return "", domain.NewValidationError("v2 project UID is required")
+	}
+	return m.lookup(projectUIDKey(v2UID))
+}
+
+// MapProjectV1ToV2 maps a v1 project SFID to v2 project UID
+func (m *FileMapper) MapProjectV1ToV2(_ context.Context, v1SFID string) (string, error) {
+	if v1SFID == "" {
+		return "", domain.NewValidationError("v1 project SFID is required")
+	}
+	return m.lookup(projectSFIDKey(v1SFID))
+}
+
+// MapCommitteeV2ToV1 maps a v2 committee UID to v1 committee SFID
+func (m *FileMapper) MapCommitteeV2ToV1(_ context.Context, v2UID string) (string, error) {
+	if v2UID == "" {
+		return "", domain.NewValidationError("v2 committee UID is required")
+	}
+	value, err := m.lookup(committeeUIDKey(v2UID))
+	if err != nil {
+		return "", err
+	}
+	return committeeSFIDFromMapping(value)
+}
+
+// MapCommitteeV1ToV2 maps a v1 committee SFID to v2 committee UID
+func (m *FileMapper) MapCommitteeV1ToV2(_ context.Context, v1SFID string) (string, error) {
+	if v1SFID == "" {
+		return "", domain.NewValidationError("v1 committee SFID is required")
+	}
+	return m.lookup(committeeSFIDKey(v1SFID))
+}
+
+// MapProjectsV2ToV1 maps v2 project UIDs to v1 project SFIDs
+func (m *FileMapper) MapProjectsV2ToV1(_ context.Context, v2UIDs []string) (map[string]string, error) {
+	return m.lookupBatch(v2UIDs, projectUIDKey, identity)
+}
+
+// MapProjectsV1ToV2 maps v1 project SFIDs to v2 project UIDs
+func (m *FileMapper) MapProjectsV1ToV2(_ context.Context, v1SFIDs []string) (map[string]string, error) {
+	return m.lookupBatch(v1SFIDs, projectSFIDKey, identity)
+}
+
+// MapCommitteesV2ToV1 maps v2 committee UIDs to v1 committee SFIDs
+func (m *FileMapper) MapCommitteesV2ToV1(_ context.Context, v2UIDs []string) (map[string]string, error) {
+	return m.lookupBatch(v2UIDs, committeeUIDKey, committeeSFIDFromMapping)
+}
+
+// MapCommitteesV1ToV2 maps v1 committee SFIDs to v2 committee UIDs
+func (m *FileMapper) MapCommitteesV1ToV2(_ context.Context, v1SFIDs []string) (map[string]string, error) {
+	return m.lookupBatch(v1SFIDs, committeeSFIDKey, identity)
+}
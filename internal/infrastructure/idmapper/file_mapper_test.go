@@ -0,0 +1,132 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package idmapper
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMappingYAML = `
+projects:
+  - v1: proj-sfid
+    v2: proj-uid
+committees:
+  - v1: proj-sfid:com-sfid
+    v2: com-uid
+  - v1: bare-com-sfid
+    v2: bare-com-uid
+`
+
+const testMappingJSON = `{
+  "projects": [{"v1": "proj-sfid", "v2": "proj-uid"}],
+  "committees": [
+    {"v1": "proj-sfid:com-sfid", "v2": "com-uid"},
+    {"v1": "bare-com-sfid", "v2": "bare-com-uid"}
+  ]
+}`
+
+func writeMappingFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestFileMapper_Lookups(t *testing.T) {
+	for _, tc := range []struct{ name, file, content string }{
+		{"yaml", "mappings.yaml", testMappingYAML},
+		{"json", "mappings.json", testMappingJSON},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := NewFileMapper(FileConfig{Path: writeMappingFile(t, tc.file, tc.content)}, slog.Default())
+			require.NoError(t, err)
+			t.Cleanup(m.Close)
+			ctx := context.Background()
+
+			tests := []struct {
+				name   string
+				lookup func(context.Context, string) (string, error)
+				in     string
+				want   string
+			}{
+				{"project v2 to v1", m.MapProjectV2ToV1, "proj-uid", "proj-sfid"},
+				{"project v1 to v2", m.MapProjectV1ToV2, "proj-sfid", "proj-uid"},
+				{"compound committee v2 to v1", m.MapCommitteeV2ToV1, "com-uid", "com-sfid"},
+				{"compound committee v1 to v2", m.MapCommitteeV1ToV2, "com-sfid", "com-uid"},
+				{"bare committee v2 to v1", m.MapCommitteeV2ToV1, "bare-com-uid", "bare-com-sfid"},
+				{"bare committee v1 to v2", m.MapCommitteeV1ToV2, "bare-com-sfid", "bare-com-uid"},
+			}
+			for _, tt := range tests {
+				got, err := tt.lookup(ctx, tt.in)
+				require.NoError(t, err, tt.name)
+				assert.Equal(t, tt.want, got, tt.name)
+			}
+
+			_, err = m.MapProjectV1ToV2(ctx, "unknown")
+			assert.Equal(t, domain.ErrorTypeValidation, domain.GetErrorType(err))
+
+			got, err := m.MapCommitteesV2ToV1(ctx, []string{"com-uid", "bare-com-uid", "unknown"})
+			assert.Equal(t, map[string]string{"com-uid": "com-sfid", "bare-com-uid": "bare-com-sfid"}, got)
+			var batchErr *domain.BatchMappingError
+			require.ErrorAs(t, err, &batchErr)
+			assert.Equal(t, []string{"unknown"}, batchErr.FailedIDs())
+		})
+	}
+}
+
+func TestFileMapper_InvalidFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		wantErr string
+	}{
+		{"unsupported extension", "mappings.txt", testMappingYAML, "unsupported mapping file extension"},
+		{"malformed yaml", "mappings.yaml", "projects: [", "failed to parse YAML"},
+		{"missing v2", "mappings.json", `{"projects": [{"v1": "p"}]}`, "projects[0]"},
+		{"bad compound committee", "mappings.yaml", "committees:\n  - v1: a:b:c\n    v2: x\n", "committees[0]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewFileMapper(FileConfig{Path: writeMappingFile(t, tt.file, tt.content)}, slog.Default())
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestFileMapper_HotReload(t *testing.T) {
+	path := writeMappingFile(t, "mappings.yaml", testMappingYAML)
+	m, err := NewFileMapper(FileConfig{Path: path, ReloadInterval: 10 * time.Millisecond}, slog.Default())
+	require.NoError(t, err)
+	t.Cleanup(m.Close)
+	ctx := context.Background()
+
+	updated := "projects:\n  - v1: new-sfid\n    v2: new-uid\n"
+	require.NoError(t, os.WriteFile(path, []byte(updated), 0o600))
+	require.Eventually(t, func() bool {
+		got, err := m.MapProjectV1ToV2(ctx, "new-sfid")
+		return err == nil && got == "new-uid"
+	}, 2*time.Second, 10*time.Millisecond)
+
+	_, err = m.MapProjectV1ToV2(ctx, "proj-sfid")
+	assert.Error(t, err, "mappings removed from the file are dropped")
+
+	// A broken edit keeps the last good mappings.
+	require.NoError(t, os.WriteFile(path, []byte("projects: ["), 0o600))
+	time.Sleep(50 * time.Millisecond)
+	got, err := m.MapProjectV1ToV2(ctx, "new-sfid")
+	require.NoError(t, err)
+	assert.Equal(t, "new-uid", got)
+}
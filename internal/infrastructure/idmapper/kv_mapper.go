@@ -0,0 +1,322 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package idmapper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"sync"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-survey-service/pkg/kvwatch"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	// Default KV bucket holding the v1-sync-helper ID mappings
+	defaultMappingsBucket = "v1-mappings"
+
+	// Value written over a mapping key when the underlying object is deleted
+	mappingTombstone = "!del"
+)
+
+// mirroredKeyFilters limits the watch to project and committee mappings; the bucket
+// also holds survey and other object mappings that the mapper never reads.
+func mirroredKeyFilters() []string {
+	// A fresh slice each time: WatchFiltered rewrites its argument in place.
+	return []string{"project.>", "committee.>"}
+}
+
+// errMirrorNotSynced is returned for mirror misses without a fallback while the mirror is
+// not synced, so they are not mistaken (and cached) as "mapping not found"
+var errMirrorNotSynced = domain.NewUnavailableError("ID mapping mirror is not synced")
+
+// KVConfig holds the configuration for the KV-backed ID mapper
+type KVConfig struct {
+	URL    string
+	Bucket string
+}
+
+// KVMapper implements IDMapper by reading the v1-mappings KV bucket directly.
+// A watch keeps an in-memory mirror of the project and committee keys, so lookups
+// do not depend on the v1-sync-helper being up. Keys missing from the mirror, and
+// every lookup made while the mirror is not synced (before the initial sync and
+// while a closed watch is re-created), are resolved through the fallback mapper.
+type KVMapper struct {
+	conn     *nats.Conn
+	kv       jetstream.KeyValue
+	fallback domain.IDMapper
+	logger   *slog.Logger
+
+	mu     sync.RWMutex
+	mirror map[string]string
+	ready  bool
+
+	watch      *kvwatch.Watch
+	synced     chan struct{}
+	syncedOnce sync.Once
+}
+
+// NewKVMapper connects to NATS, binds the mappings bucket and starts mirroring it.
+// fallback may be nil, in which case mirror misses are reported as "mapping not found",
+// or as unavailable while the mirror is not synced.
+func NewKVMapper(ctx context.Context, cfg KVConfig, fallback domain.IDMapper, logger *slog.Logger) (*KVMapper, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("NATS URL is required")
+	}
+	bucket := cfg.Bucket
+	if bucket == "" {
+		bucket = defaultMappingsBucket
+	}
+
+	conn, err := nats.Connect(cfg.URL, nats.Name("survey-service-id-mapper"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create JetStream context: %w", err)
+	}
+
+	kv, err := js.KeyValue(ctx, bucket)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to access %s KV bucket: %w", bucket, err)
+	}
+
+	m, err := newKVMapper(ctx, kv, fallback, logger)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	m.conn = conn
+	return m, nil
+}
+
+// newKVMapper starts mirroring an already-bound bucket
+func newKVMapper(ctx context.Context, kv jetstream.KeyValue, fallback domain.IDMapper, logger *slog.Logger) (*KVMapper, error) {
+	m := &KVMapper{
+		kv:       kv,
+		fallback: fallback,
+		logger:   logger,
+		mirror:   make(map[string]string),
+		synced:   make(chan struct{}),
+	}
+
+	watch, err := kvwatch.Start(ctx, func(ctx context.Context) (jetstream.KeyWatcher, error) {
+		return kv.WatchFiltered(ctx, mirroredKeyFilters())
+	}, kvwatch.Mirror{
+		Reset:  m.reset,
+		Apply:  m.apply,
+		Synced: m.markSynced,
+	}, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch %s KV bucket: %w", kv.Bucket(), err)
+	}
+	m.watch = watch
+	return m, nil
+}
+
+// reset drops the mirror after its watch closed; lookups use the fallback until
+// the new watch has re-synced
+func (m *KVMapper) reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.mirror = make(map[string]string)
+	m.ready = false
+	m.logger.Warn("ID mapping mirror lost its watch, falling back to lookups until it re-syncs",
+		"bucket", m.kv.Bucket(),
+	)
+}
+
+// markSynced marks the mirror ready once a watch has delivered the current bucket contents
+func (m *KVMapper) markSynced() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ready = true
+	m.syncedOnce.Do(func() { close(m.synced) })
+	m.logger.Info("ID mapping mirror synced",
+		"bucket", m.kv.Bucket(),
+		"keys", len(m.mirror),
+	)
+}
+
+// apply records a single KV entry in the mirror
+func (m *KVMapper) apply(entry jetstream.KeyValueEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	value := string(entry.Value())
+	if entry.Operation() != jetstream.KeyValuePut || value == "" || value == mappingTombstone {
+		delete(m.mirror, entry.Key())
+		return
+	}
+	m.mirror[entry.Key()] = value
+}
+
+func (m *KVMapper) size() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.mirror)
+}
+
+// get returns the mirrored value for key. While the mirror is not synced it only
+// answers when there is no fallback to ask instead.
+func (m *KVMapper) get(key string) (string, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.getLocked(key)
+}
+
+func (m *KVMapper) getLocked(key string) (string, bool) {
+	if !m.ready && m.fallback != nil {
+		return "", false
+	}
+	value, ok := m.mirror[key]
+	return value, ok
+}
+
+// Synced returns a channel that is closed once the initial bucket contents are mirrored
+func (m *KVMapper) Synced() <-chan struct{} {
+	return m.synced
+}
+
+// Close stops the watch and closes the NATS connection if the mapper owns one
+func (m *KVMapper) Close() {
+	m.watch.Stop()
+	if m.conn != nil {
+		m.conn.Close()
+	}
+}
+
+// MapProjectV2ToV1 maps a v2 project UID to v1 project SFID
+func (m *KVMapper) MapProjectV2ToV1(ctx context.Context, v2UID string) (string, error) {
+	if v2UID == "" {
+		return "", domain.NewValidationError("v2 project UID is required")
+	}
+	if value, ok := m.get(projectUIDKey(v2UID)); ok {
+		return value, nil
+	}
+	return m.miss(ctx, projectUIDKey(v2UID), v2UID, m.fallbackFunc(domain.IDMapper.MapProjectV2ToV1))
+}
+
+// MapProjectV1ToV2 maps a v1 project SFID to v2 project UID
+func (m *KVMapper) MapProjectV1ToV2(ctx context.Context, v1SFID string) (string, error) {
+	if v1SFID == "" {
+		return "", domain.NewValidationError("v1 project SFID is required")
+	}
+	if value, ok := m.get(projectSFIDKey(v1SFID)); ok {
+		return value, nil
+	}
+	return m.miss(ctx, projectSFIDKey(v1SFID), v1SFID, m.fallbackFunc(domain.IDMapper.MapProjectV1ToV2))
+}
+
+// MapCommitteeV2ToV1 maps a v2 committee UID to v1 committee SFID
+func (m *KVMapper) MapCommitteeV2ToV1(ctx context.Context, v2UID string) (string, error) {
+	if v2UID == "" {
+		return "", domain.NewValidationError("v2 committee UID is required")
+	}
+	if value, ok := m.get(committeeUIDKey(v2UID)); ok {
+		return committeeSFIDFromMapping(value)
+	}
+	return m.miss(ctx, committeeUIDKey(v2UID), v2UID, m.fallbackFunc(domain.IDMapper.MapCommitteeV2ToV1))
+}
+
+// MapCommitteeV1ToV2 maps a v1 committee SFID to v2 committee UID
+func (m *KVMapper) MapCommitteeV1ToV2(ctx context.Context, v1SFID string) (string, error) {
+	if v1SFID == "" {
+		return "", domain.NewValidationError("v1 committee SFID is required")
+	}
+	if value, ok := m.get(committeeSFIDKey(v1SFID)); ok {
+		return value, nil
+	}
+	return m.miss(ctx, committeeSFIDKey(v1SFID), v1SFID, m.fallbackFunc(domain.IDMapper.MapCommitteeV1ToV2))
+}
+
+// MapProjectsV2ToV1 maps v2 project UIDs to v1 project SFIDs
+func (m *KVMapper) MapProjectsV2ToV1(ctx context.Context, v2UIDs []string) (map[string]string, error) {
+	return m.lookupBatch(ctx, v2UIDs, projectUIDKey, identity, domain.IDMapper.MapProjectsV2ToV1)
+}
+
+// MapProjectsV1ToV2 maps v1 project SFIDs to v2 project UIDs
+func (m *KVMapper) MapProjectsV1ToV2(ctx context.Context, v1SFIDs []string) (map[string]string, error) {
+	return m.lookupBatch(ctx, v1SFIDs, projectSFIDKey, identity, domain.IDMapper.MapProjectsV1ToV2)
+}
+
+// MapCommitteesV2ToV1 maps v2 committee UIDs to v1 committee SFIDs
+func (m *KVMapper) MapCommitteesV2ToV1(ctx context.Context, v2UIDs []string) (map[string]string, error) {
+	return m.lookupBatch(ctx, v2UIDs, committeeUIDKey, committeeSFIDFromMapping, domain.IDMapper.MapCommitteesV2ToV1)
+}
+
+// MapCommitteesV1ToV2 maps v1 committee SFIDs to v2 committee UIDs
+func (m *KVMapper) MapCommitteesV1ToV2(ctx context.Context, v1SFIDs []string) (map[string]string, error) {
+	return m.lookupBatch(ctx, v1SFIDs, committeeSFIDKey, identity, domain.IDMapper.MapCommitteesV1ToV2)
+}
+
+// lookupBatch resolves ids from the mirror in one pass and sends the misses to the
+// fallback mapper as a single batch
+func (m *KVMapper) lookupBatch(ctx context.Context, ids []string, key func(string) string, decode func(string) (string, error), fallback func(domain.IDMapper, context.Context, []string) (map[string]string, error)) (map[string]string, error) {
+	m.mu.RLock()
+	b := resolveBatch(uniqueIDs(ids), key, decode, m.getLocked)
+	ready := m.ready
+	m.mu.RUnlock()
+
+	if len(b.missing) == 0 {
+		return b.result, b.err()
+	}
+	if m.fallback == nil {
+		if !ready {
+			for _, id := range b.missing {
+				b.failed[id] = errMirrorNotSynced
+			}
+			return b.result, b.err()
+		}
+		b.failMissing(key)
+		return b.result, b.err()
+	}
+
+	m.logger.DebugContext(ctx, "ID mappings not in KV mirror, falling back to lookup", "count", len(b.missing))
+	resolved, err := fallback(m.fallback, ctx, b.missing)
+	maps.Copy(b.result, resolved)
+	if err != nil {
+		var batchErr *domain.BatchMappingError
+		if !errors.As(err, &batchErr) {
+			return b.result, err
+		}
+		maps.Copy(b.failed, batchErr.Failed)
+	}
+	return b.result, b.err()
+}
+
+// fallbackFunc binds a single-ID IDMapper method to the fallback mapper, or
+// returns nil when no fallback is configured
+func (m *KVMapper) fallbackFunc(method func(domain.IDMapper, context.Context, string) (string, error)) func(context.Context, string) (string, error) {
+	if m.fallback == nil {
+		return nil
+	}
+	return func(ctx context.Context, id string) (string, error) {
+		return method(m.fallback, ctx, id)
+	}
+}
+
+// miss resolves an ID that is not in the mirror
+func (m *KVMapper) miss(ctx context.Context, key, id string, fallback func(context.Context, string) (string, error)) (string, error) {
+	if fallback == nil {
+		m.mu.RLock()
+		ready := m.ready
+		m.mu.RUnlock()
+		if !ready {
+			return "", errMirrorNotSynced
+		}
+		return "", mappingNotFound(key)
+	}
+	m.logger.DebugContext(ctx, "ID mapping not in KV mirror, falling back to lookup", "key", key)
+	return fallback(ctx, id)
+}
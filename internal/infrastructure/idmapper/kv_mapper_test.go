@@ -0,0 +1,217 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package idmapper
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupMappingsBucket starts an in-process JetStream server with an empty mappings bucket
+func setupMappingsBucket(t *testing.T) jetstream.KeyValue {
+	t.Helper()
+
+	ns, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      -1,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+	})
+	require.NoError(t, err)
+	go ns.Start()
+	if !ns.ReadyForConnections(4 * time.Second) {
+		t.Fatal("NATS server not ready")
+	}
+
+	nc, err := nats.Connect(ns.ClientURL())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		nc.Close()
+		ns.Shutdown()
+	})
+
+	js, err := jetstream.New(nc)
+	require.NoError(t, err)
+	kv, err := js.CreateKeyValue(context.Background(), jetstream.KeyValueConfig{Bucket: defaultMappingsBucket})
+	require.NoError(t, err)
+	return kv
+}
+
+func newSyncedKVMapper(t *testing.T, kv jetstream.KeyValue, fallback domain.IDMapper) *KVMapper {
+	t.Helper()
+	m, err := newKVMapper(context.Background(), kv, fallback, slog.Default())
+	require.NoError(t, err)
+	t.Cleanup(m.Close)
+
+	select {
+	case <-m.Synced():
+	case <-time.After(4 * time.Second):
+		t.Fatal("mirror did not sync")
+	}
+	return m
+}
+
+func TestKVMapper_ResolvesFromMirror(t *testing.T) {
+	kv := setupMappingsBucket(t)
+	ctx := context.Background()
+	for key, value := range map[string]string{
+		"project.uid.p-v2":    "p-v1",
+		"project.sfid.p-v1":   "p-v2",
+		"committee.uid.c-v2":  "p-v1:c-v1",
+		"committee.sfid.c-v1": "c-v2",
+		"survey.s-1":          "1",
+	} {
+		_, err := kv.PutString(ctx, key, value)
+		require.NoError(t, err)
+	}
+
+	fallback := &countingMapper{}
+	m := newSyncedKVMapper(t, kv, fallback)
+
+	got, err := m.MapProjectV2ToV1(ctx, "p-v2")
+	require.NoError(t, err)
+	assert.Equal(t, "p-v1", got)
+
+	got, err = m.MapProjectV1ToV2(ctx, "p-v1")
+	require.NoError(t, err)
+	assert.Equal(t, "p-v2", got)
+
+	got, err = m.MapCommitteeV2ToV1(ctx, "c-v2")
+	require.NoError(t, err)
+	assert.Equal(t, "c-v1", got)
+
+	got, err = m.MapCommitteeV1ToV2(ctx, "c-v1")
+	require.NoError(t, err)
+	assert.Equal(t, "c-v2", got)
+
+	assert.Zero(t, fallback.calls.Load())
+	assert.Equal(t, 4, m.size(), "non project/committee keys are not mirrored")
+}
+
+func TestKVMapper_FollowsUpdates(t *testing.T) {
+	kv := setupMappingsBucket(t)
+	ctx := context.Background()
+	m := newSyncedKVMapper(t, kv, nil)
+
+	_, err := m.MapProjectV1ToV2(ctx, "p-v1")
+	assert.Equal(t, domain.ErrorTypeValidation, domain.GetErrorType(err))
+
+	_, err = kv.PutString(ctx, "project.sfid.p-v1", "p-v2")
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		got, err := m.MapProjectV1ToV2(ctx, "p-v1")
+		return err == nil && got == "p-v2"
+	}, 4*time.Second, 10*time.Millisecond)
+
+	// Tombstoned and deleted keys drop out of the mirror.
+	_, err = kv.PutString(ctx, "project.sfid.p-v1", mappingTombstone)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		_, ok := m.get("project.sfid.p-v1")
+		return !ok
+	}, 4*time.Second, 10*time.Millisecond)
+
+	_, err = kv.PutString(ctx, "committee.sfid.c-v1", "c-v2")
+	require.NoError(t, err)
+	require.NoError(t, kv.Delete(ctx, "committee.sfid.c-v1"))
+	require.Eventually(t, func() bool {
+		_, ok := m.get("committee.sfid.c-v1")
+		return !ok && m.size() == 0
+	}, 4*time.Second, 10*time.Millisecond)
+}
+
+func TestKVMapper_FallsBackOnMiss(t *testing.T) {
+	kv := setupMappingsBucket(t)
+	ctx := context.Background()
+	fallback := &countingMapper{mappings: map[string]string{"c-v2": "c-v1"}}
+	m := newSyncedKVMapper(t, kv, fallback)
+
+	got, err := m.MapCommitteeV2ToV1(ctx, "c-v2")
+	require.NoError(t, err)
+	assert.Equal(t, "c-v1", got)
+	assert.Equal(t, int32(1), fallback.calls.Load())
+
+	got2, err := m.MapCommitteesV2ToV1(ctx, []string{"c-v2", "unknown"})
+	assert.Equal(t, map[string]string{"c-v2": "c-v1"}, got2)
+	var batchErr *domain.BatchMappingError
+	require.ErrorAs(t, err, &batchErr)
+	assert.Equal(t, []string{"unknown"}, batchErr.FailedIDs())
+	assert.Equal(t, int32(1), fallback.batches.Load(), "misses go to the fallback as one batch")
+}
+
+func TestKVMapper_BatchResolvesFromMirror(t *testing.T) {
+	kv := setupMappingsBucket(t)
+	ctx := context.Background()
+	_, err := kv.PutString(ctx, "committee.uid.c-v2", "p-v1:c-v1")
+	require.NoError(t, err)
+
+	fallback := &countingMapper{mappings: map[string]string{"other-v2": "other-v1"}}
+	m := newSyncedKVMapper(t, kv, fallback)
+
+	got, err := m.MapCommitteesV2ToV1(ctx, []string{"c-v2", "other-v2", "c-v2"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"c-v2": "c-v1", "other-v2": "other-v1"}, got)
+	assert.Equal(t, int32(1), fallback.calls.Load(), "only the miss is looked up")
+}
+
+func TestKVMapper_RequiresID(t *testing.T) {
+	kv := setupMappingsBucket(t)
+	m := newSyncedKVMapper(t, kv, nil)
+
+	_, err := m.MapCommitteeV1ToV2(context.Background(), "")
+	assert.Equal(t, domain.ErrorTypeValidation, domain.GetErrorType(err))
+}
+
+func TestKVMapper_FallsBackUntilResynced(t *testing.T) {
+	kv := setupMappingsBucket(t)
+	ctx := context.Background()
+	_, err := kv.PutString(ctx, "project.uid.p-v2", "p-v1")
+	require.NoError(t, err)
+
+	fallback := &countingMapper{mappings: map[string]string{"p-v2": "from-fallback"}}
+	m := newSyncedKVMapper(t, kv, fallback)
+
+	// The watch closed: nothing is served from the mirror until it has re-synced.
+	m.reset()
+	got, err := m.MapProjectV2ToV1(ctx, "p-v2")
+	require.NoError(t, err)
+	assert.Equal(t, "from-fallback", got)
+
+	batch, err := m.MapProjectsV2ToV1(ctx, []string{"p-v2"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"p-v2": "from-fallback"}, batch)
+	assert.Equal(t, int32(2), fallback.calls.Load())
+
+	entry, err := kv.Get(ctx, "project.uid.p-v2")
+	require.NoError(t, err)
+	m.apply(entry)
+	m.markSynced()
+
+	got, err = m.MapProjectV2ToV1(ctx, "p-v2")
+	require.NoError(t, err)
+	assert.Equal(t, "p-v1", got)
+	assert.Equal(t, int32(2), fallback.calls.Load())
+}
+
+func TestKVMapper_UnsyncedWithoutFallbackIsUnavailable(t *testing.T) {
+	kv := setupMappingsBucket(t)
+	ctx := context.Background()
+	m := newSyncedKVMapper(t, kv, nil)
+
+	m.reset()
+	_, err := m.MapProjectV1ToV2(ctx, "p-v1")
+	assert.Equal(t, domain.ErrorTypeUnavailable, domain.GetErrorType(err))
+
+	_, err = m.MapProjectsV1ToV2(ctx, []string{"p-v1"})
+	assert.Equal(t, domain.ErrorTypeUnavailable, domain.GetErrorType(err))
+}
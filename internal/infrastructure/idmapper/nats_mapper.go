@@ -11,6 +11,7 @@ import (
 
 	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
 	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
@@ -29,11 +30,44 @@ const (
 type Config struct {
 	URL     string
 	Timeout time.Duration
+	// Bucket is the v1-mappings KV bucket read by the batch methods
+	Bucket string
 }
 
-// NATSMapper implements IDMapper using NATS messaging to the v1-sync-helper service
+// Mapping keys. The v1-sync-helper lookup subject accepts the same keys it stores
+// in the v1-mappings KV bucket, so they are shared by NATSMapper and KVMapper.
+
+func projectUIDKey(v2UID string) string     { return "project.uid." + v2UID }
+func projectSFIDKey(v1SFID string) string   { return "project.sfid." + v1SFID }
+func committeeUIDKey(v2UID string) string   { return "committee.uid." + v2UID }
+func committeeSFIDKey(v1SFID string) string { return "committee.sfid." + v1SFID }
+
+// committeeSFIDFromMapping extracts the committee SFID from a committee.uid mapping value
+// Format: "projectSFID:committeeSFID" -> we want "committeeSFID"
+// If no colon present, assume the value is already just the committee SFID
+func committeeSFIDFromMapping(value string) (string, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) == 1 {
+		return value, nil
+	}
+
+	if len(parts) != 2 {
+		return "", domain.NewUnavailableError(fmt.Sprintf("unexpected committee mapping format: %s", value))
+	}
+
+	committeeSFID := parts[1]
+	if committeeSFID == "" {
+		return "", domain.NewUnavailableError("committee SFID is empty in mapping response")
+	}
+
+	return committeeSFID, nil
+}
+
+// NATSMapper implements IDMapper using NATS messaging to the v1-sync-helper service.
+// Single lookups are request/reply; batches read the v1-mappings bucket directly.
 type NATSMapper struct {
 	conn    *nats.Conn
+	kv      jetstream.KeyValue
 	timeout time.Duration
 }
 
@@ -47,6 +81,10 @@ func NewNATSMapper(cfg Config) (*NATSMapper, error) {
 	if timeout == 0 {
 		timeout = defaultTimeout
 	}
+	bucket := cfg.Bucket
+	if bucket == "" {
+		bucket = defaultMappingsBucket
+	}
 
 	// Connect to NATS server
 	conn, err := nats.Connect(cfg.URL)
@@ -54,8 +92,23 @@ func NewNATSMapper(cfg Config) (*NATSMapper, error) {
 		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
 	}
 
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to create JetStream context: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	kv, err := js.KeyValue(ctx, bucket)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to access %s KV bucket: %w", bucket, err)
+	}
+
 	return &NATSMapper{
 		conn:    conn,
+		kv:      kv,
 		timeout: timeout,
 	}, nil
 }
@@ -74,8 +127,7 @@ func (m *NATSMapper) MapProjectV2ToV1(ctx context.Context, v2UID string) (string
 	}
 
 	// Request format: project.uid.{v2_uuid} returns {v1_sfid}
-	key := fmt.Sprintf("project.uid.%s", v2UID)
-	return m.lookup(ctx, key)
+	return m.lookup(ctx, projectUIDKey(v2UID))
 }
 
 // MapProjectV1ToV2 maps a v1 project SFID to v2 project UID
@@ -85,8 +137,7 @@ func (m *NATSMapper) MapProjectV1ToV2(ctx context.Context, v1SFID string) (strin
 	}
 
 	// Request format: project.sfid.{v1_sfid} returns {v2_uuid}
-	key := fmt.Sprintf("project.sfid.%s", v1SFID)
-	return m.lookup(ctx, key)
+	return m.lookup(ctx, projectSFIDKey(v1SFID))
 }
 
 // MapCommitteeV2ToV1 maps a v2 committee UID to v1 committee SFID
@@ -97,30 +148,12 @@ func (m *NATSMapper) MapCommitteeV2ToV1(ctx context.Context, v2UID string) (stri
 	}
 
 	// Request format: committee.uid.{v2_uuid} returns {project_sfid}:{committee_sfid}
-	key := fmt.Sprintf("committee.uid.%s", v2UID)
-	response, err := m.lookup(ctx, key)
+	response, err := m.lookup(ctx, committeeUIDKey(v2UID))
 	if err != nil {
 		return "", err
 	}
 
-	// Parse the response to extract only the committee SFID
-	// Format: "projectSFID:committeeSFID" -> we want "committeeSFID"
-	// If no colon present, assume the response is already just the committee SFID
-	parts := strings.Split(response, ":")
-	if len(parts) == 1 {
-		return response, nil
-	}
-
-	if len(parts) != 2 {
-		return "", domain.NewUnavailableError(fmt.Sprintf("unexpected committee mapping format: %s", response))
-	}
-
-	committeeSFID := parts[1]
-	if committeeSFID == "" {
-		return "", domain.NewUnavailableError("committee SFID is empty in mapping response")
-	}
-
-	return committeeSFID, nil
+	return committeeSFIDFromMapping(response)
 }
 
 // MapCommitteeV1ToV2 maps a v1 committee SFID to v2 committee UID
@@ -130,8 +163,58 @@ func (m *NATSMapper) MapCommitteeV1ToV2(ctx context.Context, v1SFID string) (str
 	}
 
 	// Request format: committee.sfid.{v1_sfid} returns {v2_uuid}
-	key := fmt.Sprintf("committee.sfid.%s", v1SFID)
-	return m.lookup(ctx, key)
+	return m.lookup(ctx, committeeSFIDKey(v1SFID))
+}
+
+// The v1-sync-helper lookup protocol is single-key, so the batch methods below read
+// the keys it serves straight from the v1-mappings bucket in one pass.
+
+// MapProjectsV2ToV1 maps v2 project UIDs to v1 project SFIDs
+func (m *NATSMapper) MapProjectsV2ToV1(ctx context.Context, v2UIDs []string) (map[string]string, error) {
+	return m.readBatch(ctx, v2UIDs, projectUIDKey, identity)
+}
+
+// MapProjectsV1ToV2 maps v1 project SFIDs to v2 project UIDs
+func (m *NATSMapper) MapProjectsV1ToV2(ctx context.Context, v1SFIDs []string) (map[string]string, error) {
+	return m.readBatch(ctx, v1SFIDs, projectSFIDKey, identity)
+}
+
+// MapCommitteesV2ToV1 maps v2 committee UIDs to v1 committee SFIDs
+func (m *NATSMapper) MapCommitteesV2ToV1(ctx context.Context, v2UIDs []string) (map[string]string, error) {
+	return m.readBatch(ctx, v2UIDs, committeeUIDKey, committeeSFIDFromMapping)
+}
+
+// MapCommitteesV1ToV2 maps v1 committee SFIDs to v2 committee UIDs
+func (m *NATSMapper) MapCommitteesV1ToV2(ctx context.Context, v1SFIDs []string) (map[string]string, error) {
+	return m.readBatch(ctx, v1SFIDs, committeeSFIDKey, identity)
+}
+
+// readBatch resolves ids with a single read of their mapping keys
+func (m *NATSMapper) readBatch(ctx context.Context, ids []string, key func(string) string, decode func(string) (string, error)) (map[string]string, error) {
+	ids = uniqueIDs(ids)
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if k := key(id); validMappingKey(k) {
+			keys = append(keys, k)
+		}
+	}
+
+	var values map[string]string
+	if len(keys) > 0 {
+		ctx, cancel := context.WithTimeout(ctx, m.timeout)
+		defer cancel()
+		var err error
+		if values, err = readMappings(ctx, m.kv, keys); err != nil {
+			return map[string]string{}, err
+		}
+	}
+
+	b := resolveBatch(ids, key, decode, func(k string) (string, bool) {
+		value, ok := values[k]
+		return value, ok
+	})
+	b.failMissing(key)
+	return b.result, b.err()
 }
 
 // lookup performs the NATS request/reply lookup
@@ -176,7 +259,7 @@ func (m *NATSMapper) lookup(ctx context.Context, key string) (string, error) {
 	if response == "" {
 		span.RecordError(fmt.Errorf("mapping not found for %s", key))
 		span.SetStatus(codes.Error, "mapping not found")
-		return "", domain.NewValidationError(fmt.Sprintf("invalid ID: mapping not found for %s", key))
+		return "", mappingNotFound(key)
 	}
 
 	span.SetStatus(codes.Ok, "")
@@ -38,3 +38,32 @@ func (m *NoOpMapper) MapCommitteeV2ToV1(ctx context.Context, v2UID string) (stri
 func (m *NoOpMapper) MapCommitteeV1ToV2(ctx context.Context, v1SFID string) (string, error) {
 	return v1SFID, nil
 }
+
+// MapProjectsV2ToV1 returns each input ID mapped to itself
+func (m *NoOpMapper) MapProjectsV2ToV1(ctx context.Context, v2UIDs []string) (map[string]string, error) {
+	return identityMap(v2UIDs), nil
+}
+
+// MapProjectsV1ToV2 returns each input ID mapped to itself
+func (m *NoOpMapper) MapProjectsV1ToV2(ctx context.Context, v1SFIDs []string) (map[string]string, error) {
+	return identityMap(v1SFIDs), nil
+}
+
+// MapCommitteesV2ToV1 returns each input ID mapped to itself
+func (m *NoOpMapper) MapCommitteesV2ToV1(ctx context.Context, v2UIDs []string) (map[string]string, error) {
+	return identityMap(v2UIDs), nil
+}
+
+// MapCommitteesV1ToV2 returns each input ID mapped to itself
+func (m *NoOpMapper) MapCommitteesV1ToV2(ctx context.Context, v1SFIDs []string) (map[string]string, error) {
+	return identityMap(v1SFIDs), nil
+}
+
+func identityMap(ids []string) map[string]string {
+	ids = uniqueIDs(ids)
+	result := make(map[string]string, len(ids))
+	for _, id := range ids {
+		result[id] = id
+	}
+	return result
+}
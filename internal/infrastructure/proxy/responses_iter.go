@@ -0,0 +1,109 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package proxy
+
+import (
+	"context"
+	"iter"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/pkg/models/itx"
+)
+
+// responsesPrefetchPages is the number of fetched pages that may be buffered
+// ahead of the consumer while it is still ranging over the current page.
+const responsesPrefetchPages = 1
+
+// listResponsesFunc fetches a single page of survey responses.
+type listResponsesFunc func(ctx context.Context, surveyID string, params *itx.ListResponsesParams) (*itx.PaginatedSurveyResponses, error)
+
+// responsesPage is a fetched page (or the error that stopped pagination)
+// handed from the prefetching goroutine to the iterator.
+type responsesPage struct {
+	data []itx.SurveyRecipientResponse
+	err  error
+}
+
+// ResponsesIter returns an iterator over every response of a survey, following
+// ITX page tokens until the last page. The next page is fetched in the
+// background while the caller consumes the current one. Iteration ends after
+// the first error (yielded once with a zero response), when the caller stops
+// ranging, or when ctx is cancelled.
+func (c *Client) ResponsesIter(ctx context.Context, surveyID string, params *itx.ListResponsesParams) iter.Seq2[itx.SurveyRecipientResponse, error] {
+	return responsesIter(ctx, c.ListResponses, surveyID, params)
+}
+
+func responsesIter(ctx context.Context, list listResponsesFunc, surveyID string, params *itx.ListResponsesParams) iter.Seq2[itx.SurveyRecipientResponse, error] {
+	return func(yield func(itx.SurveyRecipientResponse, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		pages := make(chan responsesPage, responsesPrefetchPages)
+		go fetchResponsePages(ctx, list, surveyID, params, pages)
+
+		for {
+			var page responsesPage
+			var ok bool
+			select {
+			case <-ctx.Done():
+				yield(itx.SurveyRecipientResponse{}, ctx.Err())
+				return
+			case page, ok = <-pages:
+			}
+			if !ok {
+				return
+			}
+			if page.err != nil {
+				yield(itx.SurveyRecipientResponse{}, page.err)
+				return
+			}
+			for _, resp := range page.data {
+				if ctx.Err() != nil {
+					yield(itx.SurveyRecipientResponse{}, ctx.Err())
+					return
+				}
+				if !yield(resp, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// fetchResponsePages walks the page tokens and sends each page to out. It
+// closes out after the last page, after sending an error, or once ctx is done.
+func fetchResponsePages(ctx context.Context, list listResponsesFunc, surveyID string, params *itx.ListResponsesParams, out chan<- responsesPage) {
+	defer close(out)
+
+	// Copy so advancing the page token never mutates the caller's params.
+	var pageParams itx.ListResponsesParams
+	if params != nil {
+		pageParams = *params
+	}
+
+	for {
+		result, err := list(ctx, surveyID, &pageParams)
+		var page responsesPage
+		if err != nil {
+			page.err = err
+		} else if result != nil {
+			page.data = result.Data
+		}
+
+		select {
+		case out <- page:
+		case <-ctx.Done():
+			return
+		}
+
+		if err != nil || result == nil || result.Meta.PageToken == "" {
+			return
+		}
+		// Guard against an upstream that keeps returning the same token.
+		if pageParams.PageToken != nil && *pageParams.PageToken == result.Meta.PageToken {
+			return
+		}
+		next := result.Meta.PageToken
+		pageParams.PageToken = &next
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/pkg/models/itx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubPages serves canned pages keyed by the requested page token ("" for the first page).
+type stubPages struct {
+	mu     sync.Mutex
+	pages  map[string]*itx.PaginatedSurveyResponses
+	errs   map[string]error
+	tokens []string
+}
+
+func (s *stubPages) list(_ context.Context, _ string, params *itx.ListResponsesParams) (*itx.PaginatedSurveyResponses, error) {
+	token := ""
+	if params != nil && params.PageToken != nil {
+		token = *params.PageToken
+	}
+	s.mu.Lock()
+	s.tokens = append(s.tokens, token)
+	s.mu.Unlock()
+	if err := s.errs[token]; err != nil {
+		return nil, err
+	}
+	return s.pages[token], nil
+}
+
+func page(next string, ids ...string) *itx.PaginatedSurveyResponses {
+	p := &itx.PaginatedSurveyResponses{Meta: itx.PageMetadata{PageToken: next}}
+	for _, id := range ids {
+		p.Data = append(p.Data, itx.SurveyRecipientResponse{ID: id})
+	}
+	return p
+}
+
+func TestResponsesIter(t *testing.T) {
+	errUpstream := errors.New("upstream failure")
+
+	tests := []struct {
+		name    string
+		stub    *stubPages
+		limit   int
+		wantIDs []string
+		wantErr error
+	}{
+		{
+			name: "single page",
+			stub: &stubPages{pages: map[string]*itx.PaginatedSurveyResponses{
+				"": page("", "r1", "r2"),
+			}},
+			wantIDs: []string{"r1", "r2"},
+		},
+		{
+			name: "follows page tokens across pages",
+			stub: &stubPages{pages: map[string]*itx.PaginatedSurveyResponses{
+				"":   page("t2", "r1"),
+				"t2": page("t3", "r2", "r3"),
+				"t3": page("", "r4"),
+			}},
+			wantIDs: []string{"r1", "r2", "r3", "r4"},
+		},
+		{
+			name: "stops when upstream repeats the same token",
+			stub: &stubPages{pages: map[string]*itx.PaginatedSurveyResponses{
+				"":   page("t2", "r1"),
+				"t2": page("t2", "r2"),
+			}},
+			wantIDs: []string{"r1", "r2"},
+		},
+		{
+			name: "yields error from a later page",
+			stub: &stubPages{
+				pages: map[string]*itx.PaginatedSurveyResponses{"": page("t2", "r1")},
+				errs:  map[string]error{"t2": errUpstream},
+			},
+			wantIDs: []string{"r1"},
+			wantErr: errUpstream,
+		},
+		{
+			name: "caller can stop early",
+			stub: &stubPages{pages: map[string]*itx.PaginatedSurveyResponses{
+				"":   page("t2", "r1", "r2"),
+				"t2": page("", "r3"),
+			}},
+			limit:   1,
+			wantIDs: []string{"r1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotIDs []string
+			var gotErr error
+			for resp, err := range responsesIter(context.Background(), tt.stub.list, "survey-1", nil) {
+				if err != nil {
+					gotErr = err
+					break
+				}
+				gotIDs = append(gotIDs, resp.ID)
+				if tt.limit > 0 && len(gotIDs) == tt.limit {
+					break
+				}
+			}
+
+			assert.Equal(t, tt.wantIDs, gotIDs)
+			assert.ErrorIs(t, gotErr, tt.wantErr)
+		})
+	}
+}
+
+func TestResponsesIter_DoesNotMutateParams(t *testing.T) {
+	stub := &stubPages{pages: map[string]*itx.PaginatedSurveyResponses{
+		"":   page("t2", "r1"),
+		"t2": page("", "r2"),
+	}}
+	perPage := "50"
+	params := &itx.ListResponsesParams{PerPage: &perPage}
+
+	for _, err := range responsesIter(context.Background(), stub.list, "survey-1", params) {
+		require.NoError(t, err)
+	}
+
+	assert.Nil(t, params.PageToken)
+	assert.Equal(t, []string{"", "t2"}, stub.tokens)
+}
+
+func TestResponsesIter_ContextCancelled(t *testing.T) {
+	stub := &stubPages{pages: map[string]*itx.PaginatedSurveyResponses{
+		"":   page("t2", "r1", "r2"),
+		"t2": page("", "r3"),
+	}}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var gotIDs []string
+	var gotErr error
+	for resp, err := range responsesIter(ctx, stub.list, "survey-1", nil) {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		gotIDs = append(gotIDs, resp.ID)
+		cancel()
+	}
+
+	assert.Equal(t, []string{"r1"}, gotIDs)
+	assert.ErrorIs(t, gotErr, context.Canceled)
+}
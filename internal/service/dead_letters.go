@@ -0,0 +1,137 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/gen/survey"
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+)
+
+// ListDeadLetters implements survey.Service.ListDeadLetters
+func (s *SurveyService) ListDeadLetters(ctx context.Context, p *survey.ListDeadLettersPayload) (*survey.DeadLetterList, error) {
+	principal, err := s.parsePrincipal(ctx, p.Token)
+	if err != nil {
+		return nil, err
+	}
+	if s.deadLetters == nil {
+		return nil, mapDomainError(errEventProcessingDisabled("dead letters are unavailable"))
+	}
+
+	filter := domain.DeadLetterFilter{Limit: p.Limit}
+	if p.KeyPrefix != nil {
+		filter.KeyPrefix = *p.KeyPrefix
+	}
+	if p.After != nil {
+		filter.AfterSequence = *p.After
+	}
+
+	s.logger.InfoContext(ctx, "listing dead letters",
+		"principal", principal,
+		"key_prefix", filter.KeyPrefix,
+		"after", filter.AfterSequence,
+		"limit", filter.Limit,
+	)
+
+	entries, err := s.deadLetters.List(ctx, filter)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list dead letters", "error", err)
+		return nil, mapDomainError(err)
+	}
+
+	result := &survey.DeadLetterList{Entries: make([]*survey.DeadLetterEntry, 0, len(entries))}
+	for _, e := range entries {
+		item := mapDeadLetterToResult(e)
+		// Payloads can be large; they are only returned by get_dead_letter.
+		item.Payload = nil
+		item.Headers = nil
+		result.Entries = append(result.Entries, item)
+	}
+	if len(entries) > 0 && len(entries) == p.Limit {
+		next := entries[len(entries)-1].Sequence
+		result.NextAfter = &next
+	}
+	return result, nil
+}
+
+// GetDeadLetter implements survey.Service.GetDeadLetter
+func (s *SurveyService) GetDeadLetter(ctx context.Context, p *survey.GetDeadLetterPayload) (*survey.DeadLetterEntry, error) {
+	principal, err := s.parsePrincipal(ctx, p.Token)
+	if err != nil {
+		return nil, err
+	}
+	if s.deadLetters == nil {
+		return nil, mapDomainError(errEventProcessingDisabled("dead letters are unavailable"))
+	}
+
+	s.logger.InfoContext(ctx, "getting dead letter",
+		"principal", principal,
+		"sequence", p.Sequence,
+	)
+
+	entry, err := s.deadLetters.Get(ctx, p.Sequence)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get dead letter", "sequence", p.Sequence, "error", err)
+		return nil, mapDomainError(err)
+	}
+	return mapDeadLetterToResult(*entry), nil
+}
+
+// ReplayDeadLetter implements survey.Service.ReplayDeadLetter
+func (s *SurveyService) ReplayDeadLetter(ctx context.Context, p *survey.ReplayDeadLetterPayload) (*survey.DeadLetterReplayResult, error) {
+	principal, err := s.parsePrincipal(ctx, p.Token)
+	if err != nil {
+		return nil, err
+	}
+	if s.deadLetters == nil {
+		return nil, mapDomainError(errEventProcessingDisabled("dead letters are unavailable"))
+	}
+
+	s.logger.InfoContext(ctx, "replaying dead letter",
+		"principal", principal,
+		"sequence", p.Sequence,
+	)
+
+	replay, err := s.deadLetters.Replay(ctx, p.Sequence)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to replay dead letter", "sequence", p.Sequence, "error", err)
+		return nil, mapDomainError(err)
+	}
+
+	result := &survey.DeadLetterReplayResult{
+		Sequence:  replay.Sequence,
+		Key:       replay.Key,
+		Outcome:   string(replay.Outcome),
+		Succeeded: replay.Succeeded,
+	}
+	if replay.Error != "" {
+		result.Error = &replay.Error
+	}
+	return result, nil
+}
+
+// errEventProcessingDisabled reports that a feature backed by the event processor is off
+func errEventProcessingDisabled(unavailable string) error {
+	return domain.NewUnavailableError(unavailable + ": event processing is disabled")
+}
+
+func mapDeadLetterToResult(e domain.DeadLetter) *survey.DeadLetterEntry {
+	result := &survey.DeadLetterEntry{
+		Sequence:       e.Sequence,
+		Key:            e.Key,
+		Operation:      e.Operation,
+		Reason:         string(e.Reason),
+		Error:          e.Error,
+		Attempts:       e.Attempts,
+		DeadLetteredAt: e.DeadLetteredAt.UTC().Format(time.RFC3339),
+		Payload:        e.Payload,
+		Headers:        e.Headers,
+	}
+	if e.Revision != 0 {
+		result.Revision = &e.Revision
+	}
+	return result
+}
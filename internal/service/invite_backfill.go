@@ -0,0 +1,106 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/gen/survey"
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+)
+
+// StartInviteBackfill implements survey.Service.StartInviteBackfill
+func (s *SurveyService) StartInviteBackfill(ctx context.Context, p *survey.StartInviteBackfillPayload) (*survey.InviteBackfillStatus, error) {
+	principal, err := s.parsePrincipal(ctx, p.Token)
+	if err != nil {
+		return nil, err
+	}
+	if s.backfiller == nil {
+		return nil, mapDomainError(errEventProcessingDisabled("invite backfill is unavailable"))
+	}
+
+	opts := domain.InviteBackfillOptions{
+		SurveyUIDs:    p.SurveyUids,
+		DryRun:        p.DryRun,
+		RatePerSecond: p.RatePerSecond,
+	}
+
+	s.logger.InfoContext(ctx, "starting invite backfill",
+		"principal", principal,
+		"survey_uids", opts.SurveyUIDs,
+		"dry_run", opts.DryRun,
+		"rate_per_second", opts.RatePerSecond,
+	)
+
+	status, err := s.backfiller.Start(ctx, opts)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to start invite backfill", "error", err)
+		return nil, mapDomainError(err)
+	}
+	return mapInviteBackfillStatusToResult(status), nil
+}
+
+// GetInviteBackfill implements survey.Service.GetInviteBackfill
+func (s *SurveyService) GetInviteBackfill(ctx context.Context, p *survey.GetInviteBackfillPayload) (*survey.InviteBackfillStatus, error) {
+	if _, err := s.parsePrincipal(ctx, p.Token); err != nil {
+		return nil, err
+	}
+	if s.backfiller == nil {
+		return nil, mapDomainError(errEventProcessingDisabled("invite backfill is unavailable"))
+	}
+
+	status, err := s.backfiller.Status(ctx)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+	return mapInviteBackfillStatusToResult(status), nil
+}
+
+// CancelInviteBackfill implements survey.Service.CancelInviteBackfill
+func (s *SurveyService) CancelInviteBackfill(ctx context.Context, p *survey.CancelInviteBackfillPayload) (*survey.InviteBackfillStatus, error) {
+	principal, err := s.parsePrincipal(ctx, p.Token)
+	if err != nil {
+		return nil, err
+	}
+	if s.backfiller == nil {
+		return nil, mapDomainError(errEventProcessingDisabled("invite backfill is unavailable"))
+	}
+
+	s.logger.InfoContext(ctx, "cancelling invite backfill", "principal", principal)
+
+	status, err := s.backfiller.Cancel(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to cancel invite backfill", "error", err)
+		return nil, mapDomainError(err)
+	}
+	return mapInviteBackfillStatusToResult(status), nil
+}
+
+func mapInviteBackfillStatusToResult(status *domain.InviteBackfillStatus) *survey.InviteBackfillStatus {
+	result := &survey.InviteBackfillStatus{
+		State:                 string(status.State),
+		DryRun:                status.Options.DryRun,
+		SurveyUids:            status.Options.SurveyUIDs,
+		RatePerSecond:         status.Options.RatePerSecond,
+		StartedAt:             status.StartedAt.UTC().Format(time.RFC3339),
+		FinishedAt:            formatReindexTime(status.FinishedAt),
+		Total:                 status.Total,
+		Scanned:               status.Scanned,
+		Candidates:            status.Candidates,
+		Recipients:            status.Recipients,
+		Invited:               status.Invited,
+		Attached:              status.Attached,
+		Failed:                status.Failed,
+		Skipped:               status.Skipped,
+		CandidateResponseUids: status.CandidateResponseUIDs,
+	}
+	if result.Skipped == nil {
+		result.Skipped = map[string]int{}
+	}
+	if status.Error != "" {
+		result.Error = &status.Error
+	}
+	return result
+}
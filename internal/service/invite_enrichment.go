@@ -0,0 +1,45 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/gen/survey"
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+)
+
+// RerunInviteEnrichment implements survey.Service.RerunInviteEnrichment
+func (s *SurveyService) RerunInviteEnrichment(ctx context.Context, p *survey.RerunInviteEnrichmentPayload) (*survey.InviteEnrichmentResult, error) {
+	principal, err := s.parsePrincipal(ctx, p.Token)
+	if err != nil {
+		return nil, err
+	}
+	if s.enricher == nil {
+		return nil, mapDomainError(domain.NewUnavailableError("invite enrichment is unavailable: invites are disabled"))
+	}
+
+	var username string
+	if p.Username != nil {
+		username = *p.Username
+	}
+
+	s.logger.InfoContext(ctx, "re-running invite enrichment",
+		"principal", principal,
+		"email", p.Email,
+		"username", username,
+	)
+
+	result, err := s.enricher.EnrichInvitee(ctx, p.Email, username)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to re-run invite enrichment", "email", p.Email, "error", err)
+		return nil, mapDomainError(err)
+	}
+
+	return &survey.InviteEnrichmentResult{
+		Email:              result.Email,
+		Username:           result.Username,
+		DeadLettersCleared: result.DeadLettersCleared,
+	}, nil
+}
@@ -0,0 +1,155 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/gen/survey"
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+)
+
+// GetSurveyInviteSettings implements survey.Service.GetSurveyInviteSettings
+func (s *SurveyService) GetSurveyInviteSettings(ctx context.Context, p *survey.GetSurveyInviteSettingsPayload) (*survey.InviteSettings, error) {
+	return s.getInviteSettings(ctx, p.Token, domain.InviteSettingsScopeSurvey, p.SurveyUID)
+}
+
+// UpdateSurveyInviteSettings implements survey.Service.UpdateSurveyInviteSettings
+func (s *SurveyService) UpdateSurveyInviteSettings(ctx context.Context, p *survey.UpdateSurveyInviteSettingsPayload) (*survey.InviteSettings, error) {
+	return s.putInviteSettings(ctx, p.Token, &domain.InviteSettings{
+		Scope:               domain.InviteSettingsScopeSurvey,
+		UID:                 p.SurveyUID,
+		Enabled:             p.Enabled,
+		Role:                stringValue(p.Role),
+		ExpirationDays:      intValue(p.ExpirationDays),
+		ReturnURLTemplate:   stringValue(p.ReturnURLTemplate),
+		DisplayNameFallback: stringValue(p.DisplayNameFallback),
+		Locale:              stringValue(p.Locale),
+	})
+}
+
+// DeleteSurveyInviteSettings implements survey.Service.DeleteSurveyInviteSettings
+func (s *SurveyService) DeleteSurveyInviteSettings(ctx context.Context, p *survey.DeleteSurveyInviteSettingsPayload) error {
+	return s.deleteInviteSettings(ctx, p.Token, domain.InviteSettingsScopeSurvey, p.SurveyUID)
+}
+
+// GetProjectInviteSettings implements survey.Service.GetProjectInviteSettings
+func (s *SurveyService) GetProjectInviteSettings(ctx context.Context, p *survey.GetProjectInviteSettingsPayload) (*survey.InviteSettings, error) {
+	return s.getInviteSettings(ctx, p.Token, domain.InviteSettingsScopeProject, p.ProjectUID)
+}
+
+// UpdateProjectInviteSettings implements survey.Service.UpdateProjectInviteSettings
+func (s *SurveyService) UpdateProjectInviteSettings(ctx context.Context, p *survey.UpdateProjectInviteSettingsPayload) (*survey.InviteSettings, error) {
+	return s.putInviteSettings(ctx, p.Token, &domain.InviteSettings{
+		Scope:               domain.InviteSettingsScopeProject,
+		UID:                 p.ProjectUID,
+		Enabled:             p.Enabled,
+		Role:                stringValue(p.Role),
+		ExpirationDays:      intValue(p.ExpirationDays),
+		ReturnURLTemplate:   stringValue(p.ReturnURLTemplate),
+		DisplayNameFallback: stringValue(p.DisplayNameFallback),
+		Locale:              stringValue(p.Locale),
+	})
+}
+
+// DeleteProjectInviteSettings implements survey.Service.DeleteProjectInviteSettings
+func (s *SurveyService) DeleteProjectInviteSettings(ctx context.Context, p *survey.DeleteProjectInviteSettingsPayload) error {
+	return s.deleteInviteSettings(ctx, p.Token, domain.InviteSettingsScopeProject, p.ProjectUID)
+}
+
+func (s *SurveyService) getInviteSettings(ctx context.Context, token *string, scope domain.InviteSettingsScope, uid string) (*survey.InviteSettings, error) {
+	if _, err := s.parsePrincipal(ctx, token); err != nil {
+		return nil, err
+	}
+	if s.inviteSettings == nil {
+		return nil, mapDomainError(errEventProcessingDisabled("invite settings are unavailable"))
+	}
+
+	settings, err := s.inviteSettings.GetInviteSettings(ctx, scope, uid)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+	return mapInviteSettingsToResult(settings), nil
+}
+
+func (s *SurveyService) putInviteSettings(ctx context.Context, token *string, settings *domain.InviteSettings) (*survey.InviteSettings, error) {
+	principal, err := s.parsePrincipal(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if s.inviteSettings == nil {
+		return nil, mapDomainError(errEventProcessingDisabled("invite settings are unavailable"))
+	}
+
+	s.logger.InfoContext(ctx, "updating invite settings",
+		"principal", principal,
+		"scope", settings.Scope,
+		"uid", settings.UID,
+	)
+
+	settings.UpdatedBy = principal
+	stored, err := s.inviteSettings.PutInviteSettings(ctx, settings)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to update invite settings", "scope", settings.Scope, "uid", settings.UID, "error", err)
+		return nil, mapDomainError(err)
+	}
+	return mapInviteSettingsToResult(stored), nil
+}
+
+func (s *SurveyService) deleteInviteSettings(ctx context.Context, token *string, scope domain.InviteSettingsScope, uid string) error {
+	principal, err := s.parsePrincipal(ctx, token)
+	if err != nil {
+		return err
+	}
+	if s.inviteSettings == nil {
+		return mapDomainError(errEventProcessingDisabled("invite settings are unavailable"))
+	}
+
+	s.logger.InfoContext(ctx, "deleting invite settings",
+		"principal", principal,
+		"scope", scope,
+		"uid", uid,
+	)
+
+	if err := s.inviteSettings.DeleteInviteSettings(ctx, scope, uid); err != nil {
+		s.logger.ErrorContext(ctx, "failed to delete invite settings", "scope", scope, "uid", uid, "error", err)
+		return mapDomainError(err)
+	}
+	return nil
+}
+
+func mapInviteSettingsToResult(settings *domain.InviteSettings) *survey.InviteSettings {
+	result := &survey.InviteSettings{
+		Scope:               string(settings.Scope),
+		UID:                 settings.UID,
+		Enabled:             settings.Enabled,
+		Role:                optionalString(settings.Role),
+		ReturnURLTemplate:   optionalString(settings.ReturnURLTemplate),
+		DisplayNameFallback: optionalString(settings.DisplayNameFallback),
+		Locale:              optionalString(settings.Locale),
+		UpdatedAt:           settings.UpdatedAt.UTC().Format(time.RFC3339),
+		UpdatedBy:           optionalString(settings.UpdatedBy),
+	}
+	if settings.ExpirationDays > 0 {
+		result.ExpirationDays = &settings.ExpirationDays
+	}
+	return result
+}
+
+// stringValue returns "" for an omitted optional string
+func stringValue(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
+
+// intValue returns 0 for an omitted optional int
+func intValue(v *int) int {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
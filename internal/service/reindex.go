@@ -0,0 +1,134 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/gen/survey"
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+)
+
+// StartReindex implements survey.Service.StartReindex
+func (s *SurveyService) StartReindex(ctx context.Context, p *survey.StartReindexPayload) (*survey.ReindexStatus, error) {
+	principal, err := s.parsePrincipal(ctx, p.Token)
+	if err != nil {
+		return nil, err
+	}
+	if s.reindexer == nil {
+		return nil, mapDomainError(errEventProcessingDisabled("reindexing is unavailable"))
+	}
+
+	opts := domain.ReindexOptions{
+		KeyPrefixes:   p.KeyPrefixes,
+		DryRun:        p.DryRun,
+		Force:         p.Force,
+		RatePerSecond: p.RatePerSecond,
+	}
+	if opts.ModifiedSince, err = parseReindexTime("modified_since", p.ModifiedSince); err != nil {
+		return nil, mapDomainError(err)
+	}
+	if opts.ModifiedUntil, err = parseReindexTime("modified_until", p.ModifiedUntil); err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	s.logger.InfoContext(ctx, "starting reindex",
+		"principal", principal,
+		"key_prefixes", opts.KeyPrefixes,
+		"modified_since", p.ModifiedSince,
+		"modified_until", p.ModifiedUntil,
+		"dry_run", opts.DryRun,
+		"force", opts.Force,
+		"rate_per_second", opts.RatePerSecond,
+	)
+
+	status, err := s.reindexer.Start(ctx, opts)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to start reindex", "error", err)
+		return nil, mapDomainError(err)
+	}
+	return mapReindexStatusToResult(status), nil
+}
+
+// GetReindex implements survey.Service.GetReindex
+func (s *SurveyService) GetReindex(ctx context.Context, p *survey.GetReindexPayload) (*survey.ReindexStatus, error) {
+	if _, err := s.parsePrincipal(ctx, p.Token); err != nil {
+		return nil, err
+	}
+	if s.reindexer == nil {
+		return nil, mapDomainError(errEventProcessingDisabled("reindexing is unavailable"))
+	}
+
+	status, err := s.reindexer.Status(ctx)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+	return mapReindexStatusToResult(status), nil
+}
+
+// CancelReindex implements survey.Service.CancelReindex
+func (s *SurveyService) CancelReindex(ctx context.Context, p *survey.CancelReindexPayload) (*survey.ReindexStatus, error) {
+	principal, err := s.parsePrincipal(ctx, p.Token)
+	if err != nil {
+		return nil, err
+	}
+	if s.reindexer == nil {
+		return nil, mapDomainError(errEventProcessingDisabled("reindexing is unavailable"))
+	}
+
+	s.logger.InfoContext(ctx, "cancelling reindex", "principal", principal)
+
+	status, err := s.reindexer.Cancel(ctx)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to cancel reindex", "error", err)
+		return nil, mapDomainError(err)
+	}
+	return mapReindexStatusToResult(status), nil
+}
+
+// parseReindexTime parses an optional RFC3339 bound; nil yields the zero time (unbounded)
+func parseReindexTime(field string, value *string) (time.Time, error) {
+	if value == nil || *value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		return time.Time{}, domain.NewValidationError(fmt.Sprintf("%s must be an RFC3339 timestamp", field), err)
+	}
+	return t, nil
+}
+
+func formatReindexTime(t time.Time) *string {
+	if t.IsZero() {
+		return nil
+	}
+	formatted := t.UTC().Format(time.RFC3339)
+	return &formatted
+}
+
+func mapReindexStatusToResult(status *domain.ReindexStatus) *survey.ReindexStatus {
+	result := &survey.ReindexStatus{
+		State:         string(status.State),
+		DryRun:        status.Options.DryRun,
+		Force:         status.Options.Force,
+		KeyPrefixes:   status.Options.KeyPrefixes,
+		ModifiedSince: formatReindexTime(status.Options.ModifiedSince),
+		ModifiedUntil: formatReindexTime(status.Options.ModifiedUntil),
+		RatePerSecond: status.Options.RatePerSecond,
+		StartedAt:     status.StartedAt.UTC().Format(time.RFC3339),
+		FinishedAt:    formatReindexTime(status.FinishedAt),
+		Total:         status.Total,
+		Scanned:       status.Scanned,
+		Matched:       status.Matched,
+		Processed:     status.Processed,
+		Failed:        status.Failed,
+		FailedKeys:    status.FailedKeys,
+	}
+	if status.Error != "" {
+		result.Error = &status.Error
+	}
+	return result
+}
@@ -0,0 +1,296 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/gen/survey"
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-survey-service/pkg/models/itx"
+)
+
+// CreateSurveyResponse submits a survey response after validating its answers
+func (s *SurveyService) CreateSurveyResponse(ctx context.Context, p *survey.CreateSurveyResponsePayload) error {
+	principal, err := s.parsePrincipal(ctx, p.Token)
+	if err != nil {
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "creating survey response",
+		"principal", principal,
+		"survey_uid", p.SurveyUID,
+		"response_id", p.SurveyResponseUID,
+		"answers", len(p.Answers),
+	)
+
+	// The gateway cannot see the response UID in the body, so ownership is checked here:
+	// only the recipient the response was created for may answer it.
+	response, err := s.proxy.GetResponse(ctx, p.SurveyResponseUID)
+	if err != nil {
+		return mapDomainError(err)
+	}
+	if response.SurveyUID != p.SurveyUID {
+		return mapDomainError(domain.NewNotFoundError(fmt.Sprintf("survey response %s not found in survey %s", p.SurveyResponseUID, p.SurveyUID)))
+	}
+	if response.UserName == nil || *response.UserName != principal {
+		s.logger.WarnContext(ctx, "principal does not own survey response",
+			"principal", principal,
+			"response_id", p.SurveyResponseUID,
+		)
+		return mapDomainError(domain.NewForbiddenError(fmt.Sprintf("survey response %s does not belong to the caller", p.SurveyResponseUID)))
+	}
+
+	answers := mapSurveyAnswersToITX(p.Answers)
+	if err := s.validateSurveyAnswers(ctx, p.SurveyUID, answers); err != nil {
+		return mapDomainError(err)
+	}
+
+	err = s.proxy.CreateResponse(ctx, &itx.CreateResponseRequest{
+		SurveyResponseUID: p.SurveyResponseUID,
+		SurveyUID:         p.SurveyUID,
+		Answers:           answers,
+	})
+	if err != nil {
+		return mapDomainError(err)
+	}
+
+	s.logger.InfoContext(ctx, "survey response created successfully",
+		"survey_uid", p.SurveyUID,
+		"response_id", p.SurveyResponseUID,
+	)
+
+	return nil
+}
+
+// UpdateSurveyResponse replaces the answers of a survey response after validating them
+func (s *SurveyService) UpdateSurveyResponse(ctx context.Context, p *survey.UpdateSurveyResponsePayload) error {
+	principal, err := s.parsePrincipal(ctx, p.Token)
+	if err != nil {
+		return err
+	}
+
+	s.logger.InfoContext(ctx, "updating survey response",
+		"principal", principal,
+		"survey_uid", p.SurveyUID,
+		"response_id", p.ResponseID,
+		"answers", len(p.Answers),
+	)
+
+	// The template is looked up through the survey in the path, so make sure the
+	// response belongs to it rather than validating against the wrong questions.
+	response, err := s.proxy.GetResponse(ctx, p.ResponseID)
+	if err != nil {
+		return mapDomainError(err)
+	}
+	if response.SurveyUID != p.SurveyUID {
+		return mapDomainError(domain.NewNotFoundError(fmt.Sprintf("survey response %s not found in survey %s", p.ResponseID, p.SurveyUID)))
+	}
+
+	answers := mapSurveyAnswersToITX(p.Answers)
+	if err := s.validateSurveyAnswers(ctx, p.SurveyUID, answers); err != nil {
+		return mapDomainError(err)
+	}
+
+	if err := s.proxy.UpdateResponse(ctx, p.ResponseID, &itx.UpdateResponseRequest{Answers: answers}); err != nil {
+		return mapDomainError(err)
+	}
+
+	s.logger.InfoContext(ctx, "survey response updated successfully",
+		"survey_uid", p.SurveyUID,
+		"response_id", p.ResponseID,
+	)
+
+	return nil
+}
+
+// validateSurveyAnswers checks answers against the questions of the survey's template. It is
+// skipped when the catalog is not available, when the survey was not scheduled from a
+// template, or when the template record carries no question structure; ITX still applies
+// its own checks in those cases.
+func (s *SurveyService) validateSurveyAnswers(ctx context.Context, surveyUID string, answers []itx.SurveyAnswer) error {
+	if s.templates == nil {
+		return nil
+	}
+
+	surveyResp, err := s.proxy.GetSurvey(ctx, surveyUID, nil)
+	if err != nil {
+		return err
+	}
+	if surveyResp.SurveyMonkeyID == nil || *surveyResp.SurveyMonkeyID == "" {
+		return nil
+	}
+
+	template, err := s.templates.Get(ctx, *surveyResp.SurveyMonkeyID)
+	if domain.GetErrorType(err) == domain.ErrorTypeNotFound {
+		s.logger.WarnContext(ctx, "survey template not in catalog, skipping answer validation",
+			"survey_uid", surveyUID,
+			"survey_monkey_id", *surveyResp.SurveyMonkeyID,
+		)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(template.Pages) == 0 {
+		return nil
+	}
+
+	if questionErrors := checkSurveyAnswers(template.Pages, answers); len(questionErrors) > 0 {
+		return domain.NewValidationError("survey response answers are invalid", &domain.AnswerValidationError{Errors: questionErrors})
+	}
+	return nil
+}
+
+// checkSurveyAnswers returns one error per rejected answer, in answer order, followed by one
+// per required question left unanswered, in template order. The answers are a full set:
+// updates replace every answer, so required questions are checked for both.
+func checkSurveyAnswers(pages []domain.SurveyQuestionPage, answers []itx.SurveyAnswer) []domain.QuestionError {
+	questions := make(map[string]*domain.SurveyQuestion)
+	for i := range pages {
+		for j := range pages[i].Questions {
+			questions[pages[i].Questions[j].ID] = &pages[i].Questions[j]
+		}
+	}
+
+	var questionErrors []domain.QuestionError
+	answered := make(map[string]bool, len(answers))
+	for _, answer := range answers {
+		question, ok := questions[answer.QuestionID]
+		switch {
+		case !ok:
+			questionErrors = append(questionErrors, domain.QuestionError{QuestionID: answer.QuestionID, Message: "question is not part of this survey"})
+			continue
+		case answered[answer.QuestionID]:
+			questionErrors = append(questionErrors, domain.QuestionError{QuestionID: answer.QuestionID, Message: "question is answered more than once"})
+			continue
+		}
+		if !hasAnswerValue(answer) {
+			continue
+		}
+		answered[answer.QuestionID] = true
+		if msg := checkSurveyAnswer(question, answer); msg != "" {
+			questionErrors = append(questionErrors, domain.QuestionError{QuestionID: answer.QuestionID, Message: msg})
+		}
+	}
+
+	for _, page := range pages {
+		for _, question := range page.Questions {
+			if question.Required && !answered[question.ID] {
+				questionErrors = append(questionErrors, domain.QuestionError{QuestionID: question.ID, Message: "an answer is required"})
+			}
+		}
+	}
+	return questionErrors
+}
+
+func hasAnswerValue(answer itx.SurveyAnswer) bool {
+	return (answer.AnswerText != nil && strings.TrimSpace(*answer.AnswerText) != "") ||
+		len(answer.ChoiceIDs) > 0 || answer.RatingValue != nil || answer.YesNoValue != nil
+}
+
+// checkSurveyAnswer checks one answer against its question and returns why it is rejected,
+// or "" when it is valid. Each question family takes exactly one kind of value.
+func checkSurveyAnswer(question *domain.SurveyQuestion, answer itx.SurveyAnswer) string {
+	hasText := answer.AnswerText != nil && strings.TrimSpace(*answer.AnswerText) != ""
+	hasChoices := len(answer.ChoiceIDs) > 0
+	hasRating := answer.RatingValue != nil
+	hasYesNo := answer.YesNoValue != nil
+
+	switch {
+	case question.Family == domain.QuestionFamilyPresentation:
+		return "question does not accept answers"
+
+	case question.Family == domain.QuestionFamilyMatrix && question.Subtype == domain.QuestionSubtypeRating:
+		if hasText || hasChoices || hasYesNo {
+			return "rating question expects rating_value"
+		}
+		if len(question.Choices) == 0 {
+			return "" // no choices to derive a range from
+		}
+		if *answer.RatingValue < question.RatingMin || *answer.RatingValue > question.RatingMax {
+			return fmt.Sprintf("rating %d is outside the range %d-%d", *answer.RatingValue, question.RatingMin, question.RatingMax)
+		}
+		return ""
+
+	case question.Family == domain.QuestionFamilySingleChoice:
+		if hasYesNo && !hasText && !hasChoices && !hasRating {
+			if len(question.Choices) != 2 {
+				return "yes_no_value is only accepted for questions with two choices"
+			}
+			return ""
+		}
+		if hasText || hasRating || hasYesNo {
+			return "single choice question expects choice_ids"
+		}
+		if len(answer.ChoiceIDs) != 1 {
+			return "single choice question accepts exactly one choice"
+		}
+		return checkChoiceIDs(question, answer.ChoiceIDs)
+
+	case question.Family == domain.QuestionFamilyMultipleChoice || question.Family == domain.QuestionFamilyMatrix:
+		if hasText || hasRating || hasYesNo {
+			return question.Family + " question expects choice_ids"
+		}
+		return checkChoiceIDs(question, answer.ChoiceIDs)
+
+	case question.Family == domain.QuestionFamilyOpenEnded || question.Family == domain.QuestionFamilyDemographic || question.Family == domain.QuestionFamilyDatetime:
+		if hasChoices || hasRating || hasYesNo {
+			return question.Family + " question expects answer_text"
+		}
+		if question.Subtype == domain.QuestionSubtypeNumerical {
+			if _, err := strconv.ParseFloat(strings.TrimSpace(*answer.AnswerText), 64); err != nil {
+				return "numerical question expects a number"
+			}
+		}
+		return ""
+
+	default:
+		// Unknown families are left to ITX
+		return ""
+	}
+}
+
+// checkChoiceIDs rejects choice IDs that are not options of the question or are repeated
+func checkChoiceIDs(question *domain.SurveyQuestion, choiceIDs []string) string {
+	seen := make(map[string]bool, len(choiceIDs))
+	for _, id := range choiceIDs {
+		if seen[id] {
+			return fmt.Sprintf("choice %s is selected more than once", id)
+		}
+		seen[id] = true
+
+		valid := false
+		for _, choice := range question.Choices {
+			if choice.ID == id {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return fmt.Sprintf("choice %s is not an option for this question", id)
+		}
+	}
+	return ""
+}
+
+func mapSurveyAnswersToITX(answers []*survey.SurveyAnswer) []itx.SurveyAnswer {
+	result := make([]itx.SurveyAnswer, 0, len(answers))
+	for _, a := range answers {
+		if a == nil {
+			continue
+		}
+		result = append(result, itx.SurveyAnswer{
+			QuestionID:  a.QuestionID,
+			AnswerText:  a.AnswerText,
+			ChoiceIDs:   a.ChoiceIds,
+			RatingValue: a.RatingValue,
+			YesNoValue:  a.YesNoValue,
+		})
+	}
+	return result
+}
@@ -0,0 +1,98 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/gen/survey"
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+)
+
+// ListSurveyInvites implements survey.Service.ListSurveyInvites
+func (s *SurveyService) ListSurveyInvites(ctx context.Context, p *survey.ListSurveyInvitesPayload) (*survey.SurveyInviteList, error) {
+	principal, err := s.parsePrincipal(ctx, p.Token)
+	if err != nil {
+		return nil, err
+	}
+	if s.invites == nil {
+		return nil, mapDomainError(errEventProcessingDisabled("survey invite tracking is unavailable"))
+	}
+
+	s.logger.InfoContext(ctx, "listing survey invites",
+		"principal", principal,
+		"survey_uid", p.SurveyUID,
+		"status", p.Status,
+	)
+
+	invites, err := s.invites.ListSurveyInvites(ctx, p.SurveyUID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list survey invites", "survey_uid", p.SurveyUID, "error", err)
+		return nil, mapDomainError(err)
+	}
+
+	result := &survey.SurveyInviteList{
+		Counts:  &survey.SurveyInviteCounts{Total: len(invites)},
+		Invites: make([]*survey.SurveyInvite, 0, len(invites)),
+	}
+	for _, invite := range invites {
+		switch invite.Status {
+		case domain.InviteStatusPending:
+			result.Counts.Pending++
+		case domain.InviteStatusSent:
+			result.Counts.Sent++
+		case domain.InviteStatusFailed:
+			result.Counts.Failed++
+		case domain.InviteStatusAccepted:
+			result.Counts.Accepted++
+		case domain.InviteStatusExpired:
+			result.Counts.Expired++
+		}
+		if p.Status != nil && string(invite.Status) != *p.Status {
+			continue
+		}
+		result.Invites = append(result.Invites, mapSurveyInviteToResult(invite))
+	}
+	return result, nil
+}
+
+func mapSurveyInviteToResult(invite domain.SurveyInvite) *survey.SurveyInvite {
+	var attempts []*survey.InviteAttempt
+	for _, attempt := range invite.Attempts {
+		attempts = append(attempts, &survey.InviteAttempt{
+			At:        attempt.At.UTC().Format(time.RFC3339),
+			InviteUID: optionalString(attempt.InviteUID),
+			ExpiresAt: formatInviteTime(attempt.ExpiresAt),
+			Error:     optionalString(attempt.Error),
+		})
+	}
+
+	return &survey.SurveyInvite{
+		SurveyResponseUID: invite.SurveyResponseUID,
+		Email:             invite.Email,
+		Name:              optionalString(invite.Name),
+		Status:            string(invite.Status),
+		InviteUID:         optionalString(invite.InviteUID),
+		Error:             optionalString(invite.Error),
+		CreatedAt:         invite.CreatedAt.UTC().Format(time.RFC3339),
+		SentAt:            formatInviteTime(invite.SentAt),
+		FailedAt:          formatInviteTime(invite.FailedAt),
+		ExpiresAt:         formatInviteTime(invite.ExpiresAt),
+		AcceptedAt:        formatInviteTime(invite.AcceptedAt),
+		AcceptedBy:        optionalString(invite.AcceptedBy),
+		Reinvites:         invite.Reinvites,
+		Attempts:          attempts,
+		AttachedTo:        optionalString(invite.AttachedTo),
+		UpdatedAt:         invite.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func formatInviteTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.UTC().Format(time.RFC3339)
+	return &formatted
+}
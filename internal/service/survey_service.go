@@ -6,20 +6,28 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 	"strings"
 
 	"github.com/linuxfoundation/lfx-v2-survey-service/gen/survey"
 	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
-	"github.com/linuxfoundation/lfx-v2-survey-service/pkg/concurrent"
 	"github.com/linuxfoundation/lfx-v2-survey-service/pkg/models/itx"
 )
 
 type SurveyService struct {
-	auth     domain.Authenticator
-	proxy    domain.ITXProxyClient
-	idMapper domain.IDMapper
-	logger   *slog.Logger
+	auth           domain.Authenticator
+	proxy          domain.ITXProxyClient
+	idMapper       domain.IDMapper
+	deadLetters    domain.DeadLetterQueue
+	reindexer      domain.Reindexer
+	backfiller     domain.InviteBackfiller
+	templates      domain.SurveyTemplateCatalog
+	invites        domain.SurveyInviteReader
+	enricher       domain.InviteEnricher
+	inviteSettings domain.InviteSettingsStore
+	surveySettings domain.SurveySettingsStore
+	logger         *slog.Logger
 }
 
 func NewSurveyService(
@@ -36,6 +44,55 @@ func NewSurveyService(
 	}
 }
 
+// SetDeadLetterQueue enables the dead-letter admin endpoints. It is only called when
+// event processing is enabled; otherwise those endpoints report the feature unavailable.
+func (s *SurveyService) SetDeadLetterQueue(q domain.DeadLetterQueue) {
+	s.deadLetters = q
+}
+
+// SetReindexer enables the reindex admin endpoints; like SetDeadLetterQueue it is only
+// called when event processing is enabled.
+func (s *SurveyService) SetReindexer(r domain.Reindexer) {
+	s.reindexer = r
+}
+
+// SetInviteBackfiller enables the invite backfill admin endpoints; like SetDeadLetterQueue
+// it is only called when event processing is enabled.
+func (s *SurveyService) SetInviteBackfiller(b domain.InviteBackfiller) {
+	s.backfiller = b
+}
+
+// SetSurveyTemplateCatalog enables the survey template endpoints and the survey_monkey_id
+// check in ScheduleSurvey; like SetDeadLetterQueue it is only called when event
+// processing is enabled.
+func (s *SurveyService) SetSurveyTemplateCatalog(c domain.SurveyTemplateCatalog) {
+	s.templates = c
+}
+
+// SetSurveyInviteReader enables the invite management endpoint; like SetDeadLetterQueue
+// it is only called when event processing is enabled.
+func (s *SurveyService) SetSurveyInviteReader(r domain.SurveyInviteReader) {
+	s.invites = r
+}
+
+// SetInviteEnricher enables the invite enrichment admin endpoint. It is only called when
+// the invite feature is enabled.
+func (s *SurveyService) SetInviteEnricher(e domain.InviteEnricher) {
+	s.enricher = e
+}
+
+// SetInviteSettingsStore enables the invite settings endpoints; like SetDeadLetterQueue it
+// is only called when event processing is enabled.
+func (s *SurveyService) SetInviteSettingsStore(store domain.InviteSettingsStore) {
+	s.inviteSettings = store
+}
+
+// SetSurveySettingsStore enables the survey settings endpoints; like SetDeadLetterQueue it
+// is only called when event processing is enabled.
+func (s *SurveyService) SetSurveySettingsStore(store domain.SurveySettingsStore) {
+	s.surveySettings = store
+}
+
 // ScheduleSurvey implements survey.Service.ScheduleSurvey
 func (s *SurveyService) ScheduleSurvey(ctx context.Context, p *survey.ScheduleSurveyPayload) (*survey.SurveyScheduleResult, error) {
 	// Parse JWT token to get principal (or use mock principal if configured)
@@ -52,6 +109,14 @@ func (s *SurveyService) ScheduleSurvey(ctx context.Context, p *survey.ScheduleSu
 		"committee_uid", p.CommitteeUID,
 	)
 
+	if err := s.validateSurveyMonkeyID(ctx, p.SurveyMonkeyID); err != nil {
+		s.logger.ErrorContext(ctx, "failed to validate survey_monkey_id",
+			"survey_monkey_id", p.SurveyMonkeyID,
+			"error", err,
+		)
+		return nil, mapDomainError(err)
+	}
+
 	// Map committee UID from V2 to V1 (ITX expects V1 SFID)
 	committeeV1, err := s.idMapper.MapCommitteeV2ToV1(ctx, p.CommitteeUID)
 	if err != nil {
@@ -738,6 +803,11 @@ func (s *SurveyService) ListSurveyResponses(ctx context.Context, p *survey.ListS
 		)
 	}
 
+	anonymous, err := s.surveyIsAnonymous(ctx, p.SurveyUID)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
 	// Call ITX API
 	itxResponse, err := s.proxy.ListResponses(ctx, p.SurveyUID, params)
 	if err != nil {
@@ -752,6 +822,11 @@ func (s *SurveyService) ListSurveyResponses(ctx context.Context, p *survey.ListS
 		)
 		return nil, mapDomainError(err)
 	}
+	if anonymous {
+		for _, item := range result.Data {
+			deidentifyResponseItem(item)
+		}
+	}
 
 	s.logger.InfoContext(ctx, "survey responses listed successfully",
 		"survey_uid", p.SurveyUID,
@@ -826,10 +901,9 @@ func (s *SurveyService) mapOptionalProjectV2ToV1(ctx context.Context, projectUID
 	return &mapped, nil
 }
 
-// mapProjectUIDsV2ToV1 maps a comma-delimited list of project UIDs from V2 to V1.
-// Mapping calls are fanned out concurrently using the shared WorkerPool — each goroutine
-// writes to a pre-allocated, index-disjoint slot in v1IDs (the same pattern used by
-// mapSurveyCommitteesToResult and mapITXResponsesToPage).
+// mapProjectUIDsV2ToV1 maps a comma-delimited list of project UIDs from V2 to V1
+// with a single batch lookup. If any UID fails to map, the returned error names
+// every failed UID so the caller can fix them in one pass.
 func (s *SurveyService) mapProjectUIDsV2ToV1(ctx context.Context, projectUIDs string) (string, error) {
 	if projectUIDs == "" {
 		return "", nil
@@ -847,33 +921,75 @@ func (s *SurveyService) mapProjectUIDsV2ToV1(ctx context.Context, projectUIDs st
 		return "", nil
 	}
 
-	// Pre-allocate result slice; goroutines write to index-disjoint slots.
+	mapped, err := s.idMapper.MapProjectsV2ToV1(ctx, validUIDs)
+	if err != nil {
+		var batchErr *domain.BatchMappingError
+		if !errors.As(err, &batchErr) {
+			s.logger.ErrorContext(ctx, "failed to map project UIDs to V1", "error", err)
+			return "", err
+		}
+		failedUIDs := batchErr.FailedIDs()
+		s.logger.ErrorContext(ctx, "failed to map project UIDs to V1",
+			"failed_project_v2_uids", failedUIDs,
+			"error", err,
+		)
+		return "", &domain.DomainError{
+			Type:    domain.GetErrorType(err),
+			Message: fmt.Sprintf("failed to map project UIDs: %s", strings.Join(failedUIDs, ", ")),
+			Err:     err,
+		}
+	}
+
+	// Preserve the caller's order (including any duplicates) in the joined result.
 	v1IDs := make([]string, len(validUIDs))
-	pool := concurrent.NewWorkerPool(5)
-	mappingFunctions := make([]func() error, len(validUIDs))
 	for i, uid := range validUIDs {
-		i, uid := i, uid
-		mappingFunctions[i] = func() error {
-			mapped, err := s.idMapper.MapProjectV2ToV1(ctx, uid)
-			if err != nil {
-				s.logger.ErrorContext(ctx, "failed to map project UID to V1",
-					"project_v2_uid", uid,
-					"error", err,
-				)
-				return err
-			}
-			v1IDs[i] = mapped
-			return nil
-		}
+		v1IDs[i] = mapped[uid]
 	}
+	return strings.Join(v1IDs, ","), nil
+}
+
+// v1ToV2Resolver translates V1 IDs to V2 UIDs using the results of one batch lookup,
+// falling back to the V1 ID for anything the batch could not map.
+type v1ToV2Resolver map[string]string
 
-	// pool.Run blocks until all functions complete; v1IDs[i] writes are index-disjoint and safe.
-	if err := pool.Run(ctx, mappingFunctions...); err != nil {
-		return "", err
+func (r v1ToV2Resolver) resolve(v1ID string) string {
+	if mapped, ok := r[v1ID]; ok {
+		return mapped
 	}
+	return v1ID
+}
 
-	// Join back into comma-delimited string
-	return strings.Join(v1IDs, ","), nil
+// resolvePtr is resolve for optional IDs; nil and empty IDs yield nil.
+func (r v1ToV2Resolver) resolvePtr(v1ID *string) *string {
+	if v1ID == nil || *v1ID == "" {
+		return nil
+	}
+	mapped := r.resolve(*v1ID)
+	return &mapped
+}
+
+// batchMapV1ToV2 resolves ids through batch and logs failures once for the whole
+// batch. Read paths never fail on mapping errors; unmapped IDs keep their V1 value.
+func (s *SurveyService) batchMapV1ToV2(
+	ctx context.Context,
+	idType string,
+	ids []string,
+	batch func(context.Context, []string) (map[string]string, error),
+) v1ToV2Resolver {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	mapped, err := batch(ctx, ids)
+	if err != nil {
+		attrs := []any{"id_type", idType, "error", err}
+		var batchErr *domain.BatchMappingError
+		if errors.As(err, &batchErr) {
+			attrs = append(attrs, "failed_v1_ids", batchErr.FailedIDs())
+		}
+		s.logger.WarnContext(ctx, "failed to map IDs from V1 to V2, using V1 IDs", attrs...)
+	}
+	return mapped
 }
 
 // mapITXResponseToResult maps ITX response to Goa result with V1→V2 ID mapping
@@ -929,65 +1045,30 @@ func (s *SurveyService) mapSurveyCommitteesToResult(ctx context.Context, committ
 		return nil, nil
 	}
 
-	result := make([]*survey.SurveyCommittee, len(committees))
-
-	// Create worker pool with 5 workers
-	pool := concurrent.NewWorkerPool(5)
-
-	// Build mapping functions for each committee
-	mappingFunctions := make([]func() error, len(committees))
-	for i, c := range committees {
-		mappingFunctions[i] = func() error {
-			// Map committee ID from V1 to V2 if present
-			var committeeV2 *string
-			if c.CommitteeID != nil && *c.CommitteeID != "" {
-				mapped, err := s.idMapper.MapCommitteeV1ToV2(ctx, *c.CommitteeID)
-				if err != nil {
-					s.logger.WarnContext(ctx, "failed to map committee ID from V1 to V2, using V1 ID",
-						"committee_v1_sfid", *c.CommitteeID,
-						"error", err,
-					)
-					// Fall back to V1 ID if mapping fails
-					committeeV2 = c.CommitteeID
-				} else {
-					committeeV2 = &mapped
-				}
-			}
-
-			// Map project ID from V1 to V2 if present
-			var projectV2 *string
-			if c.ProjectID != nil && *c.ProjectID != "" {
-				mapped, err := s.idMapper.MapProjectV1ToV2(ctx, *c.ProjectID)
-				if err != nil {
-					s.logger.WarnContext(ctx, "failed to map project ID from V1 to V2, using V1 ID",
-						"project_v1_sfid", *c.ProjectID,
-						"error", err,
-					)
-					// Fall back to V1 ID if mapping fails
-					projectV2 = c.ProjectID
-				} else {
-					projectV2 = &mapped
-				}
-			}
-
-			result[i] = &survey.SurveyCommittee{
-				CommitteeName:   c.CommitteeName,
-				CommitteeUID:    committeeV2,
-				ProjectUID:      projectV2,
-				ProjectName:     c.ProjectName,
-				SurveyURL:       c.SurveyURL,
-				TotalRecipients: c.TotalRecipients,
-				TotalResponses:  c.TotalResponses,
-				NpsValue:        c.NPSValue,
-			}
-
-			return nil
+	var committeeIDs, projectIDs []string
+	for _, c := range committees {
+		if c.CommitteeID != nil {
+			committeeIDs = append(committeeIDs, *c.CommitteeID)
+		}
+		if c.ProjectID != nil {
+			projectIDs = append(projectIDs, *c.ProjectID)
 		}
 	}
+	committeeMap := s.batchMapV1ToV2(ctx, "committee", committeeIDs, s.idMapper.MapCommitteesV1ToV2)
+	projectMap := s.batchMapV1ToV2(ctx, "project", projectIDs, s.idMapper.MapProjectsV1ToV2)
 
-	// Execute all mapping functions concurrently
-	if err := pool.Run(ctx, mappingFunctions...); err != nil {
-		return nil, err
+	result := make([]*survey.SurveyCommittee, len(committees))
+	for i, c := range committees {
+		result[i] = &survey.SurveyCommittee{
+			CommitteeName:   c.CommitteeName,
+			CommitteeUID:    committeeMap.resolvePtr(c.CommitteeID),
+			ProjectUID:      projectMap.resolvePtr(c.ProjectID),
+			ProjectName:     c.ProjectName,
+			SurveyURL:       c.SurveyURL,
+			TotalRecipients: c.TotalRecipients,
+			TotalResponses:  c.TotalResponses,
+			NpsValue:        c.NPSValue,
+		}
 	}
 
 	return result, nil
@@ -1019,47 +1100,23 @@ func (s *SurveyService) mapLFXProjectsToResult(ctx context.Context, projects []i
 		return make([]*survey.LFXProject, 0), nil
 	}
 
-	result := make([]*survey.LFXProject, len(projects))
-
-	// Create worker pool with 5 workers
-	pool := concurrent.NewWorkerPool(5)
+	projectIDs := make([]string, 0, len(projects))
+	for _, p := range projects {
+		projectIDs = append(projectIDs, p.ID)
+	}
+	projectMap := s.batchMapV1ToV2(ctx, "project", projectIDs, s.idMapper.MapProjectsV1ToV2)
 
-	// Build mapping functions for each project
-	mappingFunctions := make([]func() error, len(projects))
+	result := make([]*survey.LFXProject, len(projects))
 	for i, p := range projects {
-		mappingFunctions[i] = func() error {
-			// Map project ID from V1 to V2 if present
-			projectV2 := p.ID
-			if p.ID != "" {
-				mapped, err := s.idMapper.MapProjectV1ToV2(ctx, p.ID)
-				if err != nil {
-					s.logger.WarnContext(ctx, "failed to map project ID from V1 to V2, using V1 ID",
-						"project_v1_sfid", p.ID,
-						"error", err,
-					)
-					// Fall back to V1 ID if mapping fails
-				} else {
-					projectV2 = mapped
-				}
-			}
-
-			result[i] = &survey.LFXProject{
-				ID:      projectV2,
-				Name:    p.Name,
-				Slug:    p.Slug,
-				Status:  p.Status,
-				LogoURL: p.LogoURL,
-			}
-
-			return nil
+		result[i] = &survey.LFXProject{
+			ID:      projectMap.resolve(p.ID),
+			Name:    p.Name,
+			Slug:    p.Slug,
+			Status:  p.Status,
+			LogoURL: p.LogoURL,
 		}
 	}
 
-	// Execute all mapping functions concurrently
-	if err := pool.Run(ctx, mappingFunctions...); err != nil {
-		return nil, err
-	}
-
 	return result, nil
 }
 
@@ -1069,62 +1126,26 @@ func (s *SurveyService) mapExcludedCommitteesToResult(ctx context.Context, commi
 		return make([]*survey.ExcludedCommittee, 0), nil
 	}
 
-	result := make([]*survey.ExcludedCommittee, len(committees))
-
-	// Create worker pool with 5 workers
-	pool := concurrent.NewWorkerPool(5)
+	committeeIDs := make([]string, 0, len(committees))
+	projectIDs := make([]string, 0, len(committees))
+	for _, c := range committees {
+		committeeIDs = append(committeeIDs, c.CommitteeID)
+		projectIDs = append(projectIDs, c.ProjectID)
+	}
+	committeeMap := s.batchMapV1ToV2(ctx, "committee", committeeIDs, s.idMapper.MapCommitteesV1ToV2)
+	projectMap := s.batchMapV1ToV2(ctx, "project", projectIDs, s.idMapper.MapProjectsV1ToV2)
 
-	// Build mapping functions for each committee
-	mappingFunctions := make([]func() error, len(committees))
+	result := make([]*survey.ExcludedCommittee, len(committees))
 	for i, c := range committees {
-		mappingFunctions[i] = func() error {
-			// Map committee ID from V1 to V2 if present
-			committeeV2 := c.CommitteeID
-			if c.CommitteeID != "" {
-				mapped, err := s.idMapper.MapCommitteeV1ToV2(ctx, c.CommitteeID)
-				if err != nil {
-					s.logger.WarnContext(ctx, "failed to map committee ID from V1 to V2, using V1 ID",
-						"committee_v1_sfid", c.CommitteeID,
-						"error", err,
-					)
-					// Fall back to V1 ID if mapping fails
-				} else {
-					committeeV2 = mapped
-				}
-			}
-
-			// Map project ID from V1 to V2 if present
-			projectV2 := c.ProjectID
-			if c.ProjectID != "" {
-				mapped, err := s.idMapper.MapProjectV1ToV2(ctx, c.ProjectID)
-				if err != nil {
-					s.logger.WarnContext(ctx, "failed to map project ID from V1 to V2, using V1 ID",
-						"project_v1_sfid", c.ProjectID,
-						"error", err,
-					)
-					// Fall back to V1 ID if mapping fails
-				} else {
-					projectV2 = mapped
-				}
-			}
-
-			result[i] = &survey.ExcludedCommittee{
-				ProjectUID:        projectV2,
-				ProjectName:       c.ProjectName,
-				CommitteeUID:      committeeV2,
-				CommitteeName:     c.CommitteeName,
-				CommitteeCategory: c.CommitteeCategory,
-			}
-
-			return nil
+		result[i] = &survey.ExcludedCommittee{
+			ProjectUID:        projectMap.resolve(c.ProjectID),
+			ProjectName:       c.ProjectName,
+			CommitteeUID:      committeeMap.resolve(c.CommitteeID),
+			CommitteeName:     c.CommitteeName,
+			CommitteeCategory: c.CommitteeCategory,
 		}
 	}
 
-	// Execute all mapping functions concurrently
-	if err := pool.Run(ctx, mappingFunctions...); err != nil {
-		return nil, err
-	}
-
 	return result, nil
 }
 
@@ -1219,28 +1240,23 @@ func (s *SurveyService) mapExtendedExclusionToResult(ctx context.Context, itxExc
 }
 
 // mapITXResponsesToPage maps ITX paginated responses to a Goa result with V1→V2 ID mapping.
-// Uses a worker pool to run per-item NATS ID-mapper lookups concurrently, matching the
-// pattern used by mapSurveyCommitteesToResult and mapLFXProjectsToResult.
+// Project and committee IDs for the whole page are resolved with one batch lookup each.
 func (s *SurveyService) mapITXResponsesToPage(ctx context.Context, itxResponse *itx.PaginatedSurveyResponses) (*survey.SurveyResponsesPage, error) {
-	data := make([]*survey.SurveyResponseItem, len(itxResponse.Data))
-
-	pool := concurrent.NewWorkerPool(5)
-	mappingFunctions := make([]func() error, len(itxResponse.Data))
-	for i, r := range itxResponse.Data {
-		i, r := i, r
-		mappingFunctions[i] = func() error {
-			item, err := s.mapITXRecipientResponseToItem(ctx, r)
-			if err != nil {
-				return err
-			}
-			data[i] = item
-			return nil
+	var committeeIDs, projectIDs []string
+	for _, r := range itxResponse.Data {
+		if r.CommitteeID != nil {
+			committeeIDs = append(committeeIDs, *r.CommitteeID)
+		}
+		if r.Project != nil && r.Project.ID != nil {
+			projectIDs = append(projectIDs, *r.Project.ID)
 		}
 	}
+	committeeMap := s.batchMapV1ToV2(ctx, "committee", committeeIDs, s.idMapper.MapCommitteesV1ToV2)
+	projectMap := s.batchMapV1ToV2(ctx, "project", projectIDs, s.idMapper.MapProjectsV1ToV2)
 
-	// pool.Run blocks until all functions complete; data[i] writes are index-disjoint and safe.
-	if err := pool.Run(ctx, mappingFunctions...); err != nil {
-		return nil, err
+	data := make([]*survey.SurveyResponseItem, len(itxResponse.Data))
+	for i, r := range itxResponse.Data {
+		data[i] = mapITXRecipientResponseToItem(r, projectMap, committeeMap)
 	}
 
 	return &survey.SurveyResponsesPage{
@@ -1254,43 +1270,14 @@ func (s *SurveyService) mapITXResponsesToPage(ctx context.Context, itxResponse *
 	}, nil
 }
 
-// mapITXRecipientResponseToItem maps a single ITX SurveyRecipientResponse to a Goa SurveyResponseItem
-func (s *SurveyService) mapITXRecipientResponseToItem(ctx context.Context, r itx.SurveyRecipientResponse) (*survey.SurveyResponseItem, error) {
-	// Map project V1 ID → V2 UID if present; fall back to original on failure
+// mapITXRecipientResponseToItem maps a single ITX SurveyRecipientResponse to a Goa SurveyResponseItem,
+// translating project and committee IDs with the page's batch lookup results
+func mapITXRecipientResponseToItem(r itx.SurveyRecipientResponse, projectMap, committeeMap v1ToV2Resolver) *survey.SurveyResponseItem {
 	var projectResult *survey.SurveyResponseProj
 	if r.Project != nil {
-		var uid *string
-		name := r.Project.Name
-		if r.Project.ID != nil && *r.Project.ID != "" {
-			mapped, err := s.idMapper.MapProjectV1ToV2(ctx, *r.Project.ID)
-			if err != nil {
-				s.logger.WarnContext(ctx, "failed to map project ID from V1 to V2 for response, using V1 ID",
-					"project_v1_sfid", *r.Project.ID,
-					"error", err,
-				)
-				uid = r.Project.ID
-			} else {
-				uid = &mapped
-			}
-		}
 		projectResult = &survey.SurveyResponseProj{
-			UID:  uid,
-			Name: name,
-		}
-	}
-
-	// Map committee V1 ID → V2 UID if present; fall back to original on failure
-	var committeeUID *string
-	if r.CommitteeID != nil && *r.CommitteeID != "" {
-		mapped, err := s.idMapper.MapCommitteeV1ToV2(ctx, *r.CommitteeID)
-		if err != nil {
-			s.logger.WarnContext(ctx, "failed to map committee ID from V1 to V2 for response, using V1 ID",
-				"committee_v1_sfid", *r.CommitteeID,
-				"error", err,
-			)
-			committeeUID = r.CommitteeID
-		} else {
-			committeeUID = &mapped
+			UID:  projectMap.resolvePtr(r.Project.ID),
+			Name: r.Project.Name,
 		}
 	}
 
@@ -1326,7 +1313,7 @@ func (s *SurveyService) mapITXRecipientResponseToItem(ctx context.Context, r itx
 		ID:                            r.ID,
 		SurveyUID:                     r.SurveyID,
 		SurveyLink:                    r.SurveyLink,
-		CommitteeUID:                  committeeUID,
+		CommitteeUID:                  committeeMap.resolvePtr(r.CommitteeID),
 		Email:                         r.Email,
 		FirstName:                     r.FirstName,
 		LastName:                      r.LastName,
@@ -1358,7 +1345,7 @@ func (s *SurveyService) mapITXRecipientResponseToItem(ctx context.Context, r itx
 		SesEmailOpenedLastTime:  r.SESEmailOpenedLastTime,
 		SesLinkClicked:          r.SESLinkClicked,
 		SesLinkClickedLastTime:  r.SESLinkClickedLastTime,
-	}, nil
+	}
 }
 
 func mapDomainError(err error) error {
@@ -1372,10 +1359,25 @@ func mapDomainError(err error) error {
 
 	switch domainErr.Type {
 	case domain.ErrorTypeValidation:
-		return &survey.BadRequestError{
+		badRequest := &survey.BadRequestError{
 			Code:    "400",
 			Message: domainErr.Message,
 		}
+		var answerErr *domain.AnswerValidationError
+		if errors.As(domainErr, &answerErr) {
+			for _, qe := range answerErr.Errors {
+				badRequest.QuestionErrors = append(badRequest.QuestionErrors, &survey.QuestionError{
+					QuestionID: qe.QuestionID,
+					Message:    qe.Message,
+				})
+			}
+		}
+		return badRequest
+	case domain.ErrorTypeForbidden:
+		return &survey.ForbiddenError{
+			Code:    "403",
+			Message: domainErr.Message,
+		}
 	case domain.ErrorTypeNotFound:
 		return &survey.NotFoundError{
 			Code:    "404",
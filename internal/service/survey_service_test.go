@@ -5,9 +5,11 @@ package service_test
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/linuxfoundation/lfx-v2-survey-service/gen/survey"
 	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
@@ -27,12 +29,13 @@ func (m *mockAuth) ParsePrincipal(_ context.Context, _ string, _ *slog.Logger) (
 }
 
 // mockProxy is a test double for domain.ITXProxyClient
-// Only ListResponses is implemented; all others panic if called unexpectedly.
+// Only ListResponses and GetSurveyResults are implemented; all others panic if called unexpectedly.
 type mockProxy struct {
 	listResponsesResult *itx.PaginatedSurveyResponses
 	listResponsesErr    error
 	capturedSurveyID    string
 	capturedParams      *itx.ListResponsesParams
+	surveyResults       *itx.SurveyResults
 }
 
 func (m *mockProxy) ScheduleSurvey(_ context.Context, _ *itx.ScheduleSurveyRequest) (*itx.SurveyScheduleResponse, error) {
@@ -78,7 +81,10 @@ func (m *mockProxy) DeleteExclusionByID(_ context.Context, _ string) error {
 	panic("unexpected call to DeleteExclusionByID")
 }
 func (m *mockProxy) GetSurveyResults(_ context.Context, _ string) (*itx.SurveyResults, error) {
-	panic("unexpected call to GetSurveyResults")
+	if m.surveyResults == nil {
+		panic("unexpected call to GetSurveyResults")
+	}
+	return m.surveyResults, nil
 }
 func (m *mockProxy) ValidateEmail(_ context.Context, _ *itx.ValidateEmailRequest) (*itx.ValidateEmailResponse, error) {
 	panic("unexpected call to ValidateEmail")
@@ -408,3 +414,746 @@ func TestListSurveyResponses_BothProjectFilters_ReturnsValidationError(t *testin
 		t.Error("expected proxy not to be called when validation fails, but capturedParams is set")
 	}
 }
+
+// partialMapper is a test double for domain.IDMapper that maps IDs by appending
+// "-mapped" and reports every ID listed in failing as unresolved.
+type partialMapper struct {
+	failing map[string]bool
+}
+
+func (m *partialMapper) one(id string) (string, error) {
+	if m.failing[id] {
+		return "", domain.NewValidationError("invalid ID: mapping not found for " + id)
+	}
+	return id + "-mapped", nil
+}
+
+func (m *partialMapper) batch(ids []string) (map[string]string, error) {
+	result := make(map[string]string)
+	failed := make(map[string]error)
+	for _, id := range ids {
+		if mapped, err := m.one(id); err != nil {
+			failed[id] = err
+		} else {
+			result[id] = mapped
+		}
+	}
+	if len(failed) > 0 {
+		return result, &domain.BatchMappingError{Failed: failed}
+	}
+	return result, nil
+}
+
+func (m *partialMapper) MapProjectV2ToV1(_ context.Context, id string) (string, error) {
+	return m.one(id)
+}
+func (m *partialMapper) MapProjectV1ToV2(_ context.Context, id string) (string, error) {
+	return m.one(id)
+}
+func (m *partialMapper) MapCommitteeV2ToV1(_ context.Context, id string) (string, error) {
+	return m.one(id)
+}
+func (m *partialMapper) MapCommitteeV1ToV2(_ context.Context, id string) (string, error) {
+	return m.one(id)
+}
+func (m *partialMapper) MapProjectsV2ToV1(_ context.Context, ids []string) (map[string]string, error) {
+	return m.batch(ids)
+}
+func (m *partialMapper) MapProjectsV1ToV2(_ context.Context, ids []string) (map[string]string, error) {
+	return m.batch(ids)
+}
+func (m *partialMapper) MapCommitteesV2ToV1(_ context.Context, ids []string) (map[string]string, error) {
+	return m.batch(ids)
+}
+func (m *partialMapper) MapCommitteesV1ToV2(_ context.Context, ids []string) (map[string]string, error) {
+	return m.batch(ids)
+}
+
+func newTestServiceWithMapper(proxy domain.ITXProxyClient, mapper domain.IDMapper) *service.SurveyService {
+	auth := &mockAuth{principal: "test-user"}
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	return service.NewSurveyService(auth, proxy, mapper, logger)
+}
+
+func TestListSurveyResponses_ProjectUIDs_PartialMappingFailure(t *testing.T) {
+	// Every unmapped project UID is reported in one 400 and ITX is never called.
+	proxy := &mockProxy{}
+	mapper := &partialMapper{failing: map[string]bool{"uid-two": true, "uid-three": true}}
+
+	svc := newTestServiceWithMapper(proxy, mapper)
+	token := "test-token"
+	projectUIDs := "uid-one,uid-two,uid-three"
+
+	_, err := svc.ListSurveyResponses(context.Background(), &survey.ListSurveyResponsesPayload{
+		Token:       &token,
+		SurveyUID:   "survey-uid-abc",
+		ProjectUids: &projectUIDs,
+	})
+
+	badReq, ok := err.(*survey.BadRequestError)
+	if !ok {
+		t.Fatalf("expected *survey.BadRequestError, got %T: %v", err, err)
+	}
+	if badReq.Message != "failed to map project UIDs: uid-three, uid-two" {
+		t.Errorf("unexpected message: %q", badReq.Message)
+	}
+	if proxy.capturedParams != nil {
+		t.Error("expected ListResponses not to be called")
+	}
+}
+
+func TestListSurveyResponses_BatchMapping_FallsBackToV1IDs(t *testing.T) {
+	// IDs that fail V1→V2 mapping keep their V1 value; the rest of the page is mapped.
+	proxy := &mockProxy{
+		listResponsesResult: &itx.PaginatedSurveyResponses{
+			Data: []itx.SurveyRecipientResponse{
+				{
+					ID:          "resp-001",
+					SurveyID:    "survey-uid-abc",
+					CommitteeID: strPtr("com-ok"),
+					Project:     &itx.SurveyResponseProject{ID: strPtr("proj-ok")},
+				},
+				{
+					ID:          "resp-002",
+					SurveyID:    "survey-uid-abc",
+					CommitteeID: strPtr("com-bad"),
+					Project:     &itx.SurveyResponseProject{ID: strPtr("proj-bad")},
+				},
+			},
+		},
+	}
+	mapper := &partialMapper{failing: map[string]bool{"com-bad": true, "proj-bad": true}}
+
+	svc := newTestServiceWithMapper(proxy, mapper)
+	token := "test-token"
+
+	result, err := svc.ListSurveyResponses(context.Background(), &survey.ListSurveyResponsesPayload{
+		Token:     &token,
+		SurveyUID: "survey-uid-abc",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []struct{ committee, project string }{
+		{"com-ok-mapped", "proj-ok-mapped"},
+		{"com-bad", "proj-bad"},
+	}
+	for i, w := range want {
+		item := result.Data[i]
+		if item.CommitteeUID == nil || *item.CommitteeUID != w.committee {
+			t.Errorf("item %d: expected committee UID %s, got %v", i, w.committee, item.CommitteeUID)
+		}
+		if item.Project == nil || item.Project.UID == nil || *item.Project.UID != w.project {
+			t.Errorf("item %d: expected project UID %s, got %v", i, w.project, item.Project)
+		}
+	}
+}
+
+// fakeDeadLetterQueue is a test double for domain.DeadLetterQueue
+type fakeDeadLetterQueue struct {
+	entries      []domain.DeadLetter
+	replayResult *domain.DeadLetterReplayResult
+}
+
+func (q *fakeDeadLetterQueue) List(_ context.Context, _ domain.DeadLetterFilter) ([]domain.DeadLetter, error) {
+	return q.entries, nil
+}
+func (q *fakeDeadLetterQueue) Get(_ context.Context, sequence uint64) (*domain.DeadLetter, error) {
+	for _, e := range q.entries {
+		if e.Sequence == sequence {
+			return &e, nil
+		}
+	}
+	return nil, domain.NewNotFoundError("dead letter not found")
+}
+func (q *fakeDeadLetterQueue) Replay(_ context.Context, _ uint64) (*domain.DeadLetterReplayResult, error) {
+	return q.replayResult, nil
+}
+
+func TestDeadLetters_UnavailableWithoutEventProcessing(t *testing.T) {
+	svc := newTestService(&mockProxy{})
+	token := "test-token"
+
+	_, err := svc.ListDeadLetters(context.Background(), &survey.ListDeadLettersPayload{Token: &token, Limit: 50})
+	if _, ok := err.(*survey.ServiceUnavailableError); !ok {
+		t.Fatalf("expected ServiceUnavailableError, got %T: %v", err, err)
+	}
+}
+
+func TestListDeadLetters_OmitsPayloadAndPaginates(t *testing.T) {
+	queue := &fakeDeadLetterQueue{entries: []domain.DeadLetter{
+		{Sequence: 7, Key: "itx-surveys.s-1", Operation: "PUT", Payload: []byte("{}"), Reason: domain.DeadLetterReasonConversionError},
+		{Sequence: 9, Key: "itx-surveys.s-2", Operation: "PUT", Payload: []byte("{}"), Reason: domain.DeadLetterReasonMaxDeliveries},
+	}}
+	svc := newTestService(&mockProxy{})
+	svc.SetDeadLetterQueue(queue)
+	token := "test-token"
+
+	result, err := svc.ListDeadLetters(context.Background(), &survey.ListDeadLettersPayload{Token: &token, Limit: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(result.Entries))
+	}
+	if result.Entries[0].Payload != nil {
+		t.Errorf("expected list entries without payload")
+	}
+	if result.NextAfter == nil || *result.NextAfter != 9 {
+		t.Errorf("expected next_after 9, got %v", result.NextAfter)
+	}
+
+	entry, err := svc.GetDeadLetter(context.Background(), &survey.GetDeadLetterPayload{Token: &token, Sequence: 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(entry.Payload) != "{}" || entry.Reason != "conversion_error" {
+		t.Errorf("unexpected entry: %+v", entry)
+	}
+
+	_, err = svc.GetDeadLetter(context.Background(), &survey.GetDeadLetterPayload{Token: &token, Sequence: 8})
+	if _, ok := err.(*survey.NotFoundError); !ok {
+		t.Fatalf("expected NotFoundError, got %T: %v", err, err)
+	}
+}
+
+// fakeReindexer is a test double for domain.Reindexer that records the options it was started with
+type fakeReindexer struct {
+	started *domain.ReindexOptions
+}
+
+func (r *fakeReindexer) Start(_ context.Context, opts domain.ReindexOptions) (*domain.ReindexStatus, error) {
+	r.started = &opts
+	return &domain.ReindexStatus{Options: opts, State: domain.ReindexStateRunning, StartedAt: time.Now()}, nil
+}
+
+func (r *fakeReindexer) Status(_ context.Context) (*domain.ReindexStatus, error) {
+	return nil, domain.NewNotFoundError("no reindex has been run")
+}
+
+func (r *fakeReindexer) Cancel(_ context.Context) (*domain.ReindexStatus, error) {
+	return nil, domain.NewConflictError("no reindex is running")
+}
+
+func TestStartReindex(t *testing.T) {
+	token := "test-token"
+	since := "2026-01-01T00:00:00Z"
+	invalid := "yesterday"
+
+	svc := newTestService(&mockProxy{})
+	if _, err := svc.StartReindex(context.Background(), &survey.StartReindexPayload{Token: &token, RatePerSecond: 50}); err == nil {
+		t.Fatalf("expected error without event processing")
+	} else if _, ok := err.(*survey.ServiceUnavailableError); !ok {
+		t.Fatalf("expected ServiceUnavailableError, got %T: %v", err, err)
+	}
+
+	reindexer := &fakeReindexer{}
+	svc.SetReindexer(reindexer)
+
+	_, err := svc.StartReindex(context.Background(), &survey.StartReindexPayload{Token: &token, ModifiedSince: &invalid, RatePerSecond: 50})
+	if _, ok := err.(*survey.BadRequestError); !ok {
+		t.Fatalf("expected BadRequestError for an invalid time, got %T: %v", err, err)
+	}
+	if reindexer.started != nil {
+		t.Fatalf("reindex should not start with invalid options")
+	}
+
+	result, err := svc.StartReindex(context.Background(), &survey.StartReindexPayload{
+		Token:         &token,
+		KeyPrefixes:   []string{"itx-surveys."},
+		ModifiedSince: &since,
+		DryRun:        true,
+		RatePerSecond: 10,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reindexer.started.DryRun || reindexer.started.RatePerSecond != 10 || !reindexer.started.ModifiedUntil.IsZero() {
+		t.Errorf("unexpected options: %+v", reindexer.started)
+	}
+	if result.State != "running" || result.ModifiedSince == nil || *result.ModifiedSince != since || result.ModifiedUntil != nil {
+		t.Errorf("unexpected status: %+v", result)
+	}
+
+	if _, err := svc.GetReindex(context.Background(), &survey.GetReindexPayload{Token: &token}); err == nil {
+		t.Fatalf("expected not found from the fake reindexer")
+	} else if _, ok := err.(*survey.NotFoundError); !ok {
+		t.Errorf("expected NotFoundError, got %T: %v", err, err)
+	}
+}
+
+// fakeTemplateCatalog is a test double for domain.SurveyTemplateCatalog
+type fakeTemplateCatalog struct {
+	templates []domain.SurveyTemplateData
+	filter    *domain.SurveyTemplateFilter
+}
+
+func (c *fakeTemplateCatalog) List(_ context.Context, filter domain.SurveyTemplateFilter) (*domain.SurveyTemplatePage, error) {
+	c.filter = &filter
+	end := min(filter.Offset+filter.Limit, len(c.templates))
+	return &domain.SurveyTemplatePage{Templates: c.templates[filter.Offset:end], Total: len(c.templates)}, nil
+}
+
+func (c *fakeTemplateCatalog) Get(_ context.Context, id string) (*domain.SurveyTemplateData, error) {
+	for _, t := range c.templates {
+		if t.ID == id {
+			return &t, nil
+		}
+	}
+	return nil, domain.NewNotFoundError("survey template not found")
+}
+
+func TestListSurveyTemplates(t *testing.T) {
+	token := "test-token"
+	svc := newTestService(&mockProxy{})
+	if _, err := svc.ListSurveyTemplates(context.Background(), &survey.ListSurveyTemplatesPayload{Token: &token, Limit: 50}); err == nil {
+		t.Fatalf("expected error without event processing")
+	} else if _, ok := err.(*survey.ServiceUnavailableError); !ok {
+		t.Fatalf("expected ServiceUnavailableError, got %T: %v", err, err)
+	}
+
+	catalog := &fakeTemplateCatalog{templates: []domain.SurveyTemplateData{
+		{ID: "1", Title: "Board NPS", Language: "en", QuestionCount: 3},
+		{ID: "2", Title: "Community NPS", Language: "en"},
+		{ID: "3", Title: "Maintainer survey", Language: "en"},
+	}}
+	svc.SetSurveyTemplateCatalog(catalog)
+
+	result, err := svc.ListSurveyTemplates(context.Background(), &survey.ListSurveyTemplatesPayload{
+		Token:    &token,
+		Language: strPtr("en"),
+		Limit:    2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if catalog.filter.Language != "en" || catalog.filter.Limit != 2 {
+		t.Errorf("unexpected filter: %+v", catalog.filter)
+	}
+	if len(result.Templates) != 2 || result.Total != 3 {
+		t.Fatalf("expected 2 of 3 templates, got %d of %d", len(result.Templates), result.Total)
+	}
+	if result.NextOffset == nil || *result.NextOffset != 2 {
+		t.Errorf("expected next_offset 2, got %v", result.NextOffset)
+	}
+	if *result.Templates[0].QuestionCount != 3 || result.Templates[0].Nickname != nil {
+		t.Errorf("unexpected template: %+v", result.Templates[0])
+	}
+
+	result, err = svc.ListSurveyTemplates(context.Background(), &survey.ListSurveyTemplatesPayload{Token: &token, Offset: 2, Limit: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Templates) != 1 || result.NextOffset != nil {
+		t.Errorf("expected the last page without next_offset, got %d templates, next %v", len(result.Templates), result.NextOffset)
+	}
+
+	_, err = svc.GetSurveyTemplate(context.Background(), &survey.GetSurveyTemplatePayload{Token: &token, TemplateID: "9"})
+	if _, ok := err.(*survey.NotFoundError); !ok {
+		t.Fatalf("expected NotFoundError, got %T: %v", err, err)
+	}
+}
+
+func TestScheduleSurvey_RejectsUnknownSurveyMonkeyID(t *testing.T) {
+	token := "test-token"
+	svc := newTestService(&mockProxy{})
+	svc.SetSurveyTemplateCatalog(&fakeTemplateCatalog{templates: []domain.SurveyTemplateData{{ID: "1", Title: "Board NPS"}}})
+
+	// The mock proxy panics on ScheduleSurvey, so the request must be rejected before ITX is called.
+	_, err := svc.ScheduleSurvey(context.Background(), &survey.ScheduleSurveyPayload{
+		Token:          &token,
+		SurveyMonkeyID: strPtr("404"),
+		CommitteeUID:   "committee-uid",
+	})
+	if _, ok := err.(*survey.BadRequestError); !ok {
+		t.Fatalf("expected BadRequestError, got %T: %v", err, err)
+	}
+}
+
+// answerProxy is a test double for the survey response submission calls
+type answerProxy struct {
+	mockProxy
+	surveyMonkeyID *string
+	responseSurvey string
+	responseOwner  string
+	created        *itx.CreateResponseRequest
+	updated        *itx.UpdateResponseRequest
+}
+
+func (m *answerProxy) GetSurvey(_ context.Context, surveyID string, _ *itx.GetSurveyParams) (*itx.SurveyScheduleResponse, error) {
+	return &itx.SurveyScheduleResponse{ID: surveyID, SurveyMonkeyID: m.surveyMonkeyID}, nil
+}
+func (m *answerProxy) GetResponse(_ context.Context, responseID string) (*itx.ResponseResponse, error) {
+	response := &itx.ResponseResponse{SurveyResponseUID: responseID, SurveyUID: m.responseSurvey}
+	if m.responseOwner != "" {
+		response.UserName = strPtr(m.responseOwner)
+	}
+	return response, nil
+}
+func (m *answerProxy) CreateResponse(_ context.Context, req *itx.CreateResponseRequest) error {
+	m.created = req
+	return nil
+}
+func (m *answerProxy) UpdateResponse(_ context.Context, _ string, req *itx.UpdateResponseRequest) error {
+	m.updated = req
+	return nil
+}
+
+func answerTemplateCatalog() *fakeTemplateCatalog {
+	return &fakeTemplateCatalog{templates: []domain.SurveyTemplateData{{
+		ID:    "sm-1",
+		Title: "Board NPS",
+		Pages: []domain.SurveyQuestionPage{{ID: "p1", Questions: []domain.SurveyQuestion{
+			{ID: "nps", Family: domain.QuestionFamilyMatrix, Subtype: domain.QuestionSubtypeRating, Required: true,
+				Choices: make([]domain.SurveyQuestionChoice, 11), RatingMin: 0, RatingMax: 10},
+			{ID: "role", Family: domain.QuestionFamilySingleChoice, Subtype: "vertical", Required: true,
+				Choices: []domain.SurveyQuestionChoice{{ID: "c1"}, {ID: "c2"}, {ID: "c3"}}},
+			{ID: "topics", Family: domain.QuestionFamilyMultipleChoice, Subtype: "vertical",
+				Choices: []domain.SurveyQuestionChoice{{ID: "t1"}, {ID: "t2"}}},
+			{ID: "comments", Family: domain.QuestionFamilyOpenEnded, Subtype: "essay"},
+		}}},
+	}}}
+}
+
+func TestCreateSurveyResponse_ReportsEachInvalidAnswer(t *testing.T) {
+	token := "test-token"
+	proxy := &answerProxy{surveyMonkeyID: strPtr("sm-1"), responseSurvey: "survey-1", responseOwner: "test-user"}
+	svc := newTestService(proxy)
+	svc.SetSurveyTemplateCatalog(answerTemplateCatalog())
+
+	rating := 11
+	err := svc.CreateSurveyResponse(context.Background(), &survey.CreateSurveyResponsePayload{
+		Token:             &token,
+		SurveyUID:         "survey-1",
+		SurveyResponseUID: "response-1",
+		Answers: []*survey.SurveyAnswer{
+			{QuestionID: "nps", RatingValue: &rating},
+			{QuestionID: "topics", ChoiceIds: []string{"t1", "t9"}},
+			{QuestionID: "comments", ChoiceIds: []string{"t1"}},
+			{QuestionID: "unknown", AnswerText: strPtr("hi")},
+		},
+	})
+	badRequest, ok := err.(*survey.BadRequestError)
+	if !ok {
+		t.Fatalf("expected BadRequestError, got %T: %v", err, err)
+	}
+	if proxy.created != nil {
+		t.Fatal("expected ITX not to be called for invalid answers")
+	}
+
+	got := make(map[string]string)
+	for _, qe := range badRequest.QuestionErrors {
+		got[qe.QuestionID] = qe.Message
+	}
+	want := map[string]string{
+		"nps":      "rating 11 is outside the range 0-10",
+		"topics":   "choice t9 is not an option for this question",
+		"comments": "open_ended question expects answer_text",
+		"unknown":  "question is not part of this survey",
+		"role":     "an answer is required",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d question errors, got %v", len(want), got)
+	}
+	for id, msg := range want {
+		if got[id] != msg {
+			t.Errorf("question %s: expected %q, got %q", id, msg, got[id])
+		}
+	}
+}
+
+func TestCreateSurveyResponse_ValidAnswers(t *testing.T) {
+	token := "test-token"
+	proxy := &answerProxy{surveyMonkeyID: strPtr("sm-1"), responseSurvey: "survey-1", responseOwner: "test-user"}
+	svc := newTestService(proxy)
+	svc.SetSurveyTemplateCatalog(answerTemplateCatalog())
+
+	rating := 0
+	err := svc.CreateSurveyResponse(context.Background(), &survey.CreateSurveyResponsePayload{
+		Token:             &token,
+		SurveyUID:         "survey-1",
+		SurveyResponseUID: "response-1",
+		Answers: []*survey.SurveyAnswer{
+			{QuestionID: "nps", RatingValue: &rating},
+			{QuestionID: "role", ChoiceIds: []string{"c2"}},
+			{QuestionID: "comments", AnswerText: strPtr("Great work")},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if proxy.created == nil || proxy.created.SurveyUID != "survey-1" || len(proxy.created.Answers) != 3 {
+		t.Fatalf("expected the response to be submitted to ITX, got %+v", proxy.created)
+	}
+}
+
+func TestCreateSurveyResponse_RequiresOwnership(t *testing.T) {
+	tests := []struct {
+		name           string
+		responseSurvey string
+		responseOwner  string
+		wantErr        any
+	}{
+		{name: "response of another user", responseSurvey: "survey-1", responseOwner: "other-user", wantErr: &survey.ForbiddenError{}},
+		{name: "response without a user", responseSurvey: "survey-1", wantErr: &survey.ForbiddenError{}},
+		{name: "response of another survey", responseSurvey: "survey-2", responseOwner: "test-user", wantErr: &survey.NotFoundError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := "test-token"
+			proxy := &answerProxy{responseSurvey: tt.responseSurvey, responseOwner: tt.responseOwner}
+			svc := newTestService(proxy)
+
+			err := svc.CreateSurveyResponse(context.Background(), &survey.CreateSurveyResponsePayload{
+				Token:             &token,
+				SurveyUID:         "survey-1",
+				SurveyResponseUID: "response-1",
+				Answers:           []*survey.SurveyAnswer{{QuestionID: "role", ChoiceIds: []string{"c1"}}},
+			})
+			if fmt.Sprintf("%T", err) != fmt.Sprintf("%T", tt.wantErr) {
+				t.Fatalf("expected %T, got %T: %v", tt.wantErr, err, err)
+			}
+			if proxy.created != nil {
+				t.Fatal("expected ITX not to be called")
+			}
+		})
+	}
+}
+
+func TestUpdateSurveyResponse_RejectsResponseOfAnotherSurvey(t *testing.T) {
+	token := "test-token"
+	proxy := &answerProxy{surveyMonkeyID: strPtr("sm-1"), responseSurvey: "survey-2"}
+	svc := newTestService(proxy)
+	svc.SetSurveyTemplateCatalog(answerTemplateCatalog())
+
+	err := svc.UpdateSurveyResponse(context.Background(), &survey.UpdateSurveyResponsePayload{
+		Token:      &token,
+		SurveyUID:  "survey-1",
+		ResponseID: "response-1",
+		Answers:    []*survey.SurveyAnswer{{QuestionID: "role", ChoiceIds: []string{"c1"}}},
+	})
+	if _, ok := err.(*survey.NotFoundError); !ok {
+		t.Fatalf("expected NotFoundError, got %T: %v", err, err)
+	}
+	if proxy.updated != nil {
+		t.Fatal("expected ITX not to be called")
+	}
+}
+
+// fakeInviteReader is a test double for domain.SurveyInviteReader
+type fakeInviteReader struct {
+	invites []domain.SurveyInvite
+}
+
+func (r *fakeInviteReader) ListSurveyInvites(_ context.Context, _ string) ([]domain.SurveyInvite, error) {
+	return r.invites, nil
+}
+
+func TestListSurveyInvites(t *testing.T) {
+	token := "test-token"
+	svc := newTestService(&mockProxy{})
+	if _, err := svc.ListSurveyInvites(context.Background(), &survey.ListSurveyInvitesPayload{Token: &token, SurveyUID: "s-1"}); err == nil {
+		t.Fatal("expected an error when invite tracking is unavailable")
+	}
+
+	sentAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	svc.SetSurveyInviteReader(&fakeInviteReader{invites: []domain.SurveyInvite{
+		{SurveyResponseUID: "r-1", Email: "a@example.com", Status: domain.InviteStatusFailed, Error: "timeout"},
+		{SurveyResponseUID: "r-2", Email: "b@example.com", Status: domain.InviteStatusSent, SentAt: &sentAt},
+		{SurveyResponseUID: "r-3", Email: "c@example.com", Status: domain.InviteStatusFailed},
+		{SurveyResponseUID: "r-4", Email: "d@example.com", Status: domain.InviteStatusAccepted, AcceptedBy: "dee"},
+	}})
+
+	result, err := svc.ListSurveyInvites(context.Background(), &survey.ListSurveyInvitesPayload{
+		Token:     &token,
+		SurveyUID: "s-1",
+		Status:    strPtr("failed"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	counts := result.Counts
+	if counts.Total != 4 || counts.Failed != 2 || counts.Sent != 1 || counts.Accepted != 1 || counts.Pending != 0 || counts.Expired != 0 {
+		t.Errorf("unexpected counts: %+v", counts)
+	}
+	if len(result.Invites) != 2 || result.Invites[0].SurveyResponseUID != "r-1" || *result.Invites[0].Error != "timeout" {
+		t.Errorf("expected only the failed invites, got %+v", result.Invites)
+	}
+}
+
+// fakeSurveySettings is a test double for domain.SurveySettingsStore
+type fakeSurveySettings struct {
+	anonymous bool
+}
+
+func (f *fakeSurveySettings) GetSurveySettings(_ context.Context, surveyUID string) (*domain.SurveySettings, error) {
+	return &domain.SurveySettings{SurveyUID: surveyUID, Anonymous: f.anonymous}, nil
+}
+
+func (f *fakeSurveySettings) PutSurveySettings(_ context.Context, settings *domain.SurveySettings) (*domain.SurveySettings, *domain.ResultsAccessSync, error) {
+	return settings, &domain.ResultsAccessSync{}, nil
+}
+
+func TestListSurveyResponses_AnonymousSurveyIsDeidentified(t *testing.T) {
+	choiceText := "Very satisfied"
+	proxy := &mockProxy{
+		listResponsesResult: &itx.PaginatedSurveyResponses{
+			Data: []itx.SurveyRecipientResponse{
+				{
+					ID:             "resp-001",
+					SurveyID:       "survey-uid-abc",
+					Email:          strPtr("jane.doe@example.com"),
+					FirstName:      strPtr("Jane"),
+					Username:       strPtr("jdoe"),
+					ResponseStatus: strPtr("Responded"),
+					NPSValue:       f64Ptr(9.0),
+					Organization:   &itx.SurveyResponseOrganization{ID: strPtr("org-001"), Name: strPtr("Acme Corp")},
+					SurveyMonkeyQuestionAnswers: []itx.SurveyMonkeyQuestionAnswer{
+						{QuestionID: "q-001", Answers: []itx.SurveyMonkeyAnswer{{Text: &choiceText}}},
+					},
+				},
+			},
+		},
+	}
+
+	svc := newTestService(proxy)
+	svc.SetSurveySettingsStore(&fakeSurveySettings{anonymous: true})
+	token := "test-token"
+
+	result, err := svc.ListSurveyResponses(context.Background(), &survey.ListSurveyResponsesPayload{
+		Token:     &token,
+		SurveyUID: "survey-uid-abc",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	item := result.Data[0]
+	if item.Email != nil || item.FirstName != nil || item.Username != nil || item.Organization != nil || item.NpsValue != nil {
+		t.Errorf("expected identifying fields to be dropped, got %+v", item)
+	}
+	if len(item.SurveyMonkeyQuestionAnswers) != 0 {
+		t.Errorf("expected no answers, got %d", len(item.SurveyMonkeyQuestionAnswers))
+	}
+	if item.ResponseStatus == nil || *item.ResponseStatus != "Responded" {
+		t.Errorf("expected the response status to be kept, got %v", item.ResponseStatus)
+	}
+}
+
+func TestGetSurveyResults(t *testing.T) {
+	proxy := &mockProxy{surveyResults: &itx.SurveyResults{
+		NumRecipients: 40,
+		NumResponses:  12,
+		SurveyResults: []itx.SurveyResultItem{{
+			QuestionID: "q-1",
+			Responses: []itx.QuestionResponse{
+				{Answer: "Yes", Count: 9, Percentage: 75},
+				{Answer: "No", Count: 3, Percentage: 25},
+				{Answer: "Maybe", Count: 0, Percentage: 0},
+			},
+		}},
+		CommentResults: []itx.CommentResult{{QuestionID: "q-2", Comments: []string{"Personal remark"}}},
+	}}
+	svc := newTestService(proxy)
+	token := "test-token"
+	payload := &survey.GetSurveyResultsPayload{Token: &token, SurveyUID: "s-1"}
+
+	result, err := svc.GetSurveyResults(context.Background(), payload)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.MinCellSize != nil || len(result.Questions) != 1 || result.Questions[0].Answers[1].Count != 3 {
+		t.Errorf("expected unsuppressed counts for an identified survey, got %+v", result.Questions[0].Answers[1])
+	}
+
+	svc.SetSurveySettingsStore(&fakeSurveySettings{anonymous: true})
+	result, err = svc.GetSurveyResults(context.Background(), payload)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.MinCellSize == nil || *result.MinCellSize != domain.AnonymousMinCellSize {
+		t.Errorf("expected min_cell_size %d, got %v", domain.AnonymousMinCellSize, result.MinCellSize)
+	}
+	if result.Suppressed == nil || *result.Suppressed {
+		t.Errorf("expected the results not to be suppressed as a whole, got %v", result.Suppressed)
+	}
+	answers := result.Questions[0].Answers
+	if answers[1].Count != 0 || answers[1].Percentage != 0 || !*answers[1].Suppressed {
+		t.Errorf("expected the small cell to be suppressed, got %+v", answers[1])
+	}
+	if answers[0].Count != 0 || !*answers[0].Suppressed {
+		t.Errorf("expected the next-smallest given answer to be suppressed with the small cell, got %+v", answers[0])
+	}
+	if *answers[2].Suppressed {
+		t.Errorf("expected an empty cell not to be marked suppressed, got %+v", answers[2])
+	}
+}
+
+func TestGetSurveyResults_AnonymousSuppression(t *testing.T) {
+	tests := []struct {
+		name           string
+		numResponses   int
+		counts         []int
+		wantSuppressed []bool
+		wantAll        bool
+	}{
+		{
+			name:           "two small cells hide each other",
+			numResponses:   20,
+			counts:         []int{12, 4, 2, 2},
+			wantSuppressed: []bool{false, true, true, true},
+		},
+		{
+			name:           "single small cell takes the next-smallest with it",
+			numResponses:   20,
+			counts:         []int{9, 7, 4, 0},
+			wantSuppressed: []bool{false, true, true, false},
+		},
+		{
+			name:           "no small cells",
+			numResponses:   20,
+			counts:         []int{12, 8},
+			wantSuppressed: []bool{false, false},
+		},
+		{
+			name:           "too few responses suppress everything",
+			numResponses:   4,
+			counts:         []int{4, 0},
+			wantSuppressed: []bool{true, true},
+			wantAll:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			responses := make([]itx.QuestionResponse, 0, len(tt.counts))
+			for i, count := range tt.counts {
+				responses = append(responses, itx.QuestionResponse{Answer: fmt.Sprintf("a-%d", i), Count: count, Percentage: 10})
+			}
+			proxy := &mockProxy{surveyResults: &itx.SurveyResults{
+				NumResponses:  tt.numResponses,
+				SurveyResults: []itx.SurveyResultItem{{QuestionID: "q-1", Responses: responses}},
+			}}
+			svc := newTestService(proxy)
+			svc.SetSurveySettingsStore(&fakeSurveySettings{anonymous: true})
+			token := "test-token"
+
+			result, err := svc.GetSurveyResults(context.Background(), &survey.GetSurveyResultsPayload{Token: &token, SurveyUID: "s-1"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if *result.Suppressed != tt.wantAll {
+				t.Errorf("expected results suppressed %v, got %v", tt.wantAll, *result.Suppressed)
+			}
+			for i, answer := range result.Questions[0].Answers {
+				if *answer.Suppressed != tt.wantSuppressed[i] {
+					t.Errorf("answer %d: expected suppressed %v, got %v", i, tt.wantSuppressed[i], *answer.Suppressed)
+				}
+				if *answer.Suppressed && (answer.Count != 0 || answer.Percentage != 0) {
+					t.Errorf("answer %d: expected a suppressed answer to be zeroed, got %+v", i, answer)
+				}
+			}
+		})
+	}
+}
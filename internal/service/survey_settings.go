@@ -0,0 +1,226 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/gen/survey"
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+	"github.com/linuxfoundation/lfx-v2-survey-service/pkg/models/itx"
+)
+
+// GetSurveySettings implements survey.Service.GetSurveySettings
+func (s *SurveyService) GetSurveySettings(ctx context.Context, p *survey.GetSurveySettingsPayload) (*survey.SurveySettings, error) {
+	if _, err := s.parsePrincipal(ctx, p.Token); err != nil {
+		return nil, err
+	}
+	if s.surveySettings == nil {
+		return nil, mapDomainError(errEventProcessingDisabled("survey settings are unavailable"))
+	}
+
+	settings, err := s.surveySettings.GetSurveySettings(ctx, p.SurveyUID)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+	return mapSurveySettingsToResult(settings, nil), nil
+}
+
+// UpdateSurveySettings implements survey.Service.UpdateSurveySettings
+func (s *SurveyService) UpdateSurveySettings(ctx context.Context, p *survey.UpdateSurveySettingsPayload) (*survey.SurveySettings, error) {
+	principal, err := s.parsePrincipal(ctx, p.Token)
+	if err != nil {
+		return nil, err
+	}
+	if s.surveySettings == nil {
+		return nil, mapDomainError(errEventProcessingDisabled("survey settings are unavailable"))
+	}
+
+	s.logger.InfoContext(ctx, "updating survey settings",
+		"principal", principal,
+		"survey_uid", p.SurveyUID,
+		"share_results", p.ShareResults,
+		"anonymous", p.Anonymous,
+	)
+
+	stored, sync, err := s.surveySettings.PutSurveySettings(ctx, &domain.SurveySettings{
+		SurveyUID:    p.SurveyUID,
+		ShareResults: p.ShareResults,
+		Anonymous:    p.Anonymous,
+		UpdatedBy:    principal,
+	})
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to update survey settings", "survey_uid", p.SurveyUID, "error", err)
+		return nil, mapDomainError(err)
+	}
+	return mapSurveySettingsToResult(stored, sync), nil
+}
+
+// GetSurveyResults implements survey.Service.GetSurveyResults. Respondents reach it through
+// results_viewer, so only aggregate counts are returned; free-text comments are dropped.
+// Answers of an anonymous survey given by fewer than domain.AnonymousMinCellSize
+// respondents are suppressed, as are all answers when it has fewer responses.
+func (s *SurveyService) GetSurveyResults(ctx context.Context, p *survey.GetSurveyResultsPayload) (*survey.SurveyResults, error) {
+	principal, err := s.parsePrincipal(ctx, p.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "getting survey results",
+		"principal", principal,
+		"survey_uid", p.SurveyUID,
+	)
+
+	anonymous, err := s.surveyIsAnonymous(ctx, p.SurveyUID)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+
+	results, err := s.proxy.GetSurveyResults(ctx, p.SurveyUID)
+	if err != nil {
+		return nil, mapDomainError(err)
+	}
+	result := mapSurveyResultsToResult(p.SurveyUID, results)
+	if anonymous {
+		suppressSmallCells(result, domain.AnonymousMinCellSize)
+	}
+	return result, nil
+}
+
+// surveyIsAnonymous reports whether a survey's settings make it anonymous. Without event
+// processing no settings can be read, and surveys are treated as identified.
+func (s *SurveyService) surveyIsAnonymous(ctx context.Context, surveyUID string) (bool, error) {
+	if s.surveySettings == nil {
+		return false, nil
+	}
+	settings, err := s.surveySettings.GetSurveySettings(ctx, surveyUID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to read survey settings", "survey_uid", surveyUID, "error", err)
+		return false, err
+	}
+	return settings.Anonymous, nil
+}
+
+func mapSurveySettingsToResult(settings *domain.SurveySettings, sync *domain.ResultsAccessSync) *survey.SurveySettings {
+	result := &survey.SurveySettings{
+		SurveyUID:    settings.SurveyUID,
+		ShareResults: settings.ShareResults,
+		Anonymous:    settings.Anonymous,
+		UpdatedBy:    optionalString(settings.UpdatedBy),
+	}
+	if !settings.UpdatedAt.IsZero() {
+		updatedAt := settings.UpdatedAt.UTC().Format(time.RFC3339)
+		result.UpdatedAt = &updatedAt
+	}
+	if sync != nil {
+		result.ResultsAccess = &survey.ResultsAccessSync{
+			Granted: sync.Granted,
+			Revoked: sync.Revoked,
+			Failed:  sync.Failed,
+		}
+	}
+	return result
+}
+
+func mapSurveyResultsToResult(surveyUID string, results *itx.SurveyResults) *survey.SurveyResults {
+	result := &survey.SurveyResults{
+		SurveyUID:     surveyUID,
+		NumRecipients: results.NumRecipients,
+		NumResponses:  results.NumResponses,
+		Questions:     make([]*survey.SurveyQuestionResult, 0, len(results.SurveyResults)),
+	}
+	if results.SurveyEndTime != nil {
+		endTime := results.SurveyEndTime.UTC().Format(time.RFC3339)
+		result.SurveyEndTime = &endTime
+	}
+	for _, item := range results.SurveyResults {
+		question := &survey.SurveyQuestionResult{
+			QuestionID:   item.QuestionID,
+			QuestionText: optionalString(item.QuestionText),
+			QuestionType: optionalString(item.QuestionType),
+			Answers:      make([]*survey.SurveyAnswerResult, 0, len(item.Responses)),
+		}
+		for _, response := range item.Responses {
+			question.Answers = append(question.Answers, &survey.SurveyAnswerResult{
+				Answer:     response.Answer,
+				Count:      response.Count,
+				Percentage: response.Percentage,
+			})
+		}
+		result.Questions = append(result.Questions, question)
+	}
+	return result
+}
+
+// suppressSmallCells zeroes the answers given by fewer than minCellSize respondents. When
+// a question has a single such answer, the next-smallest is suppressed with it so the small
+// count cannot be recovered from the others. Results of fewer than minCellSize responses
+// are suppressed entirely.
+func suppressSmallCells(result *survey.SurveyResults, minCellSize int) {
+	result.MinCellSize = &minCellSize
+	all := result.NumResponses < minCellSize
+	result.Suppressed = &all
+
+	for _, question := range result.Questions {
+		suppressed := make([]bool, len(question.Answers))
+		count := 0
+		for i, answer := range question.Answers {
+			suppressed[i] = all || (answer.Count > 0 && answer.Count < minCellSize)
+			if suppressed[i] {
+				count++
+			}
+		}
+		if count == 1 {
+			if i := complementaryCell(question.Answers, suppressed); i >= 0 {
+				suppressed[i] = true
+			}
+		}
+
+		for i, answer := range question.Answers {
+			if suppressed[i] {
+				answer.Count = 0
+				answer.Percentage = 0
+			}
+			answer.Suppressed = &suppressed[i]
+		}
+	}
+}
+
+// complementaryCell returns the index of the smallest unsuppressed answer, preferring answers
+// that were given, or -1 when every answer is suppressed
+func complementaryCell(answers []*survey.SurveyAnswerResult, suppressed []bool) int {
+	best := -1
+	for i, answer := range answers {
+		if suppressed[i] {
+			continue
+		}
+		if best < 0 {
+			best = i
+			continue
+		}
+		current := answers[best]
+		if (current.Count == 0 && answer.Count > 0) || (answer.Count > 0 && answer.Count < current.Count) {
+			best = i
+		}
+	}
+	return best
+}
+
+// deidentifyResponseItem drops the fields of a listed response of an anonymous survey
+// that identify the respondent or carry their answers
+func deidentifyResponseItem(item *survey.SurveyResponseItem) {
+	item.Email = nil
+	item.FirstName = nil
+	item.LastName = nil
+	item.Username = nil
+	item.JobTitle = nil
+	item.Organization = nil
+	item.SurveyLink = nil
+	item.NpsValue = nil
+	item.SurveyMonkeyRespondentID = nil
+	item.SurveyMonkeyQuestionAnswers = []*survey.SurveyQuestionAnswer{}
+	// Bounce diagnostics quote the recipient address
+	item.SesBounceDiagnosticCode = nil
+}
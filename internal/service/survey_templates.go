@@ -0,0 +1,120 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package service
+
+import (
+	"context"
+
+	"github.com/linuxfoundation/lfx-v2-survey-service/gen/survey"
+	"github.com/linuxfoundation/lfx-v2-survey-service/internal/domain"
+)
+
+// ListSurveyTemplates implements survey.Service.ListSurveyTemplates
+func (s *SurveyService) ListSurveyTemplates(ctx context.Context, p *survey.ListSurveyTemplatesPayload) (*survey.SurveyTemplateList, error) {
+	principal, err := s.parsePrincipal(ctx, p.Token)
+	if err != nil {
+		return nil, err
+	}
+	if s.templates == nil {
+		return nil, mapDomainError(errEventProcessingDisabled("the survey template catalog is unavailable"))
+	}
+
+	filter := domain.SurveyTemplateFilter{Offset: p.Offset, Limit: p.Limit}
+	if p.Title != nil {
+		filter.Title = *p.Title
+	}
+	if p.Category != nil {
+		filter.Category = *p.Category
+	}
+	if p.Language != nil {
+		filter.Language = *p.Language
+	}
+
+	s.logger.InfoContext(ctx, "listing survey templates",
+		"principal", principal,
+		"title", filter.Title,
+		"category", filter.Category,
+		"language", filter.Language,
+		"offset", filter.Offset,
+		"limit", filter.Limit,
+	)
+
+	page, err := s.templates.List(ctx, filter)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list survey templates", "error", err)
+		return nil, mapDomainError(err)
+	}
+
+	result := &survey.SurveyTemplateList{
+		Templates: make([]*survey.SurveyTemplate, 0, len(page.Templates)),
+		Total:     page.Total,
+	}
+	for _, t := range page.Templates {
+		result.Templates = append(result.Templates, mapSurveyTemplateToResult(t))
+	}
+	if next := filter.Offset + len(page.Templates); len(page.Templates) > 0 && next < page.Total {
+		result.NextOffset = &next
+	}
+	return result, nil
+}
+
+// GetSurveyTemplate implements survey.Service.GetSurveyTemplate
+func (s *SurveyService) GetSurveyTemplate(ctx context.Context, p *survey.GetSurveyTemplatePayload) (*survey.SurveyTemplate, error) {
+	principal, err := s.parsePrincipal(ctx, p.Token)
+	if err != nil {
+		return nil, err
+	}
+	if s.templates == nil {
+		return nil, mapDomainError(errEventProcessingDisabled("the survey template catalog is unavailable"))
+	}
+
+	s.logger.InfoContext(ctx, "getting survey template",
+		"principal", principal,
+		"template_id", p.TemplateID,
+	)
+
+	template, err := s.templates.Get(ctx, p.TemplateID)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get survey template", "template_id", p.TemplateID, "error", err)
+		return nil, mapDomainError(err)
+	}
+	return mapSurveyTemplateToResult(*template), nil
+}
+
+// validateSurveyMonkeyID checks that a survey is being scheduled from a template in the
+// catalog. It is skipped when the catalog is not available (event processing disabled).
+func (s *SurveyService) validateSurveyMonkeyID(ctx context.Context, surveyMonkeyID *string) error {
+	if s.templates == nil || surveyMonkeyID == nil {
+		return nil
+	}
+	_, err := s.templates.Get(ctx, *surveyMonkeyID)
+	if domain.GetErrorType(err) == domain.ErrorTypeNotFound {
+		return domain.NewValidationError("survey_monkey_id " + *surveyMonkeyID + " is not in the survey template catalog")
+	}
+	return err
+}
+
+func mapSurveyTemplateToResult(t domain.SurveyTemplateData) *survey.SurveyTemplate {
+	return &survey.SurveyTemplate{
+		ID:            t.ID,
+		Title:         t.Title,
+		Nickname:      optionalString(t.Nickname),
+		Category:      optionalString(t.Category),
+		Language:      optionalString(t.Language),
+		QuestionCount: &t.QuestionCount,
+		PageCount:     &t.PageCount,
+		PreviewURL:    optionalString(t.Preview),
+		CollectURL:    optionalString(t.CollectUrl),
+		DateCreated:   optionalString(t.DateCreated),
+		DateModified:  optionalString(t.DateModified),
+	}
+}
+
+// optionalString returns nil for an empty string so it is omitted from the response
+func optionalString(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
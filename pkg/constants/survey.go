@@ -8,3 +8,7 @@ const ResourceTypeSurvey = "survey"
 
 // InviteRoleParticipant is the invite-service role for survey participants who do not yet have an LFID.
 const InviteRoleParticipant = "Participant"
+
+// InviteRoles are the invite-service roles a project or survey may grant through its invite settings.
+// Keep in sync with the role enum of the invite settings API.
+var InviteRoles = []string{InviteRoleParticipant}
@@ -0,0 +1,146 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package kvwatch
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	// Delay before the first re-watch after a watch closes
+	defaultMinBackoff = time.Second
+
+	// Upper bound for the delay between re-watch attempts
+	defaultMaxBackoff = 30 * time.Second
+)
+
+// Mirror receives the entries of a followed watch
+type Mirror struct {
+	// Reset is called when a watch closes unexpectedly. The mirror is stale until the
+	// next Synced; the entries delivered in between rebuild it from scratch.
+	Reset func()
+	// Apply records one entry
+	Apply func(entry jetstream.KeyValueEntry)
+	// Synced is called each time a watch has delivered every existing entry
+	Synced func()
+}
+
+// Watch follows a KV watch, re-creating it with exponential backoff whenever the
+// server closes its updates channel, e.g. after a reconnect or a stream outage.
+type Watch struct {
+	watch  func(ctx context.Context) (jetstream.KeyWatcher, error)
+	mirror Mirror
+	logger *slog.Logger
+
+	minBackoff time.Duration
+	maxBackoff time.Duration
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Start creates the first watcher and follows it in the background until Stop.
+// watch is called with a context that is cancelled by Stop, not with ctx, which
+// only bounds the first call.
+func Start(ctx context.Context, watch func(ctx context.Context) (jetstream.KeyWatcher, error), mirror Mirror, logger *slog.Logger) (*Watch, error) {
+	return start(ctx, watch, mirror, logger, defaultMinBackoff, defaultMaxBackoff)
+}
+
+func start(ctx context.Context, watch func(ctx context.Context) (jetstream.KeyWatcher, error), mirror Mirror, logger *slog.Logger, minBackoff, maxBackoff time.Duration) (*Watch, error) {
+	runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	watcher, err := watch(runCtx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	w := &Watch{
+		watch:      watch,
+		mirror:     mirror,
+		logger:     logger,
+		minBackoff: minBackoff,
+		maxBackoff: maxBackoff,
+		cancel:     cancel,
+		done:       make(chan struct{}),
+	}
+	go w.run(runCtx, watcher)
+	return w, nil
+}
+
+// Stop stops the current watcher and waits for the background loop to exit
+func (w *Watch) Stop() {
+	w.cancel()
+	<-w.done
+}
+
+func (w *Watch) run(ctx context.Context, watcher jetstream.KeyWatcher) {
+	defer close(w.done)
+
+	delay := w.minBackoff
+	for {
+		synced := w.follow(ctx, watcher)
+		if err := watcher.Stop(); err != nil && ctx.Err() == nil {
+			w.logger.With("error", err).Debug("failed to stop closed KV watcher")
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		w.mirror.Reset()
+		if synced {
+			delay = w.minBackoff
+		}
+		w.logger.Warn("KV watch closed, re-watching", "retry_in", delay)
+
+		var ok bool
+		if watcher, ok = w.rewatch(ctx, &delay); !ok {
+			return
+		}
+	}
+}
+
+// rewatch re-creates the watcher, backing off between attempts, until it succeeds
+// or ctx is done
+func (w *Watch) rewatch(ctx context.Context, delay *time.Duration) (jetstream.KeyWatcher, bool) {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, false
+		case <-time.After(*delay):
+		}
+		*delay = min(*delay*2, w.maxBackoff)
+
+		watcher, err := w.watch(ctx)
+		if err == nil {
+			return watcher, true
+		}
+		w.logger.With("error", err).Warn("failed to re-create KV watch", "retry_in", *delay)
+	}
+}
+
+// follow applies updates until the watcher closes or ctx is done, reporting
+// whether the watcher delivered all existing entries
+func (w *Watch) follow(ctx context.Context, watcher jetstream.KeyWatcher) (synced bool) {
+	for {
+		select {
+		case <-ctx.Done():
+			return synced
+		case entry, ok := <-watcher.Updates():
+			if !ok {
+				return synced
+			}
+			// A nil entry marks the end of the initial values.
+			if entry == nil {
+				synced = true
+				w.mirror.Synced()
+				continue
+			}
+			w.mirror.Apply(entry)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright The Linux Foundation and each contributor to LFX.
+// SPDX-License-Identifier: MIT
+
+package kvwatch
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeWatcher struct {
+	updates chan jetstream.KeyValueEntry
+	stopped atomic.Bool
+}
+
+func newFakeWatcher() *fakeWatcher {
+	return &fakeWatcher{updates: make(chan jetstream.KeyValueEntry, 8)}
+}
+
+func (w *fakeWatcher) Updates() <-chan jetstream.KeyValueEntry { return w.updates }
+
+func (w *fakeWatcher) Stop() error {
+	w.stopped.Store(true)
+	return nil
+}
+
+type fakeEntry struct {
+	jetstream.KeyValueEntry
+	key string
+}
+
+func (e fakeEntry) Key() string { return e.key }
+
+// recorder is a Mirror that logs every call
+type recorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (r *recorder) record(event string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+func (r *recorder) snapshot() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.events...)
+}
+
+func (r *recorder) mirror() Mirror {
+	return Mirror{
+		Reset:  func() { r.record("reset") },
+		Apply:  func(entry jetstream.KeyValueEntry) { r.record("apply " + entry.Key()) },
+		Synced: func() { r.record("synced") },
+	}
+}
+
+func TestWatch_RewatchesWhenUpdatesClose(t *testing.T) {
+	first, second := newFakeWatcher(), newFakeWatcher()
+	var calls atomic.Int32
+	watch := func(context.Context) (jetstream.KeyWatcher, error) {
+		switch calls.Add(1) {
+		case 1:
+			return first, nil
+		case 2:
+			return nil, errors.New("stream unavailable")
+		default:
+			return second, nil
+		}
+	}
+
+	rec := &recorder{}
+	w, err := start(context.Background(), watch, rec.mirror(), slog.Default(), time.Millisecond, 4*time.Millisecond)
+	require.NoError(t, err)
+
+	first.updates <- fakeEntry{key: "a"}
+	first.updates <- nil
+	close(first.updates)
+
+	second.updates <- fakeEntry{key: "b"}
+	second.updates <- nil
+
+	want := []string{"apply a", "synced", "reset", "apply b", "synced"}
+	require.Eventually(t, func() bool {
+		return len(rec.snapshot()) == len(want)
+	}, 2*time.Second, time.Millisecond)
+	assert.Equal(t, want, rec.snapshot())
+	assert.Equal(t, int32(3), calls.Load(), "a failed re-watch is retried")
+	assert.True(t, first.stopped.Load())
+
+	w.Stop()
+	assert.True(t, second.stopped.Load())
+}
+
+func TestWatch_StartFailure(t *testing.T) {
+	_, err := Start(context.Background(), func(context.Context) (jetstream.KeyWatcher, error) {
+		return nil, errors.New("no bucket")
+	}, (&recorder{}).mirror(), slog.Default())
+	assert.EqualError(t, err, "no bucket")
+}
+
+func TestWatch_StopWhileBackingOff(t *testing.T) {
+	first := newFakeWatcher()
+	var calls atomic.Int32
+	watch := func(context.Context) (jetstream.KeyWatcher, error) {
+		if calls.Add(1) == 1 {
+			return first, nil
+		}
+		return nil, errors.New("stream unavailable")
+	}
+
+	rec := &recorder{}
+	w, err := start(context.Background(), watch, rec.mirror(), slog.Default(), time.Hour, time.Hour)
+	require.NoError(t, err)
+	close(first.updates)
+
+	require.Eventually(t, func() bool {
+		return len(rec.snapshot()) == 1
+	}, 2*time.Second, time.Millisecond)
+
+	stopped := make(chan struct{})
+	go func() {
+		w.Stop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Stop did not interrupt the backoff")
+	}
+	assert.Equal(t, []string{"reset"}, rec.snapshot())
+}